@@ -1,19 +1,27 @@
 package main
 
 import (
+	"crypto/subtle"
 	"fmt"
-	"log"
+	"log/slog"
 	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
 	"ololo-gate/internal/handlers"
+	"ololo-gate/internal/logging"
+	"ololo-gate/internal/metrics"
 	"ololo-gate/internal/middleware"
-	"ololo-gate/internal/models"
+	"ololo-gate/internal/migrations"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/scheduler"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/storage"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
-	"github.com/gofiber/fiber/v2/middleware/recover"
 
 	fiberSwagger "github.com/swaggo/fiber-swagger"
 	_ "ololo-gate/docs" // Import generated docs
@@ -49,115 +57,388 @@ func main() {
 	// Load configuration
 	config.LoadConfig()
 
+	// Write structured log lines (LOG_FORMAT, LOG_LEVEL) from here on
+	if err := logging.Setup(os.Stdout, config.AppConfig().Logging.Format, config.AppConfig().Logging.Level); err != nil {
+		logging.Fatal("Invalid logging configuration", "error", err)
+	}
+	config.OnReload(func(cfg *config.Config) {
+		_ = logging.SetLevel(cfg.Logging.Level) // Validated when the config was built
+	})
+
+	// "migrate <command>" manages the database schema and exits without starting the server
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		db.Connect()
+		if err := migrations.RunCommand(db.DB, os.Args[2:], os.Stdout); err != nil {
+			logging.Fatal("Migration failed", "error", err)
+		}
+		return
+	}
+
+	// A misspelled country would reject every new phone number
+	if err := phonenumber.ValidateAllowedCountries(); err != nil {
+		logging.Fatal("Invalid phone configuration", "error", err)
+	}
+
+	// Set up file storage for gate report photos and other artifacts
+	if _, err := storage.Default(); err != nil {
+		logging.Fatal("Failed to initialize file storage", "error", err)
+	}
+
+	// Report panics and server errors (no-op without SENTRY_DSN)
+	if err := services.InitErrorReporting(); err != nil {
+		logging.Fatal("Failed to initialize error reporting", "error", err)
+	}
+
+	if config.AppConfig().Sandbox.Enabled {
+		slog.Info("Sandbox mode: gate commands and SMS are simulated, no barrier moves and no message is sent")
+	}
+	if faults := config.AppConfig().Faults; faults.Enabled {
+		slog.Warn("Fault injection enabled",
+			"latency_rate", faults.LatencyRate, "latency", faults.Latency, "provider_error_rate", faults.ProviderErrorRate, "db_error_rate", faults.DBErrorRate)
+	}
+
 	// Connect to database
 	db.Connect()
 
-	// Auto-migrate database models
-	db.AutoMigrate(&models.User{}, &models.Admin{}, &models.Contact{}, &models.AdminAuditLog{})
+	// Apply pending schema migrations (DB_MIGRATE_ON_START) and refuse to run on any other schema
+	if err := migrations.EnsureSchema(db.DB, config.AppConfig().Database.MigrateOnStart); err != nil {
+		logging.Fatal("Database schema is not up to date", "error", err)
+	}
+
+	// Encrypt phone numbers and device IDs stored before encryption at rest
+	db.MigrateUserPII()
+
+	// Give users created before user_phones existed their primary number row
+	db.MigrateUserPhones()
 
 	// Create initial super admin if not exists
 	db.CreateInitialAdmin()
 
+	// Subscribe side-effect subsystems to domain events
+	services.RegisterEventSubscribers()
+
+	// Start background jobs
+	if err := services.RegisterScheduledJobs(); err != nil {
+		logging.Fatal("Failed to register scheduled jobs", "error", err)
+	}
+	scheduler.Default().Start()
+
+	// Start writing buffered usage metering counters
+	services.Meter().Start(config.AppConfig().Metering.FlushInterval)
+
+	// Watch provider error rate, DB pool and job backlog and alert admins
+	services.StartAlertMonitor()
+
+	// Send gate commands queued while the provider was down once it recovers
+	services.StartGateCommandQueue(5 * time.Second)
+
+	// Reload CORS origins, rate limits, quotas and feature flags on SIGHUP
+	watchConfigReload()
+
 	// Initialize Fiber app
 	app := fiber.New(fiber.Config{
-		AppName: "Ololo Gate API v1.0",
-		ErrorHandler: func(c *fiber.Ctx, err error) error {
-			code := fiber.StatusInternalServerError
-			if e, ok := err.(*fiber.Error); ok {
-				code = e.Code
-			}
-			return c.Status(code).JSON(fiber.Map{
-				"success": false,
-				"message": err.Error(),
-			})
-		},
+		AppName:       "Ololo Gate API v1.0",
+		ErrorHandler:  handlers.ErrorHandler, // RFC 7807 problem+json for v2, {success, message} for v1
+		CaseSensitive: true,                  // /users/:id/MERGE must not reach a route whose access rule is /users/:id/merge
 	})
 
+	// Load balancer probe, registered ahead of the middleware so probes skip logging, CORS and error reporting
+	app.Get("/ping", ping)
+
 	// Middleware
-	app.Use(recover.New()) // Recover from panics
-	app.Use(logger.New(logger.Config{
-		Format: "[${time}] ${status} - ${method} ${path} (${latency})\n",
-	}))
-
-	// CORS configuration - handle wildcard origins securely
-	corsConfig := cors.Config{
-		AllowOrigins:     config.AppConfig.CORS.AllowedOrigins,
-		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
-		AllowHeaders:     "Origin,Content-Type,Accept,Authorization",
-		ExposeHeaders:    "Content-Length",
-		MaxAge:           86400, // 24 hours preflight cache
-		AllowCredentials: config.AppConfig.CORS.AllowedOrigins != "*", // Only allow credentials if not using wildcard
-	}
-	app.Use(cors.New(corsConfig))
+	app.Use(middleware.RequestID())      // Correlation ID in X-Request-ID and in every log line of the request
+	app.Use(middleware.AccessLog())      // One structured log line per request
+	app.Use(middleware.ErrorReporting()) // Recover from panics and report them and 5xx responses
+
+	// CORS - public origins from CORS_ALLOWED_ORIGINS, a stricter explicit-only policy for /api/v1/admin/*,
+	// both extended by origins managed in the database and followed across config reloads
+	app.Use(middleware.CORS())
+
+	// Mark responses of a sandbox instance, whose gate commands and SMS are simulated
+	app.Use(middleware.MarkSandbox())
 
 	// Routes
 	setupRoutes(app)
 
+	// Every API route must have an access rule; uncovered routes would be unreachable
+	if uncovered := middleware.UncoveredRoutes(app); len(uncovered) > 0 {
+		logging.Fatal("Routes without an access policy rule", "routes", uncovered)
+	}
+
 	// Start server
-	port := ":" + config.AppConfig.Server.Port
-	log.Printf("🚀 Ololo Gate API server starting on port %s", config.AppConfig.Server.Port)
-	log.Fatal(app.Listen(port))
+	port := ":" + config.AppConfig().Server.Port
+	slog.Info("Ololo Gate API server starting", "port", config.AppConfig().Server.Port)
+	logging.Fatal("Server stopped", "error", app.Listen(port))
 }
 
 func setupRoutes(app *fiber.App) {
-	// Swagger documentation
+	// Swagger documentation, exposed according to SWAGGER_MODE and SWAGGER_ALLOWED_ORIGINS
+	app.Use("/swagger", middleware.SwaggerAccess())
 	app.Get("/swagger/*", fiberSwagger.WrapHandler)
+	app.Get("/openapi.json", middleware.SwaggerAccess(), handlers.GetOpenAPISpec) // GET /openapi.json - API description the typed client is generated from
 
 	// Health check endpoint
 	app.Get("/", healthCheck)
 
-	// API v1 routes
-	api := app.Group("/api/v1")
+	// Prometheus metrics endpoint
+	app.Get("/metrics", metrics.Handler)
+
+	// Browser WebSocket clients pass the admin token as ?token=, which must be in place before authorization
+	app.Use("/api/v1/admin/feed", handlers.AdminFeedTokenFromQuery)
+
+	// API v1 routes (access to each route is declared in middleware.AccessPolicy)
+	api := app.Group("/api/v1", middleware.RequestDeadline(), middleware.TrackSLOs(), middleware.InjectFaults(), middleware.MeterUsage(), middleware.AuditDenials(), middleware.Authorize(), middleware.LocalizeTimestamps(), middleware.AuditCapture())
 
 	// Auth routes (public)
 	auth := api.Group("/auth")
-	auth.Post("/register", handlers.Register)                    // POST /api/v1/auth/register - Register new user
-	auth.Post("/login", handlers.Login)                          // POST /api/v1/auth/login - Login user
-	auth.Post("/refresh", handlers.RefreshToken)                 // POST /api/v1/auth/refresh - Refresh access token
-	auth.Get("/check-phone", handlers.CheckPhoneAvailability)    // GET /api/v1/auth/check-phone - Check if phone number is available
+	auth.Post("/register", handlers.Register)                                     // POST /api/v1/auth/register - Register new user
+	auth.Post("/login", handlers.Login)                                           // POST /api/v1/auth/login - Login user
+	auth.Post("/refresh", handlers.RefreshToken)                                  // POST /api/v1/auth/refresh - Refresh access token
+	auth.Post("/login-otp/request", handlers.RequestLoginOTP)                     // POST /api/v1/auth/login-otp/request - Send a login code by SMS
+	auth.Post("/login-otp/confirm", handlers.ConfirmLoginOTP)                     // POST /api/v1/auth/login-otp/confirm - Log in with a login code
+	auth.Post("/verify-otp", handlers.VerifyRegistrationOTP)                      // POST /api/v1/auth/verify-otp - Confirm a registration with the code sent by SMS
+	auth.Post("/verify-otp/resend", handlers.ResendRegistrationOTP)               // POST /api/v1/auth/verify-otp/resend - Send a new registration code
+	auth.Post("/change-password", handlers.ChangePassword)                        // POST /api/v1/auth/change-password - Change password (also for expired passwords)
+	auth.Post("/forgot-password", handlers.ForgotPassword)                        // POST /api/v1/auth/forgot-password - Send a password reset code by SMS
+	auth.Post("/reset-password", handlers.ResetPassword)                          // POST /api/v1/auth/reset-password - Set a new password with the reset code
+	auth.Get("/check-phone", handlers.CheckPhoneAvailability)                     // GET /api/v1/auth/check-phone - Check if phone number is available
+	auth.Post("/logout", handlers.Logout)                                         // POST /api/v1/auth/logout - Log out this device
+	auth.Get("/sessions", handlers.GetMySessions)                                 // GET /api/v1/auth/sessions - List my device sessions
+	auth.Delete("/sessions", handlers.RevokeAllMySessions)                        // DELETE /api/v1/auth/sessions - Log out all devices
+	auth.Delete("/sessions/:id", handlers.RevokeMySession)                        // DELETE /api/v1/auth/sessions/:id - Log out one device
+	auth.Get("/legal", handlers.GetMyLegalStatus)                                 // GET /api/v1/auth/legal - Which terms/privacy versions I accepted
+	auth.Post("/legal/accept", handlers.AcceptLegalDocument)                      // POST /api/v1/auth/legal/accept - Accept the current terms or privacy policy
+	auth.Get("/notification-preferences", handlers.GetNotificationPreferences)    // GET /api/v1/auth/notification-preferences - Which optional messages I receive
+	auth.Put("/notification-preferences", handlers.UpdateNotificationPreferences) // PUT /api/v1/auth/notification-preferences - Turn the inactivity digest on or off
 
 	// User management routes (protected - requires Admin JWT authentication)
-	users := api.Group("/users", middleware.AdminJWTProtected())
-	users.Get("/", handlers.GetAllUsers)        // GET /api/v1/users - Get all users (admins only)
-	users.Post("/", handlers.CreateUser)        // POST /api/v1/users - Create new user with locations/gates (admins only)
-	users.Get("/:id", handlers.GetUserByID)     // GET /api/v1/users/:id - Get user by ID (admins only)
-	users.Patch("/:id", handlers.UpdateUser)    // PATCH /api/v1/users/:id - Update user password and locations/gates (admins only)
-	users.Delete("/:id", handlers.DeleteUser)   // DELETE /api/v1/users/:id - Delete user (admins only)
+	users := api.Group("/users")
+	users.Get("/", handlers.GetAllUsers)                              // GET /api/v1/users - Get all users (admins only)
+	users.Post("/", handlers.CreateUser)                              // POST /api/v1/users - Create new user with locations/gates (admins only)
+	users.Post("/bulk-delete", handlers.BulkDeleteUsers)              // POST /api/v1/users/bulk-delete - Move several users to the trash after confirmation (admins only)
+	users.Get("/duplicates", handlers.GetDuplicateUsers)              // GET /api/v1/users/duplicates - Detect likely duplicate users (admins only)
+	users.Get("/trash", handlers.GetTrashedUsers)                     // GET /api/v1/users/trash - List deleted users that can be restored (admins only)
+	users.Get("/:id", handlers.GetUserByID)                           // GET /api/v1/users/:id - Get user by ID (admins only)
+	users.Patch("/:id", handlers.UpdateUser)                          // PATCH /api/v1/users/:id - Update user password and locations/gates (admins only)
+	users.Delete("/:id", handlers.DeleteUser)                         // DELETE /api/v1/users/:id - Move user to the trash (admins only)
+	users.Post("/:id/restore", handlers.RestoreUser)                  // POST /api/v1/users/:id/restore - Restore user from the trash (admins only)
+	users.Post("/:id/retry-assignment", handlers.RetryUserAssignment) // POST /api/v1/users/:id/retry-assignment - Retry a failed location/gate assignment now (admins only)
+	users.Get("/:id/history", handlers.GetUserHistory)                // GET /api/v1/users/:id/history - Changes to the user with before/after values (admins only)
+	users.Get("/:id/sessions", handlers.GetUserSessions)              // GET /api/v1/users/:id/sessions - List the user's device sessions, incl. trusted ones (admins only)
+	users.Get("/:id/phones", handlers.GetUserPhones)                  // GET /api/v1/users/:id/phones - List primary and secondary numbers (admins only)
+	users.Post("/:id/phones", handlers.AddUserPhone)                  // POST /api/v1/users/:id/phones - Add a secondary number (admins only)
+	users.Delete("/:id/phones/:phoneId", handlers.DeleteUserPhone)    // DELETE /api/v1/users/:id/phones/:phoneId - Remove a secondary number (admins only)
+	users.Post("/:id/merge", handlers.MergeUsers)                     // POST /api/v1/users/:id/merge - Merge duplicate users into this user (super admin only)
 
 	// Admin authentication (public)
 	adminAuth := api.Group("/admin")
-	adminAuth.Post("/login", handlers.AdminLogin) // POST /api/v1/admin/login - Admin login
-
-	// Admin user management routes (Admin JWT protected, role-based access control in handlers)
-	adminUsers := api.Group("/admin/users", middleware.AdminJWTProtected())
-	adminUsers.Get("/", middleware.SuperAdminOnly(), handlers.GetAllAdmins)           // GET /api/v1/admin/users - Get all admin accounts (super admin only)
-	adminUsers.Post("/", middleware.SuperAdminOnly(), handlers.CreateAdmin)           // POST /api/v1/admin/users - Create new admin account (super admin only)
-	adminUsers.Get("/:id", handlers.GetAdminByID)                                      // GET /api/v1/admin/users/:id - Get admin by ID (super/regular with self-access)
-	adminUsers.Patch("/:id", handlers.UpdateAdmin)                                    // PATCH /api/v1/admin/users/:id - Update admin (super/regular with field-level access)
-	adminUsers.Delete("/:id", middleware.SuperAdminOnly(), handlers.DeleteAdmin)      // DELETE /api/v1/admin/users/:id - Delete admin (super admin only)
+	adminAuth.Post("/login", handlers.AdminLogin)                             // POST /api/v1/admin/login - Admin login
+	adminAuth.Post("/login/passkey/options", handlers.BeginAdminPasskeyLogin) // POST /api/v1/admin/login/passkey/options - Create a passkey login challenge
+	adminAuth.Post("/login/passkey", handlers.AdminPasskeyLogin)              // POST /api/v1/admin/login/passkey - Admin login with a passkey
+	adminAuth.Post("/refresh", handlers.AdminRefreshToken)                    // POST /api/v1/admin/refresh - Exchange an admin refresh token for a new access token
+
+	// Admin user management routes (Admin JWT protected, super admin or self-access per AccessPolicy)
+	adminUsers := api.Group("/admin/users")
+	adminUsers.Get("/", handlers.GetAllAdmins)                                       // GET /api/v1/admin/users - Get all admin accounts (super admin only)
+	adminUsers.Post("/", handlers.CreateAdmin)                                       // POST /api/v1/admin/users - Create new admin account (super admin only)
+	adminUsers.Post("/import", handlers.ImportAdmins)                                // POST /api/v1/admin/users/import - Create admin accounts from CSV with generated passwords (super admin only)
+	adminUsers.Get("/:id", handlers.GetAdminByID)                                    // GET /api/v1/admin/users/:id - Get admin by ID (super/regular with self-access)
+	adminUsers.Patch("/:id", handlers.UpdateAdmin)                                   // PATCH /api/v1/admin/users/:id - Update admin (super/regular with field-level access)
+	adminUsers.Delete("/:id", handlers.DeleteAdmin)                                  // DELETE /api/v1/admin/users/:id - Delete admin (super admin only)
+	adminUsers.Get("/:id/history", handlers.GetAdminHistory)                         // GET /api/v1/admin/users/:id/history - Changes to the admin account with who/when/what (super admin only)
+	adminUsers.Get("/:id/passkeys", handlers.GetAdminPasskeys)                       // GET /api/v1/admin/users/:id/passkeys - List the admin's passkeys (super/regular with self-access)
+	adminUsers.Post("/:id/passkeys/options", handlers.BeginAdminPasskeyRegistration) // POST /api/v1/admin/users/:id/passkeys/options - Create a passkey registration challenge (self only)
+	adminUsers.Post("/:id/passkeys", handlers.RegisterAdminPasskey)                  // POST /api/v1/admin/users/:id/passkeys - Register a passkey (self only)
+	adminUsers.Patch("/:id/passkeys/:credentialId", handlers.RenameAdminPasskey)     // PATCH /api/v1/admin/users/:id/passkeys/:credentialId - Rename a passkey (super/regular with self-access)
+	adminUsers.Delete("/:id/passkeys/:credentialId", handlers.DeleteAdminPasskey)    // DELETE /api/v1/admin/users/:id/passkeys/:credentialId - Remove a passkey (super/regular with self-access)
+
+	// User impersonation for troubleshooting (super admin only, audited)
+	api.Post("/admin/impersonate/:userId", handlers.ImpersonateUser) // POST /api/v1/admin/impersonate/:userId - Issue a short-lived impersonation token for a user
 
 	// Gate management routes (User JWT protected - users only, not admins)
-	api.Get("/locations", middleware.JWTProtected(), handlers.GetLocations)                           // GET /api/v1/locations - Get all locations accessible to user
-	api.Get("/locations/:locationId/gates", middleware.JWTProtected(), handlers.GetGatesByLocation)  // GET /api/v1/locations/:locationId/gates - Get gates for location accessible to user
-	api.Put("/locations/:gateId/open", middleware.JWTProtected(), handlers.OpenGate)                 // PUT /api/v1/locations/:gateId/open - Open a gate
-	api.Put("/locations/:gateId/close", middleware.JWTProtected(), handlers.CloseGate)               // PUT /api/v1/locations/:gateId/close - Close a gate
+	api.Get("/locations", handlers.GetLocations)                         // GET /api/v1/locations - Get all locations accessible to user
+	api.Get("/locations/:locationId/gates", handlers.GetGatesByLocation) // GET /api/v1/locations/:locationId/gates - Get gates for location accessible to user
+	api.Put("/locations/:gateId/open", handlers.OpenGate)                // PUT /api/v1/locations/:gateId/open - Open a gate
+	api.Put("/locations/:gateId/close", handlers.CloseGate)              // PUT /api/v1/locations/:gateId/close - Close a gate
+	api.Post("/locations/:gateId/links", handlers.CreateGateLink)        // POST /api/v1/locations/:gateId/links - Create a signed shareable link to a gate
+
+	// Gate problem reports (User JWT protected)
+	api.Post("/gates/:gateId/report", handlers.ReportGateProblem) // POST /api/v1/gates/:gateId/report - Report a stuck or damaged barrier, optionally with a photo
+
+	// Shared gate link routes (public, signature checked)
+	api.Get("/links/:id", handlers.ResolveGateLink) // GET /api/v1/links/:id - Resolve a shared gate link's metadata
+
+	// Gate command status routes
+	api.Get("/gate-commands/:id", handlers.GetGateCommand)                // GET /api/v1/gate-commands/:id - Get status of a gate command issued by the user
+	api.Post("/gate-commands/:id/callback", handlers.GateCommandCallback) // POST /api/v1/gate-commands/:id/callback - Provider status callback (X-Provider-Token)
+
+	// Admin notification center routes (Admin JWT protected)
+	adminNotifications := api.Group("/admin/notifications")
+	adminNotifications.Get("/", handlers.GetAdminNotifications)                       // GET /api/v1/admin/notifications - List notifications with unread count
+	adminNotifications.Patch("/read-all", handlers.MarkAllAdminNotificationsRead)     // PATCH /api/v1/admin/notifications/read-all - Mark all notifications as read
+	adminNotifications.Patch("/:id/read", handlers.MarkAdminNotificationRead)         // PATCH /api/v1/admin/notifications/:id/read - Mark a notification as read
+	adminNotifications.Get("/:id", handlers.GetAdminNotificationByID)                 // GET /api/v1/admin/notifications/:id - Get a notification with its comment thread
+	adminNotifications.Post("/:id/comments", handlers.CreateAdminNotificationComment) // POST /api/v1/admin/notifications/:id/comments - Comment on a notification or add a resolution note
+
+	// Admin audit log routes (Admin JWT protected, super admin only)
+	adminAudit := api.Group("/admin/audit-logs")
+	adminAudit.Get("/", handlers.GetAdminAuditLogs)                  // GET /api/v1/admin/audit-logs - List audit log entries
+	adminAudit.Get("/:id", handlers.GetAdminAuditLogByID)            // GET /api/v1/admin/audit-logs/:id - Get an audit log entry with its comment thread
+	adminAudit.Post("/:id/comments", handlers.CreateAuditLogComment) // POST /api/v1/admin/audit-logs/:id/comments - Comment on an audit log entry or add a resolution note
+
+	// Requests refused with 403, for detecting privilege probing (Admin JWT protected, super admin only)
+	api.Get("/admin/security/denials", handlers.GetSecurityDenials) // GET /api/v1/admin/security/denials - List permission denials
+
+	// Live admin dashboard feed (WebSocket, Admin JWT via header or ?token=)
+	api.Get("/admin/feed", handlers.AdminFeedUpgrade, handlers.AdminFeed) // GET /api/v1/admin/feed - Live dashboard WebSocket feed
+
+	// Scheduled job status route (Admin JWT protected, super admin only)
+	api.Get("/admin/jobs", handlers.GetScheduledJobs)     // GET /api/v1/admin/jobs - List background jobs and their last run
+	api.Get("/admin/digest/runs", handlers.GetDigestRuns) // GET /api/v1/admin/digest/runs - Inactivity digest runs with sent, opted-out and failed counts
+
+	// Usage metering routes (Admin JWT protected, super admin only)
+	api.Get("/admin/usage", handlers.GetUsageRollup)            // GET /api/v1/admin/usage - Monthly usage per organization for billing
+	api.Get("/admin/provider-usage", handlers.GetProviderUsage) // GET /api/v1/admin/provider-usage - Third-party API calls against the monthly quota
+
+	// Service level objectives (Admin JWT protected, super admin only)
+	api.Get("/admin/slo", handlers.GetSLOSummary) // GET /api/v1/admin/slo - SLO compliance and error budgets
+
+	// Operational reports (Admin JWT protected, super admin only)
+	api.Get("/admin/reports", handlers.GetAdminReport) // GET /api/v1/admin/reports - Canned daily reports as JSON or CSV
+
+	// Provider migration comparison (Admin JWT protected, super admin only)
+	api.Get("/admin/provider-migration/report", handlers.GetProviderMigrationReport) // GET /api/v1/admin/provider-migration/report - Compare the current and the migration provider
+
+	// Gate operation history export for billing reconciliation (Admin JWT protected, super admin only)
+	api.Get("/admin/gate-events", handlers.GetGateEvents)                                                                    // GET /api/v1/admin/gate-events - List users' gate open/close attempts
+	api.Get("/admin/gate-events/export", middleware.Quota("exports", services.ExportQuotaLimits), handlers.ExportGateEvents) // GET /api/v1/admin/gate-events/export - Filtered gate commands as CSV
+
+	// Background exports with signed download URLs (Admin JWT protected, super admin only)
+	api.Post("/admin/exports", middleware.Quota("exports", services.ExportQuotaLimits), handlers.CreateExport) // POST /api/v1/admin/exports - Queue a gate event or report export
+	api.Get("/admin/exports/:id", handlers.GetExport)                                                          // GET /api/v1/admin/exports/:id - Export status and download URL
+
+	// Sign every user out after a security incident (Admin JWT protected, super admin only)
+	api.Post("/admin/forced-logouts", handlers.CreateForcedLogout) // POST /api/v1/admin/forced-logouts - Bump every user's token version in the background
+	api.Get("/admin/forced-logouts/:id", handlers.GetForcedLogout) // GET /api/v1/admin/forced-logouts/:id - Forced logout progress
+
+	// Stale device retention (Admin JWT protected, super admin only)
+	api.Get("/admin/stale-devices", handlers.GetStaleDevices) // GET /api/v1/admin/stale-devices - Dry-run report of sessions the stale device purge deletes
+
+	// Route listing for security reviews (Admin JWT protected, super admin only)
+	api.Get("/admin/routes", handlers.GetRegisteredRoutes) // GET /api/v1/admin/routes - List endpoints with their required access
+
+	// Runtime config reload (Admin JWT protected, super admin only)
+	api.Post("/admin/config/reload", handlers.ReloadConfig) // POST /api/v1/admin/config/reload - Apply reloadable settings without a restart
+
+	// CORS origin management (Admin JWT protected, super admin only)
+	api.Get("/admin/cors-origins", handlers.GetCORSOrigins)          // GET /api/v1/admin/cors-origins - List database-managed CORS origins
+	api.Post("/admin/cors-origins", handlers.CreateCORSOrigin)       // POST /api/v1/admin/cors-origins - Allow a public or admin origin
+	api.Delete("/admin/cors-origins/:id", handlers.DeleteCORSOrigin) // DELETE /api/v1/admin/cors-origins/:id - Remove an allowed origin
+
+	// Invite code management (Admin JWT protected)
+	api.Get("/admin/invite-codes", handlers.GetInviteCodes)          // GET /api/v1/admin/invite-codes - List invite codes for self-registration
+	api.Post("/admin/invite-codes", handlers.CreateInviteCode)       // POST /api/v1/admin/invite-codes - Generate an invite code with locations/gates
+	api.Delete("/admin/invite-codes/:id", handlers.RevokeInviteCode) // DELETE /api/v1/admin/invite-codes/:id - Revoke an invite code
+
+	// Machine API keys (super admin only)
+	api.Get("/admin/api-keys", handlers.GetAPIKeys)          // GET /api/v1/admin/api-keys - List API keys
+	api.Post("/admin/api-keys", handlers.CreateAPIKey)       // POST /api/v1/admin/api-keys - Create an API key, shown once
+	api.Delete("/admin/api-keys/:id", handlers.RevokeAPIKey) // DELETE /api/v1/admin/api-keys/:id - Revoke an API key
+
+	// Webhook signing secrets (super admin only)
+	api.Get("/admin/webhook-secrets", handlers.GetWebhookSecrets)                  // GET /api/v1/admin/webhook-secrets - List webhook secrets in use
+	api.Post("/admin/webhook-secrets/:scope/rotate", handlers.RotateWebhookSecret) // POST /api/v1/admin/webhook-secrets/:scope/rotate - Rotate a webhook secret, shown once
+
+	// Sandbox mode (super admin only)
+	api.Get("/admin/sandbox/sms", handlers.GetSandboxSMS) // GET /api/v1/admin/sandbox/sms - Text messages captured in sandbox mode
+
+	// SCIM 2.0 user provisioning (API key with the "scim" scope)
+	api.Get("/scim/v2/Users", handlers.ListSCIMUsers)         // GET /api/v1/scim/v2/Users - List users, with filters
+	api.Post("/scim/v2/Users", handlers.CreateSCIMUser)       // POST /api/v1/scim/v2/Users - Provision a user
+	api.Get("/scim/v2/Users/:id", handlers.GetSCIMUser)       // GET /api/v1/scim/v2/Users/:id - Get a user
+	api.Put("/scim/v2/Users/:id", handlers.ReplaceSCIMUser)   // PUT /api/v1/scim/v2/Users/:id - Replace a user
+	api.Patch("/scim/v2/Users/:id", handlers.PatchSCIMUser)   // PATCH /api/v1/scim/v2/Users/:id - Update a user (PatchOp)
+	api.Delete("/scim/v2/Users/:id", handlers.DeleteSCIMUser) // DELETE /api/v1/scim/v2/Users/:id - Deprovision a user
+
+	// Change feed for mirroring users and assignments (API key with the "sync" scope)
+	api.Get("/sync/changes", handlers.GetSyncChanges) // GET /api/v1/sync/changes?since=<token> - Changes after a token
+
+	// Registration review queue (Admin JWT protected)
+	api.Get("/admin/registrations", handlers.GetRegistrations)                 // GET /api/v1/admin/registrations - List registrations awaiting approval
+	api.Post("/admin/registrations/:id/approve", handlers.ApproveRegistration) // POST /api/v1/admin/registrations/:id/approve - Approve, assign locations/gates and send a welcome SMS
+	api.Post("/admin/registrations/:id/reject", handlers.RejectRegistration)   // POST /api/v1/admin/registrations/:id/reject - Reject with a reason
+
+	// Location freezes and emergency overrides (Admin JWT protected)
+	api.Get("/admin/locations/freezes", handlers.GetLocationFreezes)       // GET /api/v1/admin/locations/freezes - List current and scheduled freezes
+	api.Post("/admin/locations/:id/freeze", handlers.FreezeLocation)       // POST /api/v1/admin/locations/:id/freeze - Block user opens at a location, optionally until an end time
+	api.Delete("/admin/locations/:id/freeze", handlers.LiftLocationFreeze) // DELETE /api/v1/admin/locations/:id/freeze - Lift the location's freezes
+
+	// Location branding overrides (Admin JWT protected)
+	api.Get("/admin/locations/overrides", handlers.GetLocationOverrides)         // GET /api/v1/admin/locations/overrides - List display name, logo and order overrides
+	api.Put("/admin/locations/:id/override", handlers.SetLocationOverride)       // PUT /api/v1/admin/locations/:id/override - Override a location's display name, logo or order
+	api.Delete("/admin/locations/:id/override", handlers.DeleteLocationOverride) // DELETE /api/v1/admin/locations/:id/override - Restore the provider's branding
+	api.Put("/admin/gates/:gateId/open", handlers.EmergencyOpenGate)             // PUT /api/v1/admin/gates/:gateId/open - Emergency open that ignores freezes
+
+	// Gate maintenance (Admin JWT protected)
+	api.Get("/admin/gates/maintenance", handlers.GetGateMaintenances)           // GET /api/v1/admin/gates/maintenance - List gates under maintenance
+	api.Put("/admin/gates/:gateId/maintenance", handlers.SetGateMaintenance)    // PUT /api/v1/admin/gates/:gateId/maintenance - Refuse user opens and closes of a gate with a note
+	api.Delete("/admin/gates/:gateId/maintenance", handlers.EndGateMaintenance) // DELETE /api/v1/admin/gates/:gateId/maintenance - End a gate's maintenance
+
+	// Gate problem report queue (Admin JWT protected)
+	api.Get("/admin/gate-reports", handlers.GetGateReports)                 // GET /api/v1/admin/gate-reports - List reported gate problems
+	api.Get("/admin/gate-reports/:id/photo", handlers.GetGateReportPhoto)   // GET /api/v1/admin/gate-reports/:id/photo - Download the photo attached to a report
+	api.Post("/admin/gate-reports/:id/resolve", handlers.ResolveGateReport) // POST /api/v1/admin/gate-reports/:id/resolve - Mark a reported problem as fixed
+
+	// Legal documents (reading is public, publishing is super admin only)
+	api.Get("/legal", handlers.GetLegalDocuments)           // GET /api/v1/legal - Current terms of service and privacy policy
+	api.Get("/legal/:kind", handlers.GetLegalDocument)      // GET /api/v1/legal/:kind - One document, current or ?version=
+	api.Post("/admin/legal", handlers.PublishLegalDocument) // POST /api/v1/admin/legal - Publish a new document version
+
+	// Stored files behind signed URLs (public - the URL signature authorizes the download)
+	api.Get("/files/*", handlers.ServeSignedFile) // GET /api/v1/files/* - Download a file from local storage
+
+	// Omnibox search across users, admins, locations/gates and audit logs (Admin JWT protected)
+	api.Get("/admin/search", handlers.GetAdminSearch) // GET /api/v1/admin/search - Typed, ranked search results for the admin panel
 
 	// Available locations route (Admin JWT protected - for admin panel to view all available locations)
-	api.Get("/available-locations", middleware.AdminJWTProtected(), handlers.GetAvailableLocations)  // GET /api/v1/available-locations - Get all locations in system (admin only)
+	api.Get("/available-locations", handlers.GetAvailableLocations) // GET /api/v1/available-locations - Get all locations in system (admin only)
 
 	// Contact information routes
-	api.Get("/contacts", handlers.GetContact)                                  // GET /api/v1/contacts - Get contact information (public)
-	api.Patch("/contacts", middleware.AdminJWTProtected(), handlers.UpdateContact) // PATCH /api/v1/contacts - Update contact information (admin only)
+	api.Get("/contacts", handlers.GetContact)      // GET /api/v1/contacts - Get contact information (public)
+	api.Patch("/contacts", handlers.UpdateContact) // PATCH /api/v1/contacts - Update contact information (admin only)
+}
+
+// ping godoc
+// @Summary Load balancer ping
+// @Description Answer 204 with no body as cheaply as possible, for load balancer and orchestrator probes. Requests skip logging and all middleware
+// @Tags Health
+// @Success 204 "Server is up"
+// @Router /ping [get]
+func ping(c *fiber.Ctx) error {
+	c.Status(fiber.StatusNoContent)
+	return nil
 }
 
 // healthCheck godoc
 // @Summary Health check endpoint
-// @Description Check if the API server is running and retrieve detailed health information including status, timestamp, uptime, and environment
+// @Description Check if the API server is running and retrieve detailed health information including status, timestamp, uptime, and environment. When HEALTH_TOKEN is set, the details are only returned with it as a Bearer token; other requests get the status alone
 // @Tags Health
 // @Produce json
+// @Param Authorization header string false "Bearer HEALTH_TOKEN, when it is set"
 // @Success 200 {object} handlers.HealthCheckResponse "Health check successful"
 // @Router / [get]
 func healthCheck(c *fiber.Ctx) error {
+	if token := config.AppConfig().Server.HealthToken; token != "" {
+		given := strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			return c.JSON(handlers.HealthCheckResponse{
+				Success: true,
+				Message: "Ololo Gate API is running",
+				Status:  "healthy",
+			})
+		}
+	}
+
 	// Calculate uptime
 	uptime := time.Since(serverStartTime)
 
@@ -173,7 +454,7 @@ func healthCheck(c *fiber.Ctx) error {
 		Status:      "healthy",
 		Timestamp:   currentTime.Format(time.RFC3339),
 		Uptime:      uptimeStr,
-		Environment: config.AppConfig.Server.Env,
+		Environment: config.AppConfig().Server.Env,
 		Version:     "1.0.0",
 	})
 }
@@ -195,3 +476,18 @@ func formatDuration(d time.Duration) string {
 		return fmt.Sprintf("%ds", secs)
 	}
 }
+
+// watchConfigReload reloads the hot-reloadable settings whenever the process receives SIGHUP.
+// Gate command workers and open connections are not affected.
+func watchConfigReload() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	go func() {
+		for range signals {
+			slog.Info("[CONFIG_RELOAD] Received SIGHUP")
+			if _, err := config.Reload(); err != nil {
+				slog.Error("[CONFIG_RELOAD] Reload failed, keeping current configuration", "error", err)
+			}
+		}
+	}()
+}
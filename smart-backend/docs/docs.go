@@ -25,7 +25,7 @@ const docTemplate = `{
     "paths": {
         "/": {
             "get": {
-                "description": "Check if the API server is running and retrieve detailed health information including status, timestamp, uptime, and environment",
+                "description": "Check if the API server is running and retrieve detailed health information including status, timestamp, uptime, and environment. When HEALTH_TOKEN is set, the details are only returned with it as a Bearer token; other requests get the status alone",
                 "produces": [
                     "application/json"
                 ],
@@ -33,6 +33,14 @@ const docTemplate = `{
                     "Health"
                 ],
                 "summary": "Health check endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer HEALTH_TOKEN, when it is set",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "Health check successful",
@@ -43,14 +51,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/admin/audit-logs": {
+        "/api/v1/admin/api-keys": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve audit logs of admin actions (super admin only). Returns paginated list of all administrative operations.",
+                "description": "Retrieve the machine API keys, newest first. Keys themselves are never shown again after creation, only their prefix (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -58,48 +66,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin Audit Logs"
-                ],
-                "summary": "Get admin audit logs",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by admin ID",
-                        "name": "admin_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by action type",
-                        "name": "action",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by resource type",
-                        "name": "resource_type",
-                        "in": "query"
-                    }
+                    "Admin API Keys"
                 ],
+                "summary": "List API keys",
                 "responses": {
                     "200": {
-                        "description": "Audit logs retrieved successfully",
+                        "description": "API keys retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.PaginatedAuditLogResponse"
+                            "$ref": "#/definitions/handlers.APIKeysResponse"
                         }
                     },
                     "401": {
@@ -121,16 +95,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/admin/audit-logs/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a specific audit log entry by ID (super admin only)",
+                "description": "Create a key for a machine client, e.g. an identity provider provisioning users over SCIM (scope \"scim\") or a system mirroring users through the change feed (scope \"sync\"). The key is sent as \"Authorization: Bearer \u003ckey\u003e\" and is only returned in this response (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -138,39 +110,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin Audit Logs"
+                    "Admin API Keys"
                 ],
-                "summary": "Get audit log by ID",
+                "summary": "Create an API key",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Audit log ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Key name and scopes",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateAPIKeyRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Audit log retrieved successfully",
+                    "201": {
+                        "description": "API key created successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.AuditLogDetailResponse"
+                            "$ref": "#/definitions/handlers.CreatedAPIKeyResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized - invalid or missing admin token",
+                    "400": {
+                        "description": "Invalid request body or unknown scope",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - super admin access required",
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "Audit log not found",
+                    "403": {
+                        "description": "Forbidden - super admin access required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -184,9 +158,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/admin/login": {
-            "post": {
-                "description": "Authenticate admin with username and password, returns permanent access token (no expiry)",
+        "/api/v1/admin/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop an API key from authenticating. Revoking is immediate and cannot be undone (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -194,35 +173,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin Authentication"
+                    "Admin API Keys"
                 ],
-                "summary": "Admin login",
+                "summary": "Revoke an API key",
                 "parameters": [
                     {
-                        "description": "Admin credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.AdminLoginRequest"
-                        }
+                        "type": "string",
+                        "description": "API key ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful with permanent token",
+                        "description": "API key revoked successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.AdminLoginResponse"
+                            "$ref": "#/definitions/handlers.APIKeyResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or missing credentials",
+                        "description": "Invalid API key ID format",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
-                        "description": "Invalid credentials",
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "API key not found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -236,14 +225,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/admin/users": {
+        "/api/v1/admin/audit-logs": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a list of all admin accounts with pagination, search, filtering, and ordering (super admin only)",
+                "description": "Retrieve audit logs of admin actions (super admin only). Returns paginated list of all administrative operations.",
                 "consumes": [
                     "application/json"
                 ],
@@ -251,50 +240,64 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin User Management"
+                    "Admin Audit Logs"
                 ],
-                "summary": "Get all admin users",
+                "summary": "Get admin audit logs",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page number (default: 1)",
+                        "default": 1,
+                        "description": "Page number",
                         "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Records per page (default: 500)",
+                        "default": 20,
+                        "description": "Items per page",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Search by username",
-                        "name": "search",
+                        "description": "Filter by admin ID",
+                        "name": "admin_id",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by role (super or regular)",
-                        "name": "role",
+                        "description": "Filter by action type",
+                        "name": "action",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Order results by created_at (ASC or DESC, default: DESC)",
-                        "name": "order",
+                        "description": "Filter by resource type",
+                        "name": "resource_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "exact (default) or estimate: approximate total from table statistics, for large tables",
+                        "name": "count",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Admin users retrieved successfully",
+                        "description": "Audit logs retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.AdminsListResponse"
+                            "$ref": "#/definitions/handlers.PaginatedAuditLogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid count mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -312,14 +315,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/admin/audit-logs/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new admin account with specified role (super admin only)",
+                "description": "Retrieve a specific audit log entry by ID with its comment thread (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -327,35 +332,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin User Management"
+                    "Admin Audit Logs"
                 ],
-                "summary": "Create a new admin user",
+                "summary": "Get audit log by ID",
                 "parameters": [
                     {
-                        "description": "Admin creation details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateAdminRequest"
-                        }
+                        "type": "string",
+                        "description": "Audit log ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Admin user created successfully",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.AdminResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request body or validation error",
+                    "200": {
+                        "description": "Audit log retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
+                            "$ref": "#/definitions/handlers.AuditLogDetailResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -366,8 +363,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "409": {
-                        "description": "Admin with this username already exists",
+                    "404": {
+                        "description": "Audit log not found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -381,14 +378,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/admin/users/{id}": {
-            "get": {
+        "/api/v1/admin/audit-logs/{id}/comments": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a specific admin's details by ID. Super admins can retrieve any admin. Regular admins can only retrieve their own details.",
+                "description": "Add a comment or resolution note to an audit log entry to record the context of an investigation. Comments cannot be edited; the thread is returned by GET /admin/audit-logs/:id (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -396,45 +393,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin User Management"
+                    "Admin Audit Logs"
                 ],
-                "summary": "Get admin by ID",
+                "summary": "Comment on an audit log entry",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Admin ID (UUID)",
+                        "description": "Audit log ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateAuditCommentRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Admin retrieved successfully",
+                    "201": {
+                        "description": "Comment added",
                         "schema": {
-                            "$ref": "#/definitions/handlers.AdminDetailResponse"
+                            "$ref": "#/definitions/handlers.AuditCommentResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid admin ID format",
+                        "description": "Invalid audit log ID, missing or too long comment",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "403": {
-                        "description": "Forbidden - regular admins can only access their own record",
+                        "description": "Forbidden - super admin access required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "Admin not found",
+                        "description": "Audit log not found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -446,14 +452,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/admin/config/reload": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an admin account by ID (soft delete, super admin only)",
+                "description": "Re-read .env, CONFIG_FILE and the environment and apply the hot-reloadable settings (CORS origins, provider rate limits and timeouts, admin quotas, feature flags) without restarting the server. Equivalent to sending SIGHUP (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -461,33 +469,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin User Management"
-                ],
-                "summary": "Delete an admin user",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Admin ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Admin Config"
                 ],
+                "summary": "Reload runtime configuration",
                 "responses": {
                     "200": {
-                        "description": "Admin user deleted successfully",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.AdminResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid admin ID format",
+                        "description": "Configuration reloaded successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
+                            "$ref": "#/definitions/handlers.ConfigReloadResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -498,27 +491,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "404": {
-                        "description": "Admin not found",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                    "422": {
+                        "description": "New configuration is invalid, current configuration kept",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/api/v1/admin/cors-origins": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an admin's details (password, username, and/or role). Super admins can update any admin. Regular admins can only update their own password and username (not role).",
+                "description": "Retrieve the browser origins added by super admins, in addition to CORS_ALLOWED_ORIGINS and CORS_ADMIN_ALLOWED_ORIGINS (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -526,54 +515,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin User Management"
+                    "Admin CORS"
                 ],
-                "summary": "Update admin details",
+                "summary": "List CORS origins",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Admin ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update details (at least one field required)",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateAdminRequest"
-                        }
+                        "description": "Filter by scope (public, admin)",
+                        "name": "scope",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Admin updated successfully",
+                        "description": "CORS origins retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.AdminResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid admin ID or request body",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
+                            "$ref": "#/definitions/handlers.CORSOriginsResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "403": {
-                        "description": "Forbidden - insufficient permissions for this operation",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Admin not found",
+                        "description": "Forbidden - super admin access required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -585,49 +552,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/auth/check-phone": {
-            "get": {
-                "description": "Check if a phone number is available for registration or account creation (public endpoint, no authentication required)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User Authentication"
-                ],
-                "summary": "Check if phone number is available for registration",
-                "parameters": [
+            },
+            "post": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Phone number in E.164 format (e.g., +77771234567)",
-                        "name": "phone",
-                        "in": "query",
-                        "required": true
+                        "BearerAuth": []
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "Phone availability check result",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.PhoneAvailabilityResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid phone number format",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/auth/login": {
-            "post": {
-                "description": "Authenticate user with phone and password, returns access and refresh tokens. Supports device-based token invalidation.",
+                "description": "Allow a browser origin to call public endpoints (scope \"public\") or /api/v1/admin/* endpoints (scope \"admin\"). Admin origins must be explicit, wildcards are rejected (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -635,41 +567,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Authentication"
+                    "Admin CORS"
                 ],
-                "summary": "User login",
+                "summary": "Allow a CORS origin",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Unique device identifier (optional - if provided and different from current device, previous tokens will be invalidated)",
-                        "name": "device_id",
-                        "in": "query"
-                    },
-                    {
-                        "description": "Login credentials",
+                        "description": "Origin and scope",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.LoginRequest"
+                            "$ref": "#/definitions/handlers.CreateCORSOriginRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Login successful with tokens",
+                    "201": {
+                        "description": "CORS origin added successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.LoginResponse"
+                            "$ref": "#/definitions/handlers.CORSOriginResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or phone format",
+                        "description": "Invalid origin or scope",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
-                        "description": "Invalid credentials",
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Origin already allowed for this scope",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -683,9 +621,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/refresh": {
-            "post": {
-                "description": "Exchange a valid refresh token for a new access token",
+        "/api/v1/admin/cors-origins/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop allowing a browser origin added by a super admin (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -693,41 +636,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Authentication"
+                    "Admin CORS"
                 ],
-                "summary": "Refresh access token",
+                "summary": "Remove a CORS origin",
                 "parameters": [
                     {
-                        "description": "Refresh token",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.RefreshRequest"
-                        }
+                        "type": "string",
+                        "description": "CORS origin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "New access token generated",
+                        "description": "CORS origin removed successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.RefreshResponse"
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid CORS origin ID format",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
-                        "description": "Invalid or expired refresh token, or token has been invalidated",
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "CORS origin not found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -741,45 +688,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/register": {
-            "post": {
-                "description": "Register a new user account with phone number and password (E.164 format required)",
-                "consumes": [
-                    "application/json"
+        "/api/v1/admin/digest/runs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Retrieve the most recent runs of the weekly inactivity digest, newest first, with how many inactive users were found, opted out, were sent the digest or failed (requires admin authentication)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User Authentication"
-                ],
-                "summary": "Register a new user",
-                "parameters": [
-                    {
-                        "description": "Registration details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.RegisterRequest"
-                        }
-                    }
+                    "Admin Jobs"
                 ],
+                "summary": "List inactivity digest runs",
                 "responses": {
-                    "201": {
-                        "description": "User registered successfully",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.RegisterResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request body or validation error",
+                    "200": {
+                        "description": "Digest runs retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
+                            "$ref": "#/definitions/handlers.DigestRunsResponse"
                         }
                     },
-                    "409": {
-                        "description": "User with this phone number already exists",
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -793,14 +725,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/available-locations": {
-            "get": {
+        "/api/v1/admin/exports": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Fetch all locations from third-party API without filtering by user (admin access only)",
+                "description": "Queue a large export instead of downloading it in the request: gate_events (the CSV of GET /admin/gate-events/export, with the same filters) or report (a report of GET /admin/reports as CSV or JSON). A background job writes the file to file storage. Poll GET /admin/exports/:id until status is ready and download it from download_url. Exports are deleted EXPORT_RETENTION after they finish (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -808,55 +740,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Location Management"
+                    "Admin Reports"
+                ],
+                "summary": "Request a background export",
+                "parameters": [
+                    {
+                        "description": "Export type and filters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateExportRequest"
+                        }
+                    }
                 ],
-                "summary": "Get all available locations in the system",
                 "responses": {
-                    "200": {
-                        "description": "Available locations retrieved successfully",
+                    "202": {
+                        "description": "Export queued",
                         "schema": {
-                            "$ref": "#/definitions/handlers.AvailableLocationsResponse"
+                            "$ref": "#/definitions/handlers.ExportResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                    "400": {
+                        "description": "Unknown type or report, invalid filter or format",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - requires admin access",
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden - super admin access required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/api/v1/contacts": {
-            "get": {
-                "description": "Retrieve the application's contact information (public endpoint, no authentication required). Returns empty values if contact information has not been set.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Contact Information"
-                ],
-                "summary": "Get contact information",
-                "responses": {
-                    "200": {
-                        "description": "Contact information retrieved successfully",
+                    },
+                    "429": {
+                        "description": "Daily export quota (EXPORT_QUOTA_DAILY) exceeded",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ContactResponse"
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "500": {
@@ -866,14 +792,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/api/v1/admin/exports/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update or create the application's contact information (admin only). Creates a new contact record if one doesn't exist.",
+                "description": "Retrieve the status of a background export. Once status is ready, download_url is a short-lived signed link to the file, valid until download_url_expires_at; call again for a fresh one (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -881,29 +809,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Contact Information"
+                    "Admin Reports"
                 ],
-                "summary": "Update contact information",
+                "summary": "Get export status",
                 "parameters": [
                     {
-                        "description": "Contact information to update",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateContactRequest"
-                        }
+                        "type": "string",
+                        "description": "Export ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Contact information updated or created successfully",
+                        "description": "Export retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.ContactResponse"
+                            "$ref": "#/definitions/handlers.ExportResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or validation error",
+                        "description": "Invalid export ID",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -915,13 +841,19 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - admin access required",
+                        "description": "Forbidden - super admin access required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Export not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -929,39 +861,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/locations": {
+        "/api/v1/admin/feed": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Fetch all locations from third-party API based on user's phone with their gates",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "WebSocket stream of new audit entries, gate open/close events and admin notifications as JSON messages ({id, type, data, occurred_at}). Audit entries are only streamed to super admins. Browser clients may pass the admin token as the ` + "`" + `token` + "`" + ` query parameter. The connection is closed when the token expires or is invalidated (logout elsewhere, role change, deleted admin).",
                 "tags": [
-                    "Gate Management"
+                    "Admin Notifications"
+                ],
+                "summary": "Live admin dashboard feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin access token (alternative to the Authorization header)",
+                        "name": "token",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all locations accessible to the current user",
                 "responses": {
-                    "200": {
-                        "description": "Locations retrieved successfully",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.LocationsListResponse"
-                        }
+                    "101": {
+                        "description": "Switching protocols"
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "426": {
+                        "description": "WebSocket upgrade required",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -969,14 +900,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/locations/{gateId}/close": {
-            "put": {
+        "/api/v1/admin/forced-logouts": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Send command to close a specific gate to third-party API",
+                "description": "Invalidate the tokens and sessions of every user, e.g. after a security incident. A background job bumps the token version of all users in batches; poll GET /admin/forced-logouts/:id for progress. The reason is stored in the audit log and every admin is notified. Admin accounts are not affected (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -984,33 +915,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Gate Management"
+                    "Admin User Management"
                 ],
-                "summary": "Close a gate",
+                "summary": "Sign every user out",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Gate ID",
-                        "name": "gateId",
-                        "in": "path",
-                        "required": true
+                        "description": "Why everyone is signed out",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateForcedLogoutRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Gate operation response",
+                    "202": {
+                        "description": "Forced logout queued",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GateActionResponse"
+                            "$ref": "#/definitions/handlers.ForcedLogoutResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid gate ID",
+                        "description": "Missing reason",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A forced logout is already in progress",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1024,14 +969,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/locations/{gateId}/open": {
-            "put": {
+        "/api/v1/admin/forced-logouts/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Send command to open a specific gate to third-party API",
+                "description": "Retrieve the status of a forced logout and how many users it has signed out so far (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1039,33 +984,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Gate Management"
+                    "Admin User Management"
                 ],
-                "summary": "Open a gate",
+                "summary": "Get forced logout progress",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Gate ID",
-                        "name": "gateId",
+                        "type": "string",
+                        "description": "Forced logout ID (UUID)",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Gate operation response",
+                        "description": "Forced logout retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GateActionResponse"
+                            "$ref": "#/definitions/handlers.ForcedLogoutResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid gate ID",
+                        "description": "Invalid forced logout ID",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Forced logout not found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1079,14 +1036,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/locations/{locationId}/gates": {
+        "/api/v1/admin/gate-events": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Fetch all gates accessible to the current user for a specific location from third-party API",
+                "description": "Retrieve users' gate open and close attempts, newest first, whatever their result: sent to the provider, queued while it was unavailable, refused (no access, impersonation, maintenance, freeze or a conflicting command) or failed. Each has the user, gate, location (when the gate is in the user's gate list), response status and message, command, latency and IP address. Filter by a range of days in the request's timezone (X-Timezone, the admin's timezone or DEFAULT_TIMEZONE; at most 366, defaults to the last 30), user, gate, location and result. Attempts are kept for GATE_COMMAND_RETENTION",
                 "consumes": [
                     "application/json"
                 ],
@@ -1094,33 +1051,76 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Gate Management"
+                    "Admin Reports"
                 ],
-                "summary": "Get all gates for a specific location",
+                "summary": "List gate open/close attempts",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Location ID",
-                        "name": "locationId",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day, YYYY-MM-DD (defaults to 29 days before to)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day, inclusive, YYYY-MM-DD (defaults to today)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only attempts by this user (UUID)",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only this gate",
+                        "name": "gate_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only gates of this location",
+                        "name": "location_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only this result (sent, queued, refused, failed)",
+                        "name": "result",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Gates retrieved successfully",
+                        "description": "Gate events retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GatesListResponse"
+                            "$ref": "#/definitions/handlers.GateEventLogsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid location ID",
+                        "description": "Invalid filter",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid or missing token",
+                        "description": "Unauthorized - invalid or missing admin token",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1134,55 +1134,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users": {
+        "/api/v1/admin/gate-events/export": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a list of all registered users with pagination and search (requires admin authentication)",
+                "description": "Stream open/close commands, oldest first, as CSV for parking reconciliation with billing: command ID, timestamps, user, phone, location, gate, action, status and error. Filter by a range of days in the request's timezone (X-Timezone, the admin's timezone or DEFAULT_TIMEZONE; at most 366, defaults to the last 30), which timestamps are also written in, location, gate and user. Locations are resolved from the provider's location list; if it cannot be loaded, location columns are left blank and filtering by location fails (super admin only)",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "User Management"
+                    "Admin Reports"
                 ],
-                "summary": "Get all users",
+                "summary": "Export gate operation history as CSV",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
+                        "type": "string",
+                        "description": "First day, YYYY-MM-DD (defaults to 29 days before to)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day, inclusive, YYYY-MM-DD (defaults to today)",
+                        "name": "to",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Records per page (default: 500)",
-                        "name": "limit",
+                        "description": "Only gates of this location",
+                        "name": "location_id",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Search by phone number",
-                        "name": "search",
+                        "type": "integer",
+                        "description": "Only this gate",
+                        "name": "gate_id",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Order results by created_at (ASC or DESC, default: DESC)",
-                        "name": "order",
+                        "description": "Only commands issued by this user (UUID)",
+                        "name": "user_id",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Users retrieved successfully",
+                        "description": "CSV export",
                         "schema": {
-                            "$ref": "#/definitions/handlers.UsersListResponse"
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "401": {
@@ -1191,21 +1203,41 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Daily export quota (EXPORT_QUOTA_DAILY) exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Locations could not be loaded from the provider",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Provider unavailable",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/admin/gate-reports": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new user account and assign locations and gates via third-party API (requires admin authentication)",
+                "description": "Retrieve problems users reported with gates, newest first, filtered by status (requires admin authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1213,29 +1245,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Admin Gate Reports"
                 ],
-                "summary": "Create a new user with location and gate assignment",
+                "summary": "List gate problem reports",
                 "parameters": [
                     {
-                        "description": "User creation details with locations and gates",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.CreateUserRequest"
-                        }
+                        "type": "string",
+                        "description": "open (default), resolved or all",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only reports on this gate",
+                        "name": "gate_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "User created successfully",
+                    "200": {
+                        "description": "Gate reports retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handlers.UserResponse"
+                            "$ref": "#/definitions/handlers.GateReportsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or validation error",
+                        "description": "Invalid status",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1246,14 +1295,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
-                    "409": {
-                        "description": "User with this phone number already exists",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.APIResponse"
-                        }
-                    },
                     "500": {
-                        "description": "Internal server error or third-party API failure",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1261,42 +1304,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users/{id}": {
+        "/api/v1/admin/gate-reports/{id}/photo": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a specific user's details by ID including their assigned locations and gates from third-party API (requires admin authentication)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Redirect to a short-lived signed URL of the photo attached to a gate report (requires admin authentication)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Admin Gate Reports"
                 ],
-                "summary": "Get user by ID with assigned locations and gates",
+                "summary": "Download a gate report photo",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
+                        "description": "Gate report ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User retrieved successfully with locations",
+                    "302": {
+                        "description": "Redirect to the signed photo URL",
                         "schema": {
-                            "$ref": "#/definitions/handlers.UserDetailResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID format",
+                        "description": "Invalid report ID",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1308,7 +1348,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Report not found or has no photo",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1320,14 +1360,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/admin/gate-reports/{id}/resolve": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a user account by ID (soft delete, requires admin authentication)",
+                "description": "Mark a reported gate problem as fixed, with an optional note on what was done. The user can report the gate again afterwards (requires admin authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1335,27 +1377,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Admin Gate Reports"
                 ],
-                "summary": "Delete a user",
+                "summary": "Resolve a gate report",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
+                        "description": "Gate report ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Resolution note",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ResolveGateReportRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User deleted successfully",
+                        "description": "Report resolved",
                         "schema": {
-                            "$ref": "#/definitions/handlers.UserResponse"
+                            "$ref": "#/definitions/handlers.GateReportResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID format",
+                        "description": "Invalid report ID or request body",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1367,7 +1417,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Report is already resolved",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1379,14 +1435,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/api/v1/admin/gates/maintenance": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a user's password (optional) and reassign locations and gates via third-party API (requires admin authentication)",
+                "description": "Retrieve the gates under maintenance with their notes, longest first (requires admin authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1394,36 +1452,76 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Gate Management"
                 ],
-                "summary": "Update user password and location/gate assignments",
+                "summary": "List gates under maintenance",
+                "responses": {
+                    "200": {
+                        "description": "Gate maintenance retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateMaintenancesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/gates/{gateId}/maintenance": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Refuse user opens and closes of a gate with the note (code gate_maintenance), show it greyed out in the app and drop its queued user commands until maintenance ends. Setting it again replaces the note. Admins can still open the gate with PUT /admin/gates/:gateId/open (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Put a gate under maintenance",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "type": "integer",
+                        "description": "Gate ID",
+                        "name": "gateId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update details (password optional, locations and gates required)",
+                        "description": "Note shown to users",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateUserRequest"
+                            "$ref": "#/definitions/handlers.SetGateMaintenanceRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User updated successfully",
+                        "description": "Gate under maintenance",
                         "schema": {
-                            "$ref": "#/definitions/handlers.UserResponse"
+                            "$ref": "#/definitions/handlers.GateMaintenanceResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID or request body",
+                        "description": "Invalid gate ID or missing note",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
@@ -1435,122 +1533,10992 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Gate not found",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error or third-party API failure",
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Third-party API unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Let users open and close the gate again (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "End a gate's maintenance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gate ID",
+                        "name": "gateId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Maintenance ended",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gate ID",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Gate is not under maintenance",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handlers.APIResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handlers.APIResponse": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "message": {
-                    "type": "string"
-                },
-                "success": {
-                    "type": "boolean"
+        },
+        "/api/v1/admin/gates/{gateId}/open": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Open any gate, even at a frozen location, e.g. to let emergency services in. The reason is stored in the audit log and every admin is notified (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location Management"
+                ],
+                "summary": "Open a gate as an emergency override",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gate ID",
+                        "name": "gateId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Why the gate is opened",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.EmergencyOpenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gate operation response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateActionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gate ID or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A conflicting command is in progress for this gate",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Gate provider unavailable - the command was queued (command_status queued) or failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateActionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/impersonate/{userId}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Issue a short-lived access token for a user so support can see exactly what the resident sees. The token is flagged as impersonation, has no refresh token, and every request made with it is written to the audit log under the admin. Gate operations are blocked unless block_gate_operations is false (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Management"
+                ],
+                "summary": "Impersonate a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason and options",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ImpersonateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Impersonation token issued",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ImpersonationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID, or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User is in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/invite-codes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the invite codes generated for self-registration, newest first (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Invite Codes"
+                ],
+                "summary": "List invite codes",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only list codes that can still be used",
+                        "name": "active",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invite codes retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.InviteCodesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generate a code people use to register with POST /api/v1/auth/register. Users registering with it are assigned its locations and gates. The code can be limited to a number of registrations and a lifetime (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Invite Codes"
+                ],
+                "summary": "Generate an invite code",
+                "parameters": [
+                    {
+                        "description": "Invite code settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateInviteCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Invite code generated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.InviteCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/invite-codes/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop an invite code from being used for new registrations. Users who already registered with it are not affected (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Invite Codes"
+                ],
+                "summary": "Revoke an invite code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invite code ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invite code revoked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.InviteCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid invite code ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invite code not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/jobs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve all registered background jobs with their cron schedule, next run time and the outcome of their most recent run (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Jobs"
+                ],
+                "summary": "List scheduled jobs",
+                "responses": {
+                    "200": {
+                        "description": "Scheduled jobs retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ScheduledJobsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/legal": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publish a new version of the terms of service or the privacy policy. It becomes the current version, and users are asked to accept it through the X-Legal-Acceptance-Required header (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Legal"
+                ],
+                "summary": "Publish a legal document version",
+                "parameters": [
+                    {
+                        "description": "Document version",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PublishLegalDocumentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Legal document published successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LegalDocumentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or document kind",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Version already published",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/locations/freezes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the freezes in effect now or scheduled to start, soonest first (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location Management"
+                ],
+                "summary": "List location freezes",
+                "responses": {
+                    "200": {
+                        "description": "Freezes retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LocationFreezesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/locations/overrides": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every location override in list order (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location Management"
+                ],
+                "summary": "List location branding overrides",
+                "responses": {
+                    "200": {
+                        "description": "Overrides retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LocationOverridesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/locations/{id}/freeze": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Block users from opening the gates of a location, for a lockdown or maintenance, from starts_at (default now) until ends_at or until the freeze is lifted. Users can still close gates, and admins can still open them with PUT /admin/gates/:gateId/open (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location Management"
+                ],
+                "summary": "Freeze a location",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason and optional start and end time",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.FreezeLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Location frozen",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LocationFreezeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid location ID, reason or times",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Location not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Third-party API unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "End the current and scheduled freezes of a location so users can open its gates again (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location Management"
+                ],
+                "summary": "Lift a location freeze",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Freezes lifted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LocationFreezesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid location ID",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Location is not frozen",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/locations/{id}/override": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the display name, logo and list position of a location from the third-party API in GET /locations and GET /available-locations. The override replaces any previous one; empty fields keep the provider's value (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location Management"
+                ],
+                "summary": "Override a location's branding",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Display name, logo URL and sort order",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LocationOverrideRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Override saved",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LocationOverrideResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid location ID, logo URL or empty override",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Location not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Third-party API unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show the location with the provider's title, logo and position again (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location Management"
+                ],
+                "summary": "Remove a location's branding override",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Override removed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid location ID",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Location has no override",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/login": {
+            "post": {
+                "description": "Authenticate admin with username and password, returns an access token (valid for JWT_ADMIN_ACCESS_EXPIRY) and a refresh token (valid for JWT_ADMIN_REFRESH_EXPIRY) for POST /admin/refresh",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Admin login",
+                "parameters": [
+                    {
+                        "description": "Admin credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminLoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful with access and refresh tokens",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminLoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or missing credentials",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Password login is disabled for this account (WEBAUTHN_PASSWORD_FALLBACK=unenrolled)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed logins for this username or client IP, retry after Retry-After",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/login/passkey": {
+            "post": {
+                "description": "Verify the response to a passkey login challenge and return a permanent access token, like POST /admin/login. Each challenge can be answered once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Admin login with a passkey",
+                "parameters": [
+                    {
+                        "description": "Credential returned by navigator.credentials.get()",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PasskeyLoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful with permanent token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminLoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Passkey could not be verified",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passkey login is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/login/passkey/options": {
+            "post": {
+                "description": "Create a login challenge and return the options for navigator.credentials.get(). The browser offers any passkey it holds for the site; allowCredentials is always empty so the options do not reveal which admins exist. With a username the challenge only accepts that admin's passkeys. The challenge expires after WEBAUTHN_CHALLENGE_TTL",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Start a passkey login",
+                "parameters": [
+                    {
+                        "description": "Optional username",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PasskeyLoginOptionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login options created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PasskeyRequestOptionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passkey login is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve notification center entries (security alerts, provider outages, failed jobs), newest first, with the total unread count",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Notifications"
+                ],
+                "summary": "Get admin notifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by severity (info, warning, critical)",
+                        "name": "severity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category (security, provider, jobs)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only return unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "exact (default) or estimate: approximate total from table statistics, for large tables",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notifications retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminNotificationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid severity filter or count mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/notifications/read-all": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark every unread notification as read by the current admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Notifications"
+                ],
+                "summary": "Mark all notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "All notifications marked as read",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/notifications/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a notification center entry with its comment thread",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Notifications"
+                ],
+                "summary": "Get notification by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminNotificationDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid notification ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/notifications/{id}/comments": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a comment or resolution note to a notification center alert, e.g. what was found when investigating a security alert. Comments cannot be edited; the thread is returned by GET /admin/notifications/:id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Notifications"
+                ],
+                "summary": "Comment on a notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateAuditCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Comment added",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AuditCommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid notification ID, missing or too long comment",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/notifications/{id}/read": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark a single notification as read by the current admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Notifications"
+                ],
+                "summary": "Mark notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification marked as read",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminNotificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid notification ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/provider-migration/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "While migrating to a new gate provider (THIRD_PARTY_MIRROR_API_URL), assignments and gate commands are mirrored to it. This report compares the outcomes of both providers per operation, with the most recent mismatches (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Reports"
+                ],
+                "summary": "Provider migration comparison report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 168,
+                        "description": "Only include calls from the last N hours",
+                        "name": "since_hours",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of mismatches to return (max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ProviderMigrationReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid since_hours or limit",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/provider-usage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve this organization's calls to the gate provider (and the migration provider while one is configured) in a month, against THIRD_PARTY_MONTHLY_QUOTA and THIRD_PARTY_MIRROR_MONTHLY_QUOTA. Admins are warned in the notification center when usage crosses 80% and 95% of a quota (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Usage"
+                ],
+                "summary": "Third-party API usage against the monthly quota",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UTC month in YYYY-MM format (defaults to the current month)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Provider usage retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ProviderUsageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/refresh": {
+            "post": {
+                "description": "Exchange a valid admin refresh token for a new access token. The token version is checked, so the refresh token stops working after the admin logs in again or is signed out; the username and role are read from the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Authentication"
+                ],
+                "summary": "Refresh admin access token",
+                "parameters": [
+                    {
+                        "description": "Admin refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New access token generated",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RefreshResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired refresh token, or token has been invalidated",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/registrations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve self-registrations by status, oldest first. Registrations without an invite code land in the pending queue when USER_REGISTRATION_APPROVAL is enabled (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Registrations"
+                ],
+                "summary": "List registrations awaiting review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pending (default), rejected, approved or unverified (phone not confirmed yet, OTP_REGISTRATION_ENABLED)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Registrations retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegistrationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/registrations/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve a pending (or previously rejected) registration so the user can log in. The optional locations and gates are assigned to the user, and a welcome SMS is sent. If the assignment or the SMS fails, the registration stays approved and the response carries a warning (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Registrations"
+                ],
+                "summary": "Approve a registration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Locations and gates to assign",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ApproveRegistrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Registration approved (warning set if assignment or the welcome SMS failed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegistrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Registration is already approved",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/registrations/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reject a pending registration with a reason. The user cannot log in; the reason is kept on the user (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Registrations"
+                ],
+                "summary": "Reject a registration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rejection reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RejectRegistrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Registration rejected",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegistrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Registration is not pending",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/reports": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compute a canned report server-side for a range of days in the request's timezone (X-Timezone, the admin's timezone or DEFAULT_TIMEZONE): daily_active_users (distinct users active per day), gate_opens (confirmed open commands per location per day, from hourly rollups), user_churn (users registered and moved to the trash per day) or provider_error_rate (third-party API calls, failures and error rate per day). daily_active_users and provider_error_rate are metered per UTC day and always use UTC days; timezone in the response names the zone used. Returns JSON, or CSV with format=csv (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Admin Reports"
+                ],
+                "summary": "Operational reports",
+                "parameters": [
+                    {
+                        "enum": [
+                            "daily_active_users",
+                            "gate_opens",
+                            "user_churn",
+                            "provider_error_rate"
+                        ],
+                        "type": "string",
+                        "description": "Report name",
+                        "name": "report",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day, YYYY-MM-DD (defaults to 29 days before to)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day, inclusive, YYYY-MM-DD (defaults to today)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report computed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown report, invalid date range or format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/routes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Introspect the route table and return every endpoint with its middleware chain and the access it requires, so security reviews can verify coverage without reading code (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Routes"
+                ],
+                "summary": "List registered routes",
+                "responses": {
+                    "200": {
+                        "description": "Routes retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegisteredRoutesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/sandbox/sms": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the text messages captured instead of sent in sandbox mode (SANDBOX_MODE), newest first, so end-to-end tests can read the codes sent to their phones. Only the latest 100 messages are kept, in memory of the instance that sent them (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Sandbox"
+                ],
+                "summary": "List sandbox text messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only messages sent to this phone number",
+                        "name": "phone",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sandbox messages retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SandboxSMSResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid phone number format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Sandbox mode is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Search users (ID, full phone number, last 4 digits or email), locations and gates (ID, title, address or description, from a cached provider list), and for super admins and auditors also admin accounts (ID or username) and audit logs (resource ID, admin or action), in one call. Results are typed and ranked: exact matches first, then prefix, phone suffix and substring matches, then audit log entries. If the provider cannot be reached and no locations are cached, locations and gates are left out and the response carries a warning (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Search"
+                ],
+                "summary": "Search the admin panel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query (at least 2 characters)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of results (max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search results",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminSearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Query too short",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/security/denials": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve requests refused with 403, newest first, with the caller, the access rule of the route and the reason: super admin routes called by regular admins, admins accessing other admins' records, auditors attempting changes, API keys without the route's scope, gate operations with impersonation tokens, gate commands for gates not assigned to the user and invalid link signatures. Denials are kept for SECURITY_DENIAL_RETENTION (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Security"
+                ],
+                "summary": "List permission denials",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by caller type (admin, user, api_key, anonymous)",
+                        "name": "actor_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by admin, user or API key ID (UUID)",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by reason (super_admin_required, not_owner, auditor_read_only, api_key_scope, impersonation, gate_not_assigned, invalid_signature, unknown_requirement, forbidden)",
+                        "name": "reason",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by access rule pattern, e.g. /api/v1/admins/:id",
+                        "name": "route",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by client IP address",
+                        "name": "ip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only denials at or after this time (RFC3339)",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Security denials retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SecurityDenialsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/slo": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve each service level objective (SLO_OBJECTIVES) with its compliance and remaining error budget over the rolling SLO_WINDOW. A request is good when it is answered within the latency target without a 5xx. Counts are per instance and start over on restart; the slo_* Prometheus series are meant for alerting across instances (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin SLO"
+                ],
+                "summary": "Get SLO compliance",
+                "responses": {
+                    "200": {
+                        "description": "SLO summary retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SLOSummaryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/stale-devices": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Dry-run report of the device sessions the stale_device_purge job deletes: sessions not used for JWT_STALE_DEVICE_AFTER, or for older_than to preview another retention. Lists the least recently used ones first. Review it before setting JWT_STALE_DEVICE_PURGE=true (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin User Management"
+                ],
+                "summary": "Stale device report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Retention to preview as a Go duration, e.g. 2160h (defaults to JWT_STALE_DEVICE_AFTER)",
+                        "name": "older_than",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Stale sessions to list (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stale device report generated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.StaleDeviceReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid older_than",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/usage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the monthly usage rollup (API calls, gate operations, SMS sent, active users) of every organization for billing (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Usage"
+                ],
+                "summary": "Monthly usage per organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UTC month in YYYY-MM format (defaults to the current month)",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Usage rollup retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UsageRollupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of all admin accounts with pagination, search, filtering, and ordering (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin User Management"
+                ],
+                "summary": "Get all admin users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Records per page (default: 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by username",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by role (super, regular or auditor)",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order results by created_at (ASC or DESC, default: DESC)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "With limit=-1: json streams the response row by row, ndjson streams one object per line",
+                        "name": "stream",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Admin users retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid role or stream mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new admin account with specified role (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin User Management"
+                ],
+                "summary": "Create a new admin user",
+                "parameters": [
+                    {
+                        "description": "Admin creation details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateAdminRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Admin user created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Admin with this username already exists",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create up to 200 admin accounts at once from a CSV with a header row: username, role (super, regular or auditor) and optionally locations, which must be left empty as admin accounts are not limited to locations. Send the CSV as the request body (text/csv) or as the \"file\" field of a multipart form. Each account gets a generated password, returned only in this response; share it with the admin, who changes it with PATCH /admin/users/:id. If any line is invalid, no account is created and every problem is listed by line (super admin only)",
+                "consumes": [
+                    "text/csv",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin User Management"
+                ],
+                "summary": "Import admin accounts from CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file, when uploading a multipart form",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Admins imported with their generated passwords",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid CSV, nothing was imported",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminImportErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a specific admin's details by ID. Super admins can retrieve any admin. Regular admins can only retrieve their own details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin User Management"
+                ],
+                "summary": "Get admin by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Admin retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid admin ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - regular admins can only access their own record",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Admin not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an admin account by ID (soft delete, super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin User Management"
+                ],
+                "summary": "Delete an admin user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Admin user deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid admin ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Admin not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an admin's details (password, username, role and/or timezone). Super admins can update any admin. Regular admins can only update their own password, username and timezone (not role). The timezone applies to timestamps and report days of the admin's requests without X-Timezone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin User Management"
+                ],
+                "summary": "Update admin details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update details (at least one field required)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateAdminRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Admin updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid admin ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - insufficient permissions for this operation",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Admin not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every change to an admin account, newest first, with who made it and the changed fields before and after: username, role and password changes (flag only). History is kept after the admin is deleted (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin User Management"
+                ],
+                "summary": "Get an admin account's change history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Admin history retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid admin ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Admin not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/{id}/passkeys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the passkeys registered for the admin account, oldest first (super admin or self)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Passkeys"
+                ],
+                "summary": "List an admin's passkeys",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Passkeys retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminPasskeysResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid admin ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - regular admins can only access their own record",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Verify the response to a registration challenge and add the passkey to the admin's account. Attestation is not verified, any authenticator is accepted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Passkeys"
+                ],
+                "summary": "Register a passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Credential returned by navigator.credentials.create()",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PasskeyRegistrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Passkey registered",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminPasskeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, unknown or expired challenge, or the credential could not be verified",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - passkeys can only be registered for your own account",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passkey login is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Passkey is already registered",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/{id}/passkeys/options": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a registration challenge and return the options for navigator.credentials.create(). Passkeys are created as discoverable credentials (residentKey required). Admins can only register passkeys for their own account, since registering needs their authenticator",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Passkeys"
+                ],
+                "summary": "Start registering a passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Registration options created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PasskeyCreationOptionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid admin ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - passkeys can only be registered for your own account",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passkey login is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/users/{id}/passkeys/{credentialId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove one of the admin's passkeys so it can no longer log in. Super admins can remove passkeys of other admins, e.g. for a lost security key. With WEBAUTHN_PASSWORD_FALLBACK=unenrolled, removing an admin's last passkey lets them log in with their password again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Passkeys"
+                ],
+                "summary": "Remove a passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passkey ID (UUID)",
+                        "name": "credentialId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Passkey removed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminPasskeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - regular admins can only access their own record",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passkey not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the label of one of the admin's passkeys (super admin or self)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Passkeys"
+                ],
+                "summary": "Rename a passkey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admin ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Passkey ID (UUID)",
+                        "name": "credentialId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RenamePasskeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Passkey renamed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AdminPasskeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or missing name",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - regular admins can only access their own record",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passkey not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/webhook-secrets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the webhook secrets in use: per scope, the current one and, during a rotation, the previous one with its expiry. The secrets themselves are never shown again after rotation, only their last characters. Scopes still using the secret set in the configuration (WEBHOOK_SIGNING_SECRET, GATE_PROVIDER_CALLBACK_TOKEN) are not listed (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Webhook Secrets"
+                ],
+                "summary": "List webhook secrets",
+                "responses": {
+                    "200": {
+                        "description": "Webhook secrets retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.WebhookSecretsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/webhook-secrets/{scope}/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generate a new secret for a scope: \"outbound\" signs the webhooks the API sends (X-Ololo-Signature), \"provider_callback\" is the token the gate provider sends in X-Provider-Token. The replaced secret stays valid for WEBHOOK_SECRET_OVERLAP, so deliveries keep working while the other side switches over; an older previous secret expires right away. The new secret is only returned in this response (super admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin Webhook Secrets"
+                ],
+                "summary": "Rotate a webhook secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "outbound or provider_callback",
+                        "name": "scope",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Webhook secret rotated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RotatedWebhookSecretResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown scope",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/change-password": {
+            "post": {
+                "description": "Change the password with the current one. This is also how users whose password expired (login refused with code password_expired, see PASSWORD_MAX_AGE) set a new one, so it does not need an access token. All devices are logged out; log in again with the new password.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Change password",
+                "parameters": [
+                    {
+                        "description": "Login identifier, current and new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password changed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, phone or email format, new password too short or unchanged",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/check-phone": {
+            "get": {
+                "description": "Check if a phone number is available for registration or account creation (public endpoint, no authentication required)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Check if phone number is available for registration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Phone number, international (e.g., +77771234567) or national format",
+                        "name": "phone",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Phone availability check result",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PhoneAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid phone number format, or number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/forgot-password": {
+            "post": {
+                "description": "Send a one-time code by SMS to a registered phone number, for setting a new password at POST /auth/reset-password without the current one. The response is the same whether or not the number has an account. Requesting a new code invalidates the previous one. Codes are rate limited per phone (OTP_RESEND_INTERVAL, OTP_PHONE_HOURLY) and per client IP (OTP_IP_HOURLY). Only available when OTP_PASSWORD_RESET_ENABLED is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Request a password reset code",
+                "parameters": [
+                    {
+                        "description": "Phone number",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Code sent if the number is registered",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or phone number format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Password reset is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many codes requested (see Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/legal": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "For each current legal document, the version the user accepted and when, and whether acceptance is required",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Legal"
+                ],
+                "summary": "Get my legal acceptance status",
+                "responses": {
+                    "200": {
+                        "description": "Legal acceptance status retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LegalStatusResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/legal/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record that the user accepted the current version of the terms of service or the privacy policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Legal"
+                ],
+                "summary": "Accept a legal document",
+                "parameters": [
+                    {
+                        "description": "Document kind and the current version",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AcceptLegalDocumentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Legal document accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LegalStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or document kind",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Version is not the current version",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "Authenticate user with phone or verified email and password, returns access and refresh tokens. The identifier used is recorded in the idt token claim. Supports device-based token invalidation. Past the user's session limit (JWT_MAX_SESSIONS or the user's max_sessions) the oldest sessions are logged out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique device identifier (optional - a new login on the same device replaces that device's previous session)",
+                        "name": "device_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client type selecting token lifetimes (e.g. kiosk, resident); unknown types use the default lifetimes",
+                        "name": "client_type",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Login credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful with tokens",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, phone or email format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Password expired (code password_expired, change it with POST /auth/change-password), or registration awaiting approval, rejected or not confirmed by SMS",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PasswordExpiredResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed logins for this account or client IP, retry after Retry-After",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/login-otp/confirm": {
+            "post": {
+                "description": "Log in with a phone number and the one-time code sent to it by POST /auth/login-otp/request, returns access and refresh tokens like POST /auth/login. A code can be used once, and is invalidated after OTP_MAX_ATTEMPTS wrong guesses. Not affected by PASSWORD_MAX_AGE, as no password is used. Only available when OTP_LOGIN_ENABLED is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Log in with a login code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique device identifier (optional - a new login on the same device replaces that device's previous session)",
+                        "name": "device_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client type selecting token lifetimes (e.g. kiosk, resident); unknown types use the default lifetimes",
+                        "name": "client_type",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Phone number and code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginOTPConfirmRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful with tokens",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or phone number format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired code",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Registration awaiting approval or rejected",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passwordless login is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/login-otp/request": {
+            "post": {
+                "description": "Send a one-time login code by SMS to a registered phone number, for logging in without a password. The response is the same whether or not the number has an account. Requesting a new code invalidates the previous one. Codes are rate limited per phone (OTP_RESEND_INTERVAL, OTP_PHONE_HOURLY) and per client IP (OTP_IP_HOURLY). Only available when OTP_LOGIN_ENABLED is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Request a login code",
+                "parameters": [
+                    {
+                        "description": "Phone number",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LoginOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Code sent if the number is registered",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or phone number format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Passwordless login is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many codes requested (see Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Log out the device making this request: its session is revoked, so its access and refresh tokens stop working immediately while other devices stay logged in. Tokens issued before device sessions existed carry no session; for them every token of the user is invalidated (token version bumped), like DELETE /auth/sessions.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Log out",
+                "responses": {
+                    "200": {
+                        "description": "Logged out successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/notification-preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Which optional messages the user receives, e.g. the SMS digest sent after weeks without opening a gate",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Get my notification preferences",
+                "responses": {
+                    "200": {
+                        "description": "Notification preferences retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.NotificationPreferencesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turn the inactivity digest SMS on or off. The change is recorded in the user's history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Update my notification preferences",
+                "parameters": [
+                    {
+                        "description": "Preferences to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateNotificationPreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification preferences updated",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.NotificationPreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/refresh": {
+            "post": {
+                "description": "Exchange a valid refresh token for a new access token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Device the refresh token was issued to (required for device-bound tokens unless JWT_REQUIRE_DEVICE_HEADER is off)",
+                        "name": "X-Device-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New access token generated",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RefreshResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired refresh token, or token has been invalidated",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/register": {
+            "post": {
+                "description": "Register a new user account with phone number, password and an invite code generated by an admin. The user is assigned the invite code's locations and gates. The phone is stored in E.164 form; national formats of PHONE_DEFAULT_REGION are accepted. With OTP_REGISTRATION_ENABLED the user is created unverified (registration_status unverified) and a code is sent to the phone by SMS; the registration is completed, and the locations assigned, by POST /auth/verify-otp",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "Registration details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User registered successfully (warning set if assigning the invite code's locations failed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegisterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation error, missing, invalid or expired invite code, or number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User with this phone number already exists",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many registration codes requested for the phone or client IP (see Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/reset-password": {
+            "post": {
+                "description": "Set a new password with the one-time code sent by SMS at POST /auth/forgot-password. All devices are logged out; log in again with the new password. A code can be used once, and is invalidated after OTP_MAX_ATTEMPTS wrong guesses. Only available when OTP_PASSWORD_RESET_ENABLED is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Reset a forgotten password",
+                "parameters": [
+                    {
+                        "description": "Phone number, code and new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password reset",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, phone number format or new password too short",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired code",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Password reset is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the active login sessions (one per device) of the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "List my device sessions",
+                "responses": {
+                    "200": {
+                        "description": "Sessions retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserSessionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke every session of the current user, including the one making this request, and invalidate all previously issued tokens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Log out all devices",
+                "responses": {
+                    "200": {
+                        "description": "All sessions revoked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke one of the current user's sessions. Tokens issued for that device stop working immediately; other devices stay logged in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Log out a device session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Session revoked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid session ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/verify-otp": {
+            "post": {
+                "description": "Confirm the phone number of a registration with the one-time code sent to it by SMS at POST /auth/register. The registration is then approved, or awaits approval when USER_REGISTRATION_APPROVAL applies, and the invite code's locations and gates are assigned. A code can be used once, and is invalidated after OTP_MAX_ATTEMPTS wrong guesses. Only available when OTP_REGISTRATION_ENABLED is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Confirm a registration",
+                "parameters": [
+                    {
+                        "description": "Phone number and code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VerifyRegistrationOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Registration confirmed (warning set if assigning the invite code's locations failed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegisterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or phone number format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired code",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Registration codes are not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/verify-otp/resend": {
+            "post": {
+                "description": "Send a new one-time code by SMS to a registration waiting for confirmation, for example when the first code expired. The response is the same whether or not the number has such a registration. Requesting a new code invalidates the previous one. Codes are rate limited per phone (OTP_RESEND_INTERVAL, OTP_PHONE_HOURLY) and per client IP (OTP_IP_HOURLY). Only available when OTP_REGISTRATION_ENABLED is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Authentication"
+                ],
+                "summary": "Resend a registration code",
+                "parameters": [
+                    {
+                        "description": "Phone number",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ResendRegistrationOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Code sent if the number has a registration to confirm",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or phone number format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Registration codes are not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many codes requested (see Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/available-locations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fetch all locations from third-party API without filtering by user, with admin overrides of display name, logo and order applied (admin access only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location Management"
+                ],
+                "summary": "Get all available locations in the system",
+                "responses": {
+                    "200": {
+                        "description": "Available locations retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AvailableLocationsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - requires admin access",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Third-party API unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/contacts": {
+            "get": {
+                "description": "Retrieve the application's contact information (public endpoint, no authentication required). Returns empty values if contact information has not been set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Contact Information"
+                ],
+                "summary": "Get contact information",
+                "responses": {
+                    "200": {
+                        "description": "Contact information retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ContactResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update or create the application's contact information (admin only). Creates a new contact record if one doesn't exist.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Contact Information"
+                ],
+                "summary": "Update contact information",
+                "parameters": [
+                    {
+                        "description": "Contact information to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateContactRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Contact information updated or created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ContactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/files/{key}": {
+            "get": {
+                "description": "Serve a file from local file storage through a signed URL handed out by the API, e.g. a gate report photo. The URL carries its own authorization and expires after STORAGE_SIGNED_URL_TTL. With the s3 storage driver signed URLs point at the bucket instead",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Files"
+                ],
+                "summary": "Download a stored file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expiry (unix seconds)",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature",
+                        "name": "sig",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid or expired signature",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/gate-commands/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the lifecycle status (accepted, executing, confirmed, failed) of a gate command issued by the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Get gate command status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Gate command ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gate command retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateCommandResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gate command ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Gate command not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/gate-commands/{id}/callback": {
+            "post": {
+                "description": "Called by the gate provider to report the final outcome of a command. Requires the shared provider token in the X-Provider-Token header; during a rotation (POST /admin/webhook-secrets/provider_callback/rotate) the previous token is accepted until it expires.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Gate command status callback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shared provider callback token",
+                        "name": "X-Provider-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gate command ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Command outcome",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateCommandCallbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gate command updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateCommandResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gate command ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid provider token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Gate command not found or callbacks disabled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/gates/{gateId}/report": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report a stuck or damaged barrier on a gate the user has access to, with a category, an optional description and an optional photo (JPEG, PNG or WebP up to GATE_REPORT_MAX_PHOTO_SIZE). Send a multipart form with the photo in the \"photo\" field, or the same fields as a JSON body (ReportGateProblemRequest) without a photo. The report goes to the admin queue and the facility team is notified. A user can have one open report per gate.",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Report a problem with a gate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gate ID",
+                        "name": "gateId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Problem category: stuck_open, stuck_closed, damaged or other",
+                        "name": "category",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "What is wrong with the gate",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Photo of the problem",
+                        "name": "photo",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Problem reported",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gate ID, category, description or photo",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Gate not found or not accessible to the user",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The user already has an open report for this gate",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateReportResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Photo too large",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Third-party API unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/legal": {
+            "get": {
+                "description": "Retrieve the current version of the terms of service and the privacy policy (public)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Legal"
+                ],
+                "summary": "Get current legal documents",
+                "responses": {
+                    "200": {
+                        "description": "Legal documents retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LegalDocumentsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/legal/{kind}": {
+            "get": {
+                "description": "Retrieve the current version of the terms of service (\"terms\") or the privacy policy (\"privacy\"), or an earlier version (public)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Legal"
+                ],
+                "summary": "Get a legal document",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "terms or privacy",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Version (default: current)",
+                        "name": "version",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Legal document retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LegalDocumentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown document kind",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Document not published",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/links/{id}": {
+            "get": {
+                "description": "Return the metadata of a shared gate link after checking its signature and expiry server-side. Used by the app and the web page a universal link opens. Does not require authentication. A link is revoked after GATE_LINK_MAX_FAILED_ATTEMPTS wrong signatures, and a client IP is throttled after GATE_LINK_IP_HOURLY_FAILURES failed resolutions in an hour.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Resolve a shared gate link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link signature",
+                        "name": "sig",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Link is valid",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateLinkMetadataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid link ID",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid signature",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Link not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Link expired or revoked",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts from this IP (see Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/locations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fetch all locations from third-party API based on user's phone with their gates, with admin overrides of display name, logo and order applied and gates under maintenance flagged. While the provider is unavailable the user's last loaded list is returned with degraded set to true and cached_at; gate states in it may be stale.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Get all locations accessible to the current user",
+                "responses": {
+                    "200": {
+                        "description": "Locations retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LocationsListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Third-party API unavailable and no cached locations for this user",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/locations/{gateId}/close": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send command to close a specific gate to third-party API. The returned command ID can be used to follow the command until the barrier is confirmed closed. Only gates assigned to the user's phone can be closed. Gates under maintenance are refused with code gate_maintenance and the maintenance note.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Close a gate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gate ID",
+                        "name": "gateId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gate operation response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateActionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gate ID",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "The gate is not assigned to the user, or gate operations are blocked for this impersonation token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A conflicting command is in progress for this gate",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "The gate is under maintenance (code gate_maintenance)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateMaintenanceRefusedResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Gate provider unavailable - the command was queued (command_status queued) or failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateActionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/locations/{gateId}/links": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a signed link to a gate the user has access to, for guest access and \"share my gate\". The response has an app deep link and, when GATE_LINK_BASE_URL is set, a universal link. The signature covers the link, gate and expiry, and is checked when the link is resolved.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Create a shareable link to a gate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gate ID",
+                        "name": "gateId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Link label and lifetime",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateGateLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Link created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gate ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Gate operations are blocked for this impersonation token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Gate not found or not accessible to the user",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Third-party API unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/locations/{gateId}/open": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send command to open a specific gate to third-party API. The returned command ID can be used to follow the command until the barrier is confirmed open. Only gates assigned to the user's phone can be opened. Gates at a frozen location cannot be opened, and gates under maintenance are refused with code gate_maintenance and the maintenance note.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Open a gate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gate ID",
+                        "name": "gateId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gate operation response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateActionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gate ID",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "The gate is not assigned to the user, or gate operations are blocked for this impersonation token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A conflicting command is in progress for this gate",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "The gate's location is frozen, or the gate is under maintenance (GateMaintenanceRefusedResponse, code gate_maintenance)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.LocationFrozenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Gate provider unavailable - the command was queued (command_status queued) or failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GateActionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/locations/{locationId}/gates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fetch all gates accessible to the current user for a specific location from third-party API, with gates under maintenance flagged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gate Management"
+                ],
+                "summary": "Get all gates for a specific location",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Location ID",
+                        "name": "locationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gates retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GatesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid location ID",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Third-party API returned an unexpected response",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Third-party API unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/scim/v2/Users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List users in SCIM form, oldest first, including users in the trash (active=false). Supports filters comparing one attribute with \"eq\": userName, externalId, emails.value, active and id (requires an API key with the \"scim\" scope)",
+                "produces": [
+                    "application/scim+json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "List SCIM users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter, e.g. userName eq \\",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "1-based index of the first result",
+                        "name": "startIndex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Results per page (max 200)",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unsupported filter",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing, invalid or revoked API key",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the scim scope",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a user from a SCIM user. userName is the phone number; the primary email becomes a verified login email; without a password a random one is set, so the user logs in with a login code or resets it. The extension's locations are assigned to the user like POST /api/v1/users, including ASSIGNMENT_STRICT_MODE (requires an API key with the \"scim\" scope)",
+                "consumes": [
+                    "application/scim+json"
+                ],
+                "produces": [
+                    "application/scim+json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Provision a user over SCIM",
+                "parameters": [
+                    {
+                        "description": "SCIM user",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing, invalid or revoked API key",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the scim scope",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Phone number, email or externalId already in use",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "502": {
+                        "description": "Strict mode: assignment failed and the user was rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/scim/v2/Users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a user in SCIM form, including users in the trash (active=false) (requires an API key with the \"scim\" scope)",
+                "produces": [
+                    "application/scim+json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Get a SCIM user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMUser"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing, invalid or revoked API key",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the scim scope",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace a user's attributes with a SCIM user. Attributes left out are cleared (externalId, emails) or reset (active defaults to true); the password is only changed when given, and locations only when the extension is given. Changing the phone number or password logs the user out (requires an API key with the \"scim\" scope)",
+                "consumes": [
+                    "application/scim+json"
+                ],
+                "produces": [
+                    "application/scim+json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Replace a SCIM user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM user",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User replaced",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing, invalid or revoked API key",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the scim scope",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "409": {
+                        "description": "Phone number, email or externalId already in use",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "502": {
+                        "description": "Assignment at the gate provider failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move the user to the trash, like DELETE /api/v1/users/{id}: login is blocked at once and the user is purged, revoking their gate access, after USER_TRASH_RETENTION (requires an API key with the \"scim\" scope)",
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Deprovision a SCIM user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "User deprovisioned"
+                    },
+                    "401": {
+                        "description": "Missing, invalid or revoked API key",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the scim scope",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apply SCIM PatchOp operations (add, replace, remove) to a user. Supported paths: userName, externalId, password, active, emails (including emails[type eq \"work\"].value) and the extension's locations (urn:ololo-gate:params:scim:schemas:extension:2.0:User:locations); without a path, value is an object of these attributes. active=false moves the user to the trash and active=true restores them (requires an API key with the \"scim\" scope)",
+                "consumes": [
+                    "application/scim+json"
+                ],
+                "produces": [
+                    "application/scim+json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Update a SCIM user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "PatchOp request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid operation, path or value",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing, invalid or revoked API key",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the scim scope",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "409": {
+                        "description": "Phone number, email or externalId already in use",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    },
+                    "502": {
+                        "description": "Assignment at the gate provider failed",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SCIMError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/sync/changes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Incremental feed of changes to users and their location/gate assignments, oldest first, for mirroring them into another system. Start without since, then pass the returned next_token to get the changes after it; when has_more is false, poll again later with the same token. Changes are user history entries: created, registered, updated, assigned, phone_added, phone_removed, trashed, restored, purged, approved, rejected, merged and merged_into, with the changed fields before and after (requires an API key with the \"sync\" scope)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sync"
+                ],
+                "summary": "Get changes since a token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token returned as next_token by the previous call; omit to start at the first change",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Changes per page (max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Changes retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.SyncChangesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid change token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing, invalid or revoked API key",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the sync scope",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of all registered users with pagination and search (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get all users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Records per page (default: 500)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by full phone number, its last 4 digits, or full email",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order results by created_at (ASC or DESC, default: DESC)",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "With limit=-1: json streams the response row by row, ndjson streams one object per line",
+                        "name": "stream",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "exact (default) or estimate: approximate total from table statistics, for large tables",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UsersListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid count or stream mode",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new user account and assign locations and gates via third-party API (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Create a new user with location and gate assignment",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Roll back the user if location/gate assignment fails (defaults to ASSIGNMENT_STRICT_MODE)",
+                        "name": "strict",
+                        "in": "query"
+                    },
+                    {
+                        "description": "User creation details with locations and gates",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, validation error, or number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User with this phone number or email already exists",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error or third-party API failure",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Strict mode: third-party assignment failed and the user was rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/bulk-delete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move several users to the trash in two steps. Without confirmation_token, nothing is deleted: the response summarizes the users that would be deleted and returns a confirmation token valid for 5 minutes. Sending the same user_ids with the token executes the deletion. The token only confirms the same admin and the same set of users (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Bulk delete users",
+                "parameters": [
+                    {
+                        "description": "Users to delete and, to execute, the confirmation token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BulkDeleteUsersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Summary and confirmation token (no confirmation_token sent)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BulkDeleteSummaryResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Users moved to the trash (confirmation_token sent)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.BulkDeleteResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, too many users, or invalid/expired confirmation token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List groups of users sharing a phone number once normalized, e.g. \"8 777 123 45 67\" and \"+77771234567\" from different imports. Primary and verified secondary numbers are compared (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Detect likely duplicate users",
+                "responses": {
+                    "200": {
+                        "description": "Duplicate users retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.DuplicateUsersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve deleted users that can still be restored, with when each will be purged (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "List users in the trash",
+                "responses": {
+                    "200": {
+                        "description": "Trashed users retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TrashedUsersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a specific user's details by ID including their assigned locations and gates from third-party API (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get user by ID with assigned locations and gates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User retrieved successfully with locations",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a user to the trash (requires admin authentication). Login is blocked and all tokens and sessions are revoked, but the user's numbers and gate access are kept for USER_TRASH_RETENTION, during which the deletion can be undone with POST /api/v1/users/{id}/restore. Afterwards the user is purged and their gate access revoked at the provider.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User moved to the trash",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.TrashedUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User is already in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a user's password (optional), session limit (optional) and reassign locations and gates via third-party API (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Update user password and location/gate assignments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update details (password optional, locations and gates required)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or request body, or new number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Phone number or email is already in use",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error or third-party API failure",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve every change to a user, newest first, with the changed fields before and after: phone and email, password changes (flag only), status (approved, pending, rejected, trashed, deleted), secondary numbers and location/gate assignments. History is kept after the user is deleted (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get a user's change history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by action (created, registered, updated, assigned, phone_added, phone_removed, trashed, restored, purged, approved, rejected, merged, merged_into)",
+                        "name": "action",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User history retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Merge the source users into the user in the path. Their numbers become verified secondary numbers (numbers the target already has are dropped), their device sessions and gate command history move to the target, and the target takes over a verified email if it has none. The source users are deleted. Every number of the target is then given the union of the locations and gates the accounts had at the provider (requires super admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Merge duplicate users into one account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target user ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Users to merge into the target",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MergeUsersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users merged (warning set if consolidating gate access failed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MergeUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - super admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Target or source user not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/phones": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the primary and secondary phone numbers of a user (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "List a user's phone numbers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Phone numbers retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserPhonesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a secondary phone number to a user, e.g. for a family member sharing the account. Numbers added by an admin are verified and can log in immediately. The number gets the same locations and gates as the primary number (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Add a secondary phone number",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Phone number",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddUserPhoneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Phone number added (warning set if copying gate access failed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserPhoneResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or phone number format, or number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Phone number is already in use",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/phones/{phoneId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a secondary phone number from a user and revoke its gate access at the provider. The primary number is changed with PATCH /api/v1/users/{id} instead (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Remove a secondary phone number",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Phone number ID (UUID)",
+                        "name": "phoneId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Phone number removed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format, or the number is the primary number",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User or phone number not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Undo the deletion of a user in the trash. The user can log in again with their existing password; their numbers and gate access were kept (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Restore a user from the trash",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User restored successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User is not in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/retry-assignment": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send the user's pending location/gate assignment, queued when the provider refused it on create or update, to the provider again right away instead of waiting for the assignment_retry job. Assignments given up after ASSIGNMENT_RETRY_MAX_ATTEMPTS can be retried too (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Retry a failed location/gate assignment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Locations and gates assigned",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found or no pending assignment",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Provider refused the assignment again; the next attempt is scheduled",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the active login sessions (one per device) of a user, for support. trusted marks sessions logged in with \"remember me\", which use the long refresh expiry (requires admin authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "List a user's device sessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sessions retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserSessionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID format",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid or missing admin token",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ping": {
+            "get": {
+                "description": "Answer 204 with no body as cheaply as possible, for load balancer and orchestrator probes. Requests skip logging and all middleware",
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Load balancer ping",
+                "responses": {
+                    "204": {
+                        "description": "Server is up"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handlers.APIKeyDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-01T08:00:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2026-10-16T10:30:45Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Acme HR (Okta)"
+                },
+                "prefix": {
+                    "description": "First characters of the key, to recognize it",
+                    "type": "string",
+                    "example": "ogk_Q2x9fA"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "scim"
+                    ]
+                }
+            }
+        },
+        "handlers.APIKeyResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.APIKeyDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "API key revoked successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.APIKeysResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.APIKeyDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "API keys retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.APIResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string"
+                },
+                "retry_strategy": {
+                    "description": "Set on throttled (429) and degraded (503) responses",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/middleware.RetryStrategy"
+                        }
+                    ]
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.AcceptLegalDocumentRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "version"
+            ],
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "example": "terms"
+                },
+                "version": {
+                    "description": "Must be the current version",
+                    "type": "string",
+                    "example": "2025-01"
+                }
+            }
+        },
+        "handlers.AddUserPhoneRequest": {
+            "type": "object",
+            "required": [
+                "phone"
+            ],
+            "properties": {
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234568"
+                }
+            }
+        },
+        "handlers.AdminDTO": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "id",
+                "role",
+                "updated_at",
+                "username"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000001"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "super"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "handlers.AdminData": {
+            "type": "object",
+            "required": [
+                "id",
+                "role",
+                "username"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "regular"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "newadmin"
+                }
+            }
+        },
+        "handlers.AdminDetailData": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00+06:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000001"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "super"
+                },
+                "timezone": {
+                    "description": "Admin panel timezone; omitted for DEFAULT_TIMEZONE",
+                    "type": "string",
+                    "example": "Asia/Bishkek"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00+06:00"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "handlers.AdminDetailResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AdminDetailData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Admin retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminHistoryDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "created, updated or deleted",
+                    "type": "string",
+                    "example": "updated"
+                },
+                "actor": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "actor_id": {
+                    "description": "Admin who made the change; omitted for the system",
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "changes": {
+                    "description": "Changed fields, e.g. \"username\", \"role\", \"password_changed\"",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/handlers.FieldChangeDTO"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "handlers.AdminHistoryResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AdminHistoryDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Admin history retrieved successfully"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/handlers.PaginationMeta"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminImportErrorResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AdminImportLineErrorDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Import rejected, no admins were created"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.AdminImportLineErrorDTO": {
+            "type": "object",
+            "properties": {
+                "line": {
+                    "description": "The header is line 1",
+                    "type": "integer",
+                    "example": 3
+                },
+                "message": {
+                    "type": "string",
+                    "example": "invalid role \"guard\", must be super, regular or auditor"
+                }
+            }
+        },
+        "handlers.AdminImportResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ImportedAdminDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "2 admins imported. Share each password once; admins change it with PATCH /admin/users/:id"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminLoginData": {
+            "type": "object",
+            "required": [
+                "access_token",
+                "id",
+                "refresh_token",
+                "role",
+                "username"
+            ],
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "id": {
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000001"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "role": {
+                    "type": "string",
+                    "example": "super"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "handlers.AdminLoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "handlers.AdminLoginResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AdminLoginData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Login successful"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminNotificationDTO": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "provider"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Third-party open_gate failed: third-party API returned status code 503"
+                },
+                "read": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "read_by": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string",
+                    "example": "critical"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Gate provider unavailable"
+                }
+            }
+        },
+        "handlers.AdminNotificationDetailDTO": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "provider"
+                },
+                "comments": {
+                    "description": "Oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AuditCommentDTO"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Third-party open_gate failed: third-party API returned status code 503"
+                },
+                "read": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "read_by": {
+                    "type": "string"
+                },
+                "resolved": {
+                    "description": "A resolution note was added",
+                    "type": "boolean",
+                    "example": false
+                },
+                "severity": {
+                    "type": "string",
+                    "example": "critical"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Gate provider unavailable"
+                }
+            }
+        },
+        "handlers.AdminNotificationDetailResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AdminNotificationDetailDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Notification retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminNotificationResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AdminNotificationDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Notification marked as read"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminNotificationsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AdminNotificationDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Notifications retrieved successfully"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/handlers.PaginationMeta"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "unread_count": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handlers.AdminPasskeyDTO": {
+            "type": "object",
+            "properties": {
+                "aaguid": {
+                    "description": "Authenticator model, zero when not disclosed",
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "algorithm": {
+                    "description": "COSE algorithm",
+                    "type": "integer",
+                    "example": -7
+                },
+                "backup_eligible": {
+                    "description": "Synced passkey rather than a device-bound key",
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-01T08:00:00Z"
+                },
+                "credential_id": {
+                    "description": "base64url, as browsers report it",
+                    "type": "string",
+                    "example": "pX3f0w8sQ1u9kq2Hn7cZbA"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2026-10-16T10:30:45Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "MacBook Touch ID"
+                },
+                "transports": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "internal",
+                        "hybrid"
+                    ]
+                }
+            }
+        },
+        "handlers.AdminPasskeyResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AdminPasskeyDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Passkey registered"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminPasskeysResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AdminPasskeyDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Passkeys retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminReportDTO": {
+            "type": "object",
+            "required": [
+                "columns",
+                "from",
+                "report",
+                "timezone",
+                "to"
+            ],
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "day",
+                        "active_users"
+                    ]
+                },
+                "from": {
+                    "description": "First day covered",
+                    "type": "string",
+                    "example": "2026-10-01"
+                },
+                "report": {
+                    "type": "string",
+                    "example": "daily_active_users"
+                },
+                "rows": {
+                    "description": "One value per column",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {}
+                    }
+                },
+                "timezone": {
+                    "description": "IANA zone of the days",
+                    "type": "string",
+                    "example": "Asia/Bishkek"
+                },
+                "to": {
+                    "description": "Last day covered, inclusive",
+                    "type": "string",
+                    "example": "2026-10-30"
+                }
+            }
+        },
+        "handlers.AdminReportResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AdminReportDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Report computed successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "warning": {
+                    "description": "Set when gate opens could not be attributed to locations",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.AdminResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AdminData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Admin created successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AdminSearchResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.SearchResultDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Search completed successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "warning": {
+                    "description": "Set when locations and gates could not be searched",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.AdminsListResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AdminDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Admins retrieved successfully"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/handlers.PaginationMeta"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.ApproveRegistrationRequest": {
+            "type": "object",
+            "properties": {
+                "locations": {
+                    "description": "Optional - locations and gates assigned to the user on approval",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationAssignmentRequest"
+                    }
+                }
+            }
+        },
+        "handlers.AuditCommentDTO": {
+            "type": "object",
+            "properties": {
+                "admin_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "admin_name": {
+                    "type": "string",
+                    "example": "security_lead"
+                },
+                "body": {
+                    "type": "string",
+                    "example": "Confirmed with the admin, the bulk delete was a planned cleanup"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "resolution": {
+                    "description": "The comment closes the investigation",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AuditCommentResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AuditCommentDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Comment added"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AuditLogDetailDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "\"create_user\", \"update_user\", \"delete_user\", \"create_admin\", \"delete_admin\", \"update_contact\", etc.",
+                    "type": "string"
+                },
+                "admin_id": {
+                    "description": "Who performed the action",
+                    "type": "string"
+                },
+                "admin_name": {
+                    "description": "Admin username for quick access (denormalized)",
+                    "type": "string"
+                },
+                "comments": {
+                    "description": "Oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AuditCommentDTO"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "details": {
+                    "description": "JSON with request details (what was changed)",
+                    "type": "string"
+                },
+                "error_message": {
+                    "description": "Error message if failed",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "description": "Request IP address",
+                    "type": "string"
+                },
+                "resolved": {
+                    "description": "A resolution note was added",
+                    "type": "boolean",
+                    "example": false
+                },
+                "resource_id": {
+                    "description": "UUID or ID of affected resource",
+                    "type": "string"
+                },
+                "resource_type": {
+                    "description": "\"user\", \"admin\", \"contact\", etc.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "\"success\" or \"failed\"",
+                    "type": "string"
+                },
+                "user_agent": {
+                    "description": "Request user agent",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.AuditLogDetailResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.AuditLogDetailDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Audit log retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.AvailableLocationsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Available locations retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.BulkDeleteResultDTO": {
+            "type": "object",
+            "properties": {
+                "skipped": {
+                    "description": "Users that do not exist, were already in the trash, or failed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "trashed": {
+                    "description": "Users moved to the trash",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.BulkDeleteResultResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.BulkDeleteResultDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "2 user(s) moved to the trash"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.BulkDeleteSummaryDTO": {
+            "type": "object",
+            "properties": {
+                "confirmation_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "count": {
+                    "description": "Users that will be moved to the trash",
+                    "type": "integer",
+                    "example": 2
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:35:00Z"
+                },
+                "skipped": {
+                    "description": "Requested users that do not exist or are already in the trash",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.UserDTO"
+                    }
+                }
+            }
+        },
+        "handlers.BulkDeleteSummaryResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.BulkDeleteSummaryDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "2 user(s) will be moved to the trash. Send the request again with confirmation_token to proceed"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.BulkDeleteUsersRequest": {
+            "type": "object",
+            "required": [
+                "user_ids"
+            ],
+            "properties": {
+                "confirmation_token": {
+                    "description": "Token from the summary response; omit to get a summary",
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.CORSOriginDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "origin": {
+                    "type": "string",
+                    "example": "https://admin.example.com"
+                },
+                "scope": {
+                    "description": "\"public\" or \"admin\"",
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "handlers.CORSOriginResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.CORSOriginDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "CORS origin added successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.CORSOriginsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.CORSOriginDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "CORS origins retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string",
+                    "example": "password123"
+                },
+                "email": {
+                    "description": "Alternative to phone; only verified emails can be used",
+                    "type": "string",
+                    "example": "staff@example.com"
+                },
+                "new_password": {
+                    "type": "string",
+                    "example": "newpassword456"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                }
+            }
+        },
+        "handlers.ConfigReloadDTO": {
+            "type": "object",
+            "properties": {
+                "changed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "THIRD_PARTY_RATE_LIMIT",
+                        "CORS_ALLOWED_ORIGINS"
+                    ]
+                },
+                "reloadable": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "CORS_ALLOWED_ORIGINS",
+                        "THIRD_PARTY_RATE_LIMIT",
+                        "ADMIN_QUOTA_HOURLY"
+                    ]
+                }
+            }
+        },
+        "handlers.ConfigReloadResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.ConfigReloadDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Configuration reloaded, 2 setting(s) changed"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.ContactDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "г. Бишкек, проспект Чуй, 135"
+                },
+                "email_support": {
+                    "type": "string",
+                    "example": "support@ololo.com"
+                },
+                "support_number": {
+                    "type": "integer",
+                    "example": 77091234567
+                }
+            }
+        },
+        "handlers.ContactResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.ContactDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Contact information retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Acme HR (Okta)"
+                },
+                "scopes": {
+                    "description": "What the key can access: scim, sync",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "scim"
+                    ]
+                }
+            }
+        },
+        "handlers.CreateAdminRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "role",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "password123"
+                },
+                "role": {
+                    "description": "\"super\", \"regular\" or \"auditor\" (read-only)",
+                    "type": "string",
+                    "example": "regular"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "newadmin"
+                }
+            }
+        },
+        "handlers.CreateAuditCommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Up to 4000 characters",
+                    "type": "string",
+                    "example": "Confirmed with the admin, the bulk delete was a planned cleanup"
+                },
+                "resolution": {
+                    "description": "Mark the comment as the resolution of the investigation",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.CreateCORSOriginRequest": {
+            "type": "object",
+            "required": [
+                "origin",
+                "scope"
+            ],
+            "properties": {
+                "origin": {
+                    "type": "string",
+                    "example": "https://admin.example.com"
+                },
+                "scope": {
+                    "description": "\"public\" or \"admin\"",
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "handlers.CreateExportRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "format": {
+                    "description": "report only: csv (default) or json",
+                    "type": "string",
+                    "example": "csv"
+                },
+                "from": {
+                    "description": "First day in the request's timezone, YYYY-MM-DD (defaults to 29 days before to)",
+                    "type": "string",
+                    "example": "2026-09-01"
+                },
+                "gate_id": {
+                    "description": "gate_events only: only this gate",
+                    "type": "integer",
+                    "example": 10
+                },
+                "location_id": {
+                    "description": "gate_events only: only gates of this location",
+                    "type": "integer",
+                    "example": 1
+                },
+                "report": {
+                    "description": "report only: report name, as for GET /admin/reports",
+                    "type": "string",
+                    "example": "gate_opens"
+                },
+                "to": {
+                    "description": "Last day, inclusive, YYYY-MM-DD (defaults to today)",
+                    "type": "string",
+                    "example": "2026-09-30"
+                },
+                "type": {
+                    "description": "gate_events or report",
+                    "type": "string",
+                    "example": "gate_events"
+                },
+                "user_id": {
+                    "description": "gate_events only: only commands issued by this user",
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "handlers.CreateForcedLogoutRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "description": "Kept in the audit log and shown to admins",
+                    "type": "string",
+                    "example": "Incident #42: signing keys may have leaked"
+                }
+            }
+        },
+        "handlers.CreateGateLinkRequest": {
+            "type": "object",
+            "properties": {
+                "expires_in_minutes": {
+                    "description": "Link lifetime (default GATE_LINK_TTL, capped at GATE_LINK_MAX_TTL)",
+                    "type": "integer",
+                    "example": 60
+                },
+                "label": {
+                    "description": "Optional note shown with the link",
+                    "type": "string",
+                    "example": "Courier"
+                }
+            }
+        },
+        "handlers.CreateInviteCodeRequest": {
+            "type": "object",
+            "properties": {
+                "expires_in_hours": {
+                    "description": "Optional - code lifetime (0 = never expires)",
+                    "type": "integer",
+                    "example": 168
+                },
+                "locations": {
+                    "description": "Optional - assigned to users registering with the code",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationAssignmentRequest"
+                    }
+                },
+                "max_uses": {
+                    "description": "Optional - registrations allowed (0 = unlimited)",
+                    "type": "integer",
+                    "example": 50
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Building 4 residents"
+                }
+            }
+        },
+        "handlers.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "phone"
+            ],
+            "properties": {
+                "email": {
+                    "description": "Optional - alternative login identifier, verified when set by an admin",
+                    "type": "string",
+                    "example": "staff@example.com"
+                },
+                "locations": {
+                    "description": "Optional - if provided, will assign user to these locations and gates",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationAssignmentRequest"
+                    }
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "password123"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                }
+            }
+        },
+        "handlers.CreatedAPIKeyDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-01T08:00:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "ogk_Q2x9fAbT0kZ1pLr8yW3nVd6sHc4jXe7uGm5oIq2aRtY"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2026-10-16T10:30:45Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Acme HR (Okta)"
+                },
+                "prefix": {
+                    "description": "First characters of the key, to recognize it",
+                    "type": "string",
+                    "example": "ogk_Q2x9fA"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "scim"
+                    ]
+                }
+            }
+        },
+        "handlers.CreatedAPIKeyResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.CreatedAPIKeyDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "API key created successfully. Store the key now, it cannot be shown again"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.DigestRunDTO": {
+            "type": "object",
+            "properties": {
+                "eligible": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2025-01-13T10:00:42Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "inactive_since": {
+                    "description": "Users without a gate open since then were eligible",
+                    "type": "string",
+                    "example": "2024-12-16T10:00:00Z"
+                },
+                "opted_out": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "sent": {
+                    "type": "integer",
+                    "example": 104
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-01-13T10:00:00Z"
+                }
+            }
+        },
+        "handlers.DigestRunsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DigestRunDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Digest runs retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.DuplicateUserGroupDTO": {
+            "type": "object",
+            "properties": {
+                "phone": {
+                    "description": "Normalized number the users share",
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "users": {
+                    "description": "Oldest first; the oldest account is the suggested merge target",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.UserDTO"
+                    }
+                }
+            }
+        },
+        "handlers.DuplicateUsersResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.DuplicateUserGroupDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Duplicate users retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.EmergencyOpenRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Ambulance at the gate"
+                }
+            }
+        },
+        "handlers.ExportDTO": {
+            "type": "object",
+            "required": [
+                "id",
+                "status",
+                "type"
+            ],
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "text/csv"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-01T10:00:00Z"
+                },
+                "download_url": {
+                    "description": "Signed link to the file, once ready",
+                    "type": "string",
+                    "example": "https://api.example.com/api/v1/files/..."
+                },
+                "download_url_expires_at": {
+                    "type": "string",
+                    "example": "2026-10-01T10:15:30Z"
+                },
+                "error": {
+                    "description": "Why the export failed",
+                    "type": "string",
+                    "example": ""
+                },
+                "expires_at": {
+                    "description": "When the finished export is deleted",
+                    "type": "string",
+                    "example": "2026-10-02T10:00:30Z"
+                },
+                "filename": {
+                    "description": "Set once ready",
+                    "type": "string",
+                    "example": "gate_events_2026-09-01_2026-09-30.csv"
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2026-10-01T10:00:30Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "requested_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "size_bytes": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2026-10-01T10:00:01Z"
+                },
+                "status": {
+                    "description": "pending, running, ready or failed",
+                    "type": "string",
+                    "example": "ready"
+                },
+                "type": {
+                    "description": "gate_events or report",
+                    "type": "string",
+                    "example": "gate_events"
+                }
+            }
+        },
+        "handlers.ExportResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.ExportDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Export retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.FieldChangeDTO": {
+            "type": "object",
+            "properties": {
+                "after": {},
+                "before": {
+                    "description": "null when the field was not set or its previous value is unknown"
+                }
+            }
+        },
+        "handlers.ForcedLogoutDTO": {
+            "type": "object",
+            "required": [
+                "id",
+                "reason",
+                "status"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-01T10:00:00Z"
+                },
+                "error": {
+                    "description": "Why the forced logout failed",
+                    "type": "string",
+                    "example": ""
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2026-10-01T10:02:30Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "percent": {
+                    "type": "integer",
+                    "example": 37
+                },
+                "processed": {
+                    "description": "Users signed out so far",
+                    "type": "integer",
+                    "example": 4500
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Incident #42: signing keys may have leaked"
+                },
+                "requested_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2026-10-01T10:00:01Z"
+                },
+                "status": {
+                    "description": "pending, running, completed or failed",
+                    "type": "string",
+                    "example": "running"
+                },
+                "total": {
+                    "description": "Users to sign out, counted when the job starts",
+                    "type": "integer",
+                    "example": 12000
+                }
+            }
+        },
+        "handlers.ForcedLogoutResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.ForcedLogoutDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Forced logout retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.ForgotPasswordRequest": {
+            "type": "object",
+            "required": [
+                "phone"
+            ],
+            "properties": {
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                }
+            }
+        },
+        "handlers.FreezeLocationRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "ends_at": {
+                    "description": "Optional - frozen until lifted when omitted",
+                    "type": "string",
+                    "example": "2025-01-16T06:00:00Z"
+                },
+                "reason": {
+                    "description": "Shown to users whose open is refused",
+                    "type": "string",
+                    "example": "Fire alarm, building in lockdown"
+                },
+                "starts_at": {
+                    "description": "Optional - defaults to now",
+                    "type": "string",
+                    "example": "2025-01-15T22:00:00Z"
+                }
+            }
+        },
+        "handlers.GateActionData": {
+            "type": "object",
+            "properties": {
+                "command_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "command_status": {
+                    "description": "accepted, queued, executing, confirmed or failed",
+                    "type": "string",
+                    "example": "executing"
+                },
+                "gate_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sandbox": {
+                    "description": "Executed by the gate simulator (SANDBOX_MODE), no barrier moved",
+                    "type": "boolean",
+                    "example": false
+                },
+                "status": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateActionResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.GateActionData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate operation completed successfully"
+                },
+                "retry_strategy": {
+                    "description": "Set when the provider is unavailable: poll a queued command, retry a failed one",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/middleware.RetryStrategy"
+                        }
+                    ]
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateCommandCallbackRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "error": {
+                    "description": "Optional failure reason",
+                    "type": "string",
+                    "example": "Barrier obstructed"
+                },
+                "status": {
+                    "description": "\"confirmed\" or \"failed\"",
+                    "type": "string",
+                    "example": "confirmed"
+                }
+            }
+        },
+        "handlers.GateCommandDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "open"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:03Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "error_message": {
+                    "type": "string",
+                    "example": ""
+                },
+                "gate_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "sandbox": {
+                    "description": "Executed by the gate simulator (SANDBOX_MODE), no barrier moved",
+                    "type": "boolean",
+                    "example": false
+                },
+                "status": {
+                    "description": "accepted, queued, executing, confirmed or failed",
+                    "type": "string",
+                    "example": "confirmed"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:03Z"
+                }
+            }
+        },
+        "handlers.GateCommandResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.GateCommandDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate command retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Main vehicle entrance for visitors. Controlled by biometric access, opens in 3 seconds with safety sensors."
+                },
+                "gate_is_horizontal": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_open": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "location_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "maintenance_note": {
+                    "description": "Present while under maintenance",
+                    "type": "string",
+                    "example": "Barrier motor replacement until Friday"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Автоматический Шлагбаум №12"
+                },
+                "under_maintenance": {
+                    "description": "Shown greyed out - opens and closes are refused",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.GateEventLogDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "open"
+                },
+                "command_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "gate_id": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "192.168.1.1"
+                },
+                "latency_ms": {
+                    "type": "integer",
+                    "example": 420
+                },
+                "location_id": {
+                    "description": "Unknown for gates not assigned to the user",
+                    "type": "integer",
+                    "example": 4
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate operation completed"
+                },
+                "result": {
+                    "description": "sent, queued, refused or failed",
+                    "type": "string",
+                    "example": "sent"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                }
+            }
+        },
+        "handlers.GateEventLogsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.GateEventLogDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate events retrieved successfully"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/handlers.PaginationMeta"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateLinkDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "deep_link": {
+                    "type": "string",
+                    "example": "ololo-gate://links/550e8400-e29b-41d4-a716-446655440000?sig=kP3q9xX0..."
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-16T10:30:00Z"
+                },
+                "gate_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "gate_title": {
+                    "type": "string",
+                    "example": "Автоматический Шлагбаум №12"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Courier"
+                },
+                "location_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "signature": {
+                    "type": "string",
+                    "example": "kP3q9xX0..."
+                },
+                "universal_link": {
+                    "description": "Empty when GATE_LINK_BASE_URL is not set",
+                    "type": "string",
+                    "example": "https://app.example.com/links/550e8400-e29b-41d4-a716-446655440000?sig=kP3q9xX0..."
+                }
+            }
+        },
+        "handlers.GateLinkMetadataDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-16T10:30:00Z"
+                },
+                "gate_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "gate_title": {
+                    "type": "string",
+                    "example": "Автоматический Шлагбаум №12"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Courier"
+                },
+                "location_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handlers.GateLinkMetadataResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.GateLinkMetadataDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Link is valid"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateLinkResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.GateLinkDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate link created"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateMaintenanceDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "When the gate went under maintenance",
+                    "type": "string",
+                    "example": "2025-01-15T08:00:00Z"
+                },
+                "gate_id": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Barrier motor replacement until Friday"
+                },
+                "started_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-15T09:30:00Z"
+                }
+            }
+        },
+        "handlers.GateMaintenanceRefusedData": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "gate_maintenance"
+                },
+                "gate_id": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Barrier motor replacement until Friday"
+                },
+                "since": {
+                    "type": "string",
+                    "example": "2025-01-15T08:00:00Z"
+                }
+            }
+        },
+        "handlers.GateMaintenanceRefusedResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.GateMaintenanceRefusedData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate is under maintenance: Barrier motor replacement until Friday"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.GateMaintenanceResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.GateMaintenanceDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate under maintenance"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateMaintenancesResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.GateMaintenanceDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate maintenance retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateReportDTO": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "stuck_open, stuck_closed, damaged or other",
+                    "type": "string",
+                    "example": "stuck_closed"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Barrier does not lift, the motor hums"
+                },
+                "gate_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "gate_title": {
+                    "type": "string",
+                    "example": "Автоматический Шлагбаум №12"
+                },
+                "has_photo": {
+                    "description": "Admins download it from /admin/gate-reports/:id/photo",
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "location_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "resolution_note": {
+                    "type": "string",
+                    "example": "Motor replaced"
+                },
+                "resolved_at": {
+                    "type": "string",
+                    "example": "2025-01-15T12:00:00Z"
+                },
+                "resolved_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "status": {
+                    "description": "open or resolved",
+                    "type": "string",
+                    "example": "open"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+                }
+            }
+        },
+        "handlers.GateReportResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.GateReportDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Problem reported, the facility team has been notified"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GateReportsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.GateReportDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gate reports retrieved successfully"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/handlers.PaginationMeta"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.GatesListResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.GateDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gates retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.HealthCheckResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "status",
+                "success"
+            ],
+            "properties": {
+                "environment": {
+                    "type": "string",
+                    "example": "production"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Ololo Gate API is running"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "healthy"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "timestamp": {
+                    "description": "Details are left out without the HEALTH_TOKEN, when it is set",
+                    "type": "string",
+                    "example": "2025-01-15T10:30:45Z"
+                },
+                "uptime": {
+                    "type": "string",
+                    "example": "1h30m45s"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "1.0.0"
+                }
+            }
+        },
+        "handlers.ImpersonateUserRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "block_gate_operations": {
+                    "description": "Refuse gate open/close with the token (defaults to true unless IMPERSONATION_ALLOW_GATE_OPERATIONS is set)",
+                    "type": "boolean",
+                    "example": true
+                },
+                "reason": {
+                    "description": "Why the admin needs to act as the user (kept in the audit log)",
+                    "type": "string",
+                    "example": "Ticket #1234: resident cannot see their gates"
+                }
+            }
+        },
+        "handlers.ImpersonationResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.ImpersonationTokenDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Impersonation token issued"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.ImpersonationTokenDTO": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:45:00Z"
+                },
+                "expires_in": {
+                    "description": "Seconds; no refresh token is issued",
+                    "type": "integer",
+                    "example": 900
+                },
+                "gate_operations_blocked": {
+                    "description": "Gate open/close is refused with this token",
+                    "type": "boolean",
+                    "example": true
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "handlers.ImportedAdminDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "password": {
+                    "description": "Shown only in this response",
+                    "type": "string",
+                    "example": "Xq3v9LmT2kPa8Rz1"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "regular"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "desk-north"
+                }
+            }
+        },
+        "handlers.InviteCodeDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Not revoked, expired or used up",
+                    "type": "boolean",
+                    "example": true
+                },
+                "code": {
+                    "type": "string",
+                    "example": "7KQ2MXR4PA"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-22T10:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "locations": {
+                    "description": "Assigned to users registering with the code",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationAssignmentRequest"
+                    }
+                },
+                "max_uses": {
+                    "description": "0 = unlimited",
+                    "type": "integer",
+                    "example": 50
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Building 4 residents"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "uses": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "handlers.InviteCodeResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.InviteCodeDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Invite code generated successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.InviteCodesResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.InviteCodeDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Invite codes retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.JobRunDTO": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2025-01-01T03:00:02Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "api-1-3f2a9c1d"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-01-01T03:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "succeeded"
+                }
+            }
+        },
+        "handlers.LegalDocumentDTO": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "1. Use of the service..."
+                },
+                "kind": {
+                    "description": "\"terms\" or \"privacy\"",
+                    "type": "string",
+                    "example": "terms"
+                },
+                "published_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Terms of Service"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "2025-01"
+                }
+            }
+        },
+        "handlers.LegalDocumentResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.LegalDocumentDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Legal document retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.LegalDocumentsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LegalDocumentDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Legal documents retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.LegalStatusDTO": {
+            "type": "object",
+            "properties": {
+                "acceptance_required": {
+                    "description": "The current version has not been accepted",
+                    "type": "boolean",
+                    "example": true
+                },
+                "accepted_at": {
+                    "type": "string",
+                    "example": "2024-06-01T09:00:00Z"
+                },
+                "accepted_version": {
+                    "description": "Latest version the user accepted",
+                    "type": "string",
+                    "example": "2024-06"
+                },
+                "current_version": {
+                    "type": "string",
+                    "example": "2025-01"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "terms"
+                }
+            }
+        },
+        "handlers.LegalStatusResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LegalStatusDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Legal acceptance status retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.LocationAssignmentRequest": {
+            "type": "object",
+            "required": [
+                "gateIds",
+                "locationId"
+            ],
+            "properties": {
+                "gateIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "locationId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handlers.LocationDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "г. Бишкек, проспект Чуй, 135"
+                },
+                "gates": {
+                    "description": "Always include gates, even if empty array",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.GateDTO"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "logo": {
+                    "type": "string",
+                    "example": "https://picsum.photos/seed/alatoo/200"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Торгово-развлекательный центр Ала-Тоо"
+                }
+            }
+        },
+        "handlers.LocationFreezeDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Blocking opens right now",
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T21:55:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "ends_at": {
+                    "description": "Absent when frozen until lifted",
+                    "type": "string",
+                    "example": "2025-01-16T06:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "lifted_at": {
+                    "type": "string",
+                    "example": "2025-01-16T01:00:00Z"
+                },
+                "lifted_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "location_id": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Fire alarm, building in lockdown"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2025-01-15T22:00:00Z"
+                }
+            }
+        },
+        "handlers.LocationFreezeResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.LocationFreezeDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Location frozen"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.LocationFreezesResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationFreezeDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Freezes retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.LocationFrozenData": {
+            "type": "object",
+            "properties": {
+                "ends_at": {
+                    "description": "Absent when frozen until lifted",
+                    "type": "string",
+                    "example": "2025-01-16T06:00:00Z"
+                },
+                "location_id": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Fire alarm, building in lockdown"
+                }
+            }
+        },
+        "handlers.LocationFrozenResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.LocationFrozenData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Gates at this location are frozen until 2025-01-16T06:00:00Z: Fire alarm, building in lockdown"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.LocationOverrideDTO": {
+            "type": "object",
+            "required": [
+                "location_id"
+            ],
+            "properties": {
+                "display_name": {
+                    "type": "string",
+                    "example": "Ala-Too Mall"
+                },
+                "location_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "logo": {
+                    "type": "string",
+                    "example": "https://cdn.example.com/logos/alatoo.png"
+                },
+                "sort_order": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-10-01T10:00:00Z"
+                },
+                "updated_by": {
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "handlers.LocationOverrideRequest": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "description": "Replaces the provider's title (empty = keep it)",
+                    "type": "string",
+                    "example": "Ala-Too Mall"
+                },
+                "logo": {
+                    "description": "http(s) URL replacing the provider's logo (empty = keep it)",
+                    "type": "string",
+                    "example": "https://cdn.example.com/logos/alatoo.png"
+                },
+                "sort_order": {
+                    "description": "Lower is listed first (null = provider order, after sorted locations)",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handlers.LocationOverrideResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.LocationOverrideDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Override saved"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.LocationOverridesResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationOverrideDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Overrides retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.LocationsListResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "cached_at": {
+                    "description": "When the cached data was loaded (degraded only)",
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationDTO"
+                    }
+                },
+                "degraded": {
+                    "description": "true when the provider is down and cached data is served",
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Locations retrieved successfully"
+                },
+                "retry_strategy": {
+                    "description": "When to reload for fresh data (degraded only)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/middleware.RetryStrategy"
+                        }
+                    ]
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.LoginData": {
+            "type": "object",
+            "required": [
+                "access_expires_in",
+                "access_token",
+                "id",
+                "phone",
+                "refresh_expires_in",
+                "refresh_token"
+            ],
+            "properties": {
+                "access_expires_in": {
+                    "type": "integer",
+                    "example": 900
+                },
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "refresh_expires_in": {
+                    "type": "integer",
+                    "example": 2592000
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "handlers.LoginOTPConfirmRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "phone"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "482913"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "trusted_device": {
+                    "description": "\"Remember me\": use the long refresh expiry (JWT_TRUSTED_REFRESH_EXPIRY)",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.LoginOTPRequest": {
+            "type": "object",
+            "required": [
+                "phone"
+            ],
+            "properties": {
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                }
+            }
+        },
+        "handlers.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "description": "Alternative to phone; only verified emails can log in",
+                    "type": "string",
+                    "example": "staff@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "password123"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "trusted_device": {
+                    "description": "\"Remember me\": use the long refresh expiry (JWT_TRUSTED_REFRESH_EXPIRY)",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.LoginResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.LoginData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Login successful"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.MergeUsersRequest": {
+            "type": "object",
+            "required": [
+                "source_ids"
+            ],
+            "properties": {
+                "source_ids": {
+                    "description": "Users to merge into the target and delete",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.MergeUsersResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.MergeUsersResultDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Users merged successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "warning": {
+                    "description": "Set when consolidating gate access failed",
+                    "type": "string",
+                    "example": "Third-party API assignment error: ..."
+                }
+            }
+        },
+        "handlers.MergeUsersResultDTO": {
+            "type": "object",
+            "properties": {
+                "email_moved": {
+                    "description": "The target took over a merged user's email",
+                    "type": "boolean",
+                    "example": false
+                },
+                "gate_commands_moved": {
+                    "description": "Gate command history moved",
+                    "type": "integer",
+                    "example": 15
+                },
+                "merged_user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "phones_added": {
+                    "description": "Numbers added as verified secondary numbers",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sessions_moved": {
+                    "description": "Device sessions moved (revoked; the devices log in again)",
+                    "type": "integer",
+                    "example": 2
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "handlers.NotificationPreferencesDTO": {
+            "type": "object",
+            "properties": {
+                "digest": {
+                    "description": "SMS digest after weeks without opening a gate",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.NotificationPreferencesResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.NotificationPreferencesDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Notification preferences retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.PaginatedAuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AdminAuditLog"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Audit logs retrieved successfully"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/handlers.PaginationMeta"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.PaginationMeta": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "estimated": {
+                    "description": "Total is the planner's estimate (count=estimate)",
+                    "type": "boolean",
+                    "example": false
+                },
+                "last_page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "per_page": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "handlers.PasskeyCreationOptionsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/services.PasskeyCreationOptions"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Registration options created"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.PasskeyLoginOptionsRequest": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "description": "Optional: restricts the login to this admin's passkeys",
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
+        },
+        "handlers.PasskeyLoginRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "description": "Credential ID, base64url",
+                    "type": "string",
+                    "example": "pX3f0w8sQ1u9kq2Hn7cZbA"
+                },
+                "response": {
+                    "type": "object",
+                    "required": [
+                        "authenticatorData",
+                        "clientDataJSON",
+                        "signature"
+                    ],
+                    "properties": {
+                        "authenticatorData": {
+                            "type": "string"
+                        },
+                        "clientDataJSON": {
+                            "type": "string"
+                        },
+                        "signature": {
+                            "type": "string"
+                        },
+                        "userHandle": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "example": "public-key"
+                }
+            }
+        },
+        "handlers.PasskeyRegistrationRequest": {
+            "type": "object",
+            "properties": {
+                "credential": {
+                    "type": "object",
+                    "required": [
+                        "id"
+                    ],
+                    "properties": {
+                        "id": {
+                            "type": "string",
+                            "example": "pX3f0w8sQ1u9kq2Hn7cZbA"
+                        },
+                        "response": {
+                            "type": "object",
+                            "required": [
+                                "attestationObject",
+                                "clientDataJSON"
+                            ],
+                            "properties": {
+                                "attestationObject": {
+                                    "type": "string"
+                                },
+                                "clientDataJSON": {
+                                    "type": "string"
+                                },
+                                "transports": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "string"
+                                    },
+                                    "example": [
+                                        "internal",
+                                        "hybrid"
+                                    ]
+                                }
+                            }
+                        },
+                        "type": {
+                            "type": "string",
+                            "example": "public-key"
+                        }
+                    }
+                },
+                "name": {
+                    "description": "Label shown in the passkey list",
+                    "type": "string",
+                    "example": "MacBook Touch ID"
+                }
+            }
+        },
+        "handlers.PasskeyRequestOptionsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/services.PasskeyRequestOptions"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Login options created"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.PasswordExpiredData": {
+            "type": "object",
+            "required": [
+                "code",
+                "password_changed_at"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "password_expired"
+                },
+                "password_changed_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:45Z"
+                }
+            }
+        },
+        "handlers.PasswordExpiredResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.PasswordExpiredData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Your password has expired and must be changed"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.PhoneAvailabilityResponse": {
+            "type": "object",
+            "required": [
+                "available",
+                "message",
+                "success"
+            ],
+            "properties": {
+                "available": {
+                    "description": "true if phone is available, false if already in use",
+                    "type": "boolean",
+                    "example": true
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Phone availability checked"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.ProviderMigrationReportDTO": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "description": "Whether writes are being mirrored now (THIRD_PARTY_MIRROR_API_URL is set)",
+                    "type": "boolean",
+                    "example": true
+                },
+                "mismatches": {
+                    "description": "Most recent first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ProviderMirrorMismatchDTO"
+                    }
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ProviderMirrorOperationDTO"
+                    }
+                },
+                "since": {
+                    "type": "string",
+                    "example": "2026-10-09T10:30:45Z"
+                }
+            }
+        },
+        "handlers.ProviderMigrationReportResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.ProviderMigrationReportDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Provider migration report retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.ProviderMirrorMismatchDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-16T10:30:45Z"
+                },
+                "gate_id": {
+                    "description": "Set for gate commands",
+                    "type": "integer",
+                    "example": 40
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "mirror_detail": {
+                    "type": "string",
+                    "example": "migration provider returned status code 404"
+                },
+                "mirror_outcome": {
+                    "type": "string",
+                    "example": "error:not_found"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "open_gate"
+                },
+                "phone": {
+                    "description": "Set for assignments",
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "primary_outcome": {
+                    "description": "ok, ok:\u003cresult\u003e or error:\u003ckind\u003e",
+                    "type": "string",
+                    "example": "ok:true"
+                }
+            }
+        },
+        "handlers.ProviderMirrorOperationDTO": {
+            "type": "object",
+            "properties": {
+                "matched": {
+                    "type": "integer",
+                    "example": 118
+                },
+                "mirror_errors": {
+                    "description": "Calls the migration provider failed, whether or not the current provider failed too",
+                    "type": "integer",
+                    "example": 1
+                },
+                "mismatched": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "operation": {
+                    "description": "assign_user, open_gate or close_gate",
+                    "type": "string",
+                    "example": "assign_user"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "handlers.ProviderUsageDTO": {
+            "type": "object",
+            "required": [
+                "month",
+                "provider"
+            ],
+            "properties": {
+                "calls": {
+                    "type": "integer",
+                    "example": 81234
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2026-10"
+                },
+                "percent": {
+                    "description": "Share of the quota used, 0 without a quota",
+                    "type": "number",
+                    "example": 81.2
+                },
+                "provider": {
+                    "description": "primary or mirror (the migration provider)",
+                    "type": "string",
+                    "example": "primary"
+                },
+                "quota": {
+                    "description": "THIRD_PARTY_MONTHLY_QUOTA or THIRD_PARTY_MIRROR_MONTHLY_QUOTA, 0 = no quota",
+                    "type": "integer",
+                    "example": 100000
+                },
+                "warned": {
+                    "description": "Thresholds admins were warned about this month",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        80
+                    ]
+                }
+            }
+        },
+        "handlers.ProviderUsageResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ProviderUsageDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Provider usage retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.PublishLegalDocumentRequest": {
+            "type": "object",
+            "required": [
+                "content",
+                "kind",
+                "version"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "1. Use of the service..."
+                },
+                "kind": {
+                    "description": "\"terms\" or \"privacy\"",
+                    "type": "string",
+                    "example": "terms"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Terms of Service"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "2025-01"
+                }
+            }
+        },
+        "handlers.RefreshData": {
+            "type": "object",
+            "required": [
+                "access_token"
+            ],
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "handlers.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "handlers.RefreshResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.RefreshData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Token refreshed successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.RegisterData": {
+            "type": "object",
+            "required": [
+                "id",
+                "phone"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                }
+            }
+        },
+        "handlers.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "phone"
+            ],
+            "properties": {
+                "invite_code": {
+                    "description": "Required unless USER_OPEN_REGISTRATION is enabled",
+                    "type": "string",
+                    "example": "7KQ2MXR4PA"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "password123"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                }
+            }
+        },
+        "handlers.RegisterResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.RegisterData"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "User registered successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.RegisteredRoutesResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.RouteDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Routes retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.RegistrationDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "rejection_reason": {
+                    "type": "string",
+                    "example": "Not a resident of this building"
+                },
+                "reviewed_at": {
+                    "type": "string",
+                    "example": "2025-01-15T11:00:00Z"
+                },
+                "reviewed_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "status": {
+                    "description": "pending, rejected or approved",
+                    "type": "string",
+                    "example": "pending"
+                }
+            }
+        },
+        "handlers.RegistrationResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.RegistrationDTO"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Registration approved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "warning": {
+                    "description": "Set when assigning locations/gates or the welcome SMS failed",
+                    "type": "string",
+                    "example": "Welcome SMS error: ..."
+                }
+            }
+        },
+        "handlers.RegistrationsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.RegistrationDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Registrations retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.RejectRegistrationRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Not a resident of this building"
+                }
+            }
+        },
+        "handlers.RenamePasskeyRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "YubiKey 5C"
                 }
             }
         },
-        "handlers.AdminDTO": {
+        "handlers.ResendRegistrationOTPRequest": {
             "type": "object",
             "required": [
-                "created_at",
-                "id",
-                "role",
-                "updated_at",
-                "username"
+                "phone"
             ],
             "properties": {
-                "created_at": {
-                    "type": "string",
-                    "example": "2025-01-15T10:30:00Z"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "00000000-0000-0000-0000-000000000001"
-                },
-                "role": {
-                    "type": "string",
-                    "example": "super"
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2025-01-15T10:30:00Z"
-                },
-                "username": {
+                "phone": {
                     "type": "string",
-                    "example": "admin"
+                    "example": "+77771234567"
                 }
             }
         },
-        "handlers.AdminData": {
+        "handlers.ResetPasswordRequest": {
             "type": "object",
             "required": [
-                "id",
-                "role",
-                "username"
+                "code",
+                "new_password",
+                "phone"
             ],
             "properties": {
-                "id": {
+                "code": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                    "example": "482913"
                 },
-                "role": {
+                "new_password": {
                     "type": "string",
-                    "example": "regular"
+                    "example": "newpassword456"
                 },
-                "username": {
+                "phone": {
                     "type": "string",
-                    "example": "newadmin"
+                    "example": "+77771234567"
                 }
             }
         },
-        "handlers.AdminDetailData": {
+        "handlers.ResolveGateReportRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "description": "What was done, shown with the report",
+                    "type": "string",
+                    "example": "Motor replaced"
+                }
+            }
+        },
+        "handlers.RotatedWebhookSecretDTO": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string",
-                    "example": "2025-01-15T10:30:00Z"
+                    "example": "2026-10-16T10:30:45Z"
                 },
-                "id": {
+                "created_by": {
                     "type": "string",
-                    "example": "00000000-0000-0000-0000-000000000001"
+                    "example": "admin"
                 },
-                "role": {
+                "current": {
+                    "description": "False for the previous secret, still valid until expires_at",
+                    "type": "boolean",
+                    "example": true
+                },
+                "expires_at": {
                     "type": "string",
-                    "example": "super"
+                    "example": "2026-10-17T10:30:45Z"
                 },
-                "updated_at": {
+                "hint": {
+                    "description": "Last characters of the secret, to recognize it",
                     "type": "string",
-                    "example": "2025-01-15T10:30:00Z"
+                    "example": "x7Qa"
                 },
-                "username": {
+                "id": {
                     "type": "string",
-                    "example": "admin"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "scope": {
+                    "description": "outbound or provider_callback",
+                    "type": "string",
+                    "example": "outbound"
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "whsec_Q2x9fAbT0kZ1pLr8yW3nVd6sHc4jXe7uGm5oIq2aRtY"
                 }
             }
         },
-        "handlers.AdminDetailResponse": {
+        "handlers.RotatedWebhookSecretResponse": {
             "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/handlers.AdminDetailData"
+                    "$ref": "#/definitions/handlers.RotatedWebhookSecretDTO"
                 },
                 "message": {
                     "type": "string",
-                    "example": "Admin retrieved successfully"
+                    "example": "Webhook secret rotated successfully. Store the secret now, it cannot be shown again"
                 },
                 "success": {
                     "type": "boolean",
@@ -1558,173 +12526,280 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.AdminLoginData": {
+        "handlers.RouteDTO": {
             "type": "object",
             "required": [
-                "access_token",
-                "id",
-                "role",
-                "username"
+                "access",
+                "handler",
+                "method",
+                "path"
             ],
             "properties": {
-                "access_token": {
+                "access": {
+                    "description": "\"public\", \"provider_token\", \"user\", \"admin\" or \"super_admin\"",
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                    "example": "super_admin"
                 },
-                "id": {
+                "handler": {
                     "type": "string",
-                    "example": "00000000-0000-0000-0000-000000000001"
+                    "example": "handlers.GetScheduledJobs"
                 },
-                "role": {
+                "method": {
                     "type": "string",
-                    "example": "super"
+                    "example": "GET"
                 },
-                "username": {
+                "middleware": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "middleware.AdminJWTProtected",
+                        "middleware.SuperAdminOnly"
+                    ]
+                },
+                "path": {
                     "type": "string",
-                    "example": "admin"
+                    "example": "/api/v1/admin/jobs"
                 }
             }
         },
-        "handlers.AdminLoginRequest": {
+        "handlers.SCIMEmail": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
+                "primary": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "type": {
                     "type": "string",
-                    "example": "admin"
+                    "example": "work"
                 },
-                "username": {
+                "value": {
                     "type": "string",
-                    "example": "admin"
+                    "example": "resident@example.com"
                 }
             }
         },
-        "handlers.AdminLoginResponse": {
+        "handlers.SCIMError": {
             "type": "object",
-            "required": [
-                "message",
-                "success"
-            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/handlers.AdminLoginData"
+                "detail": {
+                    "type": "string",
+                    "example": "User with this phone number already exists"
                 },
-                "message": {
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scimType": {
                     "type": "string",
-                    "example": "Login successful"
+                    "example": "uniqueness"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "status": {
+                    "type": "string",
+                    "example": "409"
                 }
             }
         },
-        "handlers.AdminResponse": {
+        "handlers.SCIMListResponse": {
             "type": "object",
-            "required": [
-                "message",
-                "success"
-            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/handlers.AdminData"
+                "Resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.SCIMUser"
+                    }
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Admin created successfully"
+                "itemsPerPage": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startIndex": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "totalResults": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "handlers.AdminsListResponse": {
+        "handlers.SCIMMeta": {
             "type": "object",
-            "required": [
-                "message",
-                "success"
-            ],
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handlers.AdminDTO"
-                    }
+                "created": {
+                    "type": "string",
+                    "example": "2026-10-16T10:30:45Z"
                 },
-                "message": {
+                "lastModified": {
                     "type": "string",
-                    "example": "Admins retrieved successfully"
+                    "example": "2026-10-16T10:30:45Z"
                 },
-                "pagination": {
-                    "$ref": "#/definitions/handlers.PaginationMeta"
+                "location": {
+                    "type": "string",
+                    "example": "/api/v1/scim/v2/Users/550e8400-e29b-41d4-a716-446655440000"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "resourceType": {
+                    "type": "string",
+                    "example": "User"
                 }
             }
         },
-        "handlers.AuditLogDetailResponse": {
+        "handlers.SCIMPatchOperation": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/models.AdminAuditLog"
+                "op": {
+                    "description": "add, replace or remove",
+                    "type": "string",
+                    "example": "replace"
                 },
-                "message": {
+                "path": {
+                    "description": "Optional; without a path, value is an object of attributes",
                     "type": "string",
-                    "example": "Audit log retrieved successfully"
+                    "example": "active"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "value": {
+                    "type": "object"
                 }
             }
         },
-        "handlers.AvailableLocationsResponse": {
+        "handlers.SCIMPatchRequest": {
             "type": "object",
-            "required": [
-                "message",
-                "success"
-            ],
             "properties": {
-                "data": {
+                "Operations": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handlers.LocationDTO"
+                        "$ref": "#/definitions/handlers.SCIMPatchOperation"
                     }
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Available locations retrieved successfully"
-                },
-                "success": {
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.SCIMUser": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "false moves the user to the trash",
                     "type": "boolean",
                     "example": true
+                },
+                "emails": {
+                    "description": "The primary email is the user's login email",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.SCIMEmail"
+                    }
+                },
+                "externalId": {
+                    "description": "The identity provider's ID for the user",
+                    "type": "string",
+                    "example": "00u1ab2cd3EF4gh5i6j7"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "meta": {
+                    "$ref": "#/definitions/handlers.SCIMMeta"
+                },
+                "password": {
+                    "description": "Write-only; users created without one get a random password",
+                    "type": "string"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "urn:ololo-gate:params:scim:schemas:extension:2.0:User": {
+                    "$ref": "#/definitions/handlers.SCIMUserExtension"
+                },
+                "userName": {
+                    "description": "Phone number in international format",
+                    "type": "string",
+                    "example": "+77771234567"
                 }
             }
         },
-        "handlers.ContactDTO": {
+        "handlers.SCIMUserExtension": {
             "type": "object",
             "properties": {
-                "address": {
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationAssignmentRequest"
+                    }
+                }
+            }
+        },
+        "handlers.SLOStatusDTO": {
+            "type": "object",
+            "properties": {
+                "compliance": {
+                    "description": "Percent of good requests; 100 without requests",
+                    "type": "number",
+                    "example": 98
+                },
+                "error_budget_remaining": {
+                    "description": "Percent of the allowed bad requests left; negative once overspent",
+                    "type": "number",
+                    "example": 60
+                },
+                "good_requests": {
+                    "type": "integer",
+                    "example": 1176
+                },
+                "latency_target": {
                     "type": "string",
-                    "example": "г. Бишкек, проспект Чуй, 135"
+                    "example": "2s"
                 },
-                "email_support": {
+                "met": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "method": {
                     "type": "string",
-                    "example": "support@ololo.com"
+                    "example": "PUT"
                 },
-                "support_number": {
+                "name": {
+                    "type": "string",
+                    "example": "gate_open"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/api/v1/locations/:gateId/open"
+                },
+                "requests": {
                     "type": "integer",
-                    "example": 77091234567
+                    "example": 1200
+                },
+                "target": {
+                    "description": "Percent of requests that must succeed within latency_target",
+                    "type": "number",
+                    "example": 95
+                },
+                "window": {
+                    "type": "string",
+                    "example": "24h0m0s"
                 }
             }
         },
-        "handlers.ContactResponse": {
+        "handlers.SLOSummaryResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -1732,11 +12807,14 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/handlers.ContactDTO"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.SLOStatusDTO"
+                    }
                 },
                 "message": {
                     "type": "string",
-                    "example": "Contact information retrieved successfully"
+                    "example": "SLO summary retrieved successfully"
                 },
                 "success": {
                     "type": "boolean",
@@ -1744,69 +12822,71 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.CreateAdminRequest": {
+        "handlers.SandboxSMSDTO": {
             "type": "object",
-            "required": [
-                "password",
-                "role",
-                "username"
-            ],
             "properties": {
-                "password": {
+                "message": {
                     "type": "string",
-                    "minLength": 6,
-                    "example": "password123"
+                    "example": "Your Ololo Gate login code is 482913. It expires in 5 minutes. Do not share it with anyone."
                 },
-                "role": {
-                    "description": "\"super\" or \"regular\"",
+                "phone": {
                     "type": "string",
-                    "example": "regular"
+                    "example": "+77771234567"
                 },
-                "username": {
+                "sent_at": {
                     "type": "string",
-                    "example": "newadmin"
+                    "example": "2025-01-15T10:30:00Z"
                 }
             }
         },
-        "handlers.CreateUserRequest": {
+        "handlers.SandboxSMSResponse": {
             "type": "object",
             "required": [
-                "password",
-                "phone"
+                "message",
+                "success"
             ],
             "properties": {
-                "locations": {
-                    "description": "Optional - if provided, will assign user to these locations and gates",
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handlers.LocationAssignmentRequest"
+                        "$ref": "#/definitions/handlers.SandboxSMSDTO"
                     }
                 },
-                "password": {
+                "message": {
                     "type": "string",
-                    "minLength": 6,
-                    "example": "password123"
+                    "example": "Sandbox messages retrieved successfully"
                 },
-                "phone": {
-                    "type": "string",
-                    "example": "+77771234567"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "handlers.GateActionData": {
+        "handlers.ScheduledJobDTO": {
             "type": "object",
             "properties": {
-                "gate_id": {
-                    "type": "integer",
-                    "example": 1
+                "last_run": {
+                    "$ref": "#/definitions/handlers.JobRunDTO"
                 },
-                "status": {
+                "name": {
+                    "type": "string",
+                    "example": "gate_commands_retention"
+                },
+                "next_run": {
+                    "type": "string",
+                    "example": "2025-01-02T03:00:00Z"
+                },
+                "running": {
                     "type": "boolean",
-                    "example": true
+                    "example": false
+                },
+                "schedule": {
+                    "type": "string",
+                    "example": "0 3 * * *"
                 }
             }
         },
-        "handlers.GateActionResponse": {
+        "handlers.ScheduledJobsResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -1814,11 +12894,14 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/handlers.GateActionData"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ScheduledJobDTO"
+                    }
                 },
                 "message": {
                     "type": "string",
-                    "example": "Gate operation completed successfully"
+                    "example": "Scheduled jobs retrieved successfully"
                 },
                 "success": {
                     "type": "boolean",
@@ -1826,36 +12909,94 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.GateDTO": {
+        "handlers.SearchResultDTO": {
             "type": "object",
             "properties": {
-                "description": {
+                "id": {
+                    "description": "UUID, or the provider's numeric ID for locations and gates",
                     "type": "string",
-                    "example": "Main vehicle entrance for visitors. Controlled by biometric access, opens in 3 seconds with safety sensors."
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "gate_is_horizontal": {
-                    "type": "boolean",
-                    "example": true
+                "score": {
+                    "description": "Higher is a better match",
+                    "type": "integer",
+                    "example": 100
+                },
+                "subtitle": {
+                    "description": "Email, role, address, the gate's location or the audit entry summary",
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "type": {
+                    "description": "user, admin, location, gate or audit_log",
+                    "type": "string",
+                    "example": "user"
+                }
+            }
+        },
+        "handlers.SecurityDenialDTO": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "actor_name": {
+                    "type": "string",
+                    "example": "operator"
+                },
+                "actor_role": {
+                    "type": "string",
+                    "example": "regular"
+                },
+                "actor_type": {
+                    "description": "admin, user, api_key or anonymous",
+                    "type": "string",
+                    "example": "admin"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
                 },
                 "id": {
-                    "type": "integer",
-                    "example": 1
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "is_open": {
-                    "type": "boolean",
-                    "example": true
+                "ip_address": {
+                    "type": "string",
+                    "example": "192.168.1.1"
                 },
-                "location_id": {
-                    "type": "integer",
-                    "example": 1
+                "message": {
+                    "type": "string",
+                    "example": "Super admin access required"
                 },
-                "title": {
+                "method": {
                     "type": "string",
-                    "example": "Автоматический Шлагбаум №12"
+                    "example": "DELETE"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/api/v1/admins/550e8400-e29b-41d4-a716-446655440002"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "super_admin_required"
+                },
+                "route": {
+                    "type": "string",
+                    "example": "/api/v1/admins/:id"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0"
                 }
             }
         },
-        "handlers.GatesListResponse": {
+        "handlers.SecurityDenialsResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -1865,12 +13006,15 @@ const docTemplate = `{
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handlers.GateDTO"
+                        "$ref": "#/definitions/handlers.SecurityDenialDTO"
                     }
                 },
                 "message": {
                     "type": "string",
-                    "example": "Gates retrieved successfully"
+                    "example": "Security denials retrieved successfully"
+                },
+                "pagination": {
+                    "$ref": "#/definitions/handlers.PaginationMeta"
                 },
                 "success": {
                     "type": "boolean",
@@ -1878,96 +13022,149 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.HealthCheckResponse": {
+        "handlers.SetGateMaintenanceRequest": {
             "type": "object",
             "required": [
-                "environment",
-                "message",
-                "status",
-                "success",
-                "timestamp",
-                "uptime",
-                "version"
+                "note"
             ],
             "properties": {
-                "environment": {
+                "note": {
+                    "description": "Shown to users whose open or close is refused",
                     "type": "string",
-                    "example": "production"
+                    "example": "Barrier motor replacement until Friday"
+                }
+            }
+        },
+        "handlers.StaleDeviceDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Not logged out or expired yet; deleting it logs the device out",
+                    "type": "boolean",
+                    "example": true
                 },
-                "message": {
+                "device_id": {
                     "type": "string",
-                    "example": "Ololo Gate API is running"
+                    "example": "iphone-15-abc123"
                 },
-                "status": {
+                "expires_at": {
                     "type": "string",
-                    "example": "healthy"
+                    "example": "2026-05-30T12:00:00Z"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "timestamp": {
+                "last_used_at": {
                     "type": "string",
-                    "example": "2025-01-15T10:30:45Z"
+                    "example": "2026-03-01T12:00:00Z"
                 },
-                "uptime": {
+                "user_agent": {
                     "type": "string",
-                    "example": "1h30m45s"
+                    "example": "OloloGate/2.3 (iOS 17.4)"
                 },
-                "version": {
+                "user_id": {
                     "type": "string",
-                    "example": "1.0.0"
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
                 }
             }
         },
-        "handlers.LocationAssignmentRequest": {
+        "handlers.StaleDeviceReportDTO": {
             "type": "object",
-            "required": [
-                "gateIds",
-                "locationId"
-            ],
             "properties": {
-                "gateIds": {
+                "active": {
+                    "description": "Of which could still be used",
+                    "type": "integer",
+                    "example": 37
+                },
+                "cutoff": {
+                    "description": "Sessions last used before this are stale",
+                    "type": "string",
+                    "example": "2026-04-19T09:00:00Z"
+                },
+                "devices": {
+                    "description": "Least recently used first, up to limit",
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/handlers.StaleDeviceDTO"
                     }
                 },
-                "locationId": {
+                "purge_enabled": {
+                    "description": "JWT_STALE_DEVICE_PURGE: the job deletes them, rather than only reporting",
+                    "type": "boolean",
+                    "example": false
+                },
+                "stale_after": {
+                    "description": "Retention the report was made for",
+                    "type": "string",
+                    "example": "4320h0m0s"
+                },
+                "total": {
+                    "description": "Stale sessions",
                     "type": "integer",
-                    "example": 1
+                    "example": 1520
+                },
+                "users": {
+                    "description": "Users with at least one stale session",
+                    "type": "integer",
+                    "example": 980
                 }
             }
         },
-        "handlers.LocationDTO": {
+        "handlers.StaleDeviceReportResponse": {
             "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
             "properties": {
-                "address": {
+                "data": {
+                    "$ref": "#/definitions/handlers.StaleDeviceReportDTO"
+                },
+                "message": {
                     "type": "string",
-                    "example": "г. Бишкек, проспект Чуй, 135"
+                    "example": "Stale device report generated successfully"
                 },
-                "gates": {
-                    "description": "Always include gates, even if empty array",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handlers.GateDTO"
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "handlers.SyncChangeDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Same actions as the user history",
+                    "type": "string",
+                    "example": "assigned"
+                },
+                "actor": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "changes": {
+                    "description": "Changed fields, e.g. \"phone\", \"email\", \"status\", \"assignments\"",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/handlers.FieldChangeDTO"
                     }
                 },
                 "id": {
-                    "type": "integer",
-                    "example": 1
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "logo": {
+                "occurred_at": {
                     "type": "string",
-                    "example": "https://picsum.photos/seed/alatoo/200"
+                    "example": "2026-10-16T10:30:45Z"
                 },
-                "title": {
+                "user_id": {
                     "type": "string",
-                    "example": "Торгово-развлекательный центр Ала-Тоо"
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
                 }
             }
         },
-        "handlers.LocationsListResponse": {
+        "handlers.SyncChangesResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -1977,12 +13174,22 @@ const docTemplate = `{
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handlers.LocationDTO"
+                        "$ref": "#/definitions/handlers.SyncChangeDTO"
                     }
                 },
+                "has_more": {
+                    "description": "More changes are available right away",
+                    "type": "boolean",
+                    "example": false
+                },
                 "message": {
                     "type": "string",
-                    "example": "Locations retrieved successfully"
+                    "example": "Changes retrieved successfully"
+                },
+                "next_token": {
+                    "description": "Pass as since to get the following changes",
+                    "type": "string",
+                    "example": "MTc2MDYxMDI0NTAwMDAwMDAwMDo1NTBlODQwMC1lMjliLTQxZDQtYTcxNi00NDY2NTU0NDAwMDA"
                 },
                 "success": {
                     "type": "boolean",
@@ -1990,25 +13197,9 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.LoginData": {
+        "handlers.TrashedUserDTO": {
             "type": "object",
-            "required": [
-                "access_expires_in",
-                "access_token",
-                "id",
-                "phone",
-                "refresh_expires_in",
-                "refresh_token"
-            ],
             "properties": {
-                "access_expires_in": {
-                    "type": "integer",
-                    "example": 900
-                },
-                "access_token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
-                },
                 "id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
@@ -2017,34 +13208,22 @@ const docTemplate = `{
                     "type": "string",
                     "example": "+77771234567"
                 },
-                "refresh_expires_in": {
-                    "type": "integer",
-                    "example": 2592000
-                },
-                "refresh_token": {
+                "purge_at": {
+                    "description": "When the user is purged and their gate access revoked",
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
-                }
-            }
-        },
-        "handlers.LoginRequest": {
-            "type": "object",
-            "required": [
-                "password",
-                "phone"
-            ],
-            "properties": {
-                "password": {
+                    "example": "2025-01-22T10:30:00Z"
+                },
+                "trashed_at": {
                     "type": "string",
-                    "example": "password123"
+                    "example": "2025-01-15T10:30:00Z"
                 },
-                "phone": {
+                "trashed_by": {
                     "type": "string",
-                    "example": "+77771234567"
+                    "example": "admin"
                 }
             }
         },
-        "handlers.LoginResponse": {
+        "handlers.TrashedUserResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -2052,11 +13231,11 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/handlers.LoginData"
+                    "$ref": "#/definitions/handlers.TrashedUserDTO"
                 },
                 "message": {
                     "type": "string",
-                    "example": "Login successful"
+                    "example": "User moved to the trash"
                 },
                 "success": {
                     "type": "boolean",
@@ -2064,21 +13243,22 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.PaginatedAuditLogResponse": {
+        "handlers.TrashedUsersResponse": {
             "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.AdminAuditLog"
+                        "$ref": "#/definitions/handlers.TrashedUserDTO"
                     }
                 },
                 "message": {
                     "type": "string",
-                    "example": "Audit logs retrieved successfully"
-                },
-                "pagination": {
-                    "$ref": "#/definitions/handlers.PaginationMeta"
+                    "example": "Trashed users retrieved successfully"
                 },
                 "success": {
                     "type": "boolean",
@@ -2086,75 +13266,135 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.PaginationMeta": {
+        "handlers.UpdateAdminRequest": {
             "type": "object",
             "properties": {
-                "current_page": {
-                    "type": "integer",
-                    "example": 1
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "newpassword123"
                 },
-                "last_page": {
-                    "type": "integer",
-                    "example": 1
+                "role": {
+                    "type": "string",
+                    "example": "regular"
                 },
-                "per_page": {
-                    "type": "integer",
-                    "example": 100
+                "timezone": {
+                    "description": "IANA zone of timestamps and report days in the admin panel; \"\" for DEFAULT_TIMEZONE",
+                    "type": "string",
+                    "example": "Asia/Bishkek"
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 100
+                "username": {
+                    "type": "string",
+                    "example": "newusername"
                 }
             }
         },
-        "handlers.PhoneAvailabilityResponse": {
+        "handlers.UpdateContactRequest": {
             "type": "object",
             "required": [
-                "available",
-                "message",
-                "success"
+                "address",
+                "email_support",
+                "support_number"
             ],
             "properties": {
-                "available": {
-                    "description": "true if phone is available, false if already in use",
-                    "type": "boolean",
-                    "example": true
+                "address": {
+                    "type": "string",
+                    "example": "г. Бишкек, проспект Чуй, 135"
                 },
-                "message": {
+                "email_support": {
                     "type": "string",
-                    "example": "Phone availability checked"
+                    "example": "support@ololo.com"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "support_number": {
+                    "type": "integer",
+                    "example": 77091234567
                 }
             }
         },
-        "handlers.RefreshData": {
+        "handlers.UpdateNotificationPreferencesRequest": {
             "type": "object",
             "required": [
-                "access_token"
+                "digest"
             ],
             "properties": {
-                "access_token": {
+                "digest": {
+                    "description": "Receive the inactivity digest SMS",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handlers.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Optional - if provided, will set the login email after checking availability",
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                    "example": "staff@example.com"
+                },
+                "locations": {
+                    "description": "Optional - if provided, will reassign user to these locations and gates",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationAssignmentRequest"
+                    }
+                },
+                "max_sessions": {
+                    "description": "Optional - concurrent session limit (0 = unlimited, -1 = back to JWT_MAX_SESSIONS)",
+                    "type": "integer",
+                    "example": 2
+                },
+                "password": {
+                    "description": "Optional - only updates if provided",
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "newpassword123"
+                },
+                "phone": {
+                    "description": "Optional - if provided, will update phone number after checking availability",
+                    "type": "string",
+                    "example": "+77771234567"
                 }
             }
         },
-        "handlers.RefreshRequest": {
+        "handlers.UsageRollupDTO": {
             "type": "object",
             "required": [
-                "refresh_token"
+                "active_users",
+                "api_calls",
+                "gate_operations",
+                "month",
+                "org_id",
+                "sms_sent"
             ],
             "properties": {
-                "refresh_token": {
+                "active_users": {
+                    "type": "integer",
+                    "example": 412
+                },
+                "api_calls": {
+                    "type": "integer",
+                    "example": 125000
+                },
+                "gate_operations": {
+                    "type": "integer",
+                    "example": 8400
+                },
+                "month": {
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                    "example": "2026-10"
+                },
+                "org_id": {
+                    "type": "string",
+                    "example": "default"
+                },
+                "sms_sent": {
+                    "type": "integer",
+                    "example": 320
                 }
             }
         },
-        "handlers.RefreshResponse": {
+        "handlers.UsageRollupResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -2162,11 +13402,14 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/handlers.RefreshData"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.UsageRollupDTO"
+                    }
                 },
                 "message": {
                     "type": "string",
-                    "example": "Token refreshed successfully"
+                    "example": "Usage rollup retrieved successfully"
                 },
                 "success": {
                     "type": "boolean",
@@ -2174,13 +13417,23 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.RegisterData": {
+        "handlers.UserDTO": {
             "type": "object",
             "required": [
+                "created_at",
                 "id",
-                "phone"
+                "phone",
+                "updated_at"
             ],
             "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "staff@example.com"
+                },
                 "id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
@@ -2188,20 +13441,23 @@ const docTemplate = `{
                 "phone": {
                     "type": "string",
                     "example": "+77771234567"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
                 }
             }
         },
-        "handlers.RegisterRequest": {
+        "handlers.UserData": {
             "type": "object",
             "required": [
-                "password",
+                "id",
                 "phone"
             ],
             "properties": {
-                "password": {
+                "id": {
                     "type": "string",
-                    "minLength": 6,
-                    "example": "password123"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
                 "phone": {
                     "type": "string",
@@ -2209,7 +13465,66 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.RegisterResponse": {
+        "handlers.UserDetailDTO": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "id",
+                "locations",
+                "phone",
+                "updated_at"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "staff@example.com"
+                },
+                "email_verified_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.LocationDTO"
+                    }
+                },
+                "max_sessions": {
+                    "description": "User's own session limit; absent when JWT_MAX_SESSIONS applies",
+                    "type": "integer",
+                    "example": 2
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                },
+                "phones": {
+                    "description": "Primary and secondary numbers",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.UserPhoneDTO"
+                    }
+                },
+                "trashed_at": {
+                    "description": "Set while the user is in the trash",
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-15T10:30:00Z"
+                }
+            }
+        },
+        "handlers.UserDetailResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -2217,11 +13532,11 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/handlers.RegisterData"
+                    "$ref": "#/definitions/handlers.UserDetailDTO"
                 },
                 "message": {
                     "type": "string",
-                    "example": "User registered successfully"
+                    "example": "User retrieved successfully"
                 },
                 "success": {
                     "type": "boolean",
@@ -2229,148 +13544,140 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.UpdateAdminRequest": {
+        "handlers.UserHistoryDTO": {
             "type": "object",
             "properties": {
-                "password": {
+                "action": {
                     "type": "string",
-                    "minLength": 6,
-                    "example": "newpassword123"
+                    "example": "updated"
                 },
-                "role": {
+                "actor": {
+                    "description": "Admin username, \"self\" or \"system\"",
                     "type": "string",
-                    "example": "regular"
+                    "example": "admin"
                 },
-                "username": {
-                    "type": "string",
-                    "example": "newusername"
-                }
-            }
-        },
-        "handlers.UpdateContactRequest": {
-            "type": "object",
-            "required": [
-                "address",
-                "email_support",
-                "support_number"
-            ],
-            "properties": {
-                "address": {
-                    "type": "string",
-                    "example": "г. Бишкек, проспект Чуй, 135"
+                "changes": {
+                    "description": "Changed fields, e.g. \"phone\", \"email\", \"status\", \"password_changed\", \"assignments\"",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/handlers.FieldChangeDTO"
+                    }
                 },
-                "email_support": {
+                "created_at": {
                     "type": "string",
-                    "example": "support@ololo.com"
+                    "example": "2025-01-15T10:30:00Z"
                 },
-                "support_number": {
-                    "type": "integer",
-                    "example": 77091234567
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 }
             }
         },
-        "handlers.UpdateUserRequest": {
+        "handlers.UserHistoryResponse": {
             "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
             "properties": {
-                "locations": {
-                    "description": "Optional - if provided, will reassign user to these locations and gates",
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handlers.LocationAssignmentRequest"
+                        "$ref": "#/definitions/handlers.UserHistoryDTO"
                     }
                 },
-                "password": {
-                    "description": "Optional - only updates if provided",
+                "message": {
                     "type": "string",
-                    "minLength": 6,
-                    "example": "newpassword123"
+                    "example": "User history retrieved successfully"
                 },
-                "phone": {
-                    "description": "Optional - if provided, will update phone number after checking availability",
-                    "type": "string",
-                    "example": "+77771234567"
+                "pagination": {
+                    "$ref": "#/definitions/handlers.PaginationMeta"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "handlers.UserDTO": {
+        "handlers.UserPhoneDTO": {
             "type": "object",
-            "required": [
-                "created_at",
-                "id",
-                "phone",
-                "updated_at"
-            ],
             "properties": {
+                "added_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
                 "created_at": {
                     "type": "string",
-                    "example": "2025-01-15T10:30:00Z"
+                    "example": "2025-01-01T00:00:00Z"
                 },
                 "id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
+                "is_primary": {
+                    "type": "boolean",
+                    "example": false
+                },
                 "phone": {
                     "type": "string",
                     "example": "+77771234567"
                 },
-                "updated_at": {
+                "verified_at": {
+                    "description": "Only verified numbers can log in",
                     "type": "string",
-                    "example": "2025-01-15T10:30:00Z"
+                    "example": "2025-01-01T00:00:00Z"
                 }
             }
         },
-        "handlers.UserData": {
+        "handlers.UserPhoneResponse": {
             "type": "object",
             "required": [
-                "id",
-                "phone"
+                "message",
+                "success"
             ],
             "properties": {
-                "id": {
+                "data": {
+                    "$ref": "#/definitions/handlers.UserPhoneDTO"
+                },
+                "message": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "Phone number added successfully"
                 },
-                "phone": {
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "warning": {
+                    "description": "Set when copying gate access to the number failed",
                     "type": "string",
-                    "example": "+77771234567"
+                    "example": "Third-party API assignment error: ..."
                 }
             }
         },
-        "handlers.UserDetailDTO": {
+        "handlers.UserPhonesResponse": {
             "type": "object",
             "required": [
-                "created_at",
-                "id",
-                "locations",
-                "phone",
-                "updated_at"
+                "message",
+                "success"
             ],
             "properties": {
-                "created_at": {
-                    "type": "string",
-                    "example": "2025-01-15T10:30:00Z"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
-                },
-                "locations": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handlers.LocationDTO"
+                        "$ref": "#/definitions/handlers.UserPhoneDTO"
                     }
                 },
-                "phone": {
+                "message": {
                     "type": "string",
-                    "example": "+77771234567"
+                    "example": "Phone numbers retrieved successfully"
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2025-01-15T10:30:00Z"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "handlers.UserDetailResponse": {
+        "handlers.UserResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -2378,11 +13685,11 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/handlers.UserDetailDTO"
+                    "$ref": "#/definitions/handlers.UserData"
                 },
                 "message": {
                     "type": "string",
-                    "example": "User retrieved successfully"
+                    "example": "User created successfully"
                 },
                 "success": {
                     "type": "boolean",
@@ -2390,7 +13697,50 @@ const docTemplate = `{
                 }
             }
         },
-        "handlers.UserResponse": {
+        "handlers.UserSessionDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
+                },
+                "current": {
+                    "description": "True for the session making this request",
+                    "type": "boolean",
+                    "example": true
+                },
+                "device_id": {
+                    "type": "string",
+                    "example": "iphone-15-abc123"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-31T12:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "192.168.1.10"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:00:00Z"
+                },
+                "trusted": {
+                    "description": "Logged in as a trusted device (\"remember me\"), so the session is long-lived",
+                    "type": "boolean",
+                    "example": false
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "OloloGate/2.3 (iOS 17.4)"
+                }
+            }
+        },
+        "handlers.UserSessionsResponse": {
             "type": "object",
             "required": [
                 "message",
@@ -2398,11 +13748,14 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/handlers.UserData"
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.UserSessionDTO"
+                    }
                 },
                 "message": {
                     "type": "string",
-                    "example": "User created successfully"
+                    "example": "Sessions retrieved successfully"
                 },
                 "success": {
                     "type": "boolean",
@@ -2436,6 +13789,107 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.VerifyRegistrationOTPRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "phone"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "482913"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+77771234567"
+                }
+            }
+        },
+        "handlers.WebhookSecretDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-16T10:30:45Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "current": {
+                    "description": "False for the previous secret, still valid until expires_at",
+                    "type": "boolean",
+                    "example": true
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2026-10-17T10:30:45Z"
+                },
+                "hint": {
+                    "description": "Last characters of the secret, to recognize it",
+                    "type": "string",
+                    "example": "x7Qa"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "scope": {
+                    "description": "outbound or provider_callback",
+                    "type": "string",
+                    "example": "outbound"
+                }
+            }
+        },
+        "handlers.WebhookSecretsResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.WebhookSecretDTO"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Webhook secrets retrieved successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "middleware.RetryStrategy": {
+            "type": "object",
+            "properties": {
+                "max_attempts": {
+                    "description": "Exponential only: give up after this many retries",
+                    "type": "integer",
+                    "example": 5
+                },
+                "reset_at": {
+                    "description": "Unix time of the earliest retry, same as X-RateLimit-Reset",
+                    "type": "integer",
+                    "example": 1760610645
+                },
+                "retry_after_seconds": {
+                    "description": "Same as the Retry-After header",
+                    "type": "integer",
+                    "example": 30
+                },
+                "strategy": {
+                    "description": "fixed, exponential or poll",
+                    "type": "string",
+                    "example": "exponential"
+                }
+            }
+        },
         "models.AdminAuditLog": {
             "type": "object",
             "properties": {
@@ -2486,6 +13940,154 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "services.PasskeyAuthenticatorSelection": {
+            "type": "object",
+            "properties": {
+                "residentKey": {
+                    "type": "string",
+                    "example": "required"
+                },
+                "userVerification": {
+                    "type": "string",
+                    "example": "preferred"
+                }
+            }
+        },
+        "services.PasskeyCreationOptions": {
+            "type": "object",
+            "properties": {
+                "attestation": {
+                    "type": "string",
+                    "example": "none"
+                },
+                "authenticatorSelection": {
+                    "$ref": "#/definitions/services.PasskeyAuthenticatorSelection"
+                },
+                "challenge": {
+                    "type": "string",
+                    "example": "mX2v0Vw1b7N6Q3xYk8zJ4tH5aR9cL0pE2uS6dF1gW3o"
+                },
+                "excludeCredentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PasskeyCredentialDescriptor"
+                    }
+                },
+                "pubKeyCredParams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PasskeyCredentialParameter"
+                    }
+                },
+                "rp": {
+                    "$ref": "#/definitions/services.PasskeyRelyingParty"
+                },
+                "timeout": {
+                    "description": "Milliseconds",
+                    "type": "integer",
+                    "example": 300000
+                },
+                "user": {
+                    "$ref": "#/definitions/services.PasskeyUser"
+                }
+            }
+        },
+        "services.PasskeyCredentialDescriptor": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "base64url",
+                    "type": "string",
+                    "example": "pX3f0w8sQ1u9kq2Hn7cZbA"
+                },
+                "transports": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "internal",
+                        "hybrid"
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "example": "public-key"
+                }
+            }
+        },
+        "services.PasskeyCredentialParameter": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "integer",
+                    "example": -7
+                },
+                "type": {
+                    "type": "string",
+                    "example": "public-key"
+                }
+            }
+        },
+        "services.PasskeyRelyingParty": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "admin.example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ololo Gate"
+                }
+            }
+        },
+        "services.PasskeyRequestOptions": {
+            "type": "object",
+            "properties": {
+                "allowCredentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PasskeyCredentialDescriptor"
+                    }
+                },
+                "challenge": {
+                    "type": "string",
+                    "example": "mX2v0Vw1b7N6Q3xYk8zJ4tH5aR9cL0pE2uS6dF1gW3o"
+                },
+                "rpId": {
+                    "type": "string",
+                    "example": "admin.example.com"
+                },
+                "timeout": {
+                    "description": "Milliseconds",
+                    "type": "integer",
+                    "example": 300000
+                },
+                "userVerification": {
+                    "type": "string",
+                    "example": "preferred"
+                }
+            }
+        },
+        "services.PasskeyUser": {
+            "type": "object",
+            "properties": {
+                "displayName": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "id": {
+                    "description": "base64url of the admin UUID bytes, returned as the user handle",
+                    "type": "string",
+                    "example": "AAAAAAAAAAAAAAAAAAAAAQ"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "admin"
+                }
+            }
         }
     },
     "securityDefinitions": {
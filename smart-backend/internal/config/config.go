@@ -1,9 +1,16 @@
 package config
 
 import (
-	"log"
-	"os"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"ololo-gate/internal/logging"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
+	_ "time/tzdata" // Zone database for DEFAULT_TIMEZONE on hosts and images without one
 
 	"github.com/joho/godotenv"
 )
@@ -14,6 +21,34 @@ type Config struct {
 	Server           ServerConfig
 	CORS             CORSConfig
 	InitAdmin        InitAdminConfig
+	Assignment       AssignmentConfig
+	Users            UsersConfig
+	Gates            GatesConfig
+	GateReports      GateReportsConfig
+	Storage          StorageConfig
+	Exports          ExportsConfig
+	ThirdParty       ThirdPartyConfig
+	Quotas           QuotaConfig
+	Metering         MeteringConfig
+	Sentry           SentryConfig
+	Encryption       EncryptionConfig
+	Phone            PhoneConfig
+	Impersonation    ImpersonationConfig
+	Links            LinksConfig
+	SMS              SMSConfig
+	OTP              OTPConfig
+	Alerts           AlertsConfig
+	SLO              SLOConfig
+	WebAuthn         WebAuthnConfig
+	Digest           DigestConfig
+	Swagger          SwaggerConfig
+	Residency        ResidencyConfig
+	Webhooks         WebhooksConfig
+	Sandbox          SandboxConfig
+	Security         SecurityConfig
+	Login            LoginConfig
+	Faults           FaultsConfig
+	Logging          LoggingConfig
 	ThirdPartyAPIURL string
 }
 
@@ -23,21 +58,142 @@ type DatabaseConfig struct {
 	User     string
 	Password string
 	DBName   string
+
+	MaxOpenConns    int           // Connections open at once, in use or idle (0 = unlimited)
+	MaxIdleConns    int           // Idle connections kept for reuse
+	ConnMaxLifetime time.Duration // Connections are closed after this age (0 = never)
+	ConnMaxIdleTime time.Duration // Idle connections are closed after this long (0 = never)
+
+	PrepareStmt            bool // Cache prepared statements per connection; turn off behind PgBouncer in transaction mode
+	PreparedStmtCacheSize  int  // Prepared statements kept, least recently used evicted first
+	SkipDefaultTransaction bool // Run single creates, updates and deletes without a transaction; user saves then no longer update user_phones atomically
+
+	MigrateOnStart bool // Apply pending schema migrations at startup instead of refusing to start (default off in production)
 }
 
 type JWTConfig struct {
-	Secret        string
+	Secret         string
+	AccessExpiry   time.Duration
+	RefreshExpiry  time.Duration
+	ClientProfiles map[string]ClientProfile // Token lifetimes per client type sent at login (e.g. "kiosk")
+	Issuer         string                   // "iss" claim set on and required from every token (empty disables the check)
+	Audience       string                   // "aud" claim set on and required from every token (empty disables the check)
+	Leeway         time.Duration            // Clock-skew tolerance applied to nbf/iat/exp validation
+	RequireDevice  bool                     // Reject device-bound tokens presented without X-Device-ID (off only while older clients are rolled out)
+
+	TrustedRefreshExpiry time.Duration // Refresh token lifetime for logins from a trusted device ("remember me"; 0 = not offered)
+	VersionCacheTTL      time.Duration // How long token versions checked on every request are cached; revocations on other instances apply within it (0 = no cache)
+	MaxSessions          int           // Concurrent sessions per user; a login past it logs out the oldest (0 = unlimited; users can override it)
+	StaleDeviceAfter     time.Duration // Sessions not used for this long are stale
+	StaleDevicePurge     bool          // Let the stale_device_purge job delete stale sessions; otherwise it only reports them
+
+	AdminAccessExpiry  time.Duration // Admin access token lifetime
+	AdminRefreshExpiry time.Duration // Admin refresh token lifetime; admins log in again after it
+}
+
+// ClientProfile overrides token lifetimes for one client type
+type ClientProfile struct {
 	AccessExpiry  time.Duration
 	RefreshExpiry time.Duration
 }
 
+// Expiry returns the access and refresh token lifetimes for a client type,
+// falling back to the defaults for unknown or empty client types
+func (j JWTConfig) Expiry(clientType string) (time.Duration, time.Duration) {
+	if profile, ok := j.ClientProfiles[clientType]; ok {
+		return profile.AccessExpiry, profile.RefreshExpiry
+	}
+	return j.AccessExpiry, j.RefreshExpiry
+}
+
+// LoginExpiry returns the token lifetimes for a login. Logins from a trusted device get the trusted
+// refresh expiry; it never shortens the client type's own refresh expiry.
+func (j JWTConfig) LoginExpiry(clientType string, trustedDevice bool) (time.Duration, time.Duration) {
+	access, refresh := j.Expiry(clientType)
+	if trustedDevice && j.TrustedRefreshExpiry > refresh {
+		refresh = j.TrustedRefreshExpiry
+	}
+	return access, refresh
+}
+
 type ServerConfig struct {
-	Port string
-	Env  string
+	Port            string
+	Env             string
+	HealthToken     string        // Bearer token that unlocks the detailed health check at / (empty = details are public)
+	DefaultTimezone string        // IANA zone of timestamps and report days for requests without X-Timezone or an admin preference
+	RequestTimeout  time.Duration // Deadline of each API request's queries and provider calls (0 = none)
 }
 
 type CORSConfig struct {
-	AllowedOrigins string
+	AllowedOrigins      string
+	AdminAllowedOrigins string // Origins allowed on /api/v1/admin/* (empty = AllowedOrigins unless it is "*"; never a wildcard)
+}
+
+// Swagger UI exposure modes (SWAGGER_MODE)
+const (
+	SwaggerPublic   = "public"   // Anyone can browse it
+	SwaggerAdmin    = "admin"    // Requires an admin token
+	SwaggerBasic    = "basic"    // Requires SWAGGER_USERNAME and SWAGGER_PASSWORD
+	SwaggerDisabled = "disabled" // Not served
+)
+
+// SwaggerConfig controls who can see the Swagger UI and API description at /swagger
+type SwaggerConfig struct {
+	Mode           string   // public, admin, basic or disabled (default disabled in production, public otherwise)
+	Username       string   // Basic auth credentials for the basic mode
+	Password       string
+	AllowedOrigins []string // Origins that may fetch /swagger cross-origin; requests from other origins are refused
+}
+
+// ResidencyConfig is the data residency policy of the organization this instance serves. Its
+// flags make the export and webhook subsystems refuse destinations outside the policy, both
+// when they are configured and before data is sent.
+type ResidencyConfig struct {
+	Regions          []string // Storage regions data may be kept in, e.g. eu-central-1
+	AllowedHosts     []string // Hosts external destinations may be on; a leading dot allows subdomains, e.g. .example.eu
+	RestrictExports  bool     // Exports are only written to storage in Regions, or on AllowedHosts for S3-compatible endpoints
+	RestrictWebhooks bool     // Webhooks (gate reports, alerts) are only sent to AllowedHosts
+}
+
+// CheckStorage returns an error if exports may not be written to the storage
+func (r ResidencyConfig) CheckStorage(storage StorageConfig) error {
+	if !r.RestrictExports || storage.Driver != "s3" {
+		return nil
+	}
+	// A custom endpoint's region is only a label, so the endpoint itself must be allowed
+	if storage.S3.Endpoint != "" {
+		return r.checkHost(storage.S3.Endpoint)
+	}
+	for _, region := range r.Regions {
+		if strings.EqualFold(region, storage.S3.Region) {
+			return nil
+		}
+	}
+	return fmt.Errorf("storage region %q is outside the data residency regions", storage.S3.Region)
+}
+
+// CheckWebhook returns an error if data may not be posted to the webhook URL
+func (r ResidencyConfig) CheckWebhook(webhookURL string) error {
+	if !r.RestrictWebhooks || webhookURL == "" {
+		return nil
+	}
+	return r.checkHost(webhookURL)
+}
+
+// checkHost returns an error unless the URL's host is one of AllowedHosts
+func (r ResidencyConfig) checkHost(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Hostname() == "" {
+		return fmt.Errorf("invalid destination URL %q", rawURL)
+	}
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range r.AllowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed || (strings.HasPrefix(allowed, ".") && strings.HasSuffix(host, allowed)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("destination host %q is outside the data residency hosts", host)
 }
 
 type InitAdminConfig struct {
@@ -46,67 +202,919 @@ type InitAdminConfig struct {
 	Password string
 }
 
-var AppConfig *Config
+// WebhooksConfig controls the secrets webhooks are signed and provider callbacks authenticated with.
+// The secrets are rotated with POST /admin/webhook-secrets/:scope/rotate; the values set here are
+// only used until the first rotation.
+type WebhooksConfig struct {
+	SigningSecret string        // HMAC secret outbound webhooks (alerts, gate reports) are signed with (empty = unsigned)
+	SecretOverlap time.Duration // How long the previous secret stays valid after a rotation
+}
+
+// SandboxConfig puts the organization this instance serves in sandbox mode, for integrators testing
+// end-to-end flows: gate commands and text messages go to simulators instead of the provider and
+// the SMS gateway, and every API response is marked with X-Sandbox. Reads from the provider (locations,
+// gates) still use the real one.
+type SandboxConfig struct {
+	Enabled bool // Simulate gate commands and SMS (restart to change, so commands are never half simulated)
+}
+
+// SecurityConfig controls the security events recorded for requests refused with 403
+// (GET /admin/security/denials)
+type SecurityConfig struct {
+	DenialRetention time.Duration // How long denials are kept before the nightly purge
+}
+
+// LoginConfig controls the brute-force protection of password logins (POST /auth/login and
+// /admin/login). Failures are counted per account (phone, email or admin username) and per client
+// IP; past the limit the account or IP is locked out, for twice as long after each lockout.
+type LoginConfig struct {
+	MaxFailedAttempts   int           // Failed logins of an account within FailureWindow before it is locked out (0 = unlimited)
+	IPMaxFailedAttempts int           // Failed logins from one client IP within FailureWindow before it is locked out (0 = unlimited)
+	FailureWindow       time.Duration // How long failures count, and how long after a lockout ends the backoff resets
+	Lockout             time.Duration // First lockout; each further lockout doubles it
+	MaxLockout          time.Duration // Longest lockout
+}
+
+// FaultsConfig injects failures into API requests (FAULT_INJECTION) so staging can rehearse how
+// clients, retries and the provider circuit breaker behave under partial failure. Rates are
+// percentages of the requests matching Routes. Refused in production.
+type FaultsConfig struct {
+	Enabled           bool
+	Routes            []FaultRoute  // Requests faults are injected into (empty = every /api/v1 request)
+	Latency           time.Duration // Longest delay added to a request; each delay is random up to it
+	LatencyRate       float64       // Percent of requests delayed
+	ProviderErrorRate float64       // Percent of requests failed as if the gate provider were unavailable
+	DBErrorRate       float64       // Percent of requests failed as if a database query had failed
+}
+
+// FaultRoute selects requests by method (empty = any) and path pattern, matched like access rules
+type FaultRoute struct {
+	Method string
+	Path   string
+}
+
+// LoggingConfig controls the structured log every component writes to standard output
+type LoggingConfig struct {
+	Level  string // Minimum level logged: debug, info, warn or error
+	Format string // json (one object per line) or text (key=value pairs)
+}
+
+// AssignmentConfig controls how user location/gate assignment failures are handled
+type AssignmentConfig struct {
+	StrictMode       bool          // Roll back user creation when the third-party assignment fails
+	RetryBackoff     time.Duration // Delay before the first retry of a failed assignment, doubled after every failed retry
+	RetryMaxBackoff  time.Duration // Longest delay between retries of a failed assignment
+	RetryMaxAttempts int           // Attempts before a failed assignment is given up and admins are notified (0 = retry forever)
+}
+
+// UsersConfig controls user account lifecycle
+type UsersConfig struct {
+	TrashRetention       time.Duration // How long deleted users stay in the trash, restorable, before they are purged
+	OpenRegistration     bool          // Allow POST /auth/register without an invite code (such users get no locations or gates)
+	RegistrationApproval bool          // Accept registrations without an invite code as pending, for an admin to approve or reject
+	PasswordMaxAge       time.Duration // Passwords older than this must be changed before logging in (0 = never expire)
+}
+
+// GatesConfig controls gate command handling
+type GatesConfig struct {
+	CommandHoldWindow     time.Duration // How long a finished command keeps blocking conflicting commands for the same gate
+	ConfirmInterval       time.Duration // Delay between provider status polls while confirming a command
+	ConfirmAttempts       int           // Number of status polls before a command is marked failed (0 = trust the provider response)
+	ProviderCallbackToken string        // Shared secret the provider sends in X-Provider-Token on command callbacks, until the first rotation (empty = callbacks disabled)
+	CommandRetention      time.Duration // How long finished gate commands are kept before the retention job purges them
+	QueueTTL              time.Duration // How long commands queued while the provider is down wait to be sent (0 = fail them right away)
+}
+
+// GateReportsConfig controls problem reports users file about gates. Reports always reach
+// the admin notification center; the facility team can also get them by webhook or email.
+type GateReportsConfig struct {
+	MaxPhotoSize int64    // Largest accepted photo in bytes
+	WebhookURL   string   // New reports are POSTed here as JSON (empty = no webhook)
+	EmailTo      []string // Facility team addresses, emailed through the SMTP_* server (empty = no email)
+}
+
+// StorageConfig selects where file artifacts such as gate report photos are kept
+type StorageConfig struct {
+	Driver       string        // "local" or "s3"
+	LocalDir     string        // Directory of the local driver
+	PublicURL    string        // Origin of this API used in local signed URLs, e.g. https://api.example.com (empty = relative URLs)
+	SignedURLTTL time.Duration // How long signed download URLs stay valid
+	S3           S3Config
+}
+
+// S3Config points the s3 storage driver at a bucket on AWS or an S3-compatible server
+type S3Config struct {
+	Endpoint        string // e.g. https://minio.internal:9000 (empty = AWS for Region)
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	PathStyle       bool // Address the bucket as endpoint/bucket instead of bucket.endpoint, as MinIO expects
+}
+
+// ExportsConfig controls exports generated in the background
+type ExportsConfig struct {
+	Retention time.Duration // How long finished exports are kept in file storage before they are purged
+}
+
+// ThirdPartyConfig controls outbound traffic to the third-party API
+type ThirdPartyConfig struct {
+	RateLimit    int           // Requests per second allowed to the provider (0 = unlimited)
+	Burst        int           // Maximum requests sent at once before the rate applies
+	QueueTimeout time.Duration // How long a request may wait for a slot before failing
+	Timeout      time.Duration // Total time allowed for one provider request, per attempt (0 = no timeout)
+
+	Retries         int           // Extra attempts after a network failure, timeout, 5xx or 429 (0 = no retries)
+	RetryBackoff    time.Duration // Delay before the first retry; doubles for each further retry, with jitter
+	RetryMaxBackoff time.Duration // Longest delay between retries
+
+	BreakerThreshold int           // Consecutive unavailable responses that open the circuit breaker (0 = no breaker)
+	BreakerCooldown  time.Duration // How long the breaker stays open before a trial request is let through
+
+	HedgeDelay time.Duration // Send a second open gate attempt if the first has not responded after this long (0 = no hedging)
+
+	MirrorURL          string // Base URL of a provider being migrated to; assignments and gate commands are mirrored to it (empty = off)
+	MirrorGateCommands bool   // Also mirror gate open/close commands, not only assignments
+
+	MonthlyQuota       int64 // Calls per UTC month allowed by the provider contract; admins are warned at 80% and 95% (0 = no quota)
+	MirrorMonthlyQuota int64 // Calls per UTC month allowed to the migration provider (0 = no quota)
+}
+
+// QuotaConfig controls per-principal request quotas on admin and machine endpoints
+type QuotaConfig struct {
+	AdminHourly  int // Requests per admin account per clock hour (0 = unlimited)
+	AdminDaily   int // Requests per admin account per UTC day (0 = unlimited)
+	APIKeyHourly int // Requests per machine API key per clock hour (0 = unlimited)
+	APIKeyDaily  int // Requests per machine API key per UTC day (0 = unlimited)
+	ExportDaily  int // Exports (CSV downloads and background exports) per admin account per UTC day (0 = unlimited)
+}
+
+// MeteringConfig controls usage metering for billing
+type MeteringConfig struct {
+	OrgID         string        // Organization this instance records usage for
+	FlushInterval time.Duration // How often buffered usage counters are written to the database
+}
+
+// SentryConfig controls error reporting to Sentry (or a Sentry-compatible service)
+type SentryConfig struct {
+	DSN         string // Project DSN (empty = error reporting disabled)
+	Environment string // Environment tag on reported events
+	Release     string // Release tag on reported events, e.g. a git SHA or version
+}
+
+// EncryptionConfig controls application-level encryption of personal data (user phone numbers
+// and device IDs). Keys are base64-encoded 32-byte values.
+type EncryptionConfig struct {
+	Key      string // AES-256-GCM key (empty = development key, rejected in production)
+	IndexKey string // HMAC key for blind indexes (empty = derived from Key). Changing it requires reindexing.
+}
+
+// PhoneConfig controls phone number parsing
+type PhoneConfig struct {
+	DefaultRegion    string   // ISO 3166-1 alpha-2 region for numbers entered without a country code
+	AllowedCountries []string // Regions new numbers must belong to, e.g. KG, KZ (empty = any country)
+}
+
+// ImpersonationConfig controls the user tokens super admins issue to troubleshoot as a user
+type ImpersonationConfig struct {
+	TokenTTL            time.Duration // Lifetime of impersonation tokens (no refresh token is issued)
+	AllowGateOperations bool          // Allow gate open/close with impersonation tokens by default (blocked unless enabled)
+}
+
+// LinksConfig controls the shareable deep links users create for their gates
+type LinksConfig struct {
+	BaseURL    string        // Origin of universal links, e.g. https://app.example.com (empty = deep links only)
+	AppScheme  string        // Custom URL scheme of the mobile app (empty = "ololo-gate")
+	DefaultTTL time.Duration // Lifetime of links created without expires_in_minutes
+	MaxTTL     time.Duration // Longest lifetime a link can be given (0 = unlimited)
+
+	MaxFailedAttempts int // Client IPs sending a wrong signature after which a link is revoked (0 = never)
+	IPHourlyFailures  int // Failed resolutions per client IP per hour before the IP is throttled (0 = unlimited)
+	AlertFailures     int // Failed resolutions of links to one gate per hour that alert admins (0 = never)
+}
+
+// SMSConfig controls outgoing text messages (e.g. the welcome SMS sent on registration approval)
+type SMSConfig struct {
+	GatewayURL   string        // Endpoint messages are POSTed to as {"phone", "message"} (empty = messages are only logged)
+	GatewayToken string        // Bearer token sent to the gateway
+	Timeout      time.Duration // Time allowed for one gateway request
+}
+
+// DigestConfig controls the weekly SMS digest sent to users who stopped opening gates.
+// Users opt out in their notification preferences.
+type DigestConfig struct {
+	Enabled       bool          // Send the digest from the weekly inactive_user_digest job
+	InactiveAfter time.Duration // Users without a gate open for this long get the digest; at most GATE_COMMAND_RETENTION
+	Message       string        // Text of the digest SMS
+}
+
+// OTPConfig controls one-time codes sent by SMS, for passwordless login, phone verification at registration and password resets
+type OTPConfig struct {
+	LoginEnabled         bool          // Offer POST /auth/login-otp/request and /confirm
+	RegistrationEnabled  bool          // New registrations stay unverified until the code sent to the phone is confirmed (POST /auth/verify-otp)
+	PasswordResetEnabled bool          // Offer POST /auth/forgot-password and /reset-password
+	Length               int           // Digits in a code
+	TTL                  time.Duration // How long a code can be confirmed
+	MaxAttempts          int           // Wrong guesses before a code is invalidated
+	ResendInterval       time.Duration // Minimum time between codes for the same phone
+	PhoneHourly          int           // Codes a phone can request per hour
+	IPHourly             int           // Codes one client IP can request per hour
+}
+
+// WebAuthnConfig controls passkey (WebAuthn) login for admins
+type WebAuthnConfig struct {
+	RPID             string        // Relying party ID, the domain passkeys are scoped to (empty = passkeys disabled)
+	RPName           string        // Name authenticators show when creating a passkey
+	Origins          []string      // Origins the admin panel is served from, e.g. https://admin.example.com
+	UserVerification string        // preferred, required or discouraged
+	ChallengeTTL     time.Duration // How long a registration or login challenge can be answered
+	PasswordFallback string        // always, or unenrolled to refuse passwords for admins with a passkey
+}
+
+// AlertsConfig controls the ops alert monitor and where its alerts are delivered.
+// Alerts always reach the admin notification center; email and webhook delivery are optional.
+type AlertsConfig struct {
+	CheckInterval time.Duration // How often rules are evaluated (0 = monitor disabled)
+	Rules         []AlertRule
+	WebhookURL    string   // Alerts are POSTed here as JSON (empty = no webhook)
+	EmailTo       []string // Recipients of alert emails (empty = no email)
+	SMTPAddr      string   // SMTP server host:port used for alert emails
+	SMTPUsername  string   // PLAIN auth username (empty = no auth)
+	SMTPPassword  string
+	SMTPFrom      string
+}
+
+// AlertRule fires when Metric stays above Threshold for at least For
+type AlertRule struct {
+	Metric    string // provider_error_rate (%), db_pool_saturation (%) or job_backlog (overdue jobs)
+	Threshold float64
+	For       time.Duration
+}
+
+// SLOConfig controls service level objective tracking
+type SLOConfig struct {
+	Window     time.Duration // Rolling window compliance and error budgets are computed over
+	Objectives []SLOObjective
+}
+
+// SLOObjective is met when Target percent of requests to an endpoint succeed (no 5xx) within Latency
+type SLOObjective struct {
+	Name    string // Label in the summary and on the Prometheus series, e.g. "gate_open"
+	Method  string
+	Path    string // Route pattern, e.g. /api/v1/locations/:gateId/open
+	Latency time.Duration
+	Target  float64 // Percent, e.g. 95 for "p95 under Latency"
+}
+
+// defaultAlertRules is used when ALERT_RULES is not set
+const defaultAlertRules = "provider_error_rate:20:5m,db_pool_saturation:90:5m,job_backlog:0:15m"
+
+// defaultSLOObjectives is used when SLO_OBJECTIVES is not set
+const defaultSLOObjectives = "gate_open PUT /api/v1/locations/:gateId/open 2s 95,gate_close PUT /api/v1/locations/:gateId/close 2s 95"
+
+// current holds the configuration in effect. Reload swaps in a new copy, so it is
+// published atomically instead of being assigned to a plain global.
+var current atomic.Pointer[Config]
+
+// AppConfig returns the configuration in effect. Callers must not modify it outside
+// tests; Reload publishes a new copy rather than changing the one already handed out.
+func AppConfig() *Config {
+	return current.Load()
+}
+
+// SetAppConfig publishes cfg as the configuration in effect
+func SetAppConfig(cfg *Config) {
+	current.Store(cfg)
+}
 
 // LoadConfig loads environment variables and initializes the global config
 func LoadConfig() {
 	// Load .env file
+	processEnv = environKeys()
 	if err := godotenv.Load(); err != nil {
-		log.Println("Warning: .env file not found, using environment variables")
+		slog.Warn(".env file not found, using environment variables")
+	}
+
+	// Layer CONFIG_FILE (base + per-environment overrides) beneath environment variables
+	loadConfigFile()
+
+	cfg, err := buildConfig()
+	if err != nil {
+		logging.Fatal("Invalid configuration", "error", err)
+	}
+	SetAppConfig(cfg)
+
+	slog.Info("Configuration loaded")
+}
+
+// buildConfig builds a Config from the current environment and CONFIG_FILE values
+func buildConfig() (*Config, error) {
+	alertRules, err := parseAlertRules(getEnv("ALERT_RULES", defaultAlertRules))
+	if err != nil {
+		return nil, err
 	}
 
 	// Parse token expiry durations
 	accessExpiry, err := time.ParseDuration(getEnv("JWT_ACCESS_EXPIRY", "15m"))
 	if err != nil {
-		log.Fatal("Invalid JWT_ACCESS_EXPIRY format:", err)
-	} else {
-		log.Println("JWT_ACCESS_EXPIRY set to:", accessExpiry)
+		return nil, fmt.Errorf("invalid JWT_ACCESS_EXPIRY format: %w", err)
 	}
-
+	slog.Debug("JWT_ACCESS_EXPIRY set", "expiry", accessExpiry)
 
 	refreshExpiry, err := time.ParseDuration(getEnv("JWT_REFRESH_EXPIRY", "720h"))
 	if err != nil {
-		log.Fatal("Invalid JWT_REFRESH_EXPIRY format:", err)
-	} else{
-		log.Println("JWT_REFRESH_EXPIRY set to:", refreshExpiry)
+		return nil, fmt.Errorf("invalid JWT_REFRESH_EXPIRY format: %w", err)
+	}
+	slog.Debug("JWT_REFRESH_EXPIRY set", "expiry", refreshExpiry)
+
+	sloObjectives, err := parseSLOObjectives(getEnv("SLO_OBJECTIVES", defaultSLOObjectives))
+	if err != nil {
+		return nil, err
+	}
+
+	clientProfiles, err := parseClientProfiles(getEnv("JWT_CLIENT_PROFILES", ""), refreshExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	encryption := EncryptionConfig{
+		Key:      getEnv("ENCRYPTION_KEY", ""),
+		IndexKey: getEnv("BLIND_INDEX_KEY", ""),
+	}
+	if encryption.Key == "" && getEnv("ENV", "development") == "production" {
+		return nil, fmt.Errorf("ENCRYPTION_KEY is required in production")
+	}
+	storage := StorageConfig{
+		Driver:       strings.ToLower(getEnv("STORAGE_DRIVER", "local")),
+		LocalDir:     getEnv("STORAGE_LOCAL_DIR", "./data/files"),
+		PublicURL:    getEnv("STORAGE_PUBLIC_URL", ""),
+		SignedURLTTL: getEnvDuration("STORAGE_SIGNED_URL_TTL", 15*time.Minute),
+		S3: S3Config{
+			Endpoint:        getEnv("STORAGE_S3_ENDPOINT", ""),
+			Region:          getEnv("STORAGE_S3_REGION", "us-east-1"),
+			Bucket:          getEnv("STORAGE_S3_BUCKET", ""),
+			AccessKeyID:     getEnv("STORAGE_S3_ACCESS_KEY_ID", ""),
+			SecretAccessKey: getEnv("STORAGE_S3_SECRET_ACCESS_KEY", ""),
+			PathStyle:       getEnvBool("STORAGE_S3_PATH_STYLE", false),
+		},
+	}
+	switch storage.Driver {
+	case "local":
+	case "s3":
+		if storage.S3.Bucket == "" || storage.S3.AccessKeyID == "" || storage.S3.SecretAccessKey == "" {
+			return nil, fmt.Errorf("STORAGE_DRIVER=s3 requires STORAGE_S3_BUCKET, STORAGE_S3_ACCESS_KEY_ID and STORAGE_S3_SECRET_ACCESS_KEY")
+		}
+	default:
+		return nil, fmt.Errorf("invalid STORAGE_DRIVER %q, use local or s3", storage.Driver)
+	}
+
+	webAuthn := WebAuthnConfig{
+		RPID:             getEnv("WEBAUTHN_RP_ID", ""),
+		RPName:           getEnv("WEBAUTHN_RP_NAME", "Ololo Gate"),
+		Origins:          splitList(getEnv("WEBAUTHN_ORIGINS", "")),
+		UserVerification: getEnv("WEBAUTHN_USER_VERIFICATION", "preferred"),
+		ChallengeTTL:     getEnvDuration("WEBAUTHN_CHALLENGE_TTL", 5*time.Minute),
+		PasswordFallback: getEnv("WEBAUTHN_PASSWORD_FALLBACK", "always"),
+	}
+	if webAuthn.RPID != "" && len(webAuthn.Origins) == 0 {
+		return nil, fmt.Errorf("WEBAUTHN_RP_ID requires WEBAUTHN_ORIGINS")
+	}
+	switch webAuthn.UserVerification {
+	case "preferred", "required", "discouraged":
+	default:
+		return nil, fmt.Errorf("invalid WEBAUTHN_USER_VERIFICATION %q, use preferred, required or discouraged", webAuthn.UserVerification)
+	}
+	switch webAuthn.PasswordFallback {
+	case "always", "unenrolled":
+	default:
+		return nil, fmt.Errorf("invalid WEBAUTHN_PASSWORD_FALLBACK %q, use always or unenrolled", webAuthn.PasswordFallback)
+	}
+
+	swaggerMode := SwaggerPublic
+	if getEnv("ENV", "development") == "production" {
+		swaggerMode = SwaggerDisabled
+	}
+	swagger := SwaggerConfig{
+		Mode:           getEnv("SWAGGER_MODE", swaggerMode),
+		Username:       getEnv("SWAGGER_USERNAME", ""),
+		Password:       getEnv("SWAGGER_PASSWORD", ""),
+		AllowedOrigins: splitList(getEnv("SWAGGER_ALLOWED_ORIGINS", "")),
+	}
+	switch swagger.Mode {
+	case SwaggerPublic, SwaggerAdmin, SwaggerDisabled:
+	case SwaggerBasic:
+		if swagger.Username == "" || swagger.Password == "" {
+			return nil, fmt.Errorf("SWAGGER_MODE=basic requires SWAGGER_USERNAME and SWAGGER_PASSWORD")
+		}
+	default:
+		return nil, fmt.Errorf("invalid SWAGGER_MODE %q, use public, admin, basic or disabled", swagger.Mode)
+	}
+
+	commandRetention := getEnvDuration("GATE_COMMAND_RETENTION", 30*24*time.Hour)
+	digest := DigestConfig{
+		Enabled:       getEnvBool("DIGEST_ENABLED", false),
+		InactiveAfter: getEnvDuration("DIGEST_INACTIVE_AFTER", 4*7*24*time.Hour),
+		Message:       getEnv("DIGEST_MESSAGE", "Ololo Gate: you have not opened a gate in a while. Your access is still active, open the app to use it. You can turn these messages off in the app settings."),
+	}
+	// Inactivity is read from gate commands, which are only kept for GATE_COMMAND_RETENTION
+	if digest.Enabled && (digest.InactiveAfter <= 0 || digest.InactiveAfter > commandRetention) {
+		return nil, fmt.Errorf("invalid DIGEST_INACTIVE_AFTER %s, use a duration up to GATE_COMMAND_RETENTION (%s)", digest.InactiveAfter, commandRetention)
+	}
+
+	residency := ResidencyConfig{
+		Regions:          splitList(getEnv("DATA_RESIDENCY_REGIONS", "")),
+		AllowedHosts:     splitList(getEnv("DATA_RESIDENCY_HOSTS", "")),
+		RestrictExports:  getEnvBool("DATA_RESIDENCY_EXPORTS", false),
+		RestrictWebhooks: getEnvBool("DATA_RESIDENCY_WEBHOOKS", false),
+	}
+	if err := residency.CheckStorage(storage); err != nil {
+		return nil, fmt.Errorf("DATA_RESIDENCY_EXPORTS: %w", err)
+	}
+	gateReportWebhook := getEnv("GATE_REPORT_WEBHOOK_URL", "")
+	alertWebhook := getEnv("ALERT_WEBHOOK_URL", "")
+	for name, webhookURL := range map[string]string{"GATE_REPORT_WEBHOOK_URL": gateReportWebhook, "ALERT_WEBHOOK_URL": alertWebhook} {
+		if err := residency.CheckWebhook(webhookURL); err != nil {
+			return nil, fmt.Errorf("DATA_RESIDENCY_WEBHOOKS: %s: %w", name, err)
+		}
+	}
+
+	maxSessions := getEnvInt("JWT_MAX_SESSIONS", 0)
+	if maxSessions < 0 {
+		return nil, fmt.Errorf("invalid JWT_MAX_SESSIONS %d, use 0 for unlimited", maxSessions)
+	}
+	staleDeviceAfter := getEnvDuration("JWT_STALE_DEVICE_AFTER", 180*24*time.Hour)
+	if staleDeviceAfter <= 0 {
+		return nil, fmt.Errorf("invalid JWT_STALE_DEVICE_AFTER %s, use a positive duration", staleDeviceAfter)
+	}
+	adminAccessExpiry := getEnvDuration("JWT_ADMIN_ACCESS_EXPIRY", 30*time.Minute)
+	adminRefreshExpiry := getEnvDuration("JWT_ADMIN_REFRESH_EXPIRY", 24*time.Hour)
+	if adminAccessExpiry <= 0 || adminRefreshExpiry < adminAccessExpiry {
+		return nil, fmt.Errorf("invalid JWT_ADMIN_ACCESS_EXPIRY %s / JWT_ADMIN_REFRESH_EXPIRY %s, use positive durations with the refresh expiry not shorter than the access expiry",
+			adminAccessExpiry, adminRefreshExpiry)
+	}
+
+	webhooks := WebhooksConfig{
+		SigningSecret: getEnv("WEBHOOK_SIGNING_SECRET", ""),
+		SecretOverlap: getEnvDuration("WEBHOOK_SECRET_OVERLAP", 24*time.Hour),
+	}
+	if webhooks.SecretOverlap <= 0 {
+		return nil, fmt.Errorf("invalid WEBHOOK_SECRET_OVERLAP %s, use a positive duration", webhooks.SecretOverlap)
+	}
+
+	defaultTimezone := getEnv("DEFAULT_TIMEZONE", "UTC")
+	if _, err := time.LoadLocation(defaultTimezone); err != nil || defaultTimezone == "Local" {
+		return nil, fmt.Errorf("invalid DEFAULT_TIMEZONE %q, use an IANA zone name such as Asia/Bishkek", defaultTimezone)
+	}
+
+	security := SecurityConfig{DenialRetention: getEnvDuration("SECURITY_DENIAL_RETENTION", 90*24*time.Hour)}
+	if security.DenialRetention <= 0 {
+		return nil, fmt.Errorf("invalid SECURITY_DENIAL_RETENTION %s, use a positive duration", security.DenialRetention)
+	}
+
+	login := LoginConfig{
+		MaxFailedAttempts:   getEnvInt("LOGIN_MAX_FAILED_ATTEMPTS", 5),
+		IPMaxFailedAttempts: getEnvInt("LOGIN_IP_MAX_FAILED_ATTEMPTS", 20),
+		FailureWindow:       getEnvDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+		Lockout:             getEnvDuration("LOGIN_LOCKOUT", time.Minute),
+		MaxLockout:          getEnvDuration("LOGIN_MAX_LOCKOUT", time.Hour),
+	}
+	for name, value := range map[string]time.Duration{"LOGIN_FAILURE_WINDOW": login.FailureWindow, "LOGIN_LOCKOUT": login.Lockout} {
+		if value <= 0 {
+			return nil, fmt.Errorf("invalid %s %s, use a positive duration", name, value)
+		}
+	}
+	if login.MaxLockout < login.Lockout {
+		return nil, fmt.Errorf("invalid LOGIN_MAX_LOCKOUT %s, must not be shorter than LOGIN_LOCKOUT %s", login.MaxLockout, login.Lockout)
+	}
+
+	thirdPartyRetries := getEnvInt("THIRD_PARTY_RETRIES", 2)
+	thirdPartyRetryBackoff := getEnvDuration("THIRD_PARTY_RETRY_BACKOFF", 200*time.Millisecond)
+	thirdPartyRetryMaxBackoff := getEnvDuration("THIRD_PARTY_RETRY_MAX_BACKOFF", 2*time.Second)
+	if thirdPartyRetries < 0 {
+		return nil, fmt.Errorf("invalid THIRD_PARTY_RETRIES %d, use 0 or more", thirdPartyRetries)
+	}
+	if thirdPartyRetryBackoff < 0 || thirdPartyRetryMaxBackoff < thirdPartyRetryBackoff {
+		return nil, fmt.Errorf("invalid THIRD_PARTY_RETRY_BACKOFF %s and THIRD_PARTY_RETRY_MAX_BACKOFF %s, the maximum must not be shorter", thirdPartyRetryBackoff, thirdPartyRetryMaxBackoff)
+	}
+
+	assignmentRetryBackoff := getEnvDuration("ASSIGNMENT_RETRY_BACKOFF", time.Minute)
+	assignmentRetryMaxBackoff := getEnvDuration("ASSIGNMENT_RETRY_MAX_BACKOFF", time.Hour)
+	assignmentRetryMaxAttempts := getEnvInt("ASSIGNMENT_RETRY_MAX_ATTEMPTS", 10)
+	if assignmentRetryBackoff <= 0 || assignmentRetryMaxBackoff < assignmentRetryBackoff {
+		return nil, fmt.Errorf("invalid ASSIGNMENT_RETRY_BACKOFF %s and ASSIGNMENT_RETRY_MAX_BACKOFF %s, use a positive backoff and a maximum that is not shorter", assignmentRetryBackoff, assignmentRetryMaxBackoff)
+	}
+	if assignmentRetryMaxAttempts < 0 {
+		return nil, fmt.Errorf("invalid ASSIGNMENT_RETRY_MAX_ATTEMPTS %d, use 0 or more", assignmentRetryMaxAttempts)
+	}
+
+	requestTimeout := getEnvDuration("REQUEST_TIMEOUT", 30*time.Second)
+	if requestTimeout < 0 {
+		return nil, fmt.Errorf("invalid REQUEST_TIMEOUT %s, use 0 or a positive duration", requestTimeout)
+	}
+
+	logLevel := strings.ToLower(getEnv("LOG_LEVEL", "info"))
+	if _, err := logging.ParseLevel(logLevel); err != nil {
+		return nil, fmt.Errorf("invalid LOG_LEVEL: %w", err)
+	}
+	logFormat := strings.ToLower(getEnv("LOG_FORMAT", "json"))
+	if logFormat != "json" && logFormat != "text" {
+		return nil, fmt.Errorf("invalid LOG_FORMAT %q, use json or text", logFormat)
+	}
+
+	faultRoutes, err := parseFaultRoutes(getEnv("FAULT_ROUTES", ""))
+	if err != nil {
+		return nil, err
+	}
+	faults := FaultsConfig{
+		Enabled:           getEnvBool("FAULT_INJECTION", false),
+		Routes:            faultRoutes,
+		Latency:           getEnvDuration("FAULT_LATENCY", time.Second),
+		LatencyRate:       getEnvFloat("FAULT_LATENCY_RATE", 0),
+		ProviderErrorRate: getEnvFloat("FAULT_PROVIDER_ERROR_RATE", 0),
+		DBErrorRate:       getEnvFloat("FAULT_DB_ERROR_RATE", 0),
+	}
+	if faults.Enabled && getEnv("ENV", "development") == "production" {
+		return nil, fmt.Errorf("FAULT_INJECTION cannot be enabled in production")
+	}
+	if faults.Latency < 0 {
+		return nil, fmt.Errorf("invalid FAULT_LATENCY %s, use a positive duration or 0", faults.Latency)
+	}
+	for name, rate := range map[string]float64{"FAULT_LATENCY_RATE": faults.LatencyRate, "FAULT_PROVIDER_ERROR_RATE": faults.ProviderErrorRate, "FAULT_DB_ERROR_RATE": faults.DBErrorRate} {
+		if rate < 0 || rate > 100 {
+			return nil, fmt.Errorf("invalid %s %v, use a percentage between 0 and 100", name, rate)
+		}
+	}
+
+	for name, value := range map[string]string{"ENCRYPTION_KEY": encryption.Key, "BLIND_INDEX_KEY": encryption.IndexKey} {
+		if _, err := DecodeEncryptionKey(value); value != "" && err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
 	}
 
-	AppConfig = &Config{
+	return &Config{
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     getEnv("DB_PORT", "5432"),
 			User:     getEnv("DB_USER", "postgres"),
 			Password: getEnv("DB_PASSWORD", "postgres"),
 			DBName:   getEnv("DB_NAME", "ololo_gate"),
+
+			MaxOpenConns:    getEnvInt("DB_MAX_OPEN_CONNS", 100),
+			MaxIdleConns:    getEnvInt("DB_MAX_IDLE_CONNS", 10),
+			ConnMaxLifetime: getEnvDuration("DB_CONN_MAX_LIFETIME", 30*time.Minute),
+			ConnMaxIdleTime: getEnvDuration("DB_CONN_MAX_IDLE_TIME", 5*time.Minute),
+
+			PrepareStmt:            getEnvBool("DB_PREPARE_STMT", true),
+			PreparedStmtCacheSize:  getEnvInt("DB_PREPARED_STMT_CACHE_SIZE", 500),
+			SkipDefaultTransaction: getEnvBool("DB_SKIP_DEFAULT_TRANSACTION", false),
+
+			MigrateOnStart: getEnvBool("DB_MIGRATE_ON_START", getEnv("ENV", "development") != "production"),
 		},
 		JWT: JWTConfig{
 			Secret:        getEnv("JWT_SECRET", "your-super-secret-key-change-in-production"),
-			AccessExpiry:  accessExpiry,
-			RefreshExpiry: refreshExpiry,
+			AccessExpiry:   accessExpiry,
+			RefreshExpiry:  refreshExpiry,
+			ClientProfiles: clientProfiles,
+			Issuer:         getEnv("JWT_ISSUER", "ololo-gate"),
+			Audience:       getEnv("JWT_AUDIENCE", "ololo-gate-api"),
+			Leeway:         getEnvDuration("JWT_LEEWAY", 30*time.Second),
+			RequireDevice:  getEnvBool("JWT_REQUIRE_DEVICE_HEADER", true),
+
+			TrustedRefreshExpiry: getEnvDuration("JWT_TRUSTED_REFRESH_EXPIRY", 90*24*time.Hour),
+			VersionCacheTTL:      getEnvDuration("JWT_VERSION_CACHE_TTL", 5*time.Second),
+			MaxSessions:          maxSessions,
+			StaleDeviceAfter:     staleDeviceAfter,
+			StaleDevicePurge:     getEnvBool("JWT_STALE_DEVICE_PURGE", false),
+
+			AdminAccessExpiry:  adminAccessExpiry,
+			AdminRefreshExpiry: adminRefreshExpiry,
 		},
 		Server: ServerConfig{
-			Port: getEnv("PORT", "8080"),
-			Env:  getEnv("ENV", "development"),
+			Port:            getEnv("PORT", "8080"),
+			Env:             getEnv("ENV", "development"),
+			HealthToken:     getEnv("HEALTH_TOKEN", ""),
+			DefaultTimezone: defaultTimezone,
+			RequestTimeout:  requestTimeout,
 		},
 		CORS: CORSConfig{
-			AllowedOrigins: getEnv("CORS_ALLOWED_ORIGINS", "*"),
+			AllowedOrigins:      getEnv("CORS_ALLOWED_ORIGINS", "*"),
+			AdminAllowedOrigins: getEnv("CORS_ADMIN_ALLOWED_ORIGINS", ""),
 		},
 		InitAdmin: InitAdminConfig{
 			UUID:     getEnv("INIT_ADMIN_UUID", "00000000-0000-0000-0000-000000000001"),
 			Username: getEnv("INIT_ADMIN", "admin"),
 			Password: getEnv("INIT_ADMIN_PASSWORD", "admin"),
 		},
+		Assignment: AssignmentConfig{
+			StrictMode:       getEnvBool("ASSIGNMENT_STRICT_MODE", false),
+			RetryBackoff:     assignmentRetryBackoff,
+			RetryMaxBackoff:  assignmentRetryMaxBackoff,
+			RetryMaxAttempts: assignmentRetryMaxAttempts,
+		},
+		Users: UsersConfig{
+			TrashRetention:       getEnvDuration("USER_TRASH_RETENTION", 7*24*time.Hour),
+			OpenRegistration:     getEnvBool("USER_OPEN_REGISTRATION", false),
+			RegistrationApproval: getEnvBool("USER_REGISTRATION_APPROVAL", false),
+			PasswordMaxAge:       getEnvDuration("PASSWORD_MAX_AGE", 0),
+		},
+		Gates: GatesConfig{
+			CommandHoldWindow:     getEnvDuration("GATE_COMMAND_HOLD_WINDOW", 3*time.Second),
+			ConfirmInterval:       getEnvDuration("GATE_COMMAND_CONFIRM_INTERVAL", 2*time.Second),
+			ConfirmAttempts:       getEnvInt("GATE_COMMAND_CONFIRM_ATTEMPTS", 5),
+			ProviderCallbackToken: getEnv("GATE_PROVIDER_CALLBACK_TOKEN", ""),
+			CommandRetention:      commandRetention,
+			QueueTTL:              getEnvDuration("GATE_COMMAND_QUEUE_TTL", 2*time.Minute),
+		},
+		GateReports: GateReportsConfig{
+			MaxPhotoSize: int64(getEnvInt("GATE_REPORT_MAX_PHOTO_SIZE", 3<<20)),
+			WebhookURL:   gateReportWebhook,
+			EmailTo:      splitList(getEnv("GATE_REPORT_EMAIL_TO", "")),
+		},
+		Storage: storage,
+		Exports: ExportsConfig{
+			Retention: getEnvDuration("EXPORT_RETENTION", 24*time.Hour),
+		},
+		ThirdParty: ThirdPartyConfig{
+			RateLimit:    getEnvInt("THIRD_PARTY_RATE_LIMIT", 0),
+			Burst:        getEnvInt("THIRD_PARTY_BURST", 10),
+			QueueTimeout: getEnvDuration("THIRD_PARTY_QUEUE_TIMEOUT", 5*time.Second),
+			Timeout:      getEnvDuration("THIRD_PARTY_TIMEOUT", 30*time.Second),
+
+			Retries:         thirdPartyRetries,
+			RetryBackoff:    thirdPartyRetryBackoff,
+			RetryMaxBackoff: thirdPartyRetryMaxBackoff,
+
+			BreakerThreshold: getEnvInt("THIRD_PARTY_BREAKER_THRESHOLD", 5),
+			BreakerCooldown:  getEnvDuration("THIRD_PARTY_BREAKER_COOLDOWN", 30*time.Second),
+
+			MonthlyQuota:       int64(getEnvInt("THIRD_PARTY_MONTHLY_QUOTA", 0)),
+			MirrorMonthlyQuota: int64(getEnvInt("THIRD_PARTY_MIRROR_MONTHLY_QUOTA", 0)),
+
+			HedgeDelay: getEnvDuration("THIRD_PARTY_HEDGE_DELAY", 0),
+
+			MirrorURL:          getEnv("THIRD_PARTY_MIRROR_API_URL", ""),
+			MirrorGateCommands: getEnvBool("THIRD_PARTY_MIRROR_GATE_COMMANDS", true),
+		},
+		Quotas: QuotaConfig{
+			AdminHourly:  getEnvInt("ADMIN_QUOTA_HOURLY", 0),
+			AdminDaily:   getEnvInt("ADMIN_QUOTA_DAILY", 0),
+			APIKeyHourly: getEnvInt("API_KEY_QUOTA_HOURLY", 0),
+			APIKeyDaily:  getEnvInt("API_KEY_QUOTA_DAILY", 0),
+			ExportDaily:  getEnvInt("EXPORT_QUOTA_DAILY", 0),
+		},
+		Metering: MeteringConfig{
+			OrgID:         getEnv("METERING_ORG_ID", "default"),
+			FlushInterval: getEnvDuration("METERING_FLUSH_INTERVAL", 30*time.Second),
+		},
+		Sentry: SentryConfig{
+			DSN:         getEnv("SENTRY_DSN", ""),
+			Environment: getEnv("SENTRY_ENVIRONMENT", getEnv("ENV", "development")),
+			Release:     getEnv("SENTRY_RELEASE", ""),
+		},
+		Encryption:       encryption,
+		Phone: PhoneConfig{
+			DefaultRegion:    getEnv("PHONE_DEFAULT_REGION", "KZ"),
+			AllowedCountries: splitList(strings.ToUpper(getEnv("PHONE_ALLOWED_COUNTRIES", ""))),
+		},
+		Impersonation: ImpersonationConfig{
+			TokenTTL:            getEnvDuration("IMPERSONATION_TOKEN_TTL", 15*time.Minute),
+			AllowGateOperations: getEnvBool("IMPERSONATION_ALLOW_GATE_OPERATIONS", false),
+		},
+		SMS: SMSConfig{
+			GatewayURL:   getEnv("SMS_GATEWAY_URL", ""),
+			GatewayToken: getEnv("SMS_GATEWAY_TOKEN", ""),
+			Timeout:      getEnvDuration("SMS_GATEWAY_TIMEOUT", 10*time.Second),
+		},
+		OTP: OTPConfig{
+			LoginEnabled:         getEnvBool("OTP_LOGIN_ENABLED", false),
+			RegistrationEnabled:  getEnvBool("OTP_REGISTRATION_ENABLED", false),
+			PasswordResetEnabled: getEnvBool("OTP_PASSWORD_RESET_ENABLED", true),
+			Length:               getEnvInt("OTP_LENGTH", 6),
+			TTL:                  getEnvDuration("OTP_TTL", 5*time.Minute),
+			MaxAttempts:          getEnvInt("OTP_MAX_ATTEMPTS", 5),
+			ResendInterval:       getEnvDuration("OTP_RESEND_INTERVAL", time.Minute),
+			PhoneHourly:          getEnvInt("OTP_PHONE_HOURLY", 5),
+			IPHourly:             getEnvInt("OTP_IP_HOURLY", 20),
+		},
+		Links: LinksConfig{
+			BaseURL:    getEnv("GATE_LINK_BASE_URL", ""),
+			AppScheme:  getEnv("GATE_LINK_APP_SCHEME", "ololo-gate"),
+			DefaultTTL: getEnvDuration("GATE_LINK_TTL", 24*time.Hour),
+			MaxTTL:     getEnvDuration("GATE_LINK_MAX_TTL", 30*24*time.Hour),
+
+			MaxFailedAttempts: getEnvInt("GATE_LINK_MAX_FAILED_ATTEMPTS", 5),
+			IPHourlyFailures:  getEnvInt("GATE_LINK_IP_HOURLY_FAILURES", 20),
+			AlertFailures:     getEnvInt("GATE_LINK_ALERT_FAILURES", 10),
+		},
+		Alerts: AlertsConfig{
+			CheckInterval: getEnvDuration("ALERT_CHECK_INTERVAL", time.Minute),
+			Rules:         alertRules,
+			WebhookURL:    alertWebhook,
+			EmailTo:       splitList(getEnv("ALERT_EMAIL_TO", "")),
+			SMTPAddr:      getEnv("SMTP_ADDR", ""),
+			SMTPUsername:  getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:  getEnv("SMTP_PASSWORD", ""),
+			SMTPFrom:      getEnv("SMTP_FROM", ""),
+		},
+		SLO: SLOConfig{
+			Window:     getEnvDuration("SLO_WINDOW", 24*time.Hour),
+			Objectives: sloObjectives,
+		},
+		WebAuthn:         webAuthn,
+		Digest:           digest,
+		Swagger:          swagger,
+		Residency:        residency,
+		Webhooks:         webhooks,
+		Sandbox:          SandboxConfig{Enabled: getEnvBool("SANDBOX_MODE", false)},
+		Security:         security,
+		Login:            login,
+		Faults:           faults,
+		Logging:          LoggingConfig{Level: logLevel, Format: logFormat},
 		ThirdPartyAPIURL: getEnv("THIRD_PARTY_API_URL", "https://localhost:3000"),
-	}
+	}, nil
+}
 
-	log.Println("✅ Configuration loaded successfully")
+// DecodeEncryptionKey decodes a base64-encoded 32-byte key
+func DecodeEncryptionKey(value string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("key must be base64-encoded: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes, got %d", len(key))
+	}
+	return key, nil
 }
 
-// getEnv retrieves an environment variable or returns a default value
+// getEnv retrieves an environment variable (or CONFIG_FILE setting) or returns a default value
 func getEnv(key, defaultValue string) string {
-	value := os.Getenv(key)
+	value := lookupEnv(key)
 	if value == "" {
 		return defaultValue
 	}
 	return value
 }
+
+// parseClientProfiles parses "name:access[:refresh],..." (e.g. "kiosk:12h,resident:15m:720h").
+// The refresh expiry defaults to the global refresh expiry when omitted.
+func parseClientProfiles(value string, defaultRefresh time.Duration) (map[string]ClientProfile, error) {
+	profiles := make(map[string]ClientProfile)
+	if value == "" {
+		return profiles, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid JWT_CLIENT_PROFILES entry %q, use name:access[:refresh]", entry)
+		}
+
+		access, err := time.ParseDuration(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid access expiry in JWT_CLIENT_PROFILES entry %q: %w", entry, err)
+		}
+		refresh := defaultRefresh
+		if len(parts) == 3 {
+			refresh, err = time.ParseDuration(parts[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid refresh expiry in JWT_CLIENT_PROFILES entry %q: %w", entry, err)
+			}
+		}
+
+		profiles[parts[0]] = ClientProfile{AccessExpiry: access, RefreshExpiry: refresh}
+		slog.Debug("JWT client profile", "client", parts[0], "access", access, "refresh", refresh)
+	}
+	return profiles, nil
+}
+
+// parseAlertRules parses "metric:threshold:for,..." (e.g. "provider_error_rate:20:5m,job_backlog:0:15m")
+func parseAlertRules(value string) ([]AlertRule, error) {
+	var rules []AlertRule
+	for _, entry := range splitList(value) {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid ALERT_RULES entry %q, use metric:threshold:for", entry)
+		}
+		threshold, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold in ALERT_RULES entry %q: %w", entry, err)
+		}
+		duration, err := time.ParseDuration(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration in ALERT_RULES entry %q: %w", entry, err)
+		}
+		rules = append(rules, AlertRule{Metric: parts[0], Threshold: threshold, For: duration})
+	}
+	return rules, nil
+}
+
+// parseSLOObjectives parses "name METHOD path latency target,..." (e.g. "gate_open PUT /api/v1/locations/:gateId/open 2s 95")
+func parseSLOObjectives(value string) ([]SLOObjective, error) {
+	var objectives []SLOObjective
+	for _, entry := range splitList(value) {
+		parts := strings.Fields(entry)
+		if len(parts) != 5 {
+			return nil, fmt.Errorf("invalid SLO_OBJECTIVES entry %q, use name METHOD path latency target", entry)
+		}
+		latency, err := time.ParseDuration(parts[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid latency in SLO_OBJECTIVES entry %q: %w", entry, err)
+		}
+		target, err := strconv.ParseFloat(parts[4], 64)
+		if err != nil || target <= 0 || target >= 100 {
+			return nil, fmt.Errorf("invalid target in SLO_OBJECTIVES entry %q, use a percentage between 0 and 100", entry)
+		}
+		objectives = append(objectives, SLOObjective{
+			Name:    parts[0],
+			Method:  strings.ToUpper(parts[1]),
+			Path:    parts[2],
+			Latency: latency,
+			Target:  target,
+		})
+	}
+	return objectives, nil
+}
+
+// parseFaultRoutes parses "[METHOD] path,..." (e.g. "PUT /api/v1/locations/:gateId/open,/api/v1/admin/*")
+func parseFaultRoutes(value string) ([]FaultRoute, error) {
+	var routes []FaultRoute
+	for _, entry := range splitList(value) {
+		parts := strings.Fields(entry)
+		switch {
+		case len(parts) == 1 && strings.HasPrefix(parts[0], "/"):
+			routes = append(routes, FaultRoute{Path: parts[0]})
+		case len(parts) == 2 && strings.HasPrefix(parts[1], "/"):
+			routes = append(routes, FaultRoute{Method: strings.ToUpper(parts[0]), Path: parts[1]})
+		default:
+			return nil, fmt.Errorf("invalid FAULT_ROUTES entry %q, use [METHOD] path", entry)
+		}
+	}
+	return routes, nil
+}
+
+// splitList splits a comma-separated value, dropping blanks
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getEnvBool retrieves a boolean environment variable or returns a default value
+func getEnvBool(key string, defaultValue bool) bool {
+	value := lookupEnv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		slog.Warn("Invalid boolean, using default", "key", key, "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// getEnvDuration retrieves a duration environment variable or returns a default value
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := lookupEnv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		slog.Warn("Invalid duration, using default", "key", key, "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// getEnvInt retrieves an integer environment variable or returns a default value
+func getEnvInt(key string, defaultValue int) int {
+	value := lookupEnv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		slog.Warn("Invalid integer, using default", "key", key, "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// getEnvFloat retrieves a decimal environment variable or returns a default value
+func getEnvFloat(key string, defaultValue float64) float64 {
+	value := lookupEnv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		slog.Warn("Invalid number, using default", "key", key, "value", value, "default", defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildConfig_DataResidencyRejectsOutOfPolicyTargets(t *testing.T) {
+	t.Setenv("DATA_RESIDENCY_REGIONS", "eu-central-1,eu-west-1")
+	t.Setenv("DATA_RESIDENCY_HOSTS", "hooks.example.eu,.internal.example.eu")
+	t.Setenv("DATA_RESIDENCY_EXPORTS", "true")
+	t.Setenv("DATA_RESIDENCY_WEBHOOKS", "true")
+	t.Setenv("STORAGE_DRIVER", "s3")
+	t.Setenv("STORAGE_S3_BUCKET", "exports")
+	t.Setenv("STORAGE_S3_ACCESS_KEY_ID", "key")
+	t.Setenv("STORAGE_S3_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("STORAGE_S3_REGION", "eu-central-1")
+	t.Setenv("ALERT_WEBHOOK_URL", "https://pager.internal.example.eu/alerts")
+	t.Setenv("GATE_REPORT_WEBHOOK_URL", "https://hooks.example.eu/reports")
+
+	cfg, err := buildConfig()
+	assert.NoError(t, err)
+	assert.True(t, cfg.Residency.RestrictExports)
+
+	t.Setenv("STORAGE_S3_REGION", "us-east-1")
+	_, err = buildConfig()
+	assert.ErrorContains(t, err, "DATA_RESIDENCY_EXPORTS")
+
+	// A custom endpoint is checked by host, whatever region it claims
+	t.Setenv("STORAGE_S3_ENDPOINT", "https://minio.internal.example.eu:9000")
+	_, err = buildConfig()
+	assert.NoError(t, err)
+
+	t.Setenv("GATE_REPORT_WEBHOOK_URL", "https://hooks.example.com/reports")
+	_, err = buildConfig()
+	assert.ErrorContains(t, err, "GATE_REPORT_WEBHOOK_URL")
+
+	// Without the flags any destination is accepted
+	t.Setenv("DATA_RESIDENCY_EXPORTS", "false")
+	t.Setenv("DATA_RESIDENCY_WEBHOOKS", "false")
+	t.Setenv("STORAGE_S3_ENDPOINT", "")
+	cfg, err = buildConfig()
+	assert.NoError(t, err)
+	assert.NoError(t, cfg.Residency.CheckWebhook("https://hooks.example.com/reports"))
+}
+
+func TestBuildConfig_FaultInjection(t *testing.T) {
+	t.Setenv("FAULT_INJECTION", "true")
+	t.Setenv("FAULT_ROUTES", "put /api/v1/locations/:gateId/open, /api/v1/admin/*")
+	t.Setenv("FAULT_PROVIDER_ERROR_RATE", "12.5")
+
+	cfg, err := buildConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, []FaultRoute{
+		{Method: "PUT", Path: "/api/v1/locations/:gateId/open"},
+		{Path: "/api/v1/admin/*"},
+	}, cfg.Faults.Routes)
+	assert.Equal(t, 12.5, cfg.Faults.ProviderErrorRate)
+
+	t.Setenv("FAULT_DB_ERROR_RATE", "150")
+	_, err = buildConfig()
+	assert.ErrorContains(t, err, "FAULT_DB_ERROR_RATE")
+	t.Setenv("FAULT_DB_ERROR_RATE", "")
+
+	t.Setenv("FAULT_ROUTES", "PUT locations")
+	_, err = buildConfig()
+	assert.ErrorContains(t, err, "FAULT_ROUTES")
+	t.Setenv("FAULT_ROUTES", "")
+
+	// Never in production
+	t.Setenv("ENV", "production")
+	t.Setenv("ENCRYPTION_KEY", "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+	_, err = buildConfig()
+	assert.ErrorContains(t, err, "FAULT_INJECTION")
+}
+
+func TestBuildConfig_Logging(t *testing.T) {
+	cfg, err := buildConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, LoggingConfig{Level: "info", Format: "json"}, cfg.Logging)
+
+	t.Setenv("LOG_LEVEL", "DEBUG")
+	t.Setenv("LOG_FORMAT", "text")
+	cfg, err = buildConfig()
+	assert.NoError(t, err)
+	assert.Equal(t, LoggingConfig{Level: "debug", Format: "text"}, cfg.Logging)
+
+	t.Setenv("LOG_LEVEL", "verbose")
+	_, err = buildConfig()
+	assert.ErrorContains(t, err, "LOG_LEVEL")
+	t.Setenv("LOG_LEVEL", "")
+
+	t.Setenv("LOG_FORMAT", "xml")
+	_, err = buildConfig()
+	assert.ErrorContains(t, err, "LOG_FORMAT")
+}
+
+func TestResidencyConfig_CheckWebhook(t *testing.T) {
+	residency := ResidencyConfig{AllowedHosts: []string{"hooks.example.eu", ".example.de"}, RestrictWebhooks: true}
+
+	assert.NoError(t, residency.CheckWebhook(""))
+	assert.NoError(t, residency.CheckWebhook("https://HOOKS.example.eu:8443/x"))
+	assert.NoError(t, residency.CheckWebhook("https://chat.example.de/hook"))
+	assert.Error(t, residency.CheckWebhook("https://example.de.attacker.com/hook"))
+	assert.Error(t, residency.CheckWebhook("https://evilexample.de/hook"))
+	assert.Error(t, residency.CheckWebhook("not a url"))
+}
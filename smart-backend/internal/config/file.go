@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/logging"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileValues holds settings from CONFIG_FILE, keyed by their environment variable name.
+// Environment variables (including .env) take precedence over them.
+var fileValues = map[string]string{}
+
+// loadConfigFile reads the YAML file named by CONFIG_FILE. Nested keys map to environment
+// variable names (third_party: {rate_limit: 5} sets THIRD_PARTY_RATE_LIMIT), and the
+// "environments" section holds per-ENV overrides layered over the base values:
+//
+//	jwt:
+//	  access_expiry: 15m
+//	environments:
+//	  production:
+//	    cors:
+//	      allowed_origins: [https://admin.example.com]
+func loadConfigFile() {
+	values, err := readConfigFile()
+	if err != nil {
+		logging.Fatal("Invalid config file", "error", err)
+	}
+	fileValues = values
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		slog.Info("Loaded settings from config file", "count", len(values), "path", path, "env", lookupEnv("ENV"))
+	}
+}
+
+// readConfigFile reads and flattens CONFIG_FILE, returning no values when it is not set
+func readConfigFile() (map[string]string, error) {
+	path := os.Getenv("CONFIG_FILE")
+	if path == "" {
+		return map[string]string{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CONFIG_FILE %s: %w", path, err)
+	}
+
+	values, err := parseConfigFile(data, os.Getenv("ENV"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CONFIG_FILE %s: %w", path, err)
+	}
+	return values, nil
+}
+
+// parseConfigFile flattens the base settings and the overrides for env into environment
+// variable names. When env is empty, the ENV value from the file itself selects the overrides.
+func parseConfigFile(data []byte, env string) (map[string]string, error) {
+	var root map[string]interface{}
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+
+	environments, _ := root["environments"].(map[string]interface{})
+	delete(root, "environments")
+
+	values := map[string]string{}
+	if err := flattenConfig("", root, values); err != nil {
+		return nil, err
+	}
+
+	if env == "" {
+		env = values["ENV"]
+	}
+	if overrides, ok := environments[env]; ok {
+		section, ok := overrides.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("environments.%s must be a mapping", env)
+		}
+		if err := flattenConfig("", section, values); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// flattenConfig joins nested keys with "_" and upper-cases them. Lists become comma-separated values.
+func flattenConfig(prefix string, node map[string]interface{}, values map[string]string) error {
+	for key, value := range node {
+		name := strings.ToUpper(key)
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if err := flattenConfig(name, v, values); err != nil {
+				return err
+			}
+		case []interface{}:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+			values[name] = strings.Join(items, ",")
+		case nil:
+			values[name] = ""
+		default:
+			values[name] = fmt.Sprint(v)
+		}
+	}
+	return nil
+}
+
+// lookupEnv returns the environment variable if set, otherwise the value from CONFIG_FILE
+func lookupEnv(key string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fileValues[key]
+}
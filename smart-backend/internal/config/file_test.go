@@ -0,0 +1,61 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigFile = `
+env: staging
+jwt:
+  access_expiry: 15m
+  issuer: ololo-gate
+cors:
+  allowed_origins: [https://a.example, https://b.example]
+third_party:
+  rate_limit: 5
+environments:
+  staging:
+    jwt:
+      issuer: ololo-gate-staging
+  production:
+    third_party:
+      rate_limit: 50
+`
+
+func TestParseConfigFile_FlattensAndLayersEnvironment(t *testing.T) {
+	values, err := parseConfigFile([]byte(testConfigFile), "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "15m", values["JWT_ACCESS_EXPIRY"])
+	assert.Equal(t, "https://a.example,https://b.example", values["CORS_ALLOWED_ORIGINS"])
+	assert.Equal(t, "ololo-gate-staging", values["JWT_ISSUER"]) // ENV from the file selects staging
+	assert.Equal(t, "5", values["THIRD_PARTY_RATE_LIMIT"])
+
+	values, err = parseConfigFile([]byte(testConfigFile), "production")
+	assert.NoError(t, err)
+	assert.Equal(t, "ololo-gate", values["JWT_ISSUER"])
+	assert.Equal(t, "50", values["THIRD_PARTY_RATE_LIMIT"])
+}
+
+func TestLookupEnv_EnvironmentWinsOverFile(t *testing.T) {
+	fileValues = map[string]string{"JWT_ISSUER": "from-file", "JWT_AUDIENCE": "from-file"}
+	defer func() { fileValues = map[string]string{} }()
+
+	os.Setenv("JWT_ISSUER", "from-env")
+	defer os.Unsetenv("JWT_ISSUER")
+
+	assert.Equal(t, "from-env", getEnv("JWT_ISSUER", "default"))
+	assert.Equal(t, "from-file", getEnv("JWT_AUDIENCE", "default"))
+	assert.Equal(t, "default", getEnv("JWT_UNSET", "default"))
+}
+
+func TestParseConfigFile_Invalid(t *testing.T) {
+	_, err := parseConfigFile([]byte("jwt: [unterminated"), "")
+	assert.Error(t, err)
+
+	_, err = parseConfigFile([]byte("environments:\n  production: 5\nenv: production\n"), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/joho/godotenv"
+)
+
+// reloadableSettings are the settings Reload applies to the running server, keyed by their
+// environment variable name. Everything else (database, JWT secret and lifetimes, port,
+// provider URL, ...) is only read at startup.
+var reloadableSettings = []struct {
+	name  string
+	field func(cfg *Config) interface{} // Pointer to the setting inside cfg
+}{
+	{"CORS_ALLOWED_ORIGINS", func(cfg *Config) interface{} { return &cfg.CORS.AllowedOrigins }},
+	{"CORS_ADMIN_ALLOWED_ORIGINS", func(cfg *Config) interface{} { return &cfg.CORS.AdminAllowedOrigins }},
+	{"THIRD_PARTY_RATE_LIMIT", func(cfg *Config) interface{} { return &cfg.ThirdParty.RateLimit }},
+	{"THIRD_PARTY_BURST", func(cfg *Config) interface{} { return &cfg.ThirdParty.Burst }},
+	{"THIRD_PARTY_QUEUE_TIMEOUT", func(cfg *Config) interface{} { return &cfg.ThirdParty.QueueTimeout }},
+	{"THIRD_PARTY_TIMEOUT", func(cfg *Config) interface{} { return &cfg.ThirdParty.Timeout }},
+	{"THIRD_PARTY_RETRIES", func(cfg *Config) interface{} { return &cfg.ThirdParty.Retries }},
+	{"THIRD_PARTY_RETRY_BACKOFF", func(cfg *Config) interface{} { return &cfg.ThirdParty.RetryBackoff }},
+	{"THIRD_PARTY_RETRY_MAX_BACKOFF", func(cfg *Config) interface{} { return &cfg.ThirdParty.RetryMaxBackoff }},
+	{"ADMIN_QUOTA_HOURLY", func(cfg *Config) interface{} { return &cfg.Quotas.AdminHourly }},
+	{"ADMIN_QUOTA_DAILY", func(cfg *Config) interface{} { return &cfg.Quotas.AdminDaily }},
+	{"API_KEY_QUOTA_HOURLY", func(cfg *Config) interface{} { return &cfg.Quotas.APIKeyHourly }},
+	{"API_KEY_QUOTA_DAILY", func(cfg *Config) interface{} { return &cfg.Quotas.APIKeyDaily }},
+	{"EXPORT_QUOTA_DAILY", func(cfg *Config) interface{} { return &cfg.Quotas.ExportDaily }},
+	{"ASSIGNMENT_STRICT_MODE", func(cfg *Config) interface{} { return &cfg.Assignment.StrictMode }},
+	{"ASSIGNMENT_RETRY_BACKOFF", func(cfg *Config) interface{} { return &cfg.Assignment.RetryBackoff }},
+	{"ASSIGNMENT_RETRY_MAX_BACKOFF", func(cfg *Config) interface{} { return &cfg.Assignment.RetryMaxBackoff }},
+	{"ASSIGNMENT_RETRY_MAX_ATTEMPTS", func(cfg *Config) interface{} { return &cfg.Assignment.RetryMaxAttempts }},
+	{"JWT_REQUIRE_DEVICE_HEADER", func(cfg *Config) interface{} { return &cfg.JWT.RequireDevice }},
+	{"JWT_LEEWAY", func(cfg *Config) interface{} { return &cfg.JWT.Leeway }},
+	{"GATE_COMMAND_CONFIRM_INTERVAL", func(cfg *Config) interface{} { return &cfg.Gates.ConfirmInterval }},
+	{"GATE_COMMAND_CONFIRM_ATTEMPTS", func(cfg *Config) interface{} { return &cfg.Gates.ConfirmAttempts }},
+	{"OTP_LOGIN_ENABLED", func(cfg *Config) interface{} { return &cfg.OTP.LoginEnabled }},
+	{"OTP_REGISTRATION_ENABLED", func(cfg *Config) interface{} { return &cfg.OTP.RegistrationEnabled }},
+	{"OTP_PASSWORD_RESET_ENABLED", func(cfg *Config) interface{} { return &cfg.OTP.PasswordResetEnabled }},
+	{"LOGIN_MAX_FAILED_ATTEMPTS", func(cfg *Config) interface{} { return &cfg.Login.MaxFailedAttempts }},
+	{"LOGIN_IP_MAX_FAILED_ATTEMPTS", func(cfg *Config) interface{} { return &cfg.Login.IPMaxFailedAttempts }},
+	{"LOGIN_FAILURE_WINDOW", func(cfg *Config) interface{} { return &cfg.Login.FailureWindow }},
+	{"LOGIN_LOCKOUT", func(cfg *Config) interface{} { return &cfg.Login.Lockout }},
+	{"LOGIN_MAX_LOCKOUT", func(cfg *Config) interface{} { return &cfg.Login.MaxLockout }},
+	{"DEFAULT_TIMEZONE", func(cfg *Config) interface{} { return &cfg.Server.DefaultTimezone }},
+	{"REQUEST_TIMEOUT", func(cfg *Config) interface{} { return &cfg.Server.RequestTimeout }},
+	{"LOG_LEVEL", func(cfg *Config) interface{} { return &cfg.Logging.Level }},
+	{"PASSWORD_MAX_AGE", func(cfg *Config) interface{} { return &cfg.Users.PasswordMaxAge }},
+	{"THIRD_PARTY_MIRROR_API_URL", func(cfg *Config) interface{} { return &cfg.ThirdParty.MirrorURL }},
+	{"THIRD_PARTY_MIRROR_GATE_COMMANDS", func(cfg *Config) interface{} { return &cfg.ThirdParty.MirrorGateCommands }},
+	{"THIRD_PARTY_MONTHLY_QUOTA", func(cfg *Config) interface{} { return &cfg.ThirdParty.MonthlyQuota }},
+	{"THIRD_PARTY_MIRROR_MONTHLY_QUOTA", func(cfg *Config) interface{} { return &cfg.ThirdParty.MirrorMonthlyQuota }},
+	{"FAULT_INJECTION", func(cfg *Config) interface{} { return &cfg.Faults.Enabled }},
+	{"FAULT_ROUTES", func(cfg *Config) interface{} { return &cfg.Faults.Routes }},
+	{"FAULT_LATENCY", func(cfg *Config) interface{} { return &cfg.Faults.Latency }},
+	{"FAULT_LATENCY_RATE", func(cfg *Config) interface{} { return &cfg.Faults.LatencyRate }},
+	{"FAULT_PROVIDER_ERROR_RATE", func(cfg *Config) interface{} { return &cfg.Faults.ProviderErrorRate }},
+	{"FAULT_DB_ERROR_RATE", func(cfg *Config) interface{} { return &cfg.Faults.DBErrorRate }},
+}
+
+var (
+	reloadMu    sync.Mutex
+	reloadHooks []func(cfg *Config)
+
+	// processEnv holds the variables set in the real environment at startup. Reload re-reads
+	// .env for everything else, so real environment variables keep their precedence.
+	processEnv = map[string]bool{}
+)
+
+// OnReload registers a hook called with the new config after every successful Reload.
+// Components that copy settings at startup (e.g. the provider rate limiter) use it to pick up changes.
+func OnReload(hook func(cfg *Config)) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	reloadHooks = append(reloadHooks, hook)
+}
+
+// Reload re-reads .env, CONFIG_FILE and the environment and applies the reloadable settings
+// to the running config. It returns the names of the settings that changed. On error the running
+// config is left untouched.
+func Reload() ([]string, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	if AppConfig() == nil {
+		return nil, errors.New("configuration has not been loaded")
+	}
+
+	if err := reloadDotEnv(); err != nil {
+		return nil, err
+	}
+
+	values, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	previousValues := fileValues
+	fileValues = values
+
+	fresh, err := buildConfig()
+	if err != nil {
+		fileValues = previousValues
+		return nil, err
+	}
+
+	next := *AppConfig()
+	changed := []string{}
+	for _, setting := range reloadableSettings {
+		current := reflect.ValueOf(setting.field(&next)).Elem()
+		updated := reflect.ValueOf(setting.field(fresh)).Elem()
+		if reflect.DeepEqual(current.Interface(), updated.Interface()) {
+			continue
+		}
+		slog.Info("[CONFIG_RELOAD] Setting changed", "setting", setting.name, "from", current.Interface(), "to", updated.Interface())
+		current.Set(updated)
+		changed = append(changed, setting.name)
+	}
+
+	SetAppConfig(&next)
+	for _, hook := range reloadHooks {
+		hook(&next)
+	}
+
+	slog.Info("[CONFIG_RELOAD] Configuration reloaded", "changed", len(changed))
+	return changed, nil
+}
+
+// ReloadableSettings returns the names of the settings Reload applies
+func ReloadableSettings() []string {
+	names := make([]string, 0, len(reloadableSettings))
+	for _, setting := range reloadableSettings {
+		names = append(names, setting.name)
+	}
+	return names
+}
+
+// reloadDotEnv applies the current .env file to variables that did not come from the real environment
+func reloadDotEnv() error {
+	values, err := godotenv.Read()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for key, value := range values {
+		if !processEnv[key] {
+			os.Setenv(key, value)
+		}
+	}
+	return nil
+}
+
+// environKeys returns the names of the variables currently set in the environment
+func environKeys() map[string]bool {
+	keys := make(map[string]bool)
+	for _, entry := range os.Environ() {
+		if i := strings.Index(entry, "="); i > 0 {
+			keys[entry[:i]] = true
+		}
+	}
+	return keys
+}
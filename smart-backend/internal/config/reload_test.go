@@ -0,0 +1,79 @@
+package config
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReload_AppliesOnlyReloadableSettings(t *testing.T) {
+	cfg, err := buildConfig()
+	assert.NoError(t, err)
+	SetAppConfig(cfg)
+	t.Cleanup(func() { reloadHooks = nil })
+
+	var hookCfg *Config
+	OnReload(func(c *Config) { hookCfg = c })
+
+	t.Setenv("THIRD_PARTY_RATE_LIMIT", "7")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://admin.example")
+	t.Setenv("JWT_SECRET", "rotated-secret") // Needs a restart
+	t.Setenv("PORT", "9999")                 // Needs a restart
+
+	changed, err := Reload()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"THIRD_PARTY_RATE_LIMIT", "CORS_ALLOWED_ORIGINS"}, changed)
+
+	assert.Equal(t, 7, AppConfig().ThirdParty.RateLimit)
+	assert.Equal(t, "https://admin.example", AppConfig().CORS.AllowedOrigins)
+	assert.Equal(t, cfg.JWT.Secret, AppConfig().JWT.Secret)
+	assert.Equal(t, cfg.Server.Port, AppConfig().Server.Port)
+	assert.Same(t, AppConfig(), hookCfg)
+
+	// Reloading again without changes reports nothing
+	changed, err = Reload()
+	assert.NoError(t, err)
+	assert.Empty(t, changed)
+}
+
+func TestReload_InvalidConfigKeepsCurrent(t *testing.T) {
+	cfg, err := buildConfig()
+	assert.NoError(t, err)
+	SetAppConfig(cfg)
+
+	t.Setenv("THIRD_PARTY_RATE_LIMIT", "7")
+	t.Setenv("JWT_ACCESS_EXPIRY", "not-a-duration")
+
+	changed, err := Reload()
+	assert.Error(t, err)
+	assert.Nil(t, changed)
+	assert.Same(t, cfg, AppConfig())
+	assert.Equal(t, 0, AppConfig().ThirdParty.RateLimit)
+}
+
+func TestReload_ConcurrentReaders(t *testing.T) {
+	cfg, err := buildConfig()
+	assert.NoError(t, err)
+	SetAppConfig(cfg)
+
+	t.Setenv("THIRD_PARTY_RATE_LIMIT", "7")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = AppConfig().ThirdParty.RateLimit
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		_, err := Reload()
+		assert.NoError(t, err)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 7, AppConfig().ThirdParty.RateLimit)
+}
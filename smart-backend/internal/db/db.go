@@ -2,8 +2,10 @@ package db
 
 import (
 	"fmt"
-	"log"
+	"log/slog"
 	"ololo-gate/internal/config"
+	"ololo-gate/internal/logging"
+	"ololo-gate/internal/metrics"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -14,7 +16,7 @@ var DB *gorm.DB
 
 // Connect establishes a connection to the PostgreSQL database
 func Connect() {
-	cfg := config.AppConfig.Database
+	cfg := config.AppConfig().Database
 
 	dsn := fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
@@ -27,35 +29,58 @@ func Connect() {
 
 	// Set logger level based on environment
 	logLevel := logger.Info
-	if config.AppConfig.Server.Env == "production" {
+	if config.AppConfig().Server.Env == "production" {
 		logLevel = logger.Error
 	}
 
 	var err error
 	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logLevel),
+		Logger:                 logger.Default.LogMode(logLevel),
+		PrepareStmt:            cfg.PrepareStmt,
+		PrepareStmtMaxSize:     cfg.PreparedStmtCacheSize,
+		SkipDefaultTransaction: cfg.SkipDefaultTransaction,
 	})
 
 	if err != nil {
-		log.Fatal("Failed to connect to database:", err)
+		logging.Fatal("Failed to connect to database", "error", err)
 	}
 
 	// Configure connection pool
 	sqlDB, err := DB.DB()
 	if err != nil {
-		log.Fatal("Failed to configure database connection pool:", err)
+		logging.Fatal("Failed to configure database connection pool", "error", err)
 	}
 
-	sqlDB.SetMaxIdleConns(10)
-	sqlDB.SetMaxOpenConns(100)
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	metrics.RegisterCollector(CollectPoolMetrics)
 
-	log.Println("✅ Database connected successfully")
+	slog.Info("Database connected", "max_open_conns", cfg.MaxOpenConns, "max_idle_conns", cfg.MaxIdleConns, "prepared_statements", cfg.PrepareStmt)
 }
 
-// AutoMigrate runs automatic migrations for the provided models
-func AutoMigrate(models ...interface{}) {
-	if err := DB.AutoMigrate(models...); err != nil {
-		log.Fatal("Failed to auto-migrate database:", err)
+// CollectPoolMetrics publishes connection pool and prepared statement cache stats for /metrics
+func CollectPoolMetrics() {
+	if DB == nil {
+		return
+	}
+	sqlDB, err := DB.DB()
+	if err != nil {
+		return
+	}
+	stats := sqlDB.Stats()
+	metrics.SetGauge("db_pool_max_open_connections", nil, float64(stats.MaxOpenConnections))
+	metrics.SetGauge("db_pool_open_connections", nil, float64(stats.OpenConnections))
+	metrics.SetGauge("db_pool_in_use_connections", nil, float64(stats.InUse))
+	metrics.SetGauge("db_pool_idle_connections", nil, float64(stats.Idle))
+	metrics.SetCounter("db_pool_wait_count_total", nil, float64(stats.WaitCount))
+	metrics.SetCounter("db_pool_wait_seconds_total", nil, stats.WaitDuration.Seconds())
+	metrics.SetCounter("db_pool_closed_total", metrics.Labels{"reason": "max_idle"}, float64(stats.MaxIdleClosed))
+	metrics.SetCounter("db_pool_closed_total", metrics.Labels{"reason": "max_idle_time"}, float64(stats.MaxIdleTimeClosed))
+	metrics.SetCounter("db_pool_closed_total", metrics.Labels{"reason": "max_lifetime"}, float64(stats.MaxLifetimeClosed))
+
+	if prepared, ok := DB.ConnPool.(*gorm.PreparedStmtDB); ok {
+		metrics.SetGauge("db_prepared_statements", nil, float64(len(prepared.Stmts.Keys())))
 	}
-	log.Println("✅ Database migrations completed")
 }
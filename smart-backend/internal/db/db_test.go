@@ -0,0 +1,30 @@
+package db
+
+import (
+	"ololo-gate/internal/metrics"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestCollectPoolMetrics_ExportsPoolAndPreparedStatements(t *testing.T) {
+	var err error
+	DB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{PrepareStmt: true})
+	assert.NoError(t, err)
+	sqlDB, err := DB.DB()
+	assert.NoError(t, err)
+	sqlDB.SetMaxOpenConns(3)
+
+	var one int
+	assert.NoError(t, DB.Raw("SELECT ?", 1).Scan(&one).Error)
+
+	metrics.RegisterCollector(CollectPoolMetrics)
+	out := metrics.Render()
+	assert.Contains(t, out, "db_pool_max_open_connections 3")
+	assert.Contains(t, out, "db_pool_open_connections 1")
+	assert.Contains(t, out, `db_pool_closed_total{reason="max_lifetime"} 0`)
+	assert.True(t, strings.Contains(out, "db_prepared_statements 1"), out)
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/logging"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/pii"
+)
+
+// piiMigrationBatchSize is how many users are encrypted per query
+const piiMigrationBatchSize = 500
+
+// legacyUserRow reads users without the encrypted serializer, so plaintext and ciphertext are seen as stored
+type legacyUserRow struct {
+	ID              string
+	Phone           string
+	CurrentDeviceID string
+}
+
+// EncryptUserPII encrypts phone numbers and device IDs stored in plaintext before encryption at
+// rest was enabled, and fills in their blind indexes. Rows that are already encrypted are
+// skipped, so it is safe to run on every startup. Soft-deleted users are included.
+// The plaintext unique index on phone is dropped by migration 0002.
+func EncryptUserPII() (int, error) {
+	migrated := 0
+	lastID := ""
+	for {
+		var rows []legacyUserRow
+		if err := DB.Table("users").Select("id", "phone", "current_device_id").
+			Where("id > ?", lastID).Order("id").Limit(piiMigrationBatchSize).
+			Scan(&rows).Error; err != nil {
+			return migrated, fmt.Errorf("failed to read users: %w", err)
+		}
+		if len(rows) == 0 {
+			return migrated, nil
+		}
+		lastID = rows[len(rows)-1].ID
+
+		for _, row := range rows {
+			if pii.IsEncrypted(row.Phone) && (row.CurrentDeviceID == "" || pii.IsEncrypted(row.CurrentDeviceID)) {
+				continue
+			}
+			if err := encryptUserRow(row); err != nil {
+				return migrated, fmt.Errorf("failed to encrypt user %s: %w", row.ID, err)
+			}
+			migrated++
+		}
+	}
+}
+
+func encryptUserRow(row legacyUserRow) error {
+	phone, err := pii.Decrypt(row.Phone)
+	if err != nil {
+		return err
+	}
+	deviceID, err := pii.Decrypt(row.CurrentDeviceID)
+	if err != nil {
+		return err
+	}
+
+	encryptedPhone, err := pii.Encrypt(phone)
+	if err != nil {
+		return err
+	}
+	encryptedDeviceID, err := pii.Encrypt(deviceID)
+	if err != nil {
+		return err
+	}
+
+	return DB.Table("users").Where("id = ?", row.ID).UpdateColumns(map[string]interface{}{
+		"phone":              encryptedPhone,
+		"phone_index":        pii.BlindIndex(phone),
+		"phone_suffix_index": pii.BlindIndex(models.PhoneSuffix(phone)),
+		"current_device_id":  encryptedDeviceID,
+	}).Error
+}
+
+// MigrateUserPII runs EncryptUserPII at startup, exiting on failure
+func MigrateUserPII() {
+	migrated, err := EncryptUserPII()
+	if err != nil {
+		logging.Fatal("Failed to encrypt user personal data", "error", err)
+	}
+	if migrated > 0 {
+		slog.Info("Encrypted personal data of existing users", "count", migrated)
+	}
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/pii"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestEncryptUserPII_EncryptsLegacyRows(t *testing.T) {
+	config.SetAppConfig(&config.Config{})
+	var err error
+	DB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, DB.AutoMigrate(&models.User{}, &models.UserPhone{}))
+
+	// Rows written before encryption at rest: plaintext and no blind index
+	legacyID := uuid.New().String()
+	assert.NoError(t, DB.Exec(
+		"INSERT INTO users (id, phone, password, token_version, current_device_id) VALUES (?, ?, ?, 0, ?)",
+		legacyID, "+77771234567", "hash", "device-1",
+	).Error)
+	current := models.User{Phone: "+77779999999", Password: "password123"}
+	assert.NoError(t, DB.Create(&current).Error)
+
+	migrated, err := EncryptUserPII()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, migrated)
+
+	var stored legacyUserRow
+	DB.Table("users").Select("id", "phone", "current_device_id").Where("id = ?", legacyID).Scan(&stored)
+	assert.True(t, pii.IsEncrypted(stored.Phone))
+	assert.True(t, pii.IsEncrypted(stored.CurrentDeviceID))
+
+	var user models.User
+	assert.NoError(t, DB.Where("phone_index = ?", pii.BlindIndex("+77771234567")).First(&user).Error)
+	assert.Equal(t, "+77771234567", user.Phone)
+	assert.Equal(t, "device-1", user.CurrentDeviceID)
+
+	// Already encrypted rows are left alone on the next run
+	migrated, err = EncryptUserPII()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, migrated)
+}
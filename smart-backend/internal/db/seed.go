@@ -1,8 +1,9 @@
 package db
 
 import (
-	"log"
+	"log/slog"
 	"ololo-gate/internal/config"
+	"ololo-gate/internal/logging"
 	"ololo-gate/internal/models"
 
 	"github.com/google/uuid"
@@ -10,12 +11,12 @@ import (
 
 // CreateInitialAdmin creates the initial super admin if it doesn't exist
 func CreateInitialAdmin() {
-	adminConfig := config.AppConfig.InitAdmin
+	adminConfig := config.AppConfig().InitAdmin
 
 	// Parse UUID from config
 	adminUUID, err := uuid.Parse(adminConfig.UUID)
 	if err != nil {
-		log.Fatalf("Invalid INIT_ADMIN_UUID format: %v", err)
+		logging.Fatal("Invalid INIT_ADMIN_UUID format", "error", err)
 	}
 
 	// Check if admin with this UUID already exists
@@ -24,7 +25,7 @@ func CreateInitialAdmin() {
 
 	if result.Error == nil {
 		// Admin already exists
-		log.Printf("ℹ️  Initial admin already exists (ID: %s, Username: %s)", adminUUID, existingAdmin.Username)
+		slog.Info("Initial admin already exists", "admin_id", adminUUID, "username", existingAdmin.Username)
 		return
 	}
 
@@ -37,9 +38,21 @@ func CreateInitialAdmin() {
 	}
 
 	if err := DB.Create(&initialAdmin).Error; err != nil {
-		log.Fatalf("Failed to create initial admin: %v", err)
+		logging.Fatal("Failed to create initial admin", "error", err)
+	}
+	history := models.AdminHistory{
+		AdminID: initialAdmin.ID,
+		Action:  models.AdminHistoryCreated,
+		Changes: map[string]models.FieldChange{
+			"username": {After: initialAdmin.Username},
+			"role":     {After: initialAdmin.Role},
+		},
+		Actor: "system",
+	}
+	if err := DB.Create(&history).Error; err != nil {
+		slog.Error("Failed to record the creation of the initial admin", "error", err)
 	}
 
-	log.Printf("✅ Initial super admin created successfully (Username: %s)", adminConfig.Username)
-	log.Printf("⚠️  Please change the default admin password in production!")
+	slog.Info("Initial super admin created", "username", adminConfig.Username)
+	slog.Warn("Please change the default admin password in production!")
 }
@@ -0,0 +1,38 @@
+package db
+
+import (
+	"log/slog"
+	"ololo-gate/internal/logging"
+	"ololo-gate/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// BackfillPrimaryPhones creates the primary user_phones row of users created before the
+// table existed. Users that already have one are skipped, so it is safe to run on every startup.
+func BackfillPrimaryPhones() (int, error) {
+	created := 0
+	var users []models.User
+	result := DB.Where("NOT EXISTS (SELECT 1 FROM user_phones WHERE user_phones.user_id = users.id AND user_phones.is_primary = ?)", true).
+		FindInBatches(&users, 500, func(tx *gorm.DB, batch int) error {
+			for i := range users {
+				if err := models.SyncPrimaryPhone(DB, &users[i]); err != nil {
+					return err
+				}
+				created++
+			}
+			return nil
+		})
+	return created, result.Error
+}
+
+// MigrateUserPhones runs BackfillPrimaryPhones at startup, exiting on failure
+func MigrateUserPhones() {
+	created, err := BackfillPrimaryPhones()
+	if err != nil {
+		logging.Fatal("Failed to backfill user phone numbers", "error", err)
+	}
+	if created > 0 {
+		slog.Info("Added primary phone numbers of existing users", "count", created)
+	}
+}
@@ -0,0 +1,145 @@
+package events
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Domain event types
+const (
+	UserCreated = "user.created"
+	GateOpened  = "gate.opened"
+	GateClosed  = "gate.closed"
+	AdminLogin  = "admin.login"
+
+	AuditEntry          = "audit.entry"          // An admin action was written to the audit log
+	AdminNotification   = "admin.notification"   // A notification was added to the admin notification center
+	JobFailed           = "job.failed"           // A scheduled job run failed
+	ProviderUnavailable = "provider.unavailable" // A third-party API call failed because the provider is down
+	SecurityAlert       = "security.alert"       // Suspicious activity (e.g. forged provider callbacks)
+	GateReported        = "gate.reported"        // A user reported a problem with a gate
+)
+
+// AllEvents subscribes a handler to every event type
+const AllEvents = "*"
+
+// Event is a domain event published by handlers and consumed by side-effect subsystems
+// (notifications, WebSocket feed, analytics, ...)
+type Event struct {
+	ID         uuid.UUID              `json:"id"`
+	Type       string                 `json:"type"`
+	Data       map[string]interface{} `json:"data"`
+	OccurredAt time.Time              `json:"occurred_at"`
+}
+
+// Handler consumes a published event
+type Handler func(Event)
+
+// Transport carries events between processes (e.g. NATS or Redis pub/sub).
+// When a transport is set, published events are sent through it and every event it receives
+// (including our own) is delivered to local subscribers.
+type Transport interface {
+	Publish(event Event) error
+	Subscribe(deliver func(Event)) error
+}
+
+// Bus is an in-process publish/subscribe event bus with an optional external transport
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[string][]Handler
+	transport   Transport
+	wg          sync.WaitGroup
+}
+
+var defaultBus = NewBus()
+
+// NewBus creates an in-process event bus
+func NewBus() *Bus {
+	return &Bus{subscribers: make(map[string][]Handler)}
+}
+
+// Default returns the process-wide event bus
+func Default() *Bus {
+	return defaultBus
+}
+
+// Publish publishes an event on the process-wide bus
+func Publish(eventType string, data map[string]interface{}) {
+	defaultBus.Publish(eventType, data)
+}
+
+// Subscribe registers a handler on the process-wide bus
+func Subscribe(eventType string, handler Handler) {
+	defaultBus.Subscribe(eventType, handler)
+}
+
+// Subscribe registers a handler for an event type (or AllEvents)
+func (b *Bus) Subscribe(eventType string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[eventType] = append(b.subscribers[eventType], handler)
+}
+
+// SetTransport routes events through an external transport instead of delivering them in-process only
+func (b *Bus) SetTransport(transport Transport) error {
+	if err := transport.Subscribe(b.deliver); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.transport = transport
+	b.mu.Unlock()
+	return nil
+}
+
+// Publish publishes an event. Delivery is asynchronous so handlers never slow down the request.
+func (b *Bus) Publish(eventType string, data map[string]interface{}) {
+	event := Event{
+		ID:         uuid.New(),
+		Type:       eventType,
+		Data:       data,
+		OccurredAt: time.Now(),
+	}
+
+	b.mu.RLock()
+	transport := b.transport
+	b.mu.RUnlock()
+
+	if transport != nil {
+		err := transport.Publish(event)
+		if err == nil {
+			return
+		}
+		slog.Warn("[EVENTS] Transport publish failed, delivering locally", "event", eventType, "error", err)
+	}
+
+	b.deliver(event)
+}
+
+// Wait blocks until all in-flight handler invocations have finished
+func (b *Bus) Wait() {
+	b.wg.Wait()
+}
+
+// deliver runs every matching local handler in its own goroutine
+func (b *Bus) deliver(event Event) {
+	b.mu.RLock()
+	handlers := append([]Handler{}, b.subscribers[event.Type]...)
+	handlers = append(handlers, b.subscribers[AllEvents]...)
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.wg.Add(1)
+		go func(handler Handler) {
+			defer b.wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					slog.Error("[EVENTS] Handler panicked", "event", event.Type, "panic", r)
+				}
+			}()
+			handler(event)
+		}(handler)
+	}
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus_DeliversToMatchingSubscribers(t *testing.T) {
+	bus := NewBus()
+
+	var mu sync.Mutex
+	var received []string
+	record := func(name string) Handler {
+		return func(e Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, name+":"+e.Type)
+		}
+	}
+
+	bus.Subscribe(UserCreated, record("users"))
+	bus.Subscribe(GateOpened, record("gates"))
+	bus.Subscribe(AllEvents, record("all"))
+
+	bus.Publish(UserCreated, map[string]interface{}{"phone": "+77771234567"})
+	bus.Wait()
+
+	assert.ElementsMatch(t, []string{"users:user.created", "all:user.created"}, received)
+}
+
+func TestBus_HandlerPanicDoesNotAffectOthers(t *testing.T) {
+	bus := NewBus()
+
+	called := make(chan struct{}, 1)
+	bus.Subscribe(AdminLogin, func(e Event) { panic("boom") })
+	bus.Subscribe(AdminLogin, func(e Event) { called <- struct{}{} })
+
+	bus.Publish(AdminLogin, nil)
+	bus.Wait()
+
+	assert.Len(t, called, 1)
+}
+
+type fakeTransport struct {
+	deliver   func(Event)
+	published []Event
+	fail      bool
+}
+
+func (f *fakeTransport) Publish(event Event) error {
+	if f.fail {
+		return errors.New("transport down")
+	}
+	f.published = append(f.published, event)
+	f.deliver(event)
+	return nil
+}
+
+func (f *fakeTransport) Subscribe(deliver func(Event)) error {
+	f.deliver = deliver
+	return nil
+}
+
+func TestBus_RoutesThroughTransport(t *testing.T) {
+	bus := NewBus()
+	transport := &fakeTransport{}
+	assert.NoError(t, bus.SetTransport(transport))
+
+	received := make(chan Event, 1)
+	bus.Subscribe(GateClosed, func(e Event) { received <- e })
+
+	bus.Publish(GateClosed, map[string]interface{}{"gate_id": 3})
+	bus.Wait()
+
+	assert.Len(t, transport.published, 1)
+	assert.Len(t, received, 1)
+}
+
+func TestBus_FallsBackToLocalDeliveryWhenTransportFails(t *testing.T) {
+	bus := NewBus()
+	assert.NoError(t, bus.SetTransport(&fakeTransport{fail: true}))
+
+	received := make(chan Event, 1)
+	bus.Subscribe(GateOpened, func(e Event) { received <- e })
+
+	bus.Publish(GateOpened, nil)
+	bus.Wait()
+
+	assert.Len(t, received, 1)
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessPolicy_CoversEveryRoute(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	assert.Empty(t, middleware.UncoveredRoutes(app))
+}
+
+func TestAccessPolicy_RuleMatching(t *testing.T) {
+	cases := map[string]string{
+		"POST /api/v1/auth/login":                  middleware.RequirementPublic,
+		"GET /api/v1/auth/sessions":                middleware.RequirementUser,
+		"DELETE /api/v1/auth/sessions/abc":         middleware.RequirementUser,
+		"GET /api/v1/users/":                       middleware.RequirementAdmin,
+		"HEAD /api/v1/users/123":                   middleware.RequirementAdmin,
+		"GET /api/v1/admin/users":                  middleware.RequirementSuperAdmin,
+		"PATCH /api/v1/admin/users/123":            middleware.RequirementSelfOrSuperAdmin,
+		"POST /api/v1/gate-commands/42/callback":   middleware.RequirementProviderToken,
+		"PATCH /api/v1/admin/notifications/1/read": middleware.RequirementAdmin,
+		"GET /api/v1/contacts":                     middleware.RequirementPublic,
+		"PATCH /api/v1/contacts":                   middleware.RequirementAdmin,
+	}
+	for route, want := range cases {
+		var method, path string
+		for i := range route {
+			if route[i] == ' ' {
+				method, path = route[:i], route[i+1:]
+				break
+			}
+		}
+		rule, ok := middleware.PolicyFor(method, path)
+		assert.True(t, ok, route)
+		assert.Equal(t, want, rule.Require, route)
+	}
+
+	_, ok := middleware.PolicyFor(fiber.MethodPut, "/api/v1/contacts")
+	assert.False(t, ok)
+}
+
+func TestAccessPolicy_MixedCasePathsGetTheirRule(t *testing.T) {
+	rule, ok := middleware.PolicyFor(fiber.MethodPost, "/api/v1/users/123/MERGE")
+	assert.True(t, ok)
+	assert.Equal(t, middleware.RequirementSuperAdmin, rule.Require)
+	rule, _ = middleware.PolicyFor(fiber.MethodPost, "/API/v1/Users/123/Restore")
+	assert.True(t, rule.Audit)
+
+	// The router does not fold case either, so the merge cannot be reached by a regular admin
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	target := models.User{Phone: "+77771239001", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&target).Error)
+	for _, path := range []string{"/api/v1/users/" + target.ID.String() + "/MERGE", "/api/v1/Users/" + target.ID.String() + "/merge"} {
+		status, _ := mergeRequest(t, app, models.RoleRegular, "POST", path, map[string]interface{}{"user_ids": []string{uuid.NewString()}})
+		assert.Contains(t, []int{fiber.StatusForbidden, fiber.StatusNotFound}, status, path)
+	}
+}
+
+func TestAccessPolicy_UnlistedRouteDenied(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	resp, err := app.Test(httptest.NewRequest("PUT", "/api/v1/contacts", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+func TestAccessPolicy_SelfOrSuperAdmin(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	regular := models.Admin{ID: uuid.New(), Username: "policy-regular", Password: "password123", Role: models.RoleRegular}
+	other := models.Admin{ID: uuid.New(), Username: "policy-other", Password: "password123", Role: models.RoleRegular}
+	super := models.Admin{ID: uuid.New(), Username: "policy-super", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&regular)
+	db.DB.Create(&other)
+	db.DB.Create(&super)
+	regularToken, _ := utils.GenerateAdminToken(regular.ID, regular.Username, regular.Role, 0)
+	superToken, _ := utils.GenerateAdminToken(super.ID, super.Username, super.Role, 0)
+
+	get := func(token string, id uuid.UUID) int {
+		req := httptest.NewRequest("GET", "/api/v1/admin/users/"+id.String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, fiber.StatusOK, get(regularToken, regular.ID))
+	assert.Equal(t, fiber.StatusForbidden, get(regularToken, other.ID))
+	assert.Equal(t, fiber.StatusOK, get(superToken, other.ID))
+
+	// Authentication is still required before the ownership check
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/admin/users/"+regular.ID.String(), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestAccessPolicy_AuditorReadOnly(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	auditor := models.Admin{ID: uuid.New(), Username: "policy-auditor", Password: "password123", Role: models.RoleAuditor}
+	other := models.Admin{ID: uuid.New(), Username: "policy-other", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&auditor)
+	db.DB.Create(&other)
+	token, _ := utils.GenerateAdminToken(auditor.ID, auditor.Username, auditor.Role, 0)
+
+	request := func(method, path, body string) int {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	// Reads are allowed, including super admin routes
+	assert.Equal(t, fiber.StatusOK, request("GET", "/api/v1/users", ""))
+	assert.Equal(t, fiber.StatusOK, request("GET", "/api/v1/admin/users", ""))
+	assert.Equal(t, fiber.StatusOK, request("GET", "/api/v1/admin/users/"+other.ID.String(), ""))
+	assert.Equal(t, fiber.StatusOK, request("GET", "/api/v1/admin/audit-logs", ""))
+
+	// Every write is denied, except to the auditor's own account
+	assert.Equal(t, fiber.StatusForbidden, request("POST", "/api/v1/users", `{"phone":"+77771234567","password":"password123"}`))
+	assert.Equal(t, fiber.StatusForbidden, request("PATCH", "/api/v1/contacts", `{}`))
+	assert.Equal(t, fiber.StatusForbidden, request("POST", "/api/v1/admin/invite-codes", `{}`))
+	assert.Equal(t, fiber.StatusForbidden, request("PATCH", "/api/v1/admin/users/"+other.ID.String(), `{"password":"newpassword"}`))
+	assert.Equal(t, fiber.StatusForbidden, request("PATCH", "/api/v1/admin/users/"+auditor.ID.String(), `{"role":"super"}`))
+	assert.Equal(t, fiber.StatusOK, request("PATCH", "/api/v1/admin/users/"+auditor.ID.String(), `{"password":"newpassword"}`))
+
+	var count int64
+	db.DB.Model(&models.User{}).Count(&count)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateAPIKeyRequest defines the structure for creating a machine API key
+// @name CreateAPIKeyRequest
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" validate:"required" example:"Acme HR (Okta)"`
+	Scopes []string `json:"scopes" validate:"required" example:"scim"` // What the key can access: scim, sync
+}
+
+// GetAPIKeys godoc
+// @Summary List API keys
+// @Description Retrieve the machine API keys, newest first. Keys themselves are never shown again after creation, only their prefix (super admin only)
+// @Tags Admin API Keys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} APIKeysResponse "API keys retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/api-keys [get]
+func GetAPIKeys(c *fiber.Ctx) error {
+	var keys []models.APIKey
+	if err := db.DB.WithContext(c.UserContext()).Order("created_at DESC").Find(&keys).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve API keys",
+		})
+	}
+
+	data := make([]APIKeyDTO, 0, len(keys))
+	for _, key := range keys {
+		data = append(data, toAPIKeyDTO(key))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIKeysResponse{
+		Success: true,
+		Message: "API keys retrieved successfully",
+		Data:    data,
+	})
+}
+
+// CreateAPIKey godoc
+// @Summary Create an API key
+// @Description Create a key for a machine client, e.g. an identity provider provisioning users over SCIM (scope "scim") or a system mirroring users through the change feed (scope "sync"). The key is sent as "Authorization: Bearer <key>" and is only returned in this response (super admin only)
+// @Tags Admin API Keys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateAPIKeyRequest true "Key name and scopes"
+// @Success 201 {object} CreatedAPIKeyResponse "API key created successfully"
+// @Failure 400 {object} APIResponse "Invalid request body or unknown scope"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/api-keys [post]
+func CreateAPIKey(c *fiber.Ctx) error {
+	var req CreateAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Name) == "" || len(req.Scopes) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body. Name and scopes are required",
+		})
+	}
+	for _, scope := range req.Scopes {
+		if !slices.Contains(services.APIKeyScopes, scope) {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Unknown scope: " + scope + ". Use: " + strings.Join(services.APIKeyScopes, ", "),
+			})
+		}
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	apiKey, key, err := services.CreateAPIKey(strings.TrimSpace(req.Name), req.Scopes, adminUsername)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[API_KEY] Failed to create API key", "error", err)
+		middleware.RecordAudit(c, "create_api_key", "api_key", "", "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to create API key",
+		})
+	}
+	middleware.RecordAudit(c, "create_api_key", "api_key", apiKey.ID.String(), "success", "")
+
+	return c.Status(fiber.StatusCreated).JSON(CreatedAPIKeyResponse{
+		Success: true,
+		Message: "API key created successfully. Store the key now, it cannot be shown again",
+		Data:    CreatedAPIKeyDTO{APIKeyDTO: toAPIKeyDTO(apiKey), Key: key},
+	})
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke an API key
+// @Description Stop an API key from authenticating. Revoking is immediate and cannot be undone (super admin only)
+// @Tags Admin API Keys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "API key ID (UUID)"
+// @Success 200 {object} APIKeyResponse "API key revoked successfully"
+// @Failure 400 {object} APIResponse "Invalid API key ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "API key not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/api-keys/{id} [delete]
+func RevokeAPIKey(c *fiber.Ctx) error {
+	keyID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid API key ID format",
+		})
+	}
+
+	var apiKey models.APIKey
+	if err := db.DB.WithContext(c.UserContext()).First(&apiKey, "id = ?", keyID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "API key not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to revoke API key",
+		})
+	}
+
+	if apiKey.RevokedAt == nil {
+		if err := services.RevokeAPIKey(&apiKey); err != nil {
+			middleware.RecordAudit(c, "revoke_api_key", "api_key", apiKey.ID.String(), "failed", err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to revoke API key",
+			})
+		}
+		slog.InfoContext(c.UserContext(), "[API_KEY] Key revoked", "key_id", apiKey.ID, "name", apiKey.Name)
+		middleware.RecordAudit(c, "revoke_api_key", "api_key", apiKey.ID.String(), "success", "")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIKeyResponse{
+		Success: true,
+		Message: "API key revoked successfully",
+		Data:    toAPIKeyDTO(apiKey),
+	})
+}
+
+func toAPIKeyDTO(apiKey models.APIKey) APIKeyDTO {
+	scopes := []string{}
+	if apiKey.Scopes != "" {
+		scopes = strings.Split(apiKey.Scopes, ",")
+	}
+	return APIKeyDTO{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     scopes,
+		CreatedBy:  apiKey.CreatedBy,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
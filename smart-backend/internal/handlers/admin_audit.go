@@ -3,6 +3,7 @@ package handlers
 import (
 	"ololo-gate/internal/db"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -19,7 +20,9 @@ import (
 // @Param admin_id query string false "Filter by admin ID"
 // @Param action query string false "Filter by action type"
 // @Param resource_type query string false "Filter by resource type"
+// @Param count query string false "exact (default) or estimate: approximate total from table statistics, for large tables"
 // @Success 200 {object} PaginatedAuditLogResponse "Audit logs retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid count mode"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
 // @Failure 403 {object} APIResponse "Forbidden - super admin access required"
 // @Failure 500 {object} APIResponse "Internal server error"
@@ -36,6 +39,13 @@ func GetAdminAuditLogs(c *fiber.Ctx) error {
 	}
 
 	offset := (page - 1) * limit
+	countMode, err := services.ParseCountMode(c.Query("count"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid count. Use exact or estimate",
+		})
+	}
 
 	// Build query with filters
 	query := db.DB
@@ -56,8 +66,7 @@ func GetAdminAuditLogs(c *fiber.Ctx) error {
 	}
 
 	// Get total count
-	var total int64
-	query.Model(&models.AdminAuditLog{}).Count(&total)
+	total, estimated, _ := services.CountRows(query.Model(&models.AdminAuditLog{}), countMode)
 
 	// Fetch paginated results (order by most recent first)
 	var logs []models.AdminAuditLog
@@ -77,13 +86,14 @@ func GetAdminAuditLogs(c *fiber.Ctx) error {
 			"page":         page,
 			"limit":        limit,
 			"pages":        (total + int64(limit) - 1) / int64(limit),
+			"estimated":    estimated,
 		},
 	})
 }
 
 // GetAdminAuditLogByID godoc
 // @Summary Get audit log by ID
-// @Description Retrieve a specific audit log entry by ID (super admin only)
+// @Description Retrieve a specific audit log entry by ID with its comment thread (super admin only)
 // @Tags Admin Audit Logs
 // @Accept json
 // @Produce json
@@ -99,17 +109,29 @@ func GetAdminAuditLogByID(c *fiber.Ctx) error {
 	logID := c.Params("id")
 
 	var log models.AdminAuditLog
-	if err := db.DB.First(&log, "id = ?", logID).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).First(&log, "id = ?", logID).Error; err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
 			Success: false,
 			Message: "Audit log not found",
 		})
 	}
 
+	comments, err := auditCommentThread(c.UserContext(), models.AuditCommentAuditLog, log.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve audit log comments",
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"success": true,
 		"message": "Audit log retrieved successfully",
-		"data":    log,
+		"data": AuditLogDetailDTO{
+			AdminAuditLog: log,
+			Resolved:      isResolved(comments),
+			Comments:      comments,
+		},
 	})
 }
 
@@ -127,5 +149,13 @@ type PaginatedAuditLogResponse struct {
 type AuditLogDetailResponse struct {
 	Success bool                  `json:"success" example:"true"`
 	Message string                `json:"message" example:"Audit log retrieved successfully"`
-	Data    models.AdminAuditLog  `json:"data"`
+	Data    AuditLogDetailDTO     `json:"data"`
+}
+
+// AuditLogDetailDTO is an audit log entry with the comments admins left on it
+// @name AuditLogDetailDTO
+type AuditLogDetailDTO struct {
+	models.AdminAuditLog
+	Resolved bool              `json:"resolved" example:"false"` // A resolution note was added
+	Comments []AuditCommentDTO `json:"comments"`                 // Oldest first
 }
@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// auditCommentMaxLength caps a comment, in characters
+const auditCommentMaxLength = 4000
+
+// CreateAuditCommentRequest defines the structure for commenting on an audit entry or alert
+// @name CreateAuditCommentRequest
+type CreateAuditCommentRequest struct {
+	Body       string `json:"body" validate:"required" example:"Confirmed with the admin, the bulk delete was a planned cleanup"` // Up to 4000 characters
+	Resolution bool   `json:"resolution" example:"false"`                                                                         // Mark the comment as the resolution of the investigation
+}
+
+// CreateAuditLogComment godoc
+// @Summary Comment on an audit log entry
+// @Description Add a comment or resolution note to an audit log entry to record the context of an investigation. Comments cannot be edited; the thread is returned by GET /admin/audit-logs/:id (super admin only)
+// @Tags Admin Audit Logs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Audit log ID (UUID)"
+// @Param request body CreateAuditCommentRequest true "Comment"
+// @Success 201 {object} AuditCommentResponse "Comment added"
+// @Failure 400 {object} APIResponse "Invalid audit log ID, missing or too long comment"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "Audit log not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/audit-logs/{id}/comments [post]
+func CreateAuditLogComment(c *fiber.Ctx) error {
+	return createAuditComment(c, models.AuditCommentAuditLog, &models.AdminAuditLog{}, "Audit log")
+}
+
+// CreateAdminNotificationComment godoc
+// @Summary Comment on a notification
+// @Description Add a comment or resolution note to a notification center alert, e.g. what was found when investigating a security alert. Comments cannot be edited; the thread is returned by GET /admin/notifications/:id
+// @Tags Admin Notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID (UUID)"
+// @Param request body CreateAuditCommentRequest true "Comment"
+// @Success 201 {object} AuditCommentResponse "Comment added"
+// @Failure 400 {object} APIResponse "Invalid notification ID, missing or too long comment"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Notification not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/notifications/{id}/comments [post]
+func CreateAdminNotificationComment(c *fiber.Ctx) error {
+	return createAuditComment(c, models.AuditCommentNotification, &models.AdminNotification{}, "Notification")
+}
+
+// GetAdminNotificationByID godoc
+// @Summary Get notification by ID
+// @Description Retrieve a notification center entry with its comment thread
+// @Tags Admin Notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID (UUID)"
+// @Success 200 {object} AdminNotificationDetailResponse "Notification retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid notification ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Notification not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/notifications/{id} [get]
+func GetAdminNotificationByID(c *fiber.Ctx) error {
+	notificationID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid notification ID format",
+		})
+	}
+
+	var notification models.AdminNotification
+	if err := db.DB.WithContext(c.UserContext()).First(&notification, "id = ?", notificationID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Notification not found",
+		})
+	}
+
+	comments, err := auditCommentThread(c.UserContext(), models.AuditCommentNotification, notification.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve notification comments",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(AdminNotificationDetailResponse{
+		Success: true,
+		Message: "Notification retrieved successfully",
+		Data: AdminNotificationDetailDTO{
+			AdminNotificationDTO: toAdminNotificationDTO(notification),
+			Resolved:             isResolved(comments),
+			Comments:             comments,
+		},
+	})
+}
+
+// createAuditComment adds a comment to the entry of targetType with the :id param, after
+// checking the entry exists in target's table
+func createAuditComment(c *fiber.Ctx, targetType string, target interface{}, name string) error {
+	targetID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid " + strings.ToLower(name) + " ID format",
+		})
+	}
+
+	var req CreateAuditCommentRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Body) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Comment body is required",
+		})
+	}
+	body := strings.TrimSpace(req.Body)
+	if utf8.RuneCountInString(body) > auditCommentMaxLength {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Comment must be at most 4000 characters",
+		})
+	}
+
+	err = db.DB.WithContext(c.UserContext()).Select("id").First(target, "id = ?", targetID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: name + " not found",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to add comment",
+		})
+	}
+
+	adminID, _ := c.Locals("id").(uuid.UUID)
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	comment := models.AuditComment{
+		TargetType: targetType,
+		TargetID:   targetID,
+		AdminID:    adminID,
+		AdminName:  adminUsername,
+		Body:       body,
+		Resolution: req.Resolution,
+	}
+	if err := db.DB.WithContext(c.UserContext()).Create(&comment).Error; err != nil {
+		middleware.RecordAudit(c, "comment_"+targetType, targetType, targetID.String(), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to add comment",
+		})
+	}
+	middleware.RecordAudit(c, "comment_"+targetType, targetType, targetID.String(), "success", "")
+
+	return c.Status(fiber.StatusCreated).JSON(AuditCommentResponse{
+		Success: true,
+		Message: "Comment added",
+		Data:    toAuditCommentDTO(comment),
+	})
+}
+
+// auditCommentThread returns the comments on an entry, oldest first
+func auditCommentThread(ctx context.Context, targetType string, targetID uuid.UUID) ([]AuditCommentDTO, error) {
+	var comments []models.AuditComment
+	if err := db.DB.WithContext(ctx).Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Order("created_at, id").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	dtos := make([]AuditCommentDTO, len(comments))
+	for i, comment := range comments {
+		dtos[i] = toAuditCommentDTO(comment)
+	}
+	return dtos, nil
+}
+
+// isResolved reports whether a thread contains a resolution note
+func isResolved(comments []AuditCommentDTO) bool {
+	for _, comment := range comments {
+		if comment.Resolution {
+			return true
+		}
+	}
+	return false
+}
+
+// toAuditCommentDTO maps an AuditComment model to its response DTO
+func toAuditCommentDTO(comment models.AuditComment) AuditCommentDTO {
+	return AuditCommentDTO{
+		ID:         comment.ID,
+		AdminID:    comment.AdminID,
+		AdminName:  comment.AdminName,
+		Body:       comment.Body,
+		Resolution: comment.Resolution,
+		CreatedAt:  comment.CreatedAt,
+	}
+}
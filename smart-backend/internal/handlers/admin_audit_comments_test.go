@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLogComments_ThreadInDetail(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	entry := models.AdminAuditLog{ID: uuid.New(), AdminName: "ops", Action: "delete_user", ResourceType: "user", Status: "success"}
+	db.DB.Create(&entry)
+	path := "/api/v1/admin/audit-logs/" + entry.ID.String()
+
+	status, _ := mergeRequest(t, app, models.RoleSuper, "POST", path+"/comments", map[string]interface{}{"body": " "})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", path+"/comments", map[string]interface{}{"body": strings.Repeat("a", 4001)})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/audit-logs/"+uuid.NewString()+"/comments", map[string]interface{}{"body": "Looking"})
+	assert.Equal(t, fiber.StatusNotFound, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", path+"/comments", map[string]interface{}{"body": "Looking"})
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "POST", path+"/comments", map[string]interface{}{"body": "Looking into this"})
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Equal(t, "Looking into this", result["data"].(map[string]interface{})["body"])
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", path+"/comments", map[string]interface{}{"body": "Planned cleanup", "resolution": true})
+	assert.Equal(t, fiber.StatusCreated, status)
+
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", path, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, "delete_user", data["action"])
+	assert.Equal(t, true, data["resolved"])
+	comments := data["comments"].([]interface{})
+	assert.Len(t, comments, 2)
+	assert.Equal(t, "Looking into this", comments[0].(map[string]interface{})["body"])
+	assert.Equal(t, true, comments[1].(map[string]interface{})["resolution"])
+
+	// Commenting is itself audited
+	var audits int64
+	db.DB.Model(&models.AdminAuditLog{}).Where("action = ? AND resource_id = ?", "comment_audit_log", entry.ID.String()).Count(&audits)
+	assert.Equal(t, int64(2), audits)
+}
+
+func TestAdminNotificationComments_ThreadInDetail(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	notification := models.AdminNotification{Severity: models.SeverityCritical, Category: models.NotificationSecurity, Title: "Repeated failed admin logins"}
+	db.DB.Create(&notification)
+	path := "/api/v1/admin/notifications/" + notification.ID.String()
+
+	status, _ := mergeRequest(t, app, models.RoleRegular, "POST", path+"/comments", map[string]interface{}{"body": "Blocked the IP at the firewall"})
+	assert.Equal(t, fiber.StatusCreated, status)
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "GET", path, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, "Repeated failed admin logins", data["title"])
+	assert.Equal(t, false, data["resolved"])
+	assert.Len(t, data["comments"], 1)
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/notifications/"+uuid.NewString(), nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+}
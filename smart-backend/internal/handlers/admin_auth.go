@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"log/slog"
 	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
 	"ololo-gate/internal/utils"
 
 	"github.com/gofiber/fiber/v2"
@@ -17,14 +20,16 @@ type AdminLoginRequest struct {
 
 // AdminLogin godoc
 // @Summary Admin login
-// @Description Authenticate admin with username and password, returns permanent access token (no expiry)
+// @Description Authenticate admin with username and password, returns an access token (valid for JWT_ADMIN_ACCESS_EXPIRY) and a refresh token (valid for JWT_ADMIN_REFRESH_EXPIRY) for POST /admin/refresh
 // @Tags Admin Authentication
 // @Accept json
 // @Produce json
 // @Param request body AdminLoginRequest true "Admin credentials"
-// @Success 200 {object} AdminLoginResponse "Login successful with permanent token"
+// @Success 200 {object} AdminLoginResponse "Login successful with access and refresh tokens"
 // @Failure 400 {object} APIResponse "Invalid request body or missing credentials"
 // @Failure 401 {object} APIResponse "Invalid credentials"
+// @Failure 403 {object} APIResponse "Password login is disabled for this account (WEBAUTHN_PASSWORD_FALLBACK=unenrolled)"
+// @Failure 429 {object} APIResponse "Too many failed logins for this username or client IP, retry after Retry-After"
 // @Failure 500 {object} APIResponse "Internal server error"
 // @Router /api/v1/admin/login [post]
 func AdminLogin(c *fiber.Ctx) error {
@@ -46,35 +51,62 @@ func AdminLogin(c *fiber.Ctx) error {
 		})
 	}
 
+	account := services.AdminLoginKey(req.Username)
+	if locked, err := loginLocked(c, account); locked {
+		return err
+	}
+
 	// Find admin by username
 	var admin models.Admin
-	if err := db.DB.Where("username = ?", req.Username).First(&admin).Error; err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+	if err := db.DB.WithContext(c.UserContext()).Where("username = ?", req.Username).First(&admin).Error; err != nil {
+		return loginFailed(c, account, c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
 			Success: false,
 			Message: "Invalid credentials",
-		})
+		}))
 	}
 
 	// Verify password
 	if !admin.CheckPassword(req.Password) {
-		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+		return loginFailed(c, account, c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
 			Success: false,
 			Message: "Invalid credentials",
+		}))
+	}
+	services.LoginAttempts().Succeed(account)
+
+	// Admins with a passkey may be required to use it
+	allowed, err := services.PasswordLoginAllowed(admin.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to check login policy",
 		})
 	}
+	if !allowed {
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Password login is disabled for this account, log in with a passkey",
+		})
+	}
+
+	return completeAdminLogin(c, admin, "password")
+}
 
+// completeAdminLogin issues access and refresh tokens to an authenticated admin. Each login
+// bumps the token version, so only the latest login session is valid.
+func completeAdminLogin(c *fiber.Ctx, admin models.Admin, method string) error {
 	// Increment token version to invalidate all previous tokens
-	// This ensures only the latest login session is valid
 	admin.TokenVersion++
-	if err := db.DB.Save(&admin).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).Save(&admin).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to update admin token version",
 		})
 	}
+	services.TokenVersions().InvalidateAdmin(admin.ID)
 
-	// Generate permanent admin token (no expiry) with new token version
-	token, err := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, admin.TokenVersion)
+	// Generate admin tokens with new token version
+	tokens, err := utils.GenerateAdminTokens(admin.ID, admin.Username, admin.Role, admin.TokenVersion)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
@@ -82,14 +114,87 @@ func AdminLogin(c *fiber.Ctx) error {
 		})
 	}
 
+	events.Publish(events.AdminLogin, map[string]interface{}{
+		"admin_id": admin.ID,
+		"username": admin.Username,
+		"role":     admin.Role,
+		"ip":       c.IP(),
+		"method":   method,
+	})
+
 	return c.Status(fiber.StatusOK).JSON(APIResponse{
 		Success: true,
 		Message: "Login successful",
 		Data: fiber.Map{
-			"id":     admin.ID,
-			"username":     admin.Username,
-			"role":         admin.Role,
-			"access_token": token,
+			"id":            admin.ID,
+			"username":      admin.Username,
+			"role":          admin.Role,
+			"access_token":  tokens.AccessToken,
+			"refresh_token": tokens.RefreshToken,
+		},
+	})
+}
+
+// AdminRefreshToken godoc
+// @Summary Refresh admin access token
+// @Description Exchange a valid admin refresh token for a new access token. The token version is checked, so the refresh token stops working after the admin logs in again or is signed out; the username and role are read from the database
+// @Tags Admin Authentication
+// @Accept json
+// @Produce json
+// @Param request body RefreshRequest true "Admin refresh token"
+// @Success 200 {object} RefreshResponse "New access token generated"
+// @Failure 400 {object} APIResponse "Invalid request body"
+// @Failure 401 {object} APIResponse "Invalid or expired refresh token, or token has been invalidated"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/refresh [post]
+func AdminRefreshToken(c *fiber.Ctx) error {
+	var req RefreshRequest
+	if err := c.BodyParser(&req); err != nil || req.RefreshToken == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	claims, err := utils.ValidateAdminRefreshToken(req.RefreshToken)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "[ADMIN_REFRESH_FAILED] Invalid or expired admin refresh token", "error", err)
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid or expired refresh token",
+		})
+	}
+
+	var admin models.Admin
+	if err := db.DB.WithContext(c.UserContext()).Select("id", "username", "role", "token_version").First(&admin, "id = ?", claims.AdminID).Error; err != nil {
+		slog.WarnContext(c.UserContext(), "[ADMIN_REFRESH_FAILED] Admin not found in database", "admin_id", claims.AdminID, "error", err)
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Token has been invalidated. Please login again.",
+		})
+	}
+	if admin.TokenVersion != claims.TokenVersion {
+		slog.WarnContext(c.UserContext(), "[ADMIN_REFRESH_FAILED] Token version mismatch",
+			"admin_id", admin.ID, "claims_version", claims.TokenVersion, "db_version", admin.TokenVersion)
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Token has been invalidated. Please login again.",
+		})
+	}
+
+	accessToken, err := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, admin.TokenVersion)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to generate access token",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "Token refreshed successfully",
+		Data: fiber.Map{
+			"access_token": accessToken,
 		},
 	})
 }
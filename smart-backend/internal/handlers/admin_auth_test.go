@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/http/httptest"
+	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
 	"ololo-gate/internal/models"
 	"ololo-gate/internal/utils"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -53,15 +56,96 @@ func TestAdminLogin_Success(t *testing.T) {
 	assert.Equal(t, "testadmin", data["username"])
 	assert.Equal(t, models.RoleSuper, data["role"])
 	assert.NotEmpty(t, data["access_token"])
+	assert.NotEmpty(t, data["refresh_token"])
 
-	// Verify token is valid and permanent (no expiry)
+	// Verify token is valid and expires after JWT_ADMIN_ACCESS_EXPIRY
 	token := data["access_token"].(string)
 	claims, err := utils.ValidateAdminToken(token)
 	assert.NoError(t, err)
 	assert.Equal(t, admin.ID, claims.AdminID)
 	assert.Equal(t, "testadmin", claims.Username)
 	assert.Equal(t, models.RoleSuper, claims.Role)
-	assert.Nil(t, claims.ExpiresAt) // Permanent token has no expiry
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), claims.ExpiresAt.Time, time.Minute)
+
+	// The refresh token is not accepted as an access token
+	_, err = utils.ValidateAdminToken(data["refresh_token"].(string))
+	assert.Error(t, err)
+}
+
+func TestAdminRefreshToken(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "testadmin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	login := func() map[string]interface{} {
+		reqBody, _ := json.Marshal(AdminLoginRequest{Username: "testadmin", Password: "password123"})
+		req := httptest.NewRequest("POST", "/api/v1/admin/login", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		var response APIResponse
+		json.NewDecoder(resp.Body).Decode(&response)
+		return response.Data.(map[string]interface{})
+	}
+	refresh := func(refreshToken string) (int, map[string]interface{}) {
+		reqBody, _ := json.Marshal(RefreshRequest{RefreshToken: refreshToken})
+		req := httptest.NewRequest("POST", "/api/v1/admin/refresh", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		return resp.StatusCode, result
+	}
+
+	tokens := login()
+	refreshToken := tokens["refresh_token"].(string)
+
+	// Access tokens cannot be used to refresh
+	status, _ := refresh(tokens["access_token"].(string))
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+
+	// The new access token carries the current role
+	db.DB.Model(&admin).Update("role", models.RoleSuper)
+	status, result := refresh(refreshToken)
+	assert.Equal(t, fiber.StatusOK, status)
+	claims, err := utils.ValidateAdminToken(result["data"].(map[string]interface{})["access_token"].(string))
+	assert.NoError(t, err)
+	assert.Equal(t, models.RoleSuper, claims.Role)
+
+	// Logging in again invalidates the earlier refresh token
+	login()
+	status, _ = refresh(refreshToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+}
+
+func TestAdminJWTProtected_RejectsExpiredAndPermanentTokens(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "testadmin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	request := func(token string) int {
+		req := httptest.NewRequest("GET", "/api/v1/admin/notifications", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	assert.Equal(t, fiber.StatusOK, request(token))
+
+	config.AppConfig().JWT.AdminAccessExpiry = -time.Hour
+	expired, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	assert.Equal(t, fiber.StatusUnauthorized, request(expired))
+
+	// Tokens issued before admin tokens expired have no exp claim
+	permanent, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, utils.AdminClaims{
+		AdminID: admin.ID, Username: admin.Username, Role: admin.Role, TokenType: utils.AdminToken,
+	}).SignedString([]byte(config.AppConfig().JWT.Secret))
+	assert.Equal(t, fiber.StatusUnauthorized, request(permanent))
 }
 
 func TestAdminLogin_InvalidUsername(t *testing.T) {
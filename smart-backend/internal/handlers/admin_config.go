@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// ReloadConfig godoc
+// @Summary Reload runtime configuration
+// @Description Re-read .env, CONFIG_FILE and the environment and apply the hot-reloadable settings (CORS origins, provider rate limits and timeouts, admin quotas, feature flags) without restarting the server. Equivalent to sending SIGHUP (super admin only)
+// @Tags Admin Config
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} ConfigReloadResponse "Configuration reloaded successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 422 {object} APIResponse "New configuration is invalid, current configuration kept"
+// @Router /api/v1/admin/config/reload [post]
+func ReloadConfig(c *fiber.Ctx) error {
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	adminID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		adminID = uuid.Nil
+	}
+
+	changed, err := config.Reload()
+	if err != nil {
+		utils.LogAdminAction(adminID, adminUsername, "reload_config", "config", "", "", c.IP(), c.Get("User-Agent"), "failed", err.Error())
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(APIResponse{
+			Success: false,
+			Message: fmt.Sprintf("Configuration not reloaded: %v", err),
+		})
+	}
+
+	auditDetails, _ := json.Marshal(map[string]interface{}{"changed": changed})
+	utils.LogAdminAction(adminID, adminUsername, "reload_config", "config", "", string(auditDetails), c.IP(), c.Get("User-Agent"), "success", "")
+
+	return c.Status(fiber.StatusOK).JSON(ConfigReloadResponse{
+		Success: true,
+		Message: fmt.Sprintf("Configuration reloaded, %d setting(s) changed", len(changed)),
+		Data: ConfigReloadDTO{
+			Changed:    changed,
+			Reloadable: config.ReloadableSettings(),
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func reloadConfig(t *testing.T, app *fiber.App, role string) (int, ConfigReloadResponse) {
+	admin := models.Admin{ID: uuid.New(), Username: "config-admin-" + role, Password: "password123", Role: role}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("POST", "/api/v1/admin/config/reload", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var response ConfigReloadResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	return resp.StatusCode, response
+}
+
+func TestReloadConfig_AppliesReloadableSettings(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	t.Setenv("ADMIN_QUOTA_HOURLY", "1000")
+	t.Setenv("DB_HOST", "db.internal") // Needs a restart
+
+	status, response := reloadConfig(t, app, models.RoleSuper)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.True(t, response.Success)
+	assert.Contains(t, response.Data.Changed, "ADMIN_QUOTA_HOURLY")
+	assert.Contains(t, response.Data.Reloadable, "CORS_ALLOWED_ORIGINS")
+	assert.Equal(t, 1000, config.AppConfig().Quotas.AdminHourly)
+	assert.Empty(t, config.AppConfig().Database.Host)
+
+	var auditLog models.AdminAuditLog
+	assert.NoError(t, db.DB.Where("action = ?", "reload_config").First(&auditLog).Error)
+	assert.Equal(t, "success", auditLog.Status)
+	assert.Contains(t, auditLog.Details, "ADMIN_QUOTA_HOURLY")
+}
+
+func TestReloadConfig_InvalidConfigRejected(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	t.Setenv("JWT_REFRESH_EXPIRY", "forever")
+
+	status, response := reloadConfig(t, app, models.RoleSuper)
+	assert.Equal(t, fiber.StatusUnprocessableEntity, status)
+	assert.False(t, response.Success)
+	assert.Equal(t, "test-secret-key", config.AppConfig().JWT.Secret)
+}
+
+func TestReloadConfig_RequiresSuperAdmin(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status, _ := reloadConfig(t, app, models.RoleRegular)
+	assert.Equal(t, fiber.StatusForbidden, status)
+}
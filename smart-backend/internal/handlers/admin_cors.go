@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateCORSOriginRequest defines the structure for allowing a new browser origin
+// @name CreateCORSOriginRequest
+type CreateCORSOriginRequest struct {
+	Origin string `json:"origin" validate:"required" example:"https://admin.example.com"`
+	Scope  string `json:"scope" validate:"required" example:"admin"` // "public" or "admin"
+}
+
+// GetCORSOrigins godoc
+// @Summary List CORS origins
+// @Description Retrieve the browser origins added by super admins, in addition to CORS_ALLOWED_ORIGINS and CORS_ADMIN_ALLOWED_ORIGINS (super admin only)
+// @Tags Admin CORS
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param scope query string false "Filter by scope (public, admin)"
+// @Success 200 {object} CORSOriginsResponse "CORS origins retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/cors-origins [get]
+func GetCORSOrigins(c *fiber.Ctx) error {
+	query := db.DB.WithContext(c.UserContext()).Model(&models.CORSOrigin{})
+	if scope := c.Query("scope"); scope != "" {
+		query = query.Where("scope = ?", scope)
+	}
+
+	var origins []models.CORSOrigin
+	if err := query.Order("scope, origin").Find(&origins).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve CORS origins",
+		})
+	}
+
+	data := make([]CORSOriginDTO, len(origins))
+	for i, origin := range origins {
+		data[i] = toCORSOriginDTO(origin)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(CORSOriginsResponse{
+		Success: true,
+		Message: "CORS origins retrieved successfully",
+		Data:    data,
+	})
+}
+
+// CreateCORSOrigin godoc
+// @Summary Allow a CORS origin
+// @Description Allow a browser origin to call public endpoints (scope "public") or /api/v1/admin/* endpoints (scope "admin"). Admin origins must be explicit, wildcards are rejected (super admin only)
+// @Tags Admin CORS
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateCORSOriginRequest true "Origin and scope"
+// @Success 201 {object} CORSOriginResponse "CORS origin added successfully"
+// @Failure 400 {object} APIResponse "Invalid origin or scope"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 409 {object} APIResponse "Origin already allowed for this scope"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/cors-origins [post]
+func CreateCORSOrigin(c *fiber.Ctx) error {
+	var req CreateCORSOriginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	if req.Scope != models.CORSScopePublic && req.Scope != models.CORSScopeAdmin {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid scope. Must be 'public' or 'admin'",
+		})
+	}
+	origin, err := services.NormalizeOrigin(req.Origin)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid origin. Use http(s)://host[:port] without a path or wildcard",
+		})
+	}
+
+	var existing int64
+	db.DB.WithContext(c.UserContext()).Model(&models.CORSOrigin{}).Where("origin = ? AND scope = ?", origin, req.Scope).Count(&existing)
+	if existing > 0 {
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "Origin is already allowed for this scope",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	adminID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		adminID = uuid.Nil
+	}
+
+	corsOrigin := models.CORSOrigin{Origin: origin, Scope: req.Scope, CreatedBy: adminUsername}
+	if err := db.DB.WithContext(c.UserContext()).Create(&corsOrigin).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to add CORS origin",
+		})
+	}
+	services.CORSOrigins().Invalidate()
+
+	auditDetails, _ := json.Marshal(map[string]interface{}{"origin": origin, "scope": req.Scope})
+	utils.LogAdminAction(adminID, adminUsername, "create_cors_origin", "cors_origin", corsOrigin.ID.String(), string(auditDetails), c.IP(), c.Get("User-Agent"), "success", "")
+
+	return c.Status(fiber.StatusCreated).JSON(CORSOriginResponse{
+		Success: true,
+		Message: "CORS origin added successfully",
+		Data:    toCORSOriginDTO(corsOrigin),
+	})
+}
+
+// DeleteCORSOrigin godoc
+// @Summary Remove a CORS origin
+// @Description Stop allowing a browser origin added by a super admin (super admin only)
+// @Tags Admin CORS
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "CORS origin ID (UUID)"
+// @Success 200 {object} APIResponse "CORS origin removed successfully"
+// @Failure 400 {object} APIResponse "Invalid CORS origin ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "CORS origin not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/cors-origins/{id} [delete]
+func DeleteCORSOrigin(c *fiber.Ctx) error {
+	originID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid CORS origin ID format",
+		})
+	}
+
+	var corsOrigin models.CORSOrigin
+	if err := db.DB.WithContext(c.UserContext()).First(&corsOrigin, "id = ?", originID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "CORS origin not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to remove CORS origin",
+		})
+	}
+
+	if err := db.DB.WithContext(c.UserContext()).Delete(&corsOrigin).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to remove CORS origin",
+		})
+	}
+	services.CORSOrigins().Invalidate()
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	adminID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		adminID = uuid.Nil
+	}
+	auditDetails, _ := json.Marshal(map[string]interface{}{"origin": corsOrigin.Origin, "scope": corsOrigin.Scope})
+	utils.LogAdminAction(adminID, adminUsername, "delete_cors_origin", "cors_origin", corsOrigin.ID.String(), string(auditDetails), c.IP(), c.Get("User-Agent"), "success", "")
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "CORS origin removed successfully",
+	})
+}
+
+func toCORSOriginDTO(origin models.CORSOrigin) CORSOriginDTO {
+	return CORSOriginDTO{
+		ID:        origin.ID,
+		Origin:    origin.Origin,
+		Scope:     origin.Scope,
+		CreatedBy: origin.CreatedBy,
+		CreatedAt: origin.CreatedAt,
+	}
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func corsAdminToken(role string) string {
+	admin := models.Admin{ID: uuid.New(), Username: "cors-admin-" + role, Password: "password123", Role: role}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	return token
+}
+
+func createCORSOrigin(t *testing.T, app *fiber.App, token, origin, scope string) (int, CORSOriginResponse) {
+	body, _ := json.Marshal(CreateCORSOriginRequest{Origin: origin, Scope: scope})
+	req := httptest.NewRequest("POST", "/api/v1/admin/cors-origins", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var response CORSOriginResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	return resp.StatusCode, response
+}
+
+// preflightAllowedOrigin sends a CORS preflight and returns the Access-Control-Allow-Origin header
+func preflightAllowedOrigin(t *testing.T, app *fiber.App, path, origin string) string {
+	req := httptest.NewRequest("OPTIONS", path, nil)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", "GET")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.Header.Get("Access-Control-Allow-Origin")
+}
+
+func TestCORSOrigins_CreateListDelete(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.CORSOrigins().Invalidate()
+	token := corsAdminToken(models.RoleSuper)
+
+	status, created := createCORSOrigin(t, app, token, "HTTPS://Admin.Example.com/", models.CORSScopeAdmin)
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Equal(t, "https://admin.example.com", created.Data.Origin)
+	assert.Equal(t, "cors-admin-super", created.Data.CreatedBy)
+
+	status, _ = createCORSOrigin(t, app, token, "https://admin.example.com", models.CORSScopeAdmin)
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/cors-origins", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	var list CORSOriginsResponse
+	json.NewDecoder(resp.Body).Decode(&list)
+	assert.Len(t, list.Data, 1)
+
+	req = httptest.NewRequest("DELETE", "/api/v1/admin/cors-origins/"+created.Data.ID.String(), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var count int64
+	db.DB.Model(&models.AdminAuditLog{}).Where("resource_type = ?", "cors_origin").Count(&count)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestCORSOrigins_Validation(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	token := corsAdminToken(models.RoleSuper)
+
+	for _, origin := range []string{"*", "https://*.example.com", "admin.example.com", "https://example.com/app", "ftp://example.com"} {
+		status, _ := createCORSOrigin(t, app, token, origin, models.CORSScopeAdmin)
+		assert.Equal(t, fiber.StatusBadRequest, status, origin)
+	}
+
+	status, _ := createCORSOrigin(t, app, token, "https://example.com", "everyone")
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	status, _ = createCORSOrigin(t, app, corsAdminToken(models.RoleRegular), "https://example.com", models.CORSScopePublic)
+	assert.Equal(t, fiber.StatusForbidden, status)
+}
+
+func TestCORSPolicy_AdminStricterThanPublic(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.CORSOrigins().Invalidate()
+	config.AppConfig().CORS.AllowedOrigins = "*"
+
+	// Public endpoints accept any origin, admin endpoints ignore the wildcard
+	assert.Equal(t, "*", preflightAllowedOrigin(t, app, "/api/v1/contacts", "https://anywhere.example"))
+	assert.Empty(t, preflightAllowedOrigin(t, app, "/api/v1/admin/users", "https://anywhere.example"))
+
+	// Admin origins added by a super admin are allowed on admin endpoints
+	status, _ := createCORSOrigin(t, app, corsAdminToken(models.RoleSuper), "https://panel.example", models.CORSScopeAdmin)
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Equal(t, "https://panel.example", preflightAllowedOrigin(t, app, "/api/v1/admin/users", "https://panel.example"))
+
+	// With explicit public origins, database-managed public origins extend the list
+	config.AppConfig().CORS.AllowedOrigins = "https://app.example"
+	assert.Equal(t, "https://app.example", preflightAllowedOrigin(t, app, "/api/v1/contacts", "https://app.example"))
+	assert.Empty(t, preflightAllowedOrigin(t, app, "/api/v1/contacts", "https://kiosk.example"))
+	assert.Empty(t, preflightAllowedOrigin(t, app, "/api/v1/contacts", "https://panel.example"))
+
+	// The public list is reused for admin endpoints unless CORS_ADMIN_ALLOWED_ORIGINS is set
+	assert.Equal(t, "https://app.example", preflightAllowedOrigin(t, app, "/api/v1/admin/users", "https://app.example"))
+	config.AppConfig().CORS.AdminAllowedOrigins = "https://ops.example"
+	assert.Empty(t, preflightAllowedOrigin(t, app, "/api/v1/admin/users", "https://app.example"))
+	assert.Equal(t, "https://ops.example", preflightAllowedOrigin(t, app, "/api/v1/admin/users", "https://ops.example"))
+}
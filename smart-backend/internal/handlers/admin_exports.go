@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/storage"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateExportRequest defines the structure for requesting a background export
+// @name CreateExportRequest
+type CreateExportRequest struct {
+	Type       string `json:"type" validate:"required" example:"gate_events"` // gate_events or report
+	From       string `json:"from" example:"2026-09-01"`                      // First day in the request's timezone, YYYY-MM-DD (defaults to 29 days before to)
+	To         string `json:"to" example:"2026-09-30"`                        // Last day, inclusive, YYYY-MM-DD (defaults to today)
+	LocationID int    `json:"location_id" example:"1"`                        // gate_events only: only gates of this location
+	GateID     int    `json:"gate_id" example:"10"`                           // gate_events only: only this gate
+	UserID     string `json:"user_id" example:""`                             // gate_events only: only commands issued by this user
+	Report     string `json:"report" example:"gate_opens"`                    // report only: report name, as for GET /admin/reports
+	Format     string `json:"format" example:"csv"`                           // report only: csv (default) or json
+}
+
+// CreateExport godoc
+// @Summary Request a background export
+// @Description Queue a large export instead of downloading it in the request: gate_events (the CSV of GET /admin/gate-events/export, with the same filters) or report (a report of GET /admin/reports as CSV or JSON). A background job writes the file to file storage. Poll GET /admin/exports/:id until status is ready and download it from download_url. Exports are deleted EXPORT_RETENTION after they finish (super admin only)
+// @Tags Admin Reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateExportRequest true "Export type and filters"
+// @Success 202 {object} ExportResponse "Export queued"
+// @Failure 400 {object} APIResponse "Unknown type or report, invalid filter or format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 429 {object} APIResponse "Daily export quota (EXPORT_QUOTA_DAILY) exceeded"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/exports [post]
+func CreateExport(c *fiber.Ctx) error {
+	var req CreateExportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	dateRange, err := services.ParseReportRange(req.From, req.To, time.Now(), middleware.RequestTimezone(c))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid date range: " + err.Error(),
+		})
+	}
+	exportReq := services.ExportRequest{Type: req.Type, Range: dateRange}
+
+	switch req.Type {
+	case models.ExportGateEvents:
+		if req.LocationID < 0 || req.GateID < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid location_id or gate_id",
+			})
+		}
+		exportReq.LocationID, exportReq.GateID = req.LocationID, req.GateID
+		if req.UserID != "" {
+			userID, err := uuid.Parse(req.UserID)
+			if err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+					Success: false,
+					Message: "Invalid user ID format",
+				})
+			}
+			exportReq.UserID = userID
+		}
+	case models.ExportReport:
+		if !slices.Contains(services.ReportNames, req.Report) {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Unknown report. Use one of: " + strings.Join(services.ReportNames, ", "),
+			})
+		}
+		if req.Format == "" {
+			req.Format = "csv"
+		}
+		if req.Format != "json" && req.Format != "csv" {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid format. Use json or csv",
+			})
+		}
+		exportReq.Report, exportReq.Format = req.Report, req.Format
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Unknown export type. Use gate_events or report",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	export, err := services.CreateExport(exportReq, adminUsername)
+	if err != nil {
+		middleware.RecordAudit(c, "create_export", "export", "", "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to queue export",
+		})
+	}
+	middleware.RecordAudit(c, "create_export", "export", export.ID.String(), "success", "")
+	slog.InfoContext(c.UserContext(), "[EXPORTS] Export requested", "admin", adminUsername, "type", export.Type, "export_id", export.ID)
+
+	return c.Status(fiber.StatusAccepted).JSON(ExportResponse{
+		Success: true,
+		Message: "Export queued",
+		Data:    toExportDTO(export, ""),
+	})
+}
+
+// GetExport godoc
+// @Summary Get export status
+// @Description Retrieve the status of a background export. Once status is ready, download_url is a short-lived signed link to the file, valid until download_url_expires_at; call again for a fresh one (super admin only)
+// @Tags Admin Reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Export ID (UUID)"
+// @Success 200 {object} ExportResponse "Export retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid export ID"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "Export not found or expired"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/exports/{id} [get]
+func GetExport(c *fiber.Ctx) error {
+	exportID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid export ID format",
+		})
+	}
+
+	var export models.Export
+	err = db.DB.WithContext(c.UserContext()).First(&export, "id = ?", exportID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Export not found or expired",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve export",
+		})
+	}
+
+	downloadURL := ""
+	if export.Status == models.ExportReady {
+		files, err := storage.Default()
+		if err == nil {
+			downloadURL, err = files.SignURL(export.FileKey, config.AppConfig().Storage.SignedURLTTL)
+		}
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to sign download URL",
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ExportResponse{
+		Success: true,
+		Message: "Export retrieved successfully",
+		Data:    toExportDTO(export, downloadURL),
+	})
+}
+
+// toExportDTO maps an Export model to its response DTO, with a signed download URL when ready
+func toExportDTO(e models.Export, downloadURL string) ExportDTO {
+	dto := ExportDTO{
+		ID:          e.ID,
+		Type:        e.Type,
+		Status:      e.Status,
+		Filename:    e.Filename,
+		ContentType: e.ContentType,
+		SizeBytes:   e.SizeBytes,
+		Error:       e.Error,
+		RequestedBy: e.RequestedBy,
+		CreatedAt:   e.CreatedAt,
+		StartedAt:   e.StartedAt,
+		FinishedAt:  e.FinishedAt,
+		ExpiresAt:   services.ExportExpiresAt(e),
+		DownloadURL: downloadURL,
+	}
+	if downloadURL != "" {
+		urlExpiresAt := time.Now().Add(config.AppConfig().Storage.SignedURLTTL)
+		dto.DownloadURLExpiresAt = &urlExpiresAt
+	}
+	return dto
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/storage"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExports_GenerateInBackgroundAndDownload(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.Locations().Invalidate() // The provider is unreachable in tests, so locations stay blank
+	config.AppConfig().Storage.SignedURLTTL = time.Minute
+	config.AppConfig().Exports.Retention = time.Hour
+	storage.SetDefault(storage.NewLocal(t.TempDir(), "", config.AppConfig().JWT.Secret))
+	defer storage.SetDefault(nil)
+
+	day := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	db.DB.Create(&models.GateCommand{UserID: uuid.New(), Phone: "+77771234567", GateID: 9001, Action: "open", Status: models.GateCommandConfirmed, CreatedAt: day})
+	db.DB.Create(&models.GateCommand{UserID: uuid.New(), Phone: "+77771234567", GateID: 9002, Action: "close", Status: models.GateCommandConfirmed, CreatedAt: day})
+
+	for _, body := range []map[string]interface{}{
+		{"type": "everything"},
+		{"type": "gate_events", "from": "2026-13-01"},
+		{"type": "gate_events", "user_id": "not-a-uuid"},
+		{"type": "report", "report": "unknown"},
+		{"type": "report", "report": services.ReportUserChurn, "format": "xml"},
+	} {
+		status, _ := mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/exports", body)
+		assert.Equal(t, fiber.StatusBadRequest, status, body)
+	}
+	status, _ := mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/exports", map[string]interface{}{"type": "gate_events"})
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/exports",
+		map[string]interface{}{"type": "gate_events", "from": "2026-10-05", "to": "2026-10-05", "gate_id": 9001})
+	assert.Equal(t, fiber.StatusAccepted, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, models.ExportPending, data["status"])
+	exportID := data["id"].(string)
+
+	status, result = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/exports",
+		map[string]interface{}{"type": "report", "report": services.ReportUserChurn, "from": "2026-10-05", "to": "2026-10-06", "format": "json"})
+	assert.Equal(t, fiber.StatusAccepted, status)
+	reportID := result["data"].(map[string]interface{})["id"].(string)
+
+	assert.NoError(t, services.RunPendingExports(context.Background()))
+
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/exports/"+exportID, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data = result["data"].(map[string]interface{})
+	assert.Equal(t, models.ExportReady, data["status"])
+	assert.Equal(t, "gate_events_2026-10-05_2026-10-05.csv", data["filename"])
+	assert.NotEmpty(t, data["expires_at"])
+
+	// The signed URL is downloaded without a token
+	resp, err := app.Test(httptest.NewRequest("GET", data["download_url"].(string), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "9001", records[1][7])
+
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/exports/"+reportID, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data = result["data"].(map[string]interface{})
+	assert.Equal(t, models.ExportReady, data["status"])
+	assert.Equal(t, "application/json", data["content_type"])
+	resp, err = app.Test(httptest.NewRequest("GET", data["download_url"].(string), nil))
+	assert.NoError(t, err)
+	var report map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+	assert.Equal(t, services.ReportUserChurn, report["report"])
+	assert.Len(t, report["rows"], 2)
+
+	// Expired exports are purged with their files
+	purged, err := services.PurgeExports(time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, purged)
+	status, _ = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/exports/"+exportID, nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+}
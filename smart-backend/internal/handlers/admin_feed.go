@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"log/slog"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+// adminFeedPingInterval keeps idle connections alive through proxies and detects dead clients
+const adminFeedPingInterval = 30 * time.Second
+
+// AdminFeedTokenFromQuery lets browser WebSocket clients (which cannot set headers) pass the
+// admin token as ?token=... by copying it into the Authorization header before authentication
+func AdminFeedTokenFromQuery(c *fiber.Ctx) error {
+	if c.Get("Authorization") == "" {
+		if token := c.Query("token"); token != "" {
+			c.Request().Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	return c.Next()
+}
+
+// AdminFeedUpgrade rejects plain HTTP requests to the feed endpoint
+func AdminFeedUpgrade(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return c.Status(fiber.StatusUpgradeRequired).JSON(APIResponse{
+			Success: false,
+			Message: "WebSocket upgrade required",
+		})
+	}
+	claims, ok := c.Locals("admin_claims").(*utils.AdminClaims)
+	if !ok || claims.ExpiresAt == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid or expired token",
+		})
+	}
+	c.Locals("feed_claims", claims)
+	return c.Next()
+}
+
+// AdminFeed godoc
+// @Summary Live admin dashboard feed
+// @Description WebSocket stream of new audit entries, gate open/close events and admin notifications as JSON messages ({id, type, data, occurred_at}). Audit entries are only streamed to super admins. Browser clients may pass the admin token as the `token` query parameter. The connection is closed when the token expires or is invalidated (logout elsewhere, role change, deleted admin).
+// @Tags Admin Notifications
+// @Security BearerAuth
+// @Param token query string false "Admin access token (alternative to the Authorization header)"
+// @Success 101 "Switching protocols"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 426 {object} APIResponse "WebSocket upgrade required"
+// @Router /api/v1/admin/feed [get]
+func AdminFeed(c *fiber.Ctx) error {
+	return adminFeedHandler(c)
+}
+
+var adminFeedHandler = websocket.New(streamAdminFeed)
+
+// streamAdminFeed writes feed events to the connection until the client disconnects or the
+// admin token stops being valid
+func streamAdminFeed(conn *websocket.Conn) {
+	claims := conn.Locals("feed_claims").(*utils.AdminClaims)
+	adminName := claims.Username
+	feed, unsubscribe := services.AdminFeed().Subscribe(claims.Role == models.RoleSuper)
+	defer unsubscribe()
+
+	slog.Info("[ADMIN_FEED] Admin connected", "admin", adminName, "clients", services.AdminFeed().ClientCount())
+	defer slog.Info("[ADMIN_FEED] Admin disconnected", "admin", adminName)
+
+	// The feed is one-way; the read loop only detects the client closing the connection
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(adminFeedPingInterval)
+	defer ping.Stop()
+	expiry := time.NewTimer(time.Until(claims.ExpiresAt.Time))
+	defer expiry.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case event, ok := <-feed:
+			if !ok {
+				return
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		case <-expiry.C:
+			closeAdminFeed(conn, "Token expired")
+			return
+		case <-ping.C:
+			if !adminFeedTokenValid(claims) {
+				slog.Info("[ADMIN_FEED] Closing feed of invalidated token", "admin", adminName)
+				closeAdminFeed(conn, "Token has been invalidated")
+				return
+			}
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(10*time.Second)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// adminFeedTokenValid reports whether the admin still exists and the token version was not
+// bumped since the connection was opened (logout elsewhere, role change)
+func adminFeedTokenValid(claims *utils.AdminClaims) bool {
+	version, err := services.TokenVersions().Admin(claims.AdminID)
+	return err == nil && version == claims.TokenVersion
+}
+
+// closeAdminFeed tells the client why the feed ends before the connection is closed
+func closeAdminFeed(conn *websocket.Conn, reason string) {
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, reason), time.Now().Add(10*time.Second))
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminFeed_RequiresAuthentication(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/feed", nil)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestAdminFeed_AcceptsTokenQueryAndRequiresUpgrade(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	_, token := createNotificationTestAdmin(t)
+
+	// Authenticated via ?token=, but a plain HTTP request is not a WebSocket handshake
+	req := httptest.NewRequest("GET", "/api/v1/admin/feed?token="+token, nil)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUpgradeRequired, resp.StatusCode)
+}
+
+func TestAdminFeed_TokenValidUntilInvalidated(t *testing.T) {
+	_, cleanup := SetupTestApp()
+	defer cleanup()
+	admin, token := createNotificationTestAdmin(t)
+	claims, err := utils.ValidateAdminToken(token)
+	assert.NoError(t, err)
+	assert.True(t, adminFeedTokenValid(claims))
+
+	// A role change or a login elsewhere bumps the token version
+	db.DB.Model(&models.Admin{}).Where("id = ?", admin.ID).Update("token_version", 1)
+	services.TokenVersions().InvalidateAdmin(admin.ID)
+	assert.False(t, adminFeedTokenValid(claims))
+
+	// Deleted admins lose the feed
+	db.DB.Delete(&admin)
+	services.TokenVersions().InvalidateAdmin(admin.ID)
+	assert.False(t, adminFeedTokenValid(claims))
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateForcedLogoutRequest defines the structure for signing every user out
+// @name CreateForcedLogoutRequest
+type CreateForcedLogoutRequest struct {
+	Reason string `json:"reason" validate:"required" example:"Incident #42: signing keys may have leaked"` // Kept in the audit log and shown to admins
+}
+
+// CreateForcedLogout godoc
+// @Summary Sign every user out
+// @Description Invalidate the tokens and sessions of every user, e.g. after a security incident. A background job bumps the token version of all users in batches; poll GET /admin/forced-logouts/:id for progress. The reason is stored in the audit log and every admin is notified. Admin accounts are not affected (super admin only)
+// @Tags Admin User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateForcedLogoutRequest true "Why everyone is signed out"
+// @Success 202 {object} ForcedLogoutResponse "Forced logout queued"
+// @Failure 400 {object} APIResponse "Missing reason"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 409 {object} APIResponse "A forced logout is already in progress"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/forced-logouts [post]
+func CreateForcedLogout(c *fiber.Ctx) error {
+	var req CreateForcedLogoutRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Reason) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Reason is required",
+		})
+	}
+	reason := strings.TrimSpace(req.Reason)
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	forcedLogout, err := services.CreateForcedLogout(reason, adminUsername)
+	if errors.Is(err, services.ErrForcedLogoutInProgress) {
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "A forced logout is already in progress",
+		})
+	}
+	if err != nil {
+		middleware.RecordAudit(c, "force_logout_all_users", "forced_logout", "", "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to queue forced logout",
+		})
+	}
+
+	slog.InfoContext(c.UserContext(), "[FORCED_LOGOUT] Forced logout requested",
+		"admin", adminUsername, "forced_logout_id", forcedLogout.ID, "reason", reason)
+	middleware.RecordAudit(c, "force_logout_all_users", "forced_logout", forcedLogout.ID.String(), "success", reason)
+	services.NotifyAdmins(models.SeverityWarning, models.NotificationSecurity, "Forced logout of all users",
+		fmt.Sprintf("Admin %s is signing every user out: %s", adminUsername, reason))
+
+	return c.Status(fiber.StatusAccepted).JSON(ForcedLogoutResponse{
+		Success: true,
+		Message: "Forced logout queued",
+		Data:    toForcedLogoutDTO(forcedLogout),
+	})
+}
+
+// GetForcedLogout godoc
+// @Summary Get forced logout progress
+// @Description Retrieve the status of a forced logout and how many users it has signed out so far (super admin only)
+// @Tags Admin User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Forced logout ID (UUID)"
+// @Success 200 {object} ForcedLogoutResponse "Forced logout retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid forced logout ID"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "Forced logout not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/forced-logouts/{id} [get]
+func GetForcedLogout(c *fiber.Ctx) error {
+	forcedLogoutID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid forced logout ID format",
+		})
+	}
+
+	var forcedLogout models.ForcedLogout
+	err = db.DB.WithContext(c.UserContext()).First(&forcedLogout, "id = ?", forcedLogoutID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Forced logout not found",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve forced logout",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ForcedLogoutResponse{
+		Success: true,
+		Message: "Forced logout retrieved successfully",
+		Data:    toForcedLogoutDTO(forcedLogout),
+	})
+}
+
+// toForcedLogoutDTO maps a ForcedLogout model to its response DTO
+func toForcedLogoutDTO(f models.ForcedLogout) ForcedLogoutDTO {
+	dto := ForcedLogoutDTO{
+		ID:          f.ID,
+		Reason:      f.Reason,
+		Status:      f.Status,
+		Total:       f.Total,
+		Processed:   f.Processed,
+		Error:       f.Error,
+		RequestedBy: f.RequestedBy,
+		CreatedAt:   f.CreatedAt,
+		StartedAt:   f.StartedAt,
+		FinishedAt:  f.FinishedAt,
+	}
+	switch {
+	case f.Status == models.ForcedLogoutCompleted:
+		dto.Percent = 100
+	case f.Total > 0:
+		dto.Percent = min(100, int(f.Processed*100/f.Total))
+	}
+	return dto
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForcedLogout_SignsEveryUserOut(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	first := models.User{Phone: "+77771234567", Password: "password123"}
+	second := models.User{Phone: "+77771234568", Password: "password123", TokenVersion: 4}
+	db.DB.Create(&first)
+	db.DB.Create(&second)
+	firstToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	secondToken, _ := loginOnDevice(t, app, "+77771234568", "password123", "tablet")
+
+	status, _ := mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/forced-logouts", map[string]interface{}{"reason": "  "})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/forced-logouts", map[string]interface{}{"reason": "Incident #42"})
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/forced-logouts", map[string]interface{}{"reason": "Incident #42"})
+	assert.Equal(t, fiber.StatusAccepted, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, models.ForcedLogoutPending, data["status"])
+	assert.Equal(t, "Incident #42", data["reason"])
+	id := data["id"].(string)
+
+	// Only one forced logout runs at a time
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/forced-logouts", map[string]interface{}{"reason": "Again"})
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	var audit models.AdminAuditLog
+	assert.NoError(t, db.DB.Where("action = ?", "force_logout_all_users").First(&audit).Error)
+	assert.Equal(t, id, audit.ResourceID)
+	assert.Equal(t, "Incident #42", audit.ErrorMessage)
+
+	assert.NoError(t, services.RunPendingForcedLogouts(context.Background()))
+
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/forced-logouts/"+id, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data = result["data"].(map[string]interface{})
+	assert.Equal(t, models.ForcedLogoutCompleted, data["status"])
+	assert.Equal(t, float64(2), data["total"])
+	assert.Equal(t, float64(2), data["processed"])
+	assert.Equal(t, float64(100), data["percent"])
+
+	db.DB.First(&first, "id = ?", first.ID)
+	db.DB.First(&second, "id = ?", second.ID)
+	assert.Equal(t, 1, first.TokenVersion)
+	assert.Equal(t, 5, second.TokenVersion)
+	var active int64
+	db.DB.Model(&models.UserSession{}).Where("revoked_at IS NULL").Count(&active)
+	assert.Zero(t, active)
+
+	for _, token := range []string{firstToken, secondToken} {
+		status, _ := getSessions(t, app, token)
+		assert.Equal(t, fiber.StatusUnauthorized, status)
+	}
+
+	// Users can sign in again, and a new forced logout can be requested
+	loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/forced-logouts", map[string]interface{}{"reason": "Again"})
+	assert.Equal(t, fiber.StatusAccepted, status)
+}
+
+func TestForcedLogout_ResumesAfterInterruption(t *testing.T) {
+	_, cleanup := SetupTestApp()
+	defer cleanup()
+
+	var users []models.User
+	for _, phone := range []string{"+77771234567", "+77771234568", "+77771234569"} {
+		user := models.User{Phone: phone, Password: "password123"}
+		db.DB.Create(&user)
+		users = append(users, user)
+	}
+	db.DB.Order("id").Find(&users)
+
+	// A run that stopped after signing out the first user in ID order
+	started := time.Now()
+	db.DB.Model(&models.User{}).Where("id = ?", users[0].ID).Update("token_version", 1)
+	forcedLogout := models.ForcedLogout{Reason: "Incident #42", Status: models.ForcedLogoutRunning, Total: 3, Processed: 1,
+		LastUserID: users[0].ID.String(), StartedAt: &started}
+	db.DB.Create(&forcedLogout)
+
+	// Users who sign up after the forced logout started are left alone
+	late := models.User{Phone: "+77771234570", Password: "password123"}
+	db.DB.Create(&late)
+
+	assert.NoError(t, services.RunPendingForcedLogouts(context.Background()))
+
+	db.DB.First(&forcedLogout, "id = ?", forcedLogout.ID)
+	assert.Equal(t, models.ForcedLogoutCompleted, forcedLogout.Status)
+	assert.Equal(t, int64(3), forcedLogout.Processed)
+	for _, user := range users {
+		db.DB.First(&user, "id = ?", user.ID)
+		assert.Equal(t, 1, user.TokenVersion, user.Phone)
+	}
+	db.DB.First(&late, "id = ?", late.ID)
+	assert.Equal(t, 0, late.TokenVersion)
+}
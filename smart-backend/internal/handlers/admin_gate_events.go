@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"bufio"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// GetGateEvents godoc
+// @Summary List gate open/close attempts
+// @Description Retrieve users' gate open and close attempts, newest first, whatever their result: sent to the provider, queued while it was unavailable, refused (no access, impersonation, maintenance, freeze or a conflicting command) or failed. Each has the user, gate, location (when the gate is in the user's gate list), response status and message, command, latency and IP address. Filter by a range of days in the request's timezone (X-Timezone, the admin's timezone or DEFAULT_TIMEZONE; at most 366, defaults to the last 30), user, gate, location and result. Attempts are kept for GATE_COMMAND_RETENTION
+// @Tags Admin Reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param from query string false "First day, YYYY-MM-DD (defaults to 29 days before to)"
+// @Param to query string false "Last day, inclusive, YYYY-MM-DD (defaults to today)"
+// @Param user_id query string false "Only attempts by this user (UUID)"
+// @Param gate_id query int false "Only this gate"
+// @Param location_id query int false "Only gates of this location"
+// @Param result query string false "Only this result (sent, queued, refused, failed)"
+// @Success 200 {object} GateEventLogsResponse "Gate events retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid filter"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/gate-events [get]
+func GetGateEvents(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	dateRange, err := services.ParseReportRange(c.Query("from"), c.Query("to"), time.Now(), middleware.RequestTimezone(c))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid date range: " + err.Error(),
+		})
+	}
+
+	filter := services.GateEventLogFilter{Range: dateRange}
+	for param, target := range map[string]*int{"location_id": &filter.LocationID, "gate_id": &filter.GateID} {
+		if value := c.Query(param); value != "" {
+			id, err := strconv.Atoi(value)
+			if err != nil || id <= 0 {
+				return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+					Success: false,
+					Message: fmt.Sprintf("Invalid %s", param),
+				})
+			}
+			*target = id
+		}
+	}
+	if value := c.Query("user_id"); value != "" {
+		userID, err := uuid.Parse(value)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid user ID format",
+			})
+		}
+		filter.UserID = userID
+	}
+	switch filter.Result = c.Query("result"); filter.Result {
+	case "", models.GateAttemptSent, models.GateAttemptQueued, models.GateAttemptRefused, models.GateAttemptFailed:
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid result, use sent, queued, refused or failed",
+		})
+	}
+
+	entries, total, err := services.GateEventLogs(filter, page, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve gate events",
+		})
+	}
+
+	dtos := make([]GateEventLogDTO, len(entries))
+	for i, entry := range entries {
+		dtos[i] = GateEventLogDTO{
+			ID:         entry.ID,
+			UserID:     entry.UserID,
+			GateID:     entry.GateID,
+			LocationID: entry.LocationID,
+			Action:     entry.Action,
+			Result:     entry.Result,
+			StatusCode: entry.StatusCode,
+			Message:    entry.Message,
+			CommandID:  entry.CommandID,
+			LatencyMs:  entry.LatencyMs,
+			IPAddress:  entry.IPAddress,
+			CreatedAt:  entry.CreatedAt,
+		}
+	}
+
+	lastPage := int((total + int64(limit) - 1) / int64(limit))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return c.Status(fiber.StatusOK).JSON(GateEventLogsResponse{
+		Success: true,
+		Message: "Gate events retrieved successfully",
+		Data:    dtos,
+		Pagination: PaginationMeta{
+			Total:       int(total),
+			PerPage:     limit,
+			CurrentPage: page,
+			LastPage:    lastPage,
+		},
+	})
+}
+
+// ExportGateEvents godoc
+// @Summary Export gate operation history as CSV
+// @Description Stream open/close commands, oldest first, as CSV for parking reconciliation with billing: command ID, timestamps, user, phone, location, gate, action, status and error. Filter by a range of days in the request's timezone (X-Timezone, the admin's timezone or DEFAULT_TIMEZONE; at most 366, defaults to the last 30), which timestamps are also written in, location, gate and user. Locations are resolved from the provider's location list; if it cannot be loaded, location columns are left blank and filtering by location fails (super admin only)
+// @Tags Admin Reports
+// @Accept json
+// @Produce text/csv
+// @Security BearerAuth
+// @Param from query string false "First day, YYYY-MM-DD (defaults to 29 days before to)"
+// @Param to query string false "Last day, inclusive, YYYY-MM-DD (defaults to today)"
+// @Param location_id query int false "Only gates of this location"
+// @Param gate_id query int false "Only this gate"
+// @Param user_id query string false "Only commands issued by this user (UUID)"
+// @Success 200 {string} string "CSV export"
+// @Failure 400 {object} APIResponse "Invalid filter"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 429 {object} APIResponse "Daily export quota (EXPORT_QUOTA_DAILY) exceeded"
+// @Failure 502 {object} APIResponse "Locations could not be loaded from the provider"
+// @Failure 503 {object} APIResponse "Provider unavailable"
+// @Router /api/v1/admin/gate-events/export [get]
+func ExportGateEvents(c *fiber.Ctx) error {
+	dateRange, err := services.ParseReportRange(c.Query("from"), c.Query("to"), time.Now(), middleware.RequestTimezone(c))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid date range: " + err.Error(),
+		})
+	}
+
+	filter := services.GateEventFilter{Range: dateRange}
+	for param, target := range map[string]*int{"location_id": &filter.LocationID, "gate_id": &filter.GateID} {
+		if value := c.Query(param); value != "" {
+			id, err := strconv.Atoi(value)
+			if err != nil || id <= 0 {
+				return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+					Success: false,
+					Message: fmt.Sprintf("Invalid %s", param),
+				})
+			}
+			*target = id
+		}
+	}
+	if value := c.Query("user_id"); value != "" {
+		userID, err := uuid.Parse(value)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid user ID format",
+			})
+		}
+		filter.UserID = userID
+	}
+
+	export, err := services.NewGateEventExport(filter, services.NewThirdPartyClient().WithContext(c.UserContext()))
+	if err != nil {
+		return respondUpstreamError(c, err, "Failed to load locations")
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, export.Filename()))
+	c.Status(fiber.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// The status is already sent, so a failure can only cut the export short
+		if err := export.WriteCSV(w); err != nil {
+			slog.ErrorContext(c.UserContext(), "[GATE_EVENTS] Export failed", "error", err)
+		}
+		w.Flush()
+	})
+	return nil
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func exportGateEvents(t *testing.T, app *fiber.App, query string) (int, [][]string) {
+	admin := models.Admin{ID: uuid.New(), Username: "export-admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/gate-events/export"+query, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	if !strings.HasPrefix(resp.Header.Get(fiber.HeaderContentType), "text/csv") {
+		return resp.StatusCode, nil
+	}
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	assert.NoError(t, err)
+	return resp.StatusCode, records
+}
+
+func TestExportGateEvents_FiltersAndStreamsCSV(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.Locations().Invalidate() // The provider is unreachable in tests, so locations stay blank
+
+	userID := uuid.New()
+	day := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	db.DB.Create(&models.GateCommand{UserID: userID, Phone: "+77771234567", GateID: 9001, Action: "open", Status: models.GateCommandConfirmed, CreatedAt: day})
+	db.DB.Create(&models.GateCommand{UserID: userID, Phone: "+77771234567", GateID: 9002, Action: "close", Status: models.GateCommandFailed, ErrorMessage: "Barrier obstructed", CreatedAt: day.Add(time.Hour)})
+	db.DB.Create(&models.GateCommand{UserID: uuid.New(), Phone: "+77770000000", GateID: 9001, Action: "open", Status: models.GateCommandConfirmed, CreatedAt: day.Add(2 * time.Hour)})
+	db.DB.Create(&models.GateCommand{UserID: userID, Phone: "+77771234567", GateID: 9001, Action: "open", Status: models.GateCommandConfirmed, CreatedAt: day.AddDate(0, 0, 2)})
+
+	status, records := exportGateEvents(t, app, "?from=2026-10-05&to=2026-10-05&user_id="+userID.String())
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, records, 3)
+	assert.Equal(t, "command_id", records[0][0])
+	assert.Equal(t, []string{"2026-10-05T09:00:00Z", "9001", "open", "confirmed"}, []string{records[1][1], records[1][7], records[1][9], records[1][10]})
+	assert.Equal(t, []string{"9002", "close", "failed", "Barrier obstructed"}, []string{records[2][7], records[2][9], records[2][10], records[2][11]})
+
+	status, records = exportGateEvents(t, app, "?from=2026-10-05&to=2026-10-07&gate_id=9001")
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, records, 4)
+}
+
+func TestExportGateEvents_InvalidFilters(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.Locations().Invalidate()
+
+	for _, query := range []string{"?from=yesterday", "?gate_id=abc", "?location_id=-1", "?user_id=not-a-uuid"} {
+		status, _ := exportGateEvents(t, app, query)
+		assert.Equal(t, fiber.StatusBadRequest, status, query)
+	}
+
+	// Filtering by location needs the provider's location list
+	status, _ := exportGateEvents(t, app, "?location_id=1")
+	assert.Equal(t, fiber.StatusServiceUnavailable, status)
+}
+
+func TestGetGateEvents_RecordsEveryAttempt(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	// Gates 40 (location 4) and 50 (location 5) are assigned to the user
+	server := freezeProvider()
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	user, token := createGateCommandTestUser(t, "+77015550101")
+	for _, path := range []string{"/api/v1/locations/40/open", "/api/v1/locations/60/open", "/api/v1/locations/50/close"} {
+		req := httptest.NewRequest("PUT", path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		_, err := app.Test(req)
+		assert.NoError(t, err)
+	}
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gate-events?user_id="+user.ID.String(), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, float64(3), result["pagination"].(map[string]interface{})["total"])
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gate-events?result=refused", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	entries := result["data"].([]interface{})
+	assert.Len(t, entries, 1)
+	refused := entries[0].(map[string]interface{})
+	assert.Equal(t, float64(60), refused["gate_id"])
+	assert.Equal(t, float64(fiber.StatusForbidden), refused["status_code"])
+	assert.Equal(t, "You do not have access to this gate", refused["message"])
+	assert.Nil(t, refused["location_id"])
+	assert.Nil(t, refused["command_id"])
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gate-events?gate_id=40", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	entries = result["data"].([]interface{})
+	assert.Len(t, entries, 1)
+	sent := entries[0].(map[string]interface{})
+	assert.Equal(t, models.GateAttemptSent, sent["result"])
+	assert.Equal(t, "open", sent["action"])
+	assert.Equal(t, float64(4), sent["location_id"])
+	assert.NotEmpty(t, sent["command_id"])
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gate-events?location_id=5", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 1)
+
+	// Attempts outside the range are not listed
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gate-events?from=2020-01-01&to=2020-01-31", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 0)
+}
+
+func TestGetGateEvents_InvalidFilters(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	for _, query := range []string{"?result=opened", "?gate_id=abc", "?user_id=nope", "?from=2026-02-01&to=2026-01-01"} {
+		status, _ := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gate-events"+query, nil)
+		assert.Equal(t, fiber.StatusBadRequest, status, query)
+	}
+}
@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"log/slog"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GateMaintenanceCode is the error code of user opens and closes refused because the gate is under maintenance
+const GateMaintenanceCode = "gate_maintenance"
+
+// SetGateMaintenanceRequest defines the structure for putting a gate under maintenance
+// @name SetGateMaintenanceRequest
+type SetGateMaintenanceRequest struct {
+	Note string `json:"note" validate:"required" example:"Barrier motor replacement until Friday"` // Shown to users whose open or close is refused
+}
+
+// SetGateMaintenance godoc
+// @Summary Put a gate under maintenance
+// @Description Refuse user opens and closes of a gate with the note (code gate_maintenance), show it greyed out in the app and drop its queued user commands until maintenance ends. Setting it again replaces the note. Admins can still open the gate with PUT /admin/gates/:gateId/open (requires admin authentication)
+// @Tags Gate Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param gateId path int true "Gate ID"
+// @Param request body SetGateMaintenanceRequest true "Note shown to users"
+// @Success 200 {object} GateMaintenanceResponse "Gate under maintenance"
+// @Failure 400 {object} APIResponse "Invalid gate ID or missing note"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Gate not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} APIResponse "Third-party API unavailable"
+// @Router /api/v1/admin/gates/{gateId}/maintenance [put]
+func SetGateMaintenance(c *fiber.Ctx) error {
+	gateID, err := strconv.Atoi(c.Params("gateId"))
+	if err != nil || gateID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid gate ID",
+		})
+	}
+
+	var req SetGateMaintenanceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+	req.Note = strings.TrimSpace(req.Note)
+	if req.Note == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Note is required",
+		})
+	}
+
+	locations, err := services.Locations().All(services.NewThirdPartyClient().WithContext(c.UserContext()))
+	if err != nil && locations == nil {
+		return respondUpstreamError(c, err, "Failed to find gate")
+	}
+	found := false
+	for _, location := range locations {
+		for _, gate := range location.Gates {
+			found = found || gate.ID == gateID
+		}
+	}
+	if !found {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Gate not found",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	maintenance, err := services.SetGateMaintenance(models.GateMaintenance{GateID: gateID, Note: req.Note, StartedBy: adminUsername})
+	if err != nil {
+		middleware.RecordAudit(c, "set_gate_maintenance", "gate", strconv.Itoa(gateID), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to put gate under maintenance",
+		})
+	}
+	middleware.RecordAudit(c, "set_gate_maintenance", "gate", strconv.Itoa(gateID), "success", "")
+	slog.InfoContext(c.UserContext(), "[GATE_MAINTENANCE] Gate put under maintenance",
+		"admin", adminUsername, "gate_id", gateID, "note", maintenance.Note)
+
+	return c.Status(fiber.StatusOK).JSON(GateMaintenanceResponse{
+		Success: true,
+		Message: "Gate under maintenance",
+		Data:    toGateMaintenanceDTO(maintenance),
+	})
+}
+
+// EndGateMaintenance godoc
+// @Summary End a gate's maintenance
+// @Description Let users open and close the gate again (requires admin authentication)
+// @Tags Gate Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param gateId path int true "Gate ID"
+// @Success 200 {object} APIResponse "Maintenance ended"
+// @Failure 400 {object} APIResponse "Invalid gate ID"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Gate is not under maintenance"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/gates/{gateId}/maintenance [delete]
+func EndGateMaintenance(c *fiber.Ctx) error {
+	gateID, err := strconv.Atoi(c.Params("gateId"))
+	if err != nil || gateID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid gate ID",
+		})
+	}
+
+	ended, err := services.EndGateMaintenance(gateID)
+	if err != nil {
+		middleware.RecordAudit(c, "end_gate_maintenance", "gate", strconv.Itoa(gateID), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to end maintenance",
+		})
+	}
+	if !ended {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Gate is not under maintenance",
+		})
+	}
+	middleware.RecordAudit(c, "end_gate_maintenance", "gate", strconv.Itoa(gateID), "success", "")
+	slog.InfoContext(c.UserContext(), "[GATE_MAINTENANCE] Gate maintenance ended", "admin", c.Locals("admin_username"), "gate_id", gateID)
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "Maintenance ended",
+	})
+}
+
+// GetGateMaintenances godoc
+// @Summary List gates under maintenance
+// @Description Retrieve the gates under maintenance with their notes, longest first (requires admin authentication)
+// @Tags Gate Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} GateMaintenancesResponse "Gate maintenance retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/gates/maintenance [get]
+func GetGateMaintenances(c *fiber.Ctx) error {
+	maintenances, err := services.GateMaintenances()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve gate maintenance",
+		})
+	}
+
+	dtos := make([]GateMaintenanceDTO, len(maintenances))
+	for i, maintenance := range maintenances {
+		dtos[i] = toGateMaintenanceDTO(maintenance)
+	}
+	return c.Status(fiber.StatusOK).JSON(GateMaintenancesResponse{
+		Success: true,
+		Message: "Gate maintenance retrieved successfully",
+		Data:    dtos,
+	})
+}
+
+// respondGateMaintenance refuses a user's open or close of a gate under maintenance
+func respondGateMaintenance(c *fiber.Ctx, maintenance *models.GateMaintenance) error {
+	return c.Status(fiber.StatusLocked).JSON(GateMaintenanceRefusedResponse{
+		Success: false,
+		Message: "Gate is under maintenance: " + maintenance.Note,
+		Data: GateMaintenanceRefusedData{
+			Code:   GateMaintenanceCode,
+			GateID: maintenance.GateID,
+			Note:   maintenance.Note,
+			Since:  maintenance.CreatedAt,
+		},
+	})
+}
+
+// gateMaintenanceOf loads the maintenance of the gates of the locations
+func gateMaintenanceOf(locations []services.LocationResponse) map[int]models.GateMaintenance {
+	var gateIDs []int
+	for _, location := range locations {
+		for _, gate := range location.Gates {
+			gateIDs = append(gateIDs, gate.ID)
+		}
+	}
+	return services.GatesUnderMaintenance(gateIDs)
+}
+
+// toGateDTO maps a provider gate to its response DTO, flagging it when it is under maintenance
+func toGateDTO(gate services.GateResponse, maintenance map[int]models.GateMaintenance) GateDTO {
+	dto := GateDTO{
+		ID:               gate.ID,
+		Title:            gate.Title,
+		Description:      gate.Description,
+		LocationID:       gate.LocationID,
+		IsOpen:           gate.IsOpen,
+		GateIsHorizontal: gate.GateIsHorizontal,
+	}
+	if m, ok := maintenance[gate.ID]; ok {
+		dto.UnderMaintenance = true
+		dto.MaintenanceNote = m.Note
+	}
+	return dto
+}
+
+// toGateMaintenanceDTO maps a GateMaintenance model to its response DTO
+func toGateMaintenanceDTO(m models.GateMaintenance) GateMaintenanceDTO {
+	return GateMaintenanceDTO{
+		GateID:    m.GateID,
+		Note:      m.Note,
+		StartedBy: m.StartedBy,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGateMaintenance_RefusesUserCommandsUntilEnded(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	server := freezeProvider()
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+	services.Locations().Invalidate()
+	defer services.Locations().Invalidate()
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+	tokens, _ := utils.GenerateTokens(user.ID, user.Phone, user.TokenVersion)
+	userRequest := func(method, path string) (int, map[string]interface{}) {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		return resp.StatusCode, result
+	}
+
+	status, _ := mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/gates/99/maintenance", map[string]interface{}{"note": "Motor replacement"})
+	assert.Equal(t, fiber.StatusNotFound, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/gates/40/maintenance", map[string]interface{}{"note": " "})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/gates/40/maintenance", map[string]interface{}{"note": "Motor replacement"})
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Motor replacement", result["data"].(map[string]interface{})["note"])
+
+	// Both opens and closes are refused with the note, other gates are unaffected
+	for _, action := range []string{"open", "close"} {
+		status, result = userRequest("PUT", "/api/v1/locations/40/"+action)
+		assert.Equal(t, fiber.StatusLocked, status)
+		assert.Equal(t, "Gate is under maintenance: Motor replacement", result["message"])
+		data := result["data"].(map[string]interface{})
+		assert.Equal(t, GateMaintenanceCode, data["code"])
+		assert.Equal(t, float64(40), data["gate_id"])
+	}
+	status, _ = userRequest("PUT", "/api/v1/locations/50/open")
+	assert.Equal(t, fiber.StatusOK, status)
+
+	// Gate lists flag the gate
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/available-locations", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	for _, location := range result["data"].([]interface{}) {
+		gate := location.(map[string]interface{})["gates"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, gate["id"] == float64(40), gate["under_maintenance"])
+		if gate["id"] == float64(40) {
+			assert.Equal(t, "Motor replacement", gate["maintenance_note"])
+		}
+	}
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gates/maintenance", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 1)
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "DELETE", "/api/v1/admin/gates/40/maintenance", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = userRequest("PUT", "/api/v1/locations/40/open")
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "DELETE", "/api/v1/admin/gates/40/maintenance", nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+}
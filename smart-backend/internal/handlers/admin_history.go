@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// GetAdminHistory godoc
+// @Summary Get an admin account's change history
+// @Description Retrieve every change to an admin account, newest first, with who made it and the changed fields before and after: username, role and password changes (flag only). History is kept after the admin is deleted (super admin only)
+// @Tags Admin User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Admin ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} AdminHistoryResponse "Admin history retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid admin ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "Admin not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/users/{id}/history [get]
+func GetAdminHistory(c *fiber.Ctx) error {
+	adminID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid admin ID format",
+		})
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	query := db.DB.WithContext(c.UserContext()).Model(&models.AdminHistory{}).Where("admin_id = ?", adminID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve admin history",
+		})
+	}
+
+	// Deleted admins keep their history, so only report admins that never existed as not found
+	if total == 0 {
+		var admins int64
+		db.DB.WithContext(c.UserContext()).Unscoped().Model(&models.Admin{}).Where("id = ?", adminID).Count(&admins)
+		if admins == 0 {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "Admin not found",
+			})
+		}
+	}
+
+	var entries []models.AdminHistory
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&entries).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve admin history",
+		})
+	}
+
+	dtos := make([]AdminHistoryDTO, len(entries))
+	for i, entry := range entries {
+		changes := make(map[string]FieldChangeDTO, len(entry.Changes))
+		for field, change := range entry.Changes {
+			changes[field] = FieldChangeDTO{Before: change.Before, After: change.After}
+		}
+		dtos[i] = AdminHistoryDTO{
+			ID:        entry.ID,
+			Action:    entry.Action,
+			ActorID:   entry.ActorID,
+			Actor:     entry.Actor,
+			Changes:   changes,
+			CreatedAt: entry.CreatedAt,
+		}
+	}
+
+	lastPage := int((total + int64(limit) - 1) / int64(limit))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return c.Status(fiber.StatusOK).JSON(AdminHistoryResponse{
+		Success: true,
+		Message: "Admin history retrieved successfully",
+		Data:    dtos,
+		Pagination: PaginationMeta{
+			Total:       int(total),
+			PerPage:     limit,
+			CurrentPage: page,
+			LastPage:    lastPage,
+		},
+	})
+}
+
+// historyActor returns the ID and username of the admin making the request, for history entries
+func historyActor(c *fiber.Ctx) (*uuid.UUID, string) {
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	adminID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		return nil, adminUsername
+	}
+	return &adminID, adminUsername
+}
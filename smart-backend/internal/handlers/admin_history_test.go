@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func adminHistoryRequest(t *testing.T, app *fiber.App, token, method, path string, body interface{}) (int, map[string]interface{}) {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestAdminHistory_TracksRoleEscalation(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	superAdmin := models.Admin{ID: uuid.New(), Username: "superadmin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&superAdmin)
+	superToken, _ := utils.GenerateAdminToken(superAdmin.ID, superAdmin.Username, superAdmin.Role, 0)
+
+	status, result := adminHistoryRequest(t, app, superToken, "POST", "/api/v1/admin/users", map[string]string{
+		"username": "operator", "password": "password123", "role": models.RoleRegular,
+	})
+	assert.Equal(t, fiber.StatusCreated, status)
+	adminID := result["data"].(map[string]interface{})["id"].(string)
+
+	status, _ = adminHistoryRequest(t, app, superToken, "PATCH", "/api/v1/admin/users/"+adminID, map[string]string{
+		"role": models.RoleSuper, "password": "newpassword123",
+	})
+	assert.Equal(t, fiber.StatusOK, status)
+
+	// Nothing changed, so nothing is recorded
+	status, _ = adminHistoryRequest(t, app, superToken, "PATCH", "/api/v1/admin/users/"+adminID, map[string]string{"username": "operator"})
+	assert.Equal(t, fiber.StatusOK, status)
+
+	status, result = adminHistoryRequest(t, app, superToken, "GET", "/api/v1/admin/users/"+adminID+"/history", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	entries := result["data"].([]interface{})
+	if !assert.Len(t, entries, 2) {
+		return
+	}
+
+	updated := entries[0].(map[string]interface{})
+	assert.Equal(t, models.AdminHistoryUpdated, updated["action"])
+	assert.Equal(t, "superadmin", updated["actor"])
+	assert.Equal(t, superAdmin.ID.String(), updated["actor_id"])
+	changes := updated["changes"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"before": models.RoleRegular, "after": models.RoleSuper}, changes["role"])
+	assert.Equal(t, map[string]interface{}{"before": false, "after": true}, changes["password_changed"])
+
+	created := entries[1].(map[string]interface{})
+	assert.Equal(t, models.AdminHistoryCreated, created["action"])
+	assert.Equal(t, map[string]interface{}{"before": nil, "after": "operator"}, created["changes"].(map[string]interface{})["username"])
+
+	// The promotion is raised in the notification center
+	var notifications int64
+	db.DB.Model(&models.AdminNotification{}).Where("category = ? AND title = ?", models.NotificationSecurity, "Admin promoted to super admin").Count(&notifications)
+	assert.Equal(t, int64(1), notifications)
+
+	// History is for super admins only, including an admin's own history
+	regularAdmin := models.Admin{ID: uuid.New(), Username: "regularadmin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&regularAdmin)
+	regularToken, _ := utils.GenerateAdminToken(regularAdmin.ID, regularAdmin.Username, regularAdmin.Role, 0)
+	status, _ = adminHistoryRequest(t, app, regularToken, "GET", "/api/v1/admin/users/"+regularAdmin.ID.String()+"/history", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// defaultImpersonationTTL is used when IMPERSONATION_TOKEN_TTL is not positive
+const defaultImpersonationTTL = 15 * time.Minute
+
+// ImpersonateUserRequest defines the structure for impersonating a user
+// @name ImpersonateUserRequest
+type ImpersonateUserRequest struct {
+	Reason              string `json:"reason" validate:"required" example:"Ticket #1234: resident cannot see their gates"` // Why the admin needs to act as the user (kept in the audit log)
+	BlockGateOperations *bool  `json:"block_gate_operations" example:"true"`                                               // Refuse gate open/close with the token (defaults to true unless IMPERSONATION_ALLOW_GATE_OPERATIONS is set)
+}
+
+// ImpersonateUser godoc
+// @Summary Impersonate a user
+// @Description Issue a short-lived access token for a user so support can see exactly what the resident sees. The token is flagged as impersonation, has no refresh token, and every request made with it is written to the audit log under the admin. Gate operations are blocked unless block_gate_operations is false (super admin only)
+// @Tags Admin Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param userId path string true "User ID (UUID)"
+// @Param request body ImpersonateUserRequest true "Reason and options"
+// @Success 200 {object} ImpersonationResponse "Impersonation token issued"
+// @Failure 400 {object} APIResponse "Invalid user ID, or missing reason"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 409 {object} APIResponse "User is in the trash"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/impersonate/{userId} [post]
+func ImpersonateUser(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("userId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		})
+	}
+
+	var req ImpersonateUserRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Reason) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "A reason for impersonating the user is required",
+		})
+	}
+
+	var user models.User
+	if err := db.DB.WithContext(c.UserContext()).First(&user, "id = ?", userID).Error; err != nil {
+		middleware.RecordAudit(c, "impersonate_user", "user", userID.String(), "failed", "User not found")
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "User not found",
+		})
+	}
+
+	if user.TrashedAt != nil {
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "User is in the trash and cannot be impersonated",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	adminID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		adminID = uuid.Nil
+	}
+
+	blockGates := !config.AppConfig().Impersonation.AllowGateOperations
+	if req.BlockGateOperations != nil {
+		blockGates = *req.BlockGateOperations
+	}
+	ttl := config.AppConfig().Impersonation.TokenTTL
+	if ttl <= 0 {
+		ttl = defaultImpersonationTTL
+	}
+
+	token, err := utils.GenerateImpersonationToken(user.ID, user.Phone, user.TokenVersion, adminID, blockGates, ttl)
+	if err != nil {
+		middleware.RecordAudit(c, "impersonate_user", "user", user.ID.String(), "failed", "Failed to generate token")
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to generate impersonation token",
+		})
+	}
+
+	slog.InfoContext(c.UserContext(), "[IMPERSONATION] Admin is impersonating a user",
+		"admin", adminUsername, "admin_id", adminID, "user_id", user.ID, "ttl", ttl, "gate_operations_blocked", blockGates, "reason", req.Reason)
+	middleware.RecordAudit(c, "impersonate_user", "user", user.ID.String(), "success", "")
+
+	return c.Status(fiber.StatusOK).JSON(ImpersonationResponse{
+		Success: true,
+		Message: "Impersonation token issued",
+		Data: ImpersonationTokenDTO{
+			AccessToken:           token,
+			ExpiresIn:             int64(ttl.Seconds()),
+			ExpiresAt:             time.Now().Add(ttl),
+			UserID:                user.ID,
+			Phone:                 user.Phone,
+			GateOperationsBlocked: blockGates,
+		},
+	})
+}
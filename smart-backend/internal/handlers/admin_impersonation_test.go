@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func impersonatedRequest(t *testing.T, app *fiber.App, method, path, token string) int {
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.StatusCode
+}
+
+func TestImpersonateUser_IssuesAuditedToken(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+	path := "/api/v1/admin/impersonate/" + user.ID.String()
+
+	// Super admins only, and a reason is required
+	status, _ := mergeRequest(t, app, models.RoleRegular, "POST", path, map[string]string{"reason": "ticket 1"})
+	assert.Equal(t, fiber.StatusForbidden, status)
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", path, map[string]string{})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "POST", path, map[string]string{"reason": "ticket 1"})
+	assert.Equal(t, fiber.StatusOK, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, true, data["gate_operations_blocked"])
+	token := data["access_token"].(string)
+
+	claims, err := utils.ValidateToken(token, utils.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, claims.UserID)
+	assert.NotEmpty(t, claims.Impersonator)
+	assert.True(t, claims.BlockGates)
+
+	// The issued token is not stored in the audit log
+	var issued models.AdminAuditLog
+	assert.NoError(t, db.DB.Where("action = ? AND resource_id = ?", "impersonate_user", user.ID.String()).First(&issued).Error)
+	assert.Contains(t, issued.Details, "ticket 1")
+	assert.False(t, strings.Contains(issued.Details, token))
+
+	// The token acts as the user, and every request is audited under the admin
+	assert.Equal(t, fiber.StatusOK, impersonatedRequest(t, app, "GET", "/api/v1/auth/sessions", token))
+
+	var request models.AdminAuditLog
+	assert.NoError(t, db.DB.Where("action = ? AND resource_id = ?", "impersonated_request", user.ID.String()).First(&request).Error)
+	assert.Equal(t, claims.Impersonator, request.AdminID.String())
+	assert.Contains(t, request.Details, "/api/v1/auth/sessions")
+
+	// Gate operations are blocked by default
+	assert.Equal(t, fiber.StatusForbidden, impersonatedRequest(t, app, "PUT", "/api/v1/locations/5/open", token))
+
+	// The token stops working once the admin is no longer a super admin
+	adminID, _ := uuid.Parse(claims.Impersonator)
+	assert.NoError(t, db.DB.Model(&models.Admin{}).Where("id = ?", adminID).Update("role", models.RoleRegular).Error)
+	assert.Equal(t, fiber.StatusUnauthorized, impersonatedRequest(t, app, "GET", "/api/v1/auth/sessions", token))
+}
+
+func TestImpersonateUser_GateOperationsCanBeAllowed(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/impersonate/"+user.ID.String(),
+		map[string]interface{}{"reason": "reproduce gate issue", "block_gate_operations": false})
+	assert.Equal(t, fiber.StatusOK, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, false, data["gate_operations_blocked"])
+
+	claims, err := utils.ValidateToken(data["access_token"].(string), utils.AccessToken)
+	assert.NoError(t, err)
+	assert.False(t, claims.BlockGates)
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ImportAdmins godoc
+// @Summary Import admin accounts from CSV
+// @Description Create up to 200 admin accounts at once from a CSV with a header row: username, role (super, regular or auditor) and optionally locations, which must be left empty as admin accounts are not limited to locations. Send the CSV as the request body (text/csv) or as the "file" field of a multipart form. Each account gets a generated password, returned only in this response; share it with the admin, who changes it with PATCH /admin/users/:id. If any line is invalid, no account is created and every problem is listed by line (super admin only)
+// @Tags Admin User Management
+// @Accept text/csv,multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file false "CSV file, when uploading a multipart form"
+// @Success 201 {object} AdminImportResponse "Admins imported with their generated passwords"
+// @Failure 400 {object} AdminImportErrorResponse "Invalid CSV, nothing was imported"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/users/import [post]
+func ImportAdmins(c *fiber.Ctx) error {
+	var csvData io.Reader = bytes.NewReader(c.Body())
+	if file, err := c.FormFile("file"); err == nil {
+		f, err := file.Open()
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to read the uploaded file",
+			})
+		}
+		defer f.Close()
+		csvData = f
+	}
+
+	actorID, actor := historyActor(c)
+	imported, err := services.ImportAdmins(csvData, actorID, actor)
+	var importErr *services.AdminImportError
+	if errors.As(err, &importErr) {
+		problems := make([]AdminImportLineErrorDTO, len(importErr.Lines))
+		for i, line := range importErr.Lines {
+			problems[i] = AdminImportLineErrorDTO{Line: line.Line, Message: line.Message}
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(AdminImportErrorResponse{
+			Success: false,
+			Message: "Import rejected, no admins were created",
+			Data:    problems,
+		})
+	}
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[ADMIN_IMPORT] Failed to import admins", "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to import admins",
+		})
+	}
+
+	admins := make([]ImportedAdminDTO, len(imported))
+	for i, created := range imported {
+		admins[i] = ImportedAdminDTO{
+			AdminID:  created.Admin.ID,
+			Username: created.Admin.Username,
+			Role:     created.Admin.Role,
+			Password: created.Password,
+		}
+	}
+	slog.InfoContext(c.UserContext(), "[ADMIN_IMPORT] Admins imported", "actor", actor, "count", len(admins))
+
+	return c.Status(fiber.StatusCreated).JSON(AdminImportResponse{
+		Success: true,
+		Message: fmt.Sprintf("%d admins imported. Share each password once; admins change it with PATCH /admin/users/:id", len(admins)),
+		Data:    admins,
+	})
+}
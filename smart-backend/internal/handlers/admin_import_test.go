@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func importAdminsRequest(t *testing.T, app *fiber.App, role, contentType string, body []byte) (int, map[string]interface{}) {
+	admin := models.Admin{ID: uuid.New(), Username: "import-admin-" + uuid.NewString()[:8], Password: "password123", Role: role}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("POST", "/api/v1/admin/users/import", bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestImportAdmins_CreatesAccountsWithGeneratedPasswords(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	csv := "\ufeffUsername,Role,Locations\ndesk-north,regular,\ndesk-south, Auditor ,\n"
+	status, _ := importAdminsRequest(t, app, models.RoleRegular, "text/csv", []byte(csv))
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	status, result := importAdminsRequest(t, app, models.RoleSuper, "text/csv", []byte(csv))
+	assert.Equal(t, fiber.StatusCreated, status)
+	imported := result["data"].([]interface{})
+	assert.Len(t, imported, 2)
+
+	first := imported[0].(map[string]interface{})
+	assert.Equal(t, "desk-north", first["username"])
+	var admin models.Admin
+	assert.NoError(t, db.DB.Where("username = ?", "desk-north").First(&admin).Error)
+	assert.Equal(t, models.RoleRegular, admin.Role)
+	assert.True(t, admin.CheckPassword(first["password"].(string)))
+
+	var auditor models.Admin
+	db.DB.Where("username = ?", "desk-south").First(&auditor)
+	assert.Equal(t, models.RoleAuditor, auditor.Role)
+
+	var history []models.AdminHistory
+	db.DB.Where("admin_id = ?", auditor.ID).Find(&history)
+	assert.Len(t, history, 1)
+	assert.Equal(t, models.AdminHistoryCreated, history[0].Action)
+
+	// Generated passwords are kept out of the audit log
+	var audit models.AdminAuditLog
+	db.DB.Where("resource_id = ?", "POST /api/v1/admin/users/import").Last(&audit)
+	assert.NotContains(t, audit.Details, first["password"].(string))
+}
+
+func TestImportAdmins_MultipartUpload(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, _ := form.CreateFormFile("file", "admins.csv")
+	part.Write([]byte("username,role\ndesk-east,regular\n"))
+	form.Close()
+
+	status, result := importAdminsRequest(t, app, models.RoleSuper, form.FormDataContentType(), body.Bytes())
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Len(t, result["data"], 1)
+}
+
+func TestImportAdmins_RejectsInvalidLinesWithoutCreatingAny(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	db.DB.Create(&models.Admin{Username: "existing", Password: "password123", Role: models.RoleRegular})
+
+	csv := strings.Join([]string{
+		"username,role,locations",
+		"desk-1,regular,",
+		"desk-2,guard,",
+		"desk-1,regular,",
+		"existing,regular,",
+		"desk-3,regular,4;5",
+		",regular,",
+	}, "\n")
+	status, result := importAdminsRequest(t, app, models.RoleSuper, "text/csv", []byte(csv))
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	problems := result["data"].([]interface{})
+	lines := make([]float64, len(problems))
+	for i, problem := range problems {
+		lines[i] = problem.(map[string]interface{})["line"].(float64)
+	}
+	assert.Equal(t, []float64{3, 4, 5, 6, 7}, lines)
+
+	var count int64
+	db.DB.Model(&models.Admin{}).Where("username LIKE ?", "desk-%").Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	status, result = importAdminsRequest(t, app, models.RoleSuper, "text/csv", []byte("name,role\nx,regular\n"))
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	assert.Contains(t, result["data"].([]interface{})[0].(map[string]interface{})["message"], "unknown column")
+}
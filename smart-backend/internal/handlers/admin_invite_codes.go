@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateInviteCodeRequest defines the structure for generating an invite code
+// @name CreateInviteCodeRequest
+type CreateInviteCodeRequest struct {
+	Note           string                      `json:"note" example:"Building 4 residents"`
+	Locations      []LocationAssignmentRequest `json:"locations"`                      // Optional - assigned to users registering with the code
+	MaxUses        int                         `json:"max_uses" example:"50"`          // Optional - registrations allowed (0 = unlimited)
+	ExpiresInHours int                         `json:"expires_in_hours" example:"168"` // Optional - code lifetime (0 = never expires)
+}
+
+// GetInviteCodes godoc
+// @Summary List invite codes
+// @Description Retrieve the invite codes generated for self-registration, newest first (requires admin authentication)
+// @Tags Admin Invite Codes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param active query bool false "Only list codes that can still be used"
+// @Success 200 {object} InviteCodesResponse "Invite codes retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/invite-codes [get]
+func GetInviteCodes(c *fiber.Ctx) error {
+	var invites []models.InviteCode
+	if err := db.DB.WithContext(c.UserContext()).Order("created_at DESC").Find(&invites).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve invite codes",
+		})
+	}
+
+	activeOnly := c.QueryBool("active", false)
+	data := make([]InviteCodeDTO, 0, len(invites))
+	for _, invite := range invites {
+		if activeOnly && !invite.IsActive() {
+			continue
+		}
+		data = append(data, toInviteCodeDTO(invite))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(InviteCodesResponse{
+		Success: true,
+		Message: "Invite codes retrieved successfully",
+		Data:    data,
+	})
+}
+
+// CreateInviteCode godoc
+// @Summary Generate an invite code
+// @Description Generate a code people use to register with POST /api/v1/auth/register. Users registering with it are assigned its locations and gates. The code can be limited to a number of registrations and a lifetime (requires admin authentication)
+// @Tags Admin Invite Codes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateInviteCodeRequest true "Invite code settings"
+// @Success 201 {object} InviteCodeResponse "Invite code generated successfully"
+// @Failure 400 {object} APIResponse "Invalid request body"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/invite-codes [post]
+func CreateInviteCode(c *fiber.Ctx) error {
+	var req CreateInviteCodeRequest
+	if err := c.BodyParser(&req); err != nil || req.MaxUses < 0 || req.ExpiresInHours < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	locations := make([]models.InviteLocation, len(req.Locations))
+	for i, location := range req.Locations {
+		if location.LocationID <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid location ID",
+			})
+		}
+		locations[i] = models.InviteLocation{LocationID: location.LocationID, GateIds: location.GateIds}
+	}
+
+	code, err := services.GenerateInviteCode()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to generate invite code",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	invite := models.InviteCode{
+		Code:      code,
+		Note:      req.Note,
+		Locations: locations,
+		MaxUses:   req.MaxUses,
+		CreatedBy: adminUsername,
+	}
+	if req.ExpiresInHours > 0 {
+		expiresAt := time.Now().Add(time.Duration(req.ExpiresInHours) * time.Hour)
+		invite.ExpiresAt = &expiresAt
+	}
+	if err := db.DB.WithContext(c.UserContext()).Create(&invite).Error; err != nil {
+		slog.ErrorContext(c.UserContext(), "[INVITE_CODES] Failed to create invite code", "error", err)
+		middleware.RecordAudit(c, "create_invite_code", "invite_code", "", "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to generate invite code",
+		})
+	}
+	middleware.RecordAudit(c, "create_invite_code", "invite_code", invite.ID.String(), "success", "")
+
+	return c.Status(fiber.StatusCreated).JSON(InviteCodeResponse{
+		Success: true,
+		Message: "Invite code generated successfully",
+		Data:    toInviteCodeDTO(invite),
+	})
+}
+
+// RevokeInviteCode godoc
+// @Summary Revoke an invite code
+// @Description Stop an invite code from being used for new registrations. Users who already registered with it are not affected (requires admin authentication)
+// @Tags Admin Invite Codes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Invite code ID (UUID)"
+// @Success 200 {object} InviteCodeResponse "Invite code revoked successfully"
+// @Failure 400 {object} APIResponse "Invalid invite code ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Invite code not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/invite-codes/{id} [delete]
+func RevokeInviteCode(c *fiber.Ctx) error {
+	inviteID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid invite code ID format",
+		})
+	}
+
+	var invite models.InviteCode
+	if err := db.DB.WithContext(c.UserContext()).First(&invite, "id = ?", inviteID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "Invite code not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to revoke invite code",
+		})
+	}
+
+	if invite.RevokedAt == nil {
+		if err := services.RevokeInviteCode(&invite); err != nil {
+			middleware.RecordAudit(c, "revoke_invite_code", "invite_code", invite.ID.String(), "failed", err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to revoke invite code",
+			})
+		}
+		middleware.RecordAudit(c, "revoke_invite_code", "invite_code", invite.ID.String(), "success", "")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(InviteCodeResponse{
+		Success: true,
+		Message: "Invite code revoked successfully",
+		Data:    toInviteCodeDTO(invite),
+	})
+}
+
+func toInviteCodeDTO(invite models.InviteCode) InviteCodeDTO {
+	locations := make([]LocationAssignmentRequest, len(invite.Locations))
+	for i, location := range invite.Locations {
+		locations[i] = LocationAssignmentRequest{LocationID: location.LocationID, GateIds: location.GateIds}
+	}
+	return InviteCodeDTO{
+		ID:        invite.ID,
+		Code:      invite.Code,
+		Note:      invite.Note,
+		Locations: locations,
+		MaxUses:   invite.MaxUses,
+		Uses:      invite.Uses,
+		Active:    invite.IsActive(),
+		ExpiresAt: invite.ExpiresAt,
+		RevokedAt: invite.RevokedAt,
+		CreatedBy: invite.CreatedBy,
+		CreatedAt: invite.CreatedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func registerStatus(t *testing.T, app *fiber.App, phone, code string) int {
+	payload, _ := json.Marshal(map[string]string{"phone": phone, "password": "password123", "invite_code": code})
+	req := httptest.NewRequest("POST", "/api/v1/auth/register", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.StatusCode
+}
+
+func TestInviteCodes_RegistrationAssignsLocations(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/invite-codes", map[string]interface{}{
+		"note":      "Building 4 residents",
+		"locations": []map[string]interface{}{{"locationId": 4, "gateIds": []int{40, 41}}},
+		"max_uses":  2,
+	})
+	assert.Equal(t, fiber.StatusCreated, status)
+	data := result["data"].(map[string]interface{})
+	code := data["code"].(string)
+	assert.Len(t, code, 10)
+	assert.Equal(t, true, data["active"])
+
+	// Registering with the code assigns its locations and gates
+	assert.Equal(t, fiber.StatusCreated, registerStatus(t, app, "+77771234567", code))
+	assert.Equal(t, []services.LocationAssignmentDTO{{LocationID: 4, GateIds: []int{40, 41}}}, provider.assignments["+77771234567"])
+	assert.Equal(t, fiber.StatusBadRequest, registerStatus(t, app, "+77771234568", ""))
+
+	// Revoked codes cannot be used, and are left out of the active list
+	status, _ = mergeRequest(t, app, models.RoleRegular, "DELETE", "/api/v1/admin/invite-codes/"+data["id"].(string), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, fiber.StatusBadRequest, registerStatus(t, app, "+77771234568", code))
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/invite-codes", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	codes := result["data"].([]interface{})
+	assert.Len(t, codes, 1)
+	assert.Equal(t, float64(1), codes[0].(map[string]interface{})["uses"])
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/invite-codes?active=true", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["data"])
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"ololo-gate/internal/scheduler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetScheduledJobs godoc
+// @Summary List scheduled jobs
+// @Description Retrieve all registered background jobs with their cron schedule, next run time and the outcome of their most recent run (super admin only)
+// @Tags Admin Jobs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} ScheduledJobsResponse "Scheduled jobs retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Router /api/v1/admin/jobs [get]
+func GetScheduledJobs(c *fiber.Ctx) error {
+	statuses := scheduler.Default().Statuses()
+
+	jobs := make([]ScheduledJobDTO, 0, len(statuses))
+	for _, status := range statuses {
+		dto := ScheduledJobDTO{
+			Name:     status.Name,
+			Schedule: status.Schedule,
+			NextRun:  status.NextRun,
+			Running:  status.Running,
+		}
+		if status.LastRun != nil {
+			dto.LastRun = &JobRunDTO{
+				ID:         status.LastRun.ID,
+				Instance:   status.LastRun.Instance,
+				Status:     status.LastRun.Status,
+				Error:      status.LastRun.Error,
+				StartedAt:  status.LastRun.StartedAt,
+				FinishedAt: status.LastRun.FinishedAt,
+			}
+		}
+		jobs = append(jobs, dto)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ScheduledJobsResponse{
+		Success: true,
+		Message: "Scheduled jobs retrieved successfully",
+		Data:    jobs,
+	})
+}
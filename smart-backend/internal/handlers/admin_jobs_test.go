@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetScheduledJobs_SuperAdmin(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/jobs", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var response ScheduledJobsResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	assert.True(t, response.Success)
+	assert.NotNil(t, response.Data)
+}
+
+func TestGetScheduledJobs_RegularAdminForbidden(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "regular", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/jobs", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+}
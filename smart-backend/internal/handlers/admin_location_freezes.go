@@ -0,0 +1,288 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// FreezeLocationRequest defines the structure for freezing a location
+// @name FreezeLocationRequest
+type FreezeLocationRequest struct {
+	Reason   string     `json:"reason" validate:"required" example:"Fire alarm, building in lockdown"` // Shown to users whose open is refused
+	StartsAt *time.Time `json:"starts_at" example:"2025-01-15T22:00:00Z"`                              // Optional - defaults to now
+	EndsAt   *time.Time `json:"ends_at" example:"2025-01-16T06:00:00Z"`                                // Optional - frozen until lifted when omitted
+}
+
+// EmergencyOpenRequest defines the structure for an admin emergency gate open
+// @name EmergencyOpenRequest
+type EmergencyOpenRequest struct {
+	Reason string `json:"reason" validate:"required" example:"Ambulance at the gate"`
+}
+
+// FreezeLocation godoc
+// @Summary Freeze a location
+// @Description Block users from opening the gates of a location, for a lockdown or maintenance, from starts_at (default now) until ends_at or until the freeze is lifted. Users can still close gates, and admins can still open them with PUT /admin/gates/:gateId/open (requires admin authentication)
+// @Tags Location Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Location ID"
+// @Param request body FreezeLocationRequest true "Reason and optional start and end time"
+// @Success 201 {object} LocationFreezeResponse "Location frozen"
+// @Failure 400 {object} APIResponse "Invalid location ID, reason or times"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Location not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} APIResponse "Third-party API unavailable"
+// @Router /api/v1/admin/locations/{id}/freeze [post]
+func FreezeLocation(c *fiber.Ctx) error {
+	locationID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || locationID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid location ID",
+		})
+	}
+
+	var req FreezeLocationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Reason is required",
+		})
+	}
+
+	locations, err := services.Locations().All(services.NewThirdPartyClient().WithContext(c.UserContext()))
+	if err != nil && locations == nil {
+		return respondUpstreamError(c, err, "Failed to find location")
+	}
+	found := false
+	for _, location := range locations {
+		found = found || location.ID == locationID
+	}
+	if !found {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Location not found",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	var startsAt time.Time
+	if req.StartsAt != nil {
+		startsAt = *req.StartsAt
+	}
+	freeze, err := services.FreezeLocation(locationID, req.Reason, startsAt, req.EndsAt, adminUsername)
+	if errors.Is(err, services.ErrFreezeEndsBeforeStart) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "ends_at must be after starts_at",
+		})
+	}
+	if err != nil {
+		middleware.RecordAudit(c, "freeze_location", "location", strconv.Itoa(locationID), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to freeze location",
+		})
+	}
+	middleware.RecordAudit(c, "freeze_location", "location", strconv.Itoa(locationID), "success", "")
+	slog.InfoContext(c.UserContext(), "[LOCATION_FREEZE] Location frozen",
+		"admin", adminUsername, "location_id", locationID, "starts_at", freeze.StartsAt.Format(time.RFC3339), "freeze_id", freeze.ID, "reason", freeze.Reason)
+
+	return c.Status(fiber.StatusCreated).JSON(LocationFreezeResponse{
+		Success: true,
+		Message: "Location frozen",
+		Data:    toLocationFreezeDTO(freeze),
+	})
+}
+
+// LiftLocationFreeze godoc
+// @Summary Lift a location freeze
+// @Description End the current and scheduled freezes of a location so users can open its gates again (requires admin authentication)
+// @Tags Location Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Location ID"
+// @Success 200 {object} LocationFreezesResponse "Freezes lifted"
+// @Failure 400 {object} APIResponse "Invalid location ID"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Location is not frozen"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/locations/{id}/freeze [delete]
+func LiftLocationFreeze(c *fiber.Ctx) error {
+	locationID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || locationID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid location ID",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	lifted, err := services.LiftLocationFreezes(locationID, adminUsername)
+	if err != nil {
+		middleware.RecordAudit(c, "lift_location_freeze", "location", strconv.Itoa(locationID), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to lift freeze",
+		})
+	}
+	if len(lifted) == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Location is not frozen",
+		})
+	}
+	middleware.RecordAudit(c, "lift_location_freeze", "location", strconv.Itoa(locationID), "success", "")
+	slog.InfoContext(c.UserContext(), "[LOCATION_FREEZE] Location freezes lifted",
+		"admin", adminUsername, "count", len(lifted), "location_id", locationID)
+
+	dtos := make([]LocationFreezeDTO, len(lifted))
+	for i, freeze := range lifted {
+		dtos[i] = toLocationFreezeDTO(freeze)
+	}
+	return c.Status(fiber.StatusOK).JSON(LocationFreezesResponse{
+		Success: true,
+		Message: "Freeze lifted",
+		Data:    dtos,
+	})
+}
+
+// GetLocationFreezes godoc
+// @Summary List location freezes
+// @Description Retrieve the freezes in effect now or scheduled to start, soonest first (requires admin authentication)
+// @Tags Location Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} LocationFreezesResponse "Freezes retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/locations/freezes [get]
+func GetLocationFreezes(c *fiber.Ctx) error {
+	freezes, err := services.CurrentLocationFreezes()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve freezes",
+		})
+	}
+
+	dtos := make([]LocationFreezeDTO, len(freezes))
+	for i, freeze := range freezes {
+		dtos[i] = toLocationFreezeDTO(freeze)
+	}
+	return c.Status(fiber.StatusOK).JSON(LocationFreezesResponse{
+		Success: true,
+		Message: "Freezes retrieved successfully",
+		Data:    dtos,
+	})
+}
+
+// EmergencyOpenGate godoc
+// @Summary Open a gate as an emergency override
+// @Description Open any gate, even at a frozen location, e.g. to let emergency services in. The reason is stored in the audit log and every admin is notified (requires admin authentication)
+// @Tags Location Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param gateId path int true "Gate ID"
+// @Param request body EmergencyOpenRequest true "Why the gate is opened"
+// @Success 200 {object} GateActionResponse "Gate operation response"
+// @Failure 400 {object} APIResponse "Invalid gate ID or missing reason"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 409 {object} APIResponse "A conflicting command is in progress for this gate"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} GateActionResponse "Gate provider unavailable - the command was queued (command_status queued) or failed"
+// @Router /api/v1/admin/gates/{gateId}/open [put]
+func EmergencyOpenGate(c *fiber.Ctx) error {
+	gateID, err := strconv.Atoi(c.Params("gateId"))
+	if err != nil || gateID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid gate ID",
+		})
+	}
+
+	var req EmergencyOpenRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Reason) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Reason is required",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	slog.WarnContext(c.UserContext(), "[EMERGENCY_OPEN] Emergency gate opening", "admin", adminUsername, "gate_id", gateID, "reason", req.Reason)
+	middleware.RecordAudit(c, "emergency_open_gate", "gate", strconv.Itoa(gateID), "success", strings.TrimSpace(req.Reason))
+	services.NotifyAdmins(models.SeverityWarning, models.NotificationSecurity, "Emergency gate open",
+		fmt.Sprintf("Admin %s opened gate %d with an emergency override: %s", adminUsername, gateID, strings.TrimSpace(req.Reason)))
+
+	return sendGateCommand(c, uuid.Nil, "", gateID, services.GateActionOpen)
+}
+
+// respondLocationFrozen refuses a user's open of a gate at a frozen location
+func respondLocationFrozen(c *fiber.Ctx, freeze *models.LocationFreeze) error {
+	message := "Gates at this location are frozen"
+	if freeze.EndsAt != nil {
+		message += " until " + freeze.EndsAt.UTC().Format(time.RFC3339)
+	}
+	return c.Status(fiber.StatusLocked).JSON(LocationFrozenResponse{
+		Success: false,
+		Message: message + ": " + freeze.Reason,
+		Data: LocationFrozenData{
+			LocationID: freeze.LocationID,
+			Reason:     freeze.Reason,
+			EndsAt:     freeze.EndsAt,
+		},
+	})
+}
+
+// toLocationFreezeDTO maps a LocationFreeze model to its response DTO
+func toLocationFreezeDTO(f models.LocationFreeze) LocationFreezeDTO {
+	return LocationFreezeDTO{
+		ID:         f.ID,
+		LocationID: f.LocationID,
+		Reason:     f.Reason,
+		StartsAt:   f.StartsAt,
+		EndsAt:     f.EndsAt,
+		Active:     f.ActiveAt(time.Now()),
+		CreatedBy:  f.CreatedBy,
+		LiftedAt:   f.LiftedAt,
+		LiftedBy:   f.LiftedBy,
+		CreatedAt:  f.CreatedAt,
+	}
+}
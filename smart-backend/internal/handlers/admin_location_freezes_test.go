@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// freezeProvider lists locations 4 (gate 40) and 5 (gate 50) to everyone and accepts every command
+func freezeProvider() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/locations":
+			json.NewEncoder(w).Encode([]services.LocationResponse{
+				{ID: 4, Title: "Building 4", Gates: []services.GateResponse{{ID: 40, Title: "Gate", LocationID: 4}}},
+				{ID: 5, Title: "Building 5", Gates: []services.GateResponse{{ID: 50, Title: "Gate", LocationID: 5}}},
+			})
+		case r.Method == http.MethodPut && (strings.HasSuffix(r.URL.Path, "/open") || strings.HasSuffix(r.URL.Path, "/close")):
+			w.Write([]byte("true"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestLocationFreeze_BlocksUserOpensUntilLifted(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	server := freezeProvider()
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+	services.Locations().Invalidate()
+	defer services.Locations().Invalidate()
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+	tokens, _ := utils.GenerateTokens(user.ID, user.Phone, user.TokenVersion)
+	gateCommand := func(gateID, action string) (int, map[string]interface{}) {
+		req := httptest.NewRequest("PUT", "/api/v1/locations/"+gateID+"/"+action, nil)
+		req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		return resp.StatusCode, result
+	}
+
+	endsAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	status, result := mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/locations/4/freeze", map[string]interface{}{
+		"reason": "Fire alarm", "ends_at": endsAt,
+	})
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Equal(t, true, result["data"].(map[string]interface{})["active"])
+
+	status, result = gateCommand("40", "open")
+	assert.Equal(t, fiber.StatusLocked, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, float64(4), data["location_id"])
+	assert.Equal(t, "Fire alarm", data["reason"])
+	assert.Contains(t, result["message"], endsAt.Format(time.RFC3339))
+
+	// Closing and other locations are not affected
+	status, _ = gateCommand("40", "close")
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = gateCommand("50", "open")
+	assert.Equal(t, fiber.StatusOK, status)
+
+	// Admins can still open the gate, with a reason
+	status, _ = mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/gates/40/open", map[string]string{})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/gates/40/open", map[string]string{"reason": "Ambulance at the gate"})
+	assert.Equal(t, fiber.StatusOK, status)
+	var notification models.AdminNotification
+	assert.NoError(t, db.DB.First(&notification, "title = ?", "Emergency gate open").Error)
+	assert.Contains(t, notification.Message, "Ambulance at the gate")
+
+	// A scheduled freeze does not block opens before it starts
+	status, result = mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/locations/5/freeze", map[string]interface{}{
+		"reason": "Maintenance", "starts_at": time.Now().Add(24 * time.Hour),
+	})
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Equal(t, false, result["data"].(map[string]interface{})["active"])
+	status, _ = gateCommand("50", "open")
+	assert.Equal(t, fiber.StatusOK, status)
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/locations/freezes", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 2)
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/locations/4/freeze", map[string]interface{}{
+		"reason": "Backwards", "starts_at": endsAt, "ends_at": endsAt.Add(-time.Minute),
+	})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/locations/99/freeze", map[string]interface{}{"reason": "Unknown"})
+	assert.Equal(t, fiber.StatusNotFound, status)
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "DELETE", "/api/v1/admin/locations/4/freeze", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = gateCommand("40", "open")
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "DELETE", "/api/v1/admin/locations/4/freeze", nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/url"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LocationOverrideRequest defines the structure for overriding a location's branding
+// @name LocationOverrideRequest
+type LocationOverrideRequest struct {
+	DisplayName string `json:"display_name" example:"Ala-Too Mall"`                     // Replaces the provider's title (empty = keep it)
+	Logo        string `json:"logo" example:"https://cdn.example.com/logos/alatoo.png"` // http(s) URL replacing the provider's logo (empty = keep it)
+	SortOrder   *int   `json:"sort_order" example:"1"`                                  // Lower is listed first (null = provider order, after sorted locations)
+}
+
+// SetLocationOverride godoc
+// @Summary Override a location's branding
+// @Description Replace the display name, logo and list position of a location from the third-party API in GET /locations and GET /available-locations. The override replaces any previous one; empty fields keep the provider's value (requires admin authentication)
+// @Tags Location Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Location ID"
+// @Param request body LocationOverrideRequest true "Display name, logo URL and sort order"
+// @Success 200 {object} LocationOverrideResponse "Override saved"
+// @Failure 400 {object} APIResponse "Invalid location ID, logo URL or empty override"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Location not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} APIResponse "Third-party API unavailable"
+// @Router /api/v1/admin/locations/{id}/override [put]
+func SetLocationOverride(c *fiber.Ctx) error {
+	locationID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || locationID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid location ID",
+		})
+	}
+
+	var req LocationOverrideRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+	req.DisplayName = strings.TrimSpace(req.DisplayName)
+	req.Logo = strings.TrimSpace(req.Logo)
+	if req.DisplayName == "" && req.Logo == "" && req.SortOrder == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Set display_name, logo or sort_order, or delete the override",
+		})
+	}
+	if req.Logo != "" {
+		if u, err := url.Parse(req.Logo); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Logo must be an http or https URL",
+			})
+		}
+	}
+
+	locations, err := services.Locations().All(services.NewThirdPartyClient().WithContext(c.UserContext()))
+	if err != nil && locations == nil {
+		return respondUpstreamError(c, err, "Failed to find location")
+	}
+	found := false
+	for _, location := range locations {
+		found = found || location.ID == locationID
+	}
+	if !found {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Location not found",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	override, err := services.SetLocationOverride(models.LocationOverride{
+		LocationID:  locationID,
+		DisplayName: req.DisplayName,
+		Logo:        req.Logo,
+		SortOrder:   req.SortOrder,
+		UpdatedBy:   adminUsername,
+	})
+	if err != nil {
+		middleware.RecordAudit(c, "set_location_override", "location", strconv.Itoa(locationID), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to save override",
+		})
+	}
+	middleware.RecordAudit(c, "set_location_override", "location", strconv.Itoa(locationID), "success", "")
+	slog.InfoContext(c.UserContext(), "[LOCATION_OVERRIDE] Location overridden", "admin", adminUsername, "location_id", locationID)
+
+	return c.Status(fiber.StatusOK).JSON(LocationOverrideResponse{
+		Success: true,
+		Message: "Override saved",
+		Data:    toLocationOverrideDTO(override),
+	})
+}
+
+// DeleteLocationOverride godoc
+// @Summary Remove a location's branding override
+// @Description Show the location with the provider's title, logo and position again (requires admin authentication)
+// @Tags Location Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Location ID"
+// @Success 200 {object} APIResponse "Override removed"
+// @Failure 400 {object} APIResponse "Invalid location ID"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Location has no override"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/locations/{id}/override [delete]
+func DeleteLocationOverride(c *fiber.Ctx) error {
+	locationID, err := strconv.Atoi(c.Params("id"))
+	if err != nil || locationID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid location ID",
+		})
+	}
+
+	deleted, err := services.DeleteLocationOverride(locationID)
+	if err != nil {
+		middleware.RecordAudit(c, "delete_location_override", "location", strconv.Itoa(locationID), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to remove override",
+		})
+	}
+	if !deleted {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Location has no override",
+		})
+	}
+	middleware.RecordAudit(c, "delete_location_override", "location", strconv.Itoa(locationID), "success", "")
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "Override removed",
+	})
+}
+
+// GetLocationOverrides godoc
+// @Summary List location branding overrides
+// @Description Retrieve every location override in list order (requires admin authentication)
+// @Tags Location Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} LocationOverridesResponse "Overrides retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/locations/overrides [get]
+func GetLocationOverrides(c *fiber.Ctx) error {
+	overrides, err := services.LocationOverrides()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve overrides",
+		})
+	}
+
+	dtos := make([]LocationOverrideDTO, len(overrides))
+	for i, override := range overrides {
+		dtos[i] = toLocationOverrideDTO(override)
+	}
+	return c.Status(fiber.StatusOK).JSON(LocationOverridesResponse{
+		Success: true,
+		Message: "Overrides retrieved successfully",
+		Data:    dtos,
+	})
+}
+
+// toLocationOverrideDTO maps a LocationOverride model to its response DTO
+func toLocationOverrideDTO(o models.LocationOverride) LocationOverrideDTO {
+	return LocationOverrideDTO{
+		LocationID:  o.LocationID,
+		DisplayName: o.DisplayName,
+		Logo:        o.Logo,
+		SortOrder:   o.SortOrder,
+		UpdatedBy:   o.UpdatedBy,
+		UpdatedAt:   o.UpdatedAt,
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationOverrides_MergedIntoLocationLists(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	server := freezeProvider()
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+	services.Locations().Invalidate()
+	defer services.Locations().Invalidate()
+
+	status, _ := mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/locations/5/override", map[string]interface{}{})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/locations/5/override", map[string]interface{}{"logo": "javascript:alert(1)"})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/locations/9/override", map[string]interface{}{"display_name": "Nowhere"})
+	assert.Equal(t, fiber.StatusNotFound, status)
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/locations/5/override",
+		map[string]interface{}{"display_name": "Riverside Tower", "sort_order": 1})
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Riverside Tower", result["data"].(map[string]interface{})["display_name"])
+	status, _ = mergeRequest(t, app, models.RoleRegular, "PUT", "/api/v1/admin/locations/4/override",
+		map[string]interface{}{"logo": "https://cdn.example.com/4.png"})
+	assert.Equal(t, fiber.StatusOK, status)
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/available-locations", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	locations := result["data"].([]interface{})
+	assert.Len(t, locations, 2)
+	first, second := locations[0].(map[string]interface{}), locations[1].(map[string]interface{})
+	assert.Equal(t, "Riverside Tower", first["title"])
+	assert.Equal(t, "Building 4", second["title"])
+	assert.Equal(t, "https://cdn.example.com/4.png", second["logo"])
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/locations/overrides", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 2)
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "DELETE", "/api/v1/admin/locations/5/override", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "DELETE", "/api/v1/admin/locations/5/override", nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/available-locations", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Building 4", result["data"].([]interface{})[0].(map[string]interface{})["title"])
+}
@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	"log"
+	"log/slog"
 	"ololo-gate/internal/services"
 
 	"github.com/gofiber/fiber/v2"
@@ -9,7 +9,7 @@ import (
 
 // GetAvailableLocations godoc
 // @Summary Get all available locations in the system
-// @Description Fetch all locations from third-party API without filtering by user (admin access only)
+// @Description Fetch all locations from third-party API without filtering by user, with admin overrides of display name, logo and order applied (admin access only)
 // @Tags Location Management
 // @Accept json
 // @Produce json
@@ -18,6 +18,8 @@ import (
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
 // @Failure 403 {object} APIResponse "Forbidden - requires admin access"
 // @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} APIResponse "Third-party API unavailable"
 // @Router /api/v1/available-locations [get]
 func GetAvailableLocations(c *fiber.Ctx) error {
 	// JWT middleware ensures admin is authenticated
@@ -26,19 +28,18 @@ func GetAvailableLocations(c *fiber.Ctx) error {
 		adminUsername = "unknown"
 	}
 
-	log.Printf("Admin %s fetching all available locations", adminUsername)
+	slog.DebugContext(c.UserContext(), "Fetching all available locations", "admin", adminUsername)
 
-	client := services.NewThirdPartyClient()
+	client := services.NewThirdPartyClient().WithContext(c.UserContext())
 	locations, err := client.GetAllLocations()
 	if err != nil {
-		log.Printf("Error fetching locations from third-party API: %v", err)
-		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
-			Success: false,
-			Message: "Failed to fetch locations from third-party API",
-		})
+		slog.ErrorContext(c.UserContext(), "Error fetching locations from third-party API", "error", err)
+		return respondUpstreamError(c, err, "Failed to fetch locations from third-party API")
 	}
 
-	log.Printf("Fetched %d locations from third-party API", len(locations))
+	slog.DebugContext(c.UserContext(), "Fetched locations from third-party API", "count", len(locations))
+	locations = services.ApplyLocationOverrides(locations)
+	maintenance := gateMaintenanceOf(locations)
 
 	// Convert to DTOs (include gates)
 	var dtos []LocationDTO
@@ -47,14 +48,7 @@ func GetAvailableLocations(c *fiber.Ctx) error {
 		// Initialize gates as empty array to avoid null serialization
 		gateDTOs := make([]GateDTO, 0)
 		for _, gate := range loc.Gates {
-			gateDTOs = append(gateDTOs, GateDTO{
-				ID:               gate.ID,
-				Title:            gate.Title,
-				Description:      gate.Description,
-				LocationID:       gate.LocationID,
-				IsOpen:           gate.IsOpen,
-				GateIsHorizontal: gate.GateIsHorizontal,
-			})
+			gateDTOs = append(gateDTOs, toGateDTO(gate, maintenance))
 		}
 
 		dtos = append(dtos, LocationDTO{
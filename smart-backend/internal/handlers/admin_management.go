@@ -1,8 +1,12 @@
 package handlers
 
 import (
+	"log/slog"
+	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -14,7 +18,7 @@ import (
 type CreateAdminRequest struct {
 	Username string `json:"username" validate:"required" example:"newadmin"`
 	Password string `json:"password" validate:"required,min=6" example:"password123"`
-	Role     string `json:"role" validate:"required" example:"regular"` // "super" or "regular"
+	Role     string `json:"role" validate:"required" example:"regular"` // "super", "regular" or "auditor" (read-only)
 }
 
 // UpdateAdminRequest defines the structure for updating admin details (password, username, role)
@@ -23,6 +27,7 @@ type UpdateAdminRequest struct {
 	Password *string `json:"password,omitempty" validate:"omitempty,min=6" example:"newpassword123"`
 	Username *string `json:"username,omitempty" validate:"omitempty" example:"newusername"`
 	Role     *string `json:"role,omitempty" validate:"omitempty" example:"regular"`
+	Timezone *string `json:"timezone,omitempty" validate:"omitempty" example:"Asia/Bishkek"` // IANA zone of timestamps and report days in the admin panel; "" for DEFAULT_TIMEZONE
 }
 
 // GetAllAdmins godoc
@@ -35,9 +40,11 @@ type UpdateAdminRequest struct {
 // @Param page query int false "Page number (default: 1)"
 // @Param limit query int false "Records per page (default: 500)"
 // @Param search query string false "Search by username"
-// @Param role query string false "Filter by role (super or regular)"
+// @Param role query string false "Filter by role (super, regular or auditor)"
 // @Param order query string false "Order results by created_at (ASC or DESC, default: DESC)"
+// @Param stream query string false "With limit=-1: json streams the response row by row, ndjson streams one object per line"
 // @Success 200 {object} AdminsListResponse "Admin users retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid role or stream mode"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
 // @Failure 403 {object} APIResponse "Forbidden - super admin access required"
 // @Failure 500 {object} APIResponse "Internal server error"
@@ -68,8 +75,16 @@ func GetAllAdmins(c *fiber.Ctx) error {
 		order = "DESC"
 	}
 
+	streamFormat, err := parseStreamFormat(c, limit)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid stream. Use json or ndjson, with limit=-1",
+		})
+	}
+
 	// Build query
-	query := db.DB.Select("id", "username", "role", "created_at", "updated_at")
+	query := db.DB.WithContext(c.UserContext()).Select("id", "username", "role", "created_at", "updated_at")
 
 	// Apply search filter
 	if search != "" {
@@ -78,10 +93,10 @@ func GetAllAdmins(c *fiber.Ctx) error {
 
 	// Apply role filter
 	if roleFilter != "" {
-		if roleFilter != models.RoleSuper && roleFilter != models.RoleRegular {
+		if !models.ValidAdminRole(roleFilter) {
 			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
 				Success: false,
-				Message: "Invalid role. Must be 'super' or 'regular'",
+				Message: "Invalid role. Must be 'super', 'regular' or 'auditor'",
 			})
 		}
 		query = query.Where("role = ?", roleFilter)
@@ -90,6 +105,11 @@ func GetAllAdmins(c *fiber.Ctx) error {
 	// Apply order
 	query = query.Order("created_at " + order)
 
+	// Unbounded listings can be streamed instead of being held in memory
+	if streamFormat != "" {
+		return streamListing(c, query.Model(&models.Admin{}), streamFormat, "Admins retrieved successfully", toAdminDTO)
+	}
+
 	// Get total count before pagination
 	var total int64
 	if err := query.Model(&models.Admin{}).Count(&total).Error; err != nil {
@@ -117,13 +137,7 @@ func GetAllAdmins(c *fiber.Ctx) error {
 	// Map admins to AdminDTO
 	adminDTOs := make([]AdminDTO, len(admins))
 	for i, admin := range admins {
-		adminDTOs[i] = AdminDTO{
-			ID:        admin.ID,
-			Username:  admin.Username,
-			Role:      admin.Role,
-			CreatedAt: admin.CreatedAt,
-			UpdatedAt: admin.UpdatedAt,
-		}
+		adminDTOs[i] = toAdminDTO(admin)
 	}
 
 	// Calculate pagination metadata
@@ -152,6 +166,17 @@ func GetAllAdmins(c *fiber.Ctx) error {
 	})
 }
 
+// toAdminDTO maps an Admin model to its list DTO
+func toAdminDTO(admin models.Admin) AdminDTO {
+	return AdminDTO{
+		ID:        admin.ID,
+		Username:  admin.Username,
+		Role:      admin.Role,
+		CreatedAt: admin.CreatedAt,
+		UpdatedAt: admin.UpdatedAt,
+	}
+}
+
 // CreateAdmin godoc
 // @Summary Create a new admin user
 // @Description Create a new admin account with specified role (super admin only)
@@ -179,10 +204,10 @@ func CreateAdmin(c *fiber.Ctx) error {
 	}
 
 	// Validate role
-	if req.Role != models.RoleSuper && req.Role != models.RoleRegular {
+	if !models.ValidAdminRole(req.Role) {
 		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
 			Success: false,
-			Message: "Invalid role. Must be 'super' or 'regular'",
+			Message: "Invalid role. Must be 'super', 'regular' or 'auditor'",
 		})
 	}
 
@@ -196,7 +221,7 @@ func CreateAdmin(c *fiber.Ctx) error {
 
 	// Check if admin with this username already exists
 	var existingAdmin models.Admin
-	if err := db.DB.Where("username = ?", req.Username).First(&existingAdmin).Error; err == nil {
+	if err := db.DB.WithContext(c.UserContext()).Where("username = ?", req.Username).First(&existingAdmin).Error; err == nil {
 		return c.Status(fiber.StatusConflict).JSON(APIResponse{
 			Success: false,
 			Message: "Admin with this username already exists",
@@ -210,12 +235,15 @@ func CreateAdmin(c *fiber.Ctx) error {
 		Role:     req.Role,
 	}
 
-	if err := db.DB.Create(&admin).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).Create(&admin).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to create admin",
 		})
 	}
+	actorID, actor := historyActor(c)
+	services.RecordAdminHistory(admin.ID, models.AdminHistoryCreated, actorID, actor,
+		services.FieldChanges{}.DiffAdmin(models.Admin{}, models.Admin{Username: admin.Username, Role: admin.Role}))
 
 	return c.Status(fiber.StatusCreated).JSON(APIResponse{
 		Success: true,
@@ -253,21 +281,11 @@ func GetAdminByID(c *fiber.Ctx) error {
 		})
 	}
 
-	// Get current admin role and ID from context
-	requestingAdminRole := c.Locals("admin_role").(string)
-	requestingAdminID := c.Locals("id").(uuid.UUID)
-
-	// Check access: regular admins can only access their own record
-	if requestingAdminRole != models.RoleSuper && requestingAdminID != adminID {
-		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
-			Success: false,
-			Message: "Regular admins can only access their own record",
-		})
-	}
+	// Regular admins can only reach their own record (enforced by AccessPolicy)
 
 	// Find admin
 	var admin models.Admin
-	if err := db.DB.First(&admin, adminID).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).First(&admin, adminID).Error; err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
 			Success: false,
 			Message: "Admin not found",
@@ -281,6 +299,7 @@ func GetAdminByID(c *fiber.Ctx) error {
 			AdminID:   admin.ID,
 			Username:  admin.Username,
 			Role:      admin.Role,
+			Timezone:  admin.Timezone,
 			CreatedAt: admin.CreatedAt,
 			UpdatedAt: admin.UpdatedAt,
 		},
@@ -289,7 +308,7 @@ func GetAdminByID(c *fiber.Ctx) error {
 
 // UpdateAdmin godoc
 // @Summary Update admin details
-// @Description Update an admin's details (password, username, and/or role). Super admins can update any admin. Regular admins can only update their own password and username (not role).
+// @Description Update an admin's details (password, username, role and/or timezone). Super admins can update any admin. Regular admins can only update their own password, username and timezone (not role). The timezone applies to timestamps and report days of the admin's requests without X-Timezone.
 // @Tags Admin User Management
 // @Accept json
 // @Produce json
@@ -313,18 +332,8 @@ func UpdateAdmin(c *fiber.Ctx) error {
 		})
 	}
 
-	// Get current admin role and ID from context
+	// Regular admins can only reach their own record (enforced by AccessPolicy)
 	requestingAdminRole := c.Locals("admin_role").(string)
-	requestingAdminID := c.Locals("id").(uuid.UUID)
-
-	// Check if trying to update different admin as regular admin
-	isUpdatingDifferentAdmin := requestingAdminID != adminID
-	if isUpdatingDifferentAdmin && requestingAdminRole != models.RoleSuper {
-		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
-			Success: false,
-			Message: "Regular admins can only update their own record",
-		})
-	}
 
 	var req UpdateAdminRequest
 
@@ -337,15 +346,16 @@ func UpdateAdmin(c *fiber.Ctx) error {
 	}
 
 	// Validate at least one field is provided
-	if req.Password == nil && req.Username == nil && req.Role == nil {
+	if req.Password == nil && req.Username == nil && req.Role == nil && req.Timezone == nil {
 		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
 			Success: false,
-			Message: "At least one field (password, username, or role) must be provided",
+			Message: "At least one field (password, username, role or timezone) must be provided",
 		})
 	}
 
 	// Regular admin trying to update role
 	if req.Role != nil && requestingAdminRole != models.RoleSuper {
+		middleware.SetDenialReason(c, models.DenialSuperAdminRequired)
 		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
 			Success: false,
 			Message: "Only super admins can change admin roles",
@@ -354,12 +364,13 @@ func UpdateAdmin(c *fiber.Ctx) error {
 
 	// Find admin
 	var admin models.Admin
-	if err := db.DB.First(&admin, adminID).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).First(&admin, adminID).Error; err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
 			Success: false,
 			Message: "Admin not found",
 		})
 	}
+	before := admin
 
 	// Update password if provided
 	if req.Password != nil {
@@ -387,22 +398,46 @@ func UpdateAdmin(c *fiber.Ctx) error {
 
 	// Update role if provided (only super admin can do this)
 	if req.Role != nil {
-		if *req.Role != models.RoleSuper && *req.Role != models.RoleRegular {
+		if !models.ValidAdminRole(*req.Role) {
 			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
 				Success: false,
-				Message: "Invalid role. Must be 'super' or 'regular'",
+				Message: "Invalid role. Must be 'super', 'regular' or 'auditor'",
 			})
 		}
+		// Tokens carry the role, so tokens issued with the old role are invalidated
+		if admin.Role != *req.Role {
+			admin.TokenVersion++
+		}
 		admin.Role = *req.Role
 	}
 
+	// Update timezone if provided; empty falls back to DEFAULT_TIMEZONE
+	if req.Timezone != nil {
+		if *req.Timezone != "" {
+			if _, err := services.LoadTimezone(*req.Timezone); err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+					Success: false,
+					Message: "Invalid timezone. Use an IANA zone name such as Asia/Bishkek",
+				})
+			}
+		}
+		admin.Timezone = *req.Timezone
+	}
+
 	// Save changes
-	if err := db.DB.Save(&admin).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).Save(&admin).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to update admin",
 		})
 	}
+	if admin.TokenVersion != before.TokenVersion {
+		services.TokenVersions().InvalidateAdmin(admin.ID)
+	}
+	if changes := (services.FieldChanges{}).DiffAdmin(before, admin); len(changes) > 0 {
+		actorID, actor := historyActor(c)
+		services.RecordAdminHistory(admin.ID, models.AdminHistoryUpdated, actorID, actor, changes)
+	}
 
 	return c.Status(fiber.StatusOK).JSON(APIResponse{
 		Success: true,
@@ -411,6 +446,7 @@ func UpdateAdmin(c *fiber.Ctx) error {
 			"id":       admin.ID,
 			"username": admin.Username,
 			"role":     admin.Role,
+			"timezone": admin.Timezone,
 		},
 	})
 }
@@ -440,8 +476,8 @@ func DeleteAdmin(c *fiber.Ctx) error {
 		})
 	}
 
-	// Prevent deletion of initial super admin
-	initialAdminUUID, err := uuid.Parse(db.DB.Config.Name())
+	// Prevent deletion of initial super admin (INIT_ADMIN_UUID)
+	initialAdminUUID, err := uuid.Parse(config.AppConfig().InitAdmin.UUID)
 	if err == nil && adminID == initialAdminUUID {
 		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
 			Success: false,
@@ -451,7 +487,7 @@ func DeleteAdmin(c *fiber.Ctx) error {
 
 	// Find admin
 	var admin models.Admin
-	if err := db.DB.First(&admin, adminID).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).First(&admin, adminID).Error; err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
 			Success: false,
 			Message: "Admin not found",
@@ -459,12 +495,18 @@ func DeleteAdmin(c *fiber.Ctx) error {
 	}
 
 	// Delete admin (soft delete)
-	if err := db.DB.Delete(&admin).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).Delete(&admin).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to delete admin",
 		})
 	}
+	services.TokenVersions().InvalidateAdmin(admin.ID)
+	if err := db.DB.WithContext(c.UserContext()).Where("admin_id = ?", admin.ID).Delete(&models.AdminCredential{}).Error; err != nil {
+		slog.ErrorContext(c.UserContext(), "[PASSKEY] Failed to remove passkeys of deleted admin", "admin_id", admin.ID, "error", err)
+	}
+	actorID, actor := historyActor(c)
+	services.RecordAdminHistory(admin.ID, models.AdminHistoryDeleted, actorID, actor, nil)
 
 	return c.Status(fiber.StatusOK).JSON(APIResponse{
 		Success: true,
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http/httptest"
+	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
 	"ololo-gate/internal/models"
 	"ololo-gate/internal/utils"
@@ -174,7 +175,7 @@ func TestCreateAdmin_InvalidRole(t *testing.T) {
 	json.NewDecoder(resp.Body).Decode(&response)
 
 	assert.False(t, response.Success)
-	assert.Equal(t, "Invalid role. Must be 'super' or 'regular'", response.Message)
+	assert.Equal(t, "Invalid role. Must be 'super', 'regular' or 'auditor'", response.Message)
 }
 
 func TestCreateAdmin_ShortPassword(t *testing.T) {
@@ -313,6 +314,57 @@ func TestUpdateAdminPassword_Success(t *testing.T) {
 	assert.True(t, updatedAdmin.CheckPassword("newpassword123"))
 }
 
+func TestUpdateAdminRole_InvalidatesTokens(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	// Create super admin
+	superAdmin := models.Admin{
+		ID:       uuid.New(),
+		Username: "superadmin",
+		Password: "password123",
+		Role:     models.RoleSuper,
+	}
+	db.DB.Create(&superAdmin)
+
+	// Create admin to demote
+	targetAdmin := models.Admin{
+		ID:       uuid.New(),
+		Username: "targetadmin",
+		Password: "password123",
+		Role:     models.RoleRegular,
+	}
+	db.DB.Create(&targetAdmin)
+
+	token, _ := utils.GenerateAdminToken(superAdmin.ID, superAdmin.Username, superAdmin.Role, 0)
+	targetToken, _ := utils.GenerateAdminToken(targetAdmin.ID, targetAdmin.Username, targetAdmin.Role, 0)
+	getOwnRecord := func() int {
+		req := httptest.NewRequest("GET", fmt.Sprintf("/api/v1/admin/users/%s", targetAdmin.ID.String()), nil)
+		req.Header.Set("Authorization", "Bearer "+targetToken)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+	assert.Equal(t, fiber.StatusOK, getOwnRecord())
+
+	role := models.RoleAuditor
+	reqBody, _ := json.Marshal(UpdateAdminRequest{Role: &role})
+	req := httptest.NewRequest("PATCH", fmt.Sprintf("/api/v1/admin/users/%s", targetAdmin.ID.String()), bytes.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	// The token issued with the old role no longer works
+	var updatedAdmin models.Admin
+	db.DB.First(&updatedAdmin, targetAdmin.ID)
+	assert.Equal(t, models.RoleAuditor, updatedAdmin.Role)
+	assert.Equal(t, 1, updatedAdmin.TokenVersion)
+	assert.Equal(t, fiber.StatusUnauthorized, getOwnRecord())
+}
+
 func TestUpdateAdminPassword_NotFound(t *testing.T) {
 	app, cleanup := SetupTestApp()
 	defer cleanup()
@@ -394,6 +446,47 @@ func TestDeleteAdmin_Success(t *testing.T) {
 	assert.NotNil(t, deletedAdmin.DeletedAt)
 }
 
+func TestDeleteAdmin_InitialAdminRefused(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	// Create the initial super admin, as seeded from INIT_ADMIN_UUID
+	initialAdmin := models.Admin{
+		ID:       uuid.MustParse(config.AppConfig().InitAdmin.UUID),
+		Username: "admin",
+		Password: "password123",
+		Role:     models.RoleSuper,
+	}
+	db.DB.Create(&initialAdmin)
+
+	superAdmin := models.Admin{
+		ID:       uuid.New(),
+		Username: "superadmin",
+		Password: "password123",
+		Role:     models.RoleSuper,
+	}
+	db.DB.Create(&superAdmin)
+
+	token, _ := utils.GenerateAdminToken(superAdmin.ID, superAdmin.Username, superAdmin.Role, 0)
+
+	req := httptest.NewRequest("DELETE", fmt.Sprintf("/api/v1/admin/users/%s", initialAdmin.ID.String()), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+
+	var response APIResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.False(t, response.Success)
+	assert.Equal(t, "Cannot delete the initial super admin", response.Message)
+
+	// Verify the initial admin was kept
+	var kept models.Admin
+	assert.NoError(t, db.DB.First(&kept, initialAdmin.ID).Error)
+}
+
 func TestDeleteAdmin_NotFound(t *testing.T) {
 	app, cleanup := SetupTestApp()
 	defer cleanup()
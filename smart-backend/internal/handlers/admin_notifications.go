@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// GetAdminNotifications godoc
+// @Summary Get admin notifications
+// @Description Retrieve notification center entries (security alerts, provider outages, failed jobs), newest first, with the total unread count
+// @Tags Admin Notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param severity query string false "Filter by severity (info, warning, critical)"
+// @Param category query string false "Filter by category (security, provider, jobs)"
+// @Param unread query bool false "Only return unread notifications"
+// @Param count query string false "exact (default) or estimate: approximate total from table statistics, for large tables"
+// @Success 200 {object} AdminNotificationsResponse "Notifications retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid severity filter or count mode"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/notifications [get]
+func GetAdminNotifications(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	countMode, err := services.ParseCountMode(c.Query("count"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid count. Use exact or estimate",
+		})
+	}
+
+	query := db.DB.WithContext(c.UserContext()).Model(&models.AdminNotification{})
+
+	if severity := c.Query("severity"); severity != "" {
+		if severity != models.SeverityInfo && severity != models.SeverityWarning && severity != models.SeverityCritical {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid severity. Must be 'info', 'warning' or 'critical'",
+			})
+		}
+		query = query.Where("severity = ?", severity)
+	}
+	if category := c.Query("category"); category != "" {
+		query = query.Where("category = ?", category)
+	}
+	if c.QueryBool("unread", false) {
+		query = query.Where("read_at IS NULL")
+	}
+
+	total, estimated, err := services.CountRows(query, countMode)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve notifications",
+		})
+	}
+
+	var notifications []models.AdminNotification
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&notifications).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve notifications",
+		})
+	}
+
+	var unreadCount int64
+	db.DB.WithContext(c.UserContext()).Model(&models.AdminNotification{}).Where("read_at IS NULL").Count(&unreadCount)
+
+	dtos := make([]AdminNotificationDTO, len(notifications))
+	for i, notification := range notifications {
+		dtos[i] = toAdminNotificationDTO(notification)
+	}
+
+	lastPage := int((total + int64(limit) - 1) / int64(limit))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return c.Status(fiber.StatusOK).JSON(AdminNotificationsResponse{
+		Success:     true,
+		Message:     "Notifications retrieved successfully",
+		Data:        dtos,
+		UnreadCount: unreadCount,
+		Pagination: PaginationMeta{
+			Total:       int(total),
+			PerPage:     limit,
+			CurrentPage: page,
+			LastPage:    lastPage,
+			Estimated:   estimated,
+		},
+	})
+}
+
+// MarkAdminNotificationRead godoc
+// @Summary Mark notification as read
+// @Description Mark a single notification as read by the current admin
+// @Tags Admin Notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID (UUID)"
+// @Success 200 {object} AdminNotificationResponse "Notification marked as read"
+// @Failure 400 {object} APIResponse "Invalid notification ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Notification not found"
+// @Router /api/v1/admin/notifications/{id}/read [patch]
+func MarkAdminNotificationRead(c *fiber.Ctx) error {
+	notificationID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid notification ID format",
+		})
+	}
+
+	var notification models.AdminNotification
+	if err := db.DB.WithContext(c.UserContext()).First(&notification, "id = ?", notificationID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Notification not found",
+		})
+	}
+
+	// Keep the original reader if it was already read
+	if notification.ReadAt == nil {
+		adminID, _ := c.Locals("id").(uuid.UUID)
+		now := time.Now()
+		notification.ReadAt = &now
+		notification.ReadBy = &adminID
+		if err := db.DB.WithContext(c.UserContext()).Model(&notification).Updates(map[string]interface{}{"read_at": now, "read_by": adminID}).Error; err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to update notification",
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(AdminNotificationResponse{
+		Success: true,
+		Message: "Notification marked as read",
+		Data:    toAdminNotificationDTO(notification),
+	})
+}
+
+// MarkAllAdminNotificationsRead godoc
+// @Summary Mark all notifications as read
+// @Description Mark every unread notification as read by the current admin
+// @Tags Admin Notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} APIResponse "All notifications marked as read"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/notifications/read-all [patch]
+func MarkAllAdminNotificationsRead(c *fiber.Ctx) error {
+	adminID, _ := c.Locals("id").(uuid.UUID)
+
+	result := db.DB.WithContext(c.UserContext()).Model(&models.AdminNotification{}).
+		Where("read_at IS NULL").
+		Updates(map[string]interface{}{"read_at": time.Now(), "read_by": adminID})
+	if result.Error != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to update notifications",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "All notifications marked as read",
+		Data: fiber.Map{
+			"updated": result.RowsAffected,
+		},
+	})
+}
+
+// toAdminNotificationDTO maps an AdminNotification model to its response DTO
+func toAdminNotificationDTO(n models.AdminNotification) AdminNotificationDTO {
+	return AdminNotificationDTO{
+		ID:        n.ID,
+		Severity:  n.Severity,
+		Category:  n.Category,
+		Title:     n.Title,
+		Message:   n.Message,
+		Read:      n.ReadAt != nil,
+		ReadAt:    n.ReadAt,
+		ReadBy:    n.ReadBy,
+		CreatedAt: n.CreatedAt,
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNotificationTestAdmin(t *testing.T) (models.Admin, string) {
+	admin := models.Admin{ID: uuid.New(), Username: "admin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	token, err := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	assert.NoError(t, err)
+	return admin, token
+}
+
+func TestGetAdminNotifications_FiltersAndUnreadCount(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	_, token := createNotificationTestAdmin(t)
+
+	services.NotifyAdmins(models.SeverityCritical, models.NotificationProvider, "Gate provider unavailable", "open_gate failed")
+	services.NotifyAdmins(models.SeverityWarning, models.NotificationJobs, "Scheduled job failed", "timeout")
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/notifications?severity=critical", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var response AdminNotificationsResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	assert.Len(t, response.Data, 1)
+	assert.Equal(t, "Gate provider unavailable", response.Data[0].Title)
+	assert.False(t, response.Data[0].Read)
+	assert.Equal(t, int64(2), response.UnreadCount)
+}
+
+func TestGetAdminNotifications_InvalidSeverity(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	_, token := createNotificationTestAdmin(t)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/notifications?severity=urgent", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
+
+func TestMarkAdminNotificationRead(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	admin, token := createNotificationTestAdmin(t)
+
+	notification, err := services.NotifyAdmins(models.SeverityWarning, models.NotificationSecurity, "Rejected gate provider callback", "")
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest("PATCH", "/api/v1/admin/notifications/"+notification.ID.String()+"/read", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var updated models.AdminNotification
+	db.DB.First(&updated, "id = ?", notification.ID)
+	assert.NotNil(t, updated.ReadAt)
+	assert.Equal(t, admin.ID, *updated.ReadBy)
+}
+
+func TestMarkAdminNotificationRead_NotFound(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	_, token := createNotificationTestAdmin(t)
+
+	req := httptest.NewRequest("PATCH", "/api/v1/admin/notifications/"+uuid.New().String()+"/read", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+func TestMarkAllAdminNotificationsRead(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	_, token := createNotificationTestAdmin(t)
+
+	services.NotifyAdmins(models.SeverityInfo, models.NotificationJobs, "First", "")
+	services.NotifyAdmins(models.SeverityInfo, models.NotificationJobs, "Second", "")
+
+	req := httptest.NewRequest("PATCH", "/api/v1/admin/notifications/read-all", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var unread int64
+	db.DB.Model(&models.AdminNotification{}).Where("read_at IS NULL").Count(&unread)
+	assert.Equal(t, int64(0), unread)
+}
@@ -0,0 +1,486 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PasskeyLoginOptionsRequest defines the structure for starting a passkey login
+// @name PasskeyLoginOptionsRequest
+type PasskeyLoginOptionsRequest struct {
+	Username string `json:"username" example:"admin"` // Optional: restricts the login to this admin's passkeys
+}
+
+// PasskeyLoginRequest is the PublicKeyCredential returned by navigator.credentials.get(), as
+// serialized by its toJSON() method
+// @name PasskeyLoginRequest
+type PasskeyLoginRequest struct {
+	ID       string `json:"id" validate:"required" example:"pX3f0w8sQ1u9kq2Hn7cZbA"` // Credential ID, base64url
+	Type     string `json:"type" example:"public-key"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON" validate:"required"`
+		AuthenticatorData string `json:"authenticatorData" validate:"required"`
+		Signature         string `json:"signature" validate:"required"`
+		UserHandle        string `json:"userHandle"`
+	} `json:"response"`
+}
+
+// PasskeyRegistrationRequest carries the PublicKeyCredential returned by
+// navigator.credentials.create(), as serialized by its toJSON() method
+// @name PasskeyRegistrationRequest
+type PasskeyRegistrationRequest struct {
+	Name       string `json:"name" example:"MacBook Touch ID"` // Label shown in the passkey list
+	Credential struct {
+		ID       string `json:"id" validate:"required" example:"pX3f0w8sQ1u9kq2Hn7cZbA"`
+		Type     string `json:"type" example:"public-key"`
+		Response struct {
+			ClientDataJSON    string   `json:"clientDataJSON" validate:"required"`
+			AttestationObject string   `json:"attestationObject" validate:"required"`
+			Transports        []string `json:"transports" example:"internal,hybrid"`
+		} `json:"response"`
+	} `json:"credential"`
+}
+
+// RenamePasskeyRequest defines the structure for renaming a passkey
+// @name RenamePasskeyRequest
+type RenamePasskeyRequest struct {
+	Name string `json:"name" validate:"required" example:"YubiKey 5C"`
+}
+
+// BeginAdminPasskeyLogin godoc
+// @Summary Start a passkey login
+// @Description Create a login challenge and return the options for navigator.credentials.get(). The browser offers any passkey it holds for the site; allowCredentials is always empty so the options do not reveal which admins exist. With a username the challenge only accepts that admin's passkeys. The challenge expires after WEBAUTHN_CHALLENGE_TTL
+// @Tags Admin Authentication
+// @Accept json
+// @Produce json
+// @Param request body PasskeyLoginOptionsRequest false "Optional username"
+// @Success 200 {object} PasskeyRequestOptionsResponse "Login options created"
+// @Failure 404 {object} APIResponse "Passkey login is not enabled"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/login/passkey/options [post]
+func BeginAdminPasskeyLogin(c *fiber.Ctx) error {
+	if !services.PasskeysEnabled() {
+		return passkeysDisabled(c)
+	}
+
+	var req PasskeyLoginOptionsRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	options, err := services.BeginPasskeyLogin(strings.TrimSpace(req.Username))
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[PASSKEY] Failed to start login", "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to create login options",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(PasskeyRequestOptionsResponse{
+		Success: true,
+		Message: "Login options created",
+		Data:    options,
+	})
+}
+
+// AdminPasskeyLogin godoc
+// @Summary Admin login with a passkey
+// @Description Verify the response to a passkey login challenge and return a permanent access token, like POST /admin/login. Each challenge can be answered once
+// @Tags Admin Authentication
+// @Accept json
+// @Produce json
+// @Param request body PasskeyLoginRequest true "Credential returned by navigator.credentials.get()"
+// @Success 200 {object} AdminLoginResponse "Login successful with permanent token"
+// @Failure 400 {object} APIResponse "Invalid request body"
+// @Failure 401 {object} APIResponse "Passkey could not be verified"
+// @Failure 404 {object} APIResponse "Passkey login is not enabled"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/login/passkey [post]
+func AdminPasskeyLogin(c *fiber.Ctx) error {
+	if !services.PasskeysEnabled() {
+		return passkeysDisabled(c)
+	}
+
+	var req PasskeyLoginRequest
+	if err := c.BodyParser(&req); err != nil || req.ID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+	assertion := services.PasskeyAssertion{CredentialID: strings.TrimRight(req.ID, "=")}
+	var errs [4]error
+	assertion.ClientDataJSON, errs[0] = decodeBase64URL(req.Response.ClientDataJSON)
+	assertion.AuthenticatorData, errs[1] = decodeBase64URL(req.Response.AuthenticatorData)
+	assertion.Signature, errs[2] = decodeBase64URL(req.Response.Signature)
+	assertion.UserHandle, errs[3] = decodeBase64URL(req.Response.UserHandle)
+	if err := errors.Join(errs[:]...); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body. Binary fields must be base64url-encoded",
+		})
+	}
+
+	admin, err := services.FinishPasskeyLogin(assertion)
+	if err != nil {
+		if errors.Is(err, services.ErrPasskeyInvalid) {
+			return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid credentials",
+			})
+		}
+		slog.WarnContext(c.UserContext(), "[PASSKEY] Failed to finish login", "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to verify passkey",
+		})
+	}
+
+	return completeAdminLogin(c, admin, "passkey")
+}
+
+// BeginAdminPasskeyRegistration godoc
+// @Summary Start registering a passkey
+// @Description Create a registration challenge and return the options for navigator.credentials.create(). Passkeys are created as discoverable credentials (residentKey required). Admins can only register passkeys for their own account, since registering needs their authenticator
+// @Tags Admin Passkeys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Admin ID (UUID)"
+// @Success 200 {object} PasskeyCreationOptionsResponse "Registration options created"
+// @Failure 400 {object} APIResponse "Invalid admin ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - passkeys can only be registered for your own account"
+// @Failure 404 {object} APIResponse "Passkey login is not enabled"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/users/{id}/passkeys/options [post]
+func BeginAdminPasskeyRegistration(c *fiber.Ctx) error {
+	admin, ok, err := passkeyOwner(c)
+	if !ok {
+		return err
+	}
+
+	options, err := services.BeginPasskeyRegistration(admin)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[PASSKEY] Failed to start registration", "admin_id", admin.ID, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to create registration options",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(PasskeyCreationOptionsResponse{
+		Success: true,
+		Message: "Registration options created",
+		Data:    options,
+	})
+}
+
+// RegisterAdminPasskey godoc
+// @Summary Register a passkey
+// @Description Verify the response to a registration challenge and add the passkey to the admin's account. Attestation is not verified, any authenticator is accepted
+// @Tags Admin Passkeys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Admin ID (UUID)"
+// @Param request body PasskeyRegistrationRequest true "Credential returned by navigator.credentials.create()"
+// @Success 201 {object} AdminPasskeyResponse "Passkey registered"
+// @Failure 400 {object} APIResponse "Invalid request body, unknown or expired challenge, or the credential could not be verified"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - passkeys can only be registered for your own account"
+// @Failure 404 {object} APIResponse "Passkey login is not enabled"
+// @Failure 409 {object} APIResponse "Passkey is already registered"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/users/{id}/passkeys [post]
+func RegisterAdminPasskey(c *fiber.Ctx) error {
+	admin, ok, err := passkeyOwner(c)
+	if !ok {
+		return err
+	}
+
+	var req PasskeyRegistrationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+	clientData, clientDataErr := decodeBase64URL(req.Credential.Response.ClientDataJSON)
+	attestation, attestationErr := decodeBase64URL(req.Credential.Response.AttestationObject)
+	if clientDataErr != nil || attestationErr != nil || len(clientData) == 0 || len(attestation) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body. clientDataJSON and attestationObject must be base64url-encoded",
+		})
+	}
+
+	credential, err := services.FinishPasskeyRegistration(admin, clientData, attestation, strings.TrimSpace(req.Name), req.Credential.Response.Transports)
+	if err != nil {
+		middleware.RecordAudit(c, "register_passkey", "admin", admin.ID.String(), "failed", err.Error())
+		switch {
+		case errors.Is(err, services.ErrPasskeyExists):
+			return c.Status(fiber.StatusConflict).JSON(APIResponse{
+				Success: false,
+				Message: "Passkey is already registered",
+			})
+		case errors.Is(err, services.ErrPasskeyInvalid):
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Registration failed: " + err.Error(),
+			})
+		}
+		slog.WarnContext(c.UserContext(), "[PASSKEY] Failed to register passkey", "admin_id", admin.ID, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to register passkey",
+		})
+	}
+	middleware.RecordAudit(c, "register_passkey", "admin", admin.ID.String(), "success", "")
+	actorID, actor := historyActor(c)
+	services.RecordAdminHistory(admin.ID, models.AdminHistoryPasskeyAdded, actorID, actor,
+		services.FieldChanges{}.Set("passkey", nil, credential.Name))
+
+	return c.Status(fiber.StatusCreated).JSON(AdminPasskeyResponse{
+		Success: true,
+		Message: "Passkey registered",
+		Data:    toAdminPasskeyDTO(credential),
+	})
+}
+
+// GetAdminPasskeys godoc
+// @Summary List an admin's passkeys
+// @Description Retrieve the passkeys registered for the admin account, oldest first (super admin or self)
+// @Tags Admin Passkeys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Admin ID (UUID)"
+// @Success 200 {object} AdminPasskeysResponse "Passkeys retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid admin ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - regular admins can only access their own record"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/users/{id}/passkeys [get]
+func GetAdminPasskeys(c *fiber.Ctx) error {
+	adminID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid admin ID format",
+		})
+	}
+
+	credentials, err := services.ListAdminCredentials(adminID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve passkeys",
+		})
+	}
+
+	data := make([]AdminPasskeyDTO, 0, len(credentials))
+	for _, credential := range credentials {
+		data = append(data, toAdminPasskeyDTO(credential))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(AdminPasskeysResponse{
+		Success: true,
+		Message: "Passkeys retrieved successfully",
+		Data:    data,
+	})
+}
+
+// RenameAdminPasskey godoc
+// @Summary Rename a passkey
+// @Description Change the label of one of the admin's passkeys (super admin or self)
+// @Tags Admin Passkeys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Admin ID (UUID)"
+// @Param credentialId path string true "Passkey ID (UUID)"
+// @Param request body RenamePasskeyRequest true "New name"
+// @Success 200 {object} AdminPasskeyResponse "Passkey renamed"
+// @Failure 400 {object} APIResponse "Invalid ID format or missing name"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - regular admins can only access their own record"
+// @Failure 404 {object} APIResponse "Passkey not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/users/{id}/passkeys/{credentialId} [patch]
+func RenameAdminPasskey(c *fiber.Ctx) error {
+	credential, ok, err := findAdminPasskey(c)
+	if !ok {
+		return err
+	}
+
+	var req RenamePasskeyRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Name) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body. Name is required",
+		})
+	}
+
+	credential.Name = strings.TrimSpace(req.Name)
+	if err := db.DB.WithContext(c.UserContext()).Model(&credential).Update("name", credential.Name).Error; err != nil {
+		middleware.RecordAudit(c, "rename_passkey", "admin", credential.AdminID.String(), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to rename passkey",
+		})
+	}
+	middleware.RecordAudit(c, "rename_passkey", "admin", credential.AdminID.String(), "success", "")
+
+	return c.Status(fiber.StatusOK).JSON(AdminPasskeyResponse{
+		Success: true,
+		Message: "Passkey renamed",
+		Data:    toAdminPasskeyDTO(credential),
+	})
+}
+
+// DeleteAdminPasskey godoc
+// @Summary Remove a passkey
+// @Description Remove one of the admin's passkeys so it can no longer log in. Super admins can remove passkeys of other admins, e.g. for a lost security key. With WEBAUTHN_PASSWORD_FALLBACK=unenrolled, removing an admin's last passkey lets them log in with their password again
+// @Tags Admin Passkeys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Admin ID (UUID)"
+// @Param credentialId path string true "Passkey ID (UUID)"
+// @Success 200 {object} AdminPasskeyResponse "Passkey removed"
+// @Failure 400 {object} APIResponse "Invalid ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - regular admins can only access their own record"
+// @Failure 404 {object} APIResponse "Passkey not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/users/{id}/passkeys/{credentialId} [delete]
+func DeleteAdminPasskey(c *fiber.Ctx) error {
+	credential, ok, err := findAdminPasskey(c)
+	if !ok {
+		return err
+	}
+
+	if err := db.DB.WithContext(c.UserContext()).Delete(&credential).Error; err != nil {
+		middleware.RecordAudit(c, "delete_passkey", "admin", credential.AdminID.String(), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to remove passkey",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "[PASSKEY] Passkey removed",
+		"passkey_id", credential.ID, "name", credential.Name, "admin_id", credential.AdminID)
+	middleware.RecordAudit(c, "delete_passkey", "admin", credential.AdminID.String(), "success", "")
+	actorID, actor := historyActor(c)
+	services.RecordAdminHistory(credential.AdminID, models.AdminHistoryPasskeyRemoved, actorID, actor,
+		services.FieldChanges{}.Set("passkey", credential.Name, nil))
+
+	return c.Status(fiber.StatusOK).JSON(AdminPasskeyResponse{
+		Success: true,
+		Message: "Passkey removed",
+		Data:    toAdminPasskeyDTO(credential),
+	})
+}
+
+// passkeyOwner loads the admin named in the path for passkey registration, which only the admin
+// themselves can do. When it returns false the error response has already been written.
+func passkeyOwner(c *fiber.Ctx) (models.Admin, bool, error) {
+	var admin models.Admin
+	if !services.PasskeysEnabled() {
+		return admin, false, passkeysDisabled(c)
+	}
+	adminID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return admin, false, c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid admin ID format",
+		})
+	}
+	if c.Locals("id") != adminID {
+		return admin, false, c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Passkeys can only be registered for your own account",
+		})
+	}
+	if err := db.DB.WithContext(c.UserContext()).First(&admin, "id = ?", adminID).Error; err != nil {
+		return admin, false, c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve admin",
+		})
+	}
+	return admin, true, nil
+}
+
+// findAdminPasskey loads the passkey named in the path. When it returns false the error
+// response has already been written.
+func findAdminPasskey(c *fiber.Ctx) (models.AdminCredential, bool, error) {
+	adminID, adminErr := uuid.Parse(c.Params("id"))
+	credentialID, credentialErr := uuid.Parse(c.Params("credentialId"))
+	if adminErr != nil || credentialErr != nil {
+		return models.AdminCredential{}, false, c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid ID format",
+		})
+	}
+
+	credential, err := services.FindAdminCredential(adminID, credentialID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return credential, false, c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Passkey not found",
+		})
+	}
+	if err != nil {
+		return credential, false, c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve passkey",
+		})
+	}
+	return credential, true, nil
+}
+
+func passkeysDisabled(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+		Success: false,
+		Message: "Passkey login is not enabled",
+	})
+}
+
+// decodeBase64URL decodes base64url as sent by browsers, with or without padding
+func decodeBase64URL(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
+
+func toAdminPasskeyDTO(credential models.AdminCredential) AdminPasskeyDTO {
+	transports := []string{}
+	if credential.Transports != "" {
+		transports = strings.Split(credential.Transports, ",")
+	}
+	return AdminPasskeyDTO{
+		ID:             credential.ID,
+		CredentialID:   credential.CredentialID,
+		Name:           credential.Name,
+		Algorithm:      credential.Algorithm,
+		AAGUID:         credential.AAGUID,
+		Transports:     transports,
+		BackupEligible: credential.BackupEligible,
+		LastUsedAt:     credential.LastUsedAt,
+		CreatedAt:      credential.CreatedAt,
+	}
+}
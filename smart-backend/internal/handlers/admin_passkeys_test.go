@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/tests"
+	"ololo-gate/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func enablePasskeys(fallback string) {
+	config.AppConfig().WebAuthn = config.WebAuthnConfig{
+		RPID:             "admin.example.com",
+		RPName:           "Ololo Gate",
+		Origins:          []string{"https://admin.example.com"},
+		UserVerification: "preferred",
+		ChallengeTTL:     5 * time.Minute,
+		PasswordFallback: fallback,
+	}
+}
+
+func passkeyRequest(t *testing.T, app *fiber.App, token, method, path string, body interface{}) (int, map[string]interface{}) {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+// registerPasskey runs the registration ceremony for the admin with a software authenticator
+func registerPasskey(t *testing.T, app *fiber.App, admin models.Admin, token string) *tests.Authenticator {
+	path := "/api/v1/admin/users/" + admin.ID.String() + "/passkeys"
+	status, options := passkeyRequest(t, app, token, "POST", path+"/options", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data := options["data"].(map[string]interface{})
+	userHandle, _ := base64.RawURLEncoding.DecodeString(data["user"].(map[string]interface{})["id"].(string))
+	assert.Equal(t, admin.ID[:], userHandle)
+
+	authenticator := tests.NewAuthenticator("admin.example.com", "https://admin.example.com")
+	clientData, attestation := authenticator.Create(data["challenge"].(string), userHandle)
+	status, registered := passkeyRequest(t, app, token, "POST", path, fiber.Map{
+		"name": "Test key",
+		"credential": fiber.Map{
+			"id":       authenticator.CredentialIDString(),
+			"type":     "public-key",
+			"response": fiber.Map{"clientDataJSON": clientData, "attestationObject": attestation, "transports": []string{"usb"}},
+		},
+	})
+	assert.Equal(t, fiber.StatusCreated, status, registered["message"])
+	return authenticator
+}
+
+// passkeyLogin runs the login ceremony and returns the response
+func passkeyLogin(t *testing.T, app *fiber.App, authenticator *tests.Authenticator, username string) (int, map[string]interface{}) {
+	status, options := passkeyRequest(t, app, "", "POST", "/api/v1/admin/login/passkey/options", fiber.Map{"username": username})
+	assert.Equal(t, fiber.StatusOK, status)
+	challenge := options["data"].(map[string]interface{})["challenge"].(string)
+
+	clientData, authData, sig, userHandle := authenticator.Get(challenge)
+	return passkeyRequest(t, app, "", "POST", "/api/v1/admin/login/passkey", fiber.Map{
+		"id":   authenticator.CredentialIDString(),
+		"type": "public-key",
+		"response": fiber.Map{
+			"clientDataJSON":    clientData,
+			"authenticatorData": authData,
+			"signature":         sig,
+			"userHandle":        userHandle,
+		},
+	})
+}
+
+func TestAdminPasskeys_RegisterAndLogin(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	enablePasskeys("always")
+
+	admin := models.Admin{ID: uuid.New(), Username: "passkey-admin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	authenticator := registerPasskey(t, app, admin, token)
+
+	status, list := passkeyRequest(t, app, token, "GET", "/api/v1/admin/users/"+admin.ID.String()+"/passkeys", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	passkeys := list["data"].([]interface{})
+	assert.Len(t, passkeys, 1)
+	passkey := passkeys[0].(map[string]interface{})
+	assert.Equal(t, authenticator.CredentialIDString(), passkey["credential_id"])
+	assert.Equal(t, "Test key", passkey["name"])
+	assert.Equal(t, []interface{}{"usb"}, passkey["transports"])
+
+	// Login options look the same for admins with passkeys, without passkeys and unknown usernames
+	nopasskey := models.Admin{ID: uuid.New(), Username: "nopasskey-admin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&nopasskey)
+	for _, username := range []string{admin.Username, nopasskey.Username, "nobody"} {
+		status, options := passkeyRequest(t, app, "", "POST", "/api/v1/admin/login/passkey/options", fiber.Map{"username": username})
+		assert.Equal(t, fiber.StatusOK, status)
+		assert.Empty(t, options["data"].(map[string]interface{})["allowCredentials"], username)
+	}
+
+	// Login with the admin's passkey issues a token
+
+	status, login := passkeyLogin(t, app, authenticator, admin.Username)
+	assert.Equal(t, fiber.StatusOK, status, login["message"])
+	claims, err := utils.ValidateAdminToken(login["data"].(map[string]interface{})["access_token"].(string))
+	assert.NoError(t, err)
+	assert.Equal(t, admin.ID, claims.AdminID)
+	assert.Equal(t, 1, claims.TokenVersion)
+
+	// Discoverable login without a username works too
+	status, _ = passkeyLogin(t, app, authenticator, "")
+	assert.Equal(t, fiber.StatusOK, status)
+
+	var stored models.AdminCredential
+	db.DB.First(&stored, "credential_id = ?", authenticator.CredentialIDString())
+	assert.Equal(t, int64(2), stored.SignCount)
+	assert.NotNil(t, stored.LastUsedAt)
+
+	// Another admin's username does not accept this passkey
+	other := models.Admin{ID: uuid.New(), Username: "other-admin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&other)
+	status, _ = passkeyLogin(t, app, authenticator, other.Username)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+}
+
+func TestAdminPasskeys_RejectsReplayAndForeignRegistration(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	enablePasskeys("always")
+
+	admin := models.Admin{ID: uuid.New(), Username: "passkey-admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	authenticator := registerPasskey(t, app, admin, token)
+
+	// Even super admins cannot register passkeys for someone else
+	regular := models.Admin{ID: uuid.New(), Username: "regular-admin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&regular)
+	status, _ := passkeyRequest(t, app, token, "POST", "/api/v1/admin/users/"+regular.ID.String()+"/passkeys/options", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	// Regular admins cannot see other admins' passkeys
+	regularToken, _ := utils.GenerateAdminToken(regular.ID, regular.Username, regular.Role, 0)
+	status, _ = passkeyRequest(t, app, regularToken, "GET", "/api/v1/admin/users/"+admin.ID.String()+"/passkeys", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	// The same response cannot be used twice: its challenge is gone
+	status, options := passkeyRequest(t, app, "", "POST", "/api/v1/admin/login/passkey/options", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	clientData, authData, sig, userHandle := authenticator.Get(options["data"].(map[string]interface{})["challenge"].(string))
+	body := fiber.Map{
+		"id":       authenticator.CredentialIDString(),
+		"response": fiber.Map{"clientDataJSON": clientData, "authenticatorData": authData, "signature": sig, "userHandle": userHandle},
+	}
+	status, _ = passkeyRequest(t, app, "", "POST", "/api/v1/admin/login/passkey", body)
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = passkeyRequest(t, app, "", "POST", "/api/v1/admin/login/passkey", body)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+}
+
+func TestAdminPasskeys_PasswordFallback(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	enablePasskeys("unenrolled")
+
+	admin := models.Admin{ID: uuid.New(), Username: "passkey-admin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	passwordLogin := fiber.Map{"username": admin.Username, "password": "password123"}
+
+	status, _ := passkeyRequest(t, app, "", "POST", "/api/v1/admin/login", passwordLogin)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	// Logging in invalidated the earlier token
+	db.DB.First(&admin, "id = ?", admin.ID)
+	token, _ = utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, admin.TokenVersion)
+	registerPasskey(t, app, admin, token)
+	status, response := passkeyRequest(t, app, "", "POST", "/api/v1/admin/login", passwordLogin)
+	assert.Equal(t, fiber.StatusForbidden, status)
+	assert.Contains(t, response["message"], "passkey")
+
+	// A super admin removes the passkey, e.g. a lost key, and password login works again
+	super := models.Admin{ID: uuid.New(), Username: "super-admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&super)
+	superToken, _ := utils.GenerateAdminToken(super.ID, super.Username, super.Role, 0)
+	var credential models.AdminCredential
+	db.DB.First(&credential, "admin_id = ?", admin.ID)
+	status, _ = passkeyRequest(t, app, superToken, "DELETE", "/api/v1/admin/users/"+admin.ID.String()+"/passkeys/"+credential.ID.String(), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	status, _ = passkeyRequest(t, app, "", "POST", "/api/v1/admin/login", passwordLogin)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	var history []models.AdminHistory
+	db.DB.Where("admin_id = ?", admin.ID).Order("created_at").Find(&history)
+	assert.Len(t, history, 2)
+}
+
+func TestAdminPasskeys_Disabled(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status, _ := passkeyRequest(t, app, "", "POST", "/api/v1/admin/login/passkey/options", nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"ololo-gate/internal/services"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetProviderMigrationReport godoc
+// @Summary Provider migration comparison report
+// @Description While migrating to a new gate provider (THIRD_PARTY_MIRROR_API_URL), assignments and gate commands are mirrored to it. This report compares the outcomes of both providers per operation, with the most recent mismatches (super admin only)
+// @Tags Admin Reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param since_hours query int false "Only include calls from the last N hours" default(168)
+// @Param limit query int false "Maximum number of mismatches to return (max 500)" default(50)
+// @Success 200 {object} ProviderMigrationReportResponse "Report retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid since_hours or limit"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/provider-migration/report [get]
+func GetProviderMigrationReport(c *fiber.Ctx) error {
+	sinceHours, err := strconv.Atoi(c.Query("since_hours", "168"))
+	if err != nil || sinceHours <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid since_hours",
+		})
+	}
+	limit, err := strconv.Atoi(c.Query("limit", "50"))
+	if err != nil || limit <= 0 || limit > 500 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid limit. Use 1 to 500",
+		})
+	}
+
+	report, err := services.ProviderMirrorReport(time.Now().Add(-time.Duration(sinceHours)*time.Hour), limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to compute provider migration report",
+		})
+	}
+
+	data := ProviderMigrationReportDTO{
+		Enabled:    report.Enabled,
+		Since:      report.Since,
+		Operations: make([]ProviderMirrorOperationDTO, 0, len(report.Operations)),
+		Mismatches: make([]ProviderMirrorMismatchDTO, 0, len(report.Mismatches)),
+	}
+	for _, op := range report.Operations {
+		data.Operations = append(data.Operations, ProviderMirrorOperationDTO{
+			Operation:    op.Operation,
+			Total:        op.Total,
+			Matched:      op.Matched,
+			Mismatched:   op.Mismatched,
+			MirrorErrors: op.MirrorErrors,
+		})
+	}
+	for _, m := range report.Mismatches {
+		data.Mismatches = append(data.Mismatches, ProviderMirrorMismatchDTO{
+			ID:             m.ID,
+			Operation:      m.Operation,
+			Phone:          m.Phone,
+			GateID:         m.GateID,
+			PrimaryOutcome: m.PrimaryOutcome,
+			MirrorOutcome:  m.MirrorOutcome,
+			MirrorDetail:   m.MirrorDetail,
+			CreatedAt:      m.CreatedAt,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ProviderMigrationReportResponse{
+		Success: true,
+		Message: "Provider migration report retrieved successfully",
+		Data:    data,
+	})
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProviderMigrationReport(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	defer db.DB.Exec("DELETE FROM provider_mirror_results")
+
+	db.DB.Create(&models.ProviderMirrorResult{Operation: "assign_user", Phone: "+77771234567", PrimaryOutcome: "ok", MirrorOutcome: "ok", Matched: true})
+	db.DB.Create(&models.ProviderMirrorResult{Operation: "open_gate", GateID: 40, PrimaryOutcome: "ok:true", MirrorOutcome: "error:not_found"})
+	old := models.ProviderMirrorResult{Operation: "open_gate", GateID: 41, PrimaryOutcome: "ok:true", MirrorOutcome: "ok:false"}
+	db.DB.Create(&old)
+	db.DB.Model(&old).Update("created_at", time.Now().Add(-48*time.Hour))
+
+	admin := models.Admin{ID: uuid.New(), Username: "migration-admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/provider-migration/report?since_hours=24", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, _ := io.ReadAll(resp.Body)
+	var response ProviderMigrationReportResponse
+	assert.NoError(t, json.Unmarshal(body, &response))
+	assert.False(t, response.Data.Enabled)
+	assert.Equal(t, []ProviderMirrorOperationDTO{
+		{Operation: "assign_user", Total: 1, Matched: 1},
+		{Operation: "open_gate", Total: 1, Mismatched: 1, MirrorErrors: 1},
+	}, response.Data.Operations)
+	if assert.Len(t, response.Data.Mismatches, 1) {
+		assert.Equal(t, 40, response.Data.Mismatches[0].GateID)
+	}
+}
@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ApproveRegistrationRequest defines the structure for approving a pending registration
+// @name ApproveRegistrationRequest
+type ApproveRegistrationRequest struct {
+	Locations []LocationAssignmentRequest `json:"locations"` // Optional - locations and gates assigned to the user on approval
+}
+
+// RejectRegistrationRequest defines the structure for rejecting a pending registration
+// @name RejectRegistrationRequest
+type RejectRegistrationRequest struct {
+	Reason string `json:"reason" validate:"required" example:"Not a resident of this building"`
+}
+
+// GetRegistrations godoc
+// @Summary List registrations awaiting review
+// @Description Retrieve self-registrations by status, oldest first. Registrations without an invite code land in the pending queue when USER_REGISTRATION_APPROVAL is enabled (requires admin authentication)
+// @Tags Admin Registrations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "pending (default), rejected, approved or unverified (phone not confirmed yet, OTP_REGISTRATION_ENABLED)"
+// @Success 200 {object} RegistrationsResponse "Registrations retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid status"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/registrations [get]
+func GetRegistrations(c *fiber.Ctx) error {
+	status := c.Query("status", models.RegistrationPending)
+	if status != models.RegistrationPending && status != models.RegistrationRejected && status != models.RegistrationApproved &&
+		status != models.RegistrationUnverified {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid status. Must be 'pending', 'rejected', 'approved' or 'unverified'",
+		})
+	}
+
+	var users []models.User
+	if err := db.DB.WithContext(c.UserContext()).Where("registration_status = ? AND trashed_at IS NULL", status).Order("created_at").Find(&users).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve registrations",
+		})
+	}
+
+	data := make([]RegistrationDTO, len(users))
+	for i, user := range users {
+		data[i] = toRegistrationDTO(user)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(RegistrationsResponse{
+		Success: true,
+		Message: "Registrations retrieved successfully",
+		Data:    data,
+	})
+}
+
+// ApproveRegistration godoc
+// @Summary Approve a registration
+// @Description Approve a pending (or previously rejected) registration so the user can log in. The optional locations and gates are assigned to the user, and a welcome SMS is sent. If the assignment or the SMS fails, the registration stays approved and the response carries a warning (requires admin authentication)
+// @Tags Admin Registrations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Param request body ApproveRegistrationRequest false "Locations and gates to assign"
+// @Success 200 {object} RegistrationResponse "Registration approved (warning set if assignment or the welcome SMS failed)"
+// @Failure 400 {object} APIResponse "Invalid user ID or request body"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 409 {object} APIResponse "Registration is already approved"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/registrations/{id}/approve [post]
+func ApproveRegistration(c *fiber.Ctx) error {
+	user, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	var req ApproveRegistrationRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	if err := services.ApproveRegistration(&user, adminUsername); err != nil {
+		if errors.Is(err, services.ErrRegistrationReviewed) {
+			return c.Status(fiber.StatusConflict).JSON(APIResponse{
+				Success: false,
+				Message: "Registration is already approved",
+			})
+		}
+		middleware.RecordAudit(c, "approve_registration", "user", user.ID.String(), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to approve registration",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "[REGISTRATIONS] Registration approved", "admin", adminUsername, "user_id", user.ID)
+
+	response := RegistrationResponse{
+		Success: true,
+		Message: "Registration approved successfully",
+		Data:    toRegistrationDTO(user),
+	}
+	var warnings []string
+	assigned := true
+
+	if len(req.Locations) > 0 {
+		locations := make([]services.LocationAssignmentDTO, len(req.Locations))
+		for i, location := range req.Locations {
+			locations[i] = services.LocationAssignmentDTO{LocationID: location.LocationID, GateIds: location.GateIds}
+		}
+		if err := services.AssignAllPhones(services.NewThirdPartyClient().WithContext(c.UserContext()), user, locations); err != nil {
+			slog.WarnContext(c.UserContext(), "Failed to assign locations/gates to approved user", "user_id", user.ID, "error", err)
+			assigned = false
+			middleware.RecordAudit(c, "approve_registration", "user", user.ID.String(), "failed", "Approved but failed to assign locations/gates: "+err.Error())
+			warnings = append(warnings, "Third-party API assignment error: "+err.Error())
+		} else {
+			services.RecordUserHistory(user.ID, models.UserHistoryAssigned, adminUsername,
+				services.FieldChanges{}.Set("assignments", nil, locations))
+		}
+	}
+
+	if err := services.SendWelcomeSMS(c.UserContext(), user); err != nil {
+		warnings = append(warnings, "Welcome SMS error: "+err.Error())
+	}
+
+	if len(warnings) > 0 {
+		response.Message = "Registration approved, but some follow-up steps failed"
+		response.Warning = strings.Join(warnings, "; ")
+	}
+	if assigned {
+		middleware.RecordAudit(c, "approve_registration", "user", user.ID.String(), "success", "")
+	}
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// RejectRegistration godoc
+// @Summary Reject a registration
+// @Description Reject a pending registration with a reason. The user cannot log in; the reason is kept on the user (requires admin authentication)
+// @Tags Admin Registrations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Param request body RejectRegistrationRequest true "Rejection reason"
+// @Success 200 {object} RegistrationResponse "Registration rejected"
+// @Failure 400 {object} APIResponse "Invalid user ID or missing reason"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 409 {object} APIResponse "Registration is not pending"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/registrations/{id}/reject [post]
+func RejectRegistration(c *fiber.Ctx) error {
+	user, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	var req RejectRegistrationRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Reason) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "A rejection reason is required",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	if err := services.RejectRegistration(&user, adminUsername, strings.TrimSpace(req.Reason)); err != nil {
+		if errors.Is(err, services.ErrRegistrationReviewed) {
+			return c.Status(fiber.StatusConflict).JSON(APIResponse{
+				Success: false,
+				Message: "Registration is not pending",
+			})
+		}
+		middleware.RecordAudit(c, "reject_registration", "user", user.ID.String(), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to reject registration",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "[REGISTRATIONS] Registration rejected", "admin", adminUsername, "user_id", user.ID)
+	middleware.RecordAudit(c, "reject_registration", "user", user.ID.String(), "success", "")
+
+	return c.Status(fiber.StatusOK).JSON(RegistrationResponse{
+		Success: true,
+		Message: "Registration rejected",
+		Data:    toRegistrationDTO(user),
+	})
+}
+
+func toRegistrationDTO(user models.User) RegistrationDTO {
+	return RegistrationDTO{
+		ID:              user.ID,
+		Phone:           user.Phone,
+		Status:          user.RegistrationStatus,
+		ReviewedBy:      user.ReviewedBy,
+		ReviewedAt:      user.ReviewedAt,
+		RejectionReason: user.RejectionReason,
+		CreatedAt:       user.CreatedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistrations_PendingApprovalWorkflow(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Users.RegistrationApproval = true
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+	sms := &fakeSMSSender{messages: map[string][]string{}}
+	services.SetSMSSender(sms)
+	defer services.SetSMSSender(nil)
+
+	// Registrations without an invite code wait for an admin and cannot log in
+	assert.Equal(t, fiber.StatusCreated, registerStatus(t, app, "+77771234567", ""))
+	assert.Equal(t, fiber.StatusCreated, registerStatus(t, app, "+77771234568", ""))
+	assert.Equal(t, fiber.StatusForbidden, loginStatus(t, app, "+77771234567", "password123"))
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/registrations", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	queue := result["data"].([]interface{})
+	assert.Len(t, queue, 2)
+	first := queue[0].(map[string]interface{})["id"].(string)
+	second := queue[1].(map[string]interface{})["id"].(string)
+
+	// Rejection requires a reason, which is recorded
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/registrations/"+second+"/reject", map[string]string{})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, result = mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/registrations/"+second+"/reject", map[string]string{"reason": "Unknown person"})
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Unknown person", result["data"].(map[string]interface{})["rejection_reason"])
+	assert.Equal(t, fiber.StatusForbidden, loginStatus(t, app, "+77771234568", "password123"))
+
+	// Approval assigns the locations and sends a welcome SMS
+	path := "/api/v1/admin/registrations/" + first + "/approve"
+	body := map[string]interface{}{"locations": []map[string]interface{}{{"locationId": 4, "gateIds": []int{40}}}}
+	status, result = mergeRequest(t, app, models.RoleRegular, "POST", path, body)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["warning"])
+	assert.Equal(t, []services.LocationAssignmentDTO{{LocationID: 4, GateIds: []int{40}}}, provider.assignments["+77771234567"])
+	assert.Len(t, sms.messages["+77771234567"], 1)
+	assert.Equal(t, fiber.StatusOK, loginStatus(t, app, "+77771234567", "password123"))
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", path, body)
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/registrations?status=rejected", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 1)
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/registrations", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["data"])
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/services"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetAdminReport godoc
+// @Summary Operational reports
+// @Description Compute a canned report server-side for a range of days in the request's timezone (X-Timezone, the admin's timezone or DEFAULT_TIMEZONE): daily_active_users (distinct users active per day), gate_opens (confirmed open commands per location per day, from hourly rollups), user_churn (users registered and moved to the trash per day) or provider_error_rate (third-party API calls, failures and error rate per day). daily_active_users and provider_error_rate are metered per UTC day and always use UTC days; timezone in the response names the zone used. Returns JSON, or CSV with format=csv (super admin only)
+// @Tags Admin Reports
+// @Accept json
+// @Produce json,text/csv
+// @Security BearerAuth
+// @Param report query string true "Report name" Enums(daily_active_users, gate_opens, user_churn, provider_error_rate)
+// @Param from query string false "First day, YYYY-MM-DD (defaults to 29 days before to)"
+// @Param to query string false "Last day, inclusive, YYYY-MM-DD (defaults to today)"
+// @Param format query string false "Output format" Enums(json, csv) default(json)
+// @Success 200 {object} AdminReportResponse "Report computed successfully"
+// @Failure 400 {object} APIResponse "Unknown report, invalid date range or format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/reports [get]
+func GetAdminReport(c *fiber.Ctx) error {
+	name := c.Query("report")
+	if !slices.Contains(services.ReportNames, name) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Unknown report. Use one of: " + strings.Join(services.ReportNames, ", "),
+		})
+	}
+
+	format := c.Query("format", "json")
+	if format != "json" && format != "csv" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid format. Use json or csv",
+		})
+	}
+
+	dateRange, err := services.ParseReportRange(c.Query("from"), c.Query("to"), time.Now(), middleware.RequestTimezone(c))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid date range: " + err.Error(),
+		})
+	}
+
+	// Include this instance's buffered usage in the numbers
+	services.Meter().Flush()
+
+	report, err := services.RunReport(name, dateRange, services.NewThirdPartyClient().WithContext(c.UserContext()))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to compute report",
+		})
+	}
+
+	if format == "csv" {
+		var body bytes.Buffer
+		if err := report.WriteCSV(&body); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to write report",
+			})
+		}
+		c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s_%s_%s.csv"`, report.Name, report.From, report.To))
+		return c.Status(fiber.StatusOK).Send(body.Bytes())
+	}
+
+	rows := report.Rows
+	if rows == nil {
+		rows = [][]interface{}{}
+	}
+	return c.Status(fiber.StatusOK).JSON(AdminReportResponse{
+		Success: true,
+		Message: "Report computed successfully",
+		Warning: report.Warning,
+		Data: AdminReportDTO{
+			Report:   report.Name,
+			From:     report.From,
+			To:       report.To,
+			Timezone: report.Timezone,
+			Columns:  report.Columns,
+			Rows:     rows,
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func getAdminReport(t *testing.T, app *fiber.App, query string) (int, string, string) {
+	admin := models.Admin{ID: uuid.New(), Username: "reports-admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/reports"+query, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, resp.Header.Get(fiber.HeaderContentType), string(body)
+}
+
+func TestGetAdminReport_DailyActiveUsers(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	orgID := config.AppConfig().Metering.OrgID
+	db.DB.Create(&models.UsageDailyActiveUser{OrgID: orgID, Day: "2026-10-01", UserID: uuid.New()})
+	db.DB.Create(&models.UsageDailyActiveUser{OrgID: orgID, Day: "2026-10-01", UserID: uuid.New()})
+	db.DB.Create(&models.UsageDailyActiveUser{OrgID: orgID, Day: "2026-10-03", UserID: uuid.New()})
+	db.DB.Create(&models.UsageDailyActiveUser{OrgID: "other-org", Day: "2026-10-03", UserID: uuid.New()})
+
+	status, _, body := getAdminReport(t, app, "?report=daily_active_users&from=2026-10-01&to=2026-10-03")
+	assert.Equal(t, fiber.StatusOK, status)
+
+	var response AdminReportResponse
+	assert.NoError(t, json.Unmarshal([]byte(body), &response))
+	assert.Equal(t, []string{"day", "active_users"}, response.Data.Columns)
+	// Every day in the range is listed, including days without activity
+	assert.Equal(t, [][]interface{}{
+		{"2026-10-01", float64(2)},
+		{"2026-10-02", float64(0)},
+		{"2026-10-03", float64(1)},
+	}, response.Data.Rows)
+}
+
+func TestGetAdminReport_UserChurnCSV(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	day := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	trashedAt := day.Add(3 * time.Hour)
+	db.DB.Create(&models.User{Phone: "+77770000001", Password: "password123", CreatedAt: day})
+	db.DB.Create(&models.User{Phone: "+77770000002", Password: "password123", CreatedAt: day, TrashedAt: &trashedAt})
+
+	status, contentType, body := getAdminReport(t, app, "?report=user_churn&from=2026-10-05&to=2026-10-06&format=csv")
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.True(t, strings.HasPrefix(contentType, "text/csv"))
+	assert.Equal(t, "day,new_users,churned_users,net_change\n2026-10-05,2,1,1\n2026-10-06,0,0,0\n", body)
+}
+
+func TestGetAdminReport_GateOpensFromRollups(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.Locations().Invalidate() // The provider is unreachable in tests, so gates are not attributed
+
+	hour := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	for _, status := range []string{models.GateCommandConfirmed, models.GateCommandConfirmed, models.GateCommandFailed} {
+		db.DB.Create(&models.GateEvent{Hour: hour, GateID: 9001, CommandID: uuid.New(), Action: "open", Status: status, OccurredAt: hour})
+	}
+	db.DB.Create(&models.GateEvent{Hour: hour, GateID: 9001, CommandID: uuid.New(), Action: "close", Status: models.GateCommandConfirmed, OccurredAt: hour})
+
+	status, _, body := getAdminReport(t, app, "?report=gate_opens&from=2026-10-05&to=2026-10-05")
+	assert.Equal(t, fiber.StatusOK, status)
+
+	var response AdminReportResponse
+	assert.NoError(t, json.Unmarshal([]byte(body), &response))
+	assert.NotEmpty(t, response.Warning)
+	assert.Equal(t, [][]interface{}{{"2026-10-05", float64(0), "unknown", float64(2)}}, response.Data.Rows)
+}
+
+func TestGetAdminReport_InvalidParameters(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	for _, query := range []string{
+		"",
+		"?report=unknown",
+		"?report=user_churn&format=xml",
+		"?report=user_churn&from=10/01/2026",
+		"?report=user_churn&from=2026-10-05&to=2026-10-01",
+		"?report=user_churn&from=2024-01-01&to=2026-01-01",
+	} {
+		status, _, _ := getAdminReport(t, app, query)
+		assert.Equal(t, fiber.StatusBadRequest, status, query)
+	}
+}
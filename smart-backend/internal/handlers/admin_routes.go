@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"ololo-gate/internal/middleware"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetRegisteredRoutes godoc
+// @Summary List registered routes
+// @Description Introspect the route table and return every endpoint with its middleware chain and the access it requires, so security reviews can verify coverage without reading code (super admin only)
+// @Tags Admin Routes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} RegisteredRoutesResponse "Routes retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Router /api/v1/admin/routes [get]
+func GetRegisteredRoutes(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusOK).JSON(RegisteredRoutesResponse{
+		Success: true,
+		Message: "Routes retrieved successfully",
+		Data:    describeRoutes(c.App()),
+	})
+}
+
+// describeRoutes lists the app's endpoints with the middleware that runs before each handler.
+// Group middleware is registered as separate "use" routes, so it is matched back to the
+// endpoints by path prefix. Access comes from AccessPolicy and annotated middleware.
+func describeRoutes(app *fiber.App) []RouteDTO {
+	all := app.GetRoutes()
+	endpoints := app.GetRoutes(true)
+
+	// Endpoints are a subsequence of all routes; whatever is left over is middleware
+	var uses []fiber.Route
+	j := 0
+	for _, route := range all {
+		if j < len(endpoints) && sameRoute(route, endpoints[j]) {
+			j++
+			continue
+		}
+		uses = append(uses, route)
+	}
+
+	routes := make([]RouteDTO, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint.Method == fiber.MethodHead || len(endpoint.Handlers) == 0 {
+			continue // HEAD is registered automatically for every GET
+		}
+
+		var chain []fiber.Handler
+		for _, use := range uses {
+			if use.Method == endpoint.Method && pathHasPrefix(endpoint.Path, use.Path) {
+				chain = append(chain, use.Handlers...)
+			}
+		}
+		chain = append(chain, endpoint.Handlers...)
+
+		dto := RouteDTO{
+			Method:     endpoint.Method,
+			Path:       endpoint.Path,
+			Handler:    middleware.HandlerName(chain[len(chain)-1]),
+			Middleware: []string{},
+		}
+		var requirements []string
+		for i, h := range chain {
+			if i < len(chain)-1 {
+				dto.Middleware = append(dto.Middleware, middleware.HandlerName(h))
+			}
+			if requirement, ok := middleware.Requirement(h); ok {
+				requirements = append(requirements, requirement)
+			}
+		}
+		if rule, ok := middleware.PolicyFor(endpoint.Method, endpoint.Path); ok {
+			requirements = append(requirements, rule.Require)
+		}
+		dto.Access = middleware.StrongestRequirement(requirements)
+		routes = append(routes, dto)
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+func sameRoute(a, b fiber.Route) bool {
+	if a.Method != b.Method || a.Path != b.Path || len(a.Handlers) != len(b.Handlers) {
+		return false
+	}
+	for i := range a.Handlers {
+		if reflect.ValueOf(a.Handlers[i]).Pointer() != reflect.ValueOf(b.Handlers[i]).Pointer() {
+			return false
+		}
+	}
+	return true
+}
+
+func pathHasPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRegisteredRoutes_ReportsAccess(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/routes", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var response RegisteredRoutesResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+
+	access := make(map[string]string)
+	for _, route := range response.Data {
+		assert.NotEqual(t, fiber.MethodHead, route.Method)
+		access[route.Method+" "+route.Path] = route.Access
+	}
+
+	assert.Equal(t, "public", access["POST /api/v1/auth/login"])
+	assert.Equal(t, "user", access["GET /api/v1/auth/sessions"])
+	assert.Equal(t, "admin", access["GET /api/v1/users/:id"]) // Group middleware
+	assert.Equal(t, "super_admin", access["DELETE /api/v1/admin/users/:id"])
+	assert.Equal(t, "super_admin", access["GET /api/v1/admin/routes"])
+	assert.Equal(t, "provider_token", access["POST /api/v1/gate-commands/:id/callback"])
+}
+
+func TestGetRegisteredRoutes_RegularAdminForbidden(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	_, token := createNotificationTestAdmin(t)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/routes", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetSandboxSMS godoc
+// @Summary List sandbox text messages
+// @Description Retrieve the text messages captured instead of sent in sandbox mode (SANDBOX_MODE), newest first, so end-to-end tests can read the codes sent to their phones. Only the latest 100 messages are kept, in memory of the instance that sent them (super admin only)
+// @Tags Admin Sandbox
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param phone query string false "Only messages sent to this phone number"
+// @Success 200 {object} SandboxSMSResponse "Sandbox messages retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid phone number format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "Sandbox mode is not enabled"
+// @Router /api/v1/admin/sandbox/sms [get]
+func GetSandboxSMS(c *fiber.Ctx) error {
+	if !services.SandboxEnabled() {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Sandbox mode is not enabled",
+		})
+	}
+
+	phone := c.Query("phone")
+	if phone != "" {
+		normalized, err := phonenumber.Normalize(phone)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid phone number format",
+			})
+		}
+		phone = normalized
+	}
+
+	messages := services.SandboxOutbox().Messages(phone)
+	data := make([]SandboxSMSDTO, 0, len(messages))
+	for _, message := range messages {
+		data = append(data, SandboxSMSDTO{Phone: message.Phone, Message: message.Message, SentAt: message.SentAt})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SandboxSMSResponse{
+		Success: true,
+		Message: "Sandbox messages retrieved successfully",
+		Data:    data,
+	})
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandbox_GateCommandSimulated(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Sandbox.Enabled = true
+
+	// The provider lists the user's gates but must not be asked to move a barrier
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/locations" {
+			json.NewEncoder(w).Encode([]services.LocationResponse{
+				{ID: 1, Title: "Building 1", Gates: []services.GateResponse{{ID: 7, Title: "Gate", LocationID: 1}}},
+			})
+			return
+		}
+		t.Errorf("provider called in sandbox mode: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer provider.Close()
+	config.AppConfig().ThirdPartyAPIURL = provider.URL
+
+	_, token := createGateCommandTestUser(t, "+77771234567")
+	req := httptest.NewRequest("PUT", "/api/v1/locations/7/open", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "true", resp.Header.Get(middleware.SandboxHeader))
+
+	var response GateActionResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	assert.True(t, response.Data.Status)
+	assert.True(t, response.Data.Sandbox)
+
+	var cmd models.GateCommand
+	assert.NoError(t, db.DB.First(&cmd, "id = ?", response.Data.CommandID).Error)
+	assert.True(t, cmd.Sandbox)
+	assert.Equal(t, models.GateCommandConfirmed, cmd.Status)
+}
+
+func TestSandbox_SMSCaptured(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status, _ := mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/sandbox/sms", nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+
+	config.AppConfig().Sandbox.Enabled = true
+	assert.NoError(t, services.SendSMS(context.Background(), "+77771234567", "Your code is 123456"))
+	assert.NoError(t, services.SendSMS(context.Background(), "+77777654321", "Your code is 654321"))
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/sandbox/sms", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/sandbox/sms?phone=%2B77771234567", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	messages := result["data"].([]interface{})
+	assert.NotEmpty(t, messages)
+	latest := messages[0].(map[string]interface{})
+	assert.Equal(t, "+77771234567", latest["phone"])
+	assert.Equal(t, "Your code is 123456", latest["message"])
+}
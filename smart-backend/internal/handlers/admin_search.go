@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// adminSearchMinLength is the shortest query the admin search accepts
+const adminSearchMinLength = 2
+
+// GetAdminSearch godoc
+// @Summary Search the admin panel
+// @Description Search users (ID, full phone number, last 4 digits or email), locations and gates (ID, title, address or description, from a cached provider list), and for super admins and auditors also admin accounts (ID or username) and audit logs (resource ID, admin or action), in one call. Results are typed and ranked: exact matches first, then prefix, phone suffix and substring matches, then audit log entries. If the provider cannot be reached and no locations are cached, locations and gates are left out and the response carries a warning (requires admin authentication)
+// @Tags Admin Search
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search query (at least 2 characters)"
+// @Param limit query int false "Maximum number of results (max 50)" default(20)
+// @Success 200 {object} AdminSearchResponse "Search results"
+// @Failure 400 {object} APIResponse "Query too short"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/search [get]
+func GetAdminSearch(c *fiber.Ctx) error {
+	q := strings.TrimSpace(c.Query("q"))
+	if utf8.RuneCountInString(q) < adminSearchMinLength {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Search query must be at least 2 characters long",
+		})
+	}
+
+	limit := c.QueryInt("limit", 20)
+	if limit < 1 || limit > 50 {
+		limit = 20
+	}
+
+	role, _ := c.Locals("admin_role").(string)
+	search := services.AdminSearch{
+		Query:      q,
+		IncludeAll: role == models.RoleSuper || role == models.RoleAuditor,
+		Client:     services.NewThirdPartyClient().WithContext(c.UserContext()),
+	}
+	results, err := search.Run(limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to search",
+		})
+	}
+
+	data := make([]SearchResultDTO, len(results))
+	for i, result := range results {
+		data[i] = SearchResultDTO{
+			Type:     result.Type,
+			ID:       result.ID,
+			Title:    result.Title,
+			Subtitle: result.Subtitle,
+			Score:    result.Score,
+		}
+	}
+
+	response := AdminSearchResponse{
+		Success: true,
+		Message: "Search completed successfully",
+		Data:    data,
+	}
+	if err := search.LocationError(); err != nil {
+		response.Warning = "Locations and gates could not be searched: " + err.Error()
+	}
+	return c.Status(fiber.StatusOK).JSON(response)
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func searchResults(t *testing.T, app *fiber.App, role, q string) (int, []map[string]interface{}, map[string]interface{}) {
+	status, result := mergeRequest(t, app, role, "GET", "/api/v1/admin/search?q="+q, nil)
+	var results []map[string]interface{}
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, entry := range data {
+			results = append(results, entry.(map[string]interface{}))
+		}
+	}
+	return status, results, result
+}
+
+func TestAdminSearch_RanksTypedResults(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.Locations().Invalidate()
+	defer services.Locations().Invalidate()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]services.LocationResponse{{
+			ID: 7, Title: "Riverside Park", Address: "12 River St",
+			Gates: []services.GateResponse{{ID: 70, Title: "North gate", LocationID: 7}},
+		}})
+	}))
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	user := models.User{Phone: "+77771234567", Email: "resident@example.com", Password: "password123"}
+	db.DB.Create(&user)
+	db.DB.Create(&models.Admin{Username: "riverside-ops", Password: "password123", Role: models.RoleRegular})
+
+	// A super admin gets admin accounts and locations, exact and prefix matches first
+	status, results, _ := searchResults(t, app, models.RoleSuper, "riverside")
+	assert.Equal(t, fiber.StatusOK, status)
+	types := map[string]int{}
+	for _, result := range results {
+		types[result["type"].(string)]++
+		assert.Equal(t, float64(80), result["score"])
+	}
+	assert.Equal(t, map[string]int{"admin": 1, "location": 1}, types)
+
+	status, results, _ = searchResults(t, app, models.RoleSuper, "north")
+	assert.Equal(t, fiber.StatusOK, status)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, "gate", results[0]["type"])
+		assert.Equal(t, "Riverside Park", results[0]["subtitle"])
+	}
+
+	// Users are found by full number, last digits or email
+	for _, q := range []string{"%2B77771234567", "4567", "resident@example.com"} {
+		status, results, _ = searchResults(t, app, models.RoleRegular, q)
+		assert.Equal(t, fiber.StatusOK, status)
+		if assert.NotEmpty(t, results, q) {
+			assert.Equal(t, "user", results[0]["type"])
+			assert.Equal(t, user.ID.String(), results[0]["id"])
+		}
+	}
+
+	// Regular admins don't see admin accounts or audit logs
+	status, results, _ = searchResults(t, app, models.RoleRegular, "riverside")
+	assert.Equal(t, fiber.StatusOK, status)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, "location", results[0]["type"])
+	}
+
+	status, _, _ = searchResults(t, app, models.RoleRegular, "r")
+	assert.Equal(t, fiber.StatusBadRequest, status)
+}
+
+func TestAdminSearch_WarnsWhenLocationsUnavailable(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.Locations().Invalidate()
+	defer services.Locations().Invalidate()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	status, results, result := searchResults(t, app, models.RoleRegular, "gate")
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, results)
+	assert.Contains(t, result["warning"], "Locations and gates could not be searched")
+}
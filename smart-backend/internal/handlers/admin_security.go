@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// GetSecurityDenials godoc
+// @Summary List permission denials
+// @Description Retrieve requests refused with 403, newest first, with the caller, the access rule of the route and the reason: super admin routes called by regular admins, admins accessing other admins' records, auditors attempting changes, API keys without the route's scope, gate operations with impersonation tokens, gate commands for gates not assigned to the user and invalid link signatures. Denials are kept for SECURITY_DENIAL_RETENTION (super admin only)
+// @Tags Admin Security
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param actor_type query string false "Filter by caller type (admin, user, api_key, anonymous)"
+// @Param actor_id query string false "Filter by admin, user or API key ID (UUID)"
+// @Param reason query string false "Filter by reason (super_admin_required, not_owner, auditor_read_only, api_key_scope, impersonation, gate_not_assigned, invalid_signature, unknown_requirement, forbidden)"
+// @Param route query string false "Filter by access rule pattern, e.g. /api/v1/admins/:id"
+// @Param ip query string false "Filter by client IP address"
+// @Param since query string false "Only denials at or after this time (RFC3339)"
+// @Success 200 {object} SecurityDenialsResponse "Security denials retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid filter"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/security/denials [get]
+func GetSecurityDenials(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	query := db.DB.WithContext(c.UserContext()).Model(&models.SecurityDenial{})
+	for param, column := range map[string]string{"actor_type": "actor_type", "reason": "reason", "route": "route", "ip": "ip_address"} {
+		if value := c.Query(param); value != "" {
+			query = query.Where(column+" = ?", value)
+		}
+	}
+	if value := c.Query("actor_id"); value != "" {
+		actorID, err := uuid.Parse(value)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid actor_id",
+			})
+		}
+		query = query.Where("actor_id = ?", actorID)
+	}
+	if value := c.Query("since"); value != "" {
+		since, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid since, use RFC3339",
+			})
+		}
+		query = query.Where("created_at >= ?", since)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve security denials",
+		})
+	}
+
+	var denials []models.SecurityDenial
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&denials).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve security denials",
+		})
+	}
+
+	dtos := make([]SecurityDenialDTO, len(denials))
+	for i, denial := range denials {
+		dtos[i] = SecurityDenialDTO{
+			ID:        denial.ID,
+			ActorType: denial.ActorType,
+			ActorID:   denial.ActorID,
+			ActorName: denial.ActorName,
+			ActorRole: denial.ActorRole,
+			Method:    denial.Method,
+			Route:     denial.Route,
+			Path:      denial.Path,
+			Reason:    denial.Reason,
+			Message:   denial.Message,
+			IPAddress: denial.IPAddress,
+			UserAgent: denial.UserAgent,
+			CreatedAt: denial.CreatedAt,
+		}
+	}
+
+	lastPage := int((total + int64(limit) - 1) / int64(limit))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SecurityDenialsResponse{
+		Success: true,
+		Message: "Security denials retrieved successfully",
+		Data:    dtos,
+		Pagination: PaginationMeta{
+			Total:       int(total),
+			PerPage:     limit,
+			CurrentPage: page,
+			LastPage:    lastPage,
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityDenials_RecordsRefusedRequests(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status, _ := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/audit-logs", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+	status, _ = mergeRequest(t, app, models.RoleAuditor, "POST", "/api/v1/admin/webhook-secrets/outbound/rotate", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/security/denials", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	denials := result["data"].([]interface{})
+	assert.Len(t, denials, 2)
+
+	auditor := denials[0].(map[string]interface{})
+	assert.Equal(t, models.DenialAuditorReadOnly, auditor["reason"])
+	assert.Equal(t, models.RoleAuditor, auditor["actor_role"])
+	assert.Equal(t, "POST", auditor["method"])
+
+	regular := denials[1].(map[string]interface{})
+	assert.Equal(t, models.DenialSuperAdminRequired, regular["reason"])
+	assert.Equal(t, models.DenialActorAdmin, regular["actor_type"])
+	assert.Equal(t, models.RoleRegular, regular["actor_role"])
+	assert.Equal(t, "/api/v1/admin/audit-logs/*", regular["route"])
+	assert.NotEmpty(t, regular["actor_id"])
+	assert.NotEmpty(t, regular["message"])
+
+	// Filters
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/security/denials?reason=super_admin_required", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"].([]interface{}), 1)
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/security/denials?actor_id="+regular["actor_id"].(string), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"].([]interface{}), 1)
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/security/denials?since="+time.Now().Add(time.Hour).UTC().Format(time.RFC3339), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["data"])
+	assert.Equal(t, float64(0), result["pagination"].(map[string]interface{})["total"])
+
+	status, _ = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/security/denials?since=yesterday", nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/security/denials?actor_id=nope", nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+}
+
+func TestSecurityDenials_SuperAdminOnly(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status, _ := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/security/denials", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	// The refused listing is itself recorded
+	status, result := mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/security/denials?route=/api/v1/admin/security/denials", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"].([]interface{}), 1)
+}
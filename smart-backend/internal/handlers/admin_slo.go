@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetSLOSummary godoc
+// @Summary Get SLO compliance
+// @Description Retrieve each service level objective (SLO_OBJECTIVES) with its compliance and remaining error budget over the rolling SLO_WINDOW. A request is good when it is answered within the latency target without a 5xx. Counts are per instance and start over on restart; the slo_* Prometheus series are meant for alerting across instances (super admin only)
+// @Tags Admin SLO
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SLOSummaryResponse "SLO summary retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Router /api/v1/admin/slo [get]
+func GetSLOSummary(c *fiber.Ctx) error {
+	cfg := config.AppConfig().SLO
+
+	summary := make([]SLOStatusDTO, 0, len(cfg.Objectives))
+	for _, objective := range cfg.Objectives {
+		status := services.SLOs().Status(objective, cfg.Window)
+		summary = append(summary, SLOStatusDTO{
+			Name:                 objective.Name,
+			Method:               objective.Method,
+			Path:                 objective.Path,
+			LatencyTarget:        objective.Latency.String(),
+			Target:               objective.Target,
+			Window:               cfg.Window.String(),
+			Requests:             status.Requests,
+			GoodRequests:         status.Good,
+			Compliance:           status.Compliance,
+			ErrorBudgetRemaining: status.ErrorBudgetRemaining,
+			Met:                  status.Met,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SLOSummaryResponse{
+		Success: true,
+		Message: "SLO summary retrieved successfully",
+		Data:    summary,
+	})
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSLOSummary_TracksMatchingRequests(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().SLO = config.SLOConfig{
+		Window: time.Hour,
+		Objectives: []config.SLOObjective{
+			{Name: "test_contacts", Method: fiber.MethodGet, Path: "/api/v1/contacts", Latency: time.Minute, Target: 99},
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/contacts", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	}
+
+	status, _ := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/slo", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/slo", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	summary := result["data"].([]interface{})
+	assert.Len(t, summary, 1)
+	slo := summary[0].(map[string]interface{})
+	assert.Equal(t, "test_contacts", slo["name"])
+	assert.Equal(t, float64(3), slo["requests"])
+	assert.Equal(t, float64(3), slo["good_requests"])
+	assert.Equal(t, float64(100), slo["compliance"])
+	assert.Equal(t, true, slo["met"])
+}
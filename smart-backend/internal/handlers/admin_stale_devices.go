@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetStaleDevices godoc
+// @Summary Stale device report
+// @Description Dry-run report of the device sessions the stale_device_purge job deletes: sessions not used for JWT_STALE_DEVICE_AFTER, or for older_than to preview another retention. Lists the least recently used ones first. Review it before setting JWT_STALE_DEVICE_PURGE=true (super admin only)
+// @Tags Admin User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param older_than query string false "Retention to preview as a Go duration, e.g. 2160h (defaults to JWT_STALE_DEVICE_AFTER)"
+// @Param limit query int false "Stale sessions to list (default 20, max 100)"
+// @Success 200 {object} StaleDeviceReportResponse "Stale device report generated successfully"
+// @Failure 400 {object} APIResponse "Invalid older_than"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/stale-devices [get]
+func GetStaleDevices(c *fiber.Ctx) error {
+	staleAfter := config.AppConfig().JWT.StaleDeviceAfter
+	if value := c.Query("older_than"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid older_than. Use a positive duration such as 2160h",
+			})
+		}
+		staleAfter = parsed
+	}
+	limit := c.QueryInt("limit", 20)
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	report, err := services.StaleDevices(time.Now().Add(-staleAfter), limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to generate stale device report",
+		})
+	}
+
+	devices := make([]StaleDeviceDTO, len(report.Devices))
+	for i, session := range report.Devices {
+		devices[i] = StaleDeviceDTO{
+			ID:         session.ID,
+			UserID:     session.UserID,
+			DeviceID:   session.DeviceID,
+			UserAgent:  session.UserAgent,
+			Active:     session.IsActive(),
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(StaleDeviceReportResponse{
+		Success: true,
+		Message: "Stale device report generated successfully",
+		Data: StaleDeviceReportDTO{
+			StaleAfter:   staleAfter.String(),
+			Cutoff:       report.Cutoff,
+			PurgeEnabled: config.AppConfig().JWT.StaleDevicePurge,
+			Total:        report.Total,
+			Active:       report.Active,
+			Users:        report.Users,
+			Devices:      devices,
+		},
+	})
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"math"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetUsageRollup godoc
+// @Summary Monthly usage per organization
+// @Description Retrieve the monthly usage rollup (API calls, gate operations, SMS sent, active users) of every organization for billing (super admin only)
+// @Tags Admin Usage
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param month query string false "UTC month in YYYY-MM format (defaults to the current month)"
+// @Success 200 {object} UsageRollupResponse "Usage rollup retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid month format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/usage [get]
+func GetUsageRollup(c *fiber.Ctx) error {
+	month := c.Query("month", time.Now().UTC().Format("2006-01"))
+	if _, err := time.Parse("2006-01", month); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid month format. Use YYYY-MM",
+		})
+	}
+
+	// Include this instance's buffered usage in the numbers
+	services.Meter().Flush()
+
+	rollups, err := services.UsageRollups(month)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve usage",
+		})
+	}
+
+	data := make([]UsageRollupDTO, 0, len(rollups))
+	for _, r := range rollups {
+		data = append(data, UsageRollupDTO{
+			OrgID:          r.OrgID,
+			Month:          r.Month,
+			APICalls:       r.Metrics[models.UsageAPICalls],
+			GateOperations: r.Metrics[models.UsageGateOperations],
+			SMSSent:        r.Metrics[models.UsageSMSSent],
+			ActiveUsers:    r.Metrics[models.UsageActiveUsers],
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(UsageRollupResponse{
+		Success: true,
+		Message: "Usage rollup retrieved successfully",
+		Data:    data,
+	})
+}
+
+// GetProviderUsage godoc
+// @Summary Third-party API usage against the monthly quota
+// @Description Retrieve this organization's calls to the gate provider (and the migration provider while one is configured) in a month, against THIRD_PARTY_MONTHLY_QUOTA and THIRD_PARTY_MIRROR_MONTHLY_QUOTA. Admins are warned in the notification center when usage crosses 80% and 95% of a quota (super admin only)
+// @Tags Admin Usage
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param month query string false "UTC month in YYYY-MM format (defaults to the current month)"
+// @Success 200 {object} ProviderUsageResponse "Provider usage retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid month format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/provider-usage [get]
+func GetProviderUsage(c *fiber.Ctx) error {
+	month := c.Query("month", time.Now().UTC().Format("2006-01"))
+	if _, err := time.Parse("2006-01", month); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid month format. Use YYYY-MM",
+		})
+	}
+
+	// Include this instance's buffered usage in the numbers
+	services.Meter().Flush()
+
+	usages, err := services.ProviderUsages(month)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve provider usage",
+		})
+	}
+
+	data := make([]ProviderUsageDTO, 0, len(usages))
+	for _, u := range usages {
+		data = append(data, ProviderUsageDTO{
+			Provider: u.Provider,
+			Month:    u.Month,
+			Calls:    u.Calls,
+			Quota:    u.Quota,
+			Percent:  math.Round(u.Percent*10) / 10,
+			Warned:   u.Warned,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ProviderUsageResponse{
+		Success: true,
+		Message: "Provider usage retrieved successfully",
+		Data:    data,
+	})
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func getUsageRollup(t *testing.T, app *fiber.App, query string) (int, UsageRollupResponse) {
+	admin := models.Admin{ID: uuid.New(), Username: "usage-admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/usage"+query, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var response UsageRollupResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	return resp.StatusCode, response
+}
+
+func TestGetUsageRollup_CountsCallsAndActiveUsers(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.Meter().Flush() // Drop usage buffered by earlier tests
+	db.DB.Exec("DELETE FROM usage_counters")
+	db.DB.Exec("DELETE FROM usage_active_users")
+	createSessionTestUser()
+
+	token, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	getSessions(t, app, token)
+	getSessions(t, app, token)
+
+	status, response := getUsageRollup(t, app, "")
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, response.Data, 1)
+	assert.Equal(t, time.Now().UTC().Format("2006-01"), response.Data[0].Month)
+	assert.GreaterOrEqual(t, response.Data[0].APICalls, int64(3))
+	assert.Equal(t, int64(1), response.Data[0].ActiveUsers)
+
+	// A month without usage is empty
+	status, response = getUsageRollup(t, app, "?month=2001-01")
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, response.Data)
+}
+
+func TestGetUsageRollup_InvalidMonth(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status, _ := getUsageRollup(t, app, "?month=October")
+	assert.Equal(t, fiber.StatusBadRequest, status)
+}
+
+func TestProviderUsage_WarnsOncePerThreshold(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.Meter().Flush() // Drop usage buffered by earlier tests
+	db.DB.Exec("DELETE FROM usage_counters")
+	config.AppConfig().ThirdParty.MonthlyQuota = 100
+	defer func() { config.AppConfig().ThirdParty.MonthlyQuota = 0 }()
+
+	now := time.Now().UTC()
+	counter := models.UsageCounter{OrgID: config.AppConfig().Metering.OrgID, Metric: models.UsageProviderCalls, Day: now.Format("2006-01-02"), Value: 79}
+	db.DB.Create(&counter)
+	warnings, err := services.CheckProviderQuotas(now)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	// Jumping past both thresholds records both but announces only the higher one
+	db.DB.Model(&counter).Update("value", 96)
+	warnings, err = services.CheckProviderQuotas(now)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 2)
+	warnings, err = services.CheckProviderQuotas(now)
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	var notifications []models.AdminNotification
+	db.DB.Where("category = ?", models.NotificationProvider).Find(&notifications)
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, models.SeverityCritical, notifications[0].Severity)
+	assert.Contains(t, notifications[0].Title, "95%")
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/provider-usage", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data := result["data"].([]interface{})
+	assert.Len(t, data, 1)
+	usage := data[0].(map[string]interface{})
+	assert.Equal(t, services.ProviderPrimary, usage["provider"])
+	assert.Equal(t, float64(96), usage["calls"])
+	assert.Equal(t, float64(96), usage["percent"])
+	assert.Equal(t, []interface{}{float64(80), float64(95)}, usage["warned"])
+
+	status, _ = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/provider-usage?month=2026-13", nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/provider-usage", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"log/slog"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetWebhookSecrets godoc
+// @Summary List webhook secrets
+// @Description Retrieve the webhook secrets in use: per scope, the current one and, during a rotation, the previous one with its expiry. The secrets themselves are never shown again after rotation, only their last characters. Scopes still using the secret set in the configuration (WEBHOOK_SIGNING_SECRET, GATE_PROVIDER_CALLBACK_TOKEN) are not listed (super admin only)
+// @Tags Admin Webhook Secrets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} WebhookSecretsResponse "Webhook secrets retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/webhook-secrets [get]
+func GetWebhookSecrets(c *fiber.Ctx) error {
+	secrets, err := services.ListWebhookSecrets()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve webhook secrets",
+		})
+	}
+
+	data := make([]WebhookSecretDTO, 0, len(secrets))
+	for _, secret := range secrets {
+		data = append(data, toWebhookSecretDTO(secret))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(WebhookSecretsResponse{
+		Success: true,
+		Message: "Webhook secrets retrieved successfully",
+		Data:    data,
+	})
+}
+
+// RotateWebhookSecret godoc
+// @Summary Rotate a webhook secret
+// @Description Generate a new secret for a scope: "outbound" signs the webhooks the API sends (X-Ololo-Signature), "provider_callback" is the token the gate provider sends in X-Provider-Token. The replaced secret stays valid for WEBHOOK_SECRET_OVERLAP, so deliveries keep working while the other side switches over; an older previous secret expires right away. The new secret is only returned in this response (super admin only)
+// @Tags Admin Webhook Secrets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param scope path string true "outbound or provider_callback"
+// @Success 201 {object} RotatedWebhookSecretResponse "Webhook secret rotated successfully"
+// @Failure 400 {object} APIResponse "Unknown scope"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/webhook-secrets/{scope}/rotate [post]
+func RotateWebhookSecret(c *fiber.Ctx) error {
+	scope := c.Params("scope")
+	if !slices.Contains(services.WebhookSecretScopes, scope) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Unknown scope: " + scope + ". Use: " + strings.Join(services.WebhookSecretScopes, ", "),
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	rotated, secret, err := services.RotateWebhookSecret(scope, adminUsername)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[WEBHOOK_SECRETS] Failed to rotate secret", "scope", scope, "error", err)
+		middleware.RecordAudit(c, "rotate_webhook_secret", "webhook_secret", scope, "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to rotate webhook secret",
+		})
+	}
+	middleware.RecordAudit(c, "rotate_webhook_secret", "webhook_secret", scope, "success", "")
+	slog.InfoContext(c.UserContext(), "[WEBHOOK_SECRETS] Secret rotated", "admin", adminUsername, "scope", scope)
+
+	return c.Status(fiber.StatusCreated).JSON(RotatedWebhookSecretResponse{
+		Success: true,
+		Message: "Webhook secret rotated successfully. Store the secret now, it cannot be shown again",
+		Data:    RotatedWebhookSecretDTO{WebhookSecretDTO: toWebhookSecretDTO(rotated), Secret: secret},
+	})
+}
+
+// toWebhookSecretDTO converts a webhook secret to its DTO, without the secret
+func toWebhookSecretDTO(secret models.WebhookSecret) WebhookSecretDTO {
+	return WebhookSecretDTO{
+		ID:        secret.ID,
+		Scope:     secret.Scope,
+		Hint:      secret.Hint,
+		Current:   secret.ExpiresAt == nil,
+		CreatedBy: secret.CreatedBy,
+		ExpiresAt: secret.ExpiresAt,
+		CreatedAt: secret.CreatedAt,
+	}
+}
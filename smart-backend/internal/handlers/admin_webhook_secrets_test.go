@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// callbackStatus posts a provider callback for an unknown command: 404 once the token is accepted
+func callbackStatus(t *testing.T, app *fiber.App, token string) int {
+	body, _ := json.Marshal(GateCommandCallbackRequest{Status: models.GateCommandConfirmed})
+	req := httptest.NewRequest("POST", "/api/v1/gate-commands/"+uuid.NewString()+"/callback", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Provider-Token", token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.StatusCode
+}
+
+func TestWebhookSecrets_ProviderCallbackRotationOverlaps(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Gates.ProviderCallbackToken = "provider-secret"
+	config.AppConfig().Webhooks.SecretOverlap = time.Hour
+
+	path := "/api/v1/admin/webhook-secrets/provider_callback/rotate"
+	status, _ := mergeRequest(t, app, models.RoleRegular, "POST", path, nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/webhook-secrets/inbound/rotate", nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	// The configured token stays valid next to the new secret
+	status, result := mergeRequest(t, app, models.RoleSuper, "POST", path, nil)
+	assert.Equal(t, fiber.StatusCreated, status)
+	first := result["data"].(map[string]interface{})["secret"].(string)
+	assert.True(t, strings.HasPrefix(first, "whsec_"))
+	assert.Equal(t, fiber.StatusNotFound, callbackStatus(t, app, "provider-secret"))
+	assert.Equal(t, fiber.StatusNotFound, callbackStatus(t, app, first))
+	assert.Equal(t, fiber.StatusUnauthorized, callbackStatus(t, app, "wrong"))
+
+	// Rotating again expires the configured token; only two secrets are active
+	status, result = mergeRequest(t, app, models.RoleSuper, "POST", path, nil)
+	assert.Equal(t, fiber.StatusCreated, status)
+	second := result["data"].(map[string]interface{})["secret"].(string)
+	assert.Equal(t, fiber.StatusUnauthorized, callbackStatus(t, app, "provider-secret"))
+	assert.Equal(t, fiber.StatusNotFound, callbackStatus(t, app, first))
+	assert.Equal(t, fiber.StatusNotFound, callbackStatus(t, app, second))
+
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/webhook-secrets", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	secrets := result["data"].([]interface{})
+	assert.Len(t, secrets, 2)
+	current := secrets[0].(map[string]interface{})
+	assert.Equal(t, true, current["current"])
+	assert.Equal(t, second[len(second)-4:], current["hint"])
+	assert.NotContains(t, current, "secret")
+	assert.NotNil(t, secrets[1].(map[string]interface{})["expires_at"])
+}
+
+func TestWebhookSecrets_OutboundSignedWithBothSecrets(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Webhooks.SecretOverlap = time.Hour
+
+	signature, err := services.SignWebhook([]byte(`{}`), time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, signature)
+
+	status, _ := mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/webhook-secrets/outbound/rotate", nil)
+	assert.Equal(t, fiber.StatusCreated, status)
+	signature, _ = services.SignWebhook([]byte(`{}`), time.Now())
+	assert.Equal(t, 1, strings.Count(signature, "v1="))
+
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/webhook-secrets/outbound/rotate", nil)
+	assert.Equal(t, fiber.StatusCreated, status)
+	signature, _ = services.SignWebhook([]byte(`{}`), time.Now())
+	assert.Equal(t, 2, strings.Count(signature, "v1="))
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func auditedRequest(t *testing.T, app *fiber.App, method, path string, body interface{}) int {
+	admin := models.Admin{ID: uuid.New(), Username: "audit-admin-" + uuid.NewString()[:8], Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.StatusCode
+}
+
+func TestAuditCapture_CreateUserRedactsPassword(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status := auditedRequest(t, app, "POST", "/api/v1/users", map[string]interface{}{
+		"phone":    "+77775550001",
+		"password": "s3cret-password",
+	})
+	assert.Equal(t, fiber.StatusCreated, status)
+
+	var auditLog models.AdminAuditLog
+	assert.NoError(t, db.DB.Where("action = ?", "create_user").First(&auditLog).Error)
+	assert.Equal(t, "success", auditLog.Status)
+	assert.NotContains(t, auditLog.Details, "s3cret-password")
+
+	var details map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(auditLog.Details), &details))
+	request := details["request"].(map[string]interface{})
+	assert.Equal(t, "+77775550001", request["phone"])
+	assert.Equal(t, utils.RedactedValue, request["password"])
+	assert.Equal(t, true, details["response"].(map[string]interface{})["success"])
+	assert.Equal(t, float64(fiber.StatusCreated), details["status_code"])
+}
+
+func TestAuditCapture_UpdateUserRecordsFailure(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	user := models.User{ID: uuid.New(), Phone: "+77775550002", Password: "hashed"}
+	db.DB.Create(&user)
+	other := models.User{ID: uuid.New(), Phone: "+77775550003", Password: "hashed"}
+	db.DB.Create(&other)
+
+	status := auditedRequest(t, app, "PATCH", "/api/v1/users/"+user.ID.String(), map[string]interface{}{
+		"phone":    other.Phone,
+		"password": "another-secret",
+	})
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	// The handler rejected the request before recording an action, so a generic entry is written
+	var auditLog models.AdminAuditLog
+	assert.NoError(t, db.DB.Where("action = ?", "api_request").First(&auditLog).Error)
+	assert.Equal(t, "failed", auditLog.Status)
+	assert.Equal(t, "PATCH /api/v1/users/:id", auditLog.ResourceID)
+	assert.NotContains(t, auditLog.Details, "another-secret")
+	assert.Contains(t, auditLog.Details, "Phone number is already in use")
+}
+
+func TestAuditCapture_ReadRoutesAreNotCaptured(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status := auditedRequest(t, app, "GET", "/api/v1/users", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	var count int64
+	db.DB.Model(&models.AdminAuditLog{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+}
@@ -1,28 +1,39 @@
 package handlers
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
 	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/middleware"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/pii"
+	"ololo-gate/internal/services"
 	"ololo-gate/internal/utils"
-	"regexp"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
 // RegisterRequest defines the structure for registration requests
 // @name RegisterRequest
 type RegisterRequest struct {
-	Phone    string `json:"phone" validate:"required" example:"+77771234567"`
-	Password string `json:"password" validate:"required,min=6" example:"password123"`
+	Phone      string `json:"phone" validate:"required" example:"+77771234567"`
+	Password   string `json:"password" validate:"required,min=6" example:"password123"`
+	InviteCode string `json:"invite_code" example:"7KQ2MXR4PA"` // Required unless USER_OPEN_REGISTRATION is enabled
 }
 
-// LoginRequest defines the structure for login requests
+// LoginRequest defines the structure for login requests. Either phone or email identifies the user.
 // @name LoginRequest
 type LoginRequest struct {
-	Phone    string `json:"phone" validate:"required" example:"+77771234567"`
-	Password string `json:"password" validate:"required" example:"password123"`
+	Phone         string `json:"phone" example:"+77771234567"`
+	Email         string `json:"email" example:"staff@example.com"` // Alternative to phone; only verified emails can log in
+	Password      string `json:"password" validate:"required" example:"password123"`
+	TrustedDevice bool   `json:"trusted_device" example:"false"` // "Remember me": use the long refresh expiry (JWT_TRUSTED_REFRESH_EXPIRY)
 }
 
 // RefreshRequest defines the structure for token refresh requests
@@ -34,24 +45,23 @@ type RefreshRequest struct {
 // APIResponse is a standard response format
 // @name APIResponse
 type APIResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data,omitempty"`
+	Success       bool                      `json:"success"`
+	Message       string                    `json:"message"`
+	Data          interface{}               `json:"data,omitempty"`
+	RetryStrategy *middleware.RetryStrategy `json:"retry_strategy,omitempty"` // Set on throttled (429) and degraded (503) responses
 }
 
-// Phone number validation regex (E.164 format)
-var phoneRegex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
-
 // Register godoc
 // @Summary Register a new user
-// @Description Register a new user account with phone number and password (E.164 format required)
+// @Description Register a new user account with phone number, password and an invite code generated by an admin. The user is assigned the invite code's locations and gates. The phone is stored in E.164 form; national formats of PHONE_DEFAULT_REGION are accepted. With OTP_REGISTRATION_ENABLED the user is created unverified (registration_status unverified) and a code is sent to the phone by SMS; the registration is completed, and the locations assigned, by POST /auth/verify-otp
 // @Tags User Authentication
 // @Accept json
 // @Produce json
 // @Param request body RegisterRequest true "Registration details"
-// @Success 201 {object} RegisterResponse "User registered successfully"
-// @Failure 400 {object} APIResponse "Invalid request body or validation error"
+// @Success 201 {object} RegisterResponse "User registered successfully (warning set if assigning the invite code's locations failed)"
+// @Failure 400 {object} APIResponse "Invalid request body, validation error, missing, invalid or expired invite code, or number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)"
 // @Failure 409 {object} APIResponse "User with this phone number already exists"
+// @Failure 429 {object} APIResponse "Too many registration codes requested for the phone or client IP (see Retry-After)"
 // @Failure 500 {object} APIResponse "Internal server error"
 // @Router /api/v1/auth/register [post]
 func Register(c *fiber.Ctx) error {
@@ -65,13 +75,18 @@ func Register(c *fiber.Ctx) error {
 		})
 	}
 
-	// Validate phone number format
-	if !phoneRegex.MatchString(req.Phone) {
+	// Validate the phone number and convert it to canonical E.164 form
+	normalizedPhone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
 			Success: false,
 			Message: "Invalid phone number format. Use international format (e.g., +77771234567)",
 		})
 	}
+	req.Phone = normalizedPhone
+	if err := phonenumber.CheckAllowed(req.Phone); err != nil {
+		return respondPhoneCountryNotAllowed(c)
+	}
 
 	// Validate password length
 	if len(req.Password) < 6 {
@@ -81,9 +96,16 @@ func Register(c *fiber.Ctx) error {
 		})
 	}
 
-	// Check if user already exists
-	var existingUser models.User
-	if err := db.DB.Where("phone = ?", req.Phone).First(&existingUser).Error; err == nil {
+	// A registration never confirmed with its code does not hold the number once the code expired
+	otpRegistration := config.AppConfig().OTP.RegistrationEnabled
+	if otpRegistration {
+		if err := services.ReleaseUnverifiedPhone(c.UserContext(), req.Phone); err != nil {
+			slog.WarnContext(c.UserContext(), "Failed to release unconfirmed registration", "phone", req.Phone, "error", err)
+		}
+	}
+
+	// Check if user already exists (as a primary or secondary number)
+	if services.PhoneInUse(req.Phone) {
 		return c.Status(fiber.StatusConflict).JSON(APIResponse{
 			Success: false,
 			Message: "User with this phone number already exists",
@@ -96,14 +118,125 @@ func Register(c *fiber.Ctx) error {
 		Password: req.Password,
 	}
 
-	if err := db.DB.Create(&user).Error; err != nil {
+	// Registration is vetted through admin-generated invite codes, or by an admin approving
+	// the registration when approval is enabled, unless open registration is enabled
+	if req.InviteCode == "" {
+		switch {
+		case config.AppConfig().Users.RegistrationApproval:
+			user.RegistrationStatus = models.RegistrationPending
+		case !config.AppConfig().Users.OpenRegistration:
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "An invite code is required to register",
+			})
+		}
+	}
+
+	// With OTP_REGISTRATION_ENABLED the user stays unverified until the code sent to the phone is
+	// confirmed; the approval status is decided then
+	if otpRegistration {
+		if err := services.CheckRegistrationOTPRateLimits(req.Phone, c.IP()); err != nil {
+			var limitErr *services.OTPRateLimitError
+			if errors.As(err, &limitErr) {
+				return c.Status(fiber.StatusTooManyRequests).JSON(APIResponse{
+					Success:       false,
+					Message:       "Too many codes requested. Try again later.",
+					RetryStrategy: middleware.Backoff(c, middleware.RetryStrategyFixed, limitErr.RetryAfter),
+				})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to create user",
+			})
+		}
+		user.RegistrationStatus = models.RegistrationUnverified
+	}
+
+	var invite models.InviteCode
+	if req.InviteCode != "" {
+		invite, err = services.RegisterWithInviteCode(&user, req.InviteCode)
+	} else {
+		err = db.DB.WithContext(c.UserContext()).Create(&user).Error
+	}
+	if errors.Is(err, services.ErrInviteCodeInvalid) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid or expired invite code",
+		})
+	}
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to create user",
 		})
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(APIResponse{
+	services.RecordUserHistory(user.ID, models.UserHistoryRegistered, services.HistoryActorSelf,
+		services.FieldChanges{}.DiffUser(services.UserSnapshot{}, services.SnapshotUser(user)))
+
+	events.Publish(events.UserCreated, map[string]interface{}{
+		"user_id": user.ID,
+		"phone":   user.Phone,
+	})
+
+	if user.RegistrationStatus == models.RegistrationUnverified {
+		// The user can ask for another code with POST /auth/verify-otp/resend
+		if err := services.SendRegistrationOTP(c.UserContext(), user.Phone, c.IP()); err != nil {
+			slog.WarnContext(c.UserContext(), "Failed to send registration code", "phone", user.Phone, "error", err)
+		}
+		return c.Status(fiber.StatusCreated).JSON(APIResponse{
+			Success: true,
+			Message: "Registration received. Confirm it with the code sent to your phone",
+			Data: fiber.Map{
+				"id":                  user.ID,
+				"phone":               user.Phone,
+				"registration_status": user.RegistrationStatus,
+			},
+		})
+	}
+
+	return finishRegistration(c, user, invite, fiber.StatusCreated)
+}
+
+// finishRegistration completes a registration that needs no phone confirmation, or whose phone was
+// just confirmed: it asks admins to approve pending registrations, or assigns the invite code's
+// locations and gates, and responds with the given status
+func finishRegistration(c *fiber.Ctx, user models.User, invite models.InviteCode, status int) error {
+	if user.RegistrationStatus == models.RegistrationPending {
+		services.NotifyAdmins(models.SeverityInfo, "registrations", "Registration awaiting approval",
+			fmt.Sprintf("%s registered and is waiting for approval in /api/v1/admin/registrations", user.Phone))
+		return c.Status(status).JSON(APIResponse{
+			Success: true,
+			Message: "Registration received and awaiting approval",
+			Data: fiber.Map{
+				"id":                  user.ID,
+				"phone":               user.Phone,
+				"registration_status": user.RegistrationStatus,
+			},
+		})
+	}
+
+	// Keep the user if the assignment fails, as for users created by admins
+	if len(invite.Locations) > 0 {
+		assignment := services.InviteAssignment(invite)
+		if err := services.AssignAllPhones(services.NewThirdPartyClient().WithContext(c.UserContext()), user, assignment); err != nil {
+			slog.WarnContext(c.UserContext(), "Failed to assign invite code locations/gates to user",
+				"invite_code_id", invite.ID, "phone", user.Phone, "error", err)
+			return c.Status(status).JSON(fiber.Map{
+				"success": true,
+				"message": "User registered successfully but location assignment failed. Please contact an administrator.",
+				"warning": "Third-party API assignment error: " + err.Error(),
+				"data": fiber.Map{
+					"id":    user.ID,
+					"phone": user.Phone,
+				},
+			})
+		}
+		services.RecordUserHistory(user.ID, models.UserHistoryAssigned, services.HistoryActorSelf,
+			services.FieldChanges{}.Set("assignments", nil, assignment))
+	}
+
+	return c.Status(status).JSON(APIResponse{
 		Success: true,
 		Message: "User registered successfully",
 		Data: fiber.Map{
@@ -115,15 +248,18 @@ func Register(c *fiber.Ctx) error {
 
 // Login godoc
 // @Summary User login
-// @Description Authenticate user with phone and password, returns access and refresh tokens. Supports device-based token invalidation.
+// @Description Authenticate user with phone or verified email and password, returns access and refresh tokens. The identifier used is recorded in the idt token claim. Supports device-based token invalidation. Past the user's session limit (JWT_MAX_SESSIONS or the user's max_sessions) the oldest sessions are logged out.
 // @Tags User Authentication
 // @Accept json
 // @Produce json
-// @Param device_id query string false "Unique device identifier (optional - if provided and different from current device, previous tokens will be invalidated)"
+// @Param device_id query string false "Unique device identifier (optional - a new login on the same device replaces that device's previous session)"
+// @Param client_type query string false "Client type selecting token lifetimes (e.g. kiosk, resident); unknown types use the default lifetimes"
 // @Param request body LoginRequest true "Login credentials"
 // @Success 200 {object} LoginResponse "Login successful with tokens"
-// @Failure 400 {object} APIResponse "Invalid request body or phone format"
+// @Failure 400 {object} APIResponse "Invalid request body, phone or email format"
 // @Failure 401 {object} APIResponse "Invalid credentials"
+// @Failure 403 {object} PasswordExpiredResponse "Password expired (code password_expired, change it with POST /auth/change-password), or registration awaiting approval, rejected or not confirmed by SMS"
+// @Failure 429 {object} APIResponse "Too many failed logins for this account or client IP, retry after Retry-After"
 // @Failure 500 {object} APIResponse "Internal server error"
 // @Router /api/v1/auth/login [post]
 func Login(c *fiber.Ctx) error {
@@ -137,37 +273,76 @@ func Login(c *fiber.Ctx) error {
 		})
 	}
 
-	// Validate phone number format
-	if !phoneRegex.MatchString(req.Phone) {
-		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
-			Success: false,
-			Message: "Invalid phone number format",
-		})
+	account := userLoginAccount(req)
+	if locked, err := loginLocked(c, account); locked {
+		return err
 	}
 
-	// Find user by phone
-	var user models.User
-	log.Printf("[LOGIN] Attempting login with phone: %s", req.Phone)
-	if err := db.DB.Where("phone = ?", req.Phone).First(&user).Error; err != nil {
-		log.Printf("[LOGIN_FAILED] Phone %s not found in database: %v", req.Phone, err)
-		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
-			Success: false,
-			Message: "Invalid credentials",
-		})
+	user, identifier, ok, err := findLoginUser(c, req)
+	if !ok {
+		if c.Response().StatusCode() == fiber.StatusUnauthorized {
+			return loginFailed(c, account, err)
+		}
+		return err
 	}
 
-	log.Printf("[LOGIN] User found in database: ID=%s, Phone=%s, DB token_version=%d", user.ID, user.Phone, user.TokenVersion)
+	slog.DebugContext(c.UserContext(), "[LOGIN] User found in database", "user_id", user.ID, "phone", user.Phone, "token_version", user.TokenVersion)
 
 	// Verify password
 	if !user.CheckPassword(req.Password) {
-		log.Printf("[LOGIN_FAILED] Password verification FAILED for user ID=%s (phone=%s). Provided password hash did not match stored hash.", user.ID, user.Phone)
-		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+		slog.WarnContext(c.UserContext(), "[LOGIN_FAILED] Password verification failed", "user_id", user.ID, "phone", user.Phone)
+		return loginFailed(c, account, c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
 			Success: false,
 			Message: "Invalid credentials",
+		}))
+	}
+
+	slog.DebugContext(c.UserContext(), "[LOGIN] Password verified", "user_id", user.ID, "phone", user.Phone)
+	services.LoginAttempts().Succeed(account)
+
+	// Tenants requiring password rotation set a maximum password age; an expired password
+	// must be changed with POST /auth/change-password before logging in
+	if user.PasswordExpired(config.AppConfig().Users.PasswordMaxAge) {
+		changedAt := user.PasswordLastChanged()
+		slog.WarnContext(c.UserContext(), "[LOGIN_FAILED] Password expired", "user_id", user.ID, "changed_at", changedAt.Format(time.RFC3339))
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Your password has expired and must be changed",
+			Data: PasswordExpiredData{
+				Code:              PasswordExpiredCode,
+				PasswordChangedAt: changedAt,
+			},
 		})
 	}
 
-	log.Printf("[LOGIN] Password verification SUCCESSFUL for user ID=%s (phone=%s)", user.ID, user.Phone)
+	return completeLogin(c, user, identifier, req.TrustedDevice)
+}
+
+// completeLogin finishes a login once the user has proven who they are (password or one-time code):
+// it refuses registrations that are not approved or not confirmed, records the device, creates the
+// session (logging out the oldest ones past the session limit) and responds with the tokens
+func completeLogin(c *fiber.Ctx, user models.User, identifier string, trustedDevice bool) error {
+	// Registrations waiting for or refused by an admin, or not confirmed by SMS, cannot log in
+	switch user.RegistrationStatus {
+	case models.RegistrationPending:
+		slog.WarnContext(c.UserContext(), "[LOGIN_FAILED] Registration is awaiting approval", "user_id", user.ID)
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Your registration is awaiting approval",
+		})
+	case models.RegistrationRejected:
+		slog.WarnContext(c.UserContext(), "[LOGIN_FAILED] Registration was rejected", "user_id", user.ID)
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Your registration was not approved",
+		})
+	case models.RegistrationUnverified:
+		slog.WarnContext(c.UserContext(), "[LOGIN_FAILED] Phone is not confirmed yet", "user_id", user.ID)
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Confirm your phone number with the code sent by SMS before logging in",
+		})
+	}
 
 	// Get optional device_id from query parameters (accept both deviceId and device_id)
 	deviceID := c.Query("deviceId")
@@ -175,68 +350,56 @@ func Login(c *fiber.Ctx) error {
 		deviceID = c.Query("device_id")
 	}
 
-	log.Printf("[LOGIN] Device tracking: provided=%s, current=%s", deviceID, user.CurrentDeviceID)
+	slog.DebugContext(c.UserContext(), "[LOGIN] Device tracking", "device_id", deviceID, "current_device_id", user.CurrentDeviceID)
 
-	// Determine if device changed and whether to increment token version
-	// Device change logic:
-	// - If device_id not provided: increment token_version (backward compatibility, old behavior)
-	// - If device_id provided and different from current: increment token_version (new device)
-	// - If device_id provided and same as current: don't increment (same device, reuse session)
-	deviceChanged := false
+	// Each device gets its own session, so logging in on one device no longer logs out the others.
+	// Re-logging in on the same device replaces that device's previous session.
 	previousDeviceID := user.CurrentDeviceID
-
-	if deviceID == "" {
-		// No device_id provided: increment token_version for backward compatibility
-		deviceChanged = true
-		log.Printf("[LOGIN] No device_id provided. Will increment token_version for backward compatibility.")
-	} else {
-		// Device_id provided: check if it's different from current
-		deviceChanged = user.CurrentDeviceID != "" && user.CurrentDeviceID != deviceID
-		if deviceChanged {
-			log.Printf("[LOGIN] Device CHANGED: old=%s, new=%s. Will increment token_version.", user.CurrentDeviceID, deviceID)
-		} else {
-			log.Printf("[LOGIN] Device SAME: %s. Will NOT increment token_version.", deviceID)
+	if deviceID != "" && deviceID != previousDeviceID {
+		user.CurrentDeviceID = deviceID
+		// Column updates bypass the encrypted serializer, so encrypt the device ID here
+		encryptedDeviceID, err := pii.Encrypt(deviceID)
+		if err == nil {
+			err = db.DB.WithContext(c.UserContext()).Model(&user).Update("current_device_id", encryptedDeviceID).Error
+		}
+		if err != nil {
+			slog.ErrorContext(c.UserContext(), "[LOGIN_FAILED] Failed to update current device", "error", err)
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to update user device",
+			})
+		}
+		if previousDeviceID != "" {
+			slog.InfoContext(c.UserContext(), "[DEVICE_CHANGE] User logged in on a new device",
+				"phone", user.Phone, "user_id", user.ID, "device_id", deviceID, "previous_device_id", previousDeviceID)
 		}
 	}
 
-	// Increment token version only if device changed
-	oldTokenVersion := user.TokenVersion
-	if deviceChanged {
-		user.TokenVersion++
-		log.Printf("[LOGIN] Token version incremented: %d -> %d", oldTokenVersion, user.TokenVersion)
-	}
-
-	// Update current device ID if device_id provided
-	if deviceID != "" {
-		user.CurrentDeviceID = deviceID
-	}
+	// Optional client type (e.g. "kiosk") selects token lifetimes; unknown types use the defaults
+	clientType := c.Query("client_type")
+	accessExpiry, refreshExpiry := config.AppConfig().JWT.LoginExpiry(clientType, trustedDevice)
 
-	if err := db.DB.Save(&user).Error; err != nil {
-		log.Printf("[LOGIN_FAILED] Failed to save user token_version update: %v", err)
+	session, err := services.CreateSession(c.UserContext(), user.ID, deviceID, c.IP(), c.Get("User-Agent"), refreshExpiry, trustedDevice)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
-			Message: "Failed to update user token version",
+			Message: "Failed to create session",
 		})
 	}
+	services.EnforceSessionLimit(c.UserContext(), user, session.ID)
 
-	// Log device change event for audit purposes (backend only, not sent to client)
-	if deviceChanged && deviceID != "" {
-		log.Printf("[DEVICE_CHANGE] User: %s (ID: %s) changed device from '%s' to '%s'",
-			user.Phone, user.ID, previousDeviceID, deviceID)
-	}
-
-	// Generate tokens with current token version
-	tokens, err := utils.GenerateTokens(user.ID, user.Phone, user.TokenVersion)
+	// Generate tokens bound to the new session
+	tokens, err := utils.GenerateTokensWithOptions(user.ID, user.Phone, user.TokenVersion, utils.TokenOptions{SessionID: session.ID, ClientType: clientType, Trusted: trustedDevice, DeviceID: deviceID, Identifier: identifier})
 	if err != nil {
-		log.Printf("[LOGIN_FAILED] Failed to generate tokens: %v", err)
+		slog.ErrorContext(c.UserContext(), "[LOGIN_FAILED] Failed to generate tokens", "error", err)
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to generate tokens",
 		})
 	}
 
-	log.Printf("[LOGIN_SUCCESS] Login successful for user ID=%s (phone=%s). Tokens generated with token_version=%d, device_id=%s",
-		user.ID, user.Phone, user.TokenVersion, deviceID)
+	slog.InfoContext(c.UserContext(), "[LOGIN_SUCCESS] Login successful",
+		"user_id", user.ID, "phone", user.Phone, "token_version", user.TokenVersion, "session_id", session.ID, "device_id", deviceID, "trusted", trustedDevice)
 
 	return c.Status(fiber.StatusOK).JSON(APIResponse{
 		Success: true,
@@ -246,18 +409,65 @@ func Login(c *fiber.Ctx) error {
 			"phone":               user.Phone,
 			"access_token":        tokens.AccessToken,
 			"refresh_token":       tokens.RefreshToken,
-			"access_expires_in":   int64(config.AppConfig.JWT.AccessExpiry.Seconds()),
-			"refresh_expires_in":  int64(config.AppConfig.JWT.RefreshExpiry.Seconds()),
+			"access_expires_in":   int64(accessExpiry.Seconds()),
+			"refresh_expires_in":  int64(refreshExpiry.Seconds()),
 		},
 	})
 }
 
+// findLoginUser looks the user up by the email or phone in the login request and returns the
+// identifier type used. When it returns false, the error response has already been written.
+func findLoginUser(c *fiber.Ctx, req LoginRequest) (models.User, string, bool, error) {
+	if req.Email != "" {
+		email, err := services.ParseEmail(req.Email)
+		if err != nil {
+			return models.User{}, "", false, c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid email format",
+			})
+		}
+
+		slog.DebugContext(c.UserContext(), "[LOGIN] Attempting login with email")
+		user, err := services.FindUserByEmail(email)
+		if err != nil {
+			slog.WarnContext(c.UserContext(), "[LOGIN_FAILED] Verified email not found in database", "error", err)
+			return user, "", false, c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid credentials",
+			})
+		}
+		return user, utils.IdentifierEmail, true, nil
+	}
+
+	// Validate the phone number and convert it to canonical E.164 form
+	phone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
+		return models.User{}, "", false, c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number format",
+		})
+	}
+
+	// Find user by any of their verified phone numbers
+	slog.DebugContext(c.UserContext(), "[LOGIN] Attempting login with phone", "phone", phone)
+	user, err := services.FindUserByPhone(phone)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "[LOGIN_FAILED] Phone not found in database", "phone", phone, "error", err)
+		return user, "", false, c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid credentials",
+		})
+	}
+	return user, utils.IdentifierPhone, true, nil
+}
+
 // RefreshToken godoc
 // @Summary Refresh access token
 // @Description Exchange a valid refresh token for a new access token
 // @Tags User Authentication
 // @Accept json
 // @Produce json
+// @Param X-Device-ID header string false "Device the refresh token was issued to (required for device-bound tokens unless JWT_REQUIRE_DEVICE_HEADER is off)"
 // @Param request body RefreshRequest true "Refresh token"
 // @Success 200 {object} RefreshResponse "New access token generated"
 // @Failure 400 {object} APIResponse "Invalid request body"
@@ -280,50 +490,77 @@ func RefreshToken(c *fiber.Ctx) error {
 	claims, err := utils.ValidateToken(req.RefreshToken, utils.RefreshToken)
 
 	if err != nil {
-		log.Printf("[REFRESH_FAILED] Invalid or expired refresh token: %v", err)
+		slog.WarnContext(c.UserContext(), "[REFRESH_FAILED] Invalid or expired refresh token", "error", err)
 		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
 			Success: false,
 			Message: "Invalid or expired refresh token",
 		})
 	}
 
-	log.Printf("[REFRESH] Refresh token received. User ID from claims: %s, Claims token_version: %d", claims.UserID, claims.TokenVersion)
+	slog.DebugContext(c.UserContext(), "[REFRESH] Refresh token received", "user_id", claims.UserID, "claims_version", claims.TokenVersion)
 
 	// Verify token version against database
 	var user models.User
-	if err := db.DB.Select("id", "token_version").First(&user, claims.UserID).Error; err != nil {
-		log.Printf("[REFRESH_FAILED] User ID %s not found in database: %v", claims.UserID, err)
+	if err := db.DB.WithContext(c.UserContext()).Select("id", "token_version").First(&user, claims.UserID).Error; err != nil {
+		slog.WarnContext(c.UserContext(), "[REFRESH_FAILED] User not found in database", "user_id", claims.UserID, "error", err)
 		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
 			Success: false,
 			Message: "User not found",
 		})
 	}
 
-	log.Printf("[REFRESH] User found in database: User ID=%s, DB token_version=%d, Claims token_version=%d", user.ID, user.TokenVersion, claims.TokenVersion)
+	slog.DebugContext(c.UserContext(), "[REFRESH] User found in database",
+		"user_id", user.ID, "db_version", user.TokenVersion, "claims_version", claims.TokenVersion)
 
 	// Check if token version matches
 	if user.TokenVersion != claims.TokenVersion {
-		log.Printf("[REFRESH_FAILED] Token version mismatch for user ID %s. Token invalidated. Claims version=%d, DB version=%d",
-			user.ID, claims.TokenVersion, user.TokenVersion)
+		slog.WarnContext(c.UserContext(), "[REFRESH_FAILED] Token version mismatch, token invalidated",
+			"user_id", user.ID, "claims_version", claims.TokenVersion, "db_version", user.TokenVersion)
 		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
 			Success: false,
 			Message: "Token has been invalidated. Please login again.",
 		})
 	}
 
-	log.Printf("[REFRESH] Token version match verified. Generating new access token for user ID=%s", user.ID)
+	// Check that this device's session has not been logged out
+	var session *models.UserSession
+	if claims.SessionID != uuid.Nil {
+		session, err = services.ValidateSession(claims.SessionID, claims.UserID)
+		if err != nil {
+			slog.WarnContext(c.UserContext(), "[REFRESH_FAILED] Session is no longer active", "session_id", claims.SessionID, "user_id", user.ID)
+			return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+				Success: false,
+				Message: "Session has been revoked. Please login again.",
+			})
+		}
+	}
+
+	// The refresh token must be used from the device it was issued to
+	if err := services.VerifySessionDevice(session, claims.DeviceID, c.Get("X-Device-ID")); err != nil {
+		slog.WarnContext(c.UserContext(), "[REFRESH_FAILED] Refresh token presented from another device",
+			"user_id", user.ID, "device_id", claims.DeviceID, "presented_device_id", c.Get("X-Device-ID"))
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Token is not valid for this device",
+		})
+	}
+	if session != nil {
+		services.TouchSession(session.ID)
+	}
+
+	slog.DebugContext(c.UserContext(), "[REFRESH] Token version match verified, generating new access token", "user_id", user.ID)
 
 	// Generate new access token from refresh token
 	accessToken, err := utils.RefreshAccessToken(req.RefreshToken)
 	if err != nil {
-		log.Printf("[REFRESH_FAILED] Failed to generate new access token: %v", err)
+		slog.ErrorContext(c.UserContext(), "[REFRESH_FAILED] Failed to generate new access token", "error", err)
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to generate access token",
 		})
 	}
 
-	log.Printf("[REFRESH_SUCCESS] New access token generated for user ID=%s with token_version=%d", user.ID, user.TokenVersion)
+	slog.InfoContext(c.UserContext(), "[REFRESH_SUCCESS] New access token generated", "user_id", user.ID, "token_version", user.TokenVersion)
 
 	return c.Status(fiber.StatusOK).JSON(APIResponse{
 		Success: true,
@@ -340,9 +577,9 @@ func RefreshToken(c *fiber.Ctx) error {
 // @Tags User Authentication
 // @Accept json
 // @Produce json
-// @Param phone query string true "Phone number in E.164 format (e.g., +77771234567)"
+// @Param phone query string true "Phone number, international (e.g., +77771234567) or national format"
 // @Success 200 {object} PhoneAvailabilityResponse "Phone availability check result"
-// @Failure 400 {object} APIResponse "Invalid phone number format"
+// @Failure 400 {object} APIResponse "Invalid phone number format, or number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)"
 // @Router /api/v1/auth/check-phone [get]
 func CheckPhoneAvailability(c *fiber.Ctx) error {
 	phone := c.Query("phone")
@@ -355,18 +592,22 @@ func CheckPhoneAvailability(c *fiber.Ctx) error {
 		})
 	}
 
-	// Validate phone number format
-	if !phoneRegex.MatchString(phone) {
+	// Validate the phone number and convert it to canonical E.164 form
+	normalizedPhone, err := phonenumber.Normalize(phone)
+	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
 			Success: false,
 			Message: "Invalid phone number format. Use international format (e.g., +77771234567)",
 		})
 	}
+	phone = normalizedPhone
+	if err := phonenumber.CheckAllowed(phone); err != nil {
+		return respondPhoneCountryNotAllowed(c)
+	}
 
-	// Check if phone number exists
-	var existingUser models.User
+	// Check if phone number exists (as a primary or secondary number)
 	isAvailable := true
-	if err := db.DB.Where("phone = ?", phone).First(&existingUser).Error; err == nil {
+	if services.PhoneInUse(phone) {
 		// Phone number exists - not available
 		isAvailable = false
 	}
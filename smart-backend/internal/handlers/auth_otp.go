@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LoginOTPRequest defines the structure for requesting a login code
+// @name LoginOTPRequest
+type LoginOTPRequest struct {
+	Phone string `json:"phone" validate:"required" example:"+77771234567"`
+}
+
+// LoginOTPConfirmRequest defines the structure for logging in with a login code
+// @name LoginOTPConfirmRequest
+type LoginOTPConfirmRequest struct {
+	Phone         string `json:"phone" validate:"required" example:"+77771234567"`
+	Code          string `json:"code" validate:"required" example:"482913"`
+	TrustedDevice bool   `json:"trusted_device" example:"false"` // "Remember me": use the long refresh expiry (JWT_TRUSTED_REFRESH_EXPIRY)
+}
+
+// RequestLoginOTP godoc
+// @Summary Request a login code
+// @Description Send a one-time login code by SMS to a registered phone number, for logging in without a password. The response is the same whether or not the number has an account. Requesting a new code invalidates the previous one. Codes are rate limited per phone (OTP_RESEND_INTERVAL, OTP_PHONE_HOURLY) and per client IP (OTP_IP_HOURLY). Only available when OTP_LOGIN_ENABLED is set.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Param request body LoginOTPRequest true "Phone number"
+// @Success 200 {object} APIResponse "Code sent if the number is registered"
+// @Failure 400 {object} APIResponse "Invalid request body or phone number format"
+// @Failure 404 {object} APIResponse "Passwordless login is not enabled"
+// @Failure 429 {object} APIResponse "Too many codes requested (see Retry-After)"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/login-otp/request [post]
+func RequestLoginOTP(c *fiber.Ctx) error {
+	if !config.AppConfig().OTP.LoginEnabled {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Passwordless login is not enabled",
+		})
+	}
+
+	var req LoginOTPRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	phone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number format",
+		})
+	}
+
+	if err := services.RequestLoginOTP(c.UserContext(), phone, c.IP()); err != nil {
+		var limitErr *services.OTPRateLimitError
+		if errors.As(err, &limitErr) {
+			return c.Status(fiber.StatusTooManyRequests).JSON(APIResponse{
+				Success:       false,
+				Message:       "Too many codes requested. Try again later.",
+				RetryStrategy: middleware.Backoff(c, middleware.RetryStrategyFixed, limitErr.RetryAfter),
+			})
+		}
+		slog.WarnContext(c.UserContext(), "[LOGIN_OTP] Failed to send login code", "phone", phone, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to send login code",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "If this number is registered, a login code has been sent",
+	})
+}
+
+// ConfirmLoginOTP godoc
+// @Summary Log in with a login code
+// @Description Log in with a phone number and the one-time code sent to it by POST /auth/login-otp/request, returns access and refresh tokens like POST /auth/login. A code can be used once, and is invalidated after OTP_MAX_ATTEMPTS wrong guesses. Not affected by PASSWORD_MAX_AGE, as no password is used. Only available when OTP_LOGIN_ENABLED is set.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Param device_id query string false "Unique device identifier (optional - a new login on the same device replaces that device's previous session)"
+// @Param client_type query string false "Client type selecting token lifetimes (e.g. kiosk, resident); unknown types use the default lifetimes"
+// @Param request body LoginOTPConfirmRequest true "Phone number and code"
+// @Success 200 {object} LoginResponse "Login successful with tokens"
+// @Failure 400 {object} APIResponse "Invalid request body or phone number format"
+// @Failure 401 {object} APIResponse "Invalid or expired code"
+// @Failure 403 {object} APIResponse "Registration awaiting approval or rejected"
+// @Failure 404 {object} APIResponse "Passwordless login is not enabled"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/login-otp/confirm [post]
+func ConfirmLoginOTP(c *fiber.Ctx) error {
+	if !config.AppConfig().OTP.LoginEnabled {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Passwordless login is not enabled",
+		})
+	}
+
+	var req LoginOTPConfirmRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	phone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number format",
+		})
+	}
+
+	user, err := services.ConfirmLoginOTP(c.UserContext(), phone, req.Code)
+	if errors.Is(err, services.ErrOTPInvalid) {
+		slog.WarnContext(c.UserContext(), "[LOGIN_FAILED] Invalid or expired login code", "phone", phone)
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid or expired code",
+		})
+	}
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[LOGIN_OTP] Failed to check login code", "phone", phone, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to check login code",
+		})
+	}
+
+	slog.InfoContext(c.UserContext(), "[LOGIN] Login code verified", "user_id", user.ID, "phone", user.Phone)
+	return completeLogin(c, user, utils.IdentifierPhone, req.TrustedDevice)
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/tests"
+	"ololo-gate/internal/utils"
+	"regexp"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupLoginOTPTest(t *testing.T) (*fiber.App, *fakeSMSSender) {
+	app, sms, cleanup := setupOTPTestApp(func(otp *config.OTPConfig) { otp.LoginEnabled = true })
+	t.Cleanup(cleanup)
+	return app, sms
+}
+
+var otpCodePattern = regexp.MustCompile(`\b\d{6}\b`)
+
+func TestLoginOTP_RequestAndConfirm(t *testing.T) {
+	app, sms := setupLoginOTPTest(t)
+	defer tests.CleanupTestDB(t)
+	tests.CreateTestUser(t, "+77771234567", "testpassword123")
+
+	resp, err := tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/request", map[string]string{"phone": "+77771234567"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+	assert.Len(t, sms.messages["+77771234567"], 1)
+	code := otpCodePattern.FindString(sms.messages["+77771234567"][0])
+	assert.NotEmpty(t, code)
+
+	// A wrong code is rejected without using up the right one
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/confirm", map[string]string{"phone": "+77771234567", "code": "000000x"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/confirm", map[string]string{"phone": "+77771234567", "code": code}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+	data := tests.ParseJSONResponse(t, resp)["data"].(map[string]interface{})
+	claims, err := utils.ValidateToken(data["access_token"].(string), utils.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "+77771234567", claims.Phone)
+
+	// Codes are single-use
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/confirm", map[string]string{"phone": "+77771234567", "code": code}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+}
+
+func TestLoginOTP_RateLimitsArePerPurpose(t *testing.T) {
+	app, sms := setupLoginOTPTest(t)
+	config.AppConfig().OTP.PasswordResetEnabled = true
+	tests.CreateTestUser(t, "+77771234567", "testpassword123")
+
+	resp, err := tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/request", map[string]string{"phone": "+77771234567"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+	var login models.OTPCode
+	assert.NoError(t, db.DB.Where("purpose = ?", models.OTPPurposeLogin).First(&login).Error)
+	assert.NotNil(t, login.UserID)
+
+	// A login code does not hold back a password reset code within the resend interval
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/forgot-password", map[string]string{"phone": "+77771234567"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+	assert.Len(t, sms.messages["+77771234567"], 2)
+
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/request", map[string]string{"phone": "+77771234567"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusTooManyRequests, resp.Code)
+}
+
+func TestLoginOTP_WrongGuessesInvalidateCode(t *testing.T) {
+	app, sms := setupLoginOTPTest(t)
+	defer tests.CleanupTestDB(t)
+	tests.CreateTestUser(t, "+77771234567", "testpassword123")
+
+	tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/request", map[string]string{"phone": "+77771234567"}, nil)
+	code := otpCodePattern.FindString(sms.messages["+77771234567"][0])
+
+	for i := 0; i < 3; i++ {
+		resp, _ := tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/confirm", map[string]string{"phone": "+77771234567", "code": "wrong"}, nil)
+		assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+	}
+	resp, _ := tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/confirm", map[string]string{"phone": "+77771234567", "code": code}, nil)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+}
+
+func TestLoginOTP_UnknownPhoneAndRateLimit(t *testing.T) {
+	app, sms := setupLoginOTPTest(t)
+	defer tests.CleanupTestDB(t)
+
+	// Unknown numbers get the same response, but no SMS
+	resp, err := tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/request", map[string]string{"phone": "+77770000000"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+	assert.Empty(t, sms.messages)
+
+	// A second request within the resend interval is throttled
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/request", map[string]string{"phone": "+77770000000"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusTooManyRequests, resp.Code)
+}
+
+func TestLoginOTP_Disabled(t *testing.T) {
+	app, _ := setupLoginOTPTest(t)
+	defer tests.CleanupTestDB(t)
+	config.AppConfig().OTP.LoginEnabled = false
+
+	resp, err := tests.MakeRequest(app, "POST", "/api/v1/auth/login-otp/request", map[string]string{"phone": "+77771234567"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.Code)
+}
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordExpiredCode is the error code of logins refused because the password is older than PASSWORD_MAX_AGE
+const PasswordExpiredCode = "password_expired"
+
+// ChangePasswordRequest defines the structure for changing a user's own password
+// @name ChangePasswordRequest
+type ChangePasswordRequest struct {
+	Phone           string `json:"phone" example:"+77771234567"`
+	Email           string `json:"email" example:"staff@example.com"` // Alternative to phone; only verified emails can be used
+	CurrentPassword string `json:"current_password" validate:"required" example:"password123"`
+	NewPassword     string `json:"new_password" validate:"required" example:"newpassword456"`
+}
+
+// ChangePassword godoc
+// @Summary Change password
+// @Description Change the password with the current one. This is also how users whose password expired (login refused with code password_expired, see PASSWORD_MAX_AGE) set a new one, so it does not need an access token. All devices are logged out; log in again with the new password.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Param request body ChangePasswordRequest true "Login identifier, current and new password"
+// @Success 200 {object} APIResponse "Password changed"
+// @Failure 400 {object} APIResponse "Invalid request body, phone or email format, new password too short or unchanged"
+// @Failure 401 {object} APIResponse "Invalid credentials"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/change-password [post]
+func ChangePassword(c *fiber.Ctx) error {
+	var req ChangePasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	if len(req.NewPassword) < 6 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Password must be at least 6 characters long",
+		})
+	}
+
+	user, _, ok, err := findLoginUser(c, LoginRequest{Phone: req.Phone, Email: req.Email})
+	if !ok {
+		return err
+	}
+
+	if !user.CheckPassword(req.CurrentPassword) {
+		slog.WarnContext(c.UserContext(), "[PASSWORD_CHANGE_FAILED] Current password did not match", "user_id", user.ID)
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid credentials",
+		})
+	}
+
+	if user.CheckPassword(req.NewPassword) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "New password must be different from the current password",
+		})
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to hash password",
+		})
+	}
+
+	// Bumping the token version logs out every device
+	now := time.Now()
+	if err := db.DB.WithContext(c.UserContext()).Model(&user).Updates(map[string]interface{}{
+		"password":            string(hashedPassword),
+		"password_changed_at": now,
+		"token_version":       user.TokenVersion + 1,
+	}).Error; err != nil {
+		slog.ErrorContext(c.UserContext(), "[PASSWORD_CHANGE_FAILED] Failed to update password", "user_id", user.ID, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to change password",
+		})
+	}
+	services.RevokeAllSessions(c.UserContext(), user.ID)
+
+	// Passwords are never stored in the history, only that one was set
+	services.RecordUserHistory(user.ID, models.UserHistoryPasswordChanged, services.HistoryActorSelf,
+		services.FieldChanges{}.Set("password_changed", false, true))
+
+	slog.InfoContext(c.UserContext(), "[PASSWORD_CHANGE] Password changed", "user_id", user.ID)
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "Password changed. Log in with the new password.",
+	})
+}
+
+// ForgotPasswordRequest defines the structure for requesting a password reset code
+// @name ForgotPasswordRequest
+type ForgotPasswordRequest struct {
+	Phone string `json:"phone" validate:"required" example:"+77771234567"`
+}
+
+// ResetPasswordRequest defines the structure for setting a new password with a reset code
+// @name ResetPasswordRequest
+type ResetPasswordRequest struct {
+	Phone       string `json:"phone" validate:"required" example:"+77771234567"`
+	Code        string `json:"code" validate:"required" example:"482913"`
+	NewPassword string `json:"new_password" validate:"required" example:"newpassword456"`
+}
+
+// ForgotPassword godoc
+// @Summary Request a password reset code
+// @Description Send a one-time code by SMS to a registered phone number, for setting a new password at POST /auth/reset-password without the current one. The response is the same whether or not the number has an account. Requesting a new code invalidates the previous one. Codes are rate limited per phone (OTP_RESEND_INTERVAL, OTP_PHONE_HOURLY) and per client IP (OTP_IP_HOURLY). Only available when OTP_PASSWORD_RESET_ENABLED is set.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Param request body ForgotPasswordRequest true "Phone number"
+// @Success 200 {object} APIResponse "Code sent if the number is registered"
+// @Failure 400 {object} APIResponse "Invalid request body or phone number format"
+// @Failure 404 {object} APIResponse "Password reset is not enabled"
+// @Failure 429 {object} APIResponse "Too many codes requested (see Retry-After)"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/forgot-password [post]
+func ForgotPassword(c *fiber.Ctx) error {
+	if !config.AppConfig().OTP.PasswordResetEnabled {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Password reset is not enabled",
+		})
+	}
+
+	var req ForgotPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	phone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number format",
+		})
+	}
+
+	if err := services.RequestPasswordReset(c.UserContext(), phone, c.IP()); err != nil {
+		var limitErr *services.OTPRateLimitError
+		if errors.As(err, &limitErr) {
+			return c.Status(fiber.StatusTooManyRequests).JSON(APIResponse{
+				Success:       false,
+				Message:       "Too many codes requested. Try again later.",
+				RetryStrategy: middleware.Backoff(c, middleware.RetryStrategyFixed, limitErr.RetryAfter),
+			})
+		}
+		slog.WarnContext(c.UserContext(), "[PASSWORD_RESET] Failed to send password reset code", "phone", phone, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to send password reset code",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "If this number is registered, a password reset code has been sent",
+	})
+}
+
+// ResetPassword godoc
+// @Summary Reset a forgotten password
+// @Description Set a new password with the one-time code sent by SMS at POST /auth/forgot-password. All devices are logged out; log in again with the new password. A code can be used once, and is invalidated after OTP_MAX_ATTEMPTS wrong guesses. Only available when OTP_PASSWORD_RESET_ENABLED is set.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Param request body ResetPasswordRequest true "Phone number, code and new password"
+// @Success 200 {object} APIResponse "Password reset"
+// @Failure 400 {object} APIResponse "Invalid request body, phone number format or new password too short"
+// @Failure 401 {object} APIResponse "Invalid or expired code"
+// @Failure 404 {object} APIResponse "Password reset is not enabled"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/reset-password [post]
+func ResetPassword(c *fiber.Ctx) error {
+	if !config.AppConfig().OTP.PasswordResetEnabled {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Password reset is not enabled",
+		})
+	}
+
+	var req ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	if len(req.NewPassword) < 6 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Password must be at least 6 characters long",
+		})
+	}
+
+	phone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number format",
+		})
+	}
+
+	if _, err := services.ResetPassword(c.UserContext(), phone, req.Code, req.NewPassword); err != nil {
+		if errors.Is(err, services.ErrOTPInvalid) {
+			slog.WarnContext(c.UserContext(), "[PASSWORD_RESET] Invalid or expired password reset code", "phone", phone)
+			return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid or expired code",
+			})
+		}
+		slog.ErrorContext(c.UserContext(), "[PASSWORD_RESET] Failed to reset password", "phone", phone, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to reset password",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "Password reset. Log in with the new password.",
+	})
+}
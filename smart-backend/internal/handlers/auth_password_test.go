@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/tests"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupPasswordExpiryTest(t *testing.T) *fiber.App {
+	tests.SetupTestConfig()
+	tests.SetupTestDB(t)
+	config.AppConfig().Users.PasswordMaxAge = 90 * 24 * time.Hour
+
+	app := fiber.New()
+	app.Post("/login", Login)
+	app.Post("/change-password", ChangePassword)
+	return app
+}
+
+func TestLogin_ExpiredPasswordMustBeChanged(t *testing.T) {
+	app := setupPasswordExpiryTest(t)
+	defer tests.CleanupTestDB(t)
+	user := tests.CreateTestUser(t, "+77771234567", "testpassword123")
+	assert.NotNil(t, user.PasswordChangedAt)
+
+	credentials := map[string]string{"phone": "+77771234567", "password": "testpassword123"}
+	resp, err := tests.MakeRequest(app, "POST", "/login", credentials, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+
+	db.DB.Model(&models.User{}).Where("id = ?", user.ID).Update("password_changed_at", time.Now().Add(-91*24*time.Hour))
+
+	resp, err = tests.MakeRequest(app, "POST", "/login", credentials, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.Code)
+	data := tests.ParseJSONResponse(t, resp)["data"].(map[string]interface{})
+	assert.Equal(t, PasswordExpiredCode, data["code"])
+
+	// Reusing the expired password is refused
+	resp, err = tests.MakeRequest(app, "POST", "/change-password", map[string]string{
+		"phone": "+77771234567", "current_password": "testpassword123", "new_password": "testpassword123",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.Code)
+
+	resp, err = tests.MakeRequest(app, "POST", "/change-password", map[string]string{
+		"phone": "+77771234567", "current_password": "testpassword123", "new_password": "newpassword456",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+
+	var updated models.User
+	db.DB.First(&updated, "id = ?", user.ID)
+	assert.Equal(t, user.TokenVersion+1, updated.TokenVersion)
+	assert.False(t, updated.PasswordExpired(config.AppConfig().Users.PasswordMaxAge))
+
+	resp, err = tests.MakeRequest(app, "POST", "/login", map[string]string{"phone": "+77771234567", "password": "newpassword456"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+}
+
+func TestChangePassword_WrongCurrentPassword(t *testing.T) {
+	app := setupPasswordExpiryTest(t)
+	defer tests.CleanupTestDB(t)
+	tests.CreateTestUser(t, "+77771234567", "testpassword123")
+
+	resp, err := tests.MakeRequest(app, "POST", "/change-password", map[string]string{
+		"phone": "+77771234567", "current_password": "wrongpassword", "new_password": "newpassword456",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+}
+
+func TestPasswordExpired_FallsBackToCreatedAt(t *testing.T) {
+	user := models.User{CreatedAt: time.Now().Add(-100 * 24 * time.Hour)}
+	assert.True(t, user.PasswordExpired(90*24*time.Hour))
+	assert.False(t, user.PasswordExpired(0))
+}
+
+func setupPasswordResetTest(t *testing.T) (*fiber.App, *fakeSMSSender) {
+	app, sms, cleanup := setupOTPTestApp(func(otp *config.OTPConfig) { otp.PasswordResetEnabled = true })
+	t.Cleanup(cleanup)
+	return app, sms
+}
+
+func TestResetPassword_WithSMSCode(t *testing.T) {
+	app, sms := setupPasswordResetTest(t)
+	defer tests.CleanupTestDB(t)
+	user := tests.CreateTestUser(t, "+77771234567", "testpassword123")
+
+	resp, err := tests.MakeRequest(app, "POST", "/api/v1/auth/forgot-password", map[string]string{"phone": "+77771234567"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+	assert.Len(t, sms.messages["+77771234567"], 1)
+	code := otpCodePattern.FindString(sms.messages["+77771234567"][0])
+	assert.NotEmpty(t, code)
+
+	// A wrong code and a short password are rejected without using up the code
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/reset-password", map[string]string{
+		"phone": "+77771234567", "code": "000000x", "new_password": "newpassword456",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/reset-password", map[string]string{
+		"phone": "+77771234567", "code": code, "new_password": "short",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.Code)
+
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/reset-password", map[string]string{
+		"phone": "+77771234567", "code": code, "new_password": "newpassword456",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+
+	var updated models.User
+	db.DB.First(&updated, "id = ?", user.ID)
+	assert.Equal(t, user.TokenVersion+1, updated.TokenVersion)
+
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/login", map[string]string{"phone": "+77771234567", "password": "testpassword123"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/login", map[string]string{"phone": "+77771234567", "password": "newpassword456"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+
+	// Codes are single-use
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/reset-password", map[string]string{
+		"phone": "+77771234567", "code": code, "new_password": "otherpassword789",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+}
+
+func TestForgotPassword_UnknownPhoneNotTexted(t *testing.T) {
+	app, sms := setupPasswordResetTest(t)
+	defer tests.CleanupTestDB(t)
+
+	resp, err := tests.MakeRequest(app, "POST", "/api/v1/auth/forgot-password", map[string]string{"phone": "+77771234567"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.Code)
+	assert.Empty(t, sms.messages)
+
+	config.AppConfig().OTP.PasswordResetEnabled = false
+	resp, err = tests.MakeRequest(app, "POST", "/api/v1/auth/forgot-password", map[string]string{"phone": "+77771234567"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.Code)
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// VerifyRegistrationOTPRequest defines the structure for confirming a registration with its code
+// @name VerifyRegistrationOTPRequest
+type VerifyRegistrationOTPRequest struct {
+	Phone string `json:"phone" validate:"required" example:"+77771234567"`
+	Code  string `json:"code" validate:"required" example:"482913"`
+}
+
+// ResendRegistrationOTPRequest defines the structure for requesting another registration code
+// @name ResendRegistrationOTPRequest
+type ResendRegistrationOTPRequest struct {
+	Phone string `json:"phone" validate:"required" example:"+77771234567"`
+}
+
+// VerifyRegistrationOTP godoc
+// @Summary Confirm a registration
+// @Description Confirm the phone number of a registration with the one-time code sent to it by SMS at POST /auth/register. The registration is then approved, or awaits approval when USER_REGISTRATION_APPROVAL applies, and the invite code's locations and gates are assigned. A code can be used once, and is invalidated after OTP_MAX_ATTEMPTS wrong guesses. Only available when OTP_REGISTRATION_ENABLED is set.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Param request body VerifyRegistrationOTPRequest true "Phone number and code"
+// @Success 200 {object} RegisterResponse "Registration confirmed (warning set if assigning the invite code's locations failed)"
+// @Failure 400 {object} APIResponse "Invalid request body or phone number format"
+// @Failure 401 {object} APIResponse "Invalid or expired code"
+// @Failure 404 {object} APIResponse "Registration codes are not enabled"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/verify-otp [post]
+func VerifyRegistrationOTP(c *fiber.Ctx) error {
+	if !config.AppConfig().OTP.RegistrationEnabled {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Registration codes are not enabled",
+		})
+	}
+
+	var req VerifyRegistrationOTPRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	phone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number format",
+		})
+	}
+
+	user, err := services.VerifyRegistrationOTP(c.UserContext(), phone, req.Code)
+	if errors.Is(err, services.ErrOTPInvalid) {
+		slog.WarnContext(c.UserContext(), "[REGISTRATION_OTP] Invalid or expired registration code", "phone", phone)
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid or expired code",
+		})
+	}
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[REGISTRATION_OTP] Failed to check registration code", "phone", phone, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to check registration code",
+		})
+	}
+
+	// The invite code was counted at registration; its locations are assigned now
+	var invite models.InviteCode
+	if user.InviteCodeID != nil {
+		if err := db.DB.WithContext(c.UserContext()).First(&invite, "id = ?", *user.InviteCodeID).Error; err != nil {
+			slog.WarnContext(c.UserContext(), "Invite code of user not found", "invite_code_id", *user.InviteCodeID, "user_id", user.ID, "error", err)
+		}
+	}
+
+	return finishRegistration(c, user, invite, fiber.StatusOK)
+}
+
+// ResendRegistrationOTP godoc
+// @Summary Resend a registration code
+// @Description Send a new one-time code by SMS to a registration waiting for confirmation, for example when the first code expired. The response is the same whether or not the number has such a registration. Requesting a new code invalidates the previous one. Codes are rate limited per phone (OTP_RESEND_INTERVAL, OTP_PHONE_HOURLY) and per client IP (OTP_IP_HOURLY). Only available when OTP_REGISTRATION_ENABLED is set.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Param request body ResendRegistrationOTPRequest true "Phone number"
+// @Success 200 {object} APIResponse "Code sent if the number has a registration to confirm"
+// @Failure 400 {object} APIResponse "Invalid request body or phone number format"
+// @Failure 404 {object} APIResponse "Registration codes are not enabled"
+// @Failure 429 {object} APIResponse "Too many codes requested (see Retry-After)"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/verify-otp/resend [post]
+func ResendRegistrationOTP(c *fiber.Ctx) error {
+	if !config.AppConfig().OTP.RegistrationEnabled {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Registration codes are not enabled",
+		})
+	}
+
+	var req ResendRegistrationOTPRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	phone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number format",
+		})
+	}
+
+	if err := services.SendRegistrationOTP(c.UserContext(), phone, c.IP()); err != nil {
+		var limitErr *services.OTPRateLimitError
+		if errors.As(err, &limitErr) {
+			return c.Status(fiber.StatusTooManyRequests).JSON(APIResponse{
+				Success:       false,
+				Message:       "Too many codes requested. Try again later.",
+				RetryStrategy: middleware.Backoff(c, middleware.RetryStrategyFixed, limitErr.RetryAfter),
+			})
+		}
+		slog.WarnContext(c.UserContext(), "[REGISTRATION_OTP] Failed to send registration code", "phone", phone, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to send registration code",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "If this number has a registration to confirm, a new code has been sent",
+	})
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRegistrationOTPTest(t *testing.T) (*fiber.App, *fakeSMSSender) {
+	app, sms, cleanup := setupOTPTestApp(func(otp *config.OTPConfig) { otp.RegistrationEnabled = true })
+	t.Cleanup(cleanup)
+	config.AppConfig().Users.OpenRegistration = true
+	return app, sms
+}
+
+func postPublic(t *testing.T, app *fiber.App, path string, body interface{}) int {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest("POST", path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.StatusCode
+}
+
+func TestRegistrationOTP_VerifyActivatesUser(t *testing.T) {
+	app, sms := setupRegistrationOTPTest(t)
+
+	// The user is created unverified and cannot log in until the code is confirmed
+	assert.Equal(t, fiber.StatusCreated, registerStatus(t, app, "+77771234567", ""))
+	assert.Len(t, sms.messages["+77771234567"], 1)
+	code := otpCodePattern.FindString(sms.messages["+77771234567"][0])
+	assert.NotEmpty(t, code)
+	assert.Equal(t, fiber.StatusForbidden, loginStatus(t, app, "+77771234567", "password123"))
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/registrations?status=unverified", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 1)
+
+	// A wrong code does not use up the right one
+	assert.Equal(t, fiber.StatusUnauthorized, postPublic(t, app, "/api/v1/auth/verify-otp", map[string]string{"phone": "+77771234567", "code": "000000x"}))
+	assert.Equal(t, fiber.StatusOK, postPublic(t, app, "/api/v1/auth/verify-otp", map[string]string{"phone": "+77771234567", "code": code}))
+	assert.Equal(t, fiber.StatusOK, loginStatus(t, app, "+77771234567", "password123"))
+
+	// Codes are single-use
+	assert.Equal(t, fiber.StatusUnauthorized, postPublic(t, app, "/api/v1/auth/verify-otp", map[string]string{"phone": "+77771234567", "code": code}))
+
+	var history int64
+	db.DB.Model(&models.UserHistory{}).Where("action = ?", models.UserHistoryPhoneVerified).Count(&history)
+	assert.Equal(t, int64(1), history)
+}
+
+func TestRegistrationOTP_InviteAssignedAfterVerification(t *testing.T) {
+	app, sms := setupRegistrationOTPTest(t)
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	invite := models.InviteCode{Code: "7KQ2MXR4PA", Locations: []models.InviteLocation{{LocationID: 4, GateIds: []int{40}}}}
+	assert.NoError(t, db.DB.Create(&invite).Error)
+
+	assert.Equal(t, fiber.StatusCreated, registerStatus(t, app, "+77771234567", invite.Code))
+	assert.Empty(t, provider.assignments["+77771234567"])
+
+	code := otpCodePattern.FindString(sms.messages["+77771234567"][0])
+	assert.Equal(t, fiber.StatusOK, postPublic(t, app, "/api/v1/auth/verify-otp", map[string]string{"phone": "+77771234567", "code": code}))
+	assert.Equal(t, []services.LocationAssignmentDTO{{LocationID: 4, GateIds: []int{40}}}, provider.assignments["+77771234567"])
+}
+
+func TestRegistrationOTP_ResendLimitsAndRelease(t *testing.T) {
+	app, sms := setupRegistrationOTPTest(t)
+
+	assert.Equal(t, fiber.StatusCreated, registerStatus(t, app, "+77771234567", ""))
+
+	// Resending is limited by OTP_RESEND_INTERVAL
+	assert.Equal(t, fiber.StatusTooManyRequests, postPublic(t, app, "/api/v1/auth/verify-otp/resend", map[string]string{"phone": "+77771234567"}))
+	db.DB.Model(&models.OTPCode{}).Where("1 = 1").Update("created_at", time.Now().Add(-2*time.Minute))
+	assert.Equal(t, fiber.StatusOK, postPublic(t, app, "/api/v1/auth/verify-otp/resend", map[string]string{"phone": "+77771234567"}))
+	assert.Len(t, sms.messages["+77771234567"], 2)
+
+	// The same answer, without an SMS, for numbers without a registration to confirm
+	assert.Equal(t, fiber.StatusOK, postPublic(t, app, "/api/v1/auth/verify-otp/resend", map[string]string{"phone": "+77771234568"}))
+	assert.Empty(t, sms.messages["+77771234568"])
+
+	// The number stays taken while a code is valid, and is released once it expired
+	assert.Equal(t, fiber.StatusConflict, registerStatus(t, app, "+77771234567", ""))
+	db.DB.Model(&models.OTPCode{}).Where("1 = 1").Updates(map[string]interface{}{
+		"created_at": time.Now().Add(-time.Hour),
+		"expires_at": time.Now().Add(-time.Minute),
+	})
+	assert.Equal(t, fiber.StatusCreated, registerStatus(t, app, "+77771234567", ""))
+	var users int64
+	db.DB.Unscoped().Model(&models.User{}).Count(&users)
+	assert.Equal(t, int64(1), users)
+}
+
+func TestRegistrationOTP_DisabledByDefault(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	assert.Equal(t, fiber.StatusNotFound, postPublic(t, app, "/api/v1/auth/verify-otp", map[string]string{"phone": "+77771234567", "code": "123456"}))
+	assert.Equal(t, fiber.StatusNotFound, postPublic(t, app, "/api/v1/auth/verify-otp/resend", map[string]string{"phone": "+77771234567"}))
+}
@@ -1,11 +1,16 @@
 package handlers
 
 import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
 	"ololo-gate/internal/tests"
 	"ololo-gate/internal/utils"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,9 +30,13 @@ func TestRegister_Success(t *testing.T) {
 	app := setupAuthTest(t)
 	defer tests.CleanupTestDB(t)
 
+	invite := models.InviteCode{Code: "7KQ2MXR4PA"}
+	assert.NoError(t, db.DB.Create(&invite).Error)
+
 	body := map[string]string{
-		"phone":    "+77771234567",
-		"password": "testpassword123",
+		"phone":       "+77771234567",
+		"password":    "testpassword123",
+		"invite_code": "7kq2-mxr4pa",
 	}
 
 	resp, err := tests.MakeRequest(app, "POST", "/register", body, nil)
@@ -43,6 +52,48 @@ func TestRegister_Success(t *testing.T) {
 	assert.Equal(t, "+77771234567", data["phone"])
 }
 
+func TestRegister_RequiresValidInviteCode(t *testing.T) {
+	app := setupAuthTest(t)
+	defer tests.CleanupTestDB(t)
+
+	expired := time.Now().Add(-time.Hour)
+	revoked := time.Now()
+	assert.NoError(t, db.DB.Create(&models.InviteCode{Code: "EXPIRED234"}).Error)
+	db.DB.Model(&models.InviteCode{}).Where("code = ?", "EXPIRED234").Update("expires_at", expired)
+	assert.NoError(t, db.DB.Create(&models.InviteCode{Code: "REVOKED234", RevokedAt: &revoked}).Error)
+	assert.NoError(t, db.DB.Create(&models.InviteCode{Code: "SINGLEUSE2", MaxUses: 1}).Error)
+
+	for _, code := range []string{"", "UNKNOWN234", "EXPIRED234", "REVOKED234"} {
+		body := map[string]string{"phone": "+77771234567", "password": "testpassword123", "invite_code": code}
+		resp, err := tests.MakeRequest(app, "POST", "/register", body, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 400, resp.Code, code)
+	}
+
+	// A single-use code registers one user only
+	body := map[string]string{"phone": "+77771234567", "password": "testpassword123", "invite_code": "SINGLEUSE2"}
+	resp, err := tests.MakeRequest(app, "POST", "/register", body, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 201, resp.Code)
+	body["phone"] = "+77771234568"
+	resp, err = tests.MakeRequest(app, "POST", "/register", body, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, resp.Code)
+
+	var invite models.InviteCode
+	assert.NoError(t, db.DB.First(&invite, "code = ?", "SINGLEUSE2").Error)
+	assert.Equal(t, 1, invite.Uses)
+	var user models.User
+	assert.NoError(t, db.DB.First(&user, "invite_code_id = ?", invite.ID).Error)
+
+	// Open registration does not need a code
+	config.AppConfig().Users.OpenRegistration = true
+	body = map[string]string{"phone": "+77771234568", "password": "testpassword123"}
+	resp, err = tests.MakeRequest(app, "POST", "/register", body, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 201, resp.Code)
+}
+
 func TestRegister_InvalidPhoneFormat(t *testing.T) {
 	app := setupAuthTest(t)
 	defer tests.CleanupTestDB(t)
@@ -101,6 +152,99 @@ func TestRegister_DuplicatePhone(t *testing.T) {
 	assert.Contains(t, result["message"], "already exists")
 }
 
+func TestRegister_DuplicatePhoneInOtherFormat(t *testing.T) {
+	app := setupAuthTest(t)
+	defer tests.CleanupTestDB(t)
+
+	tests.CreateTestUser(t, "+77771234567", "password123")
+
+	for _, phone := range []string{"+7 777 123 45 67", "8 (777) 123-45-67"} {
+		body := map[string]string{
+			"phone":    phone,
+			"password": "different password",
+		}
+
+		resp, err := tests.MakeRequest(app, "POST", "/register", body, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 409, resp.Code, phone)
+	}
+}
+
+func TestLogin_NationalFormatPhone(t *testing.T) {
+	app := setupAuthTest(t)
+	defer tests.CleanupTestDB(t)
+
+	tests.CreateTestUser(t, "+77771234567", "testpassword123")
+
+	body := map[string]string{
+		"phone":    "8 777 123 45 67",
+		"password": "testpassword123",
+	}
+
+	resp, err := tests.MakeRequest(app, "POST", "/login", body, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+
+	data := tests.ParseJSONResponse(t, resp)["data"].(map[string]interface{})
+	assert.Equal(t, "+77771234567", data["phone"])
+}
+
+func TestLogin_ByVerifiedEmail(t *testing.T) {
+	app := setupAuthTest(t)
+	defer tests.CleanupTestDB(t)
+
+	user := tests.CreateTestUser(t, "+77771234567", "testpassword123")
+	now := time.Now()
+	user.Email = "Staff@Example.com"
+	user.EmailVerifiedAt = &now
+	assert.NoError(t, db.DB.Save(user).Error)
+
+	body := map[string]string{
+		"email":    " staff@EXAMPLE.com",
+		"password": "testpassword123",
+	}
+
+	resp, err := tests.MakeRequest(app, "POST", "/login", body, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+
+	data := tests.ParseJSONResponse(t, resp)["data"].(map[string]interface{})
+	claims, err := utils.ValidateToken(data["access_token"].(string), utils.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, claims.UserID)
+	assert.Equal(t, utils.IdentifierEmail, claims.Identifier)
+
+	// The identifier type carries over to refreshed access tokens
+	refreshed, err := utils.RefreshAccessToken(data["refresh_token"].(string))
+	assert.NoError(t, err)
+	claims, err = utils.ValidateToken(refreshed, utils.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.IdentifierEmail, claims.Identifier)
+}
+
+func TestLogin_UnverifiedEmailRejected(t *testing.T) {
+	app := setupAuthTest(t)
+	defer tests.CleanupTestDB(t)
+
+	user := tests.CreateTestUser(t, "+77771234567", "testpassword123")
+	user.Email = "staff@example.com"
+	assert.NoError(t, db.DB.Save(user).Error)
+
+	body := map[string]string{
+		"email":    "staff@example.com",
+		"password": "testpassword123",
+	}
+
+	resp, err := tests.MakeRequest(app, "POST", "/login", body, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 401, resp.Code)
+
+	body["email"] = "not-an-email"
+	resp, err = tests.MakeRequest(app, "POST", "/login", body, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, resp.Code)
+}
+
 func TestLogin_Success(t *testing.T) {
 	app := setupAuthTest(t)
 	defer tests.CleanupTestDB(t)
@@ -132,7 +276,9 @@ func TestLogin_Success(t *testing.T) {
 	claims, err := utils.ValidateToken(accessToken, utils.AccessToken)
 	assert.NoError(t, err)
 	assert.Equal(t, "+77771234567", claims.Phone)
-	assert.Equal(t, 1, claims.TokenVersion) // TokenVersion incremented to 1 on login
+	assert.Equal(t, 0, claims.TokenVersion) // Login no longer bumps the global token version
+	assert.NotEqual(t, uuid.Nil, claims.SessionID)
+	assert.Equal(t, utils.IdentifierPhone, claims.Identifier)
 }
 
 func TestLogin_InvalidCredentials(t *testing.T) {
@@ -251,3 +397,31 @@ func TestRefreshToken_ExpiredToken(t *testing.T) {
 	// Should fail because token version doesn't match
 	assert.Equal(t, 401, resp.Code)
 }
+
+func TestLogin_ClientTypeLifetimes(t *testing.T) {
+	app := setupAuthTest(t)
+	defer tests.CleanupTestDB(t)
+
+	config.AppConfig().JWT.ClientProfiles = map[string]config.ClientProfile{
+		"kiosk": {AccessExpiry: 12 * time.Hour, RefreshExpiry: 720 * time.Hour},
+	}
+	defer func() { config.AppConfig().JWT.ClientProfiles = nil }()
+
+	tests.CreateTestUser(t, "+77771234567", "testpassword123")
+
+	body := map[string]string{
+		"phone":    "+77771234567",
+		"password": "testpassword123",
+	}
+
+	resp, err := tests.MakeRequest(app, "POST", "/login?client_type=kiosk", body, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+
+	data := tests.ParseJSONResponse(t, resp)["data"].(map[string]interface{})
+	assert.Equal(t, float64(12*60*60), data["access_expires_in"])
+
+	claims, err := utils.ValidateToken(data["access_token"].(string), utils.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "kiosk", claims.ClientType)
+}
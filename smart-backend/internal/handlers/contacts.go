@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	"log"
+	"log/slog"
 	"ololo-gate/internal/db"
 	"ololo-gate/internal/models"
 
@@ -30,8 +30,8 @@ func GetContact(c *fiber.Ctx) error {
 
 	// Try to fetch the first (and should be only) contact record
 	// If not found, return empty values with status 200
-	if err := db.DB.First(&contact).Error; err != nil {
-		log.Println("No contact information found, returning empty values")
+	if err := db.DB.WithContext(c.UserContext()).First(&contact).Error; err != nil {
+		slog.DebugContext(c.UserContext(), "No contact information found, returning empty values")
 		return c.Status(fiber.StatusOK).JSON(ContactResponse{
 			Success: true,
 			Message: "Contact information retrieved successfully",
@@ -105,14 +105,14 @@ func UpdateContact(c *fiber.Ctx) error {
 
 	// Try to fetch the first contact record
 	var contact models.Contact
-	if err := db.DB.First(&contact).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).First(&contact).Error; err != nil {
 		// If not found, create a new contact record
 		contact = models.Contact{
 			SupportNumber: req.SupportNumber,
 			EmailSupport:  req.EmailSupport,
 			Address:       req.Address,
 		}
-		if err := db.DB.Create(&contact).Error; err != nil {
+		if err := db.DB.WithContext(c.UserContext()).Create(&contact).Error; err != nil {
 			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 				Success: false,
 				Message: "Failed to create contact information",
@@ -124,7 +124,7 @@ func UpdateContact(c *fiber.Ctx) error {
 		contact.EmailSupport = req.EmailSupport
 		contact.Address = req.Address
 
-		if err := db.DB.Save(&contact).Error; err != nil {
+		if err := db.DB.WithContext(c.UserContext()).Save(&contact).Error; err != nil {
 			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 				Success: false,
 				Message: "Failed to update contact information",
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/middleware"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestDeadline_EndsRequestContext(t *testing.T) {
+	_, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Server.RequestTimeout = 20 * time.Millisecond
+
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Get("/api/v2/slow", middleware.RequestDeadline(), func(c *fiber.Ctx) error {
+		_, ok := c.UserContext().Deadline()
+		assert.True(t, ok)
+		select {
+		case <-c.UserContext().Done():
+			return c.UserContext().Err()
+		case <-time.After(time.Second):
+			return c.SendStatus(fiber.StatusOK)
+		}
+	})
+
+	start := time.Now()
+	resp, problem := getProblem(t, app, "/api/v2/slow", nil)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.Status)
+	assert.Equal(t, "/problems/service-unavailable", problem.Type)
+}
+
+func TestRequestDeadline_ZeroTimeoutHasNoDeadline(t *testing.T) {
+	_, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Server.RequestTimeout = 0
+
+	app := fiber.New()
+	app.Get("/fast", middleware.RequestDeadline(), func(c *fiber.Ctx) error {
+		_, ok := c.UserContext().Deadline()
+		assert.False(t, ok)
+		assert.NoError(t, c.UserContext().Err())
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/fast", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNoContent, resp.StatusCode)
+}
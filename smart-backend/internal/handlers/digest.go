@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// digestRunsLimit is how many recent digest runs admins see
+const digestRunsLimit = 52
+
+// UpdateNotificationPreferencesRequest defines the structure for changing notification preferences
+// @name UpdateNotificationPreferencesRequest
+type UpdateNotificationPreferencesRequest struct {
+	Digest *bool `json:"digest" validate:"required" example:"false"` // Receive the inactivity digest SMS
+}
+
+// GetNotificationPreferences godoc
+// @Summary Get my notification preferences
+// @Description Which optional messages the user receives, e.g. the SMS digest sent after weeks without opening a gate
+// @Tags Authentication
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} NotificationPreferencesResponse "Notification preferences retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Router /api/v1/auth/notification-preferences [get]
+func GetNotificationPreferences(c *fiber.Ctx) error {
+	userID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		userID = uuid.Nil
+	}
+
+	var user models.User
+	if err := db.DB.WithContext(c.UserContext()).First(&user, "id = ?", userID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "User not found",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(NotificationPreferencesResponse{
+		Success: true,
+		Message: "Notification preferences retrieved successfully",
+		Data:    NotificationPreferencesDTO{Digest: !user.DigestOptOut},
+	})
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Update my notification preferences
+// @Description Turn the inactivity digest SMS on or off. The change is recorded in the user's history.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UpdateNotificationPreferencesRequest true "Preferences to change"
+// @Success 200 {object} NotificationPreferencesResponse "Notification preferences updated"
+// @Failure 400 {object} APIResponse "Invalid request body"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/notification-preferences [put]
+func UpdateNotificationPreferences(c *fiber.Ctx) error {
+	userID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		userID = uuid.Nil
+	}
+
+	var req UpdateNotificationPreferencesRequest
+	if err := c.BodyParser(&req); err != nil || req.Digest == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "digest is required",
+		})
+	}
+
+	var user models.User
+	if err := db.DB.WithContext(c.UserContext()).First(&user, "id = ?", userID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "User not found",
+		})
+	}
+
+	optOut := !*req.Digest
+	if optOut != user.DigestOptOut {
+		if err := db.DB.WithContext(c.UserContext()).Model(&user).Update("digest_opt_out", optOut).Error; err != nil {
+			slog.ErrorContext(c.UserContext(), "Failed to update notification preferences", "user_id", user.ID, "error", err)
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to update notification preferences",
+			})
+		}
+		services.RecordUserHistory(user.ID, models.UserHistoryUpdated, "self",
+			services.FieldChanges{}.Set("digest_opt_out", !optOut, optOut))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(NotificationPreferencesResponse{
+		Success: true,
+		Message: "Notification preferences updated",
+		Data:    NotificationPreferencesDTO{Digest: !optOut},
+	})
+}
+
+// GetDigestRuns godoc
+// @Summary List inactivity digest runs
+// @Description Retrieve the most recent runs of the weekly inactivity digest, newest first, with how many inactive users were found, opted out, were sent the digest or failed (requires admin authentication)
+// @Tags Admin Jobs
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} DigestRunsResponse "Digest runs retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/digest/runs [get]
+func GetDigestRuns(c *fiber.Ctx) error {
+	runs, err := services.DigestRuns(digestRunsLimit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve digest runs",
+		})
+	}
+
+	dtos := make([]DigestRunDTO, len(runs))
+	for i, run := range runs {
+		dtos[i] = DigestRunDTO{
+			ID:            run.ID,
+			InactiveSince: run.InactiveSince,
+			Eligible:      run.Eligible,
+			OptedOut:      run.OptedOut,
+			Sent:          run.Sent,
+			Failed:        run.Failed,
+			Error:         run.Error,
+			StartedAt:     run.StartedAt,
+			FinishedAt:    run.FinishedAt,
+		}
+	}
+	return c.Status(fiber.StatusOK).JSON(DigestRunsResponse{
+		Success: true,
+		Message: "Digest runs retrieved successfully",
+		Data:    dtos,
+	})
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInactiveUserDigest_SkipsActiveAndOptedOutUsers(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Digest = config.DigestConfig{Enabled: true, InactiveAfter: 14 * 24 * time.Hour, Message: "We miss you"}
+	sms := &fakeSMSSender{messages: map[string][]string{}}
+	services.SetSMSSender(sms)
+	defer services.SetSMSSender(nil)
+
+	longAgo := time.Now().Add(-60 * 24 * time.Hour)
+	user := func(phone string) models.User {
+		u := models.User{Phone: phone, Password: "password123"}
+		assert.NoError(t, db.DB.Create(&u).Error)
+		db.DB.Model(&u).Update("created_at", longAgo)
+		return u
+	}
+	inactive := user("+77771234561")
+	active := user("+77771234562")
+	optedOut := user("+77771234563")
+	assert.NoError(t, db.DB.Create(&models.User{Phone: "+77771234564", Password: "password123"}).Error) // Too new to be inactive
+	_, err := services.CreateGateCommand(context.Background(), active.ID, active.Phone, 7, services.GateActionOpen)
+	assert.NoError(t, err)
+
+	// The user turns the digest off in their notification preferences
+	tokens, _ := utils.GenerateTokens(optedOut.ID, optedOut.Phone, optedOut.TokenVersion)
+	body, _ := json.Marshal(map[string]interface{}{"digest": false})
+	req := httptest.NewRequest("PUT", "/api/v1/auth/notification-preferences", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var prefs NotificationPreferencesResponse
+	json.NewDecoder(resp.Body).Decode(&prefs)
+	assert.False(t, prefs.Data.Digest)
+
+	run, err := services.SendInactiveUserDigest(context.Background(), time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, run.Eligible)
+	assert.Equal(t, 1, run.OptedOut)
+	assert.Equal(t, 1, run.Sent)
+	assert.Equal(t, map[string][]string{inactive.Phone: {"We miss you"}}, sms.messages)
+
+	// A second run in the same week sends nothing new
+	run, err = services.SendInactiveUserDigest(context.Background(), time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, run.Sent)
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/digest/runs", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	runs := result["data"].([]interface{})
+	assert.Len(t, runs, 2)
+	assert.Equal(t, float64(1), runs[1].(map[string]interface{})["opted_out"])
+}
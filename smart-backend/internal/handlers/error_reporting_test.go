@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"errors"
+	"net/http/httptest"
+	"ololo-gate/internal/middleware"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// captureTransport collects reported events instead of sending them
+type captureTransport struct {
+	mu     sync.Mutex
+	events []*sentry.Event
+}
+
+func (t *captureTransport) Configure(sentry.ClientOptions) {}
+func (t *captureTransport) Flush(time.Duration) bool       { return true }
+func (t *captureTransport) SendEvent(event *sentry.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events = append(t.events, event)
+}
+
+func (t *captureTransport) Events() []*sentry.Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*sentry.Event(nil), t.events...)
+}
+
+func setupErrorReportingTest(t *testing.T) (*fiber.App, *captureTransport) {
+	transport := &captureTransport{}
+	err := sentry.Init(sentry.ClientOptions{
+		Dsn:         "https://public@sentry.example.com/1",
+		Transport:   transport,
+		Environment: "test",
+		Release:     "abc123",
+	})
+	assert.NoError(t, err)
+
+	userID := uuid.New()
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Use(middleware.ErrorReporting())
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("id", userID)
+		c.Locals("phone", "+77771234567")
+		return c.Next()
+	})
+	app.Get("/panic", func(c *fiber.Ctx) error {
+		panic("boom")
+	})
+	app.Get("/error", func(c *fiber.Ctx) error {
+		return errors.New("database is down")
+	})
+	app.Get("/not-found", func(c *fiber.Ctx) error {
+		return fiber.ErrNotFound
+	})
+	return app, transport
+}
+
+func TestErrorReporting_ReportsPanics(t *testing.T) {
+	app, transport := setupErrorReportingTest(t)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/panic", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+
+	events := transport.Events()
+	assert.Len(t, events, 1)
+	assert.Equal(t, "abc123", events[0].Release)
+	assert.Equal(t, "test", events[0].Environment)
+	assert.NotEmpty(t, events[0].User.ID)
+	assert.Equal(t, "user", events[0].Tags["principal"])
+	assert.Equal(t, "GET", events[0].Request.Method)
+}
+
+func TestErrorReporting_Reports5xxOnly(t *testing.T) {
+	app, transport := setupErrorReportingTest(t)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/error", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+	assert.Len(t, transport.Events(), 1)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/not-found", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	assert.Len(t, transport.Events(), 1)
+}
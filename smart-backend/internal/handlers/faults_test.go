@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/services"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// faultRequest sends an unauthenticated request and returns the status, the injected faults and the body
+func faultRequest(t *testing.T, app *fiber.App, method, path string) (int, string, map[string]interface{}) {
+	resp, err := app.Test(httptest.NewRequest(method, path, nil))
+	assert.NoError(t, err)
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, resp.Header.Get(middleware.FaultHeader), result
+}
+
+func TestInjectFaults_ProviderErrors(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Faults = config.FaultsConfig{
+		Enabled:           true,
+		Routes:            []config.FaultRoute{{Method: "GET", Path: "/api/v1/locations"}},
+		ProviderErrorRate: 100,
+	}
+	defer services.ProviderBreaker().Success()
+
+	status, faults, result := faultRequest(t, app, "GET", "/api/v1/locations")
+	assert.Equal(t, fiber.StatusServiceUnavailable, status)
+	assert.Equal(t, middleware.FaultProviderError, faults)
+	assert.Equal(t, "provider_unavailable", result["data"].(map[string]interface{})["kind"])
+	assert.Equal(t, "exponential", result["retry_strategy"].(map[string]interface{})["strategy"])
+
+	// Other routes are untouched
+	status, faults, _ = faultRequest(t, app, "GET", "/api/v1/available-locations")
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	assert.Empty(t, faults)
+}
+
+func TestInjectFaults_LatencyThenDBError(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Faults = config.FaultsConfig{
+		Enabled:     true,
+		Latency:     time.Millisecond,
+		LatencyRate: 100,
+		DBErrorRate: 100,
+	}
+
+	status, faults, result := faultRequest(t, app, "GET", "/api/v1/available-locations")
+	assert.Equal(t, fiber.StatusInternalServerError, status)
+	assert.Equal(t, "latency, db_error", faults)
+	assert.Equal(t, false, result["success"])
+
+	// Disabled, requests go through
+	config.AppConfig().Faults.Enabled = false
+	status, faults, _ = faultRequest(t, app, "GET", "/api/v1/available-locations")
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	assert.Empty(t, faults)
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/storage"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ServeSignedFile godoc
+// @Summary Download a stored file
+// @Description Serve a file from local file storage through a signed URL handed out by the API, e.g. a gate report photo. The URL carries its own authorization and expires after STORAGE_SIGNED_URL_TTL. With the s3 storage driver signed URLs point at the bucket instead
+// @Tags Files
+// @Produce application/octet-stream
+// @Param key path string true "File key"
+// @Param expires query int true "Expiry (unix seconds)"
+// @Param sig query string true "Signature"
+// @Success 200 {file} file "The file"
+// @Failure 403 {object} APIResponse "Invalid or expired signature"
+// @Failure 404 {object} APIResponse "File not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/files/{key} [get]
+func ServeSignedFile(c *fiber.Ctx) error {
+	files, err := storage.Default()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve file",
+		})
+	}
+	local, ok := files.(*storage.LocalStorage)
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "File not found",
+		})
+	}
+
+	key := c.Params("*")
+	if err := local.Verify(key, c.Query("expires"), c.Query("sig")); err != nil {
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid or expired file link",
+		})
+	}
+
+	body, contentType, err := local.Get(key)
+	if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "File not found",
+		})
+	}
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[FILES] Failed to read file", "key", key, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve file",
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	return c.Status(fiber.StatusOK).SendStream(body)
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func init() {
+	// The callback authenticates the provider itself via X-Provider-Token
+	middleware.Annotate(GateCommandCallback, middleware.RequirementProviderToken)
+}
+
+// GateCommandCallbackRequest defines the structure of provider status callbacks for gate commands
+// @name GateCommandCallbackRequest
+type GateCommandCallbackRequest struct {
+	Status string `json:"status" validate:"required" example:"confirmed"` // "confirmed" or "failed"
+	Error  string `json:"error" example:"Barrier obstructed"`             // Optional failure reason
+}
+
+// GetGateCommand godoc
+// @Summary Get gate command status
+// @Description Retrieve the lifecycle status (accepted, executing, confirmed, failed) of a gate command issued by the current user
+// @Tags Gate Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Gate command ID (UUID)"
+// @Success 200 {object} GateCommandResponse "Gate command retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid gate command ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 404 {object} APIResponse "Gate command not found"
+// @Router /api/v1/gate-commands/{id} [get]
+func GetGateCommand(c *fiber.Ctx) error {
+	commandID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid gate command ID format",
+		})
+	}
+
+	userID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		userID = uuid.Nil
+	}
+
+	// Users can only see their own commands
+	var cmd models.GateCommand
+	if err := db.DB.WithContext(c.UserContext()).Where("id = ? AND user_id = ?", commandID, userID).First(&cmd).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Gate command not found",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(GateCommandResponse{
+		Success: true,
+		Message: "Gate command retrieved successfully",
+		Data:    toGateCommandDTO(cmd),
+	})
+}
+
+// GateCommandCallback godoc
+// @Summary Gate command status callback
+// @Description Called by the gate provider to report the final outcome of a command. Requires the shared provider token in the X-Provider-Token header; during a rotation (POST /admin/webhook-secrets/provider_callback/rotate) the previous token is accepted until it expires.
+// @Tags Gate Management
+// @Accept json
+// @Produce json
+// @Param X-Provider-Token header string true "Shared provider callback token"
+// @Param id path string true "Gate command ID (UUID)"
+// @Param request body GateCommandCallbackRequest true "Command outcome"
+// @Success 200 {object} GateCommandResponse "Gate command updated successfully"
+// @Failure 400 {object} APIResponse "Invalid gate command ID or request body"
+// @Failure 401 {object} APIResponse "Invalid provider token"
+// @Failure 404 {object} APIResponse "Gate command not found or callbacks disabled"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/gate-commands/{id}/callback [post]
+func GateCommandCallback(c *fiber.Ctx) error {
+	// During a rotation both the current and the previous provider secret are accepted
+	secrets, err := services.ActiveWebhookSecrets(models.WebhookSecretProviderCallback)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[GATE_COMMAND] Failed to load provider callback secrets", "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to authenticate callback",
+		})
+	}
+	if len(secrets) == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Provider callbacks are disabled",
+		})
+	}
+
+	if !services.MatchWebhookSecret(secrets, c.Get("X-Provider-Token")) {
+		slog.WarnContext(c.UserContext(), "[GATE_COMMAND] Rejected callback with invalid provider token", "ip", c.IP())
+		events.Publish(events.SecurityAlert, map[string]interface{}{
+			"reason":  "invalid_provider_token",
+			"ip":      c.IP(),
+			"title":   "Rejected gate provider callback",
+			"message": "A gate command callback with an invalid provider token was received from " + c.IP(),
+		})
+		return c.Status(fiber.StatusUnauthorized).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid provider token",
+		})
+	}
+
+	commandID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid gate command ID format",
+		})
+	}
+
+	var req GateCommandCallbackRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	if req.Status != models.GateCommandConfirmed && req.Status != models.GateCommandFailed {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid status. Must be 'confirmed' or 'failed'",
+		})
+	}
+
+	var cmd models.GateCommand
+	if err := db.DB.WithContext(c.UserContext()).First(&cmd, "id = ?", commandID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Gate command not found",
+		})
+	}
+
+	if _, err := services.UpdateGateCommandStatus(c.UserContext(), cmd.ID, req.Status, req.Error); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to update gate command",
+		})
+	}
+
+	db.DB.WithContext(c.UserContext()).First(&cmd, "id = ?", commandID)
+
+	return c.Status(fiber.StatusOK).JSON(GateCommandResponse{
+		Success: true,
+		Message: "Gate command updated successfully",
+		Data:    toGateCommandDTO(cmd),
+	})
+}
+
+// toGateCommandDTO maps a GateCommand model to its response DTO
+func toGateCommandDTO(cmd models.GateCommand) GateCommandDTO {
+	return GateCommandDTO{
+		ID:           cmd.ID,
+		GateID:       cmd.GateID,
+		Action:       cmd.Action,
+		Status:       cmd.Status,
+		ErrorMessage: cmd.ErrorMessage,
+		Sandbox:      cmd.Sandbox,
+		CreatedAt:    cmd.CreatedAt,
+		UpdatedAt:    cmd.UpdatedAt,
+		CompletedAt:  cmd.CompletedAt,
+	}
+}
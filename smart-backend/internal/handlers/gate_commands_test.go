@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func createGateCommandTestUser(t *testing.T, phone string) (models.User, string) {
+	user := models.User{
+		ID:           uuid.New(),
+		Phone:        phone,
+		Password:     "password123",
+		TokenVersion: 0,
+	}
+	db.DB.Create(&user)
+
+	tokens, err := utils.GenerateTokens(user.ID, user.Phone, user.TokenVersion)
+	assert.NoError(t, err)
+	return user, tokens.AccessToken
+}
+
+func TestGetGateCommand_Success(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	user, token := createGateCommandTestUser(t, "+77771234567")
+	cmd := models.GateCommand{UserID: user.ID, Phone: user.Phone, GateID: 5, Action: "open", Status: models.GateCommandExecuting}
+	db.DB.Create(&cmd)
+
+	req := httptest.NewRequest("GET", "/api/v1/gate-commands/"+cmd.ID.String(), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var response GateCommandResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+
+	assert.True(t, response.Success)
+	assert.Equal(t, cmd.ID, response.Data.ID)
+	assert.Equal(t, 5, response.Data.GateID)
+	assert.Equal(t, models.GateCommandExecuting, response.Data.Status)
+}
+
+func TestGetGateCommand_OtherUsersCommand(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	owner, _ := createGateCommandTestUser(t, "+77771234567")
+	_, token := createGateCommandTestUser(t, "+77777654321")
+	cmd := models.GateCommand{UserID: owner.ID, Phone: owner.Phone, GateID: 5, Action: "open", Status: models.GateCommandAccepted}
+	db.DB.Create(&cmd)
+
+	req := httptest.NewRequest("GET", "/api/v1/gate-commands/"+cmd.ID.String(), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+func TestGetGateCommand_InvalidID(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	_, token := createGateCommandTestUser(t, "+77771234567")
+
+	req := httptest.NewRequest("GET", "/api/v1/gate-commands/not-a-uuid", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
+
+func TestGateCommandCallback_Confirms(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Gates.ProviderCallbackToken = "provider-secret"
+
+	user, _ := createGateCommandTestUser(t, "+77771234567")
+	cmd := models.GateCommand{UserID: user.ID, Phone: user.Phone, GateID: 5, Action: "close", Status: models.GateCommandExecuting}
+	db.DB.Create(&cmd)
+
+	body, _ := json.Marshal(GateCommandCallbackRequest{Status: models.GateCommandConfirmed})
+	req := httptest.NewRequest("POST", "/api/v1/gate-commands/"+cmd.ID.String()+"/callback", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Provider-Token", "provider-secret")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var updated models.GateCommand
+	db.DB.First(&updated, "id = ?", cmd.ID)
+	assert.Equal(t, models.GateCommandConfirmed, updated.Status)
+	assert.NotNil(t, updated.CompletedAt)
+}
+
+func TestGateCommandCallback_InvalidToken(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Gates.ProviderCallbackToken = "provider-secret"
+
+	body, _ := json.Marshal(GateCommandCallbackRequest{Status: models.GateCommandConfirmed})
+	req := httptest.NewRequest("POST", "/api/v1/gate-commands/"+uuid.New().String()+"/callback", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Provider-Token", "wrong")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestGateCommandCallback_TerminalStatusIsFinal(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Gates.ProviderCallbackToken = "provider-secret"
+
+	user, _ := createGateCommandTestUser(t, "+77771234567")
+	cmd := models.GateCommand{UserID: user.ID, Phone: user.Phone, GateID: 5, Action: "open", Status: models.GateCommandFailed}
+	db.DB.Create(&cmd)
+
+	body, _ := json.Marshal(GateCommandCallbackRequest{Status: models.GateCommandConfirmed})
+	req := httptest.NewRequest("POST", "/api/v1/gate-commands/"+cmd.ID.String()+"/callback", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Provider-Token", "provider-secret")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var updated models.GateCommand
+	db.DB.First(&updated, "id = ?", cmd.ID)
+	assert.Equal(t, models.GateCommandFailed, updated.Status)
+}
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CreateGateLinkRequest defines the structure for sharing a gate
+// @name CreateGateLinkRequest
+type CreateGateLinkRequest struct {
+	Label            string `json:"label" example:"Courier"`         // Optional note shown with the link
+	ExpiresInMinutes int    `json:"expires_in_minutes" example:"60"` // Link lifetime (default GATE_LINK_TTL, capped at GATE_LINK_MAX_TTL)
+}
+
+// CreateGateLink godoc
+// @Summary Create a shareable link to a gate
+// @Description Create a signed link to a gate the user has access to, for guest access and "share my gate". The response has an app deep link and, when GATE_LINK_BASE_URL is set, a universal link. The signature covers the link, gate and expiry, and is checked when the link is resolved.
+// @Tags Gate Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param gateId path int true "Gate ID"
+// @Param request body CreateGateLinkRequest false "Link label and lifetime"
+// @Success 201 {object} GateLinkResponse "Link created"
+// @Failure 400 {object} APIResponse "Invalid gate ID or request body"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 403 {object} APIResponse "Gate operations are blocked for this impersonation token"
+// @Failure 404 {object} APIResponse "Gate not found or not accessible to the user"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} APIResponse "Third-party API unavailable"
+// @Router /api/v1/locations/{gateId}/links [post]
+func CreateGateLink(c *fiber.Ctx) error {
+	gateID, err := strconv.Atoi(c.Params("gateId"))
+	if err != nil || gateID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid gate ID",
+		})
+	}
+
+	var req CreateGateLinkRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil || req.ExpiresInMinutes < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid request body",
+			})
+		}
+	}
+	req.Label = strings.TrimSpace(req.Label)
+	if len(req.Label) > 100 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Label must be at most 100 characters",
+		})
+	}
+
+	if middleware.IsGateOperationBlocked(c) {
+		middleware.SetDenialReason(c, models.DenialImpersonation)
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Gate operations are blocked while impersonating a user",
+		})
+	}
+
+	phone, ok := c.Locals("phone").(string)
+	if !ok {
+		phone = "unknown"
+	}
+	userID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		userID = uuid.Nil
+	}
+
+	// Only gates the user can open can be shared
+	gate, err := services.NewThirdPartyClient().WithContext(c.UserContext()).GetGateState(phone, gateID)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "[GATE_LINK] Gate not available to user", "gate_id", gateID, "phone", phone, "error", err)
+		return respondUpstreamError(c, err, "Failed to find gate")
+	}
+
+	ttl := services.GateLinkTTL(time.Duration(req.ExpiresInMinutes) * time.Minute)
+	link, signature, err := services.CreateGateLink(userID, *gate, req.Label, ttl)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[GATE_LINK] Failed to create link", "gate_id", gateID, "phone", phone, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to create gate link",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "[GATE_LINK] Gate shared",
+		"phone", phone, "gate_id", gateID, "expires_at", link.ExpiresAt.Format(time.RFC3339), "link_id", link.ID)
+
+	universalLink, deepLink := services.GateLinkURLs(link, signature)
+	return c.Status(fiber.StatusCreated).JSON(GateLinkResponse{
+		Success: true,
+		Message: "Gate link created",
+		Data: GateLinkDTO{
+			GateLinkMetadataDTO: toGateLinkMetadataDTO(link),
+			Signature:           signature,
+			UniversalLink:       universalLink,
+			DeepLink:            deepLink,
+		},
+	})
+}
+
+// ResolveGateLink godoc
+// @Summary Resolve a shared gate link
+// @Description Return the metadata of a shared gate link after checking its signature and expiry server-side. Used by the app and the web page a universal link opens. Does not require authentication. A link is revoked after GATE_LINK_MAX_FAILED_ATTEMPTS wrong signatures, and a client IP is throttled after GATE_LINK_IP_HOURLY_FAILURES failed resolutions in an hour.
+// @Tags Gate Management
+// @Produce json
+// @Param id path string true "Link ID (UUID)"
+// @Param sig query string true "Link signature"
+// @Success 200 {object} GateLinkMetadataResponse "Link is valid"
+// @Failure 400 {object} APIResponse "Invalid link ID"
+// @Failure 403 {object} APIResponse "Invalid signature"
+// @Failure 404 {object} APIResponse "Link not found"
+// @Failure 410 {object} APIResponse "Link expired or revoked"
+// @Failure 429 {object} APIResponse "Too many failed attempts from this IP (see Retry-After)"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/links/{id} [get]
+func ResolveGateLink(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid link ID",
+		})
+	}
+
+	// Throttle clients guessing link IDs or signatures
+	if wait, throttled := services.GateLinkThrottled(c.IP()); throttled {
+		slog.WarnContext(c.UserContext(), "[GATE_LINK] Throttled link resolution after too many failures", "ip", c.IP())
+		return c.Status(fiber.StatusTooManyRequests).JSON(APIResponse{
+			Success:       false,
+			Message:       "Too many failed attempts. Try again later.",
+			RetryStrategy: middleware.Backoff(c, middleware.RetryStrategyFixed, wait),
+		})
+	}
+
+	link, err := services.ResolveGateLink(c.UserContext(), id, c.Query("sig"), c.IP())
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Link not found",
+		})
+	case errors.Is(err, services.ErrGateLinkSignature):
+		slog.WarnContext(c.UserContext(), "[GATE_LINK] Rejected link with invalid signature", "link_id", id, "ip", c.IP())
+		middleware.SetDenialReason(c, models.DenialInvalidSignature)
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid link signature",
+		})
+	case errors.Is(err, services.ErrGateLinkExpired):
+		return c.Status(fiber.StatusGone).JSON(APIResponse{
+			Success: false,
+			Message: "Link has expired",
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to resolve link",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(GateLinkMetadataResponse{
+		Success: true,
+		Message: "Link is valid",
+		Data:    toGateLinkMetadataDTO(link),
+	})
+}
+
+// toGateLinkMetadataDTO maps a gate link to the metadata shown to anyone holding it
+func toGateLinkMetadataDTO(link models.GateLink) GateLinkMetadataDTO {
+	return GateLinkMetadataDTO{
+		ID:         link.ID,
+		LocationID: link.LocationID,
+		GateID:     link.GateID,
+		GateTitle:  link.GateTitle,
+		Label:      link.Label,
+		ExpiresAt:  link.ExpiresAt,
+		CreatedAt:  link.CreatedAt,
+	}
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func createGateLink(t *testing.T, app *fiber.App, user models.User, gateID string, body interface{}) (int, map[string]interface{}) {
+	tokens, _ := utils.GenerateTokens(user.ID, user.Phone, user.TokenVersion)
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest("POST", "/api/v1/locations/"+gateID+"/links", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func resolveGateLink(t *testing.T, app *fiber.App, path string) (int, map[string]interface{}) {
+	resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestGateLinks_CreateAndResolve(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{
+		"+77771234567": {{LocationID: 1, GateIds: []int{10}}},
+	}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+	config.AppConfig().Links = config.LinksConfig{BaseURL: "https://app.example.com/", AppScheme: "ololo-gate", DefaultTTL: time.Hour, MaxTTL: 24 * time.Hour}
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+
+	// Only gates the user has access to can be shared
+	status, _ := createGateLink(t, app, user, "20", nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+
+	status, result := createGateLink(t, app, user, "10", map[string]interface{}{"label": "Courier", "expires_in_minutes": 100000})
+	assert.Equal(t, fiber.StatusCreated, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, float64(10), data["gate_id"])
+	assert.Equal(t, float64(1), data["location_id"])
+	expiresAt, _ := time.Parse(time.RFC3339, data["expires_at"].(string))
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), expiresAt, time.Minute)
+
+	universal := data["universal_link"].(string)
+	assert.True(t, strings.HasPrefix(universal, "https://app.example.com/links/"))
+	assert.True(t, strings.HasPrefix(data["deep_link"].(string), "ololo-gate://links/"))
+
+	// The universal link resolves through the API without authentication
+	parsed, _ := url.Parse(universal)
+	status, result = resolveGateLink(t, app, "/api/v1"+parsed.Path+"?"+parsed.RawQuery)
+	assert.Equal(t, fiber.StatusOK, status)
+	resolved := result["data"].(map[string]interface{})
+	assert.Equal(t, "Courier", resolved["label"])
+	assert.Equal(t, "Gate", resolved["gate_title"])
+
+	// Tampered signatures, unknown and expired links are rejected
+	status, _ = resolveGateLink(t, app, "/api/v1"+parsed.Path+"?sig=forged")
+	assert.Equal(t, fiber.StatusForbidden, status)
+	status, _ = resolveGateLink(t, app, "/api/v1/links/"+"00000000-0000-0000-0000-000000000000?sig=x")
+	assert.Equal(t, fiber.StatusNotFound, status)
+
+	// Extending the expiry in the database invalidates the signature
+	db.DB.Model(&models.GateLink{}).Where("id = ?", data["id"]).Update("expires_at", expiresAt.Add(time.Hour))
+	status, _ = resolveGateLink(t, app, "/api/v1"+parsed.Path+"?"+parsed.RawQuery)
+	assert.Equal(t, fiber.StatusForbidden, status)
+}
+
+func TestGateLinks_ExpiredLinkIsGone(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	link, signature, err := services.CreateGateLink(uuid.New(), services.GateResponse{ID: 10, LocationID: 1}, "", -time.Minute)
+	assert.NoError(t, err)
+
+	status, _ := resolveGateLink(t, app, "/api/v1/links/"+link.ID.String()+"?sig="+url.QueryEscape(signature))
+	assert.Equal(t, fiber.StatusGone, status)
+}
+
+func TestGateLinks_WrongSignaturesFromOneIPDoNotRevokeLink(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Links = config.LinksConfig{MaxFailedAttempts: 3}
+	defer func() { config.AppConfig().Links = config.LinksConfig{} }()
+
+	link, signature, err := services.CreateGateLink(uuid.New(), services.GateResponse{ID: 11, LocationID: 1}, "", time.Hour)
+	assert.NoError(t, err)
+	path := "/api/v1/links/" + link.ID.String() + "?sig="
+
+	for i := 0; i < 5; i++ {
+		status, _ := resolveGateLink(t, app, path+"forged")
+		assert.Equal(t, fiber.StatusForbidden, status)
+	}
+
+	var stored models.GateLink
+	db.DB.First(&stored, "id = ?", link.ID)
+	assert.Equal(t, 1, stored.FailedAttempts)
+	assert.Nil(t, stored.RevokedAt)
+
+	// The link still resolves for whoever holds the right signature
+	status, _ := resolveGateLink(t, app, path+url.QueryEscape(signature))
+	assert.Equal(t, fiber.StatusOK, status)
+}
+
+func TestGateLinks_WrongSignaturesRevokeLinkAndThrottleIP(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Links = config.LinksConfig{MaxFailedAttempts: 3}
+	defer func() { config.AppConfig().Links = config.LinksConfig{} }()
+
+	link, signature, err := services.CreateGateLink(uuid.New(), services.GateResponse{ID: 10, LocationID: 1}, "", time.Hour)
+	assert.NoError(t, err)
+	path := "/api/v1/links/" + link.ID.String() + "?sig="
+
+	status, _ := resolveGateLink(t, app, path+"forged")
+	assert.Equal(t, fiber.StatusForbidden, status)
+	for _, ip := range []string{"203.0.113.2", "203.0.113.3"} {
+		_, err := services.ResolveGateLink(context.Background(), link.ID, "forged", ip)
+		assert.ErrorIs(t, err, services.ErrGateLinkSignature)
+	}
+
+	var stored models.GateLink
+	db.DB.First(&stored, "id = ?", link.ID)
+	assert.Equal(t, 3, stored.FailedAttempts)
+	assert.NotNil(t, stored.RevokedAt)
+
+	// The revoked link no longer resolves with the right signature either
+	status, _ = resolveGateLink(t, app, path+url.QueryEscape(signature))
+	assert.Equal(t, fiber.StatusGone, status)
+
+	// Once the IP has failed too often it is throttled before the link is looked up
+	config.AppConfig().Links.IPHourlyFailures = 3
+	status, _ = resolveGateLink(t, app, path+url.QueryEscape(signature))
+	assert.Equal(t, fiber.StatusTooManyRequests, status)
+}
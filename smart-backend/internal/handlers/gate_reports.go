@@ -0,0 +1,372 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/storage"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReportGateProblemRequest defines the structure for reporting a problem with a gate
+// @name ReportGateProblemRequest
+type ReportGateProblemRequest struct {
+	Category    string `json:"category" form:"category" validate:"required" example:"stuck_closed"` // stuck_open, stuck_closed, damaged or other
+	Description string `json:"description" form:"description" example:"Barrier does not lift, the motor hums"`
+}
+
+// ResolveGateReportRequest defines the structure for resolving a gate report
+// @name ResolveGateReportRequest
+type ResolveGateReportRequest struct {
+	Note string `json:"note" example:"Motor replaced"` // What was done, shown with the report
+}
+
+// ReportGateProblem godoc
+// @Summary Report a problem with a gate
+// @Description Report a stuck or damaged barrier on a gate the user has access to, with a category, an optional description and an optional photo (JPEG, PNG or WebP up to GATE_REPORT_MAX_PHOTO_SIZE). Send a multipart form with the photo in the "photo" field, or the same fields as a JSON body (ReportGateProblemRequest) without a photo. The report goes to the admin queue and the facility team is notified. A user can have one open report per gate.
+// @Tags Gate Management
+// @Accept json,multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param gateId path int true "Gate ID"
+// @Param category formData string true "Problem category: stuck_open, stuck_closed, damaged or other"
+// @Param description formData string false "What is wrong with the gate"
+// @Param photo formData file false "Photo of the problem"
+// @Success 201 {object} GateReportResponse "Problem reported"
+// @Failure 400 {object} APIResponse "Invalid gate ID, category, description or photo"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 404 {object} APIResponse "Gate not found or not accessible to the user"
+// @Failure 409 {object} GateReportResponse "The user already has an open report for this gate"
+// @Failure 413 {object} APIResponse "Photo too large"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} APIResponse "Third-party API unavailable"
+// @Router /api/v1/gates/{gateId}/report [post]
+func ReportGateProblem(c *fiber.Ctx) error {
+	gateID, err := strconv.Atoi(c.Params("gateId"))
+	if err != nil || gateID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid gate ID",
+		})
+	}
+
+	var req ReportGateProblemRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+	req.Category = strings.ToLower(strings.TrimSpace(req.Category))
+	req.Description = strings.TrimSpace(req.Description)
+	if !models.ValidGateReportCategory(req.Category) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid category. Must be 'stuck_open', 'stuck_closed', 'damaged' or 'other'",
+		})
+	}
+	if len(req.Description) > 1000 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Description must be at most 1000 characters",
+		})
+	}
+
+	var photo []byte
+	if file, err := c.FormFile("photo"); err == nil {
+		f, err := file.Open()
+		if err == nil {
+			photo, err = io.ReadAll(f)
+			f.Close()
+		}
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to read the uploaded photo",
+			})
+		}
+	}
+
+	phone, ok := c.Locals("phone").(string)
+	if !ok {
+		phone = "unknown"
+	}
+	userID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		userID = uuid.Nil
+	}
+
+	// Only gates the user has access to can be reported
+	gate, err := services.NewThirdPartyClient().WithContext(c.UserContext()).GetGateState(phone, gateID)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "[GATE_REPORTS] Gate not available to user", "gate_id", gateID, "phone", phone, "error", err)
+		return respondUpstreamError(c, err, "Failed to find gate")
+	}
+
+	report, err := services.CreateGateReport(userID, *gate, req.Category, req.Description, photo)
+	switch {
+	case errors.Is(err, services.ErrGateReportOpen):
+		return c.Status(fiber.StatusConflict).JSON(GateReportResponse{
+			Success: false,
+			Message: "You already reported a problem with this gate; the facility team is working on it",
+			Data:    toGateReportDTO(report),
+		})
+	case errors.Is(err, services.ErrGateReportPhotoTooLarge):
+		return c.Status(fiber.StatusRequestEntityTooLarge).JSON(APIResponse{
+			Success: false,
+			Message: "Photo is too large",
+		})
+	case errors.Is(err, services.ErrGateReportPhotoType):
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Photo must be a JPEG, PNG or WebP image",
+		})
+	case err != nil:
+		slog.ErrorContext(c.UserContext(), "[GATE_REPORTS] Failed to store report", "gate_id", gateID, "phone", phone, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to report the problem",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "[GATE_REPORTS] Gate reported",
+		"phone", phone, "category", report.Category, "gate_id", gateID, "report_id", report.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(GateReportResponse{
+		Success: true,
+		Message: "Problem reported, the facility team has been notified",
+		Data:    toGateReportDTO(report),
+	})
+}
+
+// GetGateReports godoc
+// @Summary List gate problem reports
+// @Description Retrieve problems users reported with gates, newest first, filtered by status (requires admin authentication)
+// @Tags Admin Gate Reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "open (default), resolved or all"
+// @Param gate_id query int false "Only reports on this gate"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} GateReportsResponse "Gate reports retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid status"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/gate-reports [get]
+func GetGateReports(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	query := db.DB.WithContext(c.UserContext()).Model(&models.GateReport{})
+	switch status := c.Query("status", models.GateReportOpen); status {
+	case models.GateReportOpen, models.GateReportResolved:
+		query = query.Where("status = ?", status)
+	case "all":
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid status. Must be 'open', 'resolved' or 'all'",
+		})
+	}
+	if gateID := c.QueryInt("gate_id"); gateID > 0 {
+		query = query.Where("gate_id = ?", gateID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve gate reports",
+		})
+	}
+
+	var reports []models.GateReport
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&reports).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve gate reports",
+		})
+	}
+
+	dtos := make([]GateReportDTO, len(reports))
+	for i, report := range reports {
+		dtos[i] = toGateReportDTO(report)
+	}
+
+	lastPage := int((total + int64(limit) - 1) / int64(limit))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return c.Status(fiber.StatusOK).JSON(GateReportsResponse{
+		Success: true,
+		Message: "Gate reports retrieved successfully",
+		Data:    dtos,
+		Pagination: PaginationMeta{
+			Total:       int(total),
+			PerPage:     limit,
+			CurrentPage: page,
+			LastPage:    lastPage,
+		},
+	})
+}
+
+// GetGateReportPhoto godoc
+// @Summary Download a gate report photo
+// @Description Redirect to a short-lived signed URL of the photo attached to a gate report (requires admin authentication)
+// @Tags Admin Gate Reports
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Gate report ID (UUID)"
+// @Success 302 {string} string "Redirect to the signed photo URL"
+// @Failure 400 {object} APIResponse "Invalid report ID"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Report not found or has no photo"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/gate-reports/{id}/photo [get]
+func GetGateReportPhoto(c *fiber.Ctx) error {
+	reportID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid report ID format",
+		})
+	}
+
+	var report models.GateReport
+	err = db.DB.WithContext(c.UserContext()).First(&report, "id = ?", reportID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && report.PhotoKey == "") {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Report not found or has no photo",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve photo",
+		})
+	}
+
+	files, err := storage.Default()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve photo",
+		})
+	}
+	url, err := files.SignURL(report.PhotoKey, config.AppConfig().Storage.SignedURLTTL)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve photo",
+		})
+	}
+	return c.Redirect(url, fiber.StatusFound)
+}
+
+// ResolveGateReport godoc
+// @Summary Resolve a gate report
+// @Description Mark a reported gate problem as fixed, with an optional note on what was done. The user can report the gate again afterwards (requires admin authentication)
+// @Tags Admin Gate Reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Gate report ID (UUID)"
+// @Param request body ResolveGateReportRequest false "Resolution note"
+// @Success 200 {object} GateReportResponse "Report resolved"
+// @Failure 400 {object} APIResponse "Invalid report ID or request body"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "Report not found"
+// @Failure 409 {object} APIResponse "Report is already resolved"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/gate-reports/{id}/resolve [post]
+func ResolveGateReport(c *fiber.Ctx) error {
+	reportID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid report ID format",
+		})
+	}
+
+	var req ResolveGateReportRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	report, err := services.ResolveGateReport(reportID, adminUsername, strings.TrimSpace(req.Note))
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Report not found",
+		})
+	case errors.Is(err, services.ErrGateReportResolved):
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "Report is already resolved",
+		})
+	case err != nil:
+		middleware.RecordAudit(c, "resolve_gate_report", "gate_report", reportID.String(), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to resolve report",
+		})
+	}
+	middleware.RecordAudit(c, "resolve_gate_report", "gate_report", reportID.String(), "success", "")
+	slog.InfoContext(c.UserContext(), "[GATE_REPORTS] Report resolved", "admin", adminUsername, "report_id", report.ID, "gate_id", report.GateID)
+
+	return c.Status(fiber.StatusOK).JSON(GateReportResponse{
+		Success: true,
+		Message: "Report resolved",
+		Data:    toGateReportDTO(report),
+	})
+}
+
+// toGateReportDTO maps a GateReport model to its response DTO
+func toGateReportDTO(r models.GateReport) GateReportDTO {
+	return GateReportDTO{
+		ID:             r.ID,
+		UserID:         r.UserID,
+		LocationID:     r.LocationID,
+		GateID:         r.GateID,
+		GateTitle:      r.GateTitle,
+		Category:       r.Category,
+		Description:    r.Description,
+		HasPhoto:       r.PhotoContentType != "",
+		Status:         r.Status,
+		ResolvedAt:     r.ResolvedAt,
+		ResolvedBy:     r.ResolvedBy,
+		ResolutionNote: r.ResolutionNote,
+		CreatedAt:      r.CreatedAt,
+	}
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/storage"
+	"ololo-gate/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// pngPhoto is the start of a PNG file, enough for content type detection
+var pngPhoto = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 64)...)
+
+func reportGateProblem(t *testing.T, app *fiber.App, user models.User, gateID string, fields map[string]string, photo []byte) (int, map[string]interface{}) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, value := range fields {
+		writer.WriteField(name, value)
+	}
+	if photo != nil {
+		part, _ := writer.CreateFormFile("photo", "photo.png")
+		part.Write(photo)
+	}
+	writer.Close()
+
+	tokens, _ := utils.GenerateTokens(user.ID, user.Phone, user.TokenVersion)
+	req := httptest.NewRequest("POST", "/api/v1/gates/"+gateID+"/report", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestGateReports_ReportAndResolve(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{
+		"+77771234567": {{LocationID: 1, GateIds: []int{10, 11}}},
+	}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+	config.AppConfig().GateReports.MaxPhotoSize = 1 << 20
+	config.AppConfig().Storage.SignedURLTTL = time.Minute
+	storage.SetDefault(storage.NewLocal(t.TempDir(), "", config.AppConfig().JWT.Secret))
+	defer storage.SetDefault(nil)
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+
+	status, result := reportGateProblem(t, app, user, "10", map[string]string{"category": "stuck_closed", "description": "Barrier does not lift"}, pngPhoto)
+	assert.Equal(t, fiber.StatusCreated, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, "stuck_closed", data["category"])
+	assert.Equal(t, true, data["has_photo"])
+	assert.Equal(t, models.GateReportOpen, data["status"])
+	reportID := data["id"].(string)
+
+	var notification models.AdminNotification
+	assert.NoError(t, db.DB.First(&notification, "category = ?", models.NotificationGateReports).Error)
+	assert.Contains(t, notification.Message, "Barrier does not lift")
+
+	// One open report per user and gate
+	status, result = reportGateProblem(t, app, user, "10", map[string]string{"category": "damaged"}, nil)
+	assert.Equal(t, fiber.StatusConflict, status)
+	assert.Equal(t, reportID, result["data"].(map[string]interface{})["id"])
+
+	status, _ = reportGateProblem(t, app, user, "11", map[string]string{"category": "on_fire"}, nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = reportGateProblem(t, app, user, "11", map[string]string{"category": "damaged"}, []byte("not an image"))
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = reportGateProblem(t, app, user, "99", map[string]string{"category": "damaged"}, nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gate-reports", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	reports := result["data"].([]interface{})
+	assert.Len(t, reports, 1)
+	assert.Equal(t, reportID, reports[0].(map[string]interface{})["id"])
+
+	admin := models.Admin{Username: "reports-admin", Password: "password123", Role: models.RoleRegular}
+	assert.NoError(t, db.DB.Create(&admin).Error)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	req := httptest.NewRequest("GET", "/api/v1/admin/gate-reports/"+reportID+"/photo", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusFound, resp.StatusCode)
+	location := resp.Header.Get("Location")
+	assert.Contains(t, location, "/api/v1/files/gate-reports/"+reportID+".png?")
+
+	// The signed URL needs no token, but only works untampered
+	resp, err = app.Test(httptest.NewRequest("GET", location+"x", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	resp, err = app.Test(httptest.NewRequest("GET", location, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+	photo, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, pngPhoto, photo)
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/gate-reports/"+reportID+"/resolve", map[string]string{"note": "Motor replaced"})
+	assert.Equal(t, fiber.StatusOK, status)
+	data = result["data"].(map[string]interface{})
+	assert.Equal(t, models.GateReportResolved, data["status"])
+	assert.Equal(t, "Motor replaced", data["resolution_note"])
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/gate-reports/"+reportID+"/resolve", nil)
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/gate-reports", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["data"])
+
+	// Once resolved, the gate can be reported again
+	status, _ = reportGateProblem(t, app, user, "10", map[string]string{"category": "stuck_open"}, nil)
+	assert.Equal(t, fiber.StatusCreated, status)
+}
@@ -1,16 +1,27 @@
 package handlers
 
 import (
-	"log"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
 	"ololo-gate/internal/services"
 	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
+// gateCommandLocal holds the ID of the gate command created for the request, for the gate event log
+const gateCommandLocal = "gate_command_id"
+
 // GetLocations godoc
 // @Summary Get all locations accessible to the current user
-// @Description Fetch all locations from third-party API based on user's phone with their gates
+// @Description Fetch all locations from third-party API based on user's phone with their gates, with admin overrides of display name, logo and order applied and gates under maintenance flagged. While the provider is unavailable the user's last loaded list is returned with degraded set to true and cached_at; gate states in it may be stale.
 // @Tags Gate Management
 // @Accept json
 // @Produce json
@@ -18,6 +29,8 @@ import (
 // @Success 200 {object} LocationsListResponse "Locations retrieved successfully"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
 // @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} APIResponse "Third-party API unavailable and no cached locations for this user"
 // @Router /api/v1/locations [get]
 func GetLocations(c *fiber.Ctx) error {
 	// Get user phone from context (set by JWT middleware)
@@ -26,31 +39,23 @@ func GetLocations(c *fiber.Ctx) error {
 		phone = "unknown"
 	}
 
-	log.Printf("Fetching locations for phone: %s", phone)
+	slog.DebugContext(c.UserContext(), "Fetching locations", "phone", phone)
 
-	client := services.NewThirdPartyClient()
-	locations, err := client.GetAllLocationsWithGates(phone)
+	client := services.NewThirdPartyClient().WithContext(c.UserContext())
+	locations, degraded, cachedAt, err := services.UserLocations(client, phone)
 	if err != nil {
-		log.Printf("Error fetching locations from third-party API: %v", err)
-		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
-			Success: false,
-			Message: "Failed to fetch locations",
-		})
+		slog.ErrorContext(c.UserContext(), "Error fetching locations from third-party API", "error", err)
+		return respondUpstreamError(c, err, "Failed to fetch locations")
 	}
+	locations = services.ApplyLocationOverrides(locations)
+	maintenance := gateMaintenanceOf(locations)
 
 	// Convert to DTOs (include gates)
 	var dtos []LocationDTO
 	for _, loc := range locations {
 		var gateDTOs []GateDTO
 		for _, gate := range loc.Gates {
-			gateDTOs = append(gateDTOs, GateDTO{
-				ID:               gate.ID,
-				Title:            gate.Title,
-				Description:      gate.Description,
-				LocationID:       gate.LocationID,
-				IsOpen:           gate.IsOpen,
-				GateIsHorizontal: gate.GateIsHorizontal,
-			})
+			gateDTOs = append(gateDTOs, toGateDTO(gate, maintenance))
 		}
 
 		dtos = append(dtos, LocationDTO{
@@ -62,16 +67,23 @@ func GetLocations(c *fiber.Ctx) error {
 		})
 	}
 
-	return c.Status(fiber.StatusOK).JSON(LocationsListResponse{
+	response := LocationsListResponse{
 		Success: true,
 		Message: "Locations retrieved successfully",
 		Data:    dtos,
-	})
+	}
+	if degraded {
+		response.Message = "Gate provider unavailable, showing cached locations"
+		response.Degraded = true
+		response.CachedAt = &cachedAt
+		response.RetryStrategy = middleware.Backoff(c, middleware.RetryStrategyExponential, providerRetryAfter())
+	}
+	return c.Status(fiber.StatusOK).JSON(response)
 }
 
 // GetGatesByLocation godoc
 // @Summary Get all gates for a specific location
-// @Description Fetch all gates accessible to the current user for a specific location from third-party API
+// @Description Fetch all gates accessible to the current user for a specific location from third-party API, with gates under maintenance flagged
 // @Tags Gate Management
 // @Accept json
 // @Produce json
@@ -81,6 +93,8 @@ func GetLocations(c *fiber.Ctx) error {
 // @Failure 400 {object} APIResponse "Invalid location ID"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
 // @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} APIResponse "Third-party API unavailable"
 // @Router /api/v1/locations/{locationId}/gates [get]
 func GetGatesByLocation(c *fiber.Ctx) error {
 	locationIDStr := c.Params("locationId")
@@ -98,29 +112,25 @@ func GetGatesByLocation(c *fiber.Ctx) error {
 		phone = "unknown"
 	}
 
-	log.Printf("Fetching gates for location %d for phone: %s", locationID, phone)
+	slog.DebugContext(c.UserContext(), "Fetching gates", "location_id", locationID, "phone", phone)
 
-	client := services.NewThirdPartyClient()
+	client := services.NewThirdPartyClient().WithContext(c.UserContext())
 	gates, err := client.GetGatesByPhoneAndLocation(phone, locationID)
 	if err != nil {
-		log.Printf("Error fetching gates from third-party API: %v", err)
-		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
-			Success: false,
-			Message: "Failed to fetch gates",
-		})
+		slog.ErrorContext(c.UserContext(), "Error fetching gates from third-party API", "error", err)
+		return respondUpstreamError(c, err, "Failed to fetch gates")
+	}
+
+	gateIDs := make([]int, len(gates))
+	for i, gate := range gates {
+		gateIDs[i] = gate.ID
 	}
+	maintenance := services.GatesUnderMaintenance(gateIDs)
 
 	// Convert to DTOs
 	var dtos []GateDTO
 	for _, gate := range gates {
-		dtos = append(dtos, GateDTO{
-			ID:               gate.ID,
-			LocationID:       gate.LocationID,
-			Title:            gate.Title,
-			Description:      gate.Description,
-			GateIsHorizontal: gate.GateIsHorizontal,
-			IsOpen:           gate.IsOpen,
-		})
+		dtos = append(dtos, toGateDTO(gate, maintenance))
 	}
 
 	return c.Status(fiber.StatusOK).JSON(GatesListResponse{
@@ -132,7 +142,7 @@ func GetGatesByLocation(c *fiber.Ctx) error {
 
 // OpenGate godoc
 // @Summary Open a gate
-// @Description Send command to open a specific gate to third-party API
+// @Description Send command to open a specific gate to third-party API. The returned command ID can be used to follow the command until the barrier is confirmed open. Only gates assigned to the user's phone can be opened. Gates at a frozen location cannot be opened, and gates under maintenance are refused with code gate_maintenance and the maintenance note.
 // @Tags Gate Management
 // @Accept json
 // @Produce json
@@ -141,7 +151,12 @@ func GetGatesByLocation(c *fiber.Ctx) error {
 // @Success 200 {object} GateActionResponse "Gate operation response"
 // @Failure 400 {object} APIResponse "Invalid gate ID"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 403 {object} APIResponse "The gate is not assigned to the user, or gate operations are blocked for this impersonation token"
+// @Failure 409 {object} APIResponse "A conflicting command is in progress for this gate"
+// @Failure 423 {object} LocationFrozenResponse "The gate's location is frozen, or the gate is under maintenance (GateMaintenanceRefusedResponse, code gate_maintenance)"
 // @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} GateActionResponse "Gate provider unavailable - the command was queued (command_status queued) or failed"
 // @Router /api/v1/locations/{gateId}/open [put]
 func OpenGate(c *fiber.Ctx) error {
 	gateIDStr := c.Params("gateId")
@@ -153,41 +168,12 @@ func OpenGate(c *fiber.Ctx) error {
 		})
 	}
 
-	// Get user phone from context
-	phone, ok := c.Locals("phone").(string)
-	if !ok {
-		phone = "unknown"
-	}
-
-	log.Printf("User %s attempting to open gate %d", phone, gateID)
-
-	client := services.NewThirdPartyClient()
-	success, err := client.OpenGate(gateID)
-	if err != nil {
-		log.Printf("Error opening gate from third-party API: %v", err)
-		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
-			Success: false,
-			Message: "Failed to open gate",
-		})
-	}
-
-	response := GateActionResponse{
-		Success: true,
-		Message: "Gate operation completed",
-		Data: GateActionData{
-			GateID: gateID,
-			Status: success,
-		},
-	}
-
-	log.Printf("OpenGate response for gate %d: Success=%v, Status=%v", gateID, response.Success, response.Data.Status)
-
-	return c.Status(fiber.StatusOK).JSON(response)
+	return executeGateCommand(c, gateID, services.GateActionOpen)
 }
 
 // CloseGate godoc
 // @Summary Close a gate
-// @Description Send command to close a specific gate to third-party API
+// @Description Send command to close a specific gate to third-party API. The returned command ID can be used to follow the command until the barrier is confirmed closed. Only gates assigned to the user's phone can be closed. Gates under maintenance are refused with code gate_maintenance and the maintenance note.
 // @Tags Gate Management
 // @Accept json
 // @Produce json
@@ -196,7 +182,12 @@ func OpenGate(c *fiber.Ctx) error {
 // @Success 200 {object} GateActionResponse "Gate operation response"
 // @Failure 400 {object} APIResponse "Invalid gate ID"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 403 {object} APIResponse "The gate is not assigned to the user, or gate operations are blocked for this impersonation token"
+// @Failure 409 {object} APIResponse "A conflicting command is in progress for this gate"
+// @Failure 423 {object} GateMaintenanceRefusedResponse "The gate is under maintenance (code gate_maintenance)"
 // @Failure 500 {object} APIResponse "Internal server error"
+// @Failure 502 {object} APIResponse "Third-party API returned an unexpected response"
+// @Failure 503 {object} GateActionResponse "Gate provider unavailable - the command was queued (command_status queued) or failed"
 // @Router /api/v1/locations/{gateId}/close [put]
 func CloseGate(c *fiber.Ctx) error {
 	gateIDStr := c.Params("gateId")
@@ -208,34 +199,245 @@ func CloseGate(c *fiber.Ctx) error {
 		})
 	}
 
-	// Get user phone from context
+	return executeGateCommand(c, gateID, services.GateActionClose)
+}
+
+// executeGateCommand runs a user's gate command and records the attempt in the gate event log
+func executeGateCommand(c *fiber.Ctx, gateID int, action string) error {
+	start := time.Now()
+	err := runGateCommand(c, gateID, action)
+	logGateAttempt(c, gateID, action, time.Since(start))
+	return err
+}
+
+// runGateCommand runs a user's gate command unless impersonation, a gate not assigned to the user, gate maintenance
+// or a location freeze blocks it
+func runGateCommand(c *fiber.Ctx, gateID int, action string) error {
+	if middleware.IsGateOperationBlocked(c) {
+		slog.WarnContext(c.UserContext(), "[GATE_BLOCKED] Gate command refused: admin is impersonating the user",
+			"action", action, "gate_id", gateID, "impersonator", c.Locals("impersonator_username"))
+		middleware.SetDenialReason(c, models.DenialImpersonation)
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "Gate operations are blocked while impersonating a user",
+		})
+	}
+
+	// Get user info from context (set by JWT middleware)
 	phone, ok := c.Locals("phone").(string)
 	if !ok {
 		phone = "unknown"
 	}
+	userID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		userID = uuid.Nil
+	}
 
-	log.Printf("User %s attempting to close gate %d", phone, gateID)
+	slog.InfoContext(c.UserContext(), "Gate command requested", "phone", phone, "action", action, "gate_id", gateID)
 
-	client := services.NewThirdPartyClient()
-	success, err := client.CloseGate(gateID)
+	// The provider takes commands for any gate ID, so only gates assigned to the user's phone are sent
+	allowed, err := services.UserCanAccessGate(services.NewThirdPartyClient().WithContext(c.UserContext()), phone, gateID)
 	if err != nil {
-		log.Printf("Error closing gate from third-party API: %v", err)
+		slog.ErrorContext(c.UserContext(), "[GATE_BLOCKED] Could not check access to gate", "phone", phone, "gate_id", gateID, "error", err)
+		return respondUpstreamError(c, err, "Failed to "+action+" gate")
+	}
+	if !allowed {
+		slog.WarnContext(c.UserContext(), "[GATE_BLOCKED] Gate command refused: gate is not assigned to the user",
+			"action", action, "gate_id", gateID, "phone", phone)
+		middleware.SetDenialReason(c, models.DenialGateNotAssigned)
+		return c.Status(fiber.StatusForbidden).JSON(APIResponse{
+			Success: false,
+			Message: "You do not have access to this gate",
+		})
+	}
+
+	maintenance, err := services.GateMaintenanceFor(gateID)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[GATE_BLOCKED] Could not check maintenance of gate", "gate_id", gateID, "error", err)
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
-			Message: "Failed to close gate",
+			Message: "Failed to " + action + " gate",
 		})
 	}
+	if maintenance != nil {
+		slog.WarnContext(c.UserContext(), "[GATE_BLOCKED] Gate command refused: gate is under maintenance",
+			"action", action, "gate_id", gateID, "phone", phone)
+		return respondGateMaintenance(c, maintenance)
+	}
+
+	// Frozen locations still let users close their gates
+	if action == services.GateActionOpen {
+		freeze, err := services.GateFreeze(gateID)
+		if err != nil {
+			slog.ErrorContext(c.UserContext(), "[GATE_BLOCKED] Could not check freezes for gate", "gate_id", gateID, "error", err)
+			return respondUpstreamError(c, err, "Failed to "+action+" gate")
+		}
+		if freeze != nil {
+			slog.WarnContext(c.UserContext(), "[GATE_BLOCKED] Gate opening refused: location is frozen",
+				"gate_id", gateID, "phone", phone, "location_id", freeze.LocationID, "freeze_id", freeze.ID)
+			return respondLocationFrozen(c, freeze)
+		}
+	}
+
+	return sendGateCommand(c, userID, phone, gateID, action)
+}
+
+// sendGateCommand records a gate command for the user (uuid.Nil and no phone for admin overrides),
+// sends it to the third-party API through the per-gate command guard and starts tracking it
+func sendGateCommand(c *fiber.Ctx, userID uuid.UUID, phone string, gateID int, action string) error {
+	cmd, err := services.CreateGateCommand(c.UserContext(), userID, phone, gateID, action)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to " + action + " gate",
+		})
+	}
+	c.Locals(gateCommandLocal, cmd.ID)
+
+	// Don't wait on a provider known to be down - queue the command to be sent once it recovers
+	if services.ProviderBreaker().IsOpen() {
+		return respondGateCommandQueued(c, cmd)
+	}
+	services.UpdateGateCommandStatus(c.UserContext(), cmd.ID, models.GateCommandExecuting, "")
+
+	client := services.NewThirdPartyClient().WithContext(c.UserContext())
+	success, err := services.GateCommands().Execute(gateID, action, func() (bool, error) {
+		if action == services.GateActionOpen {
+			return client.OpenGate(phone, gateID, cmd.ID.String())
+		}
+		return client.CloseGate(phone, gateID)
+	})
+	var conflictErr *services.GateCommandConflictError
+	if errors.As(err, &conflictErr) {
+		services.UpdateGateCommandStatus(c.UserContext(), cmd.ID, models.GateCommandFailed, conflictErr.Error())
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "Another " + conflictErr.PendingAction + " command is in progress for this gate. Please wait and try again.",
+		})
+	}
+	if errors.Is(err, services.ErrCircuitOpen) {
+		return respondGateCommandQueued(c, cmd)
+	}
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "Error sending gate command to third-party API", "action", action, "gate_id", gateID, "error", err)
+		services.UpdateGateCommandStatus(c.UserContext(), cmd.ID, models.GateCommandFailed, err.Error())
+		return respondUpstreamError(c, err, "Failed to "+action+" gate")
+	}
+
+	services.TrackGateCommand(c.UserContext(), cmd, success)
+
+	eventType := events.GateOpened
+	if action == services.GateActionClose {
+		eventType = events.GateClosed
+	}
+	events.Publish(eventType, map[string]interface{}{
+		"user_id":    userID,
+		"phone":      phone,
+		"gate_id":    gateID,
+		"command_id": cmd.ID,
+		"accepted":   success,
+		"sandbox":    cmd.Sandbox,
+	})
+
+	// Report the status as of now - the command usually keeps executing while the barrier moves
+	commandStatus := models.GateCommandExecuting
+	var current models.GateCommand
+	if err := db.DB.WithContext(c.UserContext()).Select("status").First(&current, "id = ?", cmd.ID).Error; err == nil {
+		commandStatus = current.Status
+	}
 
 	response := GateActionResponse{
 		Success: true,
 		Message: "Gate operation completed",
 		Data: GateActionData{
-			GateID: gateID,
-			Status: success,
+			GateID:        gateID,
+			Status:        success,
+			CommandID:     cmd.ID,
+			CommandStatus: commandStatus,
+			Sandbox:       cmd.Sandbox,
 		},
 	}
 
-	log.Printf("CloseGate response for gate %d: Success=%v, Status=%v", gateID, response.Success, response.Data.Status)
+	slog.InfoContext(c.UserContext(), "Gate command response",
+		"action", action, "gate_id", gateID, "success", response.Success, "status", response.Data.Status, "command_id", cmd.ID, "command_status", commandStatus)
 
 	return c.Status(fiber.StatusOK).JSON(response)
 }
+
+// logGateAttempt records a user's gate command attempt from the response sent for it
+func logGateAttempt(c *fiber.Ctx, gateID int, action string, latency time.Duration) {
+	var response struct {
+		Message string `json:"message"`
+		Data    struct {
+			CommandStatus string `json:"command_status"`
+		} `json:"data"`
+	}
+	// Refusals carry other data; only the message and the command status matter here
+	_ = json.Unmarshal(c.Response().Body(), &response)
+
+	status := c.Response().StatusCode()
+	result := models.GateAttemptFailed
+	switch {
+	case status < fiber.StatusMultipleChoices:
+		result = models.GateAttemptSent
+	case response.Data.CommandStatus == models.GateCommandQueued:
+		result = models.GateAttemptQueued
+	case status == fiber.StatusForbidden || status == fiber.StatusConflict || status == fiber.StatusLocked:
+		result = models.GateAttemptRefused
+	}
+
+	userID, _ := c.Locals("id").(uuid.UUID)
+	phone, _ := c.Locals("phone").(string)
+	entry := &models.GateEventLog{
+		UserID:     userID,
+		GateID:     gateID,
+		Action:     action,
+		Result:     result,
+		StatusCode: status,
+		Message:    response.Message,
+		LatencyMs:  latency.Milliseconds(),
+		IPAddress:  c.IP(),
+	}
+	if commandID, ok := c.Locals(gateCommandLocal).(uuid.UUID); ok {
+		entry.CommandID = &commandID
+	}
+	if locationID := services.CachedGateLocation(phone, gateID); locationID != 0 {
+		entry.LocationID = &locationID
+	}
+	services.RecordGateEventLog(entry)
+}
+
+// respondGateCommandQueued queues a command the provider cannot take right now and tells the client
+// so, rather than reporting a generic provider failure
+func respondGateCommandQueued(c *fiber.Ctx, cmd *models.GateCommand) error {
+	if !services.QueueGateCommand(c.UserContext(), cmd) {
+		slog.WarnContext(c.UserContext(), "Gate provider unavailable, gate command failed",
+			"action", cmd.Action, "command_id", cmd.ID, "gate_id", cmd.GateID)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(GateActionResponse{
+			Success: false,
+			Message: "Gate provider unavailable, try again later",
+			Data: GateActionData{
+				GateID:        cmd.GateID,
+				CommandID:     cmd.ID,
+				CommandStatus: models.GateCommandFailed,
+				Sandbox:       cmd.Sandbox,
+			},
+			RetryStrategy: middleware.Backoff(c, middleware.RetryStrategyExponential, providerRetryAfter()),
+		})
+	}
+
+	// Resending a queued command would open the gate twice, so the client polls its status
+	slog.WarnContext(c.UserContext(), "Gate provider unavailable, gate command queued",
+		"action", cmd.Action, "command_id", cmd.ID, "gate_id", cmd.GateID)
+	return c.Status(fiber.StatusServiceUnavailable).JSON(GateActionResponse{
+		Success: false,
+		Message: "Gate provider unavailable, command queued",
+		Data: GateActionData{
+			GateID:        cmd.GateID,
+			CommandID:     cmd.ID,
+			CommandStatus: models.GateCommandQueued,
+			Sandbox:       cmd.Sandbox,
+		},
+		RetryStrategy: middleware.Backoff(c, middleware.RetryStrategyPoll, providerRetryAfter()),
+	})
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"encoding/json"
 	"net/http/httptest"
+	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
 	"ololo-gate/internal/models"
 	"ololo-gate/internal/utils"
@@ -352,3 +353,40 @@ func TestCloseGate_Unauthorized(t *testing.T) {
 
 	assert.False(t, response.Success)
 }
+
+func TestGateCommand_GateNotAssigned(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	// Gates 40 and 50 are assigned to the user
+	server := freezeProvider()
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	user, token := createGateCommandTestUser(t, "+77019876543")
+	gateCommand := func(gateID, action string) (int, map[string]interface{}) {
+		req := httptest.NewRequest("PUT", "/api/v1/locations/"+gateID+"/"+action, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		return resp.StatusCode, result
+	}
+
+	for _, action := range []string{"open", "close"} {
+		status, result := gateCommand("60", action)
+		assert.Equal(t, fiber.StatusForbidden, status)
+		assert.Equal(t, "You do not have access to this gate", result["message"])
+	}
+	status, _ := gateCommand("40", "open")
+	assert.Equal(t, fiber.StatusOK, status)
+
+	// Refused commands are not sent, and are recorded as denials
+	var commands int64
+	db.DB.Model(&models.GateCommand{}).Where("user_id = ?", user.ID).Count(&commands)
+	assert.Equal(t, int64(1), commands)
+	var denials []models.SecurityDenial
+	db.DB.Where("reason = ?", models.DenialGateNotAssigned).Find(&denials)
+	assert.Len(t, denials, 2)
+}
@@ -0,0 +1,334 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PublishLegalDocumentRequest defines the structure for publishing a legal document version
+// @name PublishLegalDocumentRequest
+type PublishLegalDocumentRequest struct {
+	Kind    string `json:"kind" validate:"required" example:"terms"` // "terms" or "privacy"
+	Version string `json:"version" validate:"required" example:"2025-01"`
+	Title   string `json:"title" example:"Terms of Service"`
+	Content string `json:"content" validate:"required" example:"1. Use of the service..."`
+}
+
+// AcceptLegalDocumentRequest defines the structure for accepting a legal document
+// @name AcceptLegalDocumentRequest
+type AcceptLegalDocumentRequest struct {
+	Kind    string `json:"kind" validate:"required" example:"terms"`
+	Version string `json:"version" validate:"required" example:"2025-01"` // Must be the current version
+}
+
+// GetLegalDocuments godoc
+// @Summary Get current legal documents
+// @Description Retrieve the current version of the terms of service and the privacy policy (public)
+// @Tags Legal
+// @Produce json
+// @Success 200 {object} LegalDocumentsResponse "Legal documents retrieved successfully"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/legal [get]
+func GetLegalDocuments(c *fiber.Ctx) error {
+	current, err := services.CurrentLegalDocuments()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve legal documents",
+		})
+	}
+
+	data := []LegalDocumentDTO{}
+	for _, kind := range services.LegalKinds {
+		if doc, ok := current[kind]; ok {
+			data = append(data, toLegalDocumentDTO(doc))
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(LegalDocumentsResponse{
+		Success: true,
+		Message: "Legal documents retrieved successfully",
+		Data:    data,
+	})
+}
+
+// GetLegalDocument godoc
+// @Summary Get a legal document
+// @Description Retrieve the current version of the terms of service ("terms") or the privacy policy ("privacy"), or an earlier version (public)
+// @Tags Legal
+// @Produce json
+// @Param kind path string true "terms or privacy"
+// @Param version query string false "Version (default: current)"
+// @Success 200 {object} LegalDocumentResponse "Legal document retrieved successfully"
+// @Failure 400 {object} APIResponse "Unknown document kind"
+// @Failure 404 {object} APIResponse "Document not published"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/legal/{kind} [get]
+func GetLegalDocument(c *fiber.Ctx) error {
+	kind := c.Params("kind")
+	if !services.ValidLegalKind(kind) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Unknown document kind. Must be 'terms' or 'privacy'",
+		})
+	}
+
+	var doc models.LegalDocument
+	var err error
+	if version := c.Query("version"); version != "" {
+		err = db.DB.WithContext(c.UserContext()).Where("kind = ? AND version = ?", kind, version).First(&doc).Error
+	} else {
+		err = db.DB.WithContext(c.UserContext()).Where("kind = ?", kind).Order("created_at DESC").First(&doc).Error
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Document not published",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve legal document",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(LegalDocumentResponse{
+		Success: true,
+		Message: "Legal document retrieved successfully",
+		Data:    toLegalDocumentDTO(doc),
+	})
+}
+
+// PublishLegalDocument godoc
+// @Summary Publish a legal document version
+// @Description Publish a new version of the terms of service or the privacy policy. It becomes the current version, and users are asked to accept it through the X-Legal-Acceptance-Required header (super admin only)
+// @Tags Legal
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body PublishLegalDocumentRequest true "Document version"
+// @Success 201 {object} LegalDocumentResponse "Legal document published successfully"
+// @Failure 400 {object} APIResponse "Invalid request body or document kind"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 409 {object} APIResponse "Version already published"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/admin/legal [post]
+func PublishLegalDocument(c *fiber.Ctx) error {
+	var req PublishLegalDocumentRequest
+	if err := c.BodyParser(&req); err != nil || strings.TrimSpace(req.Version) == "" || strings.TrimSpace(req.Content) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body. kind, version and content are required",
+		})
+	}
+	if !services.ValidLegalKind(req.Kind) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Unknown document kind. Must be 'terms' or 'privacy'",
+		})
+	}
+
+	var existing int64
+	db.DB.WithContext(c.UserContext()).Model(&models.LegalDocument{}).Where("kind = ? AND version = ?", req.Kind, strings.TrimSpace(req.Version)).Count(&existing)
+	if existing > 0 {
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "This version is already published",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	doc := models.LegalDocument{
+		Kind:        req.Kind,
+		Version:     strings.TrimSpace(req.Version),
+		Title:       req.Title,
+		Content:     req.Content,
+		PublishedBy: adminUsername,
+	}
+	if err := services.PublishLegalDocument(&doc); err != nil {
+		middleware.RecordAudit(c, "publish_legal_document", "legal_document", "", "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to publish legal document",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "[LEGAL] Document published", "admin", adminUsername, "kind", doc.Kind, "version", doc.Version)
+	middleware.RecordAudit(c, "publish_legal_document", "legal_document", doc.ID.String(), "success", "")
+
+	return c.Status(fiber.StatusCreated).JSON(LegalDocumentResponse{
+		Success: true,
+		Message: "Legal document published successfully",
+		Data:    toLegalDocumentDTO(doc),
+	})
+}
+
+// GetMyLegalStatus godoc
+// @Summary Get my legal acceptance status
+// @Description For each current legal document, the version the user accepted and when, and whether acceptance is required
+// @Tags Legal
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} LegalStatusResponse "Legal acceptance status retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/legal [get]
+func GetMyLegalStatus(c *fiber.Ctx) error {
+	userID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		userID = uuid.Nil
+	}
+
+	data, err := legalStatus(c.UserContext(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve legal acceptance status",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(LegalStatusResponse{
+		Success: true,
+		Message: "Legal acceptance status retrieved successfully",
+		Data:    data,
+	})
+}
+
+// AcceptLegalDocument godoc
+// @Summary Accept a legal document
+// @Description Record that the user accepted the current version of the terms of service or the privacy policy
+// @Tags Legal
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AcceptLegalDocumentRequest true "Document kind and the current version"
+// @Success 200 {object} LegalStatusResponse "Legal document accepted"
+// @Failure 400 {object} APIResponse "Invalid request body or document kind"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 409 {object} APIResponse "Version is not the current version"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/legal/accept [post]
+func AcceptLegalDocument(c *fiber.Ctx) error {
+	var req AcceptLegalDocumentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	userID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		userID = uuid.Nil
+	}
+
+	_, err := services.AcceptLegalDocument(userID, req.Kind, req.Version, c.IP())
+	switch {
+	case errors.Is(err, services.ErrLegalKind):
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Unknown document kind. Must be 'terms' or 'privacy'",
+		})
+	case errors.Is(err, services.ErrLegalVersionNotCurrent):
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "Only the current version can be accepted. Fetch the document again",
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to record acceptance",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "[LEGAL] Document accepted", "user_id", userID, "kind", req.Kind, "version", req.Version)
+
+	data, err := legalStatus(c.UserContext(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve legal acceptance status",
+		})
+	}
+	if pending := pendingLegalKinds(data); len(pending) > 0 {
+		c.Set(middleware.LegalAcceptanceHeader, strings.Join(pending, ","))
+	} else {
+		c.Response().Header.Del(middleware.LegalAcceptanceHeader)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(LegalStatusResponse{
+		Success: true,
+		Message: "Legal document accepted",
+		Data:    data,
+	})
+}
+
+// legalStatus returns the user's acceptance of each current legal document
+func legalStatus(ctx context.Context, userID uuid.UUID) ([]LegalStatusDTO, error) {
+	current, err := services.CurrentLegalDocuments()
+	if err != nil {
+		return nil, err
+	}
+	accepted, err := services.LegalAcceptances(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := []LegalStatusDTO{}
+	for _, kind := range services.LegalKinds {
+		doc, ok := current[kind]
+		if !ok {
+			continue
+		}
+		status := LegalStatusDTO{Kind: kind, CurrentVersion: doc.Version, AcceptanceRequired: true}
+		if acceptance, ok := accepted[kind]; ok {
+			status.AcceptedVersion = acceptance.Version
+			status.AcceptedAt = &acceptance.AcceptedAt
+			status.AcceptanceRequired = false
+		} else {
+			// Report the latest earlier version the user accepted, if any
+			var previous models.LegalAcceptance
+			if err := db.DB.WithContext(ctx).Where("user_id = ? AND kind = ?", userID, kind).Order("accepted_at DESC").First(&previous).Error; err == nil {
+				status.AcceptedVersion = previous.Version
+				status.AcceptedAt = &previous.AcceptedAt
+			}
+		}
+		data = append(data, status)
+	}
+	return data, nil
+}
+
+// pendingLegalKinds returns the kinds in status that still have to be accepted
+func pendingLegalKinds(status []LegalStatusDTO) []string {
+	var pending []string
+	for _, s := range status {
+		if s.AcceptanceRequired {
+			pending = append(pending, s.Kind)
+		}
+	}
+	return pending
+}
+
+func toLegalDocumentDTO(doc models.LegalDocument) LegalDocumentDTO {
+	return LegalDocumentDTO{
+		Kind:        doc.Kind,
+		Version:     doc.Version,
+		Title:       doc.Title,
+		Content:     doc.Content,
+		PublishedAt: doc.CreatedAt,
+	}
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func legalRequest(t *testing.T, app *fiber.App, method, path, token string, body interface{}) (int, string, map[string]interface{}) {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, resp.Header.Get(middleware.LegalAcceptanceHeader), result
+}
+
+func TestLegalDocuments_AcceptanceRequiredAfterUpdate(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	services.InvalidateLegalDocuments()
+	defer services.InvalidateLegalDocuments()
+
+	_, token := createGateCommandTestUser(t, "+77771234567")
+
+	// Nothing is published yet, so nothing has to be accepted
+	_, header, _ := legalRequest(t, app, "GET", "/api/v1/auth/legal", token, nil)
+	assert.Empty(t, header)
+
+	for _, kind := range []string{models.LegalTermsOfService, models.LegalPrivacyPolicy} {
+		status, _ := mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/legal", map[string]string{"kind": kind, "version": "v1", "content": "Initial " + kind})
+		assert.Equal(t, fiber.StatusCreated, status)
+	}
+	status, _ := mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/legal", map[string]string{"kind": "terms", "version": "v9", "content": "x"})
+	assert.Equal(t, fiber.StatusForbidden, status)
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/legal", map[string]string{"kind": "terms", "version": "v1", "content": "again"})
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	status, header, result := legalRequest(t, app, "GET", "/api/v1/auth/legal", token, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "terms,privacy", header)
+	assert.Len(t, result["data"], 2)
+
+	// Accepting both documents clears the signal
+	status, _, _ = legalRequest(t, app, "POST", "/api/v1/auth/legal/accept", token, map[string]string{"kind": "terms", "version": "v1"})
+	assert.Equal(t, fiber.StatusOK, status)
+	status, header, _ = legalRequest(t, app, "POST", "/api/v1/auth/legal/accept", token, map[string]string{"kind": "privacy", "version": "v1"})
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, header)
+	_, header, _ = legalRequest(t, app, "GET", "/api/v1/auth/legal", token, nil)
+	assert.Empty(t, header)
+
+	// A new terms version has to be accepted again; the old one can no longer be
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/legal", map[string]string{"kind": "terms", "version": "v2", "content": "Updated terms"})
+	assert.Equal(t, fiber.StatusCreated, status)
+	_, header, result = legalRequest(t, app, "GET", "/api/v1/auth/legal", token, nil)
+	assert.Equal(t, "terms", header)
+	terms := result["data"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "v2", terms["current_version"])
+	assert.Equal(t, "v1", terms["accepted_version"])
+	assert.Equal(t, true, terms["acceptance_required"])
+
+	status, _, _ = legalRequest(t, app, "POST", "/api/v1/auth/legal/accept", token, map[string]string{"kind": "terms", "version": "v1"})
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	// Documents are public, and earlier versions stay readable
+	status, _, result = legalRequest(t, app, "GET", "/api/v1/legal/terms?version=v1", "", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Initial terms", result["data"].(map[string]interface{})["content"])
+	status, _, result = legalRequest(t, app, "GET", "/api/v1/legal", "", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 2)
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/services"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// loginLocked responds 429 with Retry-After if the account or the client IP is locked out after
+// too many failed logins. Locked out logins are refused before the password is checked.
+func loginLocked(c *fiber.Ctx, account string) (bool, error) {
+	wait, locked := services.LoginAttempts().Locked(account, services.LoginIPKey(c.IP()))
+	if !locked {
+		return false, nil
+	}
+	return true, tooManyLoginAttempts(c, wait)
+}
+
+// loginFailed records a failed login of account from the client IP and returns the 401 response
+// already sent, or a 429 instead if this failure locked the account or IP out
+func loginFailed(c *fiber.Ctx, account string, response error) error {
+	if lockout, locked := services.LoginAttempts().Fail(account, services.LoginIPKey(c.IP())); locked {
+		return tooManyLoginAttempts(c, lockout)
+	}
+	return response
+}
+
+// tooManyLoginAttempts responds 429 with the remaining lockout
+func tooManyLoginAttempts(c *fiber.Ctx, wait time.Duration) error {
+	return c.Status(fiber.StatusTooManyRequests).JSON(APIResponse{
+		Success:       false,
+		Message:       "Too many failed login attempts. Try again later.",
+		RetryStrategy: middleware.Backoff(c, middleware.RetryStrategyFixed, wait),
+	})
+}
+
+// userLoginAccount is the login guard key of a user login request: the canonical phone or the
+// email, whether or not it belongs to a user, so unknown accounts are throttled alike
+func userLoginAccount(req LoginRequest) string {
+	if req.Email != "" {
+		return services.UserLoginKey(strings.TrimSpace(req.Email))
+	}
+	if phone, err := phonenumber.Normalize(req.Phone); err == nil {
+		return services.UserLoginKey(phone)
+	}
+	return services.UserLoginKey(req.Phone)
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/tests"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// enableLoginGuard turns on brute-force protection and clears the lockouts of keys afterwards,
+// as the login guard is process-wide
+func enableLoginGuard(t *testing.T, maxFailed, ipMaxFailed int, keys ...string) {
+	config.AppConfig().Login = config.LoginConfig{
+		MaxFailedAttempts:   maxFailed,
+		IPMaxFailedAttempts: ipMaxFailed,
+		FailureWindow:       15 * time.Minute,
+		Lockout:             time.Minute,
+		MaxLockout:          time.Hour,
+	}
+	// app.Test requests come from 0.0.0.0
+	keys = append(keys, services.LoginIPKey("0.0.0.0"))
+	t.Cleanup(func() {
+		for _, key := range keys {
+			services.LoginAttempts().Succeed(key)
+		}
+	})
+}
+
+func adminLoginStatus(t *testing.T, app *fiber.App, username, password string) (int, string) {
+	body, _ := json.Marshal(AdminLoginRequest{Username: username, Password: password})
+	req := httptest.NewRequest("POST", "/api/v1/admin/login", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.StatusCode, resp.Header.Get(fiber.HeaderRetryAfter)
+}
+
+func TestAdminLogin_LocksOutAfterFailedAttempts(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	enableLoginGuard(t, 3, 100, services.AdminLoginKey("lockedadmin"))
+
+	db.DB.Create(&models.Admin{ID: uuid.New(), Username: "lockedadmin", Password: "password123", Role: models.RoleSuper})
+	db.DB.Create(&models.Admin{ID: uuid.New(), Username: "otheradmin", Password: "password123", Role: models.RoleSuper})
+
+	for i := 0; i < 2; i++ {
+		status, _ := adminLoginStatus(t, app, "lockedadmin", "wrongpassword")
+		assert.Equal(t, fiber.StatusUnauthorized, status)
+	}
+	status, retryAfter := adminLoginStatus(t, app, "lockedadmin", "wrongpassword")
+	assert.Equal(t, fiber.StatusTooManyRequests, status)
+	assert.Equal(t, "60", retryAfter)
+
+	// The right password is refused during the lockout; other accounts are not affected
+	status, _ = adminLoginStatus(t, app, "LockedAdmin", "password123")
+	assert.Equal(t, fiber.StatusTooManyRequests, status)
+	status, _ = adminLoginStatus(t, app, "otheradmin", "password123")
+	assert.Equal(t, fiber.StatusOK, status)
+}
+
+func TestLogin_LocksOutClientIP(t *testing.T) {
+	app := setupAuthTest(t)
+	defer tests.CleanupTestDB(t)
+	enableLoginGuard(t, 0, 3, services.UserLoginKey("+77771234567"))
+
+	tests.CreateTestUser(t, "+77771234567", "correctpassword")
+
+	// Guessing across accounts, including unknown ones, locks the client IP out
+	for i, phone := range []string{"+77771234567", "+77770000001", "+77770000002"} {
+		resp, err := tests.MakeRequest(app, "POST", "/login", map[string]string{"phone": phone, "password": "wrongpassword"}, nil)
+		assert.NoError(t, err)
+		if i < 2 {
+			assert.Equal(t, fiber.StatusUnauthorized, resp.Code)
+		} else {
+			assert.Equal(t, fiber.StatusTooManyRequests, resp.Code)
+		}
+	}
+
+	resp, err := tests.MakeRequest(app, "POST", "/login", map[string]string{"phone": "+77771234567", "password": "correctpassword"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusTooManyRequests, resp.Code)
+	result := tests.ParseJSONResponse(t, resp)
+	retry := result["retry_strategy"].(map[string]interface{})
+	assert.Equal(t, "fixed", retry["strategy"])
+	assert.Equal(t, float64(60), retry["retry_after_seconds"])
+}
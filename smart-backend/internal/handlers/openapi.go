@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/swaggo/swag"
+)
+
+// GetOpenAPISpec serves the API description embedded in the binary by the generated docs
+// package, the same document the typed client in pkg/apiclient is generated from
+// (make client). Exposure follows SWAGGER_MODE, like /swagger.
+func GetOpenAPISpec(c *fiber.Ctx) error {
+	spec, err := swag.ReadDoc()
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[OPENAPI] API description not available", "error", err)
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "API description not available",
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	return c.SendString(spec)
+}
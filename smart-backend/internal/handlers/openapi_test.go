@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"encoding/json"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/middleware"
+	"testing"
+
+	_ "ololo-gate/docs"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOpenAPISpec(t *testing.T) {
+	_, cleanup := SetupTestApp()
+	defer cleanup()
+	defer func() { config.AppConfig().Swagger = config.SwaggerConfig{} }()
+
+	app := fiber.New()
+	app.Get("/openapi.json", middleware.SwaggerAccess(), GetOpenAPISpec)
+
+	config.AppConfig().Swagger = config.SwaggerConfig{Mode: config.SwaggerPublic}
+	resp := swaggerRequest(t, app, "GET", "/openapi.json", nil)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "application/json")
+	var spec map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&spec))
+	assert.Equal(t, "2.0", spec["swagger"])
+	assert.Contains(t, spec["paths"], "/api/v1/auth/login")
+	// Routes added after the baseline are in the served description too
+	assert.Contains(t, spec["paths"], "/api/v1/auth/login-otp/request")
+	assert.Contains(t, spec["paths"], "/api/v1/admin/feed")
+	assert.Contains(t, spec["paths"], "/api/v1/scim/v2/Users")
+
+	// Exposure follows SWAGGER_MODE
+	config.AppConfig().Swagger = config.SwaggerConfig{Mode: config.SwaggerDisabled}
+	assert.Equal(t, fiber.StatusNotFound, swaggerRequest(t, app, "GET", "/openapi.json", nil).StatusCode)
+}
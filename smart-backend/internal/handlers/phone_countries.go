@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"fmt"
+	"ololo-gate/internal/phonenumber"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PhoneCountryNotAllowedCode is the error code of numbers from countries outside PHONE_ALLOWED_COUNTRIES
+const PhoneCountryNotAllowedCode = "phone_country_not_allowed"
+
+// phoneCountryNotAllowedMessage explains which countries' numbers are accepted, e.g. "+996 (KG), +7 (KZ)"
+func phoneCountryNotAllowedMessage() string {
+	allowed := phonenumber.AllowedCountries()
+	countries := make([]string, len(allowed))
+	for i, code := range allowed {
+		countries[i] = fmt.Sprintf("%s (%s)", phonenumber.CallingCode(code), code)
+	}
+	return "Phone numbers from this country are not accepted. Allowed: " + strings.Join(countries, ", ")
+}
+
+// respondPhoneCountryNotAllowed rejects a new number from a country outside PHONE_ALLOWED_COUNTRIES
+func respondPhoneCountryNotAllowed(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+		Success: false,
+		Message: phoneCountryNotAllowedMessage(),
+		Data: PhoneCountryNotAllowedData{
+			Code:             PhoneCountryNotAllowedCode,
+			AllowedCountries: phonenumber.AllowedCountries(),
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhoneCountries_NewNumbersRestricted(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Phone.AllowedCountries = []string{"KG", "KZ"}
+	defer func() { config.AppConfig().Phone.AllowedCountries = nil }()
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/auth/check-phone?phone=%2B79161234567", nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, PhoneCountryNotAllowedCode, data["code"])
+	assert.Equal(t, []interface{}{"KG", "KZ"}, data["allowed_countries"])
+	assert.Contains(t, result["message"], "+996 (KG), +7 (KZ)")
+
+	status, _ = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/auth/check-phone?phone=%2B996555123456", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	status, result = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/users", map[string]interface{}{
+		"phone":    "+79161234567",
+		"password": "password123",
+	})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	assert.Equal(t, PhoneCountryNotAllowedCode, result["data"].(map[string]interface{})["code"])
+}
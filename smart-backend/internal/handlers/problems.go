@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/services"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProblemContentType is the media type of RFC 7807 error responses
+const ProblemContentType = "application/problem+json"
+
+// Problem codes. Each maps to a documented problem type URI under /problems/.
+const (
+	ProblemBadRequest          = "bad-request"
+	ProblemUnauthorized        = "unauthorized"
+	ProblemSessionRevoked      = "session-revoked"
+	ProblemDeviceMismatch      = "device-mismatch"
+	ProblemForbidden           = "forbidden"
+	ProblemNotFound            = "not-found"
+	ProblemMethodNotAllowed    = "method-not-allowed"
+	ProblemConflict            = "conflict"
+	ProblemRateLimited         = "rate-limited"
+	ProblemServiceUnavailable  = "service-unavailable"
+	ProblemProviderUnavailable = "provider-unavailable"
+	ProblemProviderCircuitOpen = "provider-circuit-open"
+	ProblemProviderMalformed   = "provider-malformed-response"
+	ProblemProviderNotFound    = "provider-not-found"
+	ProblemProviderRejected    = "provider-rejected"
+	ProblemInternal            = "internal-error"
+)
+
+// problemTitles are the short, human-readable summaries of each problem type
+var problemTitles = map[string]string{
+	ProblemBadRequest:          "Bad Request",
+	ProblemUnauthorized:        "Unauthorized",
+	ProblemSessionRevoked:      "Session Revoked",
+	ProblemDeviceMismatch:      "Token Not Valid For This Device",
+	ProblemForbidden:           "Forbidden",
+	ProblemNotFound:            "Not Found",
+	ProblemMethodNotAllowed:    "Method Not Allowed",
+	ProblemConflict:            "Conflict",
+	ProblemRateLimited:         "Too Many Requests",
+	ProblemServiceUnavailable:  "Service Unavailable",
+	ProblemProviderUnavailable: "Gate Provider Unavailable",
+	ProblemProviderCircuitOpen: "Gate Provider Circuit Open",
+	ProblemProviderMalformed:   "Gate Provider Returned An Invalid Response",
+	ProblemProviderNotFound:    "Not Found At Gate Provider",
+	ProblemProviderRejected:    "Gate Provider Rejected The Request",
+	ProblemInternal:            "Internal Server Error",
+}
+
+// ProblemError is an error that renders as a specific problem type.
+// v2 handlers return it instead of writing the response themselves.
+type ProblemError struct {
+	Status int
+	Code   string
+	Detail string
+}
+
+func (e *ProblemError) Error() string {
+	return e.Detail
+}
+
+// NewProblem creates a ProblemError for the given status, problem code and detail
+func NewProblem(status int, code, detail string) *ProblemError {
+	return &ProblemError{Status: status, Code: code, Detail: detail}
+}
+
+// ErrorHandler is the central Fiber error handler. Requests to /api/v2 and clients asking for
+// application/problem+json get RFC 7807 responses; v1 keeps its {success, message} shape.
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	problem, upstream := toProblem(err)
+	retry := problemRetryStrategy(c, problem)
+
+	if !wantsProblemJSON(c) {
+		return c.Status(problem.Status).JSON(APIResponse{
+			Success:       false,
+			Message:       err.Error(),
+			RetryStrategy: retry,
+		})
+	}
+
+	if problem.Status >= fiber.StatusInternalServerError && problem.Code == ProblemInternal {
+		slog.ErrorContext(c.UserContext(), "[ERROR] Request failed", "method", c.Method(), "path", c.Path(), "error", err)
+	}
+
+	body := ProblemDetails{
+		Type:          "/problems/" + problem.Code,
+		Title:         problemTitles[problem.Code],
+		Status:        problem.Status,
+		Detail:        problem.Detail,
+		Instance:      c.OriginalURL(),
+		CorrelationID: correlationID(c),
+		Upstream:      upstream,
+		RetryStrategy: retry,
+	}
+
+	return c.Status(problem.Status).JSON(body, ProblemContentType)
+}
+
+// problemRetryStrategy returns the backoff hints for throttled and unavailable responses, nil otherwise
+func problemRetryStrategy(c *fiber.Ctx, problem *ProblemError) *middleware.RetryStrategy {
+	switch {
+	case problem.Code == ProblemProviderUnavailable:
+		return middleware.Backoff(c, middleware.RetryStrategyExponential, providerRetryAfter())
+	case problem.Status == fiber.StatusTooManyRequests, problem.Status == fiber.StatusServiceUnavailable:
+		return middleware.Backoff(c, middleware.RetryStrategyExponential, defaultRetryAfter)
+	}
+	return nil
+}
+
+// wantsProblemJSON reports whether the error should be rendered as problem+json
+func wantsProblemJSON(c *fiber.Ctx) bool {
+	return strings.HasPrefix(c.Path(), "/api/v2") || strings.Contains(c.Get(fiber.HeaderAccept), ProblemContentType)
+}
+
+// toProblem maps an error to its problem type. Unclassified errors become internal errors
+// whose message is not exposed to the client.
+func toProblem(err error) (*ProblemError, *UpstreamErrorDTO) {
+	var problem *ProblemError
+	if errors.As(err, &problem) {
+		return problem, nil
+	}
+
+	var upstreamErr *services.UpstreamError
+	if errors.As(err, &upstreamErr) {
+		dto := toUpstreamErrorDTO(upstreamErr)
+		code := upstreamProblemCode(upstreamErr.Kind)
+		if dto.Code == CircuitOpenCode {
+			code = ProblemProviderCircuitOpen
+		}
+		return NewProblem(upstreamStatusCode(upstreamErr.Kind), code, upstreamErr.Detail), &dto
+	}
+
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return NewProblem(fiberErr.Code, problemCodeForStatus(fiberErr.Code), fiberErr.Message), nil
+	}
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return NewProblem(fiber.StatusNotFound, ProblemNotFound, "The requested resource was not found"), nil
+	case errors.Is(err, services.ErrSessionRevoked):
+		return NewProblem(fiber.StatusUnauthorized, ProblemSessionRevoked, "Session has been revoked. Please login again."), nil
+	case errors.Is(err, services.ErrDeviceMismatch):
+		return NewProblem(fiber.StatusUnauthorized, ProblemDeviceMismatch, "Token is not valid for this device"), nil
+	case errors.Is(err, context.DeadlineExceeded):
+		return NewProblem(fiber.StatusServiceUnavailable, ProblemServiceUnavailable, "The request took longer than allowed"), nil
+	}
+
+	return NewProblem(fiber.StatusInternalServerError, ProblemInternal, "An unexpected error occurred"), nil
+}
+
+// upstreamProblemCode maps an upstream error kind to its problem code
+func upstreamProblemCode(kind services.UpstreamErrorKind) string {
+	switch kind {
+	case services.UpstreamUnavailable:
+		return ProblemProviderUnavailable
+	case services.UpstreamMalformed:
+		return ProblemProviderMalformed
+	case services.UpstreamNotFound:
+		return ProblemProviderNotFound
+	default:
+		return ProblemProviderRejected
+	}
+}
+
+// problemCodeForStatus maps a plain HTTP status to a generic problem code
+func problemCodeForStatus(status int) string {
+	switch status {
+	case fiber.StatusBadRequest, fiber.StatusUnprocessableEntity:
+		return ProblemBadRequest
+	case fiber.StatusUnauthorized:
+		return ProblemUnauthorized
+	case fiber.StatusForbidden:
+		return ProblemForbidden
+	case fiber.StatusNotFound:
+		return ProblemNotFound
+	case fiber.StatusMethodNotAllowed:
+		return ProblemMethodNotAllowed
+	case fiber.StatusConflict:
+		return ProblemConflict
+	case fiber.StatusTooManyRequests:
+		return ProblemRateLimited
+	case fiber.StatusServiceUnavailable:
+		return ProblemServiceUnavailable
+	}
+	if status >= 400 && status < 500 {
+		return ProblemBadRequest
+	}
+	return ProblemInternal
+}
+
+// correlationID returns the ID that ties the response to server logs: the one middleware.RequestID
+// gave the request, or on routes without it the client's X-Request-ID or a new ID echoed back
+func correlationID(c *fiber.Ctx) string {
+	if id, ok := c.Locals("request_id").(string); ok && id != "" {
+		return id
+	}
+	id := c.Get("X-Request-ID")
+	if id == "" {
+		id = uuid.NewString()
+	}
+	c.Set("X-Request-ID", id)
+	return id
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupProblemTestApp() *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Get("/api/v2/upstream", func(c *fiber.Ctx) error {
+		return &services.UpstreamError{Kind: services.UpstreamUnavailable, Operation: "open_gate", StatusCode: 503, Detail: "provider down"}
+	})
+	app.Get("/api/v2/circuit-open", func(c *fiber.Ctx) error {
+		return circuitOpenError()
+	})
+	app.Get("/api/v1/circuit-open", func(c *fiber.Ctx) error {
+		return respondUpstreamError(c, circuitOpenError(), "Failed to get locations")
+	})
+	app.Get("/api/v2/conflict", func(c *fiber.Ctx) error {
+		return NewProblem(fiber.StatusConflict, ProblemConflict, "Gate is already opening")
+	})
+	app.Get("/api/v2/internal", func(c *fiber.Ctx) error {
+		return errors.New("pq: connection refused")
+	})
+	app.Get("/api/v1/internal", func(c *fiber.Ctx) error {
+		return fiber.NewError(fiber.StatusBadRequest, "bad input")
+	})
+	return app
+}
+
+// circuitOpenError is the error of a provider call refused by the open circuit breaker
+func circuitOpenError() error {
+	return &services.UpstreamError{Kind: services.UpstreamUnavailable, Operation: "get_locations", Detail: "provider unavailable, circuit breaker open", Err: services.ErrCircuitOpen}
+}
+
+func getProblem(t *testing.T, app *fiber.App, path string, headers map[string]string) (*httptestResponse, ProblemDetails) {
+	req := httptest.NewRequest("GET", path, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var problem ProblemDetails
+	json.NewDecoder(resp.Body).Decode(&problem)
+	return &httptestResponse{Status: resp.StatusCode, ContentType: resp.Header.Get("Content-Type"), RequestID: resp.Header.Get("X-Request-ID")}, problem
+}
+
+type httptestResponse struct {
+	Status      int
+	ContentType string
+	RequestID   string
+}
+
+func TestErrorHandler_UpstreamProblem(t *testing.T) {
+	app := setupProblemTestApp()
+
+	resp, problem := getProblem(t, app, "/api/v2/upstream", nil)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.Status)
+	assert.Equal(t, ProblemContentType, resp.ContentType)
+	assert.Equal(t, "/problems/provider-unavailable", problem.Type)
+	assert.Equal(t, "Gate Provider Unavailable", problem.Title)
+	assert.Equal(t, 503, problem.Status)
+	assert.Equal(t, "/api/v2/upstream", problem.Instance)
+	assert.NotEmpty(t, problem.CorrelationID)
+	assert.Equal(t, problem.CorrelationID, resp.RequestID)
+	assert.NotNil(t, problem.Upstream)
+	assert.Equal(t, "open_gate", problem.Upstream.Operation)
+	if assert.NotNil(t, problem.RetryStrategy) {
+		assert.Equal(t, middleware.RetryStrategyExponential, problem.RetryStrategy.Strategy)
+		assert.Equal(t, 5, problem.RetryStrategy.MaxAttempts)
+	}
+}
+
+func TestErrorHandler_CircuitOpenProblem(t *testing.T) {
+	app := setupProblemTestApp()
+
+	resp, problem := getProblem(t, app, "/api/v2/circuit-open", nil)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.Status)
+	assert.Equal(t, "/problems/provider-circuit-open", problem.Type)
+	assert.Equal(t, "Gate Provider Circuit Open", problem.Title)
+	if assert.NotNil(t, problem.Upstream) {
+		assert.Equal(t, "provider_unavailable", problem.Upstream.Kind)
+		assert.Equal(t, CircuitOpenCode, problem.Upstream.Code)
+	}
+	assert.NotNil(t, problem.RetryStrategy)
+}
+
+func TestRespondUpstreamError_CircuitOpenCode(t *testing.T) {
+	app := setupProblemTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/circuit-open", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusServiceUnavailable, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get("Retry-After"))
+
+	var response map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&response)
+	data := response["data"].(map[string]interface{})
+	assert.Equal(t, "provider_unavailable", data["kind"])
+	assert.Equal(t, CircuitOpenCode, data["code"])
+}
+
+func TestErrorHandler_ExplicitProblemAndCorrelationID(t *testing.T) {
+	app := setupProblemTestApp()
+
+	resp, problem := getProblem(t, app, "/api/v2/conflict", map[string]string{"X-Request-ID": "req-123"})
+	assert.Equal(t, fiber.StatusConflict, resp.Status)
+	assert.Equal(t, "/problems/conflict", problem.Type)
+	assert.Equal(t, "Gate is already opening", problem.Detail)
+	assert.Equal(t, "req-123", problem.CorrelationID)
+}
+
+func TestErrorHandler_HidesInternalErrors(t *testing.T) {
+	app := setupProblemTestApp()
+
+	resp, problem := getProblem(t, app, "/api/v2/internal", nil)
+	assert.Equal(t, fiber.StatusInternalServerError, resp.Status)
+	assert.Equal(t, "/problems/internal-error", problem.Type)
+	assert.NotContains(t, problem.Detail, "connection refused")
+}
+
+func TestErrorHandler_UnknownV2RouteIsProblem(t *testing.T) {
+	app := setupProblemTestApp()
+
+	resp, problem := getProblem(t, app, "/api/v2/missing", nil)
+	assert.Equal(t, fiber.StatusNotFound, resp.Status)
+	assert.Equal(t, ProblemContentType, resp.ContentType)
+	assert.Equal(t, "/problems/not-found", problem.Type)
+}
+
+func TestErrorHandler_V1KeepsLegacyShape(t *testing.T) {
+	app := setupProblemTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v1/internal", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), fiber.MIMEApplicationJSON)
+
+	var response APIResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	assert.False(t, response.Success)
+	assert.Equal(t, "bad input", response.Message)
+
+	// v1 clients can opt in to problem+json
+	respV1, problem := getProblem(t, app, "/api/v1/internal", map[string]string{"Accept": ProblemContentType})
+	assert.Equal(t, ProblemContentType, respV1.ContentType)
+	assert.Equal(t, "/problems/bad-request", problem.Type)
+}
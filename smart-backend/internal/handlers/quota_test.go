@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminQuota_RejectsAfterLimit(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	_, token := createNotificationTestAdmin(t)
+	config.AppConfig().Quotas.AdminHourly = 2
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest("GET", "/api/v1/admin/notifications", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, "2", resp.Header.Get("X-Quota-Limit"))
+		assert.NotEmpty(t, resp.Header.Get("X-Quota-Reset"))
+	}
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/notifications", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "0", resp.Header.Get("X-Quota-Remaining"))
+	assert.NotEmpty(t, resp.Header.Get("Retry-After"))
+	assert.NotEmpty(t, resp.Header.Get("X-RateLimit-Reset"))
+
+	var body APIResponse
+	json.NewDecoder(resp.Body).Decode(&body)
+	if assert.NotNil(t, body.RetryStrategy) {
+		assert.Equal(t, middleware.RetryStrategyFixed, body.RetryStrategy.Strategy)
+		assert.Equal(t, resp.Header.Get("Retry-After"), strconv.Itoa(body.RetryStrategy.RetryAfterSeconds))
+		assert.Equal(t, resp.Header.Get("X-RateLimit-Reset"), strconv.FormatInt(body.RetryStrategy.ResetAt, 10))
+	}
+}
+
+func TestAdminQuota_NoHeadersWhenUnlimited(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	_, token := createNotificationTestAdmin(t)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/notifications", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("X-Quota-Limit"))
+}
+
+func TestAPIKeyQuota_CountsPerKey(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Quotas.APIKeyHourly = 1
+	_, first, err := services.CreateAPIKey("Okta", []string{models.APIKeyScopeSCIM}, "admin")
+	assert.NoError(t, err)
+	_, second, err := services.CreateAPIKey("Azure", []string{models.APIKeyScopeSCIM}, "admin")
+	assert.NoError(t, err)
+
+	status := func(key string) int {
+		req := httptest.NewRequest("GET", "/api/v1/scim/v2/Users", nil)
+		req.Header.Set("Authorization", "Bearer "+key)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+	assert.Equal(t, fiber.StatusOK, status(first))
+	assert.Equal(t, fiber.StatusTooManyRequests, status(first))
+	assert.Equal(t, fiber.StatusOK, status(second))
+}
+
+func TestExportQuota_LimitsExportsPerDay(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Quotas.ExportDaily = 1
+	admin := models.Admin{ID: uuid.New(), Username: "quota-export-admin", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	request := func(method, path string) *http.Response {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	// The first export of the day counts against the export quota
+	first := request("POST", "/api/v1/admin/exports")
+	assert.NotEqual(t, fiber.StatusTooManyRequests, first.StatusCode)
+	assert.Equal(t, "1", first.Header.Get("X-Quota-Limit"))
+
+	// Both export endpoints share the daily quota; other admin endpoints do not count
+	assert.Equal(t, fiber.StatusTooManyRequests, request("GET", "/api/v1/admin/gate-events/export").StatusCode)
+	assert.Equal(t, fiber.StatusOK, request("GET", "/api/v1/admin/notifications").StatusCode)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http/httptest"
+	"ololo-gate/internal/logging"
+	"ololo-gate/internal/middleware"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRequestLogTestApp() *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	app.Use(middleware.RequestID(), middleware.AccessLog())
+	app.Get("/api/v2/context", func(c *fiber.Ctx) error {
+		return c.SendString(logging.RequestID(c.UserContext()))
+	})
+	app.Get("/api/v2/conflict", func(c *fiber.Ctx) error {
+		return NewProblem(fiber.StatusConflict, ProblemConflict, "Gate is already opening")
+	})
+	return app
+}
+
+// captureLogs sends the default logger's lines to a buffer until the test ends
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var out bytes.Buffer
+	logger, err := logging.New(&out, "json")
+	assert.NoError(t, err)
+	previous := slog.Default()
+	slog.SetDefault(logger)
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &out
+}
+
+func TestRequestID_KeepsValidClientID(t *testing.T) {
+	app := setupRequestLogTestApp()
+
+	req := httptest.NewRequest("GET", "/api/v2/context", nil)
+	req.Header.Set("X-Request-ID", "client-trace.42")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "client-trace.42", resp.Header.Get("X-Request-ID"))
+
+	buf := new(bytes.Buffer)
+	buf.ReadFrom(resp.Body)
+	assert.Equal(t, "client-trace.42", buf.String())
+
+	problemResp, problem := getProblem(t, app, "/api/v2/conflict", map[string]string{"X-Request-ID": "client-trace.43"})
+	assert.Equal(t, "client-trace.43", problemResp.RequestID)
+	assert.Equal(t, "client-trace.43", problem.CorrelationID)
+}
+
+func TestRequestID_ReplacesMissingOrInvalidClientID(t *testing.T) {
+	app := setupRequestLogTestApp()
+
+	for _, sent := range []string{"", "two words", strings.Repeat("a", 129), `"}{"level":"ERROR"`} {
+		req := httptest.NewRequest("GET", "/api/v2/context", nil)
+		if sent != "" {
+			req.Header.Set("X-Request-ID", sent)
+		}
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		id := resp.Header.Get("X-Request-ID")
+		assert.NotEqual(t, sent, id)
+		_, err = uuid.Parse(id)
+		assert.NoError(t, err, "sent %q", sent)
+	}
+}
+
+func TestAccessLog_LogsFinalStatusWithRequestID(t *testing.T) {
+	out := captureLogs(t)
+	app := setupRequestLogTestApp()
+
+	resp, _ := getProblem(t, app, "/api/v2/conflict", nil)
+	assert.Equal(t, fiber.StatusConflict, resp.Status)
+
+	var line map[string]any
+	for _, raw := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var entry map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(raw), &entry))
+		if entry["msg"] == "request" {
+			line = entry
+		}
+	}
+	assert.NotNil(t, line)
+	assert.Equal(t, "GET", line["method"])
+	assert.Equal(t, "/api/v2/conflict", line["path"])
+	assert.Equal(t, float64(fiber.StatusConflict), line["status"])
+	assert.Equal(t, resp.RequestID, line["request_id"])
+	assert.Contains(t, line, "latency_ms")
+}
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/services"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,10 +19,36 @@ type HealthCheckResponse struct {
 	Success     bool   `json:"success" example:"true" validate:"required"`
 	Message     string `json:"message" example:"Ololo Gate API is running" validate:"required"`
 	Status      string `json:"status" example:"healthy" validate:"required"`
-	Timestamp   string `json:"timestamp" example:"2025-01-15T10:30:45Z" validate:"required"`
-	Uptime      string `json:"uptime" example:"1h30m45s" validate:"required"`
-	Environment string `json:"environment" example:"production" validate:"required"`
-	Version     string `json:"version" example:"1.0.0" validate:"required"`
+	Timestamp   string `json:"timestamp,omitempty" example:"2025-01-15T10:30:45Z"` // Details are left out without the HEALTH_TOKEN, when it is set
+	Uptime      string `json:"uptime,omitempty" example:"1h30m45s"`
+	Environment string `json:"environment,omitempty" example:"production"`
+	Version     string `json:"version,omitempty" example:"1.0.0"`
+}
+
+// ========== Upstream Errors ==========
+
+// UpstreamErrorDTO describes a failed third-party API call in error responses
+// @name UpstreamErrorDTO
+type UpstreamErrorDTO struct {
+	Kind       string `json:"kind" example:"provider_unavailable"` // provider_unavailable, malformed_response, not_found or rejected
+	Operation  string `json:"operation" example:"open_gate"`
+	StatusCode int    `json:"status_code" example:"503"` // Status returned by the provider (0 if it did not respond)
+	Detail     string `json:"detail" example:"third-party API returned status code 503"`
+	Code       string `json:"code,omitempty" example:"circuit_open"` // circuit_open when the call was refused because the circuit breaker is open
+}
+
+// ProblemDetails is an RFC 7807 error response (application/problem+json), used by /api/v2
+// and by any request that accepts application/problem+json
+// @name ProblemDetails
+type ProblemDetails struct {
+	Type          string                    `json:"type" example:"/problems/provider-unavailable" validate:"required"`
+	Title         string                    `json:"title" example:"Gate Provider Unavailable" validate:"required"`
+	Status        int                       `json:"status" example:"503" validate:"required"`
+	Detail        string                    `json:"detail" example:"third-party API returned status code 503"`
+	Instance      string                    `json:"instance" example:"/api/v2/locations/12/open"`
+	CorrelationID string                    `json:"correlation_id" example:"7d3c2a1e-5b6f-4c8d-9e0f-1a2b3c4d5e6f" validate:"required"`
+	Upstream      *UpstreamErrorDTO         `json:"upstream,omitempty"`       // Present for failed third-party calls
+	RetryStrategy *middleware.RetryStrategy `json:"retry_strategy,omitempty"` // Present on 429 and 503 responses
 }
 
 // ========== Pagination ==========
@@ -28,10 +56,11 @@ type HealthCheckResponse struct {
 // PaginationMeta defines the pagination metadata for list responses
 // @name PaginationMeta
 type PaginationMeta struct {
-	Total       int `json:"total" example:"100"`
-	PerPage     int `json:"per_page" example:"100"`
-	CurrentPage int `json:"current_page" example:"1"`
-	LastPage    int `json:"last_page" example:"1"`
+	Total       int  `json:"total" example:"100"`
+	PerPage     int  `json:"per_page" example:"100"`
+	CurrentPage int  `json:"current_page" example:"1"`
+	LastPage    int  `json:"last_page" example:"1"`
+	Estimated   bool `json:"estimated,omitempty" example:"false"` // Total is the planner's estimate (count=estimate)
 }
 
 // ========== User Authentication Responses ==========
@@ -68,6 +97,34 @@ type LoginData struct {
 	RefreshExpiresIn int64     `json:"refresh_expires_in" example:"2592000" validate:"required"`
 }
 
+// PasswordExpiredResponse defines the response structure for a login refused because the password expired
+// @name PasswordExpiredResponse
+type PasswordExpiredResponse struct {
+	Success bool                `json:"success" example:"false" validate:"required"`
+	Message string              `json:"message" example:"Your password has expired and must be changed" validate:"required"`
+	Data    PasswordExpiredData `json:"data"`
+}
+
+// @name PasswordExpiredData
+type PasswordExpiredData struct {
+	Code              string    `json:"code" example:"password_expired" validate:"required"`
+	PasswordChangedAt time.Time `json:"password_changed_at" example:"2025-01-15T10:30:45Z" validate:"required"`
+}
+
+// PhoneCountryNotAllowedResponse defines the response structure for numbers from countries outside PHONE_ALLOWED_COUNTRIES
+// @name PhoneCountryNotAllowedResponse
+type PhoneCountryNotAllowedResponse struct {
+	Success bool                       `json:"success" example:"false" validate:"required"`
+	Message string                     `json:"message" example:"Phone numbers from this country are not accepted. Allowed: +996 (KG), +7 (KZ)" validate:"required"`
+	Data    PhoneCountryNotAllowedData `json:"data"`
+}
+
+// @name PhoneCountryNotAllowedData
+type PhoneCountryNotAllowedData struct {
+	Code             string   `json:"code" example:"phone_country_not_allowed" validate:"required"`
+	AllowedCountries []string `json:"allowed_countries" example:"KG,KZ" validate:"required"`
+}
+
 // RefreshResponse defines the response structure for successful token refresh
 // @name RefreshResponse
 type RefreshResponse struct {
@@ -110,6 +167,7 @@ type UsersListData struct {
 type UserDTO struct {
 	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000" validate:"required"`
 	Phone     string    `json:"phone" example:"+77771234567" validate:"required"`
+	Email     string    `json:"email,omitempty" example:"staff@example.com"`
 	CreatedAt time.Time `json:"created_at" example:"2025-01-15T10:30:00Z" validate:"required"`
 	UpdatedAt time.Time `json:"updated_at" example:"2025-01-15T10:30:00Z" validate:"required"`
 }
@@ -117,11 +175,16 @@ type UserDTO struct {
 // UserDetailDTO includes user info plus their assigned locations/gates
 // @name UserDetailDTO
 type UserDetailDTO struct {
-	ID        uuid.UUID     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000" validate:"required"`
-	Phone     string        `json:"phone" example:"+77771234567" validate:"required"`
-	CreatedAt time.Time     `json:"created_at" example:"2025-01-15T10:30:00Z" validate:"required"`
-	UpdatedAt time.Time     `json:"updated_at" example:"2025-01-15T10:30:00Z" validate:"required"`
-	Locations []LocationDTO `json:"locations" validate:"required"`
+	ID              uuid.UUID      `json:"id" example:"550e8400-e29b-41d4-a716-446655440000" validate:"required"`
+	Phone           string         `json:"phone" example:"+77771234567" validate:"required"`
+	Email           string         `json:"email,omitempty" example:"staff@example.com"`
+	EmailVerifiedAt *time.Time     `json:"email_verified_at,omitempty" example:"2025-01-15T10:30:00Z"`
+	TrashedAt       *time.Time     `json:"trashed_at,omitempty" example:"2025-01-15T10:30:00Z"` // Set while the user is in the trash
+	CreatedAt       time.Time      `json:"created_at" example:"2025-01-15T10:30:00Z" validate:"required"`
+	UpdatedAt       time.Time      `json:"updated_at" example:"2025-01-15T10:30:00Z" validate:"required"`
+	MaxSessions     *int           `json:"max_sessions,omitempty" example:"2"` // User's own session limit; absent when JWT_MAX_SESSIONS applies
+	Phones          []UserPhoneDTO `json:"phones"` // Primary and secondary numbers
+	Locations       []LocationDTO  `json:"locations" validate:"required"`
 }
 
 // UserResponse defines the response structure for user operations (create, update, delete)
@@ -158,10 +221,59 @@ type AdminLoginResponse struct {
 
 // @name AdminLoginData
 type AdminLoginData struct {
-	AdminID     uuid.UUID `json:"id" example:"00000000-0000-0000-0000-000000000001" validate:"required"`
-	Username    string    `json:"username" example:"admin" validate:"required"`
-	Role        string    `json:"role" example:"super" validate:"required"`
-	AccessToken string    `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." validate:"required"`
+	AdminID      uuid.UUID `json:"id" example:"00000000-0000-0000-0000-000000000001" validate:"required"`
+	Username     string    `json:"username" example:"admin" validate:"required"`
+	Role         string    `json:"role" example:"super" validate:"required"`
+	AccessToken  string    `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." validate:"required"`
+	RefreshToken string    `json:"refresh_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." validate:"required"`
+}
+
+// ========== Admin Passkey Responses ==========
+
+// AdminPasskeyDTO represents a passkey registered for an admin, without its public key
+// @name AdminPasskeyDTO
+type AdminPasskeyDTO struct {
+	ID             uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CredentialID   string     `json:"credential_id" example:"pX3f0w8sQ1u9kq2Hn7cZbA"` // base64url, as browsers report it
+	Name           string     `json:"name" example:"MacBook Touch ID"`
+	Algorithm      int        `json:"algorithm" example:"-7"`                                 // COSE algorithm
+	AAGUID         string     `json:"aaguid" example:"00000000-0000-0000-0000-000000000000"` // Authenticator model, zero when not disclosed
+	Transports     []string   `json:"transports" example:"internal,hybrid"`
+	BackupEligible bool       `json:"backup_eligible" example:"true"` // Synced passkey rather than a device-bound key
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty" example:"2026-10-16T10:30:45Z"`
+	CreatedAt      time.Time  `json:"created_at" example:"2026-10-01T08:00:00Z"`
+}
+
+// AdminPasskeyResponse defines the response structure for a single passkey
+// @name AdminPasskeyResponse
+type AdminPasskeyResponse struct {
+	Success bool            `json:"success" example:"true" validate:"required"`
+	Message string          `json:"message" example:"Passkey registered" validate:"required"`
+	Data    AdminPasskeyDTO `json:"data"`
+}
+
+// AdminPasskeysResponse defines the response structure for listing an admin's passkeys
+// @name AdminPasskeysResponse
+type AdminPasskeysResponse struct {
+	Success bool              `json:"success" example:"true" validate:"required"`
+	Message string            `json:"message" example:"Passkeys retrieved successfully" validate:"required"`
+	Data    []AdminPasskeyDTO `json:"data"`
+}
+
+// PasskeyCreationOptionsResponse defines the response structure for starting a passkey registration
+// @name PasskeyCreationOptionsResponse
+type PasskeyCreationOptionsResponse struct {
+	Success bool                            `json:"success" example:"true" validate:"required"`
+	Message string                          `json:"message" example:"Registration options created" validate:"required"`
+	Data    services.PasskeyCreationOptions `json:"data"`
+}
+
+// PasskeyRequestOptionsResponse defines the response structure for starting a passkey login
+// @name PasskeyRequestOptionsResponse
+type PasskeyRequestOptionsResponse struct {
+	Success bool                           `json:"success" example:"true" validate:"required"`
+	Message string                         `json:"message" example:"Login options created" validate:"required"`
+	Data    services.PasskeyRequestOptions `json:"data"`
 }
 
 // ========== Admin Management Responses ==========
@@ -218,8 +330,41 @@ type AdminDetailData struct {
 	AdminID   uuid.UUID `json:"id" example:"00000000-0000-0000-0000-000000000001"`
 	Username  string    `json:"username" example:"admin"`
 	Role      string    `json:"role" example:"super"`
-	CreatedAt time.Time `json:"created_at" example:"2025-01-15T10:30:00Z"`
-	UpdatedAt time.Time `json:"updated_at" example:"2025-01-15T10:30:00Z"`
+	Timezone  string    `json:"timezone,omitempty" example:"Asia/Bishkek"` // Admin panel timezone; omitted for DEFAULT_TIMEZONE
+	CreatedAt time.Time `json:"created_at" example:"2025-01-15T10:30:00+06:00"`
+	UpdatedAt time.Time `json:"updated_at" example:"2025-01-15T10:30:00+06:00"`
+}
+
+// ImportedAdminDTO represents an admin account created by an import, with its generated password
+// @name ImportedAdminDTO
+type ImportedAdminDTO struct {
+	AdminID  uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Username string    `json:"username" example:"desk-north"`
+	Role     string    `json:"role" example:"regular"`
+	Password string    `json:"password" example:"Xq3v9LmT2kPa8Rz1"` // Shown only in this response
+}
+
+// AdminImportResponse defines the response structure for a successful admin import
+// @name AdminImportResponse
+type AdminImportResponse struct {
+	Success bool               `json:"success" example:"true" validate:"required"`
+	Message string             `json:"message" example:"2 admins imported. Share each password once; admins change it with PATCH /admin/users/:id" validate:"required"`
+	Data    []ImportedAdminDTO `json:"data"`
+}
+
+// AdminImportLineErrorDTO represents a problem with one line of an admin import CSV
+// @name AdminImportLineErrorDTO
+type AdminImportLineErrorDTO struct {
+	Line    int    `json:"line" example:"3"` // The header is line 1
+	Message string `json:"message" example:"invalid role \"guard\", must be super, regular or auditor"`
+}
+
+// AdminImportErrorResponse defines the response structure for a rejected admin import; no account is created
+// @name AdminImportErrorResponse
+type AdminImportErrorResponse struct {
+	Success bool                      `json:"success" example:"false" validate:"required"`
+	Message string                    `json:"message" example:"Import rejected, no admins were created" validate:"required"`
+	Data    []AdminImportLineErrorDTO `json:"data"`
 }
 
 // ========== Gate Management Responses ==========
@@ -233,6 +378,8 @@ type GateDTO struct {
 	LocationID       int    `json:"location_id" example:"1"`
 	IsOpen           bool   `json:"is_open" example:"true"`
 	GateIsHorizontal bool   `json:"gate_is_horizontal" example:"true"`
+	UnderMaintenance bool   `json:"under_maintenance" example:"false"`                                           // Shown greyed out - opens and closes are refused
+	MaintenanceNote  string `json:"maintenance_note,omitempty" example:"Barrier motor replacement until Friday"` // Present while under maintenance
 }
 
 // LocationDTO represents a location/facility with associated gates
@@ -248,9 +395,12 @@ type LocationDTO struct {
 // LocationsListResponse defines the response structure for retrieving all locations
 // @name LocationsListResponse
 type LocationsListResponse struct {
-	Success bool          `json:"success" example:"true" validate:"required"`
-	Message string        `json:"message" example:"Locations retrieved successfully" validate:"required"`
-	Data    []LocationDTO `json:"data"`
+	Success       bool                      `json:"success" example:"true" validate:"required"`
+	Message       string                    `json:"message" example:"Locations retrieved successfully" validate:"required"`
+	Degraded      bool                      `json:"degraded" example:"false"`                           // true when the provider is down and cached data is served
+	CachedAt      *time.Time                `json:"cached_at,omitempty" example:"2025-01-15T10:30:00Z"` // When the cached data was loaded (degraded only)
+	Data          []LocationDTO             `json:"data"`
+	RetryStrategy *middleware.RetryStrategy `json:"retry_strategy,omitempty"` // When to reload for fresh data (degraded only)
 }
 
 // GatesListResponse defines the response structure for retrieving gates for a location
@@ -264,16 +414,42 @@ type GatesListResponse struct {
 // GateActionData represents the response data for gate open/close operations
 // @name GateActionData
 type GateActionData struct {
-	GateID int  `json:"gate_id" example:"1"`
-	Status bool `json:"status" example:"true"`
+	GateID        int       `json:"gate_id" example:"1"`
+	Status        bool      `json:"status" example:"true"`
+	CommandID     uuid.UUID `json:"command_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CommandStatus string    `json:"command_status" example:"executing"` // accepted, queued, executing, confirmed or failed
+	Sandbox       bool      `json:"sandbox,omitempty" example:"false"`  // Executed by the gate simulator (SANDBOX_MODE), no barrier moved
 }
 
 // GateActionResponse defines the response structure for gate operations (open/close)
 // @name GateActionResponse
 type GateActionResponse struct {
-	Success bool            `json:"success" example:"true" validate:"required"`
-	Message string          `json:"message" example:"Gate operation completed successfully" validate:"required"`
-	Data    GateActionData  `json:"data"`
+	Success       bool                      `json:"success" example:"true" validate:"required"`
+	Message       string                    `json:"message" example:"Gate operation completed successfully" validate:"required"`
+	Data          GateActionData            `json:"data"`
+	RetryStrategy *middleware.RetryStrategy `json:"retry_strategy,omitempty"` // Set when the provider is unavailable: poll a queued command, retry a failed one
+}
+
+// GateCommandDTO represents the lifecycle state of a gate open/close command
+// @name GateCommandDTO
+type GateCommandDTO struct {
+	ID           uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	GateID       int        `json:"gate_id" example:"1"`
+	Action       string     `json:"action" example:"open"`
+	Status       string     `json:"status" example:"confirmed"` // accepted, queued, executing, confirmed or failed
+	ErrorMessage string     `json:"error_message,omitempty" example:""`
+	Sandbox      bool       `json:"sandbox,omitempty" example:"false"` // Executed by the gate simulator (SANDBOX_MODE), no barrier moved
+	CreatedAt    time.Time  `json:"created_at" example:"2025-01-15T10:30:00Z"`
+	UpdatedAt    time.Time  `json:"updated_at" example:"2025-01-15T10:30:03Z"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty" example:"2025-01-15T10:30:03Z"`
+}
+
+// GateCommandResponse defines the response structure for retrieving a gate command status
+// @name GateCommandResponse
+type GateCommandResponse struct {
+	Success bool           `json:"success" example:"true" validate:"required"`
+	Message string         `json:"message" example:"Gate command retrieved successfully" validate:"required"`
+	Data    GateCommandDTO `json:"data"`
 }
 
 // ========== Contact Information Responses ==========
@@ -307,6 +483,7 @@ type LocationAssignmentRequest struct {
 // @name CreateUserRequest
 type CreateUserRequest struct {
 	Phone     string                        `json:"phone" example:"+77771234567" validate:"required"`
+	Email     string                        `json:"email" example:"staff@example.com"` // Optional - alternative login identifier, verified when set by an admin
 	Password  string                        `json:"password" example:"password123" validate:"required,min=6"`
 	Locations []LocationAssignmentRequest   `json:"locations"` // Optional - if provided, will assign user to these locations and gates
 }
@@ -315,8 +492,10 @@ type CreateUserRequest struct {
 // @name UpdateUserRequest
 type UpdateUserRequest struct {
 	Phone     string                        `json:"phone" example:"+77771234567"` // Optional - if provided, will update phone number after checking availability
+	Email     string                        `json:"email" example:"staff@example.com"` // Optional - if provided, will set the login email after checking availability
 	Password  string                        `json:"password" example:"newpassword123" validate:"omitempty,min=6"` // Optional - only updates if provided
 	Locations []LocationAssignmentRequest   `json:"locations"` // Optional - if provided, will reassign user to these locations and gates
+	MaxSessions *int                        `json:"max_sessions" example:"2"` // Optional - concurrent session limit (0 = unlimited, -1 = back to JWT_MAX_SESSIONS)
 }
 
 // ========== Available Locations Response ==========
@@ -328,3 +507,1158 @@ type AvailableLocationsResponse struct {
 	Message string         `json:"message" example:"Available locations retrieved successfully" validate:"required"`
 	Data    []LocationDTO  `json:"data"`
 }
+
+// ========== Scheduled Jobs Responses ==========
+
+// JobRunDTO represents a single execution of a scheduled job
+// @name JobRunDTO
+type JobRunDTO struct {
+	ID         uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Instance   string     `json:"instance" example:"api-1-3f2a9c1d"`
+	Status     string     `json:"status" example:"succeeded"`
+	Error      string     `json:"error,omitempty" example:""`
+	StartedAt  time.Time  `json:"started_at" example:"2025-01-01T03:00:00Z"`
+	FinishedAt *time.Time `json:"finished_at" example:"2025-01-01T03:00:02Z"`
+}
+
+// ScheduledJobDTO represents a registered scheduled job and its status
+// @name ScheduledJobDTO
+type ScheduledJobDTO struct {
+	Name     string     `json:"name" example:"gate_commands_retention"`
+	Schedule string     `json:"schedule" example:"0 3 * * *"`
+	NextRun  time.Time  `json:"next_run" example:"2025-01-02T03:00:00Z"`
+	Running  bool       `json:"running" example:"false"`
+	LastRun  *JobRunDTO `json:"last_run"`
+}
+
+// ScheduledJobsResponse defines the response structure for listing scheduled jobs
+// @name ScheduledJobsResponse
+type ScheduledJobsResponse struct {
+	Success bool              `json:"success" example:"true" validate:"required"`
+	Message string            `json:"message" example:"Scheduled jobs retrieved successfully" validate:"required"`
+	Data    []ScheduledJobDTO `json:"data"`
+}
+
+// ========== Admin Notification Responses ==========
+
+// AdminNotificationDTO represents an entry in the admin notification center
+// @name AdminNotificationDTO
+type AdminNotificationDTO struct {
+	ID        uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Severity  string     `json:"severity" example:"critical"`
+	Category  string     `json:"category" example:"provider"`
+	Title     string     `json:"title" example:"Gate provider unavailable"`
+	Message   string     `json:"message" example:"Third-party open_gate failed: third-party API returned status code 503"`
+	Read      bool       `json:"read" example:"false"`
+	ReadAt    *time.Time `json:"read_at"`
+	ReadBy    *uuid.UUID `json:"read_by"`
+	CreatedAt time.Time  `json:"created_at" example:"2025-01-01T00:00:00Z"`
+}
+
+// AdminNotificationsResponse defines the response structure for listing admin notifications
+// @name AdminNotificationsResponse
+type AdminNotificationsResponse struct {
+	Success     bool                   `json:"success" example:"true" validate:"required"`
+	Message     string                 `json:"message" example:"Notifications retrieved successfully" validate:"required"`
+	Data        []AdminNotificationDTO `json:"data"`
+	UnreadCount int64                  `json:"unread_count" example:"3"`
+	Pagination  PaginationMeta         `json:"pagination"`
+}
+
+// AdminNotificationResponse defines the response structure for a single admin notification
+// @name AdminNotificationResponse
+type AdminNotificationResponse struct {
+	Success bool                 `json:"success" example:"true" validate:"required"`
+	Message string               `json:"message" example:"Notification marked as read" validate:"required"`
+	Data    AdminNotificationDTO `json:"data"`
+}
+
+// AdminNotificationDetailDTO is a notification with the comments admins left on it
+// @name AdminNotificationDetailDTO
+type AdminNotificationDetailDTO struct {
+	AdminNotificationDTO
+	Resolved bool              `json:"resolved" example:"false"` // A resolution note was added
+	Comments []AuditCommentDTO `json:"comments"`                 // Oldest first
+}
+
+// AdminNotificationDetailResponse defines the response structure for a notification with its comments
+// @name AdminNotificationDetailResponse
+type AdminNotificationDetailResponse struct {
+	Success bool                       `json:"success" example:"true" validate:"required"`
+	Message string                     `json:"message" example:"Notification retrieved successfully" validate:"required"`
+	Data    AdminNotificationDetailDTO `json:"data"`
+}
+
+// AuditCommentDTO represents an admin's comment on an audit log entry or notification
+// @name AuditCommentDTO
+type AuditCommentDTO struct {
+	ID         uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	AdminID    uuid.UUID `json:"admin_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	AdminName  string    `json:"admin_name" example:"security_lead"`
+	Body       string    `json:"body" example:"Confirmed with the admin, the bulk delete was a planned cleanup"`
+	Resolution bool      `json:"resolution" example:"true"` // The comment closes the investigation
+	CreatedAt  time.Time `json:"created_at" example:"2025-01-01T12:00:00Z"`
+}
+
+// AuditCommentResponse defines the response structure for an added comment
+// @name AuditCommentResponse
+type AuditCommentResponse struct {
+	Success bool            `json:"success" example:"true" validate:"required"`
+	Message string          `json:"message" example:"Comment added" validate:"required"`
+	Data    AuditCommentDTO `json:"data"`
+}
+
+// ========== User Session Responses ==========
+
+// UserSessionDTO represents a device session of a user
+// @name UserSessionDTO
+type UserSessionDTO struct {
+	ID         uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DeviceID   string    `json:"device_id" example:"iphone-15-abc123"`
+	IPAddress  string    `json:"ip_address" example:"192.168.1.10"`
+	UserAgent  string    `json:"user_agent" example:"OloloGate/2.3 (iOS 17.4)"`
+	Current    bool      `json:"current" example:"true"`  // True for the session making this request
+	Trusted    bool      `json:"trusted" example:"false"` // Logged in as a trusted device ("remember me"), so the session is long-lived
+	LastUsedAt time.Time `json:"last_used_at" example:"2025-01-01T12:00:00Z"`
+	ExpiresAt  time.Time `json:"expires_at" example:"2025-01-31T12:00:00Z"`
+	CreatedAt  time.Time `json:"created_at" example:"2025-01-01T12:00:00Z"`
+}
+
+// UserSessionsResponse defines the response structure for listing a user's sessions
+// @name UserSessionsResponse
+type UserSessionsResponse struct {
+	Success bool             `json:"success" example:"true" validate:"required"`
+	Message string           `json:"message" example:"Sessions retrieved successfully" validate:"required"`
+	Data    []UserSessionDTO `json:"data"`
+}
+
+// StaleDeviceDTO represents a device session not used since the stale device cutoff
+// @name StaleDeviceDTO
+type StaleDeviceDTO struct {
+	ID         uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID     uuid.UUID `json:"user_id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	DeviceID   string    `json:"device_id" example:"iphone-15-abc123"`
+	UserAgent  string    `json:"user_agent" example:"OloloGate/2.3 (iOS 17.4)"`
+	Active     bool      `json:"active" example:"true"` // Not logged out or expired yet; deleting it logs the device out
+	LastUsedAt time.Time `json:"last_used_at" example:"2026-03-01T12:00:00Z"`
+	ExpiresAt  time.Time `json:"expires_at" example:"2026-05-30T12:00:00Z"`
+}
+
+// StaleDeviceReportDTO represents the sessions the stale device purge deletes
+// @name StaleDeviceReportDTO
+type StaleDeviceReportDTO struct {
+	StaleAfter   string           `json:"stale_after" example:"4320h0m0s"`       // Retention the report was made for
+	Cutoff       time.Time        `json:"cutoff" example:"2026-04-19T09:00:00Z"` // Sessions last used before this are stale
+	PurgeEnabled bool             `json:"purge_enabled" example:"false"`         // JWT_STALE_DEVICE_PURGE: the job deletes them, rather than only reporting
+	Total        int64            `json:"total" example:"1520"`                  // Stale sessions
+	Active       int64            `json:"active" example:"37"`                   // Of which could still be used
+	Users        int64            `json:"users" example:"980"`                   // Users with at least one stale session
+	Devices      []StaleDeviceDTO `json:"devices"`                               // Least recently used first, up to limit
+}
+
+// StaleDeviceReportResponse defines the response structure for the stale device report
+// @name StaleDeviceReportResponse
+type StaleDeviceReportResponse struct {
+	Success bool                 `json:"success" example:"true" validate:"required"`
+	Message string               `json:"message" example:"Stale device report generated successfully" validate:"required"`
+	Data    StaleDeviceReportDTO `json:"data"`
+}
+
+// ========== Usage Metering Responses ==========
+
+// UsageRollupDTO represents one organization's usage for a month
+// @name UsageRollupDTO
+type UsageRollupDTO struct {
+	OrgID          string `json:"org_id" example:"default" validate:"required"`
+	Month          string `json:"month" example:"2026-10" validate:"required"`
+	APICalls       int64  `json:"api_calls" example:"125000" validate:"required"`
+	GateOperations int64  `json:"gate_operations" example:"8400" validate:"required"`
+	SMSSent        int64  `json:"sms_sent" example:"320" validate:"required"`
+	ActiveUsers    int64  `json:"active_users" example:"412" validate:"required"`
+}
+
+// ProviderUsageDTO represents one provider's API calls in a month against its quota
+// @name ProviderUsageDTO
+type ProviderUsageDTO struct {
+	Provider string  `json:"provider" example:"primary" validate:"required"` // primary or mirror (the migration provider)
+	Month    string  `json:"month" example:"2026-10" validate:"required"`
+	Calls    int64   `json:"calls" example:"81234"`
+	Quota    int64   `json:"quota" example:"100000"` // THIRD_PARTY_MONTHLY_QUOTA or THIRD_PARTY_MIRROR_MONTHLY_QUOTA, 0 = no quota
+	Percent  float64 `json:"percent" example:"81.2"` // Share of the quota used, 0 without a quota
+	Warned   []int   `json:"warned" example:"80"`    // Thresholds admins were warned about this month
+}
+
+// ProviderUsageResponse defines the response structure for third-party API usage
+// @name ProviderUsageResponse
+type ProviderUsageResponse struct {
+	Success bool               `json:"success" example:"true" validate:"required"`
+	Message string             `json:"message" example:"Provider usage retrieved successfully" validate:"required"`
+	Data    []ProviderUsageDTO `json:"data"`
+}
+
+// UsageRollupResponse defines the response structure for the monthly usage rollup
+// @name UsageRollupResponse
+type UsageRollupResponse struct {
+	Success bool             `json:"success" example:"true" validate:"required"`
+	Message string           `json:"message" example:"Usage rollup retrieved successfully" validate:"required"`
+	Data    []UsageRollupDTO `json:"data"`
+}
+
+// ========== Route Listing Responses ==========
+
+// RouteDTO describes a registered endpoint and the access it requires
+// @name RouteDTO
+type RouteDTO struct {
+	Method     string   `json:"method" example:"GET" validate:"required"`
+	Path       string   `json:"path" example:"/api/v1/admin/jobs" validate:"required"`
+	Access     string   `json:"access" example:"super_admin" validate:"required"` // "public", "provider_token", "user", "admin" or "super_admin"
+	Middleware []string `json:"middleware" example:"middleware.AdminJWTProtected,middleware.SuperAdminOnly"`
+	Handler    string   `json:"handler" example:"handlers.GetScheduledJobs" validate:"required"`
+}
+
+// RegisteredRoutesResponse defines the response structure for listing registered routes
+// @name RegisteredRoutesResponse
+type RegisteredRoutesResponse struct {
+	Success bool       `json:"success" example:"true" validate:"required"`
+	Message string     `json:"message" example:"Routes retrieved successfully" validate:"required"`
+	Data    []RouteDTO `json:"data"`
+}
+
+// ========== Config Reload Responses ==========
+
+// ConfigReloadDTO reports the outcome of a runtime config reload
+// @name ConfigReloadDTO
+type ConfigReloadDTO struct {
+	Changed    []string `json:"changed" example:"THIRD_PARTY_RATE_LIMIT,CORS_ALLOWED_ORIGINS"`
+	Reloadable []string `json:"reloadable" example:"CORS_ALLOWED_ORIGINS,THIRD_PARTY_RATE_LIMIT,ADMIN_QUOTA_HOURLY"`
+}
+
+// ConfigReloadResponse defines the response structure for a runtime config reload
+// @name ConfigReloadResponse
+type ConfigReloadResponse struct {
+	Success bool            `json:"success" example:"true" validate:"required"`
+	Message string          `json:"message" example:"Configuration reloaded, 2 setting(s) changed" validate:"required"`
+	Data    ConfigReloadDTO `json:"data"`
+}
+
+// ========== CORS Origin Responses ==========
+
+// CORSOriginDTO represents a browser origin allowed by a super admin
+// @name CORSOriginDTO
+type CORSOriginDTO struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Origin    string    `json:"origin" example:"https://admin.example.com"`
+	Scope     string    `json:"scope" example:"admin"` // "public" or "admin"
+	CreatedBy string    `json:"created_by" example:"admin"`
+	CreatedAt time.Time `json:"created_at" example:"2025-01-01T00:00:00Z"`
+}
+
+// CORSOriginsResponse defines the response structure for listing CORS origins
+// @name CORSOriginsResponse
+type CORSOriginsResponse struct {
+	Success bool            `json:"success" example:"true" validate:"required"`
+	Message string          `json:"message" example:"CORS origins retrieved successfully" validate:"required"`
+	Data    []CORSOriginDTO `json:"data"`
+}
+
+// CORSOriginResponse defines the response structure for a single CORS origin
+// @name CORSOriginResponse
+type CORSOriginResponse struct {
+	Success bool          `json:"success" example:"true" validate:"required"`
+	Message string        `json:"message" example:"CORS origin added successfully" validate:"required"`
+	Data    CORSOriginDTO `json:"data"`
+}
+
+// ========== User Phone Responses ==========
+
+// UserPhoneDTO represents one phone number of a user
+// @name UserPhoneDTO
+type UserPhoneDTO struct {
+	ID         uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Phone      string     `json:"phone" example:"+77771234567"`
+	IsPrimary  bool       `json:"is_primary" example:"false"`
+	VerifiedAt *time.Time `json:"verified_at" example:"2025-01-01T00:00:00Z"` // Only verified numbers can log in
+	AddedBy    string     `json:"added_by" example:"admin"`
+	CreatedAt  time.Time  `json:"created_at" example:"2025-01-01T00:00:00Z"`
+}
+
+// UserPhonesResponse defines the response structure for listing a user's phone numbers
+// @name UserPhonesResponse
+type UserPhonesResponse struct {
+	Success bool           `json:"success" example:"true" validate:"required"`
+	Message string         `json:"message" example:"Phone numbers retrieved successfully" validate:"required"`
+	Data    []UserPhoneDTO `json:"data"`
+}
+
+// UserPhoneResponse defines the response structure for a single phone number
+// @name UserPhoneResponse
+type UserPhoneResponse struct {
+	Success bool         `json:"success" example:"true" validate:"required"`
+	Message string       `json:"message" example:"Phone number added successfully" validate:"required"`
+	Warning string       `json:"warning,omitempty" example:"Third-party API assignment error: ..."` // Set when copying gate access to the number failed
+	Data    UserPhoneDTO `json:"data"`
+}
+
+// ========== Duplicate User Responses ==========
+
+// DuplicateUserGroupDTO is a set of users sharing a phone number once normalized
+// @name DuplicateUserGroupDTO
+type DuplicateUserGroupDTO struct {
+	Phone string    `json:"phone" example:"+77771234567"` // Normalized number the users share
+	Users []UserDTO `json:"users"`                        // Oldest first; the oldest account is the suggested merge target
+}
+
+// DuplicateUsersResponse defines the response structure for duplicate user detection
+// @name DuplicateUsersResponse
+type DuplicateUsersResponse struct {
+	Success bool                    `json:"success" example:"true" validate:"required"`
+	Message string                  `json:"message" example:"Duplicate users retrieved successfully" validate:"required"`
+	Data    []DuplicateUserGroupDTO `json:"data"`
+}
+
+// MergeUsersResultDTO summarizes what a merge moved into the target user
+// @name MergeUsersResultDTO
+type MergeUsersResultDTO struct {
+	UserID            uuid.UUID   `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	MergedUserIDs     []uuid.UUID `json:"merged_user_ids"`
+	PhonesAdded       []string    `json:"phones_added"`                     // Numbers added as verified secondary numbers
+	SessionsMoved     int64       `json:"sessions_moved" example:"2"`       // Device sessions moved (revoked; the devices log in again)
+	GateCommandsMoved int64       `json:"gate_commands_moved" example:"15"` // Gate command history moved
+	EmailMoved        bool        `json:"email_moved" example:"false"`      // The target took over a merged user's email
+}
+
+// MergeUsersResponse defines the response structure for merging users
+// @name MergeUsersResponse
+type MergeUsersResponse struct {
+	Success bool                `json:"success" example:"true" validate:"required"`
+	Message string              `json:"message" example:"Users merged successfully" validate:"required"`
+	Warning string              `json:"warning,omitempty" example:"Third-party API assignment error: ..."` // Set when consolidating gate access failed
+	Data    MergeUsersResultDTO `json:"data"`
+}
+
+// ========== Impersonation Responses ==========
+
+// ImpersonationTokenDTO is a short-lived user token issued to a super admin
+// @name ImpersonationTokenDTO
+type ImpersonationTokenDTO struct {
+	AccessToken           string    `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	ExpiresIn             int64     `json:"expires_in" example:"900"` // Seconds; no refresh token is issued
+	ExpiresAt             time.Time `json:"expires_at" example:"2025-01-15T10:45:00Z"`
+	UserID                uuid.UUID `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Phone                 string    `json:"phone" example:"+77771234567"`
+	GateOperationsBlocked bool      `json:"gate_operations_blocked" example:"true"` // Gate open/close is refused with this token
+}
+
+// ImpersonationResponse defines the response structure for impersonating a user
+// @name ImpersonationResponse
+type ImpersonationResponse struct {
+	Success bool                  `json:"success" example:"true" validate:"required"`
+	Message string                `json:"message" example:"Impersonation token issued" validate:"required"`
+	Data    ImpersonationTokenDTO `json:"data"`
+}
+
+// ========== User Trash Responses ==========
+
+// TrashedUserDTO represents a deleted user that can still be restored
+// @name TrashedUserDTO
+type TrashedUserDTO struct {
+	ID        uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Phone     string     `json:"phone" example:"+77771234567"`
+	TrashedAt *time.Time `json:"trashed_at" example:"2025-01-15T10:30:00Z"`
+	TrashedBy string     `json:"trashed_by" example:"admin"`
+	PurgeAt   *time.Time `json:"purge_at" example:"2025-01-22T10:30:00Z"` // When the user is purged and their gate access revoked
+}
+
+// TrashedUserResponse defines the response structure for moving a user to the trash
+// @name TrashedUserResponse
+type TrashedUserResponse struct {
+	Success bool           `json:"success" example:"true" validate:"required"`
+	Message string         `json:"message" example:"User moved to the trash" validate:"required"`
+	Data    TrashedUserDTO `json:"data"`
+}
+
+// TrashedUsersResponse defines the response structure for listing the trash
+// @name TrashedUsersResponse
+type TrashedUsersResponse struct {
+	Success bool             `json:"success" example:"true" validate:"required"`
+	Message string           `json:"message" example:"Trashed users retrieved successfully" validate:"required"`
+	Data    []TrashedUserDTO `json:"data"`
+}
+
+// ========== Bulk Delete Responses ==========
+
+// BulkDeleteSummaryDTO describes what a bulk delete would do and carries the token that confirms it
+// @name BulkDeleteSummaryDTO
+type BulkDeleteSummaryDTO struct {
+	Count             int         `json:"count" example:"2"` // Users that will be moved to the trash
+	Users             []UserDTO   `json:"users"`
+	Skipped           []uuid.UUID `json:"skipped"` // Requested users that do not exist or are already in the trash
+	ConfirmationToken string      `json:"confirmation_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	ExpiresAt         time.Time   `json:"expires_at" example:"2025-01-15T10:35:00Z"`
+}
+
+// BulkDeleteSummaryResponse defines the response structure for a bulk delete summary
+// @name BulkDeleteSummaryResponse
+type BulkDeleteSummaryResponse struct {
+	Success bool                 `json:"success" example:"true" validate:"required"`
+	Message string               `json:"message" example:"2 user(s) will be moved to the trash. Send the request again with confirmation_token to proceed" validate:"required"`
+	Data    BulkDeleteSummaryDTO `json:"data"`
+}
+
+// BulkDeleteResultDTO reports the outcome of a confirmed bulk delete
+// @name BulkDeleteResultDTO
+type BulkDeleteResultDTO struct {
+	Trashed []uuid.UUID `json:"trashed"` // Users moved to the trash
+	Skipped []uuid.UUID `json:"skipped"` // Users that do not exist, were already in the trash, or failed
+}
+
+// BulkDeleteResultResponse defines the response structure for a confirmed bulk delete
+// @name BulkDeleteResultResponse
+type BulkDeleteResultResponse struct {
+	Success bool                `json:"success" example:"true" validate:"required"`
+	Message string              `json:"message" example:"2 user(s) moved to the trash" validate:"required"`
+	Data    BulkDeleteResultDTO `json:"data"`
+}
+
+// ========== Gate Link Responses ==========
+
+// GateLinkMetadataDTO is the metadata of a shared gate link
+// @name GateLinkMetadataDTO
+type GateLinkMetadataDTO struct {
+	ID         uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	LocationID int       `json:"location_id" example:"1"`
+	GateID     int       `json:"gate_id" example:"12"`
+	GateTitle  string    `json:"gate_title" example:"Автоматический Шлагбаум №12"`
+	Label      string    `json:"label" example:"Courier"`
+	ExpiresAt  time.Time `json:"expires_at" example:"2025-01-16T10:30:00Z"`
+	CreatedAt  time.Time `json:"created_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// GateLinkMetadataResponse defines the response structure for resolving a gate link
+// @name GateLinkMetadataResponse
+type GateLinkMetadataResponse struct {
+	Success bool                `json:"success" example:"true" validate:"required"`
+	Message string              `json:"message" example:"Link is valid" validate:"required"`
+	Data    GateLinkMetadataDTO `json:"data"`
+}
+
+// GateLinkDTO is a newly created gate link with its signed URLs
+// @name GateLinkDTO
+type GateLinkDTO struct {
+	GateLinkMetadataDTO
+	Signature     string `json:"signature" example:"kP3q9xX0..."`
+	UniversalLink string `json:"universal_link,omitempty" example:"https://app.example.com/links/550e8400-e29b-41d4-a716-446655440000?sig=kP3q9xX0..."` // Empty when GATE_LINK_BASE_URL is not set
+	DeepLink      string `json:"deep_link" example:"ololo-gate://links/550e8400-e29b-41d4-a716-446655440000?sig=kP3q9xX0..."`
+}
+
+// GateLinkResponse defines the response structure for creating a gate link
+// @name GateLinkResponse
+type GateLinkResponse struct {
+	Success bool        `json:"success" example:"true" validate:"required"`
+	Message string      `json:"message" example:"Gate link created" validate:"required"`
+	Data    GateLinkDTO `json:"data"`
+}
+
+// ========== Invite Code Responses ==========
+
+// InviteCodeDTO represents an invite code for self-registration
+// @name InviteCodeDTO
+type InviteCodeDTO struct {
+	ID        uuid.UUID                   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Code      string                      `json:"code" example:"7KQ2MXR4PA"`
+	Note      string                      `json:"note" example:"Building 4 residents"`
+	Locations []LocationAssignmentRequest `json:"locations"` // Assigned to users registering with the code
+	MaxUses   int                         `json:"max_uses" example:"50"` // 0 = unlimited
+	Uses      int                         `json:"uses" example:"12"`
+	Active    bool                        `json:"active" example:"true"` // Not revoked, expired or used up
+	ExpiresAt *time.Time                  `json:"expires_at,omitempty" example:"2025-01-22T10:30:00Z"`
+	RevokedAt *time.Time                  `json:"revoked_at,omitempty"`
+	CreatedBy string                      `json:"created_by" example:"admin"`
+	CreatedAt time.Time                   `json:"created_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// InviteCodeResponse defines the response structure for a single invite code
+// @name InviteCodeResponse
+type InviteCodeResponse struct {
+	Success bool          `json:"success" example:"true" validate:"required"`
+	Message string        `json:"message" example:"Invite code generated successfully" validate:"required"`
+	Data    InviteCodeDTO `json:"data"`
+}
+
+// InviteCodesResponse defines the response structure for listing invite codes
+// @name InviteCodesResponse
+type InviteCodesResponse struct {
+	Success bool            `json:"success" example:"true" validate:"required"`
+	Message string          `json:"message" example:"Invite codes retrieved successfully" validate:"required"`
+	Data    []InviteCodeDTO `json:"data"`
+}
+
+// ========== Registration Review Responses ==========
+
+// RegistrationDTO represents a self-registration and its review
+// @name RegistrationDTO
+type RegistrationDTO struct {
+	ID              uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Phone           string     `json:"phone" example:"+77771234567"`
+	Status          string     `json:"status" example:"pending"` // pending, rejected or approved
+	ReviewedBy      string     `json:"reviewed_by,omitempty" example:"admin"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty" example:"2025-01-15T11:00:00Z"`
+	RejectionReason string     `json:"rejection_reason,omitempty" example:"Not a resident of this building"`
+	CreatedAt       time.Time  `json:"created_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// RegistrationsResponse defines the response structure for the registration review queue
+// @name RegistrationsResponse
+type RegistrationsResponse struct {
+	Success bool              `json:"success" example:"true" validate:"required"`
+	Message string            `json:"message" example:"Registrations retrieved successfully" validate:"required"`
+	Data    []RegistrationDTO `json:"data"`
+}
+
+// RegistrationResponse defines the response structure for approving or rejecting a registration
+// @name RegistrationResponse
+type RegistrationResponse struct {
+	Success bool            `json:"success" example:"true" validate:"required"`
+	Message string          `json:"message" example:"Registration approved successfully" validate:"required"`
+	Data    RegistrationDTO `json:"data"`
+	Warning string          `json:"warning,omitempty" example:"Welcome SMS error: ..."` // Set when assigning locations/gates or the welcome SMS failed
+}
+
+// ========== Legal Document Responses ==========
+
+// LegalDocumentDTO represents a version of the terms of service or the privacy policy
+// @name LegalDocumentDTO
+type LegalDocumentDTO struct {
+	Kind        string    `json:"kind" example:"terms"` // "terms" or "privacy"
+	Version     string    `json:"version" example:"2025-01"`
+	Title       string    `json:"title" example:"Terms of Service"`
+	Content     string    `json:"content" example:"1. Use of the service..."`
+	PublishedAt time.Time `json:"published_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// LegalDocumentResponse defines the response structure for a single legal document
+// @name LegalDocumentResponse
+type LegalDocumentResponse struct {
+	Success bool             `json:"success" example:"true" validate:"required"`
+	Message string           `json:"message" example:"Legal document retrieved successfully" validate:"required"`
+	Data    LegalDocumentDTO `json:"data"`
+}
+
+// LegalDocumentsResponse defines the response structure for the current legal documents
+// @name LegalDocumentsResponse
+type LegalDocumentsResponse struct {
+	Success bool               `json:"success" example:"true" validate:"required"`
+	Message string             `json:"message" example:"Legal documents retrieved successfully" validate:"required"`
+	Data    []LegalDocumentDTO `json:"data"`
+}
+
+// LegalStatusDTO describes a user's acceptance of one legal document
+// @name LegalStatusDTO
+type LegalStatusDTO struct {
+	Kind               string     `json:"kind" example:"terms"`
+	CurrentVersion     string     `json:"current_version" example:"2025-01"`
+	AcceptedVersion    string     `json:"accepted_version,omitempty" example:"2024-06"` // Latest version the user accepted
+	AcceptedAt         *time.Time `json:"accepted_at,omitempty" example:"2024-06-01T09:00:00Z"`
+	AcceptanceRequired bool       `json:"acceptance_required" example:"true"` // The current version has not been accepted
+}
+
+// LegalStatusResponse defines the response structure for a user's legal acceptance status
+// @name LegalStatusResponse
+type LegalStatusResponse struct {
+	Success bool             `json:"success" example:"true" validate:"required"`
+	Message string           `json:"message" example:"Legal acceptance status retrieved successfully" validate:"required"`
+	Data    []LegalStatusDTO `json:"data"`
+}
+
+// ========== User History Responses ==========
+
+// FieldChangeDTO is the value of a field before and after a change
+// @name FieldChangeDTO
+type FieldChangeDTO struct {
+	Before interface{} `json:"before"` // null when the field was not set or its previous value is unknown
+	After  interface{} `json:"after"`
+}
+
+// UserHistoryDTO represents one change to a user
+// @name UserHistoryDTO
+type UserHistoryDTO struct {
+	ID        uuid.UUID                 `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Action    string                    `json:"action" example:"updated"`
+	Actor     string                    `json:"actor" example:"admin"` // Admin username, "self" or "system"
+	Changes   map[string]FieldChangeDTO `json:"changes"`               // Changed fields, e.g. "phone", "email", "status", "password_changed", "assignments"
+	CreatedAt time.Time                 `json:"created_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// UserHistoryResponse defines the response structure for a user's change history
+// @name UserHistoryResponse
+type UserHistoryResponse struct {
+	Success    bool             `json:"success" example:"true" validate:"required"`
+	Message    string           `json:"message" example:"User history retrieved successfully" validate:"required"`
+	Data       []UserHistoryDTO `json:"data"`
+	Pagination PaginationMeta   `json:"pagination"`
+}
+
+// ========== Admin History Responses ==========
+
+// AdminHistoryDTO represents one change to an admin account
+// @name AdminHistoryDTO
+type AdminHistoryDTO struct {
+	ID        uuid.UUID                 `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Action    string                    `json:"action" example:"updated"` // created, updated or deleted
+	ActorID   *uuid.UUID                `json:"actor_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"` // Admin who made the change; omitted for the system
+	Actor     string                    `json:"actor" example:"admin"`
+	Changes   map[string]FieldChangeDTO `json:"changes"` // Changed fields, e.g. "username", "role", "password_changed"
+	CreatedAt time.Time                 `json:"created_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// AdminHistoryResponse defines the response structure for an admin account's change history
+// @name AdminHistoryResponse
+type AdminHistoryResponse struct {
+	Success    bool              `json:"success" example:"true" validate:"required"`
+	Message    string            `json:"message" example:"Admin history retrieved successfully" validate:"required"`
+	Data       []AdminHistoryDTO `json:"data"`
+	Pagination PaginationMeta    `json:"pagination"`
+}
+
+// ========== Admin Search Responses ==========
+
+// SearchResultDTO represents one admin search match
+// @name SearchResultDTO
+type SearchResultDTO struct {
+	Type     string `json:"type" example:"user"` // user, admin, location, gate or audit_log
+	ID       string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"` // UUID, or the provider's numeric ID for locations and gates
+	Title    string `json:"title" example:"+77771234567"`
+	Subtitle string `json:"subtitle,omitempty" example:"user@example.com"` // Email, role, address, the gate's location or the audit entry summary
+	Score    int    `json:"score" example:"100"` // Higher is a better match
+}
+
+// AdminSearchResponse defines the response structure for the admin search
+// @name AdminSearchResponse
+type AdminSearchResponse struct {
+	Success bool              `json:"success" example:"true" validate:"required"`
+	Message string            `json:"message" example:"Search completed successfully" validate:"required"`
+	Data    []SearchResultDTO `json:"data"`
+	Warning string            `json:"warning,omitempty"` // Set when locations and gates could not be searched
+}
+
+// ========== Admin Report Responses ==========
+
+// AdminReportDTO represents a computed report as a table
+// @name AdminReportDTO
+type AdminReportDTO struct {
+	Report   string          `json:"report" example:"daily_active_users" validate:"required"`
+	From     string          `json:"from" example:"2026-10-01" validate:"required"`         // First day covered
+	To       string          `json:"to" example:"2026-10-30" validate:"required"`           // Last day covered, inclusive
+	Timezone string          `json:"timezone" example:"Asia/Bishkek" validate:"required"` // IANA zone of the days
+	Columns  []string        `json:"columns" example:"day,active_users" validate:"required"`
+	Rows     [][]interface{} `json:"rows"` // One value per column
+}
+
+// AdminReportResponse defines the response structure for an operational report
+// @name AdminReportResponse
+type AdminReportResponse struct {
+	Success bool           `json:"success" example:"true" validate:"required"`
+	Message string         `json:"message" example:"Report computed successfully" validate:"required"`
+	Data    AdminReportDTO `json:"data"`
+	Warning string         `json:"warning,omitempty"` // Set when gate opens could not be attributed to locations
+}
+
+// ========== Provider Migration Responses ==========
+
+// ProviderMirrorOperationDTO summarizes the mirrored calls of one operation
+// @name ProviderMirrorOperationDTO
+type ProviderMirrorOperationDTO struct {
+	Operation    string `json:"operation" example:"assign_user"` // assign_user, open_gate or close_gate
+	Total        int64  `json:"total" example:"120"`
+	Matched      int64  `json:"matched" example:"118"`
+	Mismatched   int64  `json:"mismatched" example:"2"`
+	MirrorErrors int64  `json:"mirror_errors" example:"1"` // Calls the migration provider failed, whether or not the current provider failed too
+}
+
+// ProviderMirrorMismatchDTO represents a write the two providers handled differently
+// @name ProviderMirrorMismatchDTO
+type ProviderMirrorMismatchDTO struct {
+	ID             uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Operation      string    `json:"operation" example:"open_gate"`
+	Phone          string    `json:"phone,omitempty" example:"+77771234567"` // Set for assignments
+	GateID         int       `json:"gate_id,omitempty" example:"40"`         // Set for gate commands
+	PrimaryOutcome string    `json:"primary_outcome" example:"ok:true"`      // ok, ok:<result> or error:<kind>
+	MirrorOutcome  string    `json:"mirror_outcome" example:"error:not_found"`
+	MirrorDetail   string    `json:"mirror_detail,omitempty" example:"migration provider returned status code 404"`
+	CreatedAt      time.Time `json:"created_at" example:"2026-10-16T10:30:45Z"`
+}
+
+// ProviderMigrationReportDTO compares the current gate provider with the one being migrated to
+// @name ProviderMigrationReportDTO
+type ProviderMigrationReportDTO struct {
+	Enabled    bool                         `json:"enabled" example:"true"` // Whether writes are being mirrored now (THIRD_PARTY_MIRROR_API_URL is set)
+	Since      time.Time                    `json:"since" example:"2026-10-09T10:30:45Z"`
+	Operations []ProviderMirrorOperationDTO `json:"operations"`
+	Mismatches []ProviderMirrorMismatchDTO  `json:"mismatches"` // Most recent first
+}
+
+// ProviderMigrationReportResponse defines the response structure for the provider migration report
+// @name ProviderMigrationReportResponse
+type ProviderMigrationReportResponse struct {
+	Success bool                       `json:"success" example:"true" validate:"required"`
+	Message string                     `json:"message" example:"Provider migration report retrieved successfully" validate:"required"`
+	Data    ProviderMigrationReportDTO `json:"data"`
+}
+
+// ========== API Key Responses ==========
+
+// APIKeyDTO represents a machine API key, without the key itself
+// @name APIKeyDTO
+type APIKeyDTO struct {
+	ID         uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name       string     `json:"name" example:"Acme HR (Okta)"`
+	Prefix     string     `json:"prefix" example:"ogk_Q2x9fA"` // First characters of the key, to recognize it
+	Scopes     []string   `json:"scopes" example:"scim"`
+	CreatedBy  string     `json:"created_by" example:"admin"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2026-10-16T10:30:45Z"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at" example:"2026-10-01T08:00:00Z"`
+}
+
+// CreatedAPIKeyDTO represents a newly created API key, the only time the key is shown
+// @name CreatedAPIKeyDTO
+type CreatedAPIKeyDTO struct {
+	APIKeyDTO
+	Key string `json:"key" example:"ogk_Q2x9fAbT0kZ1pLr8yW3nVd6sHc4jXe7uGm5oIq2aRtY"`
+}
+
+// APIKeyResponse defines the response structure for a single API key
+// @name APIKeyResponse
+type APIKeyResponse struct {
+	Success bool      `json:"success" example:"true" validate:"required"`
+	Message string    `json:"message" example:"API key revoked successfully" validate:"required"`
+	Data    APIKeyDTO `json:"data"`
+}
+
+// CreatedAPIKeyResponse defines the response structure for creating an API key
+// @name CreatedAPIKeyResponse
+type CreatedAPIKeyResponse struct {
+	Success bool             `json:"success" example:"true" validate:"required"`
+	Message string           `json:"message" example:"API key created successfully. Store the key now, it cannot be shown again" validate:"required"`
+	Data    CreatedAPIKeyDTO `json:"data"`
+}
+
+// APIKeysResponse defines the response structure for listing API keys
+// @name APIKeysResponse
+type APIKeysResponse struct {
+	Success bool        `json:"success" example:"true" validate:"required"`
+	Message string      `json:"message" example:"API keys retrieved successfully" validate:"required"`
+	Data    []APIKeyDTO `json:"data"`
+}
+
+// WebhookSecretDTO represents a webhook signing secret, without the secret itself
+// @name WebhookSecretDTO
+type WebhookSecretDTO struct {
+	ID        uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Scope     string     `json:"scope" example:"outbound"` // outbound or provider_callback
+	Hint      string     `json:"hint" example:"x7Qa"`      // Last characters of the secret, to recognize it
+	Current   bool       `json:"current" example:"true"`   // False for the previous secret, still valid until expires_at
+	CreatedBy string     `json:"created_by" example:"admin"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2026-10-17T10:30:45Z"`
+	CreatedAt time.Time  `json:"created_at" example:"2026-10-16T10:30:45Z"`
+}
+
+// RotatedWebhookSecretDTO represents a newly rotated in secret, the only time the secret is shown
+// @name RotatedWebhookSecretDTO
+type RotatedWebhookSecretDTO struct {
+	WebhookSecretDTO
+	Secret string `json:"secret" example:"whsec_Q2x9fAbT0kZ1pLr8yW3nVd6sHc4jXe7uGm5oIq2aRtY"`
+}
+
+// RotatedWebhookSecretResponse defines the response structure for rotating a webhook secret
+// @name RotatedWebhookSecretResponse
+type RotatedWebhookSecretResponse struct {
+	Success bool                    `json:"success" example:"true" validate:"required"`
+	Message string                  `json:"message" example:"Webhook secret rotated successfully. Store the secret now, it cannot be shown again" validate:"required"`
+	Data    RotatedWebhookSecretDTO `json:"data"`
+}
+
+// WebhookSecretsResponse defines the response structure for listing webhook secrets
+// @name WebhookSecretsResponse
+type WebhookSecretsResponse struct {
+	Success bool               `json:"success" example:"true" validate:"required"`
+	Message string             `json:"message" example:"Webhook secrets retrieved successfully" validate:"required"`
+	Data    []WebhookSecretDTO `json:"data"`
+}
+
+// ========== Sandbox Responses ==========
+
+// SandboxSMSDTO represents a text message captured instead of sent in sandbox mode
+// @name SandboxSMSDTO
+type SandboxSMSDTO struct {
+	Phone   string    `json:"phone" example:"+77771234567"`
+	Message string    `json:"message" example:"Your Ololo Gate login code is 482913. It expires in 5 minutes. Do not share it with anyone."`
+	SentAt  time.Time `json:"sent_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// SandboxSMSResponse defines the response structure for listing captured sandbox text messages
+// @name SandboxSMSResponse
+type SandboxSMSResponse struct {
+	Success bool            `json:"success" example:"true" validate:"required"`
+	Message string          `json:"message" example:"Sandbox messages retrieved successfully" validate:"required"`
+	Data    []SandboxSMSDTO `json:"data"`
+}
+
+// ========== Security Denial Responses ==========
+
+// SecurityDenialDTO represents a request refused with 403
+// @name SecurityDenialDTO
+type SecurityDenialDTO struct {
+	ID        uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ActorType string     `json:"actor_type" example:"admin"` // admin, user, api_key or anonymous
+	ActorID   *uuid.UUID `json:"actor_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	ActorName string     `json:"actor_name,omitempty" example:"operator"`
+	ActorRole string     `json:"actor_role,omitempty" example:"regular"`
+	Method    string     `json:"method" example:"DELETE"`
+	Route     string     `json:"route" example:"/api/v1/admins/:id"`
+	Path      string     `json:"path" example:"/api/v1/admins/550e8400-e29b-41d4-a716-446655440002"`
+	Reason    string     `json:"reason" example:"super_admin_required"`
+	Message   string     `json:"message" example:"Super admin access required"`
+	IPAddress string     `json:"ip_address" example:"192.168.1.1"`
+	UserAgent string     `json:"user_agent,omitempty" example:"Mozilla/5.0"`
+	CreatedAt time.Time  `json:"created_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// SecurityDenialsResponse defines the response structure for listing security denials
+// @name SecurityDenialsResponse
+type SecurityDenialsResponse struct {
+	Success    bool                `json:"success" example:"true" validate:"required"`
+	Message    string              `json:"message" example:"Security denials retrieved successfully" validate:"required"`
+	Data       []SecurityDenialDTO `json:"data"`
+	Pagination PaginationMeta      `json:"pagination"`
+}
+
+// ========== Gate Event Log Responses ==========
+
+// GateEventLogDTO represents a user's gate open or close attempt
+// @name GateEventLogDTO
+type GateEventLogDTO struct {
+	ID         uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID     uuid.UUID  `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	GateID     int        `json:"gate_id" example:"40"`
+	LocationID *int       `json:"location_id,omitempty" example:"4"` // Unknown for gates not assigned to the user
+	Action     string     `json:"action" example:"open"`
+	Result     string     `json:"result" example:"sent"` // sent, queued, refused or failed
+	StatusCode int        `json:"status_code" example:"200"`
+	Message    string     `json:"message" example:"Gate operation completed"`
+	CommandID  *uuid.UUID `json:"command_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	LatencyMs  int64      `json:"latency_ms" example:"420"`
+	IPAddress  string     `json:"ip_address" example:"192.168.1.1"`
+	CreatedAt  time.Time  `json:"created_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// GateEventLogsResponse defines the response structure for listing gate attempts
+// @name GateEventLogsResponse
+type GateEventLogsResponse struct {
+	Success    bool              `json:"success" example:"true" validate:"required"`
+	Message    string            `json:"message" example:"Gate events retrieved successfully" validate:"required"`
+	Data       []GateEventLogDTO `json:"data"`
+	Pagination PaginationMeta    `json:"pagination"`
+}
+
+// ========== Sync Responses ==========
+
+// SyncChangeDTO represents one change in the sync feed
+// @name SyncChangeDTO
+type SyncChangeDTO struct {
+	ID         uuid.UUID                 `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID     uuid.UUID                 `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Action     string                    `json:"action" example:"assigned"` // Same actions as the user history
+	Actor      string                    `json:"actor" example:"admin"`
+	Changes    map[string]FieldChangeDTO `json:"changes"` // Changed fields, e.g. "phone", "email", "status", "assignments"
+	OccurredAt time.Time                 `json:"occurred_at" example:"2026-10-16T10:30:45Z"`
+}
+
+// SyncChangesResponse defines the response structure for a page of the sync feed
+// @name SyncChangesResponse
+type SyncChangesResponse struct {
+	Success   bool            `json:"success" example:"true" validate:"required"`
+	Message   string          `json:"message" example:"Changes retrieved successfully" validate:"required"`
+	Data      []SyncChangeDTO `json:"data"`
+	NextToken string          `json:"next_token" example:"MTc2MDYxMDI0NTAwMDAwMDAwMDo1NTBlODQwMC1lMjliLTQxZDQtYTcxNi00NDY2NTU0NDAwMDA"` // Pass as since to get the following changes
+	HasMore   bool            `json:"has_more" example:"false"`                                                                         // More changes are available right away
+}
+
+// ========== SLO Responses ==========
+
+// SLOStatusDTO represents the compliance of one service level objective over the window
+// @name SLOStatusDTO
+type SLOStatusDTO struct {
+	Name                 string  `json:"name" example:"gate_open"`
+	Method               string  `json:"method" example:"PUT"`
+	Path                 string  `json:"path" example:"/api/v1/locations/:gateId/open"`
+	LatencyTarget        string  `json:"latency_target" example:"2s"`
+	Target               float64 `json:"target" example:"95"` // Percent of requests that must succeed within latency_target
+	Window               string  `json:"window" example:"24h0m0s"`
+	Requests             int64   `json:"requests" example:"1200"`
+	GoodRequests         int64   `json:"good_requests" example:"1176"`
+	Compliance           float64 `json:"compliance" example:"98"`             // Percent of good requests; 100 without requests
+	ErrorBudgetRemaining float64 `json:"error_budget_remaining" example:"60"` // Percent of the allowed bad requests left; negative once overspent
+	Met                  bool    `json:"met" example:"true"`
+}
+
+// SLOSummaryResponse defines the response structure for the SLO summary
+// @name SLOSummaryResponse
+type SLOSummaryResponse struct {
+	Success bool           `json:"success" example:"true" validate:"required"`
+	Message string         `json:"message" example:"SLO summary retrieved successfully" validate:"required"`
+	Data    []SLOStatusDTO `json:"data"`
+}
+
+// ========== Gate Report Responses ==========
+
+// GateReportDTO represents a problem with a gate reported by a user
+// @name GateReportDTO
+type GateReportDTO struct {
+	ID             uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID         uuid.UUID  `json:"user_id" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	LocationID     int        `json:"location_id" example:"1"`
+	GateID         int        `json:"gate_id" example:"12"`
+	GateTitle      string     `json:"gate_title" example:"Автоматический Шлагбаум №12"`
+	Category       string     `json:"category" example:"stuck_closed"` // stuck_open, stuck_closed, damaged or other
+	Description    string     `json:"description" example:"Barrier does not lift, the motor hums"`
+	HasPhoto       bool       `json:"has_photo" example:"true"` // Admins download it from /admin/gate-reports/:id/photo
+	Status         string     `json:"status" example:"open"`    // open or resolved
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty" example:"2025-01-15T12:00:00Z"`
+	ResolvedBy     string     `json:"resolved_by,omitempty" example:"admin"`
+	ResolutionNote string     `json:"resolution_note,omitempty" example:"Motor replaced"`
+	CreatedAt      time.Time  `json:"created_at" example:"2025-01-15T10:30:00Z"`
+}
+
+// GateReportResponse defines the response structure for a single gate report
+// @name GateReportResponse
+type GateReportResponse struct {
+	Success bool          `json:"success" example:"true" validate:"required"`
+	Message string        `json:"message" example:"Problem reported, the facility team has been notified" validate:"required"`
+	Data    GateReportDTO `json:"data"`
+}
+
+// GateReportsResponse defines the response structure for the gate report queue
+// @name GateReportsResponse
+type GateReportsResponse struct {
+	Success    bool            `json:"success" example:"true" validate:"required"`
+	Message    string          `json:"message" example:"Gate reports retrieved successfully" validate:"required"`
+	Data       []GateReportDTO `json:"data"`
+	Pagination PaginationMeta  `json:"pagination"`
+}
+
+// ========== Location Freeze Responses ==========
+
+// LocationFreezeDTO represents a freeze blocking user opens at a location
+// @name LocationFreezeDTO
+type LocationFreezeDTO struct {
+	ID         uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	LocationID int        `json:"location_id" example:"4"`
+	Reason     string     `json:"reason" example:"Fire alarm, building in lockdown"`
+	StartsAt   time.Time  `json:"starts_at" example:"2025-01-15T22:00:00Z"`
+	EndsAt     *time.Time `json:"ends_at,omitempty" example:"2025-01-16T06:00:00Z"` // Absent when frozen until lifted
+	Active     bool       `json:"active" example:"true"`                            // Blocking opens right now
+	CreatedBy  string     `json:"created_by" example:"admin"`
+	LiftedAt   *time.Time `json:"lifted_at,omitempty" example:"2025-01-16T01:00:00Z"`
+	LiftedBy   string     `json:"lifted_by,omitempty" example:"admin"`
+	CreatedAt  time.Time  `json:"created_at" example:"2025-01-15T21:55:00Z"`
+}
+
+// LocationFreezeResponse defines the response structure for freezing a location
+// @name LocationFreezeResponse
+type LocationFreezeResponse struct {
+	Success bool              `json:"success" example:"true" validate:"required"`
+	Message string            `json:"message" example:"Location frozen" validate:"required"`
+	Data    LocationFreezeDTO `json:"data"`
+}
+
+// LocationFreezesResponse defines the response structure for listing or lifting freezes
+// @name LocationFreezesResponse
+type LocationFreezesResponse struct {
+	Success bool                `json:"success" example:"true" validate:"required"`
+	Message string              `json:"message" example:"Freezes retrieved successfully" validate:"required"`
+	Data    []LocationFreezeDTO `json:"data"`
+}
+
+// LocationFrozenResponse defines the response structure for opens refused by a location freeze
+// @name LocationFrozenResponse
+type LocationFrozenResponse struct {
+	Success bool               `json:"success" example:"false" validate:"required"`
+	Message string             `json:"message" example:"Gates at this location are frozen until 2025-01-16T06:00:00Z: Fire alarm, building in lockdown" validate:"required"`
+	Data    LocationFrozenData `json:"data"`
+}
+
+// LocationFrozenData describes the freeze that refused an open
+// @name LocationFrozenData
+type LocationFrozenData struct {
+	LocationID int        `json:"location_id" example:"4"`
+	Reason     string     `json:"reason" example:"Fire alarm, building in lockdown"`
+	EndsAt     *time.Time `json:"ends_at,omitempty" example:"2025-01-16T06:00:00Z"` // Absent when frozen until lifted
+}
+
+// ========== Gate Maintenance Responses ==========
+
+// GateMaintenanceDTO represents a gate under maintenance
+// @name GateMaintenanceDTO
+type GateMaintenanceDTO struct {
+	GateID    int       `json:"gate_id" example:"40"`
+	Note      string    `json:"note" example:"Barrier motor replacement until Friday"`
+	StartedBy string    `json:"started_by" example:"admin"`
+	CreatedAt time.Time `json:"created_at" example:"2025-01-15T08:00:00Z"` // When the gate went under maintenance
+	UpdatedAt time.Time `json:"updated_at" example:"2025-01-15T09:30:00Z"`
+}
+
+// GateMaintenanceResponse defines the response structure for putting a gate under maintenance
+// @name GateMaintenanceResponse
+type GateMaintenanceResponse struct {
+	Success bool               `json:"success" example:"true" validate:"required"`
+	Message string             `json:"message" example:"Gate under maintenance" validate:"required"`
+	Data    GateMaintenanceDTO `json:"data"`
+}
+
+// GateMaintenancesResponse defines the response structure for listing gates under maintenance
+// @name GateMaintenancesResponse
+type GateMaintenancesResponse struct {
+	Success bool                 `json:"success" example:"true" validate:"required"`
+	Message string               `json:"message" example:"Gate maintenance retrieved successfully" validate:"required"`
+	Data    []GateMaintenanceDTO `json:"data"`
+}
+
+// GateMaintenanceRefusedResponse defines the response structure for opens and closes refused by gate maintenance
+// @name GateMaintenanceRefusedResponse
+type GateMaintenanceRefusedResponse struct {
+	Success bool                       `json:"success" example:"false" validate:"required"`
+	Message string                     `json:"message" example:"Gate is under maintenance: Barrier motor replacement until Friday" validate:"required"`
+	Data    GateMaintenanceRefusedData `json:"data"`
+}
+
+// GateMaintenanceRefusedData describes the maintenance that refused a command
+// @name GateMaintenanceRefusedData
+type GateMaintenanceRefusedData struct {
+	Code   string    `json:"code" example:"gate_maintenance"`
+	GateID int       `json:"gate_id" example:"40"`
+	Note   string    `json:"note" example:"Barrier motor replacement until Friday"`
+	Since  time.Time `json:"since" example:"2025-01-15T08:00:00Z"`
+}
+
+// ========== Notification Preference and Digest Responses ==========
+
+// NotificationPreferencesDTO represents which optional messages a user receives
+// @name NotificationPreferencesDTO
+type NotificationPreferencesDTO struct {
+	Digest bool `json:"digest" example:"true"` // SMS digest after weeks without opening a gate
+}
+
+// NotificationPreferencesResponse defines the response structure for notification preferences
+// @name NotificationPreferencesResponse
+type NotificationPreferencesResponse struct {
+	Success bool                       `json:"success" example:"true" validate:"required"`
+	Message string                     `json:"message" example:"Notification preferences retrieved successfully" validate:"required"`
+	Data    NotificationPreferencesDTO `json:"data"`
+}
+
+// DigestRunDTO represents one run of the inactivity digest with its stats
+// @name DigestRunDTO
+type DigestRunDTO struct {
+	ID            uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	InactiveSince time.Time  `json:"inactive_since" example:"2024-12-16T10:00:00Z"` // Users without a gate open since then were eligible
+	Eligible      int        `json:"eligible" example:"120"`
+	OptedOut      int        `json:"opted_out" example:"14"`
+	Sent          int        `json:"sent" example:"104"`
+	Failed        int        `json:"failed" example:"2"`
+	Error         string     `json:"error,omitempty" example:""`
+	StartedAt     time.Time  `json:"started_at" example:"2025-01-13T10:00:00Z"`
+	FinishedAt    *time.Time `json:"finished_at,omitempty" example:"2025-01-13T10:00:42Z"`
+}
+
+// DigestRunsResponse defines the response structure for listing digest runs
+// @name DigestRunsResponse
+type DigestRunsResponse struct {
+	Success bool           `json:"success" example:"true" validate:"required"`
+	Message string         `json:"message" example:"Digest runs retrieved successfully" validate:"required"`
+	Data    []DigestRunDTO `json:"data"`
+}
+
+// ========== Export Responses ==========
+
+// ExportDTO represents a background export
+// @name ExportDTO
+type ExportDTO struct {
+	ID                   uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000" validate:"required"`
+	Type                 string     `json:"type" example:"gate_events" validate:"required"`                     // gate_events or report
+	Status               string     `json:"status" example:"ready" validate:"required"`                         // pending, running, ready or failed
+	Filename             string     `json:"filename,omitempty" example:"gate_events_2026-09-01_2026-09-30.csv"` // Set once ready
+	ContentType          string     `json:"content_type,omitempty" example:"text/csv"`
+	SizeBytes            int64      `json:"size_bytes" example:"1048576"`
+	Error                string     `json:"error,omitempty" example:""` // Why the export failed
+	RequestedBy          string     `json:"requested_by" example:"admin"`
+	CreatedAt            time.Time  `json:"created_at" example:"2026-10-01T10:00:00Z"`
+	StartedAt            *time.Time `json:"started_at,omitempty" example:"2026-10-01T10:00:01Z"`
+	FinishedAt           *time.Time `json:"finished_at,omitempty" example:"2026-10-01T10:00:30Z"`
+	ExpiresAt            *time.Time `json:"expires_at,omitempty" example:"2026-10-02T10:00:30Z"`                       // When the finished export is deleted
+	DownloadURL          string     `json:"download_url,omitempty" example:"https://api.example.com/api/v1/files/..."` // Signed link to the file, once ready
+	DownloadURLExpiresAt *time.Time `json:"download_url_expires_at,omitempty" example:"2026-10-01T10:15:30Z"`
+}
+
+// ExportResponse defines the response structure for requesting or polling an export
+// @name ExportResponse
+type ExportResponse struct {
+	Success bool      `json:"success" example:"true" validate:"required"`
+	Message string    `json:"message" example:"Export retrieved successfully" validate:"required"`
+	Data    ExportDTO `json:"data"`
+}
+
+// ========== Forced Logout Responses ==========
+
+// ForcedLogoutDTO represents a forced logout of every user and its progress
+// @name ForcedLogoutDTO
+type ForcedLogoutDTO struct {
+	ID          uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000" validate:"required"`
+	Reason      string     `json:"reason" example:"Incident #42: signing keys may have leaked" validate:"required"`
+	Status      string     `json:"status" example:"running" validate:"required"` // pending, running, completed or failed
+	Total       int64      `json:"total" example:"12000"`                        // Users to sign out, counted when the job starts
+	Processed   int64      `json:"processed" example:"4500"`                     // Users signed out so far
+	Percent     int        `json:"percent" example:"37"`
+	Error       string     `json:"error,omitempty" example:""` // Why the forced logout failed
+	RequestedBy string     `json:"requested_by" example:"admin"`
+	CreatedAt   time.Time  `json:"created_at" example:"2026-10-01T10:00:00Z"`
+	StartedAt   *time.Time `json:"started_at,omitempty" example:"2026-10-01T10:00:01Z"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty" example:"2026-10-01T10:02:30Z"`
+}
+
+// ForcedLogoutResponse defines the response structure for requesting or polling a forced logout
+// @name ForcedLogoutResponse
+type ForcedLogoutResponse struct {
+	Success bool            `json:"success" example:"true" validate:"required"`
+	Message string          `json:"message" example:"Forced logout retrieved successfully" validate:"required"`
+	Data    ForcedLogoutDTO `json:"data"`
+}
+
+// ========== Location Override Responses ==========
+
+// LocationOverrideDTO represents a location's branding override
+// @name LocationOverrideDTO
+type LocationOverrideDTO struct {
+	LocationID  int       `json:"location_id" example:"1" validate:"required"`
+	DisplayName string    `json:"display_name,omitempty" example:"Ala-Too Mall"`
+	Logo        string    `json:"logo,omitempty" example:"https://cdn.example.com/logos/alatoo.png"`
+	SortOrder   *int      `json:"sort_order,omitempty" example:"1"`
+	UpdatedBy   string    `json:"updated_by" example:"admin"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2026-10-01T10:00:00Z"`
+}
+
+// LocationOverrideResponse defines the response structure for saving a location override
+// @name LocationOverrideResponse
+type LocationOverrideResponse struct {
+	Success bool                `json:"success" example:"true" validate:"required"`
+	Message string              `json:"message" example:"Override saved" validate:"required"`
+	Data    LocationOverrideDTO `json:"data"`
+}
+
+// LocationOverridesResponse defines the response structure for listing location overrides
+// @name LocationOverridesResponse
+type LocationOverridesResponse struct {
+	Success bool                  `json:"success" example:"true" validate:"required"`
+	Message string                `json:"message" example:"Overrides retrieved successfully" validate:"required"`
+	Data    []LocationOverrideDTO `json:"data"`
+}
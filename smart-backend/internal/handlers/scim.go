@@ -0,0 +1,826 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/pii"
+	"ololo-gate/internal/services"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// SCIM 2.0 (RFC 7643, RFC 7644) user provisioning for identity providers, authenticated with
+// API keys that have the "scim" scope. A SCIM user maps onto a user: userName is the phone
+// number, externalId the identity provider's ID for the user, active whether the user is out of
+// the trash, and the extension's locations the gates assigned to every number of the user.
+
+const (
+	scimContentType         = "application/scim+json"
+	scimUserSchema          = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimUserExtensionSchema = "urn:ololo-gate:params:scim:schemas:extension:2.0:User"
+	scimListSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema         = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimDefaultCount        = 100
+	scimMaxCount            = 200
+)
+
+// scimFilterPattern matches the supported filters: a single attribute compared with "eq"
+var scimFilterPattern = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+(.+?)\s*$`)
+
+// SCIMUser is a user in SCIM form
+// @name SCIMUser
+type SCIMUser struct {
+	Schemas    []string           `json:"schemas"`
+	ID         string             `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ExternalID string             `json:"externalId,omitempty" example:"00u1ab2cd3EF4gh5i6j7"` // The identity provider's ID for the user
+	UserName   string             `json:"userName" example:"+77771234567"`                     // Phone number in international format
+	Active     *bool              `json:"active,omitempty" example:"true"`                     // false moves the user to the trash
+	Password   string             `json:"password,omitempty"`                                  // Write-only; users created without one get a random password
+	Emails     []SCIMEmail        `json:"emails,omitempty"`                                    // The primary email is the user's login email
+	Extension  *SCIMUserExtension `json:"urn:ololo-gate:params:scim:schemas:extension:2.0:User,omitempty"`
+	Meta       *SCIMMeta          `json:"meta,omitempty"`
+}
+
+// SCIMEmail is an email address of a SCIM user
+// @name SCIMEmail
+type SCIMEmail struct {
+	Value   string `json:"value" example:"resident@example.com"`
+	Type    string `json:"type,omitempty" example:"work"`
+	Primary bool   `json:"primary,omitempty" example:"true"`
+}
+
+// SCIMUserExtension holds the gate access of a SCIM user. It is write-only: locations are
+// assigned at the gate provider and not returned.
+// @name SCIMUserExtension
+type SCIMUserExtension struct {
+	Locations []LocationAssignmentRequest `json:"locations"`
+}
+
+// SCIMMeta holds the resource metadata of a SCIM user
+// @name SCIMMeta
+type SCIMMeta struct {
+	ResourceType string    `json:"resourceType" example:"User"`
+	Created      time.Time `json:"created" example:"2026-10-16T10:30:45Z"`
+	LastModified time.Time `json:"lastModified" example:"2026-10-16T10:30:45Z"`
+	Location     string    `json:"location" example:"/api/v1/scim/v2/Users/550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// SCIMListResponse is a page of SCIM users
+// @name SCIMListResponse
+type SCIMListResponse struct {
+	Schemas      []string   `json:"schemas"`
+	TotalResults int64      `json:"totalResults" example:"1"`
+	StartIndex   int        `json:"startIndex" example:"1"`
+	ItemsPerPage int        `json:"itemsPerPage" example:"1"`
+	Resources    []SCIMUser `json:"Resources"`
+}
+
+// SCIMPatchRequest is a SCIM PatchOp request
+// @name SCIMPatchRequest
+type SCIMPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations"`
+}
+
+// SCIMPatchOperation is one operation of a SCIM PatchOp request
+// @name SCIMPatchOperation
+type SCIMPatchOperation struct {
+	Op    string          `json:"op" example:"replace"`  // add, replace or remove
+	Path  string          `json:"path" example:"active"` // Optional; without a path, value is an object of attributes
+	Value json.RawMessage `json:"value" swaggertype:"object"`
+}
+
+// SCIMError is a SCIM error response
+// @name SCIMError
+type SCIMError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status" example:"409"`
+	ScimType string   `json:"scimType,omitempty" example:"uniqueness"`
+	Detail   string   `json:"detail" example:"User with this phone number already exists"`
+}
+
+// scimUserUpdate holds the attributes a PUT or PATCH request changes; nil fields are left unchanged
+type scimUserUpdate struct {
+	UserName   *string
+	ExternalID *string
+	Email      *string
+	Password   *string
+	Active     *bool
+	Locations  *[]LocationAssignmentRequest
+}
+
+// ListSCIMUsers godoc
+// @Summary List SCIM users
+// @Description List users in SCIM form, oldest first, including users in the trash (active=false). Supports filters comparing one attribute with "eq": userName, externalId, emails.value, active and id (requires an API key with the "scim" scope)
+// @Tags SCIM
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param filter query string false "Filter, e.g. userName eq \"+77771234567\""
+// @Param startIndex query int false "1-based index of the first result" default(1)
+// @Param count query int false "Results per page (max 200)" default(100)
+// @Success 200 {object} SCIMListResponse "Users"
+// @Failure 400 {object} SCIMError "Unsupported filter"
+// @Failure 401 {object} APIResponse "Missing, invalid or revoked API key"
+// @Failure 403 {object} APIResponse "API key lacks the scim scope"
+// @Failure 500 {object} SCIMError "Internal server error"
+// @Router /api/v1/scim/v2/Users [get]
+func ListSCIMUsers(c *fiber.Ctx) error {
+	startIndex := c.QueryInt("startIndex", 1)
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	count := c.QueryInt("count", scimDefaultCount)
+	if count < 0 {
+		count = 0
+	}
+	if count > scimMaxCount {
+		count = scimMaxCount
+	}
+
+	query := db.DB.WithContext(c.UserContext()).Model(&models.User{})
+	if filter := c.Query("filter"); filter != "" {
+		var scimErr *SCIMError
+		if query, scimErr = applySCIMFilter(query, filter); scimErr != nil {
+			return sendSCIMError(c, scimErr)
+		}
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return sendSCIMError(c, newSCIMError(fiber.StatusInternalServerError, "", "Failed to list users"))
+	}
+
+	var users []models.User
+	if count > 0 {
+		if err := query.Order("created_at, id").Offset(startIndex - 1).Limit(count).Find(&users).Error; err != nil {
+			return sendSCIMError(c, newSCIMError(fiber.StatusInternalServerError, "", "Failed to list users"))
+		}
+	}
+
+	resources := make([]SCIMUser, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, toSCIMUser(user))
+	}
+	return sendSCIM(c, fiber.StatusOK, SCIMListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+// GetSCIMUser godoc
+// @Summary Get a SCIM user
+// @Description Retrieve a user in SCIM form, including users in the trash (active=false) (requires an API key with the "scim" scope)
+// @Tags SCIM
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {object} SCIMUser "User"
+// @Failure 401 {object} APIResponse "Missing, invalid or revoked API key"
+// @Failure 403 {object} APIResponse "API key lacks the scim scope"
+// @Failure 404 {object} SCIMError "User not found"
+// @Router /api/v1/scim/v2/Users/{id} [get]
+func GetSCIMUser(c *fiber.Ctx) error {
+	user, scimErr := findSCIMUser(c)
+	if scimErr != nil {
+		return sendSCIMError(c, scimErr)
+	}
+	return sendSCIM(c, fiber.StatusOK, toSCIMUser(user))
+}
+
+// CreateSCIMUser godoc
+// @Summary Provision a user over SCIM
+// @Description Create a user from a SCIM user. userName is the phone number; the primary email becomes a verified login email; without a password a random one is set, so the user logs in with a login code or resets it. The extension's locations are assigned to the user like POST /api/v1/users, including ASSIGNMENT_STRICT_MODE (requires an API key with the "scim" scope)
+// @Tags SCIM
+// @Accept application/scim+json
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param request body SCIMUser true "SCIM user"
+// @Success 201 {object} SCIMUser "User created"
+// @Failure 400 {object} SCIMError "Invalid user"
+// @Failure 401 {object} APIResponse "Missing, invalid or revoked API key"
+// @Failure 403 {object} APIResponse "API key lacks the scim scope"
+// @Failure 409 {object} SCIMError "Phone number, email or externalId already in use"
+// @Failure 500 {object} SCIMError "Internal server error"
+// @Failure 502 {object} SCIMError "Strict mode: assignment failed and the user was rolled back"
+// @Router /api/v1/scim/v2/Users [post]
+func CreateSCIMUser(c *fiber.Ctx) error {
+	var req SCIMUser
+	if err := c.BodyParser(&req); err != nil {
+		return sendSCIMError(c, newSCIMError(fiber.StatusBadRequest, "invalidSyntax", "Invalid request body"))
+	}
+
+	phone, err := phonenumber.Normalize(req.UserName)
+	if err != nil {
+		return sendSCIMError(c, newSCIMError(fiber.StatusBadRequest, "invalidValue", "userName must be a phone number in international format (e.g., +77771234567)"))
+	}
+	if err := phonenumber.CheckAllowed(phone); err != nil {
+		return sendSCIMError(c, newSCIMError(fiber.StatusBadRequest, "invalidValue", phoneCountryNotAllowedMessage()))
+	}
+	if services.PhoneInUse(phone) {
+		return sendSCIMError(c, newSCIMError(fiber.StatusConflict, "uniqueness", "User with this phone number already exists"))
+	}
+	if scimErr := checkSCIMExternalID(c.UserContext(), req.ExternalID, uuid.Nil); scimErr != nil {
+		return sendSCIMError(c, scimErr)
+	}
+
+	email := ""
+	if raw := primarySCIMEmail(req.Emails); raw != "" {
+		if email, err = services.ParseEmail(raw); err != nil {
+			return sendSCIMError(c, newSCIMError(fiber.StatusBadRequest, "invalidValue", "Invalid email format"))
+		}
+		if services.EmailInUse(email) {
+			return sendSCIMError(c, newSCIMError(fiber.StatusConflict, "uniqueness", "User with this email already exists"))
+		}
+	}
+
+	password := req.Password
+	if password == "" {
+		if password, err = randomSCIMPassword(); err != nil {
+			return sendSCIMError(c, newSCIMError(fiber.StatusInternalServerError, "", "Failed to create user"))
+		}
+	} else if len(password) < 6 {
+		return sendSCIMError(c, newSCIMError(fiber.StatusBadRequest, "invalidValue", "Password must be at least 6 characters long"))
+	}
+
+	actor := scimActor(c)
+
+	// Password is hashed by the BeforeCreate hook; emails set by an identity provider are verified
+	user := models.User{Phone: phone, Password: password, ExternalID: req.ExternalID}
+	if email != "" {
+		now := time.Now()
+		user.Email = email
+		user.EmailVerifiedAt = &now
+	}
+	if err := db.DB.WithContext(c.UserContext()).Create(&user).Error; err != nil {
+		slog.ErrorContext(c.UserContext(), "[SCIM] Failed to create user", "phone", phone, "error", err)
+		middleware.RecordAudit(c, "scim_create_user", "user", "", "failed", err.Error())
+		return sendSCIMError(c, newSCIMError(fiber.StatusInternalServerError, "", "Failed to create user"))
+	}
+
+	changes := services.FieldChanges{}.DiffUser(services.UserSnapshot{}, services.SnapshotUser(user))
+	if user.ExternalID != "" {
+		changes.Set("external_id", nil, user.ExternalID)
+	}
+
+	if req.Extension != nil && len(req.Extension.Locations) > 0 {
+		locations := toLocationAssignments(req.Extension.Locations)
+		if err := services.AssignAllPhones(services.NewThirdPartyClient().WithContext(c.UserContext()), user, locations); err != nil {
+			if isStrictAssignment(c) {
+				slog.WarnContext(c.UserContext(), "[SCIM] Strict mode: rolling back user after failed location/gate assignment",
+					"phone", phone, "error", err)
+				if delErr := db.DB.WithContext(c.UserContext()).Unscoped().Delete(&user).Error; delErr != nil {
+					slog.ErrorContext(c.UserContext(), "[SCIM] Error rolling back user", "phone", phone, "error", delErr)
+				}
+				middleware.RecordAudit(c, "scim_create_user", "user", user.ID.String(), "failed", "User rolled back after failed location/gate assignment: "+err.Error())
+				return sendSCIMError(c, newSCIMError(fiber.StatusBadGateway, "", "Failed to assign locations/gates, user was not created: "+err.Error()))
+			}
+			// The identity provider sends the locations again with its next update
+			slog.WarnContext(c.UserContext(), "[SCIM] Failed to assign locations/gates to user", "phone", phone, "error", err)
+			middleware.RecordAudit(c, "scim_assign_user", "user", user.ID.String(), "failed", "Failed to assign locations/gates: "+err.Error())
+		} else {
+			changes.Set("assignments", nil, locations)
+		}
+	}
+
+	services.RecordUserHistory(user.ID, models.UserHistoryCreated, actor, changes)
+	middleware.RecordAudit(c, "scim_create_user", "user", user.ID.String(), "success", "")
+	events.Publish(events.UserCreated, map[string]interface{}{
+		"user_id":    user.ID,
+		"phone":      user.Phone,
+		"created_by": actor,
+	})
+
+	if req.Active != nil && !*req.Active {
+		if err := services.TrashUser(c.UserContext(), &user, actor); err != nil {
+			slog.ErrorContext(c.UserContext(), "[SCIM] Failed to deactivate new user", "user_id", user.ID, "error", err)
+		}
+	}
+
+	slog.InfoContext(c.UserContext(), "[SCIM] User provisioned", "user_id", user.ID, "actor", actor)
+	c.Location(scimUserLocation(user.ID))
+	return sendSCIM(c, fiber.StatusCreated, toSCIMUser(user))
+}
+
+// ReplaceSCIMUser godoc
+// @Summary Replace a SCIM user
+// @Description Replace a user's attributes with a SCIM user. Attributes left out are cleared (externalId, emails) or reset (active defaults to true); the password is only changed when given, and locations only when the extension is given. Changing the phone number or password logs the user out (requires an API key with the "scim" scope)
+// @Tags SCIM
+// @Accept application/scim+json
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Param request body SCIMUser true "SCIM user"
+// @Success 200 {object} SCIMUser "User replaced"
+// @Failure 400 {object} SCIMError "Invalid user"
+// @Failure 401 {object} APIResponse "Missing, invalid or revoked API key"
+// @Failure 403 {object} APIResponse "API key lacks the scim scope"
+// @Failure 404 {object} SCIMError "User not found"
+// @Failure 409 {object} SCIMError "Phone number, email or externalId already in use"
+// @Failure 500 {object} SCIMError "Internal server error"
+// @Failure 502 {object} SCIMError "Assignment at the gate provider failed"
+// @Router /api/v1/scim/v2/Users/{id} [put]
+func ReplaceSCIMUser(c *fiber.Ctx) error {
+	user, scimErr := findSCIMUser(c)
+	if scimErr != nil {
+		return sendSCIMError(c, scimErr)
+	}
+
+	var req SCIMUser
+	if err := c.BodyParser(&req); err != nil {
+		return sendSCIMError(c, newSCIMError(fiber.StatusBadRequest, "invalidSyntax", "Invalid request body"))
+	}
+
+	active := req.Active == nil || *req.Active
+	email := primarySCIMEmail(req.Emails)
+	update := scimUserUpdate{UserName: &req.UserName, ExternalID: &req.ExternalID, Email: &email, Active: &active}
+	if req.Password != "" {
+		update.Password = &req.Password
+	}
+	if req.Extension != nil {
+		update.Locations = &req.Extension.Locations
+	}
+
+	if scimErr := applySCIMUpdate(c, &user, update); scimErr != nil {
+		return sendSCIMError(c, scimErr)
+	}
+	return sendSCIM(c, fiber.StatusOK, toSCIMUser(user))
+}
+
+// PatchSCIMUser godoc
+// @Summary Update a SCIM user
+// @Description Apply SCIM PatchOp operations (add, replace, remove) to a user. Supported paths: userName, externalId, password, active, emails (including emails[type eq "work"].value) and the extension's locations (urn:ololo-gate:params:scim:schemas:extension:2.0:User:locations); without a path, value is an object of these attributes. active=false moves the user to the trash and active=true restores them (requires an API key with the "scim" scope)
+// @Tags SCIM
+// @Accept application/scim+json
+// @Produce application/scim+json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Param request body SCIMPatchRequest true "PatchOp request"
+// @Success 200 {object} SCIMUser "User updated"
+// @Failure 400 {object} SCIMError "Invalid operation, path or value"
+// @Failure 401 {object} APIResponse "Missing, invalid or revoked API key"
+// @Failure 403 {object} APIResponse "API key lacks the scim scope"
+// @Failure 404 {object} SCIMError "User not found"
+// @Failure 409 {object} SCIMError "Phone number, email or externalId already in use"
+// @Failure 500 {object} SCIMError "Internal server error"
+// @Failure 502 {object} SCIMError "Assignment at the gate provider failed"
+// @Router /api/v1/scim/v2/Users/{id} [patch]
+func PatchSCIMUser(c *fiber.Ctx) error {
+	user, scimErr := findSCIMUser(c)
+	if scimErr != nil {
+		return sendSCIMError(c, scimErr)
+	}
+
+	var req SCIMPatchRequest
+	if err := c.BodyParser(&req); err != nil || len(req.Operations) == 0 {
+		return sendSCIMError(c, newSCIMError(fiber.StatusBadRequest, "invalidSyntax", "Invalid request body. Send a PatchOp with Operations"))
+	}
+
+	var update scimUserUpdate
+	for _, op := range req.Operations {
+		if scimErr := parseSCIMPatchOperation(&update, op); scimErr != nil {
+			return sendSCIMError(c, scimErr)
+		}
+	}
+
+	if scimErr := applySCIMUpdate(c, &user, update); scimErr != nil {
+		return sendSCIMError(c, scimErr)
+	}
+	return sendSCIM(c, fiber.StatusOK, toSCIMUser(user))
+}
+
+// DeleteSCIMUser godoc
+// @Summary Deprovision a SCIM user
+// @Description Move the user to the trash, like DELETE /api/v1/users/{id}: login is blocked at once and the user is purged, revoking their gate access, after USER_TRASH_RETENTION (requires an API key with the "scim" scope)
+// @Tags SCIM
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Success 204 "User deprovisioned"
+// @Failure 401 {object} APIResponse "Missing, invalid or revoked API key"
+// @Failure 403 {object} APIResponse "API key lacks the scim scope"
+// @Failure 404 {object} SCIMError "User not found"
+// @Failure 500 {object} SCIMError "Internal server error"
+// @Router /api/v1/scim/v2/Users/{id} [delete]
+func DeleteSCIMUser(c *fiber.Ctx) error {
+	user, scimErr := findSCIMUser(c)
+	if scimErr != nil {
+		return sendSCIMError(c, scimErr)
+	}
+
+	if err := services.TrashUser(c.UserContext(), &user, scimActor(c)); err != nil && !errors.Is(err, services.ErrUserTrashed) {
+		middleware.RecordAudit(c, "scim_delete_user", "user", user.ID.String(), "failed", err.Error())
+		return sendSCIMError(c, newSCIMError(fiber.StatusInternalServerError, "", "Failed to delete user"))
+	}
+	middleware.RecordAudit(c, "scim_delete_user", "user", user.ID.String(), "success", "")
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// applySCIMUpdate validates and applies a PUT or PATCH update to the user, recording its history
+func applySCIMUpdate(c *fiber.Ctx, user *models.User, update scimUserUpdate) *SCIMError {
+	actor := scimActor(c)
+	before := services.SnapshotUser(*user)
+	previousExternalID := user.ExternalID
+	previousTokenVersion := user.TokenVersion
+
+	if update.UserName != nil {
+		phone, err := phonenumber.Normalize(*update.UserName)
+		if err != nil {
+			return newSCIMError(fiber.StatusBadRequest, "invalidValue", "userName must be a phone number in international format (e.g., +77771234567)")
+		}
+		if phone != user.Phone {
+			if err := phonenumber.CheckAllowed(phone); err != nil {
+				return newSCIMError(fiber.StatusBadRequest, "invalidValue", phoneCountryNotAllowedMessage())
+			}
+			if services.PhoneInUse(phone) {
+				return newSCIMError(fiber.StatusConflict, "uniqueness", "Phone number is already in use")
+			}
+			user.Phone = phone
+			user.TokenVersion++
+		}
+	}
+
+	if update.ExternalID != nil && *update.ExternalID != user.ExternalID {
+		if scimErr := checkSCIMExternalID(c.UserContext(), *update.ExternalID, user.ID); scimErr != nil {
+			return scimErr
+		}
+		user.ExternalID = *update.ExternalID
+	}
+
+	if update.Email != nil {
+		email := ""
+		if *update.Email != "" {
+			parsed, err := services.ParseEmail(*update.Email)
+			if err != nil {
+				return newSCIMError(fiber.StatusBadRequest, "invalidValue", "Invalid email format")
+			}
+			email = parsed
+		}
+		if email != user.Email {
+			if email != "" && services.EmailInUse(email) {
+				return newSCIMError(fiber.StatusConflict, "uniqueness", "Email is already in use")
+			}
+			user.Email = email
+			user.EmailVerifiedAt = nil
+			if email != "" {
+				now := time.Now()
+				user.EmailVerifiedAt = &now
+			}
+		}
+	}
+
+	if update.Password != nil {
+		if len(*update.Password) < 6 {
+			return newSCIMError(fiber.StatusBadRequest, "invalidValue", "Password must be at least 6 characters long")
+		}
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*update.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return newSCIMError(fiber.StatusInternalServerError, "", "Failed to hash password")
+		}
+		now := time.Now()
+		user.Password = string(hashedPassword)
+		user.PasswordChangedAt = &now
+		user.TokenVersion++
+	}
+
+	if err := db.DB.WithContext(c.UserContext()).Save(user).Error; err != nil {
+		middleware.RecordAudit(c, "scim_update_user", "user", user.ID.String(), "failed", err.Error())
+		return newSCIMError(fiber.StatusInternalServerError, "", "Failed to update user")
+	}
+
+	// A token version bump logs out every device, so close their sessions too
+	if user.TokenVersion != previousTokenVersion {
+		services.RevokeAllSessions(c.UserContext(), user.ID)
+	}
+
+	// Passwords are never stored in the history, only that one was set
+	changes := services.FieldChanges{}.DiffUser(before, services.SnapshotUser(*user))
+	if user.ExternalID != previousExternalID {
+		changes.Set("external_id", previousExternalID, user.ExternalID)
+	}
+	if update.Password != nil {
+		changes.Set("password_changed", false, true)
+	}
+	if len(changes) > 0 {
+		services.RecordUserHistory(user.ID, models.UserHistoryUpdated, actor, changes)
+	}
+
+	if update.Active != nil {
+		var err error
+		if *update.Active && user.TrashedAt != nil {
+			err = services.RestoreUser(user, actor)
+		} else if !*update.Active && user.TrashedAt == nil {
+			err = services.TrashUser(c.UserContext(), user, actor)
+		}
+		if err != nil {
+			middleware.RecordAudit(c, "scim_update_user", "user", user.ID.String(), "failed", err.Error())
+			return newSCIMError(fiber.StatusInternalServerError, "", "Failed to change whether the user is active")
+		}
+	}
+
+	if update.Locations != nil {
+		locations := toLocationAssignments(*update.Locations)
+		client := services.NewThirdPartyClient().WithContext(c.UserContext())
+		previous := services.PreviousAssignment(client, before.Phone)
+		if err := services.AssignAllPhones(client, *user, locations); err != nil {
+			slog.ErrorContext(c.UserContext(), "[SCIM] Failed to update locations/gates for user", "user_id", user.ID, "error", err)
+			middleware.RecordAudit(c, "scim_assign_user", "user", user.ID.String(), "failed", "Failed to assign locations/gates: "+err.Error())
+			return newSCIMError(fiber.StatusBadGateway, "", "User updated but location assignment failed: "+err.Error())
+		}
+		services.RecordUserHistory(user.ID, models.UserHistoryAssigned, actor,
+			services.FieldChanges{}.Set("assignments", previous, locations))
+	}
+
+	middleware.RecordAudit(c, "scim_update_user", "user", user.ID.String(), "success", "")
+	return nil
+}
+
+// parseSCIMPatchOperation adds the attributes changed by a PatchOp operation to update
+func parseSCIMPatchOperation(update *scimUserUpdate, op SCIMPatchOperation) *SCIMError {
+	remove := false
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+	case "remove":
+		remove = true
+	default:
+		return newSCIMError(fiber.StatusBadRequest, "invalidSyntax", "Unsupported operation: "+op.Op)
+	}
+
+	if strings.TrimSpace(op.Path) != "" {
+		return setSCIMAttribute(update, op.Path, op.Value, remove)
+	}
+	if remove {
+		return newSCIMError(fiber.StatusBadRequest, "noTarget", "remove requires a path")
+	}
+
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(op.Value, &attributes); err != nil {
+		return newSCIMError(fiber.StatusBadRequest, "invalidValue", "Without a path, value must be an object of attributes")
+	}
+	for name, value := range attributes {
+		if strings.EqualFold(name, scimUserExtensionSchema) {
+			var extension SCIMUserExtension
+			if err := json.Unmarshal(value, &extension); err != nil {
+				return newSCIMError(fiber.StatusBadRequest, "invalidValue", "Invalid extension value")
+			}
+			locations := extension.Locations
+			update.Locations = &locations
+			continue
+		}
+		if scimErr := setSCIMAttribute(update, name, value, false); scimErr != nil {
+			return scimErr
+		}
+	}
+	return nil
+}
+
+// setSCIMAttribute sets or removes one attribute of update. Read-only attributes are ignored.
+func setSCIMAttribute(update *scimUserUpdate, path string, value json.RawMessage, remove bool) *SCIMError {
+	attribute := strings.ToLower(strings.TrimSpace(path))
+	attribute = strings.TrimPrefix(attribute, strings.ToLower(scimUserSchema)+":")
+	invalid := newSCIMError(fiber.StatusBadRequest, "invalidValue", "Invalid value for "+path)
+
+	switch {
+	case attribute == "username" || attribute == "password":
+		if remove {
+			return newSCIMError(fiber.StatusBadRequest, "mutability", path+" cannot be removed")
+		}
+		var s string
+		if json.Unmarshal(value, &s) != nil {
+			return invalid
+		}
+		if attribute == "username" {
+			update.UserName = &s
+		} else {
+			update.Password = &s
+		}
+
+	case attribute == "externalid":
+		s := ""
+		if !remove && json.Unmarshal(value, &s) != nil {
+			return invalid
+		}
+		update.ExternalID = &s
+
+	case attribute == "active":
+		if remove {
+			return newSCIMError(fiber.StatusBadRequest, "mutability", path+" cannot be removed")
+		}
+		active, ok := parseSCIMBool(value)
+		if !ok {
+			return invalid
+		}
+		update.Active = &active
+
+	case strings.HasPrefix(attribute, "emails"):
+		// emails, emails.value or a value filter such as emails[type eq "work"].value
+		email := ""
+		if !remove && json.Unmarshal(value, &email) != nil {
+			var emails []SCIMEmail
+			if json.Unmarshal(value, &emails) != nil {
+				return invalid
+			}
+			email = primarySCIMEmail(emails)
+		}
+		update.Email = &email
+
+	case attribute == strings.ToLower(scimUserExtensionSchema)+":locations":
+		locations := []LocationAssignmentRequest{}
+		if !remove && json.Unmarshal(value, &locations) != nil {
+			return invalid
+		}
+		update.Locations = &locations
+
+	case attribute == "schemas" || attribute == "id" || attribute == "meta":
+		// Read-only
+
+	default:
+		return newSCIMError(fiber.StatusBadRequest, "noTarget", "Unsupported attribute: "+path)
+	}
+	return nil
+}
+
+// applySCIMFilter limits a users query to a filter comparing one attribute with "eq"
+func applySCIMFilter(query *gorm.DB, filter string) (*gorm.DB, *SCIMError) {
+	match := scimFilterPattern.FindStringSubmatch(filter)
+	if match == nil {
+		return nil, newSCIMError(fiber.StatusBadRequest, "invalidFilter", `Unsupported filter. Use: <attribute> eq "<value>"`)
+	}
+	value := match[2]
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+
+	switch strings.ToLower(match[1]) {
+	case "username":
+		if phone, err := phonenumber.Normalize(value); err == nil {
+			value = phone
+		}
+		return query.Where("phone_index = ?", pii.BlindIndex(value)), nil
+	case "externalid":
+		return query.Where("external_id = ?", value), nil
+	case "emails", "emails.value":
+		return query.Where("email_index = ?", pii.BlindIndex(models.NormalizeEmail(value))), nil
+	case "id":
+		return query.Where("id = ?", value), nil
+	case "active":
+		active, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, newSCIMError(fiber.StatusBadRequest, "invalidFilter", "active must be compared with true or false")
+		}
+		if active {
+			return query.Where("trashed_at IS NULL"), nil
+		}
+		return query.Where("trashed_at IS NOT NULL"), nil
+	}
+	return nil, newSCIMError(fiber.StatusBadRequest, "invalidFilter", "Unsupported filter attribute: "+match[1]+". Use userName, externalId, emails.value, active or id")
+}
+
+// findSCIMUser loads the user in the id path parameter, including users in the trash
+func findSCIMUser(c *fiber.Ctx) (models.User, *SCIMError) {
+	var user models.User
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return user, newSCIMError(fiber.StatusNotFound, "", "User not found")
+	}
+	if err := db.DB.WithContext(c.UserContext()).First(&user, "id = ?", userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return user, newSCIMError(fiber.StatusNotFound, "", "User not found")
+		}
+		return user, newSCIMError(fiber.StatusInternalServerError, "", "Failed to retrieve user")
+	}
+	return user, nil
+}
+
+// checkSCIMExternalID rejects an externalId that already belongs to another user
+func checkSCIMExternalID(ctx context.Context, externalID string, userID uuid.UUID) *SCIMError {
+	if externalID == "" {
+		return nil
+	}
+	var count int64
+	if err := db.DB.WithContext(ctx).Model(&models.User{}).Where("external_id = ? AND id <> ?", externalID, userID).Count(&count).Error; err != nil {
+		return newSCIMError(fiber.StatusInternalServerError, "", "Failed to check externalId")
+	}
+	if count > 0 {
+		return newSCIMError(fiber.StatusConflict, "uniqueness", "User with this externalId already exists")
+	}
+	return nil
+}
+
+// primarySCIMEmail returns the primary email, or the first one when none is marked primary
+func primarySCIMEmail(emails []SCIMEmail) string {
+	for _, email := range emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(emails) > 0 {
+		return emails[0].Value
+	}
+	return ""
+}
+
+// parseSCIMBool accepts a JSON boolean or a "true"/"false" string, which some identity providers send
+func parseSCIMBool(value json.RawMessage) (bool, bool) {
+	var b bool
+	if json.Unmarshal(value, &b) == nil {
+		return b, true
+	}
+	var s string
+	if json.Unmarshal(value, &s) == nil {
+		if parsed, err := strconv.ParseBool(s); err == nil {
+			return parsed, true
+		}
+	}
+	return false, false
+}
+
+// randomSCIMPassword returns a password for users provisioned without one
+func randomSCIMPassword() (string, error) {
+	random := make([]byte, 24)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
+
+// scimActor returns the API key the request is made with, as recorded in history and audit logs
+func scimActor(c *fiber.Ctx) string {
+	actor, ok := c.Locals("admin_username").(string)
+	if !ok {
+		return "unknown"
+	}
+	return actor
+}
+
+func toLocationAssignments(requested []LocationAssignmentRequest) []services.LocationAssignmentDTO {
+	locations := make([]services.LocationAssignmentDTO, len(requested))
+	for i, loc := range requested {
+		locations[i] = services.LocationAssignmentDTO{LocationID: loc.LocationID, GateIds: loc.GateIds}
+	}
+	return locations
+}
+
+func toSCIMUser(user models.User) SCIMUser {
+	active := user.TrashedAt == nil
+	scimUser := SCIMUser{
+		Schemas:    []string{scimUserSchema, scimUserExtensionSchema},
+		ID:         user.ID.String(),
+		ExternalID: user.ExternalID,
+		UserName:   user.Phone,
+		Active:     &active,
+		Meta: &SCIMMeta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+			Location:     scimUserLocation(user.ID),
+		},
+	}
+	if user.Email != "" {
+		scimUser.Emails = []SCIMEmail{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	return scimUser
+}
+
+func scimUserLocation(id uuid.UUID) string {
+	return "/api/v1/scim/v2/Users/" + id.String()
+}
+
+func newSCIMError(status int, scimType, detail string) *SCIMError {
+	return &SCIMError{
+		Schemas:  []string{scimErrorSchema},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+func sendSCIMError(c *fiber.Ctx, scimErr *SCIMError) error {
+	status, _ := strconv.Atoi(scimErr.Status)
+	return sendSCIM(c, status, scimErr)
+}
+
+func sendSCIM(c *fiber.Ctx, status int, body interface{}) error {
+	if err := c.Status(status).JSON(body); err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, scimContentType)
+	return nil
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func scimRequest(t *testing.T, app *fiber.App, key, method, path string, body interface{}) (int, map[string]interface{}) {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/scim+json")
+	req.Header.Set("Authorization", "Bearer "+key)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestAPIKeys_CreateAuthenticateAndRevoke(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	status, _ := mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/admin/api-keys", map[string]interface{}{"name": "Okta", "scopes": []string{"scim"}})
+	assert.Equal(t, fiber.StatusForbidden, status)
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/api-keys", map[string]interface{}{"name": "Okta", "scopes": []string{"gates"}})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "POST", "/api/v1/admin/api-keys", map[string]interface{}{"name": "Okta", "scopes": []string{"scim"}})
+	assert.Equal(t, fiber.StatusCreated, status)
+	data := result["data"].(map[string]interface{})
+	key := data["key"].(string)
+	assert.Contains(t, key, data["prefix"].(string))
+
+	status, _ = scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = scimRequest(t, app, key+"x", "GET", "/api/v1/scim/v2/Users", nil)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+
+	// The key is never listed again, only its prefix
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/api-keys", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	keys := result["data"].([]interface{})
+	assert.Len(t, keys, 1)
+	assert.NotContains(t, keys[0], "key")
+	assert.NotNil(t, keys[0].(map[string]interface{})["last_used_at"])
+
+	status, _ = mergeRequest(t, app, models.RoleSuper, "DELETE", "/api/v1/admin/api-keys/"+data["id"].(string), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users", nil)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+}
+
+func TestSCIM_ScopeRequired(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	_, key, err := services.CreateAPIKey("Other", []string{"reports"}, "admin")
+	assert.NoError(t, err)
+	status, _ := scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+}
+
+func TestSCIM_ProvisionUpdateAndDeprovision(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	_, key, err := services.CreateAPIKey("Okta", []string{models.APIKeyScopeSCIM}, "admin")
+	assert.NoError(t, err)
+
+	status, result := scimRequest(t, app, key, "POST", "/api/v1/scim/v2/Users", map[string]interface{}{
+		"schemas":    []string{scimUserSchema, scimUserExtensionSchema},
+		"userName":   "+7 777 123 45 67",
+		"externalId": "00u1",
+		"emails":     []map[string]interface{}{{"value": "Resident@Example.com", "primary": true}},
+		scimUserExtensionSchema: map[string]interface{}{
+			"locations": []map[string]interface{}{{"locationId": 4, "gateIds": []int{40}}},
+		},
+	})
+	assert.Equal(t, fiber.StatusCreated, status)
+	id := result["id"].(string)
+	assert.Equal(t, "+77771234567", result["userName"])
+	assert.Equal(t, true, result["active"])
+	assert.Equal(t, []services.LocationAssignmentDTO{{LocationID: 4, GateIds: []int{40}}}, provider.assignments["+77771234567"])
+
+	var user models.User
+	db.DB.First(&user, "id = ?", id)
+	assert.Equal(t, "00u1", user.ExternalID)
+	assert.Equal(t, "resident@example.com", user.Email)
+	assert.NotNil(t, user.EmailVerifiedAt)
+
+	status, result = scimRequest(t, app, key, "POST", "/api/v1/scim/v2/Users", map[string]interface{}{"userName": "+77771234567"})
+	assert.Equal(t, fiber.StatusConflict, status)
+	assert.Equal(t, "uniqueness", result["scimType"])
+
+	// Filters
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users?filter="+url.QueryEscape(`externalId eq "00u1"`), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, float64(1), result["totalResults"])
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users?filter="+url.QueryEscape(`emails.value eq "resident@example.com"`), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, float64(1), result["totalResults"])
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users?filter="+url.QueryEscape(`userName eq "+77770000000"`), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, float64(0), result["totalResults"])
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users?filter="+url.QueryEscape(`name.familyName co "x"`), nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	assert.Equal(t, "invalidFilter", result["scimType"])
+
+	// Deactivating moves the user to the trash and logs them out; reactivating restores them
+	status, result = scimRequest(t, app, key, "PATCH", "/api/v1/scim/v2/Users/"+id, map[string]interface{}{
+		"schemas":    []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": []map[string]interface{}{{"op": "Replace", "path": "active", "value": "False"}},
+	})
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, false, result["active"])
+	db.DB.First(&user, "id = ?", id)
+	assert.NotNil(t, user.TrashedAt)
+
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users?filter="+url.QueryEscape(`active eq false`), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, float64(1), result["totalResults"])
+
+	status, result = scimRequest(t, app, key, "PATCH", "/api/v1/scim/v2/Users/"+id, map[string]interface{}{
+		"Operations": []map[string]interface{}{{"op": "replace", "value": map[string]interface{}{"active": true, "externalId": "00u2"}}},
+	})
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, true, result["active"])
+	assert.Equal(t, "00u2", result["externalId"])
+
+	// PUT replaces the user: the phone number changes and the email is cleared
+	status, result = scimRequest(t, app, key, "PUT", "/api/v1/scim/v2/Users/"+id, map[string]interface{}{
+		"userName":   "+77771234568",
+		"externalId": "00u2",
+	})
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "+77771234568", result["userName"])
+	assert.Nil(t, result["emails"])
+	db.DB.First(&user, "id = ?", id)
+	assert.Equal(t, "", user.Email)
+	assert.Equal(t, 2, user.TokenVersion) // Trashed once, phone changed once
+
+	var history []models.UserHistory
+	db.DB.Where("user_id = ?", id).Find(&history)
+	assert.Equal(t, "api_key:Okta", history[0].Actor)
+
+	status, _ = scimRequest(t, app, key, "DELETE", "/api/v1/scim/v2/Users/"+id, nil)
+	assert.Equal(t, fiber.StatusNoContent, status)
+	db.DB.First(&user, "id = ?", id)
+	assert.NotNil(t, user.TrashedAt)
+
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/scim/v2/Users/00000000-0000-0000-0000-000000000000", nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+	assert.Equal(t, "404", result["status"])
+}
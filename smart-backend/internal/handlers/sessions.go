@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetMySessions godoc
+// @Summary List my device sessions
+// @Description List the active login sessions (one per device) of the current user
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} UserSessionsResponse "Sessions retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/sessions [get]
+func GetMySessions(c *fiber.Ctx) error {
+	userID, _ := c.Locals("id").(uuid.UUID)
+	currentSessionID, _ := c.Locals("session_id").(uuid.UUID)
+
+	sessions, err := services.ActiveSessions(userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve sessions",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(UserSessionsResponse{
+		Success: true,
+		Message: "Sessions retrieved successfully",
+		Data:    toUserSessionDTOs(sessions, currentSessionID),
+	})
+}
+
+// GetUserSessions godoc
+// @Summary List a user's device sessions
+// @Description List the active login sessions (one per device) of a user, for support. trusted marks sessions logged in with "remember me", which use the long refresh expiry (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {object} UserSessionsResponse "Sessions retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid user ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/{id}/sessions [get]
+func GetUserSessions(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		})
+	}
+
+	var user models.User
+	if err := db.DB.WithContext(c.UserContext()).Select("id").First(&user, "id = ?", userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "User not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve sessions",
+		})
+	}
+
+	sessions, err := services.ActiveSessions(userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve sessions",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(UserSessionsResponse{
+		Success: true,
+		Message: "Sessions retrieved successfully",
+		Data:    toUserSessionDTOs(sessions, uuid.Nil),
+	})
+}
+
+// toUserSessionDTOs maps sessions to DTOs, marking currentSessionID as the current one
+func toUserSessionDTOs(sessions []models.UserSession, currentSessionID uuid.UUID) []UserSessionDTO {
+	dtos := make([]UserSessionDTO, len(sessions))
+	for i, session := range sessions {
+		dtos[i] = UserSessionDTO{
+			ID:         session.ID,
+			DeviceID:   session.DeviceID,
+			IPAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			Current:    currentSessionID != uuid.Nil && session.ID == currentSessionID,
+			Trusted:    session.Trusted,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			CreatedAt:  session.CreatedAt,
+		}
+	}
+	return dtos
+}
+
+// RevokeMySession godoc
+// @Summary Log out a device session
+// @Description Revoke one of the current user's sessions. Tokens issued for that device stop working immediately; other devices stay logged in.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Session ID (UUID)"
+// @Success 200 {object} APIResponse "Session revoked successfully"
+// @Failure 400 {object} APIResponse "Invalid session ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 404 {object} APIResponse "Session not found"
+// @Router /api/v1/auth/sessions/{id} [delete]
+func RevokeMySession(c *fiber.Ctx) error {
+	userID, _ := c.Locals("id").(uuid.UUID)
+
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid session ID format",
+		})
+	}
+
+	revoked, err := services.RevokeSession(sessionID, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to revoke session",
+		})
+	}
+	if !revoked {
+		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "Session not found",
+		})
+	}
+
+	slog.InfoContext(c.UserContext(), "[SESSION] Session revoked", "user_id", userID, "session_id", sessionID)
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "Session revoked successfully",
+	})
+}
+
+// RevokeAllMySessions godoc
+// @Summary Log out all devices
+// @Description Revoke every session of the current user, including the one making this request, and invalidate all previously issued tokens
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} APIResponse "All sessions revoked successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/sessions [delete]
+func RevokeAllMySessions(c *fiber.Ctx) error {
+	userID, _ := c.Locals("id").(uuid.UUID)
+
+	// Bumping the token version also invalidates tokens issued before sessions existed
+	if err := db.DB.WithContext(c.UserContext()).Model(&models.User{}).Where("id = ?", userID).
+		UpdateColumn("token_version", gorm.Expr("token_version + 1")).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to revoke sessions",
+		})
+	}
+
+	revoked, _ := services.RevokeAllSessions(c.UserContext(), userID)
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "All sessions revoked successfully",
+		Data: fiber.Map{
+			"revoked": revoked,
+		},
+	})
+}
+
+// Logout godoc
+// @Summary Log out
+// @Description Log out the device making this request: its session is revoked, so its access and refresh tokens stop working immediately while other devices stay logged in. Tokens issued before device sessions existed carry no session; for them every token of the user is invalidated (token version bumped), like DELETE /auth/sessions.
+// @Tags User Authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} APIResponse "Logged out successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/auth/logout [post]
+func Logout(c *fiber.Ctx) error {
+	userID, _ := c.Locals("id").(uuid.UUID)
+	sessionID, _ := c.Locals("session_id").(uuid.UUID)
+
+	if sessionID != uuid.Nil {
+		if _, err := services.RevokeSession(sessionID, userID); err != nil {
+			slog.ErrorContext(c.UserContext(), "[SESSION] Failed to log out session", "session_id", sessionID, "user_id", userID, "error", err)
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to log out",
+			})
+		}
+		slog.InfoContext(c.UserContext(), "[SESSION] Session logged out", "user_id", userID, "session_id", sessionID)
+	} else {
+		// Legacy tokens can only be invalidated together
+		if err := db.DB.WithContext(c.UserContext()).Model(&models.User{}).Where("id = ?", userID).
+			UpdateColumn("token_version", gorm.Expr("token_version + 1")).Error; err != nil {
+			slog.ErrorContext(c.UserContext(), "[SESSION] Failed to log out user", "user_id", userID, "error", err)
+			return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to log out",
+			})
+		}
+		services.RevokeAllSessions(c.UserContext(), userID)
+		slog.InfoContext(c.UserContext(), "[SESSION] Logged out with a token without session, all tokens invalidated", "user_id", userID)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "Logged out successfully",
+	})
+}
@@ -0,0 +1,385 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/pii"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func loginOnDevice(t *testing.T, app *fiber.App, phone, password, deviceID string) (string, string) {
+	body, _ := json.Marshal(LoginRequest{Phone: phone, Password: password})
+	req := httptest.NewRequest("POST", "/api/v1/auth/login?device_id="+deviceID, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var response LoginResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	return response.Data.AccessToken, response.Data.RefreshToken
+}
+
+// getSessions lists the sessions like a well-behaved client, sending the device the token
+// is bound to
+func getSessions(t *testing.T, app *fiber.App, token string) (int, UserSessionsResponse) {
+	req := httptest.NewRequest("GET", "/api/v1/auth/sessions", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if claims, err := utils.ValidateToken(token, utils.AccessToken); err == nil && claims.DeviceID != "" {
+		req.Header.Set("X-Device-ID", claims.DeviceID)
+	}
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var response UserSessionsResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	return resp.StatusCode, response
+}
+
+func createSessionTestUser() {
+	db.DB.Create(&models.User{Phone: "+77771234567", Password: "password123"})
+}
+
+func TestLogin_SecondDeviceKeepsFirstLoggedIn(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+
+	phoneToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	tabletToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "tablet")
+
+	status, _ := getSessions(t, app, phoneToken)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	status, response := getSessions(t, app, tabletToken)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, response.Data, 2)
+}
+
+func TestLogin_SameDeviceReplacesSession(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+
+	oldToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	newToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+
+	status, _ := getSessions(t, app, oldToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+
+	status, response := getSessions(t, app, newToken)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, response.Data, 1)
+	assert.True(t, response.Data[0].Current)
+}
+
+func TestRevokeMySession_LogsOutOnlyThatDevice(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+
+	phoneToken, phoneRefresh := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	tabletToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "tablet")
+
+	_, response := getSessions(t, app, phoneToken)
+	var phoneSessionID string
+	for _, session := range response.Data {
+		if session.DeviceID == "phone" {
+			phoneSessionID = session.ID.String()
+		}
+	}
+
+	req := httptest.NewRequest("DELETE", "/api/v1/auth/sessions/"+phoneSessionID, nil)
+	req.Header.Set("Authorization", "Bearer "+tabletToken)
+	req.Header.Set("X-Device-ID", "tablet")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	status, _ := getSessions(t, app, phoneToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	status, _ = getSessions(t, app, tabletToken)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	// The revoked device cannot refresh either
+	body, _ := json.Marshal(RefreshRequest{RefreshToken: phoneRefresh})
+	req = httptest.NewRequest("POST", "/api/v1/auth/refresh", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Device-ID", "phone")
+	resp, err = app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestRevokeAllMySessions(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+
+	phoneToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	tabletToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "tablet")
+
+	req := httptest.NewRequest("DELETE", "/api/v1/auth/sessions", nil)
+	req.Header.Set("Authorization", "Bearer "+phoneToken)
+	req.Header.Set("X-Device-ID", "phone")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	status, _ := getSessions(t, app, phoneToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	status, _ = getSessions(t, app, tabletToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+}
+
+func TestLogout_RevokesOnlyCurrentDevice(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+
+	phoneToken, phoneRefresh := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	tabletToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "tablet")
+
+	req := httptest.NewRequest("POST", "/api/v1/auth/logout", nil)
+	req.Header.Set("Authorization", "Bearer "+phoneToken)
+	req.Header.Set("X-Device-ID", "phone")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	status, _ := getSessions(t, app, phoneToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	status, _ = getSessions(t, app, tabletToken)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	body, _ := json.Marshal(RefreshRequest{RefreshToken: phoneRefresh})
+	req = httptest.NewRequest("POST", "/api/v1/auth/refresh", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Device-ID", "phone")
+	resp, err = app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestLogout_TokenWithoutSessionInvalidatesAll(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	db.DB.Create(&user)
+
+	tokens, err := utils.GenerateTokens(user.ID, user.Phone, user.TokenVersion)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/api/v1/auth/logout", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var updated models.User
+	db.DB.First(&updated, "id = ?", user.ID)
+	assert.Equal(t, user.TokenVersion+1, updated.TokenVersion)
+	status, _ := getSessions(t, app, tokens.AccessToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+}
+
+func getSessionsFromDevice(t *testing.T, app *fiber.App, token, deviceID string) int {
+	req := httptest.NewRequest("GET", "/api/v1/auth/sessions", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if deviceID != "" {
+		req.Header.Set("X-Device-ID", deviceID)
+	}
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.StatusCode
+}
+
+func TestDeviceBoundToken_RejectsOtherDevice(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+
+	token, refresh := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+
+	claims, err := utils.ValidateToken(token, utils.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "phone", claims.DeviceID)
+
+	assert.Equal(t, fiber.StatusOK, getSessionsFromDevice(t, app, token, "phone"))
+	assert.Equal(t, fiber.StatusUnauthorized, getSessionsFromDevice(t, app, token, "stolen-phone"))
+
+	// The refresh token is bound to the device as well
+	body, _ := json.Marshal(RefreshRequest{RefreshToken: refresh})
+	req := httptest.NewRequest("POST", "/api/v1/auth/refresh", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Device-ID", "stolen-phone")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestDeviceBoundToken_RequireDeviceHeader(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+
+	token, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+
+	// A token copied to another device is rejected when the header is left off
+	assert.Equal(t, fiber.StatusUnauthorized, getSessionsFromDevice(t, app, token, ""))
+	assert.Equal(t, fiber.StatusOK, getSessionsFromDevice(t, app, token, "phone"))
+
+	// Turning enforcement off for a client rollout accepts it without the header, never with another device
+	config.AppConfig().JWT.RequireDevice = false
+	defer func() { config.AppConfig().JWT.RequireDevice = true }()
+	assert.Equal(t, fiber.StatusOK, getSessionsFromDevice(t, app, token, ""))
+	assert.Equal(t, fiber.StatusUnauthorized, getSessionsFromDevice(t, app, token, "stolen-phone"))
+}
+
+func TestLogin_TrustedDeviceGetsLongRefreshExpiry(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+	config.AppConfig().JWT.TrustedRefreshExpiry = 90 * 24 * time.Hour
+
+	body, _ := json.Marshal(LoginRequest{Phone: "+77771234567", Password: "password123", TrustedDevice: true})
+	req := httptest.NewRequest("POST", "/api/v1/auth/login?device_id=laptop", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var login LoginResponse
+	json.NewDecoder(resp.Body).Decode(&login)
+	assert.Equal(t, int64((90 * 24 * time.Hour).Seconds()), login.Data.RefreshExpiresIn)
+
+	claims, err := utils.ValidateToken(login.Data.RefreshToken, utils.RefreshToken)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(90*24*time.Hour), claims.ExpiresAt.Time, time.Minute)
+
+	// A normal login on another device keeps the default expiry
+	loginOnDevice(t, app, "+77771234567", "password123", "phone")
+
+	var user models.User
+	db.DB.First(&user, "phone_index = ?", pii.BlindIndex("+77771234567"))
+	status, result := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/users/"+user.ID.String()+"/sessions", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	trusted := map[string]bool{}
+	for _, item := range result["data"].([]interface{}) {
+		session := item.(map[string]interface{})
+		trusted[session["device_id"].(string)] = session["trusted"].(bool)
+	}
+	assert.Equal(t, map[string]bool{"laptop": true, "phone": false}, trusted)
+}
+
+func TestLogin_SessionLimitEvictsOldestSession(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+	config.AppConfig().JWT.MaxSessions = 2
+	defer func() { config.AppConfig().JWT.MaxSessions = 0 }()
+
+	phoneToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	tabletToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "tablet")
+	laptopToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "laptop")
+
+	status, _ := getSessions(t, app, phoneToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	status, _ = getSessions(t, app, tabletToken)
+	assert.Equal(t, fiber.StatusOK, status)
+	status, response := getSessions(t, app, laptopToken)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, response.Data, 2)
+
+	var user models.User
+	db.DB.First(&user, "phone_index = ?", pii.BlindIndex("+77771234567"))
+	var history []models.UserHistory
+	db.DB.Where("user_id = ? AND action = ?", user.ID, models.UserHistorySessionEvicted).Find(&history)
+	assert.Len(t, history, 1)
+	assert.Equal(t, "system", history[0].Actor)
+	changes, err := history[0].FieldChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, "phone", changes["device_id"].Before)
+
+	// The user's own limit overrides the deployment limit; 0 lifts it
+	status, _ = mergeRequest(t, app, models.RoleRegular, "PATCH", "/api/v1/users/"+user.ID.String(), map[string]interface{}{"max_sessions": 0})
+	assert.Equal(t, fiber.StatusOK, status)
+	loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	status, response = getSessions(t, app, laptopToken)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, response.Data, 3)
+
+	status, _ = mergeRequest(t, app, models.RoleRegular, "PATCH", "/api/v1/users/"+user.ID.String(), map[string]interface{}{"max_sessions": -2})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+}
+
+func TestStaleDevices_ReportThenPurge(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	createSessionTestUser()
+	config.AppConfig().JWT.StaleDeviceAfter = 180 * 24 * time.Hour
+	defer func() { config.AppConfig().JWT.StaleDeviceAfter, config.AppConfig().JWT.StaleDevicePurge = 0, false }()
+
+	phoneToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "phone")
+	tabletToken, _ := loginOnDevice(t, app, "+77771234567", "password123", "tablet")
+	db.DB.Model(&models.UserSession{}).Where("device_id = ?", "tablet").Update("last_used_at", time.Now().AddDate(0, -7, 0))
+	revokedAt := time.Now().AddDate(-1, 0, 0)
+	db.DB.Create(&models.UserSession{UserID: uuid.New(), DeviceID: "old-phone", LastUsedAt: revokedAt, ExpiresAt: revokedAt, RevokedAt: &revokedAt})
+
+	status, result := mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/stale-devices", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, false, data["purge_enabled"])
+	assert.Equal(t, float64(2), data["total"])
+	assert.Equal(t, float64(1), data["active"])
+	assert.Equal(t, float64(2), data["users"])
+	devices := data["devices"].([]interface{})
+	assert.Equal(t, "old-phone", devices[0].(map[string]interface{})["device_id"])
+	assert.Equal(t, true, devices[1].(map[string]interface{})["active"])
+
+	// A shorter retention can be previewed before changing it
+	status, result = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/stale-devices?older_than=9000h", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, float64(0), result["data"].(map[string]interface{})["total"])
+	status, _ = mergeRequest(t, app, models.RoleSuper, "GET", "/api/v1/admin/stale-devices?older_than=-1h", nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/admin/stale-devices", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	// Without enforcement the job only reports
+	assert.NoError(t, services.RunStaleDevicePurge(context.Background()))
+	var remaining int64
+	db.DB.Model(&models.UserSession{}).Count(&remaining)
+	assert.Equal(t, int64(3), remaining)
+
+	config.AppConfig().JWT.StaleDevicePurge = true
+	assert.NoError(t, services.RunStaleDevicePurge(context.Background()))
+	db.DB.Model(&models.UserSession{}).Count(&remaining)
+	assert.Equal(t, int64(1), remaining)
+
+	status, _ = getSessions(t, app, tabletToken)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	status, _ = getSessions(t, app, phoneToken)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	var history []models.UserHistory
+	db.DB.Where("action = ?", models.UserHistoryDeviceRemoved).Find(&history)
+	assert.Len(t, history, 1)
+	changes, err := history[0].FieldChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, "tablet", changes["device_id"].Before)
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// Streaming formats for unbounded listings (limit=-1), selected with the stream query parameter
+const (
+	streamJSON   = "json"   // The usual list response, written row by row
+	streamNDJSON = "ndjson" // One JSON object per line, without the response envelope
+)
+
+// streamFlushEvery is how many rows are written between flushes to the client
+const streamFlushEvery = 500
+
+var errStreamFormat = errors.New("stream must be json or ndjson, with limit=-1")
+
+// parseStreamFormat validates the stream query parameter. Empty means the response is built in
+// memory as usual; streaming is only offered for unbounded listings.
+func parseStreamFormat(c *fiber.Ctx, limit int) (string, error) {
+	switch format := c.Query("stream"); format {
+	case "":
+		return "", nil
+	case streamJSON, streamNDJSON:
+		if limit != -1 {
+			return "", errStreamFormat
+		}
+		return format, nil
+	}
+	return "", errStreamFormat
+}
+
+// streamListing writes every row of query to the client as it is read from the database, so
+// memory use stays flat however large the table is. The json format has the same body as the
+// paginated response, with the total filled in after the last row. query must have its model set.
+func streamListing[M any, D any](c *fiber.Ctx, query *gorm.DB, format, message string, toDTO func(M) D) error {
+	if format == streamNDJSON {
+		c.Set(fiber.HeaderContentType, "application/x-ndjson")
+	} else {
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	}
+
+	// The rows are read after the handler returns, once the request's context has ended
+	ctx := context.WithoutCancel(c.UserContext())
+	query = query.WithContext(ctx)
+	c.Status(fiber.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// The status is already sent, so a failure can only cut the listing short
+		if err := writeListing(w, query, format, message, toDTO); err != nil {
+			slog.ErrorContext(ctx, "[STREAM] Listing failed", "error", err)
+		}
+		w.Flush()
+	})
+	return nil
+}
+
+func writeListing[M any, D any](w *bufio.Writer, query *gorm.DB, format, message string, toDTO func(M) D) error {
+	if format == streamJSON {
+		prefix, err := json.Marshal(message)
+		if err != nil {
+			return err
+		}
+		w.WriteString(`{"success":true,"message":`)
+		w.Write(prefix)
+		w.WriteString(`,"data":[`)
+	}
+
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	encoder := json.NewEncoder(w)
+	written := 0
+	for rows.Next() {
+		var row M
+		if err := db.DB.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if format == streamJSON && written > 0 {
+			w.WriteByte(',')
+		}
+		// Encode ends every object with a newline, which also separates NDJSON records
+		if err := encoder.Encode(toDTO(row)); err != nil {
+			return err
+		}
+		if written++; written%streamFlushEvery == 0 {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if format == streamJSON {
+		pagination, err := json.Marshal(PaginationMeta{Total: written, PerPage: written, CurrentPage: 1, LastPage: 1})
+		if err != nil {
+			return err
+		}
+		w.WriteString(`],"pagination":`)
+		w.Write(pagination)
+		w.WriteString(`}`)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func swaggerApp() *fiber.App {
+	app := fiber.New()
+	app.Use(middleware.CORS())
+	app.Use("/swagger", middleware.SwaggerAccess())
+	app.Get("/swagger/*", func(c *fiber.Ctx) error { return c.SendString("docs") })
+	return app
+}
+
+func swaggerRequest(t *testing.T, app *fiber.App, method, path string, headers map[string]string) *http.Response {
+	req := httptest.NewRequest(method, path, nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestSwaggerAccess_Modes(t *testing.T) {
+	_, cleanup := SetupTestApp()
+	defer cleanup()
+	app := swaggerApp()
+	defer func() { config.AppConfig().Swagger = config.SwaggerConfig{} }()
+
+	config.AppConfig().Swagger = config.SwaggerConfig{Mode: config.SwaggerDisabled}
+	assert.Equal(t, fiber.StatusNotFound, swaggerRequest(t, app, "GET", "/swagger/index.html", nil).StatusCode)
+
+	config.AppConfig().Swagger = config.SwaggerConfig{Mode: config.SwaggerBasic, Username: "docs", Password: "secret"}
+	resp := swaggerRequest(t, app, "GET", "/swagger/index.html", nil)
+	assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("WWW-Authenticate"), "Basic")
+	wrong := "Basic " + base64.StdEncoding.EncodeToString([]byte("docs:wrong"))
+	assert.Equal(t, fiber.StatusUnauthorized, swaggerRequest(t, app, "GET", "/swagger/index.html", map[string]string{"Authorization": wrong}).StatusCode)
+	right := "Basic " + base64.StdEncoding.EncodeToString([]byte("docs:secret"))
+	assert.Equal(t, fiber.StatusOK, swaggerRequest(t, app, "GET", "/swagger/index.html", map[string]string{"Authorization": right}).StatusCode)
+
+	// Admin mode takes the token once in the query and keeps it in a cookie for the UI's own requests
+	config.AppConfig().Swagger = config.SwaggerConfig{Mode: config.SwaggerAdmin}
+	admin := models.Admin{ID: uuid.New(), Username: "docs-admin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	assert.Equal(t, fiber.StatusUnauthorized, swaggerRequest(t, app, "GET", "/swagger/index.html", nil).StatusCode)
+	resp = swaggerRequest(t, app, "GET", "/swagger/index.html?token="+token, nil)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Set-Cookie"), "swagger_token="+token)
+	assert.Contains(t, resp.Header.Get("Set-Cookie"), "path=/swagger")
+	assert.Equal(t, fiber.StatusOK, swaggerRequest(t, app, "GET", "/swagger/doc.json", map[string]string{"Cookie": "swagger_token=" + token}).StatusCode)
+}
+
+func TestSwaggerAccess_AllowedOrigins(t *testing.T) {
+	_, cleanup := SetupTestApp()
+	defer cleanup()
+	app := swaggerApp()
+	config.AppConfig().CORS.AllowedOrigins = "*"
+	config.AppConfig().Swagger = config.SwaggerConfig{Mode: config.SwaggerPublic, AllowedOrigins: []string{"https://portal.example.com"}}
+	defer func() { config.AppConfig().Swagger = config.SwaggerConfig{} }()
+
+	// The API's wildcard CORS policy does not extend to the docs
+	assert.Equal(t, fiber.StatusForbidden, swaggerRequest(t, app, "GET", "/swagger/doc.json", map[string]string{"Origin": "https://evil.example.com"}).StatusCode)
+
+	resp := swaggerRequest(t, app, "GET", "/swagger/doc.json", map[string]string{"Origin": "https://portal.example.com"})
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "https://portal.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+
+	resp = swaggerRequest(t, app, "OPTIONS", "/swagger/doc.json", map[string]string{"Origin": "https://portal.example.com", "Access-Control-Request-Method": "GET"})
+	assert.Equal(t, fiber.StatusNoContent, resp.StatusCode)
+
+	// Same-origin requests and plain navigations need no allowlist
+	assert.Equal(t, fiber.StatusOK, swaggerRequest(t, app, "GET", "/swagger/doc.json", map[string]string{"Origin": "http://example.com"}).StatusCode)
+	assert.Equal(t, fiber.StatusOK, swaggerRequest(t, app, "GET", "/swagger/index.html", nil).StatusCode)
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	syncDefaultLimit = 100
+	syncMaxLimit     = 1000
+)
+
+// GetSyncChanges godoc
+// @Summary Get changes since a token
+// @Description Incremental feed of changes to users and their location/gate assignments, oldest first, for mirroring them into another system. Start without since, then pass the returned next_token to get the changes after it; when has_more is false, poll again later with the same token. Changes are user history entries: created, registered, updated, assigned, phone_added, phone_removed, trashed, restored, purged, approved, rejected, merged and merged_into, with the changed fields before and after (requires an API key with the "sync" scope)
+// @Tags Sync
+// @Produce json
+// @Security BearerAuth
+// @Param since query string false "Token returned as next_token by the previous call; omit to start at the first change"
+// @Param limit query int false "Changes per page (max 1000)" default(100)
+// @Success 200 {object} SyncChangesResponse "Changes retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid change token"
+// @Failure 401 {object} APIResponse "Missing, invalid or revoked API key"
+// @Failure 403 {object} APIResponse "API key lacks the sync scope"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/sync/changes [get]
+func GetSyncChanges(c *fiber.Ctx) error {
+	limit := c.QueryInt("limit", syncDefaultLimit)
+	if limit < 1 || limit > syncMaxLimit {
+		limit = syncDefaultLimit
+	}
+
+	page, err := services.SyncChanges(c.Query("since"), limit)
+	if errors.Is(err, services.ErrSyncTokenInvalid) {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid change token",
+		})
+	}
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[SYNC] Failed to read changes", "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve changes",
+		})
+	}
+
+	dtos := make([]SyncChangeDTO, len(page.Changes))
+	for i, entry := range page.Changes {
+		dtos[i] = SyncChangeDTO{
+			ID:         entry.ID,
+			UserID:     entry.UserID,
+			Action:     entry.Action,
+			Actor:      entry.Actor,
+			Changes:    userHistoryChanges(entry),
+			OccurredAt: entry.CreatedAt,
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(SyncChangesResponse{
+		Success:   true,
+		Message:   "Changes retrieved successfully",
+		Data:      dtos,
+		NextToken: page.NextToken,
+		HasMore:   page.HasMore,
+	})
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncChanges_PagesThroughTheLogWithTokens(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	_, key, err := services.CreateAPIKey("Warehouse", []string{models.APIKeyScopeSync}, "admin")
+	assert.NoError(t, err)
+
+	userID := uuid.New()
+	services.RecordUserHistory(userID, models.UserHistoryCreated, "admin", services.FieldChanges{}.Set("phone", nil, "+77771234567"))
+	services.RecordUserHistory(userID, models.UserHistoryAssigned, "admin", services.FieldChanges{}.Set("assignments", nil, []int{4}))
+	services.RecordUserHistory(userID, models.UserHistoryTrashed, "system", services.FieldChanges{}.Set("status", "approved", "trashed"))
+
+	status, result := scimRequest(t, app, key, "GET", "/api/v1/sync/changes?limit=2", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, true, result["has_more"])
+	changes := result["data"].([]interface{})
+	assert.Len(t, changes, 2)
+	first := changes[0].(map[string]interface{})
+	assert.Equal(t, userID.String(), first["user_id"])
+	assert.Equal(t, "+77771234567", first["changes"].(map[string]interface{})["phone"].(map[string]interface{})["after"])
+
+	token := result["next_token"].(string)
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/sync/changes?limit=2&since="+token, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, false, result["has_more"])
+	changes = result["data"].([]interface{})
+	assert.Len(t, changes, 1)
+	assert.Equal(t, models.UserHistoryTrashed, changes[0].(map[string]interface{})["action"])
+
+	// Nothing new: the same token comes back to poll with
+	token = result["next_token"].(string)
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/sync/changes?since="+token, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["data"])
+	assert.Equal(t, token, result["next_token"])
+
+	services.RecordUserHistory(userID, models.UserHistoryRestored, "admin", services.FieldChanges{}.Set("status", "trashed", "approved"))
+	status, result = scimRequest(t, app, key, "GET", "/api/v1/sync/changes?since="+token, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	changes = result["data"].([]interface{})
+	assert.Len(t, changes, 1)
+	assert.Equal(t, models.UserHistoryRestored, changes[0].(map[string]interface{})["action"])
+
+	status, _ = scimRequest(t, app, key, "GET", "/api/v1/sync/changes?since=not-a-token", nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+}
+
+func TestSyncChanges_ScopeRequired(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	_, key, err := services.CreateAPIKey("Okta", []string{models.APIKeyScopeSCIM}, "admin")
+	assert.NoError(t, err)
+	status, _ := scimRequest(t, app, key, "GET", "/api/v1/sync/changes", nil)
+	assert.Equal(t, fiber.StatusForbidden, status)
+}
@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"context"
 	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
 	"ololo-gate/internal/middleware"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"sync"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/driver/sqlite"
@@ -14,81 +18,271 @@ import (
 // SetupTestApp creates a Fiber app with all routes configured for testing
 func SetupTestApp() (*fiber.App, func()) {
 	// Setup test config
-	config.AppConfig = &config.Config{
+	config.SetAppConfig(&config.Config{
 		JWT: config.JWTConfig{
 			Secret:        "test-secret-key",
 			AccessExpiry:  900000000000,      // 15 minutes in nanoseconds
 			RefreshExpiry: 2592000000000000,  // 30 days in nanoseconds
+			AdminAccessExpiry:  30 * time.Minute,
+			AdminRefreshExpiry: 24 * time.Hour,
+			RequireDevice:      true,
 		},
 		Server: config.ServerConfig{
 			Port: "8080",
 			Env:  "test",
 		},
-	}
+		InitAdmin: config.InitAdminConfig{
+			UUID: "00000000-0000-0000-0000-000000000001",
+		},
+	})
 
 	// Setup test config for third-party API (use empty URL for tests)
-	config.AppConfig.ThirdPartyAPIURL = "http://localhost:3000"
+	config.AppConfig().ThirdPartyAPIURL = "http://localhost:3000"
 
 	// Setup test database
 	db.DB, _ = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
-	db.DB.AutoMigrate(&models.User{}, &models.Admin{}, &models.Contact{}, &models.AdminAuditLog{})
+	db.DB.AutoMigrate(&models.User{}, &models.Admin{}, &models.Contact{}, &models.AdminAuditLog{}, &models.GateCommand{}, &models.GateEvent{}, &models.GateEventRollup{}, &models.JobRun{}, &models.JobLock{}, &models.AdminNotification{}, &models.UserSession{}, &models.UsageCounter{}, &models.UsageActiveUser{}, &models.UsageDailyActiveUser{}, &models.CORSOrigin{}, &models.UserPhone{}, &models.GateLink{}, &models.InviteCode{}, &models.LegalDocument{}, &models.LegalAcceptance{}, &models.UserHistory{}, &models.AdminHistory{}, &models.ProviderMirrorResult{}, &models.APIKey{}, &models.GateReport{}, &models.LocationFreeze{}, &models.Export{}, &models.LocationOverride{}, &models.AdminCredential{}, &models.WebAuthnChallenge{}, &models.GateMaintenance{}, &models.DigestRun{}, &models.ForcedLogout{}, &models.ProviderQuotaWarning{}, &models.AuditComment{}, &models.OTPCode{}, &models.WebhookSecret{}, &models.SecurityDenial{}, &models.GateEventLog{}, &models.PendingAssignment{})
 
-	app := fiber.New()
+	app := fiber.New(fiber.Config{ErrorHandler: ErrorHandler, CaseSensitive: true})
+	app.Use(middleware.RequestID())
+	app.Use(middleware.CORS())
+	app.Use(middleware.MarkSandbox())
 
 	// Setup routes exactly as in main.go
-	api := app.Group("/api/v1")
+	app.Use("/api/v1/admin/feed", AdminFeedTokenFromQuery)
+	api := app.Group("/api/v1", middleware.RequestDeadline(), middleware.TrackSLOs(), middleware.InjectFaults(), middleware.MeterUsage(), middleware.AuditDenials(), middleware.Authorize(), middleware.LocalizeTimestamps(), middleware.AuditCapture())
+
 
 	// Auth routes (public)
 	auth := api.Group("/auth")
 	auth.Post("/register", Register)
 	auth.Post("/login", Login)
 	auth.Post("/refresh", RefreshToken)
+	auth.Post("/login-otp/request", RequestLoginOTP)
+	auth.Post("/login-otp/confirm", ConfirmLoginOTP)
+	auth.Post("/verify-otp", VerifyRegistrationOTP)
+	auth.Post("/verify-otp/resend", ResendRegistrationOTP)
+	auth.Post("/change-password", ChangePassword)
+	auth.Post("/forgot-password", ForgotPassword)
+	auth.Post("/reset-password", ResetPassword)
 	auth.Get("/check-phone", CheckPhoneAvailability)
+	auth.Get("/sessions", GetMySessions)
+	auth.Delete("/sessions", RevokeAllMySessions)
+	auth.Post("/logout", Logout)
+	auth.Delete("/sessions/:id", RevokeMySession)
+	auth.Get("/legal", GetMyLegalStatus)
+	auth.Post("/legal/accept", AcceptLegalDocument)
+	auth.Get("/notification-preferences", GetNotificationPreferences)
+	auth.Put("/notification-preferences", UpdateNotificationPreferences)
 
 	// User management routes (protected - requires Admin JWT authentication)
-	users := api.Group("/users", middleware.AdminJWTProtected())
+	users := api.Group("/users")
 	users.Get("/", GetAllUsers)
 	users.Post("/", CreateUser)
+	users.Post("/bulk-delete", BulkDeleteUsers)
+	users.Get("/duplicates", GetDuplicateUsers)
+	users.Get("/trash", GetTrashedUsers)
 	users.Get("/:id", GetUserByID)
 	users.Patch("/:id", UpdateUser)
 	users.Delete("/:id", DeleteUser)
+	users.Post("/:id/restore", RestoreUser)
+	users.Post("/:id/retry-assignment", RetryUserAssignment)
+	users.Get("/:id/history", GetUserHistory)
+	users.Get("/:id/sessions", GetUserSessions)
+	users.Get("/:id/phones", GetUserPhones)
+	users.Post("/:id/phones", AddUserPhone)
+	users.Delete("/:id/phones/:phoneId", DeleteUserPhone)
+	users.Post("/:id/merge", MergeUsers)
 
 	// Admin authentication (public)
 	adminAuth := api.Group("/admin")
 	adminAuth.Post("/login", AdminLogin)
+	adminAuth.Post("/login/passkey/options", BeginAdminPasskeyLogin)
+	adminAuth.Post("/login/passkey", AdminPasskeyLogin)
+	adminAuth.Post("/refresh", AdminRefreshToken)
 
-	// Admin user management routes (Admin JWT protected, role-based access control in handlers)
-	adminUsers := api.Group("/admin/users", middleware.AdminJWTProtected())
-	adminUsers.Get("/", middleware.SuperAdminOnly(), GetAllAdmins)
-	adminUsers.Post("/", middleware.SuperAdminOnly(), CreateAdmin)
+	// Admin user management routes (Admin JWT protected, super admin or self-access per AccessPolicy)
+	adminUsers := api.Group("/admin/users")
+	adminUsers.Get("/", GetAllAdmins)
+	adminUsers.Post("/", CreateAdmin)
+	adminUsers.Post("/import", ImportAdmins)
 	adminUsers.Get("/:id", GetAdminByID)
 	adminUsers.Patch("/:id", UpdateAdmin)
-	adminUsers.Delete("/:id", middleware.SuperAdminOnly(), DeleteAdmin)
+	adminUsers.Delete("/:id", DeleteAdmin)
+	adminUsers.Get("/:id/history", GetAdminHistory)
+	adminUsers.Get("/:id/passkeys", GetAdminPasskeys)
+	adminUsers.Post("/:id/passkeys/options", BeginAdminPasskeyRegistration)
+	adminUsers.Post("/:id/passkeys", RegisterAdminPasskey)
+	adminUsers.Patch("/:id/passkeys/:credentialId", RenameAdminPasskey)
+	adminUsers.Delete("/:id/passkeys/:credentialId", DeleteAdminPasskey)
+
+	api.Post("/admin/impersonate/:userId", ImpersonateUser)
 
 	// Gate management routes (User JWT protected - users only, not admins)
-	api.Get("/locations", middleware.JWTProtected(), GetLocations)
-	api.Get("/locations/:locationId/gates", middleware.JWTProtected(), GetGatesByLocation)
-	api.Put("/locations/:gateId/open", middleware.JWTProtected(), OpenGate)
-	api.Put("/locations/:gateId/close", middleware.JWTProtected(), CloseGate)
+	api.Get("/locations", GetLocations)
+	api.Get("/locations/:locationId/gates", GetGatesByLocation)
+	api.Put("/locations/:gateId/open", OpenGate)
+	api.Put("/locations/:gateId/close", CloseGate)
+	api.Post("/locations/:gateId/links", CreateGateLink)
+	api.Post("/gates/:gateId/report", ReportGateProblem)
+	api.Get("/links/:id", ResolveGateLink)
+
+	// Gate command status routes
+	api.Get("/gate-commands/:id", GetGateCommand)
+	api.Post("/gate-commands/:id/callback", GateCommandCallback)
+
+	// Admin notification center routes (Admin JWT protected)
+	adminNotifications := api.Group("/admin/notifications")
+	adminNotifications.Get("/", GetAdminNotifications)
+	adminNotifications.Patch("/read-all", MarkAllAdminNotificationsRead)
+	adminNotifications.Patch("/:id/read", MarkAdminNotificationRead)
+	adminNotifications.Get("/:id", GetAdminNotificationByID)
+	adminNotifications.Post("/:id/comments", CreateAdminNotificationComment)
+
+	// Live admin dashboard feed (WebSocket, Admin JWT via header or ?token=)
+	api.Get("/admin/feed", AdminFeedUpgrade, AdminFeed)
+
+	// Scheduled job status route (Admin JWT protected, super admin only)
+	api.Get("/admin/jobs", GetScheduledJobs)
+	api.Get("/admin/digest/runs", GetDigestRuns)
+
+	// Usage metering rollup route (Admin JWT protected, super admin only)
+	api.Get("/admin/usage", GetUsageRollup)
+	api.Get("/admin/provider-usage", GetProviderUsage)
+	api.Get("/admin/slo", GetSLOSummary)
+
+	// Operational reports (Admin JWT protected, super admin only)
+	api.Get("/admin/reports", GetAdminReport)
+	api.Get("/admin/provider-migration/report", GetProviderMigrationReport)
+
+	// Gate operation history export (Admin JWT protected, super admin only)
+	api.Get("/admin/gate-events", GetGateEvents)
+	api.Get("/admin/gate-events/export", middleware.Quota("exports", services.ExportQuotaLimits), ExportGateEvents)
+
+	// Background exports (Admin JWT protected, super admin only)
+	api.Post("/admin/exports", middleware.Quota("exports", services.ExportQuotaLimits), CreateExport)
+	api.Get("/admin/exports/:id", GetExport)
+
+	// Forced logout of every user (Admin JWT protected, super admin only)
+	api.Post("/admin/forced-logouts", CreateForcedLogout)
+	api.Get("/admin/forced-logouts/:id", GetForcedLogout)
+
+	// Stale device retention (Admin JWT protected, super admin only)
+	api.Get("/admin/stale-devices", GetStaleDevices)
+
+	// Route listing (Admin JWT protected, super admin only)
+	api.Get("/admin/routes", GetRegisteredRoutes)
+	api.Post("/admin/config/reload", ReloadConfig)
+	api.Get("/admin/cors-origins", GetCORSOrigins)
+	api.Post("/admin/cors-origins", CreateCORSOrigin)
+	api.Delete("/admin/cors-origins/:id", DeleteCORSOrigin)
+	api.Get("/admin/invite-codes", GetInviteCodes)
+	api.Post("/admin/invite-codes", CreateInviteCode)
+	api.Delete("/admin/invite-codes/:id", RevokeInviteCode)
+	api.Get("/admin/api-keys", GetAPIKeys)
+	api.Post("/admin/api-keys", CreateAPIKey)
+	api.Delete("/admin/api-keys/:id", RevokeAPIKey)
+	api.Get("/admin/webhook-secrets", GetWebhookSecrets)
+	api.Post("/admin/webhook-secrets/:scope/rotate", RotateWebhookSecret)
+	api.Get("/admin/sandbox/sms", GetSandboxSMS)
+	api.Get("/scim/v2/Users", ListSCIMUsers)
+	api.Post("/scim/v2/Users", CreateSCIMUser)
+	api.Get("/scim/v2/Users/:id", GetSCIMUser)
+	api.Put("/scim/v2/Users/:id", ReplaceSCIMUser)
+	api.Patch("/scim/v2/Users/:id", PatchSCIMUser)
+	api.Delete("/scim/v2/Users/:id", DeleteSCIMUser)
+	api.Get("/sync/changes", GetSyncChanges)
+	api.Get("/admin/registrations", GetRegistrations)
+	api.Post("/admin/registrations/:id/approve", ApproveRegistration)
+	api.Post("/admin/registrations/:id/reject", RejectRegistration)
+	api.Get("/admin/locations/freezes", GetLocationFreezes)
+	api.Post("/admin/locations/:id/freeze", FreezeLocation)
+	api.Delete("/admin/locations/:id/freeze", LiftLocationFreeze)
+	api.Get("/admin/locations/overrides", GetLocationOverrides)
+	api.Put("/admin/locations/:id/override", SetLocationOverride)
+	api.Delete("/admin/locations/:id/override", DeleteLocationOverride)
+	api.Put("/admin/gates/:gateId/open", EmergencyOpenGate)
+	api.Get("/admin/gates/maintenance", GetGateMaintenances)
+	api.Put("/admin/gates/:gateId/maintenance", SetGateMaintenance)
+	api.Delete("/admin/gates/:gateId/maintenance", EndGateMaintenance)
+	api.Get("/admin/gate-reports", GetGateReports)
+	api.Get("/admin/gate-reports/:id/photo", GetGateReportPhoto)
+	api.Post("/admin/gate-reports/:id/resolve", ResolveGateReport)
+
+	// Legal documents
+	api.Get("/legal", GetLegalDocuments)
+	api.Get("/legal/:kind", GetLegalDocument)
+	api.Post("/admin/legal", PublishLegalDocument)
+
+	api.Get("/files/*", ServeSignedFile)
+
+	api.Get("/admin/search", GetAdminSearch)
 
 	// Available locations route (Admin JWT protected)
-	api.Get("/available-locations", middleware.AdminJWTProtected(), GetAvailableLocations)
+	api.Get("/available-locations", GetAvailableLocations)
 
 	// Contact information routes
 	api.Get("/contacts", GetContact)
-	api.Patch("/contacts", middleware.AdminJWTProtected(), UpdateContact)
+	api.Patch("/contacts", UpdateContact)
 
 	// Admin audit log routes (Admin JWT protected, super admin only)
-	adminAudit := api.Group("/admin/audit-logs", middleware.AdminJWTProtected(), middleware.SuperAdminOnly())
+	adminAudit := api.Group("/admin/audit-logs")
 	adminAudit.Get("/", GetAdminAuditLogs)
 	adminAudit.Get("/:id", GetAdminAuditLogByID)
+	adminAudit.Post("/:id/comments", CreateAuditLogComment)
+	api.Get("/admin/security/denials", GetSecurityDenials)
 
 	cleanup := func() {
 		db.DB.Exec("DELETE FROM users")
 		db.DB.Exec("DELETE FROM admins")
 		db.DB.Exec("DELETE FROM contacts")
 		db.DB.Exec("DELETE FROM admin_audit_logs")
+		db.DB.Exec("DELETE FROM gate_commands")
+		db.DB.Exec("DELETE FROM job_runs")
+		db.DB.Exec("DELETE FROM job_locks")
+		db.DB.Exec("DELETE FROM admin_notifications")
+		db.DB.Exec("DELETE FROM audit_comments")
+		db.DB.Exec("DELETE FROM user_sessions")
+		db.DB.Exec("DELETE FROM usage_counters")
+		db.DB.Exec("DELETE FROM usage_active_users")
+		db.DB.Exec("DELETE FROM cors_origins")
+		db.DB.Exec("DELETE FROM user_phones")
 	}
 
 	return app, cleanup
 }
+
+// fakeSMSSender records the messages sent per phone
+type fakeSMSSender struct {
+	mu       sync.Mutex
+	messages map[string][]string
+}
+
+func (s *fakeSMSSender) Send(_ context.Context, phone, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages[phone] = append(s.messages[phone], message)
+	return nil
+}
+
+// setupOTPTestApp creates the test app with 6-digit SMS codes, the OTP flow switched on by
+// enable, and a fake SMS sender capturing the texts sent
+func setupOTPTestApp(enable func(*config.OTPConfig)) (*fiber.App, *fakeSMSSender, func()) {
+	app, cleanup := SetupTestApp()
+	config.AppConfig().OTP = config.OTPConfig{
+		Length:         6,
+		TTL:            5 * time.Minute,
+		MaxAttempts:    3,
+		ResendInterval: time.Minute,
+		PhoneHourly:    5,
+		IPHourly:       20,
+	}
+	enable(&config.AppConfig().OTP)
+
+	sms := &fakeSMSSender{messages: map[string][]string{}}
+	services.SetSMSSender(sms)
+	return app, sms, func() {
+		services.SetSMSSender(nil)
+		cleanup()
+	}
+}
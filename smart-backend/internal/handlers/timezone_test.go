@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// timezoneRequest sends a request as admin with an optional X-Timezone header
+func timezoneRequest(t *testing.T, app *fiber.App, admin models.Admin, method, path, timezone string, body interface{}) (int, string, map[string]interface{}) {
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	if timezone != "" {
+		req.Header.Set(middleware.TimezoneHeader, timezone)
+	}
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, resp.Header.Get(middleware.TimezoneHeader), result
+}
+
+func TestLocalizeTimestamps_HeaderAndAdminPreference(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "tz-admin", Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	path := "/api/v1/admin/users/" + admin.ID.String()
+
+	// Timestamps stay in UTC by default
+	status, zone, result := timezoneRequest(t, app, admin, "GET", path, "", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "UTC", zone)
+	assert.True(t, strings.HasSuffix(result["data"].(map[string]interface{})["created_at"].(string), "Z"))
+
+	// X-Timezone rewrites them to the same instant with the zone's offset
+	status, zone, result = timezoneRequest(t, app, admin, "GET", path, "Asia/Bishkek", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Asia/Bishkek", zone)
+	createdAt := result["data"].(map[string]interface{})["created_at"].(string)
+	assert.True(t, strings.HasSuffix(createdAt, "+06:00"))
+	parsed, err := time.Parse(time.RFC3339Nano, createdAt)
+	assert.NoError(t, err)
+	assert.True(t, parsed.Equal(admin.CreatedAt))
+
+	status, _, _ = timezoneRequest(t, app, admin, "GET", path, "Mars/Olympus", nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	// An admin's own preference applies to requests without the header
+	status, _, _ = timezoneRequest(t, app, admin, "PATCH", path, "", map[string]string{"timezone": "Nowhere"})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _, result = timezoneRequest(t, app, admin, "PATCH", path, "", map[string]string{"timezone": "Asia/Bishkek"})
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Asia/Bishkek", result["data"].(map[string]interface{})["timezone"])
+
+	status, zone, result = timezoneRequest(t, app, admin, "GET", path, "", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Asia/Bishkek", zone)
+	assert.True(t, strings.HasSuffix(result["data"].(map[string]interface{})["updated_at"].(string), "+06:00"))
+
+	// The header still wins over the preference
+	_, zone, _ = timezoneRequest(t, app, admin, "GET", path, "UTC", nil)
+	assert.Equal(t, "UTC", zone)
+}
+
+func TestGetAdminReport_DaysInRequestTimezone(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "tz-reports", Password: "password123", Role: models.RoleSuper}
+	db.DB.Create(&admin)
+	// 20:00 UTC on October 5 is 02:00 on October 6 in Bishkek (UTC+6)
+	db.DB.Create(&models.User{Phone: "+77770000001", Password: "password123", CreatedAt: time.Date(2026, 10, 5, 20, 0, 0, 0, time.UTC)})
+
+	path := "/api/v1/admin/reports?report=user_churn&from=2026-10-05&to=2026-10-06"
+	status, _, result := timezoneRequest(t, app, admin, "GET", path, "", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, "UTC", data["timezone"])
+	assert.Equal(t, float64(1), data["rows"].([]interface{})[0].([]interface{})[1])
+
+	status, _, result = timezoneRequest(t, app, admin, "GET", path, "Asia/Bishkek", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	data = result["data"].(map[string]interface{})
+	assert.Equal(t, "Asia/Bishkek", data["timezone"])
+	rows := data["rows"].([]interface{})
+	assert.Equal(t, []interface{}{"2026-10-05", float64(0), float64(0), float64(0)}, rows[0])
+	assert.Equal(t, []interface{}{"2026-10-06", float64(1), float64(0), float64(1)}, rows[1])
+
+	// Active users are metered per UTC day
+	_, _, result = timezoneRequest(t, app, admin, "GET", "/api/v1/admin/reports?report=daily_active_users", "Asia/Bishkek", nil)
+	assert.Equal(t, "UTC", result["data"].(map[string]interface{})["timezone"])
+}
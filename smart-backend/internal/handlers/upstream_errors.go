@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"errors"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CircuitOpenCode is the error code of provider calls refused without being sent because the
+// circuit breaker is open
+const CircuitOpenCode = "circuit_open"
+
+// defaultRetryAfter is the first retry delay for degraded responses without a known recovery time
+const defaultRetryAfter = 2 * time.Second
+
+// respondUpstreamError writes an error response for a failed third-party call.
+// Classified upstream failures get a matching status code and structured details,
+// anything else falls back to 500 with the given message.
+func respondUpstreamError(c *fiber.Ctx, err error, message string) error {
+	var upstreamErr *services.UpstreamError
+	if !errors.As(err, &upstreamErr) {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: message,
+		})
+	}
+
+	response := APIResponse{
+		Success: false,
+		Message: message,
+		Data:    toUpstreamErrorDTO(upstreamErr),
+	}
+	if upstreamErr.Kind == services.UpstreamUnavailable {
+		response.RetryStrategy = middleware.Backoff(c, middleware.RetryStrategyExponential, providerRetryAfter())
+	}
+	return c.Status(upstreamStatusCode(upstreamErr.Kind)).JSON(response)
+}
+
+// providerRetryAfter is when clients should retry a call that found the gate provider
+// unavailable: once the circuit breaker lets calls through again, or soon if it is closed
+func providerRetryAfter() time.Duration {
+	if wait := services.ProviderBreaker().RetryAfter(); wait > 0 {
+		return wait
+	}
+	return defaultRetryAfter
+}
+
+// upstreamStatusCode maps an upstream error kind to our response status code
+func upstreamStatusCode(kind services.UpstreamErrorKind) int {
+	switch kind {
+	case services.UpstreamUnavailable:
+		return fiber.StatusServiceUnavailable
+	case services.UpstreamNotFound:
+		return fiber.StatusNotFound
+	default:
+		return fiber.StatusBadGateway
+	}
+}
+
+// toUpstreamErrorDTO maps an UpstreamError to its response DTO
+func toUpstreamErrorDTO(err *services.UpstreamError) UpstreamErrorDTO {
+	dto := UpstreamErrorDTO{
+		Kind:       string(err.Kind),
+		Operation:  err.Operation,
+		StatusCode: err.StatusCode,
+		Detail:     err.Detail,
+	}
+	if errors.Is(err, services.ErrCircuitOpen) {
+		dto.Code = CircuitOpenCode
+	}
+	return dto
+}
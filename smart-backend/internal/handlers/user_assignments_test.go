@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyAssignmentProvider fails every call with 503 while down, and otherwise serves like
+// fakeAssignmentProvider
+type flakyAssignmentProvider struct {
+	fakeAssignmentProvider
+	down atomic.Bool
+}
+
+func (p *flakyAssignmentProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if p.down.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	p.fakeAssignmentProvider.ServeHTTP(w, r)
+}
+
+func setupAssignmentRetryTest(t *testing.T) (*fiber.App, *flakyAssignmentProvider) {
+	app, cleanup := SetupTestApp()
+	t.Cleanup(cleanup)
+
+	provider := &flakyAssignmentProvider{fakeAssignmentProvider: fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{}}}
+	server := httptest.NewServer(provider)
+	t.Cleanup(server.Close)
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+	config.AppConfig().Assignment = config.AssignmentConfig{RetryBackoff: time.Minute, RetryMaxBackoff: time.Hour, RetryMaxAttempts: 3}
+	return app, provider
+}
+
+func TestRetryUserAssignment_ReplaysQueuedAssignment(t *testing.T) {
+	app, provider := setupAssignmentRetryTest(t)
+	provider.down.Store(true)
+
+	// A failed assignment on create is queued instead of forgotten
+	body := map[string]interface{}{
+		"phone":     "+77771230001",
+		"password":  "password123",
+		"locations": []map[string]interface{}{{"locationId": 7, "gateIds": []int{70, 71}}},
+	}
+	status, result := mergeRequest(t, app, models.RoleRegular, "POST", "/api/v1/users", body)
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Contains(t, result["message"], "retried automatically")
+	data := result["data"].(map[string]interface{})
+	assert.NotEmpty(t, data["pending_assignment_id"])
+	userID := data["id"].(string)
+	path := "/api/v1/users/" + userID + "/retry-assignment"
+
+	var pending models.PendingAssignment
+	assert.NoError(t, db.DB.Where("user_id = ?", userID).First(&pending).Error)
+	assert.Equal(t, models.AssignmentPending, pending.Status)
+	assert.Equal(t, 1, pending.Attempts)
+	assert.True(t, pending.NextAttemptAt.After(time.Now()))
+
+	// A retry while the provider is still down schedules the next attempt
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", path, nil)
+	assert.NotEqual(t, fiber.StatusOK, status)
+	assert.NoError(t, db.DB.Where("user_id = ?", userID).First(&pending).Error)
+	assert.Equal(t, 2, pending.Attempts)
+	assert.NotEmpty(t, pending.LastError)
+
+	provider.down.Store(false)
+	status, result = mergeRequest(t, app, models.RoleRegular, "POST", path, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, float64(3), result["data"].(map[string]interface{})["attempts"])
+	assert.Equal(t, []services.LocationAssignmentDTO{{LocationID: 7, GateIds: []int{70, 71}}}, provider.assignments["+77771230001"])
+
+	var history int64
+	db.DB.Model(&models.UserHistory{}).Where("user_id = ? AND action = ?", userID, models.UserHistoryAssigned).Count(&history)
+	assert.Equal(t, int64(1), history)
+
+	// Nothing is left to retry
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", path, nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+}
+
+func TestRunPendingAssignments_GivesUpAfterMaxAttempts(t *testing.T) {
+	app, provider := setupAssignmentRetryTest(t)
+	provider.down.Store(true)
+
+	user := models.User{Phone: "+77771230002", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+	path := "/api/v1/users/" + user.ID.String()
+	body := map[string]interface{}{"locations": []map[string]interface{}{{"locationId": 8, "gateIds": []int{80}}}}
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "PATCH", path, body)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.NotEmpty(t, result["warning"])
+
+	// Assignments that are not due yet are left alone
+	assert.NoError(t, services.RunPendingAssignments(context.Background()))
+	var pending models.PendingAssignment
+	assert.NoError(t, db.DB.Where("user_id = ?", user.ID).First(&pending).Error)
+	assert.Equal(t, 1, pending.Attempts)
+
+	for attempt := 2; attempt <= 3; attempt++ {
+		db.DB.Model(&models.PendingAssignment{}).Where("id = ?", pending.ID).Update("next_attempt_at", time.Now().Add(-time.Second))
+		assert.NoError(t, services.RunPendingAssignments(context.Background()))
+	}
+	assert.NoError(t, db.DB.Where("user_id = ?", user.ID).First(&pending).Error)
+	assert.Equal(t, models.AssignmentFailed, pending.Status)
+	assert.Equal(t, 3, pending.Attempts)
+
+	var notifications int64
+	db.DB.Model(&models.AdminNotification{}).Where("category = ?", models.NotificationProvider).Count(&notifications)
+	assert.Equal(t, int64(1), notifications)
+
+	// Given up assignments are no longer retried by the job
+	db.DB.Model(&models.PendingAssignment{}).Where("id = ?", pending.ID).Update("next_attempt_at", time.Now().Add(-time.Second))
+	assert.NoError(t, services.RunPendingAssignments(context.Background()))
+	assert.NoError(t, db.DB.Where("user_id = ?", user.ID).First(&pending).Error)
+	assert.Equal(t, 3, pending.Attempts)
+
+	// A newer assignment that reaches the provider replaces it
+	provider.down.Store(false)
+	status, result = mergeRequest(t, app, models.RoleRegular, "PATCH", path, body)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["warning"])
+	var count int64
+	db.DB.Model(&models.PendingAssignment{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+}
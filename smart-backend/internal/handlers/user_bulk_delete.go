@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const (
+	// bulkDeleteConfirmationTTL is how long a bulk delete summary can be confirmed
+	bulkDeleteConfirmationTTL = 5 * time.Minute
+	// maxBulkDeleteUsers caps the number of users one bulk delete can target
+	maxBulkDeleteUsers = 500
+	// bulkDeleteAction binds confirmation tokens to bulk user deletion
+	bulkDeleteAction = "bulk_delete_users"
+)
+
+// BulkDeleteUsersRequest defines the structure for bulk user deletion. Send it without
+// confirmation_token to get a summary, then again with the returned token to execute it.
+// @name BulkDeleteUsersRequest
+type BulkDeleteUsersRequest struct {
+	UserIDs           []uuid.UUID `json:"user_ids" validate:"required"`
+	ConfirmationToken string      `json:"confirmation_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."` // Token from the summary response; omit to get a summary
+}
+
+// BulkDeleteUsers godoc
+// @Summary Bulk delete users
+// @Description Move several users to the trash in two steps. Without confirmation_token, nothing is deleted: the response summarizes the users that would be deleted and returns a confirmation token valid for 5 minutes. Sending the same user_ids with the token executes the deletion. The token only confirms the same admin and the same set of users (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BulkDeleteUsersRequest true "Users to delete and, to execute, the confirmation token"
+// @Success 200 {object} BulkDeleteSummaryResponse "Summary and confirmation token (no confirmation_token sent)"
+// @Success 202 {object} BulkDeleteResultResponse "Users moved to the trash (confirmation_token sent)"
+// @Failure 400 {object} APIResponse "Invalid request body, too many users, or invalid/expired confirmation token"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/bulk-delete [post]
+func BulkDeleteUsers(c *fiber.Ctx) error {
+	var req BulkDeleteUsersRequest
+	if err := c.BodyParser(&req); err != nil || len(req.UserIDs) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body. Provide the users to delete in user_ids",
+		})
+	}
+
+	// Deduplicate while keeping the requested order
+	seen := make(map[uuid.UUID]bool)
+	ids := make([]uuid.UUID, 0, len(req.UserIDs))
+	targets := make([]string, 0, len(req.UserIDs))
+	for _, id := range req.UserIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+			targets = append(targets, id.String())
+		}
+	}
+	if len(ids) > maxBulkDeleteUsers {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: fmt.Sprintf("At most %d users can be deleted at once", maxBulkDeleteUsers),
+		})
+	}
+
+	var users []models.User
+	if err := db.DB.WithContext(c.UserContext()).Where("id IN ? AND trashed_at IS NULL", ids).Find(&users).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to load users",
+		})
+	}
+	found := make(map[uuid.UUID]bool, len(users))
+	for _, user := range users {
+		found[user.ID] = true
+	}
+	skipped := []uuid.UUID{}
+	for _, id := range ids {
+		if !found[id] {
+			skipped = append(skipped, id)
+		}
+	}
+
+	adminID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		adminID = uuid.Nil
+	}
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	digest := utils.ConfirmationDigest(targets)
+
+	if req.ConfirmationToken == "" {
+		return bulkDeleteSummary(c, adminID, digest, users, skipped)
+	}
+
+	if err := utils.ValidateConfirmationToken(req.ConfirmationToken, adminID, bulkDeleteAction, digest); err != nil {
+		message := "Invalid or expired confirmation token. Request a new summary"
+		if errors.Is(err, utils.ErrConfirmationMismatch) {
+			message = "Confirmation token was issued for a different set of users. Request a new summary"
+		}
+		middleware.RecordAudit(c, bulkDeleteAction, "user", "", "failed", "Confirmation rejected: "+err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: message,
+		})
+	}
+
+	trashed := []uuid.UUID{}
+	for i := range users {
+		if err := services.TrashUser(c.UserContext(), &users[i], adminUsername); err != nil {
+			slog.ErrorContext(c.UserContext(), "[BULK_DELETE] Failed to trash user", "user_id", users[i].ID, "error", err)
+			middleware.RecordAudit(c, "trash_user", "user", users[i].ID.String(), "failed", err.Error())
+			skipped = append(skipped, users[i].ID)
+			continue
+		}
+		middleware.RecordAudit(c, "trash_user", "user", users[i].ID.String(), "success", "")
+		trashed = append(trashed, users[i].ID)
+	}
+	slog.InfoContext(c.UserContext(), "[BULK_DELETE] Users moved to the trash",
+		"admin", adminUsername, "trashed", len(trashed), "skipped", len(skipped))
+	middleware.RecordAudit(c, bulkDeleteAction, "user", "", "success", "")
+
+	return c.Status(fiber.StatusAccepted).JSON(BulkDeleteResultResponse{
+		Success: true,
+		Message: fmt.Sprintf("%d user(s) moved to the trash", len(trashed)),
+		Data: BulkDeleteResultDTO{
+			Trashed: trashed,
+			Skipped: skipped,
+		},
+	})
+}
+
+// bulkDeleteSummary writes the summary of a bulk delete with the token that confirms it
+func bulkDeleteSummary(c *fiber.Ctx, adminID uuid.UUID, digest string, users []models.User, skipped []uuid.UUID) error {
+	token, expiresAt, err := utils.GenerateConfirmationToken(adminID, bulkDeleteAction, digest, bulkDeleteConfirmationTTL)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to generate confirmation token",
+		})
+	}
+
+	summary := make([]UserDTO, len(users))
+	for i, user := range users {
+		summary[i] = UserDTO{
+			ID:        user.ID,
+			Phone:     user.Phone,
+			Email:     user.Email,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		}
+	}
+	middleware.RecordAudit(c, bulkDeleteAction+"_requested", "user", "", "success", "")
+
+	return c.Status(fiber.StatusOK).JSON(BulkDeleteSummaryResponse{
+		Success: true,
+		Message: fmt.Sprintf("%d user(s) will be moved to the trash. Send the request again with confirmation_token to proceed", len(users)),
+		Data: BulkDeleteSummaryDTO{
+			Count:             len(users),
+			Users:             summary,
+			Skipped:           skipped,
+			ConfirmationToken: token,
+			ExpiresAt:         expiresAt,
+		},
+	})
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/utils"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func bulkDeleteRequest(t *testing.T, app *fiber.App, admin models.Admin, body interface{}) (int, map[string]interface{}) {
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest("POST", "/api/v1/users/bulk-delete", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestBulkDeleteUsers_RequiresConfirmation(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "bulk-admin", Password: "password123", Role: models.RoleRegular}
+	assert.NoError(t, db.DB.Create(&admin).Error)
+	first := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&first).Error)
+	second := models.User{Phone: "+77771234568", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&second).Error)
+	missing := uuid.New()
+	ids := []string{first.ID.String(), second.ID.String(), missing.String()}
+
+	// Without a token, nothing is deleted and a summary is returned
+	status, result := bulkDeleteRequest(t, app, admin, map[string]interface{}{"user_ids": ids})
+	assert.Equal(t, fiber.StatusOK, status)
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, float64(2), data["count"])
+	assert.Equal(t, []interface{}{missing.String()}, data["skipped"])
+	token := data["confirmation_token"].(string)
+	assert.NotEmpty(t, token)
+	assert.Equal(t, fiber.StatusOK, loginStatus(t, app, "+77771234567", "password123"))
+
+	// The token only confirms the summarized users and the admin it was issued to
+	status, _ = bulkDeleteRequest(t, app, admin, map[string]interface{}{"user_ids": ids[:1], "confirmation_token": token})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	other := models.Admin{ID: uuid.New(), Username: "other-admin", Password: "password123", Role: models.RoleRegular}
+	assert.NoError(t, db.DB.Create(&other).Error)
+	status, _ = bulkDeleteRequest(t, app, other, map[string]interface{}{"user_ids": ids, "confirmation_token": token})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = bulkDeleteRequest(t, app, admin, map[string]interface{}{"user_ids": ids, "confirmation_token": "not-a-token"})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	// Echoing the token back moves the users to the trash, in any order
+	reordered := []string{missing.String(), second.ID.String(), first.ID.String()}
+	status, result = bulkDeleteRequest(t, app, admin, map[string]interface{}{"user_ids": reordered, "confirmation_token": token})
+	assert.Equal(t, fiber.StatusAccepted, status)
+	data = result["data"].(map[string]interface{})
+	assert.ElementsMatch(t, []interface{}{first.ID.String(), second.ID.String()}, data["trashed"])
+	assert.Equal(t, fiber.StatusUnauthorized, loginStatus(t, app, "+77771234567", "password123"))
+	assert.Equal(t, fiber.StatusUnauthorized, loginStatus(t, app, "+77771234568", "password123"))
+
+	var count int64
+	db.DB.Model(&models.AdminAuditLog{}).Where("action = ?", "trash_user").Count(&count)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestBulkDeleteUsers_RejectsEmptyRequest(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	admin := models.Admin{ID: uuid.New(), Username: "bulk-admin", Password: "password123", Role: models.RoleRegular}
+	assert.NoError(t, db.DB.Create(&admin).Error)
+
+	status, _ := bulkDeleteRequest(t, app, admin, map[string]interface{}{"user_ids": []string{}})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+}
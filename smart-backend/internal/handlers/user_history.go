@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// GetUserHistory godoc
+// @Summary Get a user's change history
+// @Description Retrieve every change to a user, newest first, with the changed fields before and after: phone and email, password changes (flag only), status (approved, pending, rejected, trashed, deleted), secondary numbers and location/gate assignments. History is kept after the user is deleted (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param action query string false "Filter by action (created, registered, updated, assigned, phone_added, phone_removed, trashed, restored, purged, approved, rejected, merged, merged_into)"
+// @Success 200 {object} UserHistoryResponse "User history retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid user ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/{id}/history [get]
+func GetUserHistory(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		})
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	query := db.DB.WithContext(c.UserContext()).Model(&models.UserHistory{}).Where("user_id = ?", userID)
+	if action := c.Query("action"); action != "" {
+		query = query.Where("action = ?", action)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve user history",
+		})
+	}
+
+	// Deleted users keep their history, so only report users that never existed as not found
+	if total == 0 {
+		var users int64
+		db.DB.WithContext(c.UserContext()).Unscoped().Model(&models.User{}).Where("id = ?", userID).Count(&users)
+		if users == 0 {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "User not found",
+			})
+		}
+	}
+
+	var entries []models.UserHistory
+	if err := query.Order("created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&entries).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve user history",
+		})
+	}
+
+	dtos := make([]UserHistoryDTO, len(entries))
+	for i, entry := range entries {
+		dtos[i] = UserHistoryDTO{
+			ID:        entry.ID,
+			Action:    entry.Action,
+			Actor:     entry.Actor,
+			Changes:   userHistoryChanges(entry),
+			CreatedAt: entry.CreatedAt,
+		}
+	}
+
+	lastPage := int((total + int64(limit) - 1) / int64(limit))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return c.Status(fiber.StatusOK).JSON(UserHistoryResponse{
+		Success: true,
+		Message: "User history retrieved successfully",
+		Data:    dtos,
+		Pagination: PaginationMeta{
+			Total:       int(total),
+			PerPage:     limit,
+			CurrentPage: page,
+			LastPage:    lastPage,
+		},
+	})
+}
+
+// userHistoryChanges decodes the changed fields of a history entry. Entries that cannot be
+// decoded are logged and returned without changes.
+func userHistoryChanges(entry models.UserHistory) map[string]FieldChangeDTO {
+	fields, err := entry.FieldChanges()
+	if err != nil {
+		slog.Warn("[USER_HISTORY] Failed to decode history entry", "entry_id", entry.ID, "error", err)
+	}
+	changes := make(map[string]FieldChangeDTO, len(fields))
+	for field, change := range fields {
+		changes[field] = FieldChangeDTO{Before: change.Before, After: change.After}
+	}
+	return changes
+}
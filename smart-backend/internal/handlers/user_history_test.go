@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/services"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserHistory_RecordsChanges(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	status, result := userPhonesRequest(t, app, "POST", "/api/v1/users", map[string]interface{}{
+		"phone":     "+77771234567",
+		"password":  "password123",
+		"locations": []map[string]interface{}{{"locationId": 1, "gateIds": []int{10}}},
+	})
+	assert.Equal(t, fiber.StatusCreated, status)
+	userID := result["data"].(map[string]interface{})["id"].(string)
+
+	status, _ = userPhonesRequest(t, app, "PATCH", "/api/v1/users/"+userID, map[string]interface{}{
+		"phone":     "+77771234568",
+		"password":  "newpassword123",
+		"locations": []map[string]interface{}{{"locationId": 2, "gateIds": []int{20, 21}}},
+	})
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = userPhonesRequest(t, app, "DELETE", "/api/v1/users/"+userID, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+
+	status, result = userPhonesRequest(t, app, "GET", "/api/v1/users/"+userID+"/history", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	entries := result["data"].([]interface{})
+	if !assert.Len(t, entries, 4) {
+		return
+	}
+	actions := make(map[string]map[string]interface{})
+	for _, entry := range entries {
+		entry := entry.(map[string]interface{})
+		actions[entry["action"].(string)] = entry["changes"].(map[string]interface{})
+		assert.Contains(t, entry["actor"], "phones-admin-")
+	}
+
+	created := actions["created"]
+	assert.Equal(t, map[string]interface{}{"before": nil, "after": "+77771234567"}, created["phone"])
+	assert.NotNil(t, created["assignments"])
+
+	updated := actions["updated"]
+	assert.Equal(t, map[string]interface{}{"before": "+77771234567", "after": "+77771234568"}, updated["phone"])
+	assert.Equal(t, map[string]interface{}{"before": false, "after": true}, updated["password_changed"])
+
+	// The previous assignment is read from the provider before it is replaced
+	assigned := actions["assigned"]["assignments"].(map[string]interface{})
+	assert.Len(t, assigned["before"], 1)
+	assert.Equal(t, float64(1), assigned["before"].([]interface{})[0].(map[string]interface{})["locationId"])
+	assert.Equal(t, float64(2), assigned["after"].([]interface{})[0].(map[string]interface{})["locationId"])
+
+	assert.Equal(t, map[string]interface{}{"before": "approved", "after": "trashed"}, actions["trashed"]["status"])
+
+	status, result = userPhonesRequest(t, app, "GET", "/api/v1/users/"+userID+"/history?action=trashed", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Len(t, result["data"], 1)
+
+	status, _ = userPhonesRequest(t, app, "GET", "/api/v1/users/"+uuid.NewString()+"/history", nil)
+	assert.Equal(t, fiber.StatusNotFound, status)
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// MergeUsersRequest defines the structure for merging duplicate users into one account
+// @name MergeUsersRequest
+type MergeUsersRequest struct {
+	SourceIDs []uuid.UUID `json:"source_ids" validate:"required"` // Users to merge into the target and delete
+}
+
+// GetDuplicateUsers godoc
+// @Summary Detect likely duplicate users
+// @Description List groups of users sharing a phone number once normalized, e.g. "8 777 123 45 67" and "+77771234567" from different imports. Primary and verified secondary numbers are compared (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} DuplicateUsersResponse "Duplicate users retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/duplicates [get]
+func GetDuplicateUsers(c *fiber.Ctx) error {
+	groups, err := services.FindDuplicateUsers()
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[USER_MERGE] Failed to detect duplicate users", "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to detect duplicate users",
+		})
+	}
+
+	data := make([]DuplicateUserGroupDTO, len(groups))
+	for i, group := range groups {
+		users := make([]UserDTO, len(group.Users))
+		for j, user := range group.Users {
+			users[j] = UserDTO{
+				ID:        user.ID,
+				Phone:     user.Phone,
+				Email:     user.Email,
+				CreatedAt: user.CreatedAt,
+				UpdatedAt: user.UpdatedAt,
+			}
+		}
+		data[i] = DuplicateUserGroupDTO{Phone: group.Phone, Users: users}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(DuplicateUsersResponse{
+		Success: true,
+		Message: "Duplicate users retrieved successfully",
+		Data:    data,
+	})
+}
+
+// MergeUsers godoc
+// @Summary Merge duplicate users into one account
+// @Description Merge the source users into the user in the path. Their numbers become verified secondary numbers (numbers the target already has are dropped), their device sessions and gate command history move to the target, and the target takes over a verified email if it has none. The source users are deleted. Every number of the target is then given the union of the locations and gates the accounts had at the provider (requires super admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Target user ID (UUID)"
+// @Param request body MergeUsersRequest true "Users to merge into the target"
+// @Success 200 {object} MergeUsersResponse "Users merged (warning set if consolidating gate access failed)"
+// @Failure 400 {object} APIResponse "Invalid user ID or request body"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 403 {object} APIResponse "Forbidden - super admin access required"
+// @Failure 404 {object} APIResponse "Target or source user not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/{id}/merge [post]
+func MergeUsers(c *fiber.Ctx) error {
+	target, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	var req MergeUsersRequest
+	if err := c.BodyParser(&req); err != nil || len(req.SourceIDs) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body. Provide the users to merge in source_ids",
+		})
+	}
+
+	seen := map[uuid.UUID]bool{target.ID: true}
+	sources := make([]models.User, 0, len(req.SourceIDs))
+	for _, sourceID := range req.SourceIDs {
+		if seen[sourceID] {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "source_ids must be distinct and must not contain the target user",
+			})
+		}
+		seen[sourceID] = true
+
+		var source models.User
+		if err := db.DB.WithContext(c.UserContext()).First(&source, "id = ?", sourceID).Error; err != nil {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "User not found: " + sourceID.String(),
+			})
+		}
+		sources = append(sources, source)
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	result, err := services.MergeUsers(target, sources, adminUsername)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[USER_MERGE] Failed to merge users", "source_ids", req.SourceIDs, "user_id", target.ID, "error", err)
+		middleware.RecordAudit(c, "merge_users", "user", target.ID.String(), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to merge users",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "[USER_MERGE] Users merged",
+		"admin", adminUsername, "merged", len(sources), "user_id", target.ID, "phones_added", len(result.PhonesAdded), "sessions_moved", result.SessionsMoved, "gate_commands_moved", result.GateCommandsMoved)
+
+	mergedIDs := make([]string, len(req.SourceIDs))
+	for i, sourceID := range req.SourceIDs {
+		mergedIDs[i] = sourceID.String()
+		middleware.RecordAudit(c, "merged_into_user", "user", sourceID.String(), "success", "")
+	}
+
+	response := MergeUsersResponse{
+		Success: true,
+		Message: "Users merged successfully",
+		Data: MergeUsersResultDTO{
+			UserID:            target.ID,
+			MergedUserIDs:     req.SourceIDs,
+			PhonesAdded:       result.PhonesAdded,
+			SessionsMoved:     result.SessionsMoved,
+			GateCommandsMoved: result.GateCommandsMoved,
+			EmailMoved:        result.EmailMoved,
+		},
+	}
+	if response.Data.PhonesAdded == nil {
+		response.Data.PhonesAdded = []string{}
+	}
+
+	// Reload the target so its new numbers get the consolidated access
+	err = db.DB.WithContext(c.UserContext()).First(&target, "id = ?", target.ID).Error
+	if err == nil {
+		err = services.ConsolidateAssignments(services.NewThirdPartyClient().WithContext(c.UserContext()), target, result.SourcePhones)
+	}
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "Failed to consolidate locations/gates of merged user", "user_id", target.ID, "error", err)
+		middleware.RecordAudit(c, "merge_users", "user", target.ID.String(), "failed",
+			"Merged "+strings.Join(mergedIDs, ", ")+" but failed to consolidate locations/gates: "+err.Error())
+		response.Message = "Users merged but location assignment failed. Please check the user's locations and gates."
+		response.Warning = "Third-party API assignment error: " + err.Error()
+		return c.Status(fiber.StatusOK).JSON(response)
+	}
+
+	middleware.RecordAudit(c, "merge_users", "user", target.ID.String(), "success", "")
+	return c.Status(fiber.StatusOK).JSON(response)
+}
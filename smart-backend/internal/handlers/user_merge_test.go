@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func mergeRequest(t *testing.T, app *fiber.App, role, method, path string, body interface{}) (int, map[string]interface{}) {
+	admin := models.Admin{ID: uuid.New(), Username: "merge-admin-" + uuid.NewString()[:8], Password: "password123", Role: role}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestMergeUsers_ConsolidatesDuplicate(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{
+		"+77771234567": {{LocationID: 1, GateIds: []int{10}}},
+		"87771234567":  {{LocationID: 2, GateIds: []int{20}}},
+	}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	// The duplicate was imported with the number in national format and has a secondary number
+	target := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&target).Error)
+	source := models.User{Phone: "87771234567", Password: "password123", Email: "staff@example.com"}
+	now := time.Now()
+	source.EmailVerifiedAt = &now
+	assert.NoError(t, db.DB.Create(&source).Error)
+	assert.NoError(t, db.DB.Create(&models.UserPhone{UserID: source.ID, Phone: "+77775550000", VerifiedAt: &now}).Error)
+	assert.NoError(t, db.DB.Create(&models.UserSession{UserID: source.ID, DeviceID: "old-phone", ExpiresAt: now.Add(time.Hour)}).Error)
+	assert.NoError(t, db.DB.Create(&models.GateCommand{UserID: source.ID, Phone: source.Phone, GateID: 20, Action: "open", Status: models.GateCommandConfirmed}).Error)
+
+	status, result := mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/users/duplicates", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	groups := result["data"].([]interface{})
+	assert.Len(t, groups, 1)
+	group := groups[0].(map[string]interface{})
+	assert.Equal(t, "+77771234567", group["phone"])
+	assert.Equal(t, target.ID.String(), group["users"].([]interface{})[0].(map[string]interface{})["id"])
+
+	// Merging is limited to super admins
+	path := "/api/v1/users/" + target.ID.String() + "/merge"
+	body := map[string]interface{}{"source_ids": []string{source.ID.String()}}
+	status, _ = mergeRequest(t, app, models.RoleRegular, "POST", path, body)
+	assert.Equal(t, fiber.StatusForbidden, status)
+
+	status, result = mergeRequest(t, app, models.RoleSuper, "POST", path, body)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["warning"])
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"+77775550000"}, data["phones_added"])
+	assert.Equal(t, float64(1), data["sessions_moved"])
+	assert.Equal(t, float64(1), data["gate_commands_moved"])
+	assert.Equal(t, true, data["email_moved"])
+
+	// The source is gone and its history, devices and email belong to the target
+	assert.Error(t, db.DB.First(&models.User{}, "id = ?", source.ID).Error)
+	var count int64
+	db.DB.Model(&models.GateCommand{}).Where("user_id = ?", target.ID).Count(&count)
+	assert.Equal(t, int64(1), count)
+	var session models.UserSession
+	assert.NoError(t, db.DB.Where("user_id = ?", target.ID).First(&session).Error)
+	assert.NotNil(t, session.RevokedAt)
+	merged, err := services.FindUserByEmail("staff@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, target.ID, merged.ID)
+	assert.Equal(t, fiber.StatusOK, loginStatus(t, app, "+77775550000", "password123"))
+
+	// Every number of the target has the union of the accounts' access; the old format is revoked
+	expected := []services.LocationAssignmentDTO{{LocationID: 1, GateIds: []int{10}}, {LocationID: 2, GateIds: []int{20}}}
+	assert.Equal(t, expected, provider.assignments["+77771234567"])
+	assert.Equal(t, expected, provider.assignments["+77775550000"])
+	assert.Empty(t, provider.assignments["87771234567"])
+
+	// Both users are audited
+	db.DB.Model(&models.AdminAuditLog{}).Where("action = ? AND resource_id = ?", "merge_users", target.ID.String()).Count(&count)
+	assert.Equal(t, int64(1), count)
+	db.DB.Model(&models.AdminAuditLog{}).Where("action = ? AND resource_id = ?", "merged_into_user", source.ID.String()).Count(&count)
+	assert.Equal(t, int64(1), count)
+
+	status, result = mergeRequest(t, app, models.RoleRegular, "GET", "/api/v1/users/duplicates", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["data"])
+}
+
+func TestMergeUsers_RejectsTargetAsSource(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	target := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&target).Error)
+
+	path := "/api/v1/users/" + target.ID.String() + "/merge"
+	status, _ := mergeRequest(t, app, models.RoleSuper, "POST", path, map[string]interface{}{"source_ids": []string{target.ID.String()}})
+	assert.Equal(t, fiber.StatusBadRequest, status)
+
+	status, _ = mergeRequest(t, app, models.RoleSuper, "POST", path, map[string]interface{}{"source_ids": []string{uuid.NewString()}})
+	assert.Equal(t, fiber.StatusNotFound, status)
+}
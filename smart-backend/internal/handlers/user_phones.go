@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/middleware"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AddUserPhoneRequest defines the structure for adding a secondary phone number to a user
+// @name AddUserPhoneRequest
+type AddUserPhoneRequest struct {
+	Phone string `json:"phone" validate:"required" example:"+77771234568"`
+}
+
+// GetUserPhones godoc
+// @Summary List a user's phone numbers
+// @Description Retrieve the primary and secondary phone numbers of a user (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {object} UserPhonesResponse "Phone numbers retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid user ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/{id}/phones [get]
+func GetUserPhones(c *fiber.Ctx) error {
+	user, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	phones, err := loadUserPhones(c.UserContext(), user.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to retrieve phone numbers",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(UserPhonesResponse{
+		Success: true,
+		Message: "Phone numbers retrieved successfully",
+		Data:    phones,
+	})
+}
+
+// AddUserPhone godoc
+// @Summary Add a secondary phone number
+// @Description Add a secondary phone number to a user, e.g. for a family member sharing the account. Numbers added by an admin are verified and can log in immediately. The number gets the same locations and gates as the primary number (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Param request body AddUserPhoneRequest true "Phone number"
+// @Success 201 {object} UserPhoneResponse "Phone number added (warning set if copying gate access failed)"
+// @Failure 400 {object} APIResponse "Invalid user ID or phone number format, or number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 409 {object} APIResponse "Phone number is already in use"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/{id}/phones [post]
+func AddUserPhone(c *fiber.Ctx) error {
+	user, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	var req AddUserPhoneRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid request body",
+		})
+	}
+
+	phone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number format. Use international format (e.g., +77771234567)",
+		})
+	}
+	if err := phonenumber.CheckAllowed(phone); err != nil {
+		return respondPhoneCountryNotAllowed(c)
+	}
+	if services.PhoneInUse(phone) {
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "Phone number is already in use",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	now := time.Now()
+	userPhone := models.UserPhone{UserID: user.ID, Phone: phone, VerifiedAt: &now, AddedBy: adminUsername}
+	if err := db.DB.WithContext(c.UserContext()).Create(&userPhone).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to add phone number",
+		})
+	}
+	slog.InfoContext(c.UserContext(), "Phone number added to user", "phone", phone, "user_id", user.ID, "admin", adminUsername)
+	services.RecordUserHistory(user.ID, models.UserHistoryPhoneAdded, adminUsername,
+		services.FieldChanges{}.Set("secondary_phone", nil, phone))
+
+	response := UserPhoneResponse{
+		Success: true,
+		Message: "Phone number added successfully",
+		Data:    toUserPhoneDTO(userPhone),
+	}
+
+	// Give the new number the access the primary number has
+	client := services.NewThirdPartyClient().WithContext(c.UserContext())
+	assignment, err := services.CurrentAssignment(client, user.Phone)
+	if err == nil && len(assignment) > 0 {
+		err = client.AssignUserToLocationsAndGates(services.UserLocationGateAssignmentDTO{Phone: phone, Locations: assignment})
+	}
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "Failed to copy locations/gates to new number", "phone", phone, "user_id", user.ID, "error", err)
+		middleware.RecordAudit(c, "add_user_phone", "user", user.ID.String(), "failed", "Failed to assign locations/gates: "+err.Error())
+		response.Message = "Phone number added but location assignment failed. Please try to assign locations and gates again."
+		response.Warning = "Third-party API assignment error: " + err.Error()
+		return c.Status(fiber.StatusCreated).JSON(response)
+	}
+
+	middleware.RecordAudit(c, "add_user_phone", "user", user.ID.String(), "success", "")
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+// DeleteUserPhone godoc
+// @Summary Remove a secondary phone number
+// @Description Remove a secondary phone number from a user and revoke its gate access at the provider. The primary number is changed with PATCH /api/v1/users/{id} instead (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Param phoneId path string true "Phone number ID (UUID)"
+// @Success 200 {object} APIResponse "Phone number removed successfully"
+// @Failure 400 {object} APIResponse "Invalid ID format, or the number is the primary number"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User or phone number not found"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/{id}/phones/{phoneId} [delete]
+func DeleteUserPhone(c *fiber.Ctx) error {
+	user, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	phoneID, err := uuid.Parse(c.Params("phoneId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid phone number ID format",
+		})
+	}
+
+	var userPhone models.UserPhone
+	if err := db.DB.WithContext(c.UserContext()).Where("id = ? AND user_id = ?", phoneID, user.ID).First(&userPhone).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "Phone number not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to remove phone number",
+		})
+	}
+	if userPhone.IsPrimary {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "The primary phone number cannot be removed. Change it with PATCH /api/v1/users/{id}",
+		})
+	}
+
+	if err := db.DB.WithContext(c.UserContext()).Delete(&userPhone).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
+			Success: false,
+			Message: "Failed to remove phone number",
+		})
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	services.RecordUserHistory(user.ID, models.UserHistoryPhoneRemoved, adminUsername,
+		services.FieldChanges{}.Set("secondary_phone", userPhone.Phone, nil))
+
+	// An empty assignment revokes the number's access to every location and gate
+	client := services.NewThirdPartyClient().WithContext(c.UserContext())
+	if err := client.AssignUserToLocationsAndGates(services.UserLocationGateAssignmentDTO{
+		Phone:     userPhone.Phone,
+		Locations: []services.LocationAssignmentDTO{},
+	}); err != nil {
+		slog.WarnContext(c.UserContext(), "Failed to revoke locations/gates of removed number",
+			"phone", userPhone.Phone, "user_id", user.ID, "error", err)
+		middleware.RecordAudit(c, "delete_user_phone", "user", user.ID.String(), "failed", "Failed to revoke locations/gates: "+err.Error())
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{
+			"success": true,
+			"message": "Phone number removed but its gate access could not be revoked. Please revoke it at the provider.",
+			"warning": "Third-party API assignment error: " + err.Error(),
+		})
+	}
+
+	middleware.RecordAudit(c, "delete_user_phone", "user", user.ID.String(), "success", "")
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "Phone number removed successfully",
+	})
+}
+
+// findUserParam loads the user from the :id path parameter. When it returns false, the
+// error response has already been written.
+func findUserParam(c *fiber.Ctx) (models.User, bool, error) {
+	var user models.User
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return user, false, c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid user ID format",
+		})
+	}
+	if err := db.DB.WithContext(c.UserContext()).First(&user, "id = ?", userID).Error; err != nil {
+		return user, false, c.Status(fiber.StatusNotFound).JSON(APIResponse{
+			Success: false,
+			Message: "User not found",
+		})
+	}
+	return user, true, nil
+}
+
+// loadUserPhones returns the phone numbers of a user, primary first
+func loadUserPhones(ctx context.Context, userID uuid.UUID) ([]UserPhoneDTO, error) {
+	var phones []models.UserPhone
+	if err := db.DB.WithContext(ctx).Where("user_id = ?", userID).Order("is_primary DESC, created_at").Find(&phones).Error; err != nil {
+		return nil, err
+	}
+
+	data := make([]UserPhoneDTO, len(phones))
+	for i, phone := range phones {
+		data[i] = toUserPhoneDTO(phone)
+	}
+	return data, nil
+}
+
+func toUserPhoneDTO(phone models.UserPhone) UserPhoneDTO {
+	return UserPhoneDTO{
+		ID:         phone.ID,
+		Phone:      phone.Phone,
+		IsPrimary:  phone.IsPrimary,
+		VerifiedAt: phone.VerifiedAt,
+		AddedBy:    phone.AddedBy,
+		CreatedAt:  phone.CreatedAt,
+	}
+}
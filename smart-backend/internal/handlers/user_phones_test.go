@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAssignmentProvider serves the provider's location lookup and records assignments per phone
+type fakeAssignmentProvider struct {
+	mu          sync.Mutex
+	assignments map[string][]services.LocationAssignmentDTO
+}
+
+func (p *fakeAssignmentProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/locations":
+		var locations []services.LocationResponse
+		for _, assigned := range p.assignments[r.URL.Query().Get("phone")] {
+			location := services.LocationResponse{ID: assigned.LocationID, Title: "Location", Gates: []services.GateResponse{}}
+			for _, gateID := range assigned.GateIds {
+				location.Gates = append(location.Gates, services.GateResponse{ID: gateID, Title: "Gate", LocationID: assigned.LocationID})
+			}
+			locations = append(locations, location)
+		}
+		if locations == nil {
+			locations = []services.LocationResponse{}
+		}
+		json.NewEncoder(w).Encode(locations)
+	case r.Method == http.MethodPut && r.URL.Path == "/locations/phone":
+		var assignment services.UserLocationGateAssignmentDTO
+		json.NewDecoder(r.Body).Decode(&assignment)
+		p.assignments[assignment.Phone] = assignment.Locations
+		w.Write([]byte("true"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func userPhonesRequest(t *testing.T, app *fiber.App, method, path string, body interface{}) (int, map[string]interface{}) {
+	admin := models.Admin{ID: uuid.New(), Username: "phones-admin-" + uuid.NewString()[:8], Password: "password123", Role: models.RoleRegular}
+	db.DB.Create(&admin)
+	token, _ := utils.GenerateAdminToken(admin.ID, admin.Username, admin.Role, 0)
+
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func loginStatus(t *testing.T, app *fiber.App, phone, password string) int {
+	payload, _ := json.Marshal(map[string]string{"phone": phone, "password": password})
+	req := httptest.NewRequest("POST", "/api/v1/auth/login", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp.StatusCode
+}
+
+func TestUserPhones_SecondaryNumberLifecycle(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{
+		"+77771234567": {{LocationID: 1, GateIds: []int{10, 11}}},
+	}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+	base := "/api/v1/users/" + user.ID.String() + "/phones"
+
+	// Adding a number copies the primary number's gate access
+	status, result := userPhonesRequest(t, app, "POST", base, map[string]string{"phone": "8 777 765 43 21"})
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Empty(t, result["warning"])
+	added := result["data"].(map[string]interface{})
+	assert.Equal(t, "+77777654321", added["phone"])
+	assert.Equal(t, false, added["is_primary"])
+	assert.Equal(t, provider.assignments["+77771234567"], provider.assignments["+77777654321"])
+
+	status, result = userPhonesRequest(t, app, "GET", base, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	phones := result["data"].([]interface{})
+	assert.Len(t, phones, 2)
+	assert.Equal(t, true, phones[0].(map[string]interface{})["is_primary"])
+
+	// Either number logs in to the same account
+	assert.Equal(t, fiber.StatusOK, loginStatus(t, app, "+77771234567", "password123"))
+	assert.Equal(t, fiber.StatusOK, loginStatus(t, app, "+77777654321", "password123"))
+
+	// A number belongs to one user only
+	status, _ = userPhonesRequest(t, app, "POST", base, map[string]string{"phone": "+77777654321"})
+	assert.Equal(t, fiber.StatusConflict, status)
+	status, _ = userPhonesRequest(t, app, "POST", "/api/v1/users", map[string]string{"phone": "+77777654321", "password": "password123"})
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	// Location updates reach every number
+	status, _ = userPhonesRequest(t, app, "PATCH", "/api/v1/users/"+user.ID.String(), map[string]interface{}{
+		"locations": []map[string]interface{}{{"locationId": 2, "gateIds": []int{20}}},
+	})
+	assert.Equal(t, fiber.StatusOK, status)
+	expected := []services.LocationAssignmentDTO{{LocationID: 2, GateIds: []int{20}}}
+	assert.Equal(t, expected, provider.assignments["+77771234567"])
+	assert.Equal(t, expected, provider.assignments["+77777654321"])
+
+	// The primary number cannot be removed; removing a secondary one revokes its access and login
+	status, _ = userPhonesRequest(t, app, "DELETE", base+"/"+phones[0].(map[string]interface{})["id"].(string), nil)
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	status, _ = userPhonesRequest(t, app, "DELETE", base+"/"+added["id"].(string), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, provider.assignments["+77777654321"])
+	assert.Equal(t, fiber.StatusUnauthorized, loginStatus(t, app, "+77777654321", "password123"))
+}
+
+func TestUserPhones_PurgingTrashedUserReleasesNumbers(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+
+	provider := &fakeAssignmentProvider{assignments: map[string][]services.LocationAssignmentDTO{
+		"+77771234567": {{LocationID: 1, GateIds: []int{10}}},
+		"+77777654321": {{LocationID: 1, GateIds: []int{10}}},
+	}}
+	server := httptest.NewServer(provider)
+	defer server.Close()
+	config.AppConfig().ThirdPartyAPIURL = server.URL
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+	now := time.Now()
+	assert.NoError(t, db.DB.Create(&models.UserPhone{UserID: user.ID, Phone: "+77777654321", VerifiedAt: &now}).Error)
+
+	// Deleting moves the user to the trash, keeping their numbers and gate access
+	status, _ := userPhonesRequest(t, app, "DELETE", "/api/v1/users/"+user.ID.String(), nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.True(t, services.PhoneInUse("+77777654321"))
+	assert.NotEmpty(t, provider.assignments["+77777654321"])
+
+	// Users still within the window are not purged
+	purged, err := services.PurgeTrashedUsers(services.NewThirdPartyClient(), now.Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, purged)
+
+	// Once the window expires, access is revoked and the numbers are released
+	purged, err = services.PurgeTrashedUsers(services.NewThirdPartyClient(), time.Now().Add(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Empty(t, provider.assignments["+77771234567"])
+	assert.Empty(t, provider.assignments["+77777654321"])
+
+	var count int64
+	db.DB.Model(&models.UserPhone{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Equal(t, int64(0), count)
+	assert.False(t, services.PhoneInUse("+77777654321"))
+}
@@ -1,12 +1,17 @@
 package handlers
 
 import (
-	"encoding/json"
-	"log"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/middleware"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/pii"
 	"ololo-gate/internal/services"
-	"ololo-gate/internal/utils"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -22,9 +27,12 @@ import (
 // @Security BearerAuth
 // @Param page query int false "Page number (default: 1)"
 // @Param limit query int false "Records per page (default: 500)"
-// @Param search query string false "Search by phone number"
+// @Param search query string false "Search by full phone number, its last 4 digits, or full email"
 // @Param order query string false "Order results by created_at (ASC or DESC, default: DESC)"
+// @Param stream query string false "With limit=-1: json streams the response row by row, ndjson streams one object per line"
+// @Param count query string false "exact (default) or estimate: approximate total from table statistics, for large tables"
 // @Success 200 {object} UsersListResponse "Users retrieved successfully"
+// @Failure 400 {object} APIResponse "Invalid count or stream mode"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
 // @Failure 500 {object} APIResponse "Internal server error"
 // @Router /api/v1/users [get]
@@ -34,6 +42,13 @@ func GetAllUsers(c *fiber.Ctx) error {
 	limit := c.QueryInt("limit", 500)
 	search := c.Query("search", "")
 	order := c.Query("order", "DESC")
+	countMode, err := services.ParseCountMode(c.Query("count"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid count. Use exact or estimate",
+		})
+	}
 
 	// Validate page
 	if page < 1 {
@@ -53,20 +68,39 @@ func GetAllUsers(c *fiber.Ctx) error {
 		order = "DESC"
 	}
 
-	// Build query
-	query := db.DB.Select("id", "phone", "created_at", "updated_at")
+	streamFormat, err := parseStreamFormat(c, limit)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "Invalid stream. Use json or ndjson, with limit=-1",
+		})
+	}
 
-	// Apply search filter
+	// Build query. Users in the trash are listed by GetTrashedUsers instead.
+	query := db.DB.WithContext(c.UserContext()).Select("id", "phone", "email", "created_at", "updated_at").Where("trashed_at IS NULL")
+
+	// Apply search filter. Phones and emails are encrypted, so match the full number, its last digits
+	// or the full email by blind index.
 	if search != "" {
-		query = query.Where("phone LIKE ?", "%"+search+"%")
+		fullPhone := search
+		if normalizedPhone, err := phonenumber.Normalize(search); err == nil {
+			fullPhone = normalizedPhone
+		}
+		query = query.Where("phone_index = ? OR phone_suffix_index = ? OR email_index = ?",
+			pii.BlindIndex(fullPhone), pii.BlindIndex(search), pii.BlindIndex(models.NormalizeEmail(search)))
 	}
 
 	// Apply order
 	query = query.Order("created_at " + order)
 
+	// Unbounded listings can be streamed instead of being held in memory
+	if streamFormat != "" {
+		return streamListing(c, query.Model(&models.User{}), streamFormat, "Users retrieved successfully", toUserDTO)
+	}
+
 	// Get total count before pagination
-	var total int64
-	if err := query.Model(&models.User{}).Count(&total).Error; err != nil {
+	total, estimated, err := services.CountRows(query.Model(&models.User{}), countMode)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to retrieve users",
@@ -91,12 +125,7 @@ func GetAllUsers(c *fiber.Ctx) error {
 	// Map users to UserDTO
 	userDTOs := make([]UserDTO, len(users))
 	for i, user := range users {
-		userDTOs[i] = UserDTO{
-			ID:        user.ID,
-			Phone:     user.Phone,
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
-		}
+		userDTOs[i] = toUserDTO(user)
 	}
 
 	// Calculate pagination metadata
@@ -121,10 +150,22 @@ func GetAllUsers(c *fiber.Ctx) error {
 			PerPage:     perPage,
 			CurrentPage: page,
 			LastPage:    lastPage,
+			Estimated:   estimated,
 		},
 	})
 }
 
+// toUserDTO maps a User model to its list DTO
+func toUserDTO(user models.User) UserDTO {
+	return UserDTO{
+		ID:        user.ID,
+		Phone:     user.Phone,
+		Email:     user.Email,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
+
 // CreateUser godoc
 // @Summary Create a new user with location and gate assignment
 // @Description Create a new user account and assign locations and gates via third-party API (requires admin authentication)
@@ -132,12 +173,14 @@ func GetAllUsers(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param strict query bool false "Roll back the user if location/gate assignment fails (defaults to ASSIGNMENT_STRICT_MODE)"
 // @Param request body CreateUserRequest true "User creation details with locations and gates"
 // @Success 201 {object} UserResponse "User created successfully"
-// @Failure 400 {object} APIResponse "Invalid request body or validation error"
+// @Failure 400 {object} APIResponse "Invalid request body, validation error, or number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
-// @Failure 409 {object} APIResponse "User with this phone number already exists"
+// @Failure 409 {object} APIResponse "User with this phone number or email already exists"
 // @Failure 500 {object} APIResponse "Internal server error or third-party API failure"
+// @Failure 502 {object} APIResponse "Strict mode: third-party assignment failed and the user was rolled back"
 // @Router /api/v1/users [post]
 func CreateUser(c *fiber.Ctx) error {
 	var req CreateUserRequest
@@ -150,13 +193,18 @@ func CreateUser(c *fiber.Ctx) error {
 		})
 	}
 
-	// Validate phone number format
-	if !phoneRegex.MatchString(req.Phone) {
+	// Validate the phone number and convert it to canonical E.164 form
+	normalizedPhone, err := phonenumber.Normalize(req.Phone)
+	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
 			Success: false,
 			Message: "Invalid phone number format. Use international format (e.g., +77771234567)",
 		})
 	}
+	req.Phone = normalizedPhone
+	if err := phonenumber.CheckAllowed(req.Phone); err != nil {
+		return respondPhoneCountryNotAllowed(c)
+	}
 
 	// Validate password length
 	if len(req.Password) < 6 {
@@ -166,17 +214,34 @@ func CreateUser(c *fiber.Ctx) error {
 		})
 	}
 
+	// Validate the optional login email
+	if req.Email != "" {
+		email, err := services.ParseEmail(req.Email)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid email format",
+			})
+		}
+		req.Email = email
+	}
+
 	// Location and gate IDs are optional - user can be created without them
 	// and assigned later
 
-	// Check if user already exists
-	var existingUser models.User
-	if err := db.DB.Where("phone = ?", req.Phone).First(&existingUser).Error; err == nil {
+	// Check if user already exists (as a primary or secondary number)
+	if services.PhoneInUse(req.Phone) {
 		return c.Status(fiber.StatusConflict).JSON(APIResponse{
 			Success: false,
 			Message: "User with this phone number already exists",
 		})
 	}
+	if req.Email != "" && services.EmailInUse(req.Email) {
+		return c.Status(fiber.StatusConflict).JSON(APIResponse{
+			Success: false,
+			Message: "User with this email already exists",
+		})
+	}
 
 	// Create new user (password will be hashed by BeforeCreate hook)
 	user := models.User{
@@ -184,25 +249,28 @@ func CreateUser(c *fiber.Ctx) error {
 		Password:     req.Password,
 		TokenVersion: 0, // Initialize token version
 	}
+	// Emails set by an admin are verified and can log in immediately
+	if req.Email != "" {
+		now := time.Now()
+		user.Email = req.Email
+		user.EmailVerifiedAt = &now
+	}
 
-	if err := db.DB.Create(&user).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).Create(&user).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to create user",
 		})
 	}
 
-	log.Printf("User %s created successfully in database", req.Phone)
+	slog.InfoContext(c.UserContext(), "User created in database", "phone", req.Phone)
+	changes := services.FieldChanges{}.DiffUser(services.UserSnapshot{}, services.SnapshotUser(user))
 
 	// Get admin info from context
 	adminUsername, ok := c.Locals("admin_username").(string)
 	if !ok {
 		adminUsername = "unknown"
 	}
-	adminID, ok := c.Locals("id").(uuid.UUID)
-	if !ok {
-		adminID = uuid.Nil
-	}
 
 	// Only try to assign locations and gates if they are provided
 	if len(req.Locations) > 0 {
@@ -215,75 +283,59 @@ func CreateUser(c *fiber.Ctx) error {
 			}
 		}
 
-		assignment := services.UserLocationGateAssignmentDTO{
-			Phone:     req.Phone,
-			Locations: locations,
-		}
-
-		client := services.NewThirdPartyClient()
-		err := client.AssignUserToLocationsAndGates(assignment)
+		client := services.NewThirdPartyClient().WithContext(c.UserContext())
+		err := services.AssignAllPhones(client, user, locations)
 
-		// Log audit event
-		auditDetails, _ := json.Marshal(fiber.Map{
-			"phone":     req.Phone,
-			"locations": req.Locations,
-		})
+		// Strict mode: roll back the user and surface the upstream error
+		if err != nil && isStrictAssignment(c) {
+			slog.WarnContext(c.UserContext(), "Strict mode: rolling back user after failed location/gate assignment",
+				"phone", req.Phone, "admin", adminUsername, "error", err)
+			if delErr := db.DB.WithContext(c.UserContext()).Unscoped().Delete(&user).Error; delErr != nil {
+				slog.ErrorContext(c.UserContext(), "Error rolling back user", "phone", req.Phone, "error", delErr)
+			}
+			middleware.RecordAudit(c, "create_user_with_assignment", "user", user.ID.String(), "failed", "User rolled back after failed location/gate assignment: "+err.Error())
+			var upstreamErr *services.UpstreamError
+			if errors.As(err, &upstreamErr) {
+				return c.Status(fiber.StatusBadGateway).JSON(APIResponse{
+					Success: false,
+					Message: "Failed to assign locations/gates, user was not created",
+					Data:    toUpstreamErrorDTO(upstreamErr),
+				})
+			}
+			return c.Status(fiber.StatusBadGateway).JSON(APIResponse{
+				Success: false,
+				Message: "Failed to assign locations/gates, user was not created: " + err.Error(),
+			})
+		}
 
-		// Option B: Keep user in DB but return warning if assignment fails
+		// Option B: Keep user in DB, queue the assignment for retry and return a warning
 		if err != nil {
-			log.Printf("Warning: Failed to assign locations/gates to user %s (admin: %s): %v", req.Phone, adminUsername, err)
-			utils.LogAdminAction(
-				adminID,
-				adminUsername,
-				"create_user_with_assignment",
-				"user",
-				user.ID.String(),
-				string(auditDetails),
-				c.IP(),
-				c.Get("User-Agent"),
-				"failed",
-				"Failed to assign locations/gates: "+err.Error(),
-			)
-			return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-				"success": true,
-				"message": "User created successfully but location assignment failed. Please try to assign locations and gates again.",
-				"warning": "Third-party API assignment error: " + err.Error(),
-				"data": fiber.Map{
-					"id":    user.ID,
-					"phone": user.Phone,
-				},
+			slog.WarnContext(c.UserContext(), "Failed to assign locations/gates to user", "phone", req.Phone, "admin", adminUsername, "error", err)
+			middleware.RecordAudit(c, "create_user_with_assignment", "user", user.ID.String(), "failed", "Failed to assign locations/gates: "+err.Error())
+			services.RecordUserHistory(user.ID, models.UserHistoryCreated, adminUsername, changes)
+			events.Publish(events.UserCreated, map[string]interface{}{
+				"user_id":    user.ID,
+				"phone":      user.Phone,
+				"created_by": adminUsername,
 			})
+			return c.Status(fiber.StatusCreated).JSON(assignmentFailedResponse(c, user, locations, adminUsername, "User created successfully", err))
 		}
 
-		log.Printf("User %s created and assigned to locations/gates by admin %s", req.Phone, adminUsername)
-
-		utils.LogAdminAction(
-			adminID,
-			adminUsername,
-			"create_user_with_assignment",
-			"user",
-			user.ID.String(),
-			string(auditDetails),
-			c.IP(),
-			c.Get("User-Agent"),
-			"success",
-			"",
-		)
+		slog.InfoContext(c.UserContext(), "User created and assigned to locations/gates", "phone", req.Phone, "admin", adminUsername)
+		changes.Set("assignments", nil, locations)
+
+		middleware.RecordAudit(c, "create_user_with_assignment", "user", user.ID.String(), "success", "")
 	} else {
 		// User created without location/gate assignment
-		utils.LogAdminAction(
-			adminID,
-			adminUsername,
-			"create_user",
-			"user",
-			user.ID.String(),
-			`{"phone":"`+req.Phone+`"}`,
-			c.IP(),
-			c.Get("User-Agent"),
-			"success",
-			"",
-		)
+		middleware.RecordAudit(c, "create_user", "user", user.ID.String(), "success", "")
 	}
+	services.RecordUserHistory(user.ID, models.UserHistoryCreated, adminUsername, changes)
+
+	events.Publish(events.UserCreated, map[string]interface{}{
+		"user_id":    user.ID,
+		"phone":      user.Phone,
+		"created_by": adminUsername,
+	})
 
 	return c.Status(fiber.StatusCreated).JSON(APIResponse{
 		Success: true,
@@ -297,7 +349,7 @@ func CreateUser(c *fiber.Ctx) error {
 
 // UpdateUser godoc
 // @Summary Update user password and location/gate assignments
-// @Description Update a user's password (optional) and reassign locations and gates via third-party API (requires admin authentication)
+// @Description Update a user's password (optional), session limit (optional) and reassign locations and gates via third-party API (requires admin authentication)
 // @Tags User Management
 // @Accept json
 // @Produce json
@@ -305,9 +357,10 @@ func CreateUser(c *fiber.Ctx) error {
 // @Param id path string true "User ID (UUID)"
 // @Param request body UpdateUserRequest true "Update details (password optional, locations and gates required)"
 // @Success 200 {object} UserResponse "User updated successfully"
-// @Failure 400 {object} APIResponse "Invalid user ID or request body"
+// @Failure 400 {object} APIResponse "Invalid user ID or request body, or new number from a country outside PHONE_ALLOWED_COUNTRIES (code phone_country_not_allowed)"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
 // @Failure 404 {object} APIResponse "User not found"
+// @Failure 409 {object} APIResponse "Phone number or email is already in use"
 // @Failure 500 {object} APIResponse "Internal server error or third-party API failure"
 // @Router /api/v1/users/{id} [patch]
 func UpdateUser(c *fiber.Ctx) error {
@@ -338,58 +391,94 @@ func UpdateUser(c *fiber.Ctx) error {
 			Message: "Password must be at least 6 characters long",
 		})
 	}
+	if req.MaxSessions != nil && *req.MaxSessions < -1 {
+		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+			Success: false,
+			Message: "max_sessions must be a number of sessions, 0 for unlimited or -1 for the default",
+		})
+	}
 
 	// Find user
 	var user models.User
-	if err := db.DB.First(&user, userID).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).First(&user, userID).Error; err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
 			Success: false,
 			Message: "User not found",
 		})
 	}
 
-	log.Printf("Updating user %s (phone: %s)", userID, user.Phone)
+	slog.DebugContext(c.UserContext(), "Updating user", "user_id", userID, "phone", user.Phone)
+	before := services.SnapshotUser(user)
 
 	// Get admin info from context
 	adminUsername, ok := c.Locals("admin_username").(string)
 	if !ok {
 		adminUsername = "unknown"
 	}
-	adminID, ok := c.Locals("id").(uuid.UUID)
-	if !ok {
-		adminID = uuid.Nil
-	}
 
-	// Validate phone number if provided and different from current
-	if req.Phone != "" && req.Phone != user.Phone {
-		// Validate phone format
-		if !phoneRegex.MatchString(req.Phone) {
+	// Validate phone number if provided and convert it to canonical E.164 form
+	if req.Phone != "" {
+		normalizedPhone, err := phonenumber.Normalize(req.Phone)
+		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
 				Success: false,
 				Message: "Invalid phone number format. Use international format (e.g., +77771234567)",
 			})
 		}
+		req.Phone = normalizedPhone
+	}
+
+	if req.Phone != "" && req.Phone != user.Phone {
+		if err := phonenumber.CheckAllowed(req.Phone); err != nil {
+			return respondPhoneCountryNotAllowed(c)
+		}
 
-		// Check if new phone number is already in use
-		var existingUser models.User
-		if err := db.DB.Where("phone = ?", req.Phone).First(&existingUser).Error; err == nil {
+		// Check if new phone number is already in use, including as a secondary number
+		if services.PhoneInUse(req.Phone) {
 			return c.Status(fiber.StatusConflict).JSON(APIResponse{
 				Success: false,
 				Message: "Phone number is already in use",
 			})
 		}
 
-		log.Printf("Updating phone number for user %s from %s to %s by admin %s", userID, user.Phone, req.Phone, adminUsername)
+		slog.InfoContext(c.UserContext(), "Updating phone number of user",
+			"user_id", userID, "old_phone", user.Phone, "phone", req.Phone, "admin", adminUsername)
 		user.Phone = req.Phone
 	}
 
-	// Build audit details
-	auditDetails, _ := json.Marshal(fiber.Map{
-		"phone_updated":     req.Phone != "" && req.Phone != user.Phone,
-		"new_phone":         req.Phone,
-		"password_updated":  req.Password != "",
-		"locations":         req.Locations,
-	})
+	// Set the login email if provided; emails set by an admin are verified immediately
+	if req.Email != "" {
+		email, err := services.ParseEmail(req.Email)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+				Success: false,
+				Message: "Invalid email format",
+			})
+		}
+		if email != user.Email {
+			if services.EmailInUse(email) {
+				return c.Status(fiber.StatusConflict).JSON(APIResponse{
+					Success: false,
+					Message: "Email is already in use",
+				})
+			}
+			now := time.Now()
+			user.Email = email
+			user.EmailVerifiedAt = &now
+			slog.InfoContext(c.UserContext(), "Login email updated", "user_id", userID, "admin", adminUsername)
+		}
+	}
+
+	// Set the session limit if provided; -1 returns the user to JWT_MAX_SESSIONS. It applies from the next login.
+	previousMaxSessions := user.MaxSessions
+	if req.MaxSessions != nil {
+		user.MaxSessions = req.MaxSessions
+		if *req.MaxSessions == -1 {
+			user.MaxSessions = nil
+		}
+	}
+
+	previousTokenVersion := user.TokenVersion
 
 	// Update password if provided
 	if req.Password != "" {
@@ -402,36 +491,44 @@ func UpdateUser(c *fiber.Ctx) error {
 		}
 
 		// Update password and increment token version (this invalidates all existing tokens)
+		now := time.Now()
 		user.Password = string(hashedPassword)
+		user.PasswordChangedAt = &now
 		user.TokenVersion++
-		log.Printf("Password updated for user %s by admin %s", user.Phone, adminUsername)
+		slog.InfoContext(c.UserContext(), "Password updated", "phone", user.Phone, "admin", adminUsername)
 	}
 
 	// Increment token version if phone was changed (invalidate all existing tokens)
 	if req.Phone != "" && req.Phone != user.Phone {
 		user.TokenVersion++
-		log.Printf("Token version incremented due to phone number change for user %s", user.Phone)
-	}
-
-	if err := db.DB.Save(&user).Error; err != nil {
-		utils.LogAdminAction(
-			adminID,
-			adminUsername,
-			"update_user",
-			"user",
-			user.ID.String(),
-			string(auditDetails),
-			c.IP(),
-			c.Get("User-Agent"),
-			"failed",
-			"Failed to update user in database",
-		)
+		slog.InfoContext(c.UserContext(), "Token version incremented due to phone number change", "phone", user.Phone)
+	}
+
+	if err := db.DB.WithContext(c.UserContext()).Save(&user).Error; err != nil {
+		middleware.RecordAudit(c, "update_user", "user", user.ID.String(), "failed", "Failed to update user in database")
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
 			Message: "Failed to update user",
 		})
 	}
 
+	// A token version bump logs out every device, so close their sessions too
+	if user.TokenVersion != previousTokenVersion {
+		services.RevokeAllSessions(c.UserContext(), user.ID)
+	}
+
+	// Passwords are never stored in the history, only that one was set
+	changes := services.FieldChanges{}.DiffUser(before, services.SnapshotUser(user))
+	if req.Password != "" {
+		changes.Set("password_changed", false, true)
+	}
+	if !sameSessionLimit(previousMaxSessions, user.MaxSessions) {
+		changes.Set("max_sessions", previousMaxSessions, user.MaxSessions)
+	}
+	if len(changes) > 0 {
+		services.RecordUserHistory(user.ID, models.UserHistoryUpdated, adminUsername, changes)
+	}
+
 	// Only try to assign locations and gates if they are provided
 	if len(req.Locations) > 0 {
 		// Transform LocationAssignmentRequest to LocationAssignmentDTO
@@ -443,67 +540,29 @@ func UpdateUser(c *fiber.Ctx) error {
 			}
 		}
 
-		assignment := services.UserLocationGateAssignmentDTO{
-			Phone:     user.Phone,
-			Locations: locations,
-		}
-
-		client := services.NewThirdPartyClient()
-		err := client.AssignUserToLocationsAndGates(assignment)
+		// Every verified number of the user gets the same access
+		client := services.NewThirdPartyClient().WithContext(c.UserContext())
+		previous := services.PreviousAssignment(client, before.Phone)
+		err := services.AssignAllPhones(client, user, locations)
 
-		// Option B: Keep user update but return warning if assignment fails
+		// Option B: Keep user update, queue the assignment for retry and return a warning
 		if err != nil {
-			log.Printf("Warning: Failed to update locations/gates for user %s (admin: %s): %v", user.Phone, adminUsername, err)
-			utils.LogAdminAction(
-				adminID,
-				adminUsername,
-				"update_user_assignment",
-				"user",
-				user.ID.String(),
-				string(auditDetails),
-				c.IP(),
-				c.Get("User-Agent"),
-				"failed",
-				"Failed to assign locations/gates: "+err.Error(),
-			)
-			return c.Status(fiber.StatusOK).JSON(fiber.Map{
-				"success": true,
-				"message": "User updated successfully but location assignment failed. Please try to assign locations and gates again.",
-				"warning": "Third-party API assignment error: " + err.Error(),
-				"data": fiber.Map{
-					"id":    user.ID,
-					"phone": user.Phone,
-				},
-			})
+			slog.WarnContext(c.UserContext(), "Failed to update locations/gates for user", "phone", user.Phone, "admin", adminUsername, "error", err)
+			middleware.RecordAudit(c, "update_user_assignment", "user", user.ID.String(), "failed", "Failed to assign locations/gates: "+err.Error())
+			return c.Status(fiber.StatusOK).JSON(assignmentFailedResponse(c, user, locations, adminUsername, "User updated successfully", err))
 		}
 
-		log.Printf("User %s updated and assigned to locations/gates by admin %s", user.Phone, adminUsername)
-		utils.LogAdminAction(
-			adminID,
-			adminUsername,
-			"update_user_assignment",
-			"user",
-			user.ID.String(),
-			string(auditDetails),
-			c.IP(),
-			c.Get("User-Agent"),
-			"success",
-			"",
-		)
+		slog.InfoContext(c.UserContext(), "User updated and assigned to locations/gates", "phone", user.Phone, "admin", adminUsername)
+		// An older failed assignment must not be retried over this one
+		if err := services.ClearPendingAssignment(user.ID); err != nil {
+			slog.ErrorContext(c.UserContext(), "Failed to clear pending assignment", "user_id", user.ID, "error", err)
+		}
+		services.RecordUserHistory(user.ID, models.UserHistoryAssigned, adminUsername,
+			services.FieldChanges{}.Set("assignments", previous, locations))
+		middleware.RecordAudit(c, "update_user_assignment", "user", user.ID.String(), "success", "")
 	} else {
 		// User updated without assignment changes
-		utils.LogAdminAction(
-			adminID,
-			adminUsername,
-			"update_user",
-			"user",
-			user.ID.String(),
-			string(auditDetails),
-			c.IP(),
-			c.Get("User-Agent"),
-			"success",
-			"",
-		)
+		middleware.RecordAudit(c, "update_user", "user", user.ID.String(), "success", "")
 	}
 
 	return c.Status(fiber.StatusOK).JSON(APIResponse{
@@ -542,47 +601,52 @@ func GetUserByID(c *fiber.Ctx) error {
 
 	// Find user
 	var user models.User
-	if err := db.DB.First(&user, userID).Error; err != nil {
+	if err := db.DB.WithContext(c.UserContext()).First(&user, userID).Error; err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
 			Success: false,
 			Message: "User not found",
 		})
 	}
 
-	log.Printf("Fetching user details for %s (ID: %s)", user.Phone, userID)
+	slog.DebugContext(c.UserContext(), "Fetching user details", "phone", user.Phone, "user_id", userID)
+
+	phones, err := loadUserPhones(c.UserContext(), user.ID)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "Failed to load phone numbers for user", "user_id", userID, "error", err)
+	}
 
 	// Fetch user's locations and gates from third-party API
-	client := services.NewThirdPartyClient()
+	client := services.NewThirdPartyClient().WithContext(c.UserContext())
 	locationsWithGates, err := client.GetAllLocationsWithGates(user.Phone)
 	if err != nil {
-		log.Printf("Warning: Failed to fetch locations for user %s: %v", user.Phone, err)
+		slog.WarnContext(c.UserContext(), "Failed to fetch locations for user", "phone", user.Phone, "error", err)
 		// Return user info even if third-party API fails
 		return c.Status(fiber.StatusOK).JSON(UserDetailResponse{
 			Success: true,
 			Message: "User retrieved but location data unavailable",
 			Data: UserDetailDTO{
-				ID:        user.ID,
-				Phone:     user.Phone,
-				CreatedAt: user.CreatedAt,
-				UpdatedAt: user.UpdatedAt,
-				Locations: []LocationDTO{},
+				ID:              user.ID,
+				Phone:           user.Phone,
+				Email:           user.Email,
+				EmailVerifiedAt: user.EmailVerifiedAt,
+				TrashedAt:       user.TrashedAt,
+				MaxSessions:     user.MaxSessions,
+				CreatedAt:       user.CreatedAt,
+				UpdatedAt:       user.UpdatedAt,
+				Phones:          phones,
+				Locations:       []LocationDTO{},
 			},
 		})
 	}
 
+	maintenance := gateMaintenanceOf(locationsWithGates)
+
 	// Convert LocationResponse to LocationDTO
 	var locationDTOs []LocationDTO
 	for _, loc := range locationsWithGates {
 		var gateDTOs []GateDTO
 		for _, gate := range loc.Gates {
-			gateDTOs = append(gateDTOs, GateDTO{
-				ID:               gate.ID,
-				Title:            gate.Title,
-				Description:      gate.Description,
-				LocationID:       gate.LocationID,
-				IsOpen:           gate.IsOpen,
-				GateIsHorizontal: gate.GateIsHorizontal,
-			})
+			gateDTOs = append(gateDTOs, toGateDTO(gate, maintenance))
 		}
 
 		locationDTOs = append(locationDTOs, LocationDTO{
@@ -598,72 +662,267 @@ func GetUserByID(c *fiber.Ctx) error {
 		Success: true,
 		Message: "User retrieved successfully",
 		Data: UserDetailDTO{
-			ID:        user.ID,
-			Phone:     user.Phone,
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
-			Locations: locationDTOs,
+			ID:              user.ID,
+			Phone:           user.Phone,
+			Email:           user.Email,
+			EmailVerifiedAt: user.EmailVerifiedAt,
+			TrashedAt:       user.TrashedAt,
+			MaxSessions:     user.MaxSessions,
+			CreatedAt:       user.CreatedAt,
+			UpdatedAt:       user.UpdatedAt,
+			Phones:          phones,
+			Locations:       locationDTOs,
 		},
 	})
 }
 
 // DeleteUser godoc
 // @Summary Delete a user
-// @Description Delete a user account by ID (soft delete, requires admin authentication)
+// @Description Move a user to the trash (requires admin authentication). Login is blocked and all tokens and sessions are revoked, but the user's numbers and gate access are kept for USER_TRASH_RETENTION, during which the deletion can be undone with POST /api/v1/users/{id}/restore. Afterwards the user is purged and their gate access revoked at the provider.
 // @Tags User Management
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "User ID (UUID)"
-// @Success 200 {object} UserResponse "User deleted successfully"
+// @Success 200 {object} TrashedUserResponse "User moved to the trash"
 // @Failure 400 {object} APIResponse "Invalid user ID format"
 // @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
 // @Failure 404 {object} APIResponse "User not found"
+// @Failure 409 {object} APIResponse "User is already in the trash"
 // @Failure 500 {object} APIResponse "Internal server error"
 // @Router /api/v1/users/{id} [delete]
 func DeleteUser(c *fiber.Ctx) error {
-	// Get user ID from URL parameter
-	userID, err := uuid.Parse(c.Params("id"))
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(APIResponse{
+	user, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	if err := services.TrashUser(c.UserContext(), &user, adminUsername); err != nil {
+		if errors.Is(err, services.ErrUserTrashed) {
+			return c.Status(fiber.StatusConflict).JSON(APIResponse{
+				Success: false,
+				Message: "User is already in the trash",
+			})
+		}
+		middleware.RecordAudit(c, "trash_user", "user", user.ID.String(), "failed", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
-			Message: "Invalid user ID format",
+			Message: "Failed to delete user",
 		})
 	}
+	slog.InfoContext(c.UserContext(), "User moved to the trash", "user_id", user.ID, "admin", adminUsername)
+	middleware.RecordAudit(c, "trash_user", "user", user.ID.String(), "success", "")
 
-	// Find user
-	var user models.User
-	if err := db.DB.First(&user, userID).Error; err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+	return c.Status(fiber.StatusOK).JSON(TrashedUserResponse{
+		Success: true,
+		Message: "User moved to the trash",
+		Data:    toTrashedUserDTO(user),
+	})
+}
+
+// GetTrashedUsers godoc
+// @Summary List users in the trash
+// @Description Retrieve deleted users that can still be restored, with when each will be purged (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} TrashedUsersResponse "Trashed users retrieved successfully"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/trash [get]
+func GetTrashedUsers(c *fiber.Ctx) error {
+	var users []models.User
+	if err := db.DB.WithContext(c.UserContext()).Where("trashed_at IS NOT NULL").Order("trashed_at DESC").Find(&users).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
-			Message: "User not found",
+			Message: "Failed to retrieve trashed users",
 		})
 	}
 
-	// Invalidate all user tokens by incrementing token version
-	user.TokenVersion++
+	data := make([]TrashedUserDTO, len(users))
+	for i, user := range users {
+		data[i] = toTrashedUserDTO(user)
+	}
 
-	// Delete user (soft delete by default with GORM)
-	if err := db.DB.Save(&user).Error; err != nil {
+	return c.Status(fiber.StatusOK).JSON(TrashedUsersResponse{
+		Success: true,
+		Message: "Trashed users retrieved successfully",
+		Data:    data,
+	})
+}
+
+// RestoreUser godoc
+// @Summary Restore a user from the trash
+// @Description Undo the deletion of a user in the trash. The user can log in again with their existing password; their numbers and gate access were kept (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {object} UserResponse "User restored successfully"
+// @Failure 400 {object} APIResponse "Invalid user ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User not found"
+// @Failure 409 {object} APIResponse "User is not in the trash"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/{id}/restore [post]
+func RestoreUser(c *fiber.Ctx) error {
+	user, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	if err := services.RestoreUser(&user, adminUsername); err != nil {
+		if errors.Is(err, services.ErrUserNotTrashed) {
+			return c.Status(fiber.StatusConflict).JSON(APIResponse{
+				Success: false,
+				Message: "User is not in the trash",
+			})
+		}
+		middleware.RecordAudit(c, "restore_user", "user", user.ID.String(), "failed", err.Error())
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
-			Message: "Failed to invalidate user tokens",
+			Message: "Failed to restore user",
 		})
 	}
+	middleware.RecordAudit(c, "restore_user", "user", user.ID.String(), "success", "")
 
-	if err := db.DB.Delete(&user).Error; err != nil {
+	return c.Status(fiber.StatusOK).JSON(APIResponse{
+		Success: true,
+		Message: "User restored successfully",
+		Data: fiber.Map{
+			"id":    user.ID,
+			"phone": user.Phone,
+		},
+	})
+}
+
+func toTrashedUserDTO(user models.User) TrashedUserDTO {
+	dto := TrashedUserDTO{
+		ID:        user.ID,
+		Phone:     user.Phone,
+		TrashedAt: user.TrashedAt,
+		TrashedBy: user.TrashedBy,
+	}
+	if user.TrashedAt != nil {
+		purgeAt := services.TrashPurgeAt(*user.TrashedAt)
+		dto.PurgeAt = &purgeAt
+	}
+	return dto
+}
+
+// assignmentFailedResponse queues a location/gate assignment the provider refused for retry
+// and builds the warning response returned instead of the usual success
+func assignmentFailedResponse(c *fiber.Ctx, user models.User, locations []services.LocationAssignmentDTO, adminUsername, message string, assignErr error) fiber.Map {
+	data := fiber.Map{
+		"id":    user.ID,
+		"phone": user.Phone,
+	}
+	pending, err := services.QueueAssignment(c.UserContext(), user.ID, locations, adminUsername, assignErr)
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "Failed to queue assignment for retry", "user_id", user.ID, "error", err)
+		return fiber.Map{
+			"success": true,
+			"message": message + " but location assignment failed. Please try to assign locations and gates again.",
+			"warning": "Third-party API assignment error: " + assignErr.Error(),
+			"data":    data,
+		}
+	}
+	data["pending_assignment_id"] = pending.ID
+	return fiber.Map{
+		"success": true,
+		"message": message + " but location assignment failed. It will be retried automatically.",
+		"warning": "Third-party API assignment error: " + assignErr.Error(),
+		"data":    data,
+	}
+}
+
+// RetryUserAssignment godoc
+// @Summary Retry a failed location/gate assignment
+// @Description Send the user's pending location/gate assignment, queued when the provider refused it on create or update, to the provider again right away instead of waiting for the assignment_retry job. Assignments given up after ASSIGNMENT_RETRY_MAX_ATTEMPTS can be retried too (requires admin authentication)
+// @Tags User Management
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {object} APIResponse "Locations and gates assigned"
+// @Failure 400 {object} APIResponse "Invalid user ID format"
+// @Failure 401 {object} APIResponse "Unauthorized - invalid or missing admin token"
+// @Failure 404 {object} APIResponse "User not found or no pending assignment"
+// @Failure 502 {object} APIResponse "Provider refused the assignment again; the next attempt is scheduled"
+// @Failure 500 {object} APIResponse "Internal server error"
+// @Router /api/v1/users/{id}/retry-assignment [post]
+func RetryUserAssignment(c *fiber.Ctx) error {
+	user, ok, err := findUserParam(c)
+	if !ok {
+		return err
+	}
+
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+
+	pending, err := services.FindPendingAssignment(user.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrNoPendingAssignment) {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "User has no pending assignment",
+			})
+		}
+		slog.ErrorContext(c.UserContext(), "Failed to load pending assignment", "user_id", user.ID, "error", err)
 		return c.Status(fiber.StatusInternalServerError).JSON(APIResponse{
 			Success: false,
-			Message: "Failed to delete user",
+			Message: "Failed to retry assignment",
 		})
 	}
 
+	client := services.NewThirdPartyClient().WithContext(c.UserContext())
+	if err := services.RetryPendingAssignment(c.UserContext(), client, &pending, adminUsername); err != nil {
+		if errors.Is(err, services.ErrNoPendingAssignment) {
+			return c.Status(fiber.StatusNotFound).JSON(APIResponse{
+				Success: false,
+				Message: "User has no pending assignment",
+			})
+		}
+		middleware.RecordAudit(c, "retry_user_assignment", "user", user.ID.String(), "failed", err.Error())
+		return respondUpstreamError(c, err, "Failed to assign locations/gates")
+	}
+	middleware.RecordAudit(c, "retry_user_assignment", "user", user.ID.String(), "success", "")
+
 	return c.Status(fiber.StatusOK).JSON(APIResponse{
 		Success: true,
-		Message: "User deleted successfully",
+		Message: "Locations and gates assigned successfully",
 		Data: fiber.Map{
-			"id": user.ID,
-			"phone":   user.Phone,
+			"id":       user.ID,
+			"phone":    user.Phone,
+			"attempts": pending.Attempts + 1,
 		},
 	})
 }
+
+// isStrictAssignment reports whether a failed assignment should roll back user creation.
+// The "strict" query parameter overrides the deployment-wide ASSIGNMENT_STRICT_MODE setting.
+func isStrictAssignment(c *fiber.Ctx) bool {
+	return c.QueryBool("strict", config.AppConfig().Assignment.StrictMode)
+}
+
+// sameSessionLimit reports whether two user session limits are equal (nil is the deployment limit)
+func sameSessionLimit(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
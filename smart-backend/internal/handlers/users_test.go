@@ -4,12 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http/httptest"
+	"ololo-gate/internal/config"
 	"ololo-gate/internal/db"
 	"ololo-gate/internal/middleware"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/pii"
 	"ololo-gate/internal/tests"
 	"ololo-gate/internal/utils"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -66,6 +69,69 @@ func TestGetAllUsers_Success(t *testing.T) {
 	assert.GreaterOrEqual(t, response.Pagination.Total, 3)
 }
 
+func TestGetAllUsers_CountModes(t *testing.T) {
+	app := setupUserTest(t)
+	defer tests.CleanupTestDB(t)
+
+	tests.CreateTestUser(t, "+77771234567", "password1")
+	headers := map[string]string{
+		"Authorization": "Bearer " + getValidAuthToken(t),
+	}
+
+	// Estimates need PostgreSQL statistics; elsewhere, and for small tables, rows are counted
+	resp, err := tests.MakeRequest(app, "GET", "/users/?count=estimate", nil, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+	var response UsersListResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	assert.Equal(t, 2, response.Pagination.Total)
+	assert.False(t, response.Pagination.Estimated)
+
+	resp, err = tests.MakeRequest(app, "GET", "/users/?count=approximate", nil, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, resp.Code)
+}
+
+func TestGetAllUsers_Streaming(t *testing.T) {
+	app := setupUserTest(t)
+	defer tests.CleanupTestDB(t)
+
+	tests.CreateTestUser(t, "+77771234567", "password1")
+	tests.CreateTestUser(t, "+77772345678", "password2")
+	headers := map[string]string{
+		"Authorization": "Bearer " + getValidAuthToken(t),
+	}
+
+	// The streamed JSON body decodes like the paginated response
+	resp, err := tests.MakeRequest(app, "GET", "/users/?limit=-1&stream=json&order=ASC", nil, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+	var response UsersListResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	assert.True(t, response.Success)
+	assert.Len(t, response.Data, 3)
+	assert.Equal(t, "+77771234567", response.Data[0].Phone)
+	assert.Equal(t, 3, response.Pagination.Total)
+
+	resp, err = tests.MakeRequest(app, "GET", "/users/?limit=-1&stream=ndjson", nil, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.Code)
+	decoder := json.NewDecoder(resp.Body)
+	lines := 0
+	for decoder.More() {
+		var user UserDTO
+		assert.NoError(t, decoder.Decode(&user))
+		assert.NotEqual(t, uuid.Nil, user.ID)
+		lines++
+	}
+	assert.Equal(t, 3, lines)
+
+	// Bounded pages are not streamed
+	resp, err = tests.MakeRequest(app, "GET", "/users/?limit=10&stream=json", nil, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, resp.Code)
+}
+
 func TestGetAllUsers_NoAuth(t *testing.T) {
 	app := setupUserTest(t)
 	defer tests.CleanupTestDB(t)
@@ -136,6 +202,109 @@ func TestCreateUser_DuplicatePhone(t *testing.T) {
 	assert.Contains(t, result["message"], "already exists")
 }
 
+func TestCreateUser_WithEmail(t *testing.T) {
+	app := setupUserTest(t)
+	defer tests.CleanupTestDB(t)
+
+	token := getValidAuthToken(t)
+	headers := map[string]string{
+		"Authorization": "Bearer " + token,
+	}
+
+	body := map[string]interface{}{
+		"phone":    "+77772222222",
+		"email":    "Staff@Example.com",
+		"password": "password123",
+	}
+
+	resp, err := tests.MakeRequest(app, "POST", "/users/", body, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 201, resp.Code)
+
+	// Emails set by an admin are stored normalized and verified
+	var user models.User
+	assert.NoError(t, db.DB.Where("phone_index = ?", pii.BlindIndex("+77772222222")).First(&user).Error)
+	assert.Equal(t, "staff@example.com", user.Email)
+	assert.NotNil(t, user.EmailVerifiedAt)
+
+	// An email belongs to one user only
+	body["phone"] = "+77773333333"
+	body["email"] = "staff@example.com"
+	resp, err = tests.MakeRequest(app, "POST", "/users/", body, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 409, resp.Code)
+
+	body["email"] = "Staff <staff@example.com>"
+	resp, err = tests.MakeRequest(app, "POST", "/users/", body, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, resp.Code)
+}
+
+func TestCreateUser_StrictModeRollsBackOnAssignmentFailure(t *testing.T) {
+	app := setupUserTest(t)
+	defer tests.CleanupTestDB(t)
+
+	token := getValidAuthToken(t)
+	headers := map[string]string{
+		"Authorization": "Bearer " + token,
+	}
+
+	// Third-party API is not reachable in tests, so the assignment always fails
+	body := map[string]interface{}{
+		"phone":    "+77778888888",
+		"password": "newuserpass",
+		"locations": []map[string]interface{}{
+			{"locationId": 1, "gateIds": []int{1}},
+		},
+	}
+
+	resp, err := tests.MakeRequest(app, "POST", "/users/?strict=true", body, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 502, resp.Code)
+
+	result := tests.ParseJSONResponse(t, resp)
+	assert.False(t, result["success"].(bool))
+	assert.Contains(t, result["message"], "user was not created")
+	data := result["data"].(map[string]interface{})
+	assert.Equal(t, "provider_unavailable", data["kind"])
+	assert.Equal(t, "assign_user", data["operation"])
+
+	// User must not remain in the database (not even soft-deleted)
+	var count int64
+	db.DB.Unscoped().Model(&models.User{}).Where("phone_index = ?", pii.BlindIndex("+77778888888")).Count(&count)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestCreateUser_NonStrictModeKeepsUserOnAssignmentFailure(t *testing.T) {
+	app := setupUserTest(t)
+	defer tests.CleanupTestDB(t)
+
+	token := getValidAuthToken(t)
+	headers := map[string]string{
+		"Authorization": "Bearer " + token,
+	}
+
+	body := map[string]interface{}{
+		"phone":    "+77778888889",
+		"password": "newuserpass",
+		"locations": []map[string]interface{}{
+			{"locationId": 1, "gateIds": []int{1}},
+		},
+	}
+
+	resp, err := tests.MakeRequest(app, "POST", "/users/?strict=false", body, headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 201, resp.Code)
+
+	result := tests.ParseJSONResponse(t, resp)
+	assert.True(t, result["success"].(bool))
+	assert.NotEmpty(t, result["warning"])
+
+	var count int64
+	db.DB.Model(&models.User{}).Where("phone_index = ?", pii.BlindIndex("+77778888889")).Count(&count)
+	assert.Equal(t, int64(1), count)
+}
+
 func TestUpdateUserPassword_Success(t *testing.T) {
 	app := setupUserTest(t)
 	defer tests.CleanupTestDB(t)
@@ -242,10 +411,45 @@ func TestDeleteUser_Success(t *testing.T) {
 
 	result := tests.ParseJSONResponse(t, resp)
 	assert.True(t, result["success"].(bool))
-	assert.Equal(t, "User deleted successfully", result["message"])
+	assert.Equal(t, "User moved to the trash", result["message"])
 
 	data := result["data"].(map[string]interface{})
 	assert.Equal(t, "+77771234567", data["phone"])
+	assert.NotNil(t, data["trashed_at"])
+	assert.NotNil(t, data["purge_at"])
+}
+
+func TestDeleteUser_TrashCanBeUndone(t *testing.T) {
+	app, cleanup := SetupTestApp()
+	defer cleanup()
+	config.AppConfig().Users.TrashRetention = 7 * 24 * time.Hour
+
+	user := models.User{Phone: "+77771234567", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+	path := "/api/v1/users/" + user.ID.String()
+
+	status, _ := userPhonesRequest(t, app, "DELETE", path, nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = userPhonesRequest(t, app, "DELETE", path, nil)
+	assert.Equal(t, fiber.StatusConflict, status)
+
+	// Trashed users cannot log in and are listed in the trash, not with the active users
+	assert.Equal(t, fiber.StatusUnauthorized, loginStatus(t, app, "+77771234567", "password123"))
+	status, result := userPhonesRequest(t, app, "GET", "/api/v1/users/trash", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	trashed := result["data"].([]interface{})
+	assert.Len(t, trashed, 1)
+	assert.Equal(t, user.ID.String(), trashed[0].(map[string]interface{})["id"])
+	status, result = userPhonesRequest(t, app, "GET", "/api/v1/users", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Empty(t, result["data"])
+
+	// Restoring undoes the deletion
+	status, _ = userPhonesRequest(t, app, "POST", path+"/restore", nil)
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, fiber.StatusOK, loginStatus(t, app, "+77771234567", "password123"))
+	status, _ = userPhonesRequest(t, app, "POST", path+"/restore", nil)
+	assert.Equal(t, fiber.StatusConflict, status)
 }
 
 func TestDeleteUser_NotFound(t *testing.T) {
@@ -382,3 +586,28 @@ func TestProtectedEndpoint_InvalidToken(t *testing.T) {
 	assert.False(t, result["success"].(bool))
 	assert.Contains(t, result["message"], "Invalid or expired token")
 }
+
+func TestGetAllUsers_SearchByEncryptedPhone(t *testing.T) {
+	app := setupUserTest(t)
+	defer tests.CleanupTestDB(t)
+
+	tests.CreateTestUser(t, "+77771234567", "password1")
+	tests.CreateTestUser(t, "+77772345678", "password2")
+
+	var stored string
+	db.DB.Table("users").Select("phone").Where("phone_index = ?", pii.BlindIndex("+77771234567")).Scan(&stored)
+	assert.True(t, pii.IsEncrypted(stored), "phone must not be stored in plaintext")
+
+	headers := map[string]string{"Authorization": "Bearer " + getValidAuthToken(t)}
+	for _, search := range []string{"%2B77771234567", "4567"} {
+		resp, err := tests.MakeRequest(app, "GET", "/users/?search="+search, nil, headers)
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.Code)
+
+		var response UsersListResponse
+		json.NewDecoder(resp.Body).Decode(&response)
+		if assert.Len(t, response.Data, 1, search) {
+			assert.Equal(t, "+77771234567", response.Data[0].Phone)
+		}
+	}
+}
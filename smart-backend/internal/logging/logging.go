@@ -0,0 +1,109 @@
+// Package logging sets up the structured logger every component writes to.
+//
+// Log lines are written with log/slog, as JSON by default. A line logged with a request's context
+// (slog.InfoContext(c.UserContext(), ...)) carries the request's correlation ID as request_id, the
+// ID returned to the client in X-Request-ID, so the lines of one request can be found from a
+// response or a problem report.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// requestIDKey is the context key of the request's correlation ID
+type requestIDKey struct{}
+
+// level is the minimum level logged, shared by every handler Setup builds so SetLevel applies at once
+var level slog.LevelVar
+
+// WithRequestID returns a copy of ctx carrying the correlation ID id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the correlation ID carried by ctx, empty outside a request
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// ParseLevel parses a LOG_LEVEL value: debug, info, warn or error
+func ParseLevel(value string) (slog.Level, error) {
+	var parsed slog.Level
+	if err := parsed.UnmarshalText([]byte(value)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q, use debug, info, warn or error", value)
+	}
+	return parsed, nil
+}
+
+// SetLevel changes the minimum level of the logger installed by Setup
+func SetLevel(value string) error {
+	parsed, err := ParseLevel(value)
+	if err != nil {
+		return err
+	}
+	level.Set(parsed)
+	return nil
+}
+
+// New returns a logger writing to w in format ("json" or "text") at the level set by SetLevel,
+// adding the request_id of the context of each line
+func New(w io.Writer, format string) (*slog.Logger, error) {
+	options := &slog.HandlerOptions{Level: &level}
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case "", "json":
+		handler = slog.NewJSONHandler(w, options)
+	case "text":
+		handler = slog.NewTextHandler(w, options)
+	default:
+		return nil, fmt.Errorf("invalid log format %q, use json or text", format)
+	}
+	return slog.New(contextHandler{handler}), nil
+}
+
+// Setup installs the logger built by New as the slog default at minLevel. Lines still written with
+// the log package go through it too, at info level.
+func Setup(w io.Writer, format, minLevel string) error {
+	if err := SetLevel(minLevel); err != nil {
+		return err
+	}
+	logger, err := New(w, format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+	return nil
+}
+
+// Fatal logs msg with args at error level and exits, for failures the server cannot start or keep
+// running with
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// contextHandler adds the request_id of the context to every record
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_AddsRequestIDOfContext(t *testing.T) {
+	assert.NoError(t, SetLevel("info"))
+	var out bytes.Buffer
+	logger, err := New(&out, "json")
+	assert.NoError(t, err)
+
+	logger.InfoContext(WithRequestID(context.Background(), "req-1"), "[SESSION] Session revoked", "user_id", "u1")
+	logger.Info("Configuration loaded")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 2)
+
+	var first map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	assert.Equal(t, "INFO", first["level"])
+	assert.Equal(t, "[SESSION] Session revoked", first["msg"])
+	assert.Equal(t, "u1", first["user_id"])
+	assert.Equal(t, "req-1", first["request_id"])
+
+	var second map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	assert.NotContains(t, second, "request_id")
+}
+
+func TestSetLevel_FiltersLowerLevels(t *testing.T) {
+	var out bytes.Buffer
+	logger, err := New(&out, "text")
+	assert.NoError(t, err)
+	defer SetLevel("info")
+
+	assert.NoError(t, SetLevel("warn"))
+	logger.Info("hidden")
+	logger.Warn("shown")
+	assert.NotContains(t, out.String(), "hidden")
+	assert.Contains(t, out.String(), "msg=shown")
+
+	assert.NoError(t, SetLevel("debug"))
+	logger.Debug("now shown")
+	assert.Contains(t, out.String(), `msg="now shown"`)
+}
+
+func TestSetup_RejectsUnknownSettings(t *testing.T) {
+	assert.Error(t, SetLevel("verbose"))
+	_, err := New(&bytes.Buffer{}, "xml")
+	assert.Error(t, err)
+}
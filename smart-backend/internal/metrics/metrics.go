@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Labels are the dimension values attached to a metric sample
+type Labels map[string]string
+
+type sample struct {
+	name   string
+	labels Labels
+	value  float64
+}
+
+type registry struct {
+	mu       sync.Mutex
+	counters map[string]*sample
+	gauges   map[string]*sample
+}
+
+var defaultRegistry = &registry{
+	counters: make(map[string]*sample),
+	gauges:   make(map[string]*sample),
+}
+
+var (
+	collectorsMu sync.Mutex
+	collectors   []func()
+)
+
+// RegisterCollector adds a function run before every render, to set metrics that are read on
+// demand rather than updated as they change, such as connection pool stats
+func RegisterCollector(fn func()) {
+	collectorsMu.Lock()
+	defer collectorsMu.Unlock()
+	collectors = append(collectors, fn)
+}
+
+// IncCounter increments a counter by one
+func IncCounter(name string, labels Labels) {
+	AddCounter(name, labels, 1)
+}
+
+// AddCounter increments a counter by the given value
+func AddCounter(name string, labels Labels, value float64) {
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+
+	key := seriesKey(name, labels)
+	s, ok := defaultRegistry.counters[key]
+	if !ok {
+		s = &sample{name: name, labels: labels}
+		defaultRegistry.counters[key] = s
+	}
+	s.value += value
+}
+
+// SetCounter sets a counter kept elsewhere, e.g. by database/sql, to its current total
+func SetCounter(name string, labels Labels, value float64) {
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+
+	key := seriesKey(name, labels)
+	s, ok := defaultRegistry.counters[key]
+	if !ok {
+		s = &sample{name: name, labels: labels}
+		defaultRegistry.counters[key] = s
+	}
+	s.value = value
+}
+
+// SetGauge sets a gauge to the given value
+func SetGauge(name string, labels Labels, value float64) {
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+
+	key := seriesKey(name, labels)
+	s, ok := defaultRegistry.gauges[key]
+	if !ok {
+		s = &sample{name: name, labels: labels}
+		defaultRegistry.gauges[key] = s
+	}
+	s.value = value
+}
+
+// CounterValue returns the current value of a counter (0 if it was never incremented)
+func CounterValue(name string, labels Labels) float64 {
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+
+	if s, ok := defaultRegistry.counters[seriesKey(name, labels)]; ok {
+		return s.value
+	}
+	return 0
+}
+
+// CounterTotal returns the sum of a counter across all its label values
+func CounterTotal(name string) float64 {
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+
+	total := 0.0
+	for _, s := range defaultRegistry.counters {
+		if s.name == name {
+			total += s.value
+		}
+	}
+	return total
+}
+
+// Handler serves all metrics in the Prometheus text exposition format
+func Handler(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, "text/plain; version=0.0.4")
+	return c.SendString(Render())
+}
+
+// Render returns all metrics in the Prometheus text exposition format
+func Render() string {
+	collectorsMu.Lock()
+	for _, collect := range collectors {
+		collect()
+	}
+	collectorsMu.Unlock()
+
+	defaultRegistry.mu.Lock()
+	defer defaultRegistry.mu.Unlock()
+
+	var b strings.Builder
+	writeFamily(&b, "counter", defaultRegistry.counters)
+	writeFamily(&b, "gauge", defaultRegistry.gauges)
+	return b.String()
+}
+
+func writeFamily(b *strings.Builder, metricType string, series map[string]*sample) {
+	keys := make([]string, 0, len(series))
+	for k := range series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lastName := ""
+	for _, k := range keys {
+		s := series[k]
+		if s.name != lastName {
+			fmt.Fprintf(b, "# TYPE %s %s\n", s.name, metricType)
+			lastName = s.name
+		}
+		fmt.Fprintf(b, "%s %g\n", seriesKey(s.name, s.labels), s.value)
+	}
+}
+
+// seriesKey renders name{label="value",...} with labels in a stable order
+func seriesKey(name string, labels Labels) string {
+	if len(labels) == 0 {
+		return name
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = fmt.Sprintf("%s=%q", k, labels[k])
+	}
+	return name + "{" + strings.Join(parts, ",") + "}"
+}
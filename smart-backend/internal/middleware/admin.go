@@ -1,9 +1,9 @@
 package middleware
 
 import (
-	"log"
-	"ololo-gate/internal/db"
+	"log/slog"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
 	"ololo-gate/internal/utils"
 	"strings"
 
@@ -13,94 +13,114 @@ import (
 // AdminJWTProtected validates admin JWT tokens and checks token version
 func AdminJWTProtected() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		// Get Authorization header
-		authHeader := c.Get("Authorization")
-		if authHeader == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Missing authorization header",
-			})
+		if ok, err := authenticateAdmin(c); !ok {
+			return err
 		}
+		return c.Next()
+	}
+}
 
-		// Check if it starts with "Bearer "
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Invalid authorization header format. Use: Bearer <token>",
-			})
-		}
+// authenticateAdmin validates the admin token and stores the admin in Locals.
+// When it returns false the error response has already been written.
+func authenticateAdmin(c *fiber.Ctx) (bool, error) {
+	// Get Authorization header
+	authHeader := c.Get("Authorization")
+	if authHeader == "" {
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Missing authorization header",
+		})
+	}
 
-		tokenString := parts[1]
+	// Check if it starts with "Bearer "
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Invalid authorization header format. Use: Bearer <token>",
+		})
+	}
 
-		// Validate the admin token
-		claims, err := utils.ValidateAdminToken(tokenString)
-		if err != nil {
-			log.Printf("[ADMIN_TOKEN_VALIDATION] Invalid or expired admin token: %v", err)
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Invalid or expired token",
-			})
-		}
+	tokenString := parts[1]
 
-		log.Printf("[ADMIN_TOKEN_VALIDATION] Admin token validated. Admin ID from claims: %s, Username: %s, Claims token_version: %d",
-			claims.AdminID, claims.Username, claims.TokenVersion)
-
-		// Check if token version matches the database
-		// This invalidates tokens when admin logs in from another device
-		var admin models.Admin
-		if err := db.DB.First(&admin, claims.AdminID).Error; err != nil {
-			log.Printf("[ADMIN_TOKEN_VALIDATION] Admin ID %s not found in database: %v", claims.AdminID, err)
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Token has been invalidated",
-			})
-		}
+	// Validate the admin token
+	claims, err := utils.ValidateAdminToken(tokenString)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "[ADMIN_TOKEN_VALIDATION] Invalid or expired admin token", "error", err)
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Invalid or expired token",
+		})
+	}
 
-		log.Printf("[ADMIN_TOKEN_VALIDATION] Admin found in DB. Admin ID: %s, DB token_version: %d, Claims token_version: %d",
-			admin.ID, admin.TokenVersion, claims.TokenVersion)
+	slog.DebugContext(c.UserContext(), "[ADMIN_TOKEN_VALIDATION] Admin token validated",
+		"admin_id", claims.AdminID, "username", claims.Username, "claims_version", claims.TokenVersion)
+
+	// Check if token version matches the database
+	// This invalidates tokens when admin logs in from another device
+	tokenVersion, err := services.TokenVersions().Admin(claims.AdminID)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "[ADMIN_TOKEN_VALIDATION] Admin not found in database", "admin_id", claims.AdminID, "error", err)
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Token has been invalidated",
+		})
+	}
 
-		if admin.TokenVersion != claims.TokenVersion {
-			log.Printf("[ADMIN_TOKEN_INVALIDATED] Token version mismatch for admin ID %s (username: %s). Token invalidated. Claims version=%d, DB version=%d",
-				admin.ID, claims.Username, claims.TokenVersion, admin.TokenVersion)
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Token has been invalidated",
-			})
-		}
+	slog.DebugContext(c.UserContext(), "[ADMIN_TOKEN_VALIDATION] Admin found in database",
+		"admin_id", claims.AdminID, "db_version", tokenVersion, "claims_version", claims.TokenVersion)
 
-		log.Printf("[ADMIN_TOKEN_VALID] Admin token valid for admin ID=%s (username=%s) with token_version=%d",
-			admin.ID, claims.Username, admin.TokenVersion)
+	if tokenVersion != claims.TokenVersion {
+		slog.WarnContext(c.UserContext(), "[ADMIN_TOKEN_INVALIDATED] Token version mismatch, token invalidated",
+			"admin_id", claims.AdminID, "username", claims.Username, "claims_version", claims.TokenVersion, "db_version", tokenVersion)
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Token has been invalidated",
+		})
+	}
 
-		// Store admin info in context for use in handlers
-		c.Locals("id", claims.AdminID)
-		c.Locals("admin_username", claims.Username)
-		c.Locals("admin_role", claims.Role)
+	slog.DebugContext(c.UserContext(), "[ADMIN_TOKEN_VALID] Admin token valid",
+		"admin_id", claims.AdminID, "username", claims.Username, "token_version", tokenVersion)
 
-		return c.Next()
-	}
+	// Store admin info in context for use in handlers
+	c.Locals("id", claims.AdminID)
+	c.Locals("admin_username", claims.Username)
+	c.Locals("admin_role", claims.Role)
+	c.Locals("admin_claims", claims)
+
+	return true, nil
 }
 
 // SuperAdminOnly middleware checks if the admin has super admin role
 func SuperAdminOnly() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		// Get admin role from context (must run AdminJWTProtected first)
-		role := c.Locals("admin_role")
-
-		if role == nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Authentication required",
-			})
+		if ok, err := requireSuperAdmin(c); !ok {
+			return err
 		}
+		return c.Next()
+	}
+}
 
-		if role != models.RoleSuper {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
-				"success": false,
-				"message": "Super admin access required",
-			})
-		}
+// requireSuperAdmin checks that the authenticated admin has the super role.
+// When it returns false the error response has already been written.
+func requireSuperAdmin(c *fiber.Ctx) (bool, error) {
+	// Get admin role from context (must run AdminJWTProtected first)
+	role := c.Locals("admin_role")
+
+	if role == nil {
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Authentication required",
+		})
+	}
 
-		return c.Next()
+	if role != models.RoleSuper {
+		SetDenialReason(c, models.DenialSuperAdminRequired)
+		return false, c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Super admin access required",
+		})
 	}
+
+	return true, nil
 }
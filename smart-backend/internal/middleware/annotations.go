@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Route access requirements, from weakest to strongest
+const (
+	RequirementPublic           = "public"
+	RequirementProviderToken    = "provider_token"      // Shared secret from the gate provider
+	RequirementAPIKey           = "api_key"             // Machine API key with the rule's scope
+	RequirementUser             = "user"                // User JWT
+	RequirementAdmin            = "admin"               // Admin JWT (any role)
+	RequirementSelfOrSuperAdmin = "self_or_super_admin" // Admin JWT for the admin named in the path, or with the super role
+	RequirementSuperAdmin       = "super_admin"         // Admin JWT with the super role
+)
+
+var requirementRank = map[string]int{
+	RequirementPublic:           0,
+	RequirementProviderToken:    1,
+	RequirementAPIKey:           1,
+	RequirementUser:             2,
+	RequirementAdmin:            3,
+	RequirementSelfOrSuperAdmin: 4,
+	RequirementSuperAdmin:       5,
+}
+
+var (
+	annotationsMu sync.RWMutex
+	annotations   = map[string]string{}
+)
+
+func init() {
+	// Handlers built by the same factory share their code, so one instance annotates them all
+	Annotate(JWTProtected(), RequirementUser)
+	Annotate(AdminJWTProtected(), RequirementAdmin)
+	Annotate(SuperAdminOnly(), RequirementSuperAdmin)
+}
+
+// Annotate records the access requirement enforced by a middleware or handler so the route
+// listing can report it. Use it for handlers that authenticate callers themselves.
+func Annotate(handler interface{}, requirement string) {
+	annotationsMu.Lock()
+	defer annotationsMu.Unlock()
+	annotations[HandlerName(handler)] = requirement
+}
+
+// Requirement returns the access requirement recorded for a handler, if any
+func Requirement(handler interface{}) (string, bool) {
+	annotationsMu.RLock()
+	defer annotationsMu.RUnlock()
+	requirement, ok := annotations[HandlerName(handler)]
+	return requirement, ok
+}
+
+// StrongestRequirement returns the strictest of the given requirements (public if none)
+func StrongestRequirement(requirements []string) string {
+	strongest := RequirementPublic
+	for _, r := range requirements {
+		if requirementRank[r] > requirementRank[strongest] {
+			strongest = r
+		}
+	}
+	return strongest
+}
+
+// HandlerName returns a short name for a handler function, e.g. "middleware.JWTProtected"
+// for the closure returned by JWTProtected()
+func HandlerName(handler interface{}) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(handler).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	// Closures are named Factory.func1 (or Factory.New.func1 for nested ones)
+	if i := strings.Index(name, ".func"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// authenticateAPIKey validates a machine API key sent as a bearer token, checks it grants scope
+// and stores the key in Locals. Actions are attributed to "api_key:<name>".
+// When it returns false the error response has already been written.
+func authenticateAPIKey(c *fiber.Ctx, scope string) (bool, error) {
+	key, found := strings.CutPrefix(c.Get("Authorization"), "Bearer ")
+	if !found || key == "" {
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Missing API key. Use: Authorization: Bearer <key>",
+		})
+	}
+
+	apiKey, err := services.AuthenticateAPIKey(key)
+	if errors.Is(err, services.ErrAPIKeyInvalid) {
+		slog.WarnContext(c.UserContext(), "[API_KEY] Rejected invalid or revoked API key", "ip", c.IP())
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Invalid or revoked API key",
+		})
+	}
+	if err != nil {
+		slog.ErrorContext(c.UserContext(), "[API_KEY] Failed to check API key", "error", err)
+		return false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Failed to check API key",
+		})
+	}
+	c.Locals("api_key_id", apiKey.ID)
+	c.Locals("admin_username", "api_key:"+apiKey.Name)
+
+	if scope != "" && !apiKey.HasScope(scope) {
+		slog.WarnContext(c.UserContext(), "[API_KEY] Key lacks scope",
+			"key_id", apiKey.ID, "name", apiKey.Name, "scope", scope, "method", c.Method(), "path", c.Path())
+		SetDenialReason(c, models.DenialAPIKeyScope)
+		return false, c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "API key does not grant access to this endpoint",
+		})
+	}
+	return true, nil
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"encoding/json"
+	"ololo-gate/internal/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// auditCaptureKey holds the audit entries recorded while a captured request is handled
+const auditCaptureKey = "audit_capture"
+
+// auditEntry is an audit log entry recorded by a handler, written once the response is known
+type auditEntry struct {
+	action       string
+	resourceType string
+	resourceID   string
+	status       string
+	errorMessage string
+}
+
+// AuditCapture audits the routes whose AccessPolicy rule sets Audit. The request and response
+// bodies are captured with passwords, tokens and other secrets redacted, and stored as the
+// details of every entry the handler records with RecordAudit. If the handler records nothing,
+// a generic "api_request" entry is written so the call is still audited.
+func AuditCapture() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		rule, ok := PolicyFor(c.Method(), c.Path())
+		if !ok || !rule.Audit {
+			return c.Next()
+		}
+
+		request := utils.RedactJSON(c.Body())
+		entries := &[]auditEntry{}
+		c.Locals(auditCaptureKey, entries)
+
+		if err := c.Next(); err != nil {
+			// Render the error now so the captured response is the one the client gets
+			if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+				return handlerErr
+			}
+		}
+
+		status := c.Response().StatusCode()
+		details, _ := json.Marshal(map[string]interface{}{
+			"request":     request,
+			"response":    utils.RedactJSON(c.Response().Body()),
+			"status_code": status,
+		})
+
+		if len(*entries) == 0 {
+			entry := auditEntry{action: "api_request", resourceType: "route", resourceID: c.Method() + " " + c.Route().Path, status: "success"}
+			if status >= fiber.StatusBadRequest {
+				entry.status = "failed"
+			}
+			*entries = append(*entries, entry)
+		}
+
+		adminID, adminUsername := auditActor(c)
+		for _, entry := range *entries {
+			utils.LogAdminAction(adminID, adminUsername, entry.action, entry.resourceType, entry.resourceID, string(details), c.IP(), c.Get("User-Agent"), entry.status, entry.errorMessage)
+		}
+		return nil
+	}
+}
+
+// RecordAudit records an admin action. On routes captured by AuditCapture the entry is written
+// after the response, with the redacted request and response as details; elsewhere it is
+// written immediately without details.
+func RecordAudit(c *fiber.Ctx, action, resourceType, resourceID, status, errorMessage string) {
+	if entries, ok := c.Locals(auditCaptureKey).(*[]auditEntry); ok {
+		*entries = append(*entries, auditEntry{action, resourceType, resourceID, status, errorMessage})
+		return
+	}
+	adminID, adminUsername := auditActor(c)
+	utils.LogAdminAction(adminID, adminUsername, action, resourceType, resourceID, "", c.IP(), c.Get("User-Agent"), status, errorMessage)
+}
+
+// auditActor returns the authenticated admin, or uuid.Nil and "unknown"
+func auditActor(c *fiber.Ctx) (uuid.UUID, string) {
+	adminUsername, ok := c.Locals("admin_username").(string)
+	if !ok {
+		adminUsername = "unknown"
+	}
+	adminID, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		adminID = uuid.Nil
+	}
+	return adminID, adminUsername
+}
@@ -1,81 +1,155 @@
 package middleware
 
 import (
-	"log"
-	"ololo-gate/internal/db"
+	"log/slog"
 	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
 	"ololo-gate/internal/utils"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
+// LegalAcceptanceHeader is set on authenticated user responses, listing the legal document kinds
+// ("terms", "privacy") whose current version the user has not accepted yet
+const LegalAcceptanceHeader = "X-Legal-Acceptance-Required"
+
 // JWTProtected is a middleware that validates JWT access tokens
 func JWTProtected() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		// Get Authorization header
-		authHeader := c.Get("Authorization")
-		if authHeader == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Missing authorization header",
-			})
+		if ok, err := authenticateUser(c); !ok {
+			return err
 		}
-
-		// Check if it starts with "Bearer "
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Invalid authorization header format. Use: Bearer <token>",
-			})
+		if isImpersonating(c) {
+			return auditImpersonatedRequest(c)
 		}
+		return c.Next()
+	}
+}
 
-		tokenString := parts[1]
+// authenticateUser validates the user access token and stores the user in Locals.
+// When it returns false the error response has already been written.
+func authenticateUser(c *fiber.Ctx) (bool, error) {
+	// Get Authorization header
+	authHeader := c.Get("Authorization")
+	if authHeader == "" {
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Missing authorization header",
+		})
+	}
 
-		// Validate the token
-		claims, err := utils.ValidateToken(tokenString, utils.AccessToken)
-		if err != nil {
-			log.Printf("[TOKEN_VALIDATION] Invalid or expired access token: %v", err)
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"success": false,
-				"message": "Invalid or expired token",
-			})
-		}
+	// Check if it starts with "Bearer "
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Invalid authorization header format. Use: Bearer <token>",
+		})
+	}
+
+	tokenString := parts[1]
+
+	// Validate the token
+	claims, err := utils.ValidateToken(tokenString, utils.AccessToken)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "[TOKEN_VALIDATION] Invalid or expired access token", "error", err)
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Invalid or expired token",
+		})
+	}
+
+	slog.DebugContext(c.UserContext(), "[TOKEN_VALIDATION] Access token validated",
+		"user_id", claims.UserID, "phone", claims.Phone, "claims_version", claims.TokenVersion)
 
-		log.Printf("[TOKEN_VALIDATION] Access token validated. User ID from claims: %s, Phone: %s, Claims token_version: %d",
-			claims.UserID, claims.Phone, claims.TokenVersion)
+	// Verify token version and account status against database (cached for a few seconds)
+	state, err := services.TokenVersions().User(claims.UserID)
+	if err != nil {
+		slog.WarnContext(c.UserContext(), "[TOKEN_VALIDATION] User not found in database", "user_id", claims.UserID, "error", err)
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "User not found",
+		})
+	}
 
-		// Verify token version against database
-		var user models.User
-		if err := db.DB.Select("id", "token_version").First(&user, claims.UserID).Error; err != nil {
-			log.Printf("[TOKEN_VALIDATION] User ID %s not found in database: %v", claims.UserID, err)
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+	slog.DebugContext(c.UserContext(), "[TOKEN_VALIDATION] User found in database",
+		"user_id", claims.UserID, "db_version", state.TokenVersion, "claims_version", claims.TokenVersion)
+
+	// Check if token version matches
+	if state.TokenVersion != claims.TokenVersion {
+		slog.WarnContext(c.UserContext(), "[TOKEN_INVALIDATED] Token version mismatch, token invalidated",
+			"user_id", claims.UserID, "phone", claims.Phone, "claims_version", claims.TokenVersion, "db_version", state.TokenVersion)
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Token has been invalidated. Please login again.",
+		})
+	}
+
+	// Trashed users and registrations that are not approved cannot use their tokens
+	if !state.Active() {
+		slog.WarnContext(c.UserContext(), "[TOKEN_INVALIDATED] User is not active",
+			"user_id", claims.UserID, "trashed", state.Trashed, "registration_status", state.RegistrationStatus)
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Account is not active",
+		})
+	}
+
+	// Check that this device's session has not been logged out
+	var session *models.UserSession
+	if claims.SessionID != uuid.Nil {
+		session, err = services.TokenVersions().Session(claims.SessionID, claims.UserID)
+		if err != nil {
+			slog.WarnContext(c.UserContext(), "[TOKEN_INVALIDATED] Session is no longer active",
+				"session_id", claims.SessionID, "user_id", claims.UserID)
+			return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"success": false,
-				"message": "User not found",
+				"message": "Session has been revoked. Please login again.",
 			})
 		}
+	}
 
-		log.Printf("[TOKEN_VALIDATION] User found in DB. User ID: %s, DB token_version: %d, Claims token_version: %d",
-			user.ID, user.TokenVersion, claims.TokenVersion)
+	// A token copied off one device must not be replayable from another
+	if err := services.VerifySessionDevice(session, claims.DeviceID, c.Get("X-Device-ID")); err != nil {
+		slog.WarnContext(c.UserContext(), "[TOKEN_DEVICE_MISMATCH] Token presented from another device",
+			"user_id", claims.UserID, "device_id", claims.DeviceID, "presented_device_id", c.Get("X-Device-ID"))
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Token is not valid for this device",
+		})
+	}
 
-		// Check if token version matches
-		if user.TokenVersion != claims.TokenVersion {
-			log.Printf("[TOKEN_INVALIDATED] Token version mismatch for user ID %s (phone: %s). Token invalidated. Claims version=%d, DB version=%d",
-				user.ID, claims.Phone, claims.TokenVersion, user.TokenVersion)
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+	// Impersonation tokens act as the user but stay attributed to the super admin they were issued to
+	if claims.Impersonator != "" {
+		admin, err := impersonatingAdmin(claims.Impersonator)
+		if err != nil {
+			slog.WarnContext(c.UserContext(), "[TOKEN_INVALIDATED] Impersonation token is no longer valid",
+				"user_id", claims.UserID, "impersonator", claims.Impersonator, "error", err)
+			return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"success": false,
-				"message": "Token has been invalidated. Please login again.",
+				"message": "Impersonation is no longer allowed for this admin",
 			})
 		}
+		c.Locals("impersonator_id", admin.ID)
+		c.Locals("impersonator_username", admin.Username)
+		c.Locals("impersonation_blocks_gates", claims.BlockGates)
+	}
 
-		log.Printf("[TOKEN_VALID] Access token valid for user ID=%s (phone=%s) with token_version=%d",
-			user.ID, claims.Phone, user.TokenVersion)
-
-		// Store user info in context for use in handlers
-		c.Locals("id", claims.UserID)
-		c.Locals("phone", claims.Phone)
+	slog.DebugContext(c.UserContext(), "[TOKEN_VALID] Access token valid",
+		"user_id", claims.UserID, "phone", claims.Phone, "token_version", state.TokenVersion)
 
-		return c.Next()
+	// Signal that the current terms of service or privacy policy still have to be accepted
+	if len(state.PendingLegalKinds) > 0 {
+		c.Set(LegalAcceptanceHeader, strings.Join(state.PendingLegalKinds, ","))
 	}
+
+	// Store user info in context for use in handlers
+	c.Locals("id", claims.UserID)
+	c.Locals("phone", claims.Phone)
+	c.Locals("session_id", claims.SessionID)
+	c.Locals("device_id", claims.DeviceID)
+
+	return true, nil
 }
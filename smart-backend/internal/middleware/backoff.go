@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Retry strategies sent in the retry_strategy field of throttled and degraded responses
+const (
+	RetryStrategyFixed       = "fixed"       // Retry once after retry_after_seconds, when the limit resets
+	RetryStrategyExponential = "exponential" // Retry after retry_after_seconds, then back off exponentially with jitter
+	RetryStrategyPoll        = "poll"        // Do not resend the request; poll the resource's status instead
+)
+
+// retryMaxAttempts is how many exponential retries clients make before giving up on an outage
+const retryMaxAttempts = 5
+
+// RetryStrategy tells clients when and how to retry a throttled or degraded response
+// @name RetryStrategy
+type RetryStrategy struct {
+	Strategy          string `json:"strategy" example:"exponential"`     // fixed, exponential or poll
+	RetryAfterSeconds int    `json:"retry_after_seconds" example:"30"`   // Same as the Retry-After header
+	ResetAt           int64  `json:"reset_at" example:"1760610645"`      // Unix time of the earliest retry, same as X-RateLimit-Reset
+	MaxAttempts       int    `json:"max_attempts,omitempty" example:"5"` // Exponential only: give up after this many retries
+}
+
+// Backoff sets the Retry-After and X-RateLimit-Reset headers of a throttled or degraded
+// response and returns the retry strategy for its body
+func Backoff(c *fiber.Ctx, strategy string, retryAfter time.Duration) *RetryStrategy {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	resetAt := time.Now().Add(time.Duration(seconds) * time.Second).Unix()
+
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(seconds))
+	c.Set("X-RateLimit-Reset", strconv.FormatInt(resetAt, 10))
+
+	retry := &RetryStrategy{Strategy: strategy, RetryAfterSeconds: seconds, ResetAt: resetAt}
+	if strategy == RetryStrategyExponential {
+		retry.MaxAttempts = retryMaxAttempts
+	}
+	return retry
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+// adminPathPrefix selects the stricter admin CORS policy
+const adminPathPrefix = "/api/v1/admin"
+
+// corsPolicy is a CORS handler that is rebuilt when its configured origins change
+// (e.g. on a config reload). An invalid new value keeps the previous handler.
+type corsPolicy struct {
+	scope   string
+	origins func() string
+	build   func(origins string) fiber.Handler
+
+	mu      sync.Mutex
+	current string
+	handler fiber.Handler
+}
+
+// CORS applies the CORS policy for the request path. Public endpoints allow the origins in
+// CORS_ALLOWED_ORIGINS (wildcard allowed) plus public origins added by super admins.
+// /api/v1/admin/* only allows explicitly listed origins: CORS_ADMIN_ALLOWED_ORIGINS plus
+// admin origins added by super admins, never a wildcard. /swagger has its own policy, see SwaggerAccess.
+func CORS() fiber.Handler {
+	public := &corsPolicy{
+		scope:   models.CORSScopePublic,
+		origins: func() string { return config.AppConfig().CORS.AllowedOrigins },
+		build:   newPublicCORSHandler,
+	}
+	admin := &corsPolicy{
+		scope:   models.CORSScopeAdmin,
+		origins: adminCORSOrigins,
+		build:   newAdminCORSHandler,
+	}
+
+	return func(c *fiber.Ctx) error {
+		// SwaggerAccess answers cross-origin requests for the API docs
+		if strings.HasPrefix(c.Path(), swaggerPathPrefix) {
+			return c.Next()
+		}
+		if strings.HasPrefix(c.Path(), adminPathPrefix) {
+			return admin.handle(c)
+		}
+		return public.handle(c)
+	}
+}
+
+// handle runs the current CORS handler, rebuilding it if the origins changed
+func (p *corsPolicy) handle(c *fiber.Ctx) error {
+	origins := p.origins()
+
+	p.mu.Lock()
+	if p.handler == nil || origins != p.current {
+		if next, err := p.tryBuild(origins); err != nil {
+			slog.WarnContext(c.UserContext(), "[CORS] Keeping previous policy", "scope", p.scope, "error", err)
+		} else {
+			p.handler = next
+		}
+		p.current = origins
+	}
+	h := p.handler
+	p.mu.Unlock()
+
+	if h == nil {
+		// No valid policy yet: send no CORS headers, so browsers block cross-origin calls
+		return c.Next()
+	}
+	return h(c)
+}
+
+// tryBuild builds the handler, turning the panic cors.New raises on malformed origins into an error
+func (p *corsPolicy) tryBuild(origins string) (handler fiber.Handler, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid %s CORS origins %q: %v", p.scope, origins, r)
+		}
+	}()
+	return p.build(origins), nil
+}
+
+// adminCORSOrigins returns the configured admin origins. Without CORS_ADMIN_ALLOWED_ORIGINS the
+// public list is reused unless it is a wildcard.
+func adminCORSOrigins() string {
+	cfg := config.AppConfig().CORS
+	origins := cfg.AdminAllowedOrigins
+	if origins == "" && cfg.AllowedOrigins != "*" {
+		origins = cfg.AllowedOrigins
+	}
+	if strings.Contains(origins, "*") {
+		return ""
+	}
+	return origins
+}
+
+func newPublicCORSHandler(origins string) fiber.Handler {
+	cfg := cors.Config{
+		AllowOrigins:     origins,
+		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+		AllowHeaders:     "Origin,Content-Type,Accept,Authorization," + TimezoneHeader,
+		ExposeHeaders:    "Content-Length," + LegalAcceptanceHeader + "," + SandboxHeader + "," + TimezoneHeader + "," + FaultHeader,
+		MaxAge:           86400,          // 24 hours preflight cache
+		AllowCredentials: origins != "*", // Only allow credentials if not using wildcard
+	}
+	if origins != "*" {
+		cfg.AllowOriginsFunc = func(origin string) bool {
+			return services.CORSOrigins().Allowed(models.CORSScopePublic, origin)
+		}
+	}
+	return cors.New(cfg)
+}
+
+func newAdminCORSHandler(origins string) fiber.Handler {
+	return cors.New(cors.Config{
+		AllowOrigins: origins,
+		AllowOriginsFunc: func(origin string) bool {
+			return services.CORSOrigins().Allowed(models.CORSScopeAdmin, origin)
+		},
+		AllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+		AllowHeaders:     "Origin,Content-Type,Accept,Authorization," + TimezoneHeader,
+		ExposeHeaders:    "Content-Length," + SandboxHeader + "," + TimezoneHeader + "," + FaultHeader,
+		MaxAge:           600, // 10 minutes, so removed origins stop working quickly
+		AllowCredentials: true,
+	})
+}
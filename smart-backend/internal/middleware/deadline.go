@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"context"
+	"ololo-gate/internal/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequestDeadline gives each request a context that ends after REQUEST_TIMEOUT, when the handler
+// returns or when the server shuts down, and makes it the request's c.UserContext(). Handlers pass
+// it to their database queries and provider calls, so work nobody waits for any more is abandoned.
+// fasthttp does not report clients that disconnect mid-request, so the deadline is what bounds it.
+// Streamed response bodies are written after the handler returns and must not use it.
+func RequestDeadline() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var ctx context.Context
+		var cancel context.CancelFunc
+		if timeout := config.AppConfig().Server.RequestTimeout; timeout > 0 {
+			ctx, cancel = context.WithTimeout(c.UserContext(), timeout)
+		} else {
+			ctx, cancel = context.WithCancel(c.UserContext())
+		}
+		defer cancel()
+		stop := context.AfterFunc(c.Context(), cancel)
+		defer stop()
+
+		c.SetUserContext(ctx)
+		return c.Next()
+	}
+}
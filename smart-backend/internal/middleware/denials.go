@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// denialReasonKey holds the reason of a 403 set with SetDenialReason
+const denialReasonKey = "denial_reason"
+
+// SetDenialReason tags the 403 about to be returned with one of the models.Denial* reasons, for
+// the security event AuditDenials records
+func SetDenialReason(c *fiber.Ctx, reason string) {
+	c.Locals(denialReasonKey, reason)
+}
+
+// AuditDenials records every request refused with 403, by the access policy or a handler, as a
+// security event with the caller, the route and the reason (GET /admin/security/denials). It
+// runs ahead of Authorize and reads the caller it authenticated.
+func AuditDenials() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+
+		// Errors returned to the error handler have not set the response status yet
+		status := c.Response().StatusCode()
+		message := ""
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status, message = fiberErr.Code, fiberErr.Message
+			}
+		}
+		if status != fiber.StatusForbidden {
+			return err
+		}
+		if message == "" {
+			message = denialMessage(c.Response().Body())
+		}
+
+		reason, _ := c.Locals(denialReasonKey).(string)
+		if reason == "" {
+			reason = models.DenialForbidden
+		}
+		route := c.Path()
+		if rule, ok := PolicyFor(c.Method(), c.Path()); ok {
+			route = rule.Path
+		}
+
+		denial := &models.SecurityDenial{
+			Method:    c.Method(),
+			Route:     route,
+			Path:      c.Path(),
+			Reason:    reason,
+			Message:   message,
+			IPAddress: c.IP(),
+			UserAgent: c.Get("User-Agent"),
+		}
+		setDenialActor(c, denial)
+		services.RecordSecurityDenial(denial)
+		return err
+	}
+}
+
+// setDenialActor fills in the caller Authorize authenticated, if any
+func setDenialActor(c *fiber.Ctx, denial *models.SecurityDenial) {
+	denial.ActorType = models.DenialActorAnonymous
+	if keyID, ok := c.Locals("api_key_id").(uuid.UUID); ok {
+		denial.ActorType = models.DenialActorAPIKey
+		denial.ActorID = &keyID
+		denial.ActorName, _ = c.Locals("admin_username").(string)
+		return
+	}
+	id, ok := c.Locals("id").(uuid.UUID)
+	if !ok {
+		return
+	}
+	denial.ActorID = &id
+	if role, ok := c.Locals("admin_role").(string); ok {
+		denial.ActorType = models.DenialActorAdmin
+		denial.ActorRole = role
+		denial.ActorName, _ = c.Locals("admin_username").(string)
+		return
+	}
+	denial.ActorType = models.DenialActorUser
+	denial.ActorName, _ = c.Locals("impersonator_username").(string)
+}
+
+// denialMessage returns the message of a JSON error response: "message" for v1, "detail" for
+// problem+json
+func denialMessage(body []byte) string {
+	var response struct {
+		Message string `json:"message"`
+		Detail  string `json:"detail"`
+	}
+	if json.Unmarshal(body, &response) != nil {
+		return ""
+	}
+	if response.Message != "" {
+		return response.Message
+	}
+	return response.Detail
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/utils"
+	"runtime/debug"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrorReporting recovers panics and reports them, along with every 5xx response, to Sentry
+// with the request, the release/environment tags and the authenticated user or admin.
+// It replaces the recover middleware so panics are no longer swallowed silently.
+func ErrorReporting() fiber.Handler {
+	return func(c *fiber.Ctx) (err error) {
+		hub := sentry.CurrentHub().Clone()
+
+		defer func() {
+			if r := recover(); r != nil {
+				slog.ErrorContext(c.UserContext(), "[PANIC] Request panicked",
+					"method", c.Method(), "path", c.Path(), "panic", r, "stack", string(debug.Stack()))
+				configureReportScope(hub, c)
+				hub.RecoverWithContext(c.UserContext(), r)
+				err = c.App().ErrorHandler(c, fmt.Errorf("panic: %v", r))
+			}
+		}()
+
+		if nextErr := c.Next(); nextErr != nil {
+			// Render the error now so the final status code is known
+			if handlerErr := c.App().ErrorHandler(c, nextErr); handlerErr != nil {
+				return handlerErr
+			}
+			if c.Response().StatusCode() >= fiber.StatusInternalServerError {
+				configureReportScope(hub, c)
+				hub.CaptureException(nextErr)
+			}
+			return nil
+		}
+
+		if status := c.Response().StatusCode(); status >= fiber.StatusInternalServerError {
+			configureReportScope(hub, c)
+			hub.CaptureMessage(fmt.Sprintf("%d %s %s", status, c.Method(), c.Route().Path))
+		}
+		return nil
+	}
+}
+
+// configureReportScope attaches the request and the authenticated principal to reported events.
+// Secret query parameters (admin tokens, gate link signatures) are redacted.
+func configureReportScope(hub *sentry.Hub, c *fiber.Ctx) {
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		request := &sentry.Request{
+			URL:         c.BaseURL() + c.Path(),
+			Method:      c.Method(),
+			QueryString: utils.RedactQuery(string(c.Request().URI().QueryString())),
+			Headers:     map[string]string{"User-Agent": c.Get(fiber.HeaderUserAgent), fiber.HeaderXRequestID: c.GetRespHeader(fiber.HeaderXRequestID)},
+		}
+		scope.AddEventProcessor(func(event *sentry.Event, hint *sentry.EventHint) *sentry.Event {
+			event.Request = request
+			return event
+		})
+		scope.SetTag("route", c.Route().Path)
+		scope.SetTag("status", fmt.Sprint(c.Response().StatusCode()))
+
+		id := c.Locals("id")
+		switch {
+		case id != nil && c.Locals("admin_username") != nil:
+			scope.SetUser(sentry.User{ID: fmt.Sprint(id), Username: fmt.Sprint(c.Locals("admin_username"))})
+			scope.SetTag("principal", "admin")
+			scope.SetTag("admin_role", fmt.Sprint(c.Locals("admin_role")))
+		case id != nil:
+			scope.SetUser(sentry.User{ID: fmt.Sprint(id)})
+			scope.SetTag("principal", "user")
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"log/slog"
+	"math/rand/v2"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// FaultHeader lists the faults injected into a response (latency, provider_error or db_error), so
+// rehearsal traffic can be told from real failures
+const FaultHeader = "X-Fault-Injected"
+
+// Kinds of injected faults, in FaultHeader and the faults_injected_total{kind} metric
+const (
+	FaultLatency       = "latency"
+	FaultProviderError = "provider_error"
+	FaultDBError       = "db_error"
+)
+
+// faultRetryAfter is the retry delay of injected provider errors while the circuit breaker is closed
+const faultRetryAfter = 2 * time.Second
+
+// faultRoll returns a random percentage in [0, 100), so a rate of 0 never injects and 100 always does
+func faultRoll() float64 {
+	return rand.Float64() * 100
+}
+
+// InjectFaults delays or fails requests at the rates configured for staging (FAULT_INJECTION).
+// A request may be delayed and then still fail. Injected provider errors answer like a handler
+// that found the provider unavailable and count as a circuit breaker failure, so high rates open
+// the breaker and real requests fall back to cached locations and queued commands. Injected
+// database errors answer like a failed query. Either way the handler does not run.
+func InjectFaults() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cfg := config.AppConfig().Faults
+		if !cfg.Enabled || !faultTargeted(cfg.Routes, c.Method(), c.Path()) {
+			return c.Next()
+		}
+
+		if cfg.Latency > 0 && faultRoll() < cfg.LatencyRate {
+			delay := rand.N(cfg.Latency)
+			injectedFault(c, FaultLatency)
+			time.Sleep(delay)
+		}
+
+		if faultRoll() < cfg.ProviderErrorRate {
+			injectedFault(c, FaultProviderError)
+			services.ProviderBreaker().Failure()
+			retryAfter := services.ProviderBreaker().RetryAfter()
+			if retryAfter <= 0 {
+				retryAfter = faultRetryAfter
+			}
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"success": false,
+				"message": "Gate provider unavailable",
+				"data": fiber.Map{
+					"kind":        string(services.UpstreamUnavailable),
+					"operation":   "fault_injection",
+					"status_code": 0,
+					"detail":      "injected fault",
+				},
+				"retry_strategy": Backoff(c, RetryStrategyExponential, retryAfter),
+			})
+		}
+
+		if faultRoll() < cfg.DBErrorRate {
+			injectedFault(c, FaultDBError)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Internal server error",
+			})
+		}
+
+		return c.Next()
+	}
+}
+
+// faultTargeted reports whether faults are injected into requests with method and path
+func faultTargeted(routes []config.FaultRoute, method, path string) bool {
+	if len(routes) == 0 {
+		return true
+	}
+	for _, route := range routes {
+		if (route.Method == "" || route.Method == method) && matchPolicyPath(route.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// injectedFault marks the response with a fault about to be injected
+func injectedFault(c *fiber.Ctx, kind string) {
+	c.Append(FaultHeader, kind)
+	metrics.IncCounter("faults_injected_total", metrics.Labels{"kind": kind})
+	slog.InfoContext(c.UserContext(), "[FAULT_INJECTION] Injecting fault", "kind", kind, "method", c.Method(), "path", c.Path())
+}
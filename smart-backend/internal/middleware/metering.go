@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// MeterUsage counts API calls and active users for usage metering
+func MeterUsage() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+
+		meter := services.Meter()
+		meter.Add(models.UsageAPICalls, 1)
+
+		// JWTProtected stores the phone alongside the ID; admin tokens do not count as active users
+		if userID, ok := c.Locals("id").(uuid.UUID); ok && c.Locals("phone") != nil {
+			meter.RecordActiveUser(userID)
+		}
+
+		return err
+	}
+}
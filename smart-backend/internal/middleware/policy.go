@@ -0,0 +1,312 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/services"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// AccessRule maps a route to the access it requires
+type AccessRule struct {
+	Method     string // HTTP method, or "*" for any method
+	Path       string // Route pattern: ":name" matches one segment, a trailing "*" matches any remainder
+	Require    string // One of the Requirement* constants
+	OwnerParam string // For RequirementSelfOrSuperAdmin: the path parameter holding the admin ID
+	Scope      string // For RequirementAPIKey: the scope the key must grant
+	Audit      bool   // High-risk route: AuditCapture stores the redacted request and response in the audit log
+}
+
+// AccessPolicy is the access required by every /api/v1 route. Rules are evaluated in order
+// and the first match wins, so specific rules go before wildcards. Routes without a rule are
+// rejected, and UncoveredRoutes reports them at startup.
+var AccessPolicy = []AccessRule{
+	// Authentication
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/register", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/login", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/refresh", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/login-otp/request", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/login-otp/confirm", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/verify-otp", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/verify-otp/resend", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/change-password", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/forgot-password", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/reset-password", Require: RequirementPublic},
+	{Method: fiber.MethodGet, Path: "/api/v1/auth/check-phone", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/auth/logout", Require: RequirementUser},
+	{Method: "*", Path: "/api/v1/auth/sessions/*", Require: RequirementUser},
+	{Method: "*", Path: "/api/v1/auth/legal/*", Require: RequirementUser},
+	{Method: "*", Path: "/api/v1/auth/notification-preferences", Require: RequirementUser},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/login", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/login/passkey/options", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/login/passkey", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/refresh", Require: RequirementPublic},
+
+	// User management
+	{Method: fiber.MethodPost, Path: "/api/v1/users", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/bulk-delete", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodPatch, Path: "/api/v1/users/:id", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodDelete, Path: "/api/v1/users/:id", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/:id/restore", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/:id/retry-assignment", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/:id/phones", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodDelete, Path: "/api/v1/users/:id/phones/:phoneId", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/users/:id/merge", Require: RequirementSuperAdmin, Audit: true},
+	{Method: "*", Path: "/api/v1/users/*", Require: RequirementAdmin},
+
+	// Admin account management
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/users", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/users", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/users/import", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/users/:id/history", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/users/:id/passkeys", Require: RequirementSelfOrSuperAdmin, OwnerParam: "id"},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/users/:id/passkeys/options", Require: RequirementSelfOrSuperAdmin, OwnerParam: "id"},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/users/:id/passkeys", Require: RequirementSelfOrSuperAdmin, OwnerParam: "id", Audit: true},
+	{Method: "*", Path: "/api/v1/admin/users/:id/passkeys/:credentialId", Require: RequirementSelfOrSuperAdmin, OwnerParam: "id", Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/users/:id", Require: RequirementSelfOrSuperAdmin, OwnerParam: "id"},
+	{Method: fiber.MethodPatch, Path: "/api/v1/admin/users/:id", Require: RequirementSelfOrSuperAdmin, OwnerParam: "id", Audit: true},
+	{Method: fiber.MethodDelete, Path: "/api/v1/admin/users/:id", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/impersonate/:userId", Require: RequirementSuperAdmin, Audit: true},
+
+	// Gates
+	{Method: "*", Path: "/api/v1/locations/*", Require: RequirementUser},
+	{Method: fiber.MethodPost, Path: "/api/v1/gates/:gateId/report", Require: RequirementUser},
+	{Method: fiber.MethodGet, Path: "/api/v1/links/:id", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/gate-commands/:id/callback", Require: RequirementProviderToken},
+	{Method: fiber.MethodGet, Path: "/api/v1/gate-commands/:id", Require: RequirementUser},
+	{Method: fiber.MethodGet, Path: "/api/v1/available-locations", Require: RequirementAdmin},
+
+	// Admin panel
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/notifications/:id/comments", Require: RequirementAdmin, Audit: true},
+	{Method: "*", Path: "/api/v1/admin/notifications/*", Require: RequirementAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/feed", Require: RequirementAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/jobs", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/digest/runs", Require: RequirementAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/usage", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/provider-usage", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/slo", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/reports", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/provider-migration/report", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/gate-events", Require: RequirementAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/gate-events/export", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/exports", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/exports/:id", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/forced-logouts", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/forced-logouts/:id", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/stale-devices", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/routes", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/config/reload", Require: RequirementSuperAdmin},
+	{Method: "*", Path: "/api/v1/admin/cors-origins/*", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/invite-codes", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodDelete, Path: "/api/v1/admin/invite-codes/:id", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/invite-codes", Require: RequirementAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/registrations", Require: RequirementAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/registrations/:id/approve", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/registrations/:id/reject", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/locations/freezes", Require: RequirementAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/locations/:id/freeze", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodDelete, Path: "/api/v1/admin/locations/:id/freeze", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/locations/overrides", Require: RequirementAdmin},
+	{Method: fiber.MethodPut, Path: "/api/v1/admin/locations/:id/override", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodDelete, Path: "/api/v1/admin/locations/:id/override", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodPut, Path: "/api/v1/admin/gates/:gateId/open", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/gates/maintenance", Require: RequirementAdmin},
+	{Method: fiber.MethodPut, Path: "/api/v1/admin/gates/:gateId/maintenance", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodDelete, Path: "/api/v1/admin/gates/:gateId/maintenance", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/gate-reports", Require: RequirementAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/gate-reports/:id/photo", Require: RequirementAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/gate-reports/:id/resolve", Require: RequirementAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/search", Require: RequirementAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/audit-logs/:id/comments", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/audit-logs/*", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/security/denials", Require: RequirementSuperAdmin},
+
+	// Legal documents
+	{Method: fiber.MethodGet, Path: "/api/v1/legal/*", Require: RequirementPublic},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/legal", Require: RequirementSuperAdmin, Audit: true},
+
+	// Stored files (signed URLs carry their own authorization)
+	{Method: fiber.MethodGet, Path: "/api/v1/files/*", Require: RequirementPublic},
+
+	// Machine API keys and SCIM provisioning
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/api-keys", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/api-keys", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodDelete, Path: "/api/v1/admin/api-keys/:id", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/webhook-secrets", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodPost, Path: "/api/v1/admin/webhook-secrets/:scope/rotate", Require: RequirementSuperAdmin, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/admin/sandbox/sms", Require: RequirementSuperAdmin},
+	{Method: fiber.MethodGet, Path: "/api/v1/scim/v2/*", Require: RequirementAPIKey, Scope: models.APIKeyScopeSCIM},
+	{Method: "*", Path: "/api/v1/scim/v2/*", Require: RequirementAPIKey, Scope: models.APIKeyScopeSCIM, Audit: true},
+	{Method: fiber.MethodGet, Path: "/api/v1/sync/changes", Require: RequirementAPIKey, Scope: models.APIKeyScopeSync},
+
+	// Contact information
+	{Method: fiber.MethodGet, Path: "/api/v1/contacts", Require: RequirementPublic},
+	{Method: fiber.MethodPatch, Path: "/api/v1/contacts", Require: RequirementAdmin, Audit: true},
+}
+
+// PolicyFor returns the first rule matching the method and path (a request path or a route pattern)
+func PolicyFor(method, path string) (AccessRule, bool) {
+	if method == fiber.MethodHead {
+		method = fiber.MethodGet
+	}
+	for _, rule := range AccessPolicy {
+		if (rule.Method == "*" || rule.Method == method) && matchPolicyPath(rule.Path, path) {
+			return rule, true
+		}
+	}
+	return AccessRule{}, false
+}
+
+// Authorize enforces AccessPolicy: it authenticates the caller as the matching rule requires,
+// applies the admin or API key request quota and checks the admin role. It replaces per-route
+// authentication middleware, so access is declared and reviewed in one place.
+func Authorize() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		rule, ok := PolicyFor(c.Method(), c.Path())
+		if !ok {
+			slog.WarnContext(c.UserContext(), "[AUTHZ] No access rule, denying", "method", c.Method(), "path", c.Path())
+			return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("Cannot %s %s", c.Method(), c.Path()))
+		}
+
+		switch rule.Require {
+		case RequirementPublic, RequirementProviderToken:
+			// Provider callbacks check X-Provider-Token in the handler
+			return c.Next()
+
+		case RequirementAPIKey:
+			if ok, err := authenticateAPIKey(c, rule.Scope); !ok {
+				return err
+			}
+			if ok, err := enforceQuota(c, "api_key", services.APIKeyQuotaLimits()); !ok {
+				return err
+			}
+			return c.Next()
+
+		case RequirementUser:
+			if ok, err := authenticateUser(c); !ok {
+				return err
+			}
+			if isImpersonating(c) {
+				return auditImpersonatedRequest(c)
+			}
+			return c.Next()
+
+		case RequirementAdmin, RequirementSuperAdmin, RequirementSelfOrSuperAdmin:
+			if ok, err := authenticateAdmin(c); !ok {
+				return err
+			}
+			if ok, err := enforceQuota(c, "admin", services.AdminQuotaLimits()); !ok {
+				return err
+			}
+			if c.Locals("admin_role") == models.RoleAuditor {
+				if ok, err := authorizeAuditor(c, rule); !ok {
+					return err
+				}
+				return c.Next()
+			}
+			if rule.Require == RequirementSuperAdmin {
+				if ok, err := requireSuperAdmin(c); !ok {
+					return err
+				}
+			}
+			if rule.Require == RequirementSelfOrSuperAdmin && c.Locals("admin_role") != models.RoleSuper && !isPolicyOwner(c, rule) {
+				SetDenialReason(c, models.DenialNotOwner)
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"success": false,
+					"message": "Regular admins can only access their own record",
+				})
+			}
+			return c.Next()
+		}
+
+		slog.WarnContext(c.UserContext(), "[AUTHZ] Unknown requirement, denying", "require", rule.Require, "method", c.Method(), "path", c.Path())
+		SetDenialReason(c, models.DenialUnknownRequirement)
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"success": false,
+			"message": "Access denied",
+		})
+	}
+}
+
+// authorizeAuditor lets auditors read every admin route, including super admin ones, and
+// change nothing but their own account. When it returns false the error response has
+// already been written.
+func authorizeAuditor(c *fiber.Ctx, rule AccessRule) (bool, error) {
+	if c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead {
+		return true, nil
+	}
+	if rule.Require == RequirementSelfOrSuperAdmin && isPolicyOwner(c, rule) {
+		return true, nil
+	}
+	slog.WarnContext(c.UserContext(), "[AUTHZ] Auditor denied", "admin", c.Locals("admin_username"), "method", c.Method(), "path", c.Path())
+	SetDenialReason(c, models.DenialAuditorReadOnly)
+	return false, c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+		"success": false,
+		"message": "Auditors have read-only access",
+	})
+}
+
+// isPolicyOwner reports whether the authenticated admin is the one named in the rule's OwnerParam
+func isPolicyOwner(c *fiber.Ctx, rule AccessRule) bool {
+	id, err := uuid.Parse(policyParam(rule.Path, c.Path(), rule.OwnerParam))
+	return err == nil && id == c.Locals("id")
+}
+
+// UncoveredRoutes returns the /api routes of app that no AccessPolicy rule matches
+func UncoveredRoutes(app *fiber.App) []string {
+	var uncovered []string
+	for _, route := range app.GetRoutes(true) {
+		if route.Method == fiber.MethodHead || !strings.HasPrefix(route.Path, "/api/") {
+			continue
+		}
+		if _, ok := PolicyFor(route.Method, route.Path); !ok {
+			uncovered = append(uncovered, route.Method+" "+route.Path)
+		}
+	}
+	return uncovered
+}
+
+// matchPolicyPath reports whether path matches pattern. Trailing slashes and case are ignored, so
+// a path is never matched by a looser rule than the route it reaches, whatever the router's
+// case and trailing slash settings.
+func matchPolicyPath(pattern, path string) bool {
+	patternParts := splitPolicyPath(pattern)
+	pathParts := splitPolicyPath(path)
+
+	for i, part := range patternParts {
+		if part == "*" && i == len(patternParts)-1 {
+			return true
+		}
+		if i >= len(pathParts) {
+			return false
+		}
+		if !strings.HasPrefix(part, ":") && !strings.EqualFold(part, pathParts[i]) {
+			return false
+		}
+	}
+	return len(patternParts) == len(pathParts)
+}
+
+// policyParam returns the path segment at the position of :name in pattern
+func policyParam(pattern, path, name string) string {
+	patternParts := splitPolicyPath(pattern)
+	pathParts := splitPolicyPath(path)
+	for i, part := range patternParts {
+		if part == ":"+name && i < len(pathParts) {
+			return pathParts[i]
+		}
+	}
+	return ""
+}
+
+func splitPolicyPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/services"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Quota enforces request quotas for the authenticated admin or API key within scope. Separate
+// scopes keep separate counters, so expensive endpoints (e.g. exports) can get their own daily
+// limit. Must run after Authorize.
+// Responses carry X-Quota-Limit, X-Quota-Remaining and X-Quota-Reset headers; exceeded quotas
+// also get Retry-After, X-RateLimit-Reset and a fixed retry_strategy.
+func Quota(scope string, limits func() services.QuotaLimits) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if ok, err := enforceQuota(c, scope, limits()); !ok {
+			return err
+		}
+		return c.Next()
+	}
+}
+
+// enforceQuota consumes one request from the quota in scope of the admin ("admin:<id>") or
+// API key ("api_key:<id>"). When it returns false the error response has already been written.
+func enforceQuota(c *fiber.Ctx, scope string, limits services.QuotaLimits) (bool, error) {
+	var principal string
+	if keyID := c.Locals("api_key_id"); keyID != nil {
+		principal = fmt.Sprintf("api_key:%v", keyID)
+	} else if adminID := c.Locals("id"); adminID != nil {
+		principal = fmt.Sprintf("admin:%v", adminID)
+	} else {
+		return false, c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"success": false,
+			"message": "Authentication required",
+		})
+	}
+
+	result, applied := services.RequestQuotas().Consume(scope, principal, limits)
+	if !applied {
+		return true, nil
+	}
+
+	c.Set("X-Quota-Limit", strconv.Itoa(result.Limit))
+	c.Set("X-Quota-Remaining", strconv.Itoa(result.Remaining))
+	c.Set("X-Quota-Reset", strconv.FormatInt(result.ResetAt.Unix(), 10))
+
+	if result.Exceeded {
+		slog.WarnContext(c.UserContext(), "[QUOTA_EXCEEDED] Quota exceeded",
+			"principal", principal, "scope", scope, "limit", result.Limit, "resets_at", result.ResetAt.Format(time.RFC3339))
+		metrics.IncCounter("quota_exceeded_total", metrics.Labels{"scope": scope})
+
+		return false, c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"success":        false,
+			"message":        "Request quota exceeded. Try again later.",
+			"retry_strategy": Backoff(c, RetryStrategyFixed, time.Until(result.ResetAt)),
+		})
+	}
+
+	return true, nil
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"log/slog"
+	"ololo-gate/internal/logging"
+	"regexp"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// validRequestID matches the X-Request-ID values taken from clients; anything else (too long,
+// spaces, quotes, ...) is replaced so it cannot forge or break log lines
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID gives every request a correlation ID: the client's X-Request-ID if it sent a valid
+// one, otherwise a new UUID. The ID is returned in the X-Request-ID response header, stored as
+// c.Locals("request_id") and carried by c.UserContext(), so every line logged with that context
+// has it as request_id. Register it ahead of everything that logs.
+func RequestID() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id := c.Get(fiber.HeaderXRequestID)
+		if !validRequestID.MatchString(id) {
+			id = uuid.NewString()
+		}
+		c.Locals("request_id", id)
+		c.Set(fiber.HeaderXRequestID, id)
+		c.SetUserContext(logging.WithRequestID(c.UserContext(), id))
+		return c.Next()
+	}
+}
+
+// AccessLog logs one line per request with its method, path, final status and latency. Errors
+// returned by later handlers are rendered first, so the logged status is the one the client got.
+func AccessLog() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		if err := c.Next(); err != nil {
+			if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := c.Response().StatusCode()
+		level := slog.LevelInfo
+		if status >= fiber.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		slog.Log(c.UserContext(), level, "request",
+			"method", c.Method(),
+			"path", c.Path(),
+			"status", status,
+			"latency_ms", float64(time.Since(start).Microseconds())/1000,
+			"ip", c.IP(),
+		)
+		return nil
+	}
+}
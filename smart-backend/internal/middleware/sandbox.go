@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"ololo-gate/internal/services"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SandboxHeader is set to "true" on every response in sandbox mode (SANDBOX_MODE), so integrators
+// can tell that gate commands and text messages were simulated
+const SandboxHeader = "X-Sandbox"
+
+// MarkSandbox sets SandboxHeader on responses while the instance runs in sandbox mode
+func MarkSandbox() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if services.SandboxEnabled() {
+			c.Set(SandboxHeader, "true")
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"errors"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/services"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TrackSLOs times requests to endpoints with a service level objective (SLO_OBJECTIVES) and
+// records whether they met it. Paths match like access rules; the first matching objective counts.
+func TrackSLOs() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		objective, ok := sloFor(c.Method(), c.Path())
+		if !ok {
+			return c.Next()
+		}
+
+		start := time.Now()
+		err := c.Next()
+
+		// Errors returned to the error handler have not set the response status yet
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+		}
+
+		services.SLOs().Record(objective, config.AppConfig().SLO.Window, time.Since(start), status >= fiber.StatusInternalServerError)
+		return err
+	}
+}
+
+// sloFor returns the first objective matching the method and request path
+func sloFor(method, path string) (config.SLOObjective, bool) {
+	for _, objective := range config.AppConfig().SLO.Objectives {
+		if objective.Method == method && matchPolicyPath(objective.Path, path) {
+			return objective, true
+		}
+	}
+	return config.SLOObjective{}, false
+}
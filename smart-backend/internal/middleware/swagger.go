@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"net/url"
+	"ololo-gate/internal/config"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// swaggerPathPrefix is where the Swagger UI and API description are served
+const swaggerPathPrefix = "/swagger"
+
+// swaggerTokenCookie carries the admin token to the pages and files the Swagger UI loads after
+// the first request, which cannot send an Authorization header
+const swaggerTokenCookie = "swagger_token"
+
+// SwaggerAccess guards /swagger according to SWAGGER_MODE: not served when disabled, behind
+// basic auth or an admin token when protected. An admin token can be given once as ?token=,
+// it is then kept in a cookie scoped to /swagger. Cross-origin requests are only answered for
+// SWAGGER_ALLOWED_ORIGINS; the API's CORS policy does not apply to /swagger.
+func SwaggerAccess() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cfg := config.AppConfig().Swagger
+		if cfg.Mode == config.SwaggerDisabled {
+			return fiber.ErrNotFound
+		}
+
+		if origin := c.Get(fiber.HeaderOrigin); origin != "" && !sameOrigin(c, origin) {
+			if !slices.Contains(cfg.AllowedOrigins, origin) {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"success": false,
+					"message": "Origin not allowed",
+				})
+			}
+			c.Set(fiber.HeaderAccessControlAllowOrigin, origin)
+			c.Set(fiber.HeaderAccessControlAllowCredentials, "true")
+			c.Vary(fiber.HeaderOrigin)
+			if c.Method() == fiber.MethodOptions {
+				c.Set(fiber.HeaderAccessControlAllowMethods, "GET,OPTIONS")
+				c.Set(fiber.HeaderAccessControlAllowHeaders, "Authorization")
+				return c.SendStatus(fiber.StatusNoContent)
+			}
+		}
+
+		switch cfg.Mode {
+		case config.SwaggerBasic:
+			username, password, ok := basicAuth(c)
+			if !ok || subtle.ConstantTimeCompare([]byte(username), []byte(cfg.Username)) != 1 ||
+				subtle.ConstantTimeCompare([]byte(password), []byte(cfg.Password)) != 1 {
+				c.Set(fiber.HeaderWWWAuthenticate, `Basic realm="Ololo Gate API docs"`)
+				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"success": false,
+					"message": "Authentication required",
+				})
+			}
+		case config.SwaggerAdmin:
+			fromQuery := false
+			if c.Get(fiber.HeaderAuthorization) == "" {
+				token := c.Cookies(swaggerTokenCookie)
+				if query := c.Query("token"); query != "" {
+					token, fromQuery = query, true
+				}
+				if token != "" {
+					c.Request().Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+				}
+			}
+			if ok, err := authenticateAdmin(c); !ok {
+				return err
+			}
+			if fromQuery {
+				c.Cookie(&fiber.Cookie{
+					Name:     swaggerTokenCookie,
+					Value:    c.Query("token"),
+					Path:     swaggerPathPrefix,
+					HTTPOnly: true,
+					Secure:   c.Protocol() == "https",
+					SameSite: fiber.CookieSameSiteStrictMode,
+				})
+			}
+		}
+		return c.Next()
+	}
+}
+
+// sameOrigin reports whether the Origin header names the host the request was sent to
+func sameOrigin(c *fiber.Ctx, origin string) bool {
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == string(c.Request().Host())
+}
+
+// basicAuth returns the credentials of a Basic Authorization header
+func basicAuth(c *fiber.Ctx) (username, password string, ok bool) {
+	header := c.Get(fiber.HeaderAuthorization)
+	if !strings.HasPrefix(header, "Basic ") {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(header, "Basic "))
+	if err != nil {
+		return "", "", false
+	}
+	return strings.Cut(string(decoded), ":")
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"ololo-gate/internal/services"
+	"ololo-gate/internal/utils"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// TimezoneHeader selects the timezone of a request by IANA name (e.g. Asia/Bishkek). Responses
+// carry the zone that was applied.
+const TimezoneHeader = "X-Timezone"
+
+// timezoneKey holds the *time.Location resolved by LocalizeTimestamps
+const timezoneKey = "timezone"
+
+// LocalizeTimestamps applies the request's timezone: the X-Timezone header, else the admin's
+// timezone preference, else DEFAULT_TIMEZONE. Report days follow it (RequestTimezone), and the
+// timestamps of JSON responses are rewritten to it with their UTC offset. It runs after Authorize,
+// which identifies the admin, and ahead of AuditCapture, so the audit log keeps UTC. Unknown zones
+// in the header are refused with 400.
+func LocalizeTimestamps() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		loc, err := resolveTimezone(c)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid X-Timezone header. Use an IANA zone name such as Asia/Bishkek")
+		}
+		c.Locals(timezoneKey, loc)
+		c.Set(TimezoneHeader, loc.String())
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		// Timestamps are marshalled in UTC; streamed and non-JSON bodies (CSV exports) are left alone
+		contentType := string(c.Response().Header.ContentType())
+		if loc == time.UTC || c.Response().IsBodyStream() ||
+			!(strings.HasPrefix(contentType, fiber.MIMEApplicationJSON) || strings.HasPrefix(contentType, "application/problem+json")) {
+			return nil
+		}
+		if body, err := utils.LocalizeJSONTimestamps(c.Response().Body(), loc); err == nil {
+			c.Response().SetBody(body)
+		}
+		return nil
+	}
+}
+
+// RequestTimezone returns the timezone of the request, UTC outside LocalizeTimestamps
+func RequestTimezone(c *fiber.Ctx) *time.Location {
+	if loc, ok := c.Locals(timezoneKey).(*time.Location); ok {
+		return loc
+	}
+	return time.UTC
+}
+
+// resolveTimezone returns the zone named by X-Timezone, the admin's preference or DEFAULT_TIMEZONE
+func resolveTimezone(c *fiber.Ctx) (*time.Location, error) {
+	if name := c.Get(TimezoneHeader); name != "" {
+		return services.LoadTimezone(name)
+	}
+	if _, admin := c.Locals("admin_role").(string); admin {
+		if id, ok := c.Locals("id").(uuid.UUID); ok {
+			if name := services.AdminTimezone(id); name != "" {
+				if loc, err := services.LoadTimezone(name); err == nil {
+					return loc, nil
+				}
+			}
+		}
+	}
+	return services.DefaultTimezone(), nil
+}
@@ -0,0 +1,114 @@
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// commandUsage describes the migrate subcommand
+const commandUsage = `usage: migrate <command>
+  up          apply all pending migrations
+  down [N]    revert the last N applied migrations (default 1)
+  status      list migrations and whether they are applied
+  version     print the applied version
+  force V     record version V as applied without running anything (0 clears it)`
+
+// EnsureSchema brings the database to the latest migration if apply is set, then checks that it is
+// there. The server does not start on a schema it was not built for.
+func EnsureSchema(db *gorm.DB, apply bool) error {
+	migrator, err := New(db)
+	if err != nil {
+		return err
+	}
+	if apply {
+		applied, err := migrator.Up()
+		if err != nil {
+			return err
+		}
+		if applied > 0 {
+			slog.Info("Applied database migrations", "count", applied)
+		}
+	}
+	if err := migrator.Check(); err != nil {
+		if errors.Is(err, ErrBehind) {
+			return fmt.Errorf("%w; run the migrate up command or set DB_MIGRATE_ON_START=true", err)
+		}
+		return err
+	}
+	slog.Info("Database schema up to date", "version", migrator.Latest())
+	return nil
+}
+
+// RunCommand runs the migrate subcommand with its arguments, writing results to out
+func RunCommand(db *gorm.DB, args []string, out io.Writer) error {
+	migrator, err := New(db)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New(commandUsage)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+		fmt.Fprintf(out, "Applied %d migration(s)\n", applied)
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of migrations to revert %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(steps)
+		fmt.Fprintf(out, "Reverted %d migration(s)\n", reverted)
+		return err
+	case "status":
+		version, dirty, err := migrator.Version()
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrator.Migrations() {
+			state := "pending"
+			if migration.Version <= version {
+				state = "applied"
+			}
+			if migration.Version == version && dirty {
+				state = "dirty"
+			}
+			fmt.Fprintf(out, "%04d_%s\t%s\n", migration.Version, migration.Name, state)
+		}
+		return nil
+	case "version":
+		version, dirty, err := migrator.Version()
+		if err != nil {
+			return err
+		}
+		if dirty {
+			fmt.Fprintf(out, "%d (dirty)\n", version)
+		} else {
+			fmt.Fprintf(out, "%d\n", version)
+		}
+		return nil
+	case "force":
+		if len(args) < 2 {
+			return errors.New("force needs the version to record")
+		}
+		version, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		if err := migrator.Force(uint(version)); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Recorded version %d\n", version)
+		return nil
+	}
+	return fmt.Errorf("unknown migrate command %q\n%s", args[0], commandUsage)
+}
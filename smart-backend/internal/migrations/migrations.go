@@ -0,0 +1,344 @@
+// Package migrations applies the versioned SQL migrations that define the database schema.
+//
+// Migrations are pairs of files in sql/ named <version>_<name>.up.sql and <version>_<name>.down.sql,
+// the layout golang-migrate uses. The applied version is kept in schema_migrations, which has the
+// same columns as golang-migrate's table, so either can manage a database.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// advisoryLockID serializes migrations across instances started at the same time on PostgreSQL
+const advisoryLockID = 7426130011
+
+// fileName matches migration files: version, name and direction
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+var (
+	// ErrDirty is returned while schema_migrations is marked dirty, as golang-migrate leaves it after
+	// a failed migration. Migrations run here are rolled back as a whole instead.
+	ErrDirty = errors.New("schema is dirty")
+	// ErrBehind is returned when migrations of this build have not been applied
+	ErrBehind = errors.New("schema is behind")
+	// ErrAhead is returned when the database has migrations this build does not know
+	ErrAhead = errors.New("schema is ahead")
+)
+
+// Migration is one versioned schema change
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Migrator applies migrations to a database
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration // Sorted by version
+}
+
+// New returns a migrator for the migrations built into the binary
+func New(db *gorm.DB) (*Migrator, error) {
+	sub, err := fs.Sub(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+	return NewFromFS(db, sub)
+}
+
+// NewFromFS returns a migrator for the migration files at the root of fsys
+func NewFromFS(db *gorm.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// load reads and pairs the migration files of fsys. Every version needs both directions.
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint]*Migration{}
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			return nil, fmt.Errorf("unexpected migration file %q, use <version>_<name>.up.sql or .down.sql", entry.Name())
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[uint(version)]
+		if !ok {
+			migration = &Migration{Version: uint(version), Name: match[2]}
+			byVersion[uint(version)] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has files named %q and %q", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrations returns the known migrations, oldest first
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Latest returns the version of the newest known migration, 0 if there are none
+func (m *Migrator) Latest() uint {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the applied version (0 before the first migration) and whether it is dirty
+func (m *Migrator) Version() (uint, bool, error) {
+	sqlDB, err := m.sqlDB()
+	if err != nil {
+		return 0, false, err
+	}
+	return readVersion(context.Background(), sqlDB)
+}
+
+// Check reports whether the database is at the latest known version, returning ErrDirty, ErrBehind
+// or ErrAhead (wrapped with the versions) if not
+func (m *Migrator) Check() error {
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+	switch {
+	case dirty:
+		return fmt.Errorf("%w at version %d, fix it by hand and run migrate force", ErrDirty, version)
+	case version < m.Latest():
+		return fmt.Errorf("%w: version %d, this build needs %d", ErrBehind, version, m.Latest())
+	case version > m.Latest():
+		return fmt.Errorf("%w: version %d, this build only knows up to %d", ErrAhead, version, m.Latest())
+	}
+	return nil
+}
+
+// Up applies every pending migration and returns how many were applied
+func (m *Migrator) Up() (int, error) {
+	if err := m.checkKnown(); err != nil {
+		return 0, err
+	}
+	applied := 0
+	for i, migration := range m.migrations {
+		ran, err := m.step(func(version uint) (string, uint, bool) {
+			return migration.Up, migration.Version, version == m.previous(i)
+		})
+		if err != nil {
+			return applied, fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		if ran {
+			slog.Info("[MIGRATE] Applied migration", "version", migration.Version, "name", migration.Name)
+			applied++
+		}
+	}
+	return applied, nil
+}
+
+// Down reverts the last steps applied migrations and returns how many were reverted
+func (m *Migrator) Down(steps int) (int, error) {
+	if err := m.checkKnown(); err != nil {
+		return 0, err
+	}
+	reverted := 0
+	for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+		migration := m.migrations[i]
+		ran, err := m.step(func(version uint) (string, uint, bool) {
+			return migration.Down, m.previous(i), version == migration.Version
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("reverting migration %d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		if ran {
+			slog.Info("[MIGRATE] Reverted migration", "version", migration.Version, "name", migration.Name)
+			reverted++
+		}
+	}
+	return reverted, nil
+}
+
+// Force records version as applied and clean without running any migration, e.g. after fixing a
+// failed migration by hand
+func (m *Migrator) Force(version uint) error {
+	if version != 0 && !m.known(version) {
+		return fmt.Errorf("unknown migration version %d", version)
+	}
+	sqlDB, err := m.sqlDB()
+	if err != nil {
+		return err
+	}
+	return m.inTx(sqlDB, func(ctx context.Context, tx *sql.Tx) error {
+		return writeVersion(ctx, tx, version)
+	})
+}
+
+// step runs one migration in a transaction if plan, given the applied version, says it is due.
+// The version is read again under the lock, so instances migrating at once apply it only once.
+func (m *Migrator) step(plan func(applied uint) (script string, target uint, due bool)) (bool, error) {
+	sqlDB, err := m.sqlDB()
+	if err != nil {
+		return false, err
+	}
+	ran := false
+	err = m.inTx(sqlDB, func(ctx context.Context, tx *sql.Tx) error {
+		applied, dirty, err := readVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("%w at version %d", ErrDirty, applied)
+		}
+		script, target, due := plan(applied)
+		if !due {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			return err
+		}
+		ran = true
+		return writeVersion(ctx, tx, target)
+	})
+	return ran, err
+}
+
+// previous is the version before migration i, 0 for the first
+func (m *Migrator) previous(i int) uint {
+	if i == 0 {
+		return 0
+	}
+	return m.migrations[i-1].Version
+}
+
+// checkKnown fails if the applied version is not one of the migrations, since it is unclear which
+// migrations would follow it
+func (m *Migrator) checkKnown() error {
+	version, _, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if version != 0 && !m.known(version) {
+		return fmt.Errorf("%w: version %d is not a known migration", ErrAhead, version)
+	}
+	return nil
+}
+
+// known reports whether version is one of the migrations
+func (m *Migrator) known(version uint) bool {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// sqlDB returns the connection pool under gorm. Migrations run on it directly: a file holds several
+// statements, which cannot go through gorm's prepared statements.
+func (m *Migrator) sqlDB() (*sql.DB, error) {
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sqlDB.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)`); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return sqlDB, nil
+}
+
+// inTx runs fn in a transaction, holding the migration lock on PostgreSQL
+func (m *Migrator) inTx(sqlDB *sql.DB, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	ctx := context.Background()
+	tx, err := sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if m.db.Dialector.Name() == "postgres" {
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, advisoryLockID); err != nil {
+			return fmt.Errorf("failed to take the migration lock: %w", err)
+		}
+	}
+	if err := fn(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// querier is a *sql.DB or *sql.Tx
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// readVersion reads the applied version, 0 if none is recorded
+func readVersion(ctx context.Context, q querier) (uint, bool, error) {
+	var version int64
+	var dirty bool
+	err := q.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return uint(version), dirty, nil
+}
+
+// writeVersion records version as applied and clean; 0 clears it
+func writeVersion(ctx context.Context, tx *sql.Tx, version uint) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations`); err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
+	if version == 0 {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2)`, int64(version), false); err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
+	return nil
+}
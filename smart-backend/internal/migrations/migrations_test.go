@@ -0,0 +1,248 @@
+package migrations
+
+import (
+	"ololo-gate/internal/models"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// schemaModels are the models whose tables the migrations must create
+var schemaModels = []interface{}{
+	&models.User{},
+	&models.Admin{},
+	&models.Contact{},
+	&models.AdminAuditLog{},
+	&models.GateCommand{},
+	&models.GateEvent{},
+	&models.GateEventRollup{},
+	&models.JobRun{},
+	&models.JobLock{},
+	&models.AdminNotification{},
+	&models.UserSession{},
+	&models.UsageCounter{},
+	&models.UsageActiveUser{},
+	&models.UsageDailyActiveUser{},
+	&models.CORSOrigin{},
+	&models.UserPhone{},
+	&models.GateLink{},
+	&models.InviteCode{},
+	&models.LegalDocument{},
+	&models.LegalAcceptance{},
+	&models.UserHistory{},
+	&models.AdminHistory{},
+	&models.ProviderMirrorResult{},
+	&models.APIKey{},
+	&models.GateReport{},
+	&models.LocationFreeze{},
+	&models.Export{},
+	&models.LocationOverride{},
+	&models.AdminCredential{},
+	&models.WebAuthnChallenge{},
+	&models.GateMaintenance{},
+	&models.DigestRun{},
+	&models.ForcedLogout{},
+	&models.ProviderQuotaWarning{},
+	&models.AuditComment{},
+	&models.OTPCode{},
+	&models.WebhookSecret{},
+	&models.SecurityDenial{},
+	&models.GateEventLog{},
+	&models.PendingAssignment{},
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1) // Every connection would get its own in-memory database
+	t.Cleanup(func() { sqlDB.Close() })
+	return db
+}
+
+func TestEmbeddedMigrations_CreateEveryModel(t *testing.T) {
+	db := newTestDB(t)
+	migrator, err := New(db)
+	require.NoError(t, err)
+
+	applied, err := migrator.Up()
+	require.NoError(t, err)
+	assert.Equal(t, len(migrator.Migrations()), applied)
+	assert.NoError(t, migrator.Check())
+	assert.False(t, db.Migrator().HasTable("login_otps"), "login codes are stored in otp_codes")
+
+	for _, model := range schemaModels {
+		stmt := &gorm.Statement{DB: db}
+		require.NoError(t, stmt.Parse(model))
+		if !assert.True(t, db.Migrator().HasTable(model), "no migration creates table %s", stmt.Schema.Table) {
+			continue
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName != "" {
+				assert.True(t, db.Migrator().HasColumn(model, field.DBName), "no migration creates column %s.%s", stmt.Schema.Table, field.DBName)
+			}
+		}
+	}
+
+	reverted, err := migrator.Down(len(migrator.Migrations()))
+	require.NoError(t, err)
+	assert.Equal(t, applied, reverted)
+	for _, model := range schemaModels {
+		assert.False(t, db.Migrator().HasTable(model))
+	}
+}
+
+// releasedUser is models.User as the last AutoMigrate release (before versioned migrations) created it
+type releasedUser struct {
+	ID              string `gorm:"type:char(36);primaryKey"`
+	Phone           string `gorm:"uniqueIndex:idx_phone_deleted_at;not null"`
+	Password        string `gorm:"not null"`
+	TokenVersion    int    `gorm:"default:0;not null"`
+	CurrentDeviceID string `gorm:"type:varchar(255);default:''"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"uniqueIndex:idx_phone_deleted_at;index"`
+}
+
+func (releasedUser) TableName() string { return "users" }
+
+// releasedAdmin is models.Admin as the last AutoMigrate release created it
+type releasedAdmin struct {
+	ID           string `gorm:"type:char(36);primaryKey"`
+	Username     string `gorm:"uniqueIndex:idx_username_deleted_at;not null"`
+	Password     string `gorm:"not null"`
+	Role         string `gorm:"not null"`
+	TokenVersion int    `gorm:"default:0"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"uniqueIndex:idx_username_deleted_at;index"`
+}
+
+func (releasedAdmin) TableName() string { return "admins" }
+
+func TestEmbeddedMigrations_UpgradeAutoMigratedRelease(t *testing.T) {
+	db := newTestDB(t)
+	require.NoError(t, db.AutoMigrate(&releasedUser{}, &releasedAdmin{}, &models.Contact{}, &models.AdminAuditLog{}))
+	require.NoError(t, db.Create(&releasedUser{ID: "11111111-1111-1111-1111-111111111111", Phone: "+77771234567", Password: "hash", CurrentDeviceID: "device-1"}).Error)
+
+	migrator, err := New(db)
+	require.NoError(t, err)
+	_, err = migrator.Up()
+	require.NoError(t, err)
+
+	assert.False(t, db.Migrator().HasIndex("users", "idx_phone_deleted_at"))
+	assert.True(t, db.Migrator().HasIndex("users", "idx_phone_index_deleted_at"))
+	var row struct {
+		Phone              string
+		CurrentDeviceID    string
+		RegistrationStatus string
+	}
+	require.NoError(t, db.Table("users").Select("phone", "current_device_id", "registration_status").Take(&row).Error)
+	assert.Equal(t, "+77771234567", row.Phone)
+	assert.Equal(t, "device-1", row.CurrentDeviceID)
+	assert.Equal(t, "approved", row.RegistrationStatus)
+}
+
+func testMigrations() fstest.MapFS {
+	return fstest.MapFS{
+		"0001_gates.up.sql":     {Data: []byte("CREATE TABLE gates (id integer PRIMARY KEY);")},
+		"0001_gates.down.sql":   {Data: []byte("DROP TABLE gates;")},
+		"0002_titles.up.sql":    {Data: []byte("ALTER TABLE gates ADD COLUMN title text; CREATE INDEX idx_gates_title ON gates (title);")},
+		"0002_titles.down.sql":  {Data: []byte("DROP INDEX idx_gates_title; ALTER TABLE gates DROP COLUMN title;")},
+		"0005_openers.up.sql":   {Data: []byte("CREATE TABLE openers (id integer PRIMARY KEY);")},
+		"0005_openers.down.sql": {Data: []byte("DROP TABLE openers;")},
+	}
+}
+
+func TestMigrator_UpDownAndCheck(t *testing.T) {
+	db := newTestDB(t)
+	migrator, err := NewFromFS(db, testMigrations())
+	require.NoError(t, err)
+	assert.Equal(t, uint(5), migrator.Latest())
+	assert.ErrorIs(t, migrator.Check(), ErrBehind)
+
+	applied, err := migrator.Up()
+	require.NoError(t, err)
+	assert.Equal(t, 3, applied)
+	assert.True(t, db.Migrator().HasColumn("gates", "title"))
+	assert.True(t, db.Migrator().HasTable("openers"))
+	assert.NoError(t, migrator.Check())
+
+	// Nothing left to apply
+	applied, err = migrator.Up()
+	require.NoError(t, err)
+	assert.Equal(t, 0, applied)
+
+	reverted, err := migrator.Down(2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, reverted)
+	version, dirty, err := migrator.Version()
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), version)
+	assert.False(t, dirty)
+	assert.False(t, db.Migrator().HasColumn("gates", "title"))
+	assert.False(t, db.Migrator().HasTable("openers"))
+
+	// A build with fewer migrations refuses the newer schema
+	older, err := NewFromFS(db, fstest.MapFS{
+		"0001_gates.up.sql":   {Data: []byte("CREATE TABLE gates (id integer PRIMARY KEY);")},
+		"0001_gates.down.sql": {Data: []byte("DROP TABLE gates;")},
+	})
+	require.NoError(t, err)
+	assert.NoError(t, older.Check())
+	_, err = migrator.Up()
+	require.NoError(t, err)
+	assert.ErrorIs(t, older.Check(), ErrAhead)
+}
+
+func TestMigrator_FailedMigrationIsRolledBack(t *testing.T) {
+	db := newTestDB(t)
+	files := testMigrations()
+	files["0002_titles.up.sql"] = &fstest.MapFile{Data: []byte("ALTER TABLE gates ADD COLUMN title text; CREATE INDEX broken ON missing (title);")}
+	migrator, err := NewFromFS(db, files)
+	require.NoError(t, err)
+
+	applied, err := migrator.Up()
+	assert.Error(t, err)
+	assert.Equal(t, 1, applied)
+	assert.False(t, db.Migrator().HasColumn("gates", "title"))
+	version, dirty, err := migrator.Version()
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), version)
+	assert.False(t, dirty)
+}
+
+func TestMigrator_Force(t *testing.T) {
+	db := newTestDB(t)
+	migrator, err := NewFromFS(db, testMigrations())
+	require.NoError(t, err)
+
+	// A database whose tables already exist is adopted without running the migration
+	require.NoError(t, db.Exec("CREATE TABLE gates (id integer PRIMARY KEY)").Error)
+	require.NoError(t, migrator.Force(1))
+	applied, err := migrator.Up()
+	require.NoError(t, err)
+	assert.Equal(t, 2, applied)
+
+	assert.Error(t, migrator.Force(3))
+	require.NoError(t, db.Exec("UPDATE schema_migrations SET dirty = true").Error)
+	assert.ErrorIs(t, migrator.Check(), ErrDirty)
+	_, err = migrator.Down(1)
+	assert.ErrorIs(t, err, ErrDirty)
+	require.NoError(t, migrator.Force(5))
+	assert.NoError(t, migrator.Check())
+}
+
+func TestLoad_RejectsIncompleteMigrations(t *testing.T) {
+	_, err := load(fstest.MapFS{"0001_gates.up.sql": {Data: []byte("CREATE TABLE gates (id integer);")}})
+	assert.ErrorContains(t, err, "needs both an up and a down file")
+
+	_, err = load(fstest.MapFS{"gates.sql": {Data: []byte("")}})
+	assert.ErrorContains(t, err, "unexpected migration file")
+}
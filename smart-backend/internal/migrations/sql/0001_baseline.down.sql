@@ -0,0 +1,6 @@
+-- Drops every table of the baseline schema, and all data in them.
+
+DROP TABLE IF EXISTS "admin_audit_logs";
+DROP TABLE IF EXISTS "contacts";
+DROP TABLE IF EXISTS "admins";
+DROP TABLE IF EXISTS "users";
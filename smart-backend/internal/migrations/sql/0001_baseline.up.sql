@@ -0,0 +1,62 @@
+-- Schema of the last release that created tables with GORM AutoMigrate. Tables and indexes are
+-- only created if missing, so databases set up by AutoMigrate adopt this version unchanged.
+
+CREATE TABLE IF NOT EXISTS "users" (
+    "id" char(36),
+    "phone" text NOT NULL,
+    "password" text NOT NULL,
+    "token_version" bigint NOT NULL DEFAULT 0,
+    "current_device_id" varchar(255) DEFAULT '',
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_users_deleted_at" ON "users" ("deleted_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_phone_deleted_at" ON "users" ("phone","deleted_at");
+
+CREATE TABLE IF NOT EXISTS "admins" (
+    "id" char(36),
+    "username" text NOT NULL,
+    "password" text NOT NULL,
+    "role" text NOT NULL,
+    "token_version" bigint DEFAULT 0,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_admins_deleted_at" ON "admins" ("deleted_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_username_deleted_at" ON "admins" ("username","deleted_at");
+
+CREATE TABLE IF NOT EXISTS "contacts" (
+    "id" bigserial,
+    "support_number" bigint NOT NULL,
+    "email_support" text NOT NULL,
+    "address" text NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "admin_audit_logs" (
+    "id" char(36),
+    "admin_id" char(36),
+    "admin_name" text,
+    "action" text,
+    "resource_type" text,
+    "resource_id" text,
+    "details" text,
+    "ip_address" text,
+    "user_agent" text,
+    "status" text,
+    "error_message" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_admin_audit_logs_created_at" ON "admin_audit_logs" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_admin_audit_logs_resource_id" ON "admin_audit_logs" ("resource_id");
+CREATE INDEX IF NOT EXISTS "idx_admin_audit_logs_resource_type" ON "admin_audit_logs" ("resource_type");
+CREATE INDEX IF NOT EXISTS "idx_admin_audit_logs_action" ON "admin_audit_logs" ("action");
+CREATE INDEX IF NOT EXISTS "idx_admin_audit_logs_admin_name" ON "admin_audit_logs" ("admin_name");
+CREATE INDEX IF NOT EXISTS "idx_admin_audit_logs_admin_id" ON "admin_audit_logs" ("admin_id");
@@ -0,0 +1,35 @@
+-- Drops the columns added since the baseline release. Phone numbers stay encrypted.
+
+ALTER TABLE "admins" DROP COLUMN "timezone";
+
+DROP INDEX IF EXISTS "idx_users_external_id";
+DROP INDEX IF EXISTS "idx_users_registration_status";
+DROP INDEX IF EXISTS "idx_users_invite_code_id";
+DROP INDEX IF EXISTS "idx_users_trashed_at";
+DROP INDEX IF EXISTS "idx_email_index_deleted_at";
+DROP INDEX IF EXISTS "idx_users_phone_suffix_index";
+DROP INDEX IF EXISTS "idx_phone_index_deleted_at";
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_phone_deleted_at" ON "users" ("phone","deleted_at");
+
+ALTER TABLE "users" ADD COLUMN "current_device_id_varchar" varchar(255) DEFAULT '';
+UPDATE "users" SET "current_device_id_varchar" = "current_device_id";
+ALTER TABLE "users" DROP COLUMN "current_device_id";
+ALTER TABLE "users" RENAME COLUMN "current_device_id_varchar" TO "current_device_id";
+
+ALTER TABLE "users" DROP COLUMN "digest_sent_at";
+ALTER TABLE "users" DROP COLUMN "digest_opt_out";
+ALTER TABLE "users" DROP COLUMN "max_sessions";
+ALTER TABLE "users" DROP COLUMN "external_id";
+ALTER TABLE "users" DROP COLUMN "rejection_reason";
+ALTER TABLE "users" DROP COLUMN "reviewed_at";
+ALTER TABLE "users" DROP COLUMN "reviewed_by";
+ALTER TABLE "users" DROP COLUMN "registration_status";
+ALTER TABLE "users" DROP COLUMN "invite_code_id";
+ALTER TABLE "users" DROP COLUMN "trashed_by";
+ALTER TABLE "users" DROP COLUMN "trashed_at";
+ALTER TABLE "users" DROP COLUMN "password_changed_at";
+ALTER TABLE "users" DROP COLUMN "email_verified_at";
+ALTER TABLE "users" DROP COLUMN "email_index";
+ALTER TABLE "users" DROP COLUMN "email";
+ALTER TABLE "users" DROP COLUMN "phone_suffix_index";
+ALTER TABLE "users" DROP COLUMN "phone_index";
@@ -0,0 +1,40 @@
+-- Columns added to users and admins since the baseline release.
+
+ALTER TABLE "users" ADD COLUMN "phone_index" varchar(64);
+ALTER TABLE "users" ADD COLUMN "phone_suffix_index" varchar(64);
+ALTER TABLE "users" ADD COLUMN "email" text DEFAULT '';
+ALTER TABLE "users" ADD COLUMN "email_index" varchar(64);
+ALTER TABLE "users" ADD COLUMN "email_verified_at" timestamptz;
+ALTER TABLE "users" ADD COLUMN "password_changed_at" timestamptz;
+ALTER TABLE "users" ADD COLUMN "trashed_at" timestamptz;
+ALTER TABLE "users" ADD COLUMN "trashed_by" text;
+ALTER TABLE "users" ADD COLUMN "invite_code_id" char(36);
+ALTER TABLE "users" ADD COLUMN "registration_status" varchar(16) NOT NULL DEFAULT 'approved';
+ALTER TABLE "users" ADD COLUMN "reviewed_by" text;
+ALTER TABLE "users" ADD COLUMN "reviewed_at" timestamptz;
+ALTER TABLE "users" ADD COLUMN "rejection_reason" text;
+ALTER TABLE "users" ADD COLUMN "external_id" varchar(255) DEFAULT '';
+ALTER TABLE "users" ADD COLUMN "max_sessions" bigint;
+ALTER TABLE "users" ADD COLUMN "digest_opt_out" boolean NOT NULL DEFAULT false;
+ALTER TABLE "users" ADD COLUMN "digest_sent_at" timestamptz;
+
+-- Encrypted device IDs do not fit varchar(255). The column is copied rather than altered in
+-- place, which SQLite cannot do.
+ALTER TABLE "users" ADD COLUMN "current_device_id_text" text DEFAULT '';
+UPDATE "users" SET "current_device_id_text" = "current_device_id";
+ALTER TABLE "users" DROP COLUMN "current_device_id";
+ALTER TABLE "users" RENAME COLUMN "current_device_id_text" TO "current_device_id";
+
+-- Phone numbers are encrypted at rest with a random nonce, so uniqueness moves to their blind
+-- index. Existing rows have no phone_index until the server encrypts them on startup; NULLs
+-- do not collide in a unique index.
+DROP INDEX IF EXISTS "idx_phone_deleted_at";
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_phone_index_deleted_at" ON "users" ("phone_index","deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_users_phone_suffix_index" ON "users" ("phone_suffix_index");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_email_index_deleted_at" ON "users" ("email_index","deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_users_trashed_at" ON "users" ("trashed_at");
+CREATE INDEX IF NOT EXISTS "idx_users_invite_code_id" ON "users" ("invite_code_id");
+CREATE INDEX IF NOT EXISTS "idx_users_registration_status" ON "users" ("registration_status");
+CREATE INDEX IF NOT EXISTS "idx_users_external_id" ON "users" ("external_id");
+
+ALTER TABLE "admins" ADD COLUMN "timezone" text;
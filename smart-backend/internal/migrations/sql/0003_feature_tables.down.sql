@@ -0,0 +1,38 @@
+-- Drops the tables added since the baseline release, and all data in them.
+
+DROP TABLE IF EXISTS "gate_event_logs";
+DROP TABLE IF EXISTS "security_denials";
+DROP TABLE IF EXISTS "webhook_secrets";
+DROP TABLE IF EXISTS "otp_codes";
+DROP TABLE IF EXISTS "audit_comments";
+DROP TABLE IF EXISTS "provider_quota_warnings";
+DROP TABLE IF EXISTS "forced_logouts";
+DROP TABLE IF EXISTS "digest_runs";
+DROP TABLE IF EXISTS "gate_maintenance";
+DROP TABLE IF EXISTS "webauthn_challenges";
+DROP TABLE IF EXISTS "admin_credentials";
+DROP TABLE IF EXISTS "location_overrides";
+DROP TABLE IF EXISTS "exports";
+DROP TABLE IF EXISTS "location_freezes";
+DROP TABLE IF EXISTS "gate_reports";
+DROP TABLE IF EXISTS "api_keys";
+DROP TABLE IF EXISTS "provider_mirror_results";
+DROP TABLE IF EXISTS "login_otps";
+DROP TABLE IF EXISTS "admin_history";
+DROP TABLE IF EXISTS "user_history";
+DROP TABLE IF EXISTS "legal_acceptances";
+DROP TABLE IF EXISTS "legal_documents";
+DROP TABLE IF EXISTS "invite_codes";
+DROP TABLE IF EXISTS "gate_links";
+DROP TABLE IF EXISTS "user_phones";
+DROP TABLE IF EXISTS "cors_origins";
+DROP TABLE IF EXISTS "usage_daily_active_users";
+DROP TABLE IF EXISTS "usage_active_users";
+DROP TABLE IF EXISTS "usage_counters";
+DROP TABLE IF EXISTS "user_sessions";
+DROP TABLE IF EXISTS "admin_notifications";
+DROP TABLE IF EXISTS "job_locks";
+DROP TABLE IF EXISTS "job_runs";
+DROP TABLE IF EXISTS "gate_event_rollups";
+DROP TABLE IF EXISTS "gate_events";
+DROP TABLE IF EXISTS "gate_commands";
@@ -0,0 +1,513 @@
+-- Tables added since the baseline release.
+
+CREATE TABLE IF NOT EXISTS "gate_commands" (
+    "id" char(36),
+    "user_id" char(36),
+    "phone" text NOT NULL,
+    "gate_id" bigint NOT NULL,
+    "action" text NOT NULL,
+    "status" text NOT NULL,
+    "error_message" text,
+    "sandbox" boolean NOT NULL DEFAULT false,
+    "completed_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_gate_commands_created_at" ON "gate_commands" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_gate_commands_status" ON "gate_commands" ("status");
+CREATE INDEX IF NOT EXISTS "idx_gate_commands_gate_id" ON "gate_commands" ("gate_id");
+CREATE INDEX IF NOT EXISTS "idx_gate_commands_user_id" ON "gate_commands" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "gate_events" (
+    "id" bigserial,
+    "hour" timestamptz NOT NULL,
+    "gate_id" bigint NOT NULL,
+    "command_id" char(36),
+    "user_id" char(36),
+    "action" text NOT NULL,
+    "status" text NOT NULL,
+    "occurred_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_gate_events_command_id" ON "gate_events" ("command_id");
+CREATE INDEX IF NOT EXISTS "idx_gate_events_hour_gate" ON "gate_events" ("hour","gate_id");
+
+CREATE TABLE IF NOT EXISTS "gate_event_rollups" (
+    "hour" timestamptz,
+    "gate_id" bigint,
+    "action" text,
+    "status" text,
+    "count" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("hour","gate_id","action","status")
+);
+
+CREATE TABLE IF NOT EXISTS "job_runs" (
+    "id" char(36),
+    "job_name" text NOT NULL,
+    "instance" text,
+    "status" text NOT NULL,
+    "error" text,
+    "started_at" timestamptz,
+    "finished_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_job_runs_started_at" ON "job_runs" ("started_at");
+CREATE INDEX IF NOT EXISTS "idx_job_runs_status" ON "job_runs" ("status");
+CREATE INDEX IF NOT EXISTS "idx_job_runs_job_name" ON "job_runs" ("job_name");
+
+CREATE TABLE IF NOT EXISTS "job_locks" (
+    "job_name" text,
+    "owner" text,
+    "locked_until" timestamptz,
+    PRIMARY KEY ("job_name")
+);
+
+CREATE TABLE IF NOT EXISTS "admin_notifications" (
+    "id" char(36),
+    "severity" text NOT NULL,
+    "category" text NOT NULL,
+    "title" text NOT NULL,
+    "message" text,
+    "read_at" timestamptz,
+    "read_by" char(36),
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_admin_notifications_created_at" ON "admin_notifications" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_admin_notifications_read_at" ON "admin_notifications" ("read_at");
+CREATE INDEX IF NOT EXISTS "idx_admin_notifications_category" ON "admin_notifications" ("category");
+CREATE INDEX IF NOT EXISTS "idx_admin_notifications_severity" ON "admin_notifications" ("severity");
+
+CREATE TABLE IF NOT EXISTS "user_sessions" (
+    "id" char(36),
+    "user_id" char(36) NOT NULL,
+    "device_id" varchar(255),
+    "ip_address" text,
+    "user_agent" text,
+    "last_used_at" timestamptz,
+    "expires_at" timestamptz,
+    "trusted" boolean NOT NULL DEFAULT false,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_sessions_revoked_at" ON "user_sessions" ("revoked_at");
+CREATE INDEX IF NOT EXISTS "idx_user_sessions_expires_at" ON "user_sessions" ("expires_at");
+CREATE INDEX IF NOT EXISTS "idx_user_sessions_device_id" ON "user_sessions" ("device_id");
+CREATE INDEX IF NOT EXISTS "idx_user_sessions_user_id" ON "user_sessions" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "usage_counters" (
+    "org_id" text,
+    "metric" text,
+    "day" text,
+    "value" bigint NOT NULL DEFAULT 0,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("org_id","metric","day")
+);
+
+CREATE TABLE IF NOT EXISTS "usage_active_users" (
+    "org_id" text,
+    "month" text,
+    "user_id" char(36),
+    "first_seen_at" timestamptz,
+    PRIMARY KEY ("org_id","month","user_id")
+);
+
+CREATE TABLE IF NOT EXISTS "usage_daily_active_users" (
+    "org_id" text,
+    "day" text,
+    "user_id" char(36),
+    PRIMARY KEY ("org_id","day","user_id")
+);
+
+CREATE TABLE IF NOT EXISTS "cors_origins" (
+    "id" char(36),
+    "origin" text NOT NULL,
+    "scope" text NOT NULL,
+    "created_by" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_cors_origin_scope" ON "cors_origins" ("origin","scope");
+
+CREATE TABLE IF NOT EXISTS "user_phones" (
+    "id" char(36),
+    "user_id" char(36) NOT NULL,
+    "phone" text NOT NULL,
+    "phone_index" varchar(64) NOT NULL,
+    "is_primary" boolean NOT NULL DEFAULT false,
+    "verified_at" timestamptz,
+    "added_by" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_phones_phone_index" ON "user_phones" ("phone_index");
+CREATE INDEX IF NOT EXISTS "idx_user_phones_user_id" ON "user_phones" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "gate_links" (
+    "id" char(36),
+    "user_id" char(36) NOT NULL,
+    "location_id" bigint NOT NULL,
+    "gate_id" bigint NOT NULL,
+    "gate_title" text,
+    "label" text,
+    "expires_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz,
+    "failed_attempts" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_gate_links_expires_at" ON "gate_links" ("expires_at");
+CREATE INDEX IF NOT EXISTS "idx_gate_links_gate_id" ON "gate_links" ("gate_id");
+CREATE INDEX IF NOT EXISTS "idx_gate_links_user_id" ON "gate_links" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "invite_codes" (
+    "id" char(36),
+    "code" varchar(32) NOT NULL,
+    "note" text,
+    "locations" text,
+    "max_uses" bigint NOT NULL DEFAULT 0,
+    "uses" bigint NOT NULL DEFAULT 0,
+    "expires_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_by" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_invite_codes_code" ON "invite_codes" ("code");
+
+CREATE TABLE IF NOT EXISTS "legal_documents" (
+    "id" char(36),
+    "kind" varchar(16) NOT NULL,
+    "version" varchar(32) NOT NULL,
+    "title" text,
+    "content" text,
+    "published_by" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_legal_documents_created_at" ON "legal_documents" ("created_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_legal_kind_version" ON "legal_documents" ("kind","version");
+
+CREATE TABLE IF NOT EXISTS "legal_acceptances" (
+    "id" char(36),
+    "user_id" char(36) NOT NULL,
+    "document_id" char(36) NOT NULL,
+    "kind" varchar(16) NOT NULL,
+    "version" varchar(32) NOT NULL,
+    "ip_address" text,
+    "accepted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_legal_acceptance_user_document" ON "legal_acceptances" ("user_id","document_id");
+
+CREATE TABLE IF NOT EXISTS "user_history" (
+    "id" char(36),
+    "user_id" char(36),
+    "action" varchar(32) NOT NULL,
+    "changes" text,
+    "actor" text NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_history_user_created" ON "user_history" ("user_id","created_at");
+
+CREATE TABLE IF NOT EXISTS "admin_history" (
+    "id" char(36),
+    "admin_id" char(36),
+    "action" varchar(32) NOT NULL,
+    "changes" text,
+    "actor_id" char(36),
+    "actor" text NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_admin_history_actor_id" ON "admin_history" ("actor_id");
+CREATE INDEX IF NOT EXISTS "idx_admin_history_admin_created" ON "admin_history" ("admin_id","created_at");
+
+CREATE TABLE IF NOT EXISTS "login_otps" (
+    "id" char(36),
+    "phone_index" varchar(64) NOT NULL,
+    "code_hash" varchar(64) NOT NULL,
+    "ip" text,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "expires_at" timestamptz,
+    "consumed_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_login_otps_created_at" ON "login_otps" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_login_otps_ip" ON "login_otps" ("ip");
+CREATE INDEX IF NOT EXISTS "idx_login_otps_phone_index" ON "login_otps" ("phone_index");
+
+CREATE TABLE IF NOT EXISTS "provider_mirror_results" (
+    "id" char(36),
+    "operation" text NOT NULL,
+    "phone" text DEFAULT '',
+    "gate_id" bigint,
+    "primary_outcome" text NOT NULL,
+    "mirror_outcome" text NOT NULL,
+    "mirror_detail" text,
+    "matched" boolean,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_provider_mirror_results_created_at" ON "provider_mirror_results" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_provider_mirror_results_matched" ON "provider_mirror_results" ("matched");
+CREATE INDEX IF NOT EXISTS "idx_provider_mirror_results_operation" ON "provider_mirror_results" ("operation");
+
+CREATE TABLE IF NOT EXISTS "api_keys" (
+    "id" char(36),
+    "name" text NOT NULL,
+    "prefix" varchar(16) NOT NULL,
+    "key_hash" varchar(64) NOT NULL,
+    "scopes" text NOT NULL,
+    "created_by" text,
+    "last_used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_api_keys_key_hash" ON "api_keys" ("key_hash");
+
+CREATE TABLE IF NOT EXISTS "gate_reports" (
+    "id" char(36),
+    "user_id" char(36) NOT NULL,
+    "location_id" bigint NOT NULL,
+    "gate_id" bigint NOT NULL,
+    "gate_title" text,
+    "category" text NOT NULL,
+    "description" text,
+    "photo_key" text,
+    "photo_content_type" text,
+    "status" text NOT NULL DEFAULT 'open',
+    "resolved_at" timestamptz,
+    "resolved_by" text,
+    "resolution_note" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_gate_reports_created_at" ON "gate_reports" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_gate_reports_status" ON "gate_reports" ("status");
+CREATE INDEX IF NOT EXISTS "idx_gate_reports_gate_id" ON "gate_reports" ("gate_id");
+CREATE INDEX IF NOT EXISTS "idx_gate_reports_user_id" ON "gate_reports" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "location_freezes" (
+    "id" char(36),
+    "location_id" bigint NOT NULL,
+    "reason" text,
+    "starts_at" timestamptz NOT NULL,
+    "ends_at" timestamptz,
+    "created_by" text NOT NULL,
+    "lifted_at" timestamptz,
+    "lifted_by" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_location_freezes_lifted_at" ON "location_freezes" ("lifted_at");
+CREATE INDEX IF NOT EXISTS "idx_location_freezes_ends_at" ON "location_freezes" ("ends_at");
+CREATE INDEX IF NOT EXISTS "idx_location_freezes_starts_at" ON "location_freezes" ("starts_at");
+CREATE INDEX IF NOT EXISTS "idx_location_freezes_location_id" ON "location_freezes" ("location_id");
+
+CREATE TABLE IF NOT EXISTS "exports" (
+    "id" char(36),
+    "type" text NOT NULL,
+    "params" text,
+    "status" text NOT NULL DEFAULT 'pending',
+    "file_key" text,
+    "filename" text,
+    "content_type" text,
+    "size_bytes" bigint,
+    "error" text,
+    "requested_by" text,
+    "started_at" timestamptz,
+    "finished_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_exports_created_at" ON "exports" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_exports_finished_at" ON "exports" ("finished_at");
+CREATE INDEX IF NOT EXISTS "idx_exports_status" ON "exports" ("status");
+
+CREATE TABLE IF NOT EXISTS "location_overrides" (
+    "location_id" bigint,
+    "display_name" text,
+    "logo" text,
+    "sort_order" bigint,
+    "updated_by" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("location_id")
+);
+
+CREATE TABLE IF NOT EXISTS "admin_credentials" (
+    "id" char(36),
+    "admin_id" char(36) NOT NULL,
+    "credential_id" varchar(1400) NOT NULL,
+    "public_key" bytea NOT NULL,
+    "algorithm" bigint NOT NULL,
+    "sign_count" bigint NOT NULL DEFAULT 0,
+    "aaguid" varchar(36),
+    "transports" text,
+    "name" text,
+    "backup_eligible" boolean,
+    "last_used_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_admin_credentials_credential_id" ON "admin_credentials" ("credential_id");
+CREATE INDEX IF NOT EXISTS "idx_admin_credentials_admin_id" ON "admin_credentials" ("admin_id");
+
+CREATE TABLE IF NOT EXISTS "webauthn_challenges" (
+    "id" char(36),
+    "challenge" varchar(64) NOT NULL,
+    "purpose" varchar(16) NOT NULL,
+    "admin_id" char(36),
+    "expires_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_webauthn_challenges_expires_at" ON "webauthn_challenges" ("expires_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_webauthn_challenges_challenge" ON "webauthn_challenges" ("challenge");
+
+CREATE TABLE IF NOT EXISTS "gate_maintenance" (
+    "gate_id" bigint,
+    "note" text,
+    "started_by" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("gate_id")
+);
+
+CREATE TABLE IF NOT EXISTS "digest_runs" (
+    "id" char(36),
+    "inactive_since" timestamptz,
+    "eligible" bigint,
+    "opted_out" bigint,
+    "sent" bigint,
+    "failed" bigint,
+    "error" text,
+    "started_at" timestamptz,
+    "finished_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_digest_runs_started_at" ON "digest_runs" ("started_at");
+
+CREATE TABLE IF NOT EXISTS "forced_logouts" (
+    "id" char(36),
+    "reason" text NOT NULL,
+    "status" text NOT NULL DEFAULT 'pending',
+    "total" bigint,
+    "processed" bigint,
+    "last_user_id" char(36),
+    "error" text,
+    "requested_by" text,
+    "started_at" timestamptz,
+    "finished_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_forced_logouts_created_at" ON "forced_logouts" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_forced_logouts_status" ON "forced_logouts" ("status");
+
+CREATE TABLE IF NOT EXISTS "provider_quota_warnings" (
+    "provider" text,
+    "month" text,
+    "threshold" bigint,
+    "calls" bigint,
+    "quota" bigint,
+    "created_at" timestamptz,
+    PRIMARY KEY ("provider","month","threshold")
+);
+
+CREATE TABLE IF NOT EXISTS "audit_comments" (
+    "id" char(36),
+    "target_type" text NOT NULL,
+    "target_id" char(36) NOT NULL,
+    "admin_id" char(36),
+    "admin_name" text,
+    "body" text NOT NULL,
+    "resolution" boolean,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_comments_created_at" ON "audit_comments" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_comment_target" ON "audit_comments" ("target_type","target_id");
+
+CREATE TABLE IF NOT EXISTS "otp_codes" (
+    "id" char(36),
+    "purpose" varchar(32) NOT NULL,
+    "user_id" char(36),
+    "phone_index" varchar(64) NOT NULL,
+    "code_hash" varchar(64) NOT NULL,
+    "ip" text,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "expires_at" timestamptz,
+    "consumed_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_otp_codes_created_at" ON "otp_codes" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_otp_codes_ip" ON "otp_codes" ("ip");
+CREATE INDEX IF NOT EXISTS "idx_otp_codes_phone_index" ON "otp_codes" ("phone_index");
+CREATE INDEX IF NOT EXISTS "idx_otp_codes_user_id" ON "otp_codes" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_otp_codes_purpose" ON "otp_codes" ("purpose");
+
+CREATE TABLE IF NOT EXISTS "webhook_secrets" (
+    "id" char(36),
+    "scope" varchar(32) NOT NULL,
+    "secret" text NOT NULL,
+    "hint" varchar(8),
+    "created_by" text,
+    "expires_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_webhook_secrets_expires_at" ON "webhook_secrets" ("expires_at");
+CREATE INDEX IF NOT EXISTS "idx_webhook_secrets_scope" ON "webhook_secrets" ("scope");
+
+CREATE TABLE IF NOT EXISTS "security_denials" (
+    "id" char(36),
+    "actor_type" text NOT NULL,
+    "actor_id" char(36),
+    "actor_name" text,
+    "actor_role" text,
+    "method" text NOT NULL,
+    "route" text NOT NULL,
+    "path" text NOT NULL,
+    "reason" text NOT NULL,
+    "message" text,
+    "ip_address" text,
+    "user_agent" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_security_denials_created_at" ON "security_denials" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_security_denials_ip_address" ON "security_denials" ("ip_address");
+CREATE INDEX IF NOT EXISTS "idx_security_denials_reason" ON "security_denials" ("reason");
+CREATE INDEX IF NOT EXISTS "idx_security_denials_route" ON "security_denials" ("route");
+CREATE INDEX IF NOT EXISTS "idx_security_denials_actor" ON "security_denials" ("actor_id","created_at");
+CREATE INDEX IF NOT EXISTS "idx_security_denials_actor_type" ON "security_denials" ("actor_type");
+
+CREATE TABLE IF NOT EXISTS "gate_event_logs" (
+    "id" char(36),
+    "user_id" char(36),
+    "gate_id" bigint NOT NULL,
+    "location_id" bigint,
+    "action" text NOT NULL,
+    "result" text NOT NULL,
+    "status_code" bigint NOT NULL,
+    "message" text,
+    "command_id" char(36),
+    "latency_ms" bigint NOT NULL,
+    "ip_address" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_gate_event_logs_created_at" ON "gate_event_logs" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_gate_event_logs_command_id" ON "gate_event_logs" ("command_id");
+CREATE INDEX IF NOT EXISTS "idx_gate_event_logs_result" ON "gate_event_logs" ("result");
+CREATE INDEX IF NOT EXISTS "idx_gate_event_logs_location_id" ON "gate_event_logs" ("location_id");
+CREATE INDEX IF NOT EXISTS "idx_gate_event_logs_gate" ON "gate_event_logs" ("gate_id","created_at");
+CREATE INDEX IF NOT EXISTS "idx_gate_event_logs_user" ON "gate_event_logs" ("user_id","created_at");
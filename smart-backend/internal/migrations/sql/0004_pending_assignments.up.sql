@@ -0,0 +1,18 @@
+-- Failed location/gate assignments waiting to be sent to the provider again.
+
+CREATE TABLE IF NOT EXISTS "pending_assignments" (
+    "id" char(36),
+    "user_id" char(36) NOT NULL,
+    "locations" text,
+    "status" text NOT NULL DEFAULT 'pending',
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "last_error" text,
+    "next_attempt_at" timestamptz,
+    "requested_by" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_pending_assignments_user_id" ON "pending_assignments" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_pending_assignments_status" ON "pending_assignments" ("status");
+CREATE INDEX IF NOT EXISTS "idx_pending_assignments_next_attempt_at" ON "pending_assignments" ("next_attempt_at");
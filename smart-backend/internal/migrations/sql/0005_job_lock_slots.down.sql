@@ -0,0 +1 @@
+ALTER TABLE "job_locks" DROP COLUMN "last_slot";
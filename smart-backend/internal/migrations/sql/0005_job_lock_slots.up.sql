@@ -0,0 +1,3 @@
+-- Scheduled time of the latest scheduled run of each job, so a slot runs on one replica only.
+
+ALTER TABLE "job_locks" ADD COLUMN "last_slot" timestamptz;
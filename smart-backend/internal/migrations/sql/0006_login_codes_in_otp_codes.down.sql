@@ -0,0 +1,16 @@
+DELETE FROM "otp_codes" WHERE "purpose" = 'login';
+
+CREATE TABLE IF NOT EXISTS "login_otps" (
+    "id" char(36),
+    "phone_index" varchar(64) NOT NULL,
+    "code_hash" varchar(64) NOT NULL,
+    "ip" text,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "expires_at" timestamptz,
+    "consumed_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_login_otps_created_at" ON "login_otps" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_login_otps_ip" ON "login_otps" ("ip");
+CREATE INDEX IF NOT EXISTS "idx_login_otps_phone_index" ON "login_otps" ("phone_index");
@@ -0,0 +1,4 @@
+-- Login codes are stored in otp_codes with the "login" purpose. Outstanding login codes expire
+-- within OTP_TTL, so they are dropped with the table instead of copied.
+
+DROP TABLE IF EXISTS "login_otps";
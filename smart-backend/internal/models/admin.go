@@ -11,14 +11,21 @@ import (
 const (
 	RoleSuper   = "super"
 	RoleRegular = "regular"
+	RoleAuditor = "auditor" // Read-only: can view every admin route but change nothing except their own account
 )
 
+// ValidAdminRole reports whether role is one of the admin roles
+func ValidAdminRole(role string) bool {
+	return role == RoleSuper || role == RoleRegular || role == RoleAuditor
+}
+
 type Admin struct {
 	ID           uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
 	Username     string         `gorm:"uniqueIndex:idx_username_deleted_at;not null" json:"username"`
 	Password     string         `gorm:"not null" json:"-"` // Never expose password in JSON
-	Role         string         `gorm:"not null" json:"role"` // "super" or "regular"
+	Role         string         `gorm:"not null" json:"role"` // "super", "regular" or "auditor"
 	TokenVersion int            `gorm:"default:0" json:"-"` // For token invalidation on new login
+	Timezone     string         `json:"timezone"`           // IANA zone of the admin panel, e.g. Asia/Bishkek (empty = DEFAULT_TIMEZONE)
 	CreatedAt    time.Time      `json:"created_at"`
 	UpdatedAt    time.Time      `json:"updated_at"`
 	DeletedAt    gorm.DeletedAt `gorm:"uniqueIndex:idx_username_deleted_at;index" json:"-"` // Soft delete support with composite unique index
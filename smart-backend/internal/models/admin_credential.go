@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Purposes of a WebAuthn challenge
+const (
+	WebAuthnRegistration = "registration"
+	WebAuthnLogin        = "login"
+)
+
+// AdminCredential is a passkey or security key an admin registered for login
+type AdminCredential struct {
+	ID             uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	AdminID        uuid.UUID  `gorm:"type:char(36);index;not null" json:"admin_id"`
+	CredentialID   string     `gorm:"type:varchar(1400);uniqueIndex;not null" json:"credential_id"` // base64url, as browsers report it
+	PublicKey      []byte     `gorm:"not null" json:"-"`                                            // COSE_Key
+	Algorithm      int        `gorm:"not null" json:"algorithm"`                                    // COSE algorithm, e.g. -7 for ES256
+	SignCount      int64      `gorm:"not null;default:0" json:"sign_count"`
+	AAGUID         string     `gorm:"column:aaguid;type:varchar(36)" json:"aaguid"` // Authenticator model
+	Transports     string     `json:"transports"`                                   // Comma-separated hints such as usb,nfc,internal
+	Name           string     `json:"name"`
+	BackupEligible bool       `json:"backup_eligible"` // Synced passkey rather than a device-bound key
+	LastUsedAt     *time.Time `json:"last_used_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (c *AdminCredential) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the AdminCredential model
+func (AdminCredential) TableName() string {
+	return "admin_credentials"
+}
+
+// WebAuthnChallenge is an outstanding passkey registration or login challenge. Each can be
+// answered once, before it expires.
+type WebAuthnChallenge struct {
+	ID        uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	Challenge string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"`
+	Purpose   string     `gorm:"type:varchar(16);not null" json:"purpose"` // registration or login
+	AdminID   *uuid.UUID `gorm:"type:char(36)" json:"admin_id"`            // Admin registering, or the admin named at login (nil = discoverable login)
+	ExpiresAt time.Time  `gorm:"index" json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (c *WebAuthnChallenge) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the WebAuthnChallenge model
+func (WebAuthnChallenge) TableName() string {
+	return "webauthn_challenges"
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Admin history actions
+const (
+	AdminHistoryCreated = "created"
+	AdminHistoryUpdated = "updated" // Username, role or password changed
+	AdminHistoryDeleted = "deleted"
+
+	AdminHistoryPasskeyAdded   = "passkey_added"
+	AdminHistoryPasskeyRemoved = "passkey_removed"
+)
+
+// AdminHistory is one change to an admin account, with who made it and the changed fields
+// before and after it
+type AdminHistory struct {
+	ID        uuid.UUID              `gorm:"type:char(36);primaryKey" json:"id"`
+	AdminID   uuid.UUID              `gorm:"type:char(36);index:idx_admin_history_admin_created" json:"admin_id"` // Admin account that was changed
+	Action    string                 `gorm:"type:varchar(32);not null" json:"action"`
+	Changes   map[string]FieldChange `gorm:"serializer:json;type:text" json:"changes"`
+	ActorID   *uuid.UUID             `gorm:"type:char(36);index" json:"actor_id,omitempty"` // Admin who made the change; nil for the system (initial admin seed)
+	Actor     string                 `gorm:"not null" json:"actor"`                         // Admin username at the time, or "system"
+	CreatedAt time.Time              `gorm:"index:idx_admin_history_admin_created" json:"created_at"`
+}
+
+// TableName specifies the table name for the AdminHistory model
+func (AdminHistory) TableName() string {
+	return "admin_history"
+}
+
+// BeforeCreate is a GORM hook that generates the UUID
+func (h *AdminHistory) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Notification severity levels
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// Notification categories
+const (
+	NotificationSecurity    = "security"
+	NotificationProvider    = "provider"
+	NotificationJobs        = "jobs"
+	NotificationAlerts      = "alerts"       // Ops alert rules that started firing or resolved
+	NotificationGateReports = "gate_reports" // Users reported a problem with a gate
+)
+
+// AdminNotification is an alert shown in the admin panel notification center
+type AdminNotification struct {
+	ID        uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	Severity  string     `gorm:"index;not null" json:"severity"` // "info", "warning" or "critical"
+	Category  string     `gorm:"index;not null" json:"category"` // "security", "provider", "jobs", "alerts", "registrations", "gate_reports"
+	Title     string     `gorm:"not null" json:"title"`
+	Message   string     `gorm:"type:text" json:"message"`
+	ReadAt    *time.Time `gorm:"index" json:"read_at"`         // When an admin marked it as read (nil = unread)
+	ReadBy    *uuid.UUID `gorm:"type:char(36)" json:"read_by"` // Admin who marked it as read
+	CreatedAt time.Time  `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (n *AdminNotification) BeforeCreate(tx *gorm.DB) error {
+	if n.ID == uuid.Nil {
+		n.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the AdminNotification model
+func (AdminNotification) TableName() string {
+	return "admin_notifications"
+}
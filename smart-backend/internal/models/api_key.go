@@ -0,0 +1,52 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// API key scopes
+const (
+	APIKeyScopeSCIM = "scim" // Provision users through /scim/v2
+	APIKeyScopeSync = "sync" // Read the change feed at /sync/changes
+)
+
+// APIKey authenticates a machine client, e.g. a tenant's HR system provisioning users over
+// SCIM. Only a hash of the key is stored; the key itself is shown once, when it is created.
+type APIKey struct {
+	ID         uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	Name       string     `gorm:"not null" json:"name"`                           // What the key is for, e.g. "Acme HR (Okta)"
+	Prefix     string     `gorm:"type:varchar(16);not null" json:"prefix"`        // First characters of the key, to recognize it
+	KeyHash    string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"` // SHA-256 of the key
+	Scopes     string     `gorm:"not null" json:"scopes"`                         // Comma-separated scopes, e.g. "scim"
+	CreatedBy  string     `json:"created_by"`                                     // Username of the admin who created it
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (k *APIKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == uuid.Nil {
+		k.ID = uuid.New()
+	}
+	return nil
+}
+
+// HasScope reports whether the key grants scope
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range strings.Split(k.Scopes, ",") {
+		if strings.TrimSpace(s) == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// TableName specifies the table name for the APIKey model
+func (APIKey) TableName() string {
+	return "api_keys"
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Audit comment targets
+const (
+	AuditCommentAuditLog     = "audit_log"
+	AuditCommentNotification = "notification"
+)
+
+// AuditComment is an admin's note on an audit log entry or a notification center alert,
+// giving investigations their context. Comments form a thread under the entry, oldest
+// first, and are never edited; a resolution note records how the investigation ended.
+type AuditComment struct {
+	ID         uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	TargetType string    `gorm:"index:idx_audit_comment_target;not null" json:"target_type"`             // "audit_log" or "notification"
+	TargetID   uuid.UUID `gorm:"type:char(36);index:idx_audit_comment_target;not null" json:"target_id"` // ID of the commented entry
+	AdminID    uuid.UUID `gorm:"type:char(36)" json:"admin_id"`
+	AdminName  string    `json:"admin_name"` // Admin username (denormalized)
+	Body       string    `gorm:"type:text;not null" json:"body"`
+	Resolution bool      `json:"resolution"` // The comment closes the investigation
+	CreatedAt  time.Time `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (c *AuditComment) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the AuditComment model
+func (AuditComment) TableName() string {
+	return "audit_comments"
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CORS origin scopes
+const (
+	CORSScopePublic = "public" // Public and user endpoints
+	CORSScopeAdmin  = "admin"  // /api/v1/admin/* endpoints
+)
+
+// CORSOrigin is a browser origin allowed to call the API, managed by super admins
+// in addition to the origins from CORS_ALLOWED_ORIGINS / CORS_ADMIN_ALLOWED_ORIGINS
+type CORSOrigin struct {
+	ID        uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Origin    string    `gorm:"uniqueIndex:idx_cors_origin_scope;not null" json:"origin"` // e.g. "https://admin.example.com"
+	Scope     string    `gorm:"uniqueIndex:idx_cors_origin_scope;not null" json:"scope"`  // "public" or "admin"
+	CreatedBy string    `json:"created_by"`                                               // Username of the admin who added it
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (o *CORSOrigin) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the CORSOrigin model
+func (CORSOrigin) TableName() string {
+	return "cors_origins"
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DigestRun records one run of the inactivity digest campaign with its delivery stats
+type DigestRun struct {
+	ID            uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	InactiveSince time.Time  `json:"inactive_since"` // Users without a gate open since then were eligible
+	Eligible      int        `json:"eligible"`       // Inactive users found, including those who opted out
+	OptedOut      int        `json:"opted_out"`      // Skipped because they turned the digest off
+	Sent          int        `json:"sent"`
+	Failed        int        `json:"failed"` // SMS gateway errors; retried on the next run
+	Error         string     `gorm:"type:text" json:"error"`
+	StartedAt     time.Time  `gorm:"index" json:"started_at"`
+	FinishedAt    *time.Time `json:"finished_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (r *DigestRun) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the DigestRun model
+func (DigestRun) TableName() string {
+	return "digest_runs"
+}
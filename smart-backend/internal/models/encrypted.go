@@ -0,0 +1,47 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"ololo-gate/internal/pii"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+func init() {
+	schema.RegisterSerializer("encrypted", EncryptedSerializer{})
+}
+
+// EncryptedSerializer stores string fields tagged `gorm:"serializer:encrypted"` encrypted with
+// pii.Encrypt and decrypts them when rows are loaded. Only struct-based writes (Create, Save,
+// Updates with a struct) go through it: values passed to Update("column", value) or raw SQL
+// must be encrypted by the caller.
+type EncryptedSerializer struct{}
+
+// Scan decrypts a stored value into the field
+func (EncryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var stored string
+	switch value := dbValue.(type) {
+	case nil:
+	case string:
+		stored = value
+	case []byte:
+		stored = string(value)
+	default:
+		return fmt.Errorf("unsupported encrypted value type %T for %s", dbValue, field.Name)
+	}
+
+	plaintext, err := pii.Decrypt(stored)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %w", field.Name, err)
+	}
+	field.ReflectValueOf(ctx, dst).SetString(plaintext)
+	return nil
+}
+
+// Value encrypts the field for storage
+func (EncryptedSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	value, _ := fieldValue.(string)
+	return pii.Encrypt(value)
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Export types
+const (
+	ExportGateEvents = "gate_events"
+	ExportReport     = "report"
+)
+
+// Export statuses
+const (
+	ExportPending = "pending"
+	ExportRunning = "running"
+	ExportReady   = "ready"
+	ExportFailed  = "failed"
+)
+
+// Export is a file generated in the background by the exports job and kept in file storage
+// until it expires
+type Export struct {
+	ID          uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	Type        string     `gorm:"not null" json:"type"`                         // "gate_events" or "report"
+	Params      string     `gorm:"type:text" json:"-"`                           // JSON-encoded services.ExportRequest
+	Status      string     `gorm:"index;not null;default:pending" json:"status"` // "pending", "running", "ready" or "failed"
+	FileKey     string     `json:"-"`                                            // Key of the file in file storage once ready
+	Filename    string     `json:"filename"`                                     // Suggested download name
+	ContentType string     `json:"content_type"`                                 // e.g. "text/csv"
+	SizeBytes   int64      `json:"size_bytes"`
+	Error       string     `gorm:"type:text" json:"error"` // Why the export failed
+	RequestedBy string     `json:"requested_by"`           // Username of the admin who requested the export
+	StartedAt   *time.Time `json:"started_at"`
+	FinishedAt  *time.Time `gorm:"index" json:"finished_at"`
+	CreatedAt   time.Time  `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (e *Export) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the Export model
+func (Export) TableName() string {
+	return "exports"
+}
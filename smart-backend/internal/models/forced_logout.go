@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Forced logout statuses
+const (
+	ForcedLogoutPending   = "pending"
+	ForcedLogoutRunning   = "running"
+	ForcedLogoutCompleted = "completed"
+	ForcedLogoutFailed    = "failed"
+)
+
+// ForcedLogout is a request to sign every user out, e.g. after a security incident. The forced
+// logout job bumps the token version of the users in batches, in ID order, and records how
+// far it got so an interrupted run resumes where it stopped.
+type ForcedLogout struct {
+	ID          uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	Reason      string     `gorm:"type:text;not null" json:"reason"`
+	Status      string     `gorm:"index;not null;default:pending" json:"status"` // "pending", "running", "completed" or "failed"
+	Total       int64      `json:"total"`                                        // Users to sign out, counted when the run starts
+	Processed   int64      `json:"processed"`                                    // Users signed out so far
+	LastUserID  string     `gorm:"type:char(36)" json:"-"`                       // Last user signed out; the next batch starts after it
+	Error       string     `gorm:"type:text" json:"error"`                       // Why the run failed
+	RequestedBy string     `json:"requested_by"`                                 // Username of the super admin who requested it
+	StartedAt   *time.Time `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+	CreatedAt   time.Time  `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (f *ForcedLogout) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == uuid.Nil {
+		f.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the ForcedLogout model
+func (ForcedLogout) TableName() string {
+	return "forced_logouts"
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Gate command lifecycle statuses: accepted -> executing -> confirmed/failed.
+// Commands issued while the provider is down go accepted -> queued -> executing.
+const (
+	GateCommandAccepted  = "accepted"
+	GateCommandQueued    = "queued"
+	GateCommandExecuting = "executing"
+	GateCommandConfirmed = "confirmed"
+	GateCommandFailed    = "failed"
+)
+
+// GateCommand tracks a single open/close command from acceptance until the barrier is confirmed in position
+type GateCommand struct {
+	ID           uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID       uuid.UUID  `gorm:"type:char(36);index" json:"user_id"` // User who issued the command
+	Phone        string     `gorm:"not null" json:"phone"`              // Phone used for provider status lookups
+	GateID       int        `gorm:"index;not null" json:"gate_id"`
+	Action       string     `gorm:"not null" json:"action"`                // "open" or "close"
+	Status       string     `gorm:"index;not null" json:"status"`          // "accepted", "queued", "executing", "confirmed" or "failed"
+	ErrorMessage string     `gorm:"type:text" json:"error_message"`        // Reason if failed
+	Sandbox      bool       `gorm:"not null;default:false" json:"sandbox"` // Executed by the gate simulator (SANDBOX_MODE), no barrier moved
+	CompletedAt  *time.Time `json:"completed_at"`                          // When the command reached a terminal status
+	CreatedAt    time.Time  `gorm:"index" json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (g *GateCommand) BeforeCreate(tx *gorm.DB) error {
+	if g.ID == uuid.Nil {
+		g.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsTerminal reports whether the command has finished (confirmed or failed)
+func (g *GateCommand) IsTerminal() bool {
+	return g.Status == GateCommandConfirmed || g.Status == GateCommandFailed
+}
+
+// TableName specifies the table name for the GateCommand model
+func (GateCommand) TableName() string {
+	return "gate_commands"
+}
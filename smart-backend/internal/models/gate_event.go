@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GateEvent is an append-only record of a gate command reaching a status.
+// Rows are never updated; they are keyed by Hour so they can be rolled up and purged by time window.
+type GateEvent struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	Hour       time.Time `gorm:"index:idx_gate_events_hour_gate,priority:1;not null" json:"hour"` // OccurredAt truncated to the UTC hour
+	GateID     int       `gorm:"index:idx_gate_events_hour_gate,priority:2;not null" json:"gate_id"`
+	CommandID  uuid.UUID `gorm:"type:char(36);index" json:"command_id"`
+	UserID     uuid.UUID `gorm:"type:char(36)" json:"user_id"`
+	Action     string    `gorm:"not null" json:"action"` // "open" or "close"
+	Status     string    `gorm:"not null" json:"status"` // Status the command reached
+	OccurredAt time.Time `gorm:"not null" json:"occurred_at"`
+}
+
+// TableName specifies the table name for the GateEvent model
+func (GateEvent) TableName() string {
+	return "gate_events"
+}
+
+// GateEventRollup is the number of gate events per hour, gate, action and status
+type GateEventRollup struct {
+	Hour   time.Time `gorm:"primaryKey" json:"hour"` // UTC hour
+	GateID int       `gorm:"primaryKey;autoIncrement:false" json:"gate_id"`
+	Action string    `gorm:"primaryKey" json:"action"`
+	Status string    `gorm:"primaryKey" json:"status"`
+	Count  int64     `gorm:"not null;default:0" json:"count"`
+}
+
+// TableName specifies the table name for the GateEventRollup model
+func (GateEventRollup) TableName() string {
+	return "gate_event_rollups"
+}
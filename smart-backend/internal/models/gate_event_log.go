@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Results of a gate command attempt in the gate event log
+const (
+	GateAttemptSent    = "sent"    // The provider took the command
+	GateAttemptQueued  = "queued"  // The provider was unavailable, the command was queued
+	GateAttemptRefused = "refused" // Refused before reaching the provider: no access, impersonation, maintenance, freeze or a conflicting command
+	GateAttemptFailed  = "failed"  // The provider or the API failed
+)
+
+// GateEventLog is an append-only record of a user's gate open or close attempt, whatever its
+// outcome. Unlike GateEvent it also covers attempts refused before a command was created; those
+// that got one link to it.
+type GateEventLog struct {
+	ID         uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:char(36);index:idx_gate_event_logs_user,priority:1" json:"user_id"`
+	GateID     int        `gorm:"index:idx_gate_event_logs_gate,priority:1;not null" json:"gate_id"`
+	LocationID *int       `gorm:"index" json:"location_id"` // From the user's gate list; unknown for gates not assigned to the user
+	Action     string     `gorm:"not null" json:"action"`   // "open" or "close"
+	Result     string     `gorm:"index;not null" json:"result"`
+	StatusCode int        `gorm:"not null" json:"status_code"` // Response status
+	Message    string     `gorm:"type:text" json:"message"`    // Response message
+	CommandID  *uuid.UUID `gorm:"type:char(36);index" json:"command_id"`
+	LatencyMs  int64      `gorm:"not null" json:"latency_ms"` // Time to respond to the user
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `gorm:"index;index:idx_gate_event_logs_user,priority:2;index:idx_gate_event_logs_gate,priority:2" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (l *GateEventLog) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the GateEventLog model
+func (GateEventLog) TableName() string {
+	return "gate_event_logs"
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GateLink is a shareable deep link to one gate. The link carries an HMAC signature of its ID,
+// gate and expiry, so a link cannot be forged or extended by editing it.
+type GateLink struct {
+	ID         uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:char(36);index;not null" json:"user_id"` // User who shared the gate
+	LocationID int        `gorm:"not null" json:"location_id"`
+	GateID     int        `gorm:"index;not null" json:"gate_id"`
+	GateTitle  string     `json:"gate_title"` // Gate title at the provider when the link was created
+	Label      string     `json:"label"`      // Optional note from the user, e.g. "Courier"
+	ExpiresAt  time.Time  `gorm:"index" json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+
+	FailedAttempts int `gorm:"not null;default:0" json:"failed_attempts"` // Client IPs that resolved it with a wrong signature; the link is revoked after GATE_LINK_MAX_FAILED_ATTEMPTS
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (l *GateLink) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive reports whether the link can still be resolved
+func (l *GateLink) IsActive() bool {
+	return l.RevokedAt == nil && time.Now().Before(l.ExpiresAt)
+}
+
+// TableName specifies the table name for the GateLink model
+func (GateLink) TableName() string {
+	return "gate_links"
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// GateMaintenance marks a gate as under maintenance: users can neither open nor close it and
+// queued commands for it are dropped until an admin ends the maintenance
+type GateMaintenance struct {
+	GateID    int       `gorm:"primaryKey;autoIncrement:false" json:"gate_id"`
+	Note      string    `gorm:"type:text" json:"note"` // Shown to users, e.g. "Barrier motor replacement until Friday"
+	StartedBy string    `json:"started_by"`            // Username of the admin who last set the maintenance
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for the GateMaintenance model
+func (GateMaintenance) TableName() string {
+	return "gate_maintenance"
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Gate report categories
+const (
+	GateReportStuckOpen   = "stuck_open"
+	GateReportStuckClosed = "stuck_closed"
+	GateReportDamaged     = "damaged"
+	GateReportOther       = "other"
+)
+
+// Gate report statuses
+const (
+	GateReportOpen     = "open"
+	GateReportResolved = "resolved"
+)
+
+// ValidGateReportCategory reports whether category is one users can report
+func ValidGateReportCategory(category string) bool {
+	switch category {
+	case GateReportStuckOpen, GateReportStuckClosed, GateReportDamaged, GateReportOther:
+		return true
+	}
+	return false
+}
+
+// GateReport is a problem with a gate reported by a user (a stuck or damaged barrier),
+// waiting in the admin queue until the facility team resolves it
+type GateReport struct {
+	ID               uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID           uuid.UUID  `gorm:"type:char(36);index;not null" json:"user_id"` // User who reported the problem
+	LocationID       int        `gorm:"not null" json:"location_id"`
+	GateID           int        `gorm:"index;not null" json:"gate_id"`
+	GateTitle        string     `json:"gate_title"`                                // Gate title at the provider when the report was made
+	Category         string     `gorm:"not null" json:"category"`                  // "stuck_open", "stuck_closed", "damaged" or "other"
+	Description      string     `gorm:"type:text" json:"description"`              // Optional details from the user
+	PhotoKey         string     `json:"-"`                                         // Key of the optional photo in file storage
+	PhotoContentType string     `json:"photo_content_type"`                        // e.g. "image/jpeg" (empty = no photo)
+	Status           string     `gorm:"index;not null;default:open" json:"status"` // "open" or "resolved"
+	ResolvedAt       *time.Time `json:"resolved_at"`
+	ResolvedBy       string     `json:"resolved_by"`     // Username of the admin who resolved the report
+	ResolutionNote   string     `json:"resolution_note"` // What was done, from the admin
+	CreatedAt        time.Time  `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (r *GateReport) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the GateReport model
+func (GateReport) TableName() string {
+	return "gate_reports"
+}
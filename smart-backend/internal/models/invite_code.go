@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InviteLocation is a location, and the gates in it, a user registering with an invite code is assigned
+type InviteLocation struct {
+	LocationID int   `json:"location_id"`
+	GateIds    []int `json:"gate_ids"`
+}
+
+// InviteCode lets a person register through POST /auth/register. Codes are generated by admins
+// and can be limited in uses and time; users registering with one get its locations and gates.
+type InviteCode struct {
+	ID        uuid.UUID        `gorm:"type:char(36);primaryKey" json:"id"`
+	Code      string           `gorm:"type:varchar(32);uniqueIndex;not null" json:"code"`
+	Note      string           `json:"note"`                                       // Who or what the code is for, e.g. "Building 4 residents"
+	Locations []InviteLocation `gorm:"serializer:json;type:text" json:"locations"` // Assigned to users registering with the code (empty = no access)
+	MaxUses   int              `gorm:"not null;default:0" json:"max_uses"`         // Registrations allowed with the code (0 = unlimited)
+	Uses      int              `gorm:"not null;default:0" json:"uses"`             // Registrations made with the code
+	ExpiresAt *time.Time       `json:"expires_at"`                                 // NULL = never expires
+	RevokedAt *time.Time       `json:"revoked_at"`
+	CreatedBy string           `json:"created_by"` // Username of the admin who generated it
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (i *InviteCode) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive reports whether the code can still be used to register
+func (i *InviteCode) IsActive() bool {
+	return i.RevokedAt == nil &&
+		(i.ExpiresAt == nil || time.Now().Before(*i.ExpiresAt)) &&
+		(i.MaxUses == 0 || i.Uses < i.MaxUses)
+}
+
+// TableName specifies the table name for the InviteCode model
+func (InviteCode) TableName() string {
+	return "invite_codes"
+}
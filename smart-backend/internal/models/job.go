@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Job run statuses
+const (
+	JobRunRunning   = "running"
+	JobRunSucceeded = "succeeded"
+	JobRunFailed    = "failed"
+)
+
+// JobRun records a single execution of a scheduled job
+type JobRun struct {
+	ID         uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	JobName    string     `gorm:"index;not null" json:"job_name"`
+	Instance   string     `json:"instance"`                     // Replica that ran the job
+	Status     string     `gorm:"index;not null" json:"status"` // "running", "succeeded" or "failed"
+	Error      string     `gorm:"type:text" json:"error"`       // Error message if failed
+	StartedAt  time.Time  `gorm:"index" json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (j *JobRun) BeforeCreate(tx *gorm.DB) error {
+	if j.ID == uuid.Nil {
+		j.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the JobRun model
+func (JobRun) TableName() string {
+	return "job_runs"
+}
+
+// JobLock is a lease that lets only one replica run a scheduled job at a time
+type JobLock struct {
+	JobName     string     `gorm:"primaryKey" json:"job_name"`
+	Owner       string     `json:"owner"`        // Replica holding the lease
+	LockedUntil time.Time  `json:"locked_until"` // Lease expiry; expired leases can be taken over
+	LastSlot    *time.Time `json:"last_slot"`    // Scheduled time of the latest scheduled run taken, which no replica runs again
+}
+
+// TableName specifies the table name for the JobLock model
+func (JobLock) TableName() string {
+	return "job_locks"
+}
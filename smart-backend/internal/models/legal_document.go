@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Legal document kinds
+const (
+	LegalTermsOfService = "terms"   // Terms of service
+	LegalPrivacyPolicy  = "privacy" // Privacy policy
+)
+
+// LegalDocument is one published version of the terms of service or the privacy policy.
+// The most recently published version of each kind is the one users must accept.
+type LegalDocument struct {
+	ID          uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Kind        string    `gorm:"type:varchar(16);uniqueIndex:idx_legal_kind_version;not null" json:"kind"`    // "terms" or "privacy"
+	Version     string    `gorm:"type:varchar(32);uniqueIndex:idx_legal_kind_version;not null" json:"version"` // e.g. "2025-01"
+	Title       string    `json:"title"`
+	Content     string    `gorm:"type:text" json:"content"`
+	PublishedBy string    `json:"published_by"` // Username of the admin who published it
+	CreatedAt   time.Time `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (d *LegalDocument) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the LegalDocument model
+func (LegalDocument) TableName() string {
+	return "legal_documents"
+}
+
+// LegalAcceptance records that a user accepted a version of a legal document
+type LegalAcceptance struct {
+	ID         uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID     uuid.UUID `gorm:"type:char(36);uniqueIndex:idx_legal_acceptance_user_document;not null" json:"user_id"`
+	DocumentID uuid.UUID `gorm:"type:char(36);uniqueIndex:idx_legal_acceptance_user_document;not null" json:"document_id"`
+	Kind       string    `gorm:"type:varchar(16);not null" json:"kind"`
+	Version    string    `gorm:"type:varchar(32);not null" json:"version"`
+	IPAddress  string    `json:"ip_address"` // IP address the acceptance was made from
+	AcceptedAt time.Time `json:"accepted_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (a *LegalAcceptance) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the LegalAcceptance model
+func (LegalAcceptance) TableName() string {
+	return "legal_acceptances"
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// LocationOverride replaces the branding and position of a location from the third-party
+// API in location lists. Empty fields keep the provider's value.
+type LocationOverride struct {
+	LocationID  int       `gorm:"primaryKey;autoIncrement:false" json:"location_id"`
+	DisplayName string    `json:"display_name"` // Replaces the provider's title
+	Logo        string    `json:"logo"`         // Logo URL replacing the provider's logo
+	SortOrder   *int      `json:"sort_order"`   // Locations with a sort order are listed first, lowest first (nil = provider order)
+	UpdatedBy   string    `json:"updated_by"`   // Username of the admin who last changed the override
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for the LocationOverride model
+func (LocationOverride) TableName() string {
+	return "location_overrides"
+}
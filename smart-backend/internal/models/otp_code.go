@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// One-time code purposes
+const (
+	OTPPurposeLogin         = "login"          // Passwordless login
+	OTPPurposeRegistration  = "registration"   // Confirms the phone number of a new registration
+	OTPPurposePasswordReset = "password_reset" // Lets a user who forgot their password set a new one
+)
+
+// OTPCode is a one-time code sent by SMS to log in or confirm a phone number. Neither the
+// phone number nor the code is stored: the phone is kept as its blind index and the code as
+// a hash.
+type OTPCode struct {
+	ID         uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	Purpose    string     `gorm:"type:varchar(32);index;not null" json:"purpose"` // "login", "registration" or "password_reset"
+	UserID     *uuid.UUID `gorm:"type:char(36);index" json:"user_id"`             // User the code confirms; nil when requested for a number without one
+	PhoneIndex string     `gorm:"type:varchar(64);index;not null" json:"-"`       // Blind index of the phone the code was sent to
+	CodeHash   string     `gorm:"type:varchar(64);not null" json:"-"`
+	IP         string     `gorm:"index" json:"ip"` // Client IP that requested the code
+	Attempts   int        `gorm:"not null;default:0" json:"attempts"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	ConsumedAt *time.Time `json:"consumed_at"` // Set once the code is used or invalidated
+	CreatedAt  time.Time  `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (o *OTPCode) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the OTPCode model
+func (OTPCode) TableName() string {
+	return "otp_codes"
+}
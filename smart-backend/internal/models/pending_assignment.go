@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Pending assignment statuses
+const (
+	AssignmentPending = "pending"
+	AssignmentFailed  = "failed"
+)
+
+// PendingAssignment is a location/gate assignment the provider refused, kept so the
+// assignment_retry job can send it again. A user has at most one: a newer assignment
+// replaces it, and it is deleted once the provider accepts it.
+type PendingAssignment struct {
+	ID            uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID        uuid.UUID `gorm:"type:char(36);uniqueIndex;not null" json:"user_id"`
+	Locations     string    `gorm:"type:text" json:"-"`                           // JSON-encoded []services.LocationAssignmentDTO
+	Status        string    `gorm:"index;not null;default:pending" json:"status"` // "pending", or "failed" once given up
+	Attempts      int       `gorm:"not null;default:0" json:"attempts"`
+	LastError     string    `gorm:"type:text" json:"last_error"`
+	NextAttemptAt time.Time `gorm:"index" json:"next_attempt_at"`
+	RequestedBy   string    `json:"requested_by"` // Username of the admin whose assignment failed
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (p *PendingAssignment) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the PendingAssignment model
+func (PendingAssignment) TableName() string {
+	return "pending_assignments"
+}
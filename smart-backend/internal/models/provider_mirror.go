@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProviderMirrorResult compares one write (assignment or gate command) sent to the current gate
+// provider with the same write mirrored to the provider being migrated to
+type ProviderMirrorResult struct {
+	ID             uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Operation      string    `gorm:"index;not null" json:"operation"`                                  // Client operation, e.g. "assign_user" or "open_gate"
+	Phone          string    `gorm:"serializer:encrypted;type:text;default:''" json:"phone,omitempty"` // User of an assignment (encrypted at rest)
+	GateID         int       `json:"gate_id,omitempty"`                                                // Gate of a gate command
+	PrimaryOutcome string    `gorm:"not null" json:"primary_outcome"`                                  // e.g. "ok", "ok:true" or "error:provider_unavailable"
+	MirrorOutcome  string    `gorm:"not null" json:"mirror_outcome"`
+	MirrorDetail   string    `json:"mirror_detail,omitempty"` // Why the mirrored call failed
+	Matched        bool      `gorm:"index" json:"matched"`    // Both providers had the same outcome
+	CreatedAt      time.Time `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (r *ProviderMirrorResult) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the ProviderMirrorResult model
+func (ProviderMirrorResult) TableName() string {
+	return "provider_mirror_results"
+}
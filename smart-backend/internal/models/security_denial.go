@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Callers refused by a security denial
+const (
+	DenialActorAdmin     = "admin"
+	DenialActorUser      = "user"
+	DenialActorAPIKey    = "api_key"
+	DenialActorAnonymous = "anonymous"
+)
+
+// Reasons of security denials. Refusals without a specific reason are recorded as DenialForbidden
+// with the response message.
+const (
+	DenialSuperAdminRequired = "super_admin_required" // Admin route reserved to super admins
+	DenialNotOwner           = "not_owner"            // Regular admin accessing another admin's record
+	DenialAuditorReadOnly    = "auditor_read_only"    // Auditor attempting a change
+	DenialAPIKeyScope        = "api_key_scope"        // API key without the scope of the route
+	DenialImpersonation      = "impersonation"        // Gate operation with an impersonation token
+	DenialGateNotAssigned    = "gate_not_assigned"    // Gate command for a gate not assigned to the user
+	DenialInvalidSignature   = "invalid_signature"    // Signed link with a wrong signature
+	DenialUnknownRequirement = "unknown_requirement"  // Route with a misconfigured access rule
+	DenialForbidden          = "forbidden"
+)
+
+// SecurityDenial is an append-only record of a request refused with 403, for detecting
+// privilege probing. User actors are identified by ID only; their phone is not stored.
+type SecurityDenial struct {
+	ID        uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	ActorType string     `gorm:"index;not null" json:"actor_type"`                                          // admin, user, api_key or anonymous
+	ActorID   *uuid.UUID `gorm:"type:char(36);index:idx_security_denials_actor,priority:1" json:"actor_id"` // Admin, user or API key ID
+	ActorName string     `json:"actor_name"`                                                                // Admin username or api_key:<name>; for impersonated users, the impersonating admin
+	ActorRole string     `json:"actor_role"`                                                                // Admin role
+	Method    string     `gorm:"not null" json:"method"`
+	Route     string     `gorm:"index;not null" json:"route"` // Access rule pattern, e.g. /api/v1/admins/:id
+	Path      string     `gorm:"not null" json:"path"`        // Requested path
+	Reason    string     `gorm:"index;not null" json:"reason"`
+	Message   string     `gorm:"type:text" json:"message"` // Message returned to the caller
+	IPAddress string     `gorm:"index" json:"ip_address"`
+	UserAgent string     `gorm:"type:text" json:"user_agent"`
+	CreatedAt time.Time  `gorm:"index;index:idx_security_denials_actor,priority:2" json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (d *SecurityDenial) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the SecurityDenial model
+func (SecurityDenial) TableName() string {
+	return "security_denials"
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Usage metrics recorded for billing
+const (
+	UsageAPICalls       = "api_calls"
+	UsageGateOperations = "gate_operations"
+	UsageSMSSent        = "sms_sent"
+	UsageActiveUsers    = "active_users" // Derived from UsageActiveUser rows, not stored as a counter
+)
+
+// Operational metrics recorded alongside usage for reports; they are not billed
+const (
+	UsageProviderCalls  = "provider_calls"  // Requests sent to the third-party gate API
+	UsageProviderErrors = "provider_errors" // Of which failed: unreachable, non-200 or malformed response
+
+	UsageMirrorProviderCalls = "mirror_provider_calls" // Requests mirrored to the migration provider
+)
+
+// UsageCounter is a daily per-organization usage counter
+type UsageCounter struct {
+	OrgID     string    `gorm:"primaryKey" json:"org_id"`
+	Metric    string    `gorm:"primaryKey" json:"metric"`
+	Day       string    `gorm:"primaryKey" json:"day"` // UTC day, YYYY-MM-DD
+	Value     int64     `gorm:"not null;default:0" json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for the UsageCounter model
+func (UsageCounter) TableName() string {
+	return "usage_counters"
+}
+
+// UsageActiveUser records that a user was active in an organization during a month
+type UsageActiveUser struct {
+	OrgID       string    `gorm:"primaryKey" json:"org_id"`
+	Month       string    `gorm:"primaryKey" json:"month"` // UTC month, YYYY-MM
+	UserID      uuid.UUID `gorm:"type:char(36);primaryKey" json:"user_id"`
+	FirstSeenAt time.Time `json:"first_seen_at"`
+}
+
+// TableName specifies the table name for the UsageActiveUser model
+func (UsageActiveUser) TableName() string {
+	return "usage_active_users"
+}
+
+// UsageDailyActiveUser records that a user was active in an organization during a day
+type UsageDailyActiveUser struct {
+	OrgID  string    `gorm:"primaryKey" json:"org_id"`
+	Day    string    `gorm:"primaryKey" json:"day"` // UTC day, YYYY-MM-DD
+	UserID uuid.UUID `gorm:"type:char(36);primaryKey" json:"user_id"`
+}
+
+// TableName specifies the table name for the UsageDailyActiveUser model
+func (UsageDailyActiveUser) TableName() string {
+	return "usage_daily_active_users"
+}
+
+// ProviderQuotaWarning records that a provider's calls in a month crossed a share of its
+// monthly quota, so admins are warned once per threshold and month
+type ProviderQuotaWarning struct {
+	Provider  string    `gorm:"primaryKey" json:"provider"`  // "primary" or "mirror"
+	Month     string    `gorm:"primaryKey" json:"month"`     // UTC month, YYYY-MM
+	Threshold int       `gorm:"primaryKey" json:"threshold"` // Percent of the quota, e.g. 80
+	Calls     int64     `json:"calls"`                       // Calls counted when the threshold was crossed
+	Quota     int64     `json:"quota"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for the ProviderQuotaWarning model
+func (ProviderQuotaWarning) TableName() string {
+	return "provider_quota_warnings"
+}
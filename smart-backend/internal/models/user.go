@@ -1,6 +1,8 @@
 package models
 
 import (
+	"ololo-gate/internal/pii"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,15 +10,85 @@ import (
 	"gorm.io/gorm"
 )
 
+// phoneSuffixDigits is how many trailing digits PhoneSuffixIndex covers for admin search
+const phoneSuffixDigits = 4
+
+// Registration statuses of a user
+const (
+	RegistrationApproved   = "approved"   // Can log in
+	RegistrationPending    = "pending"    // Registered without an invite code, waiting for an admin
+	RegistrationRejected   = "rejected"   // Rejected by an admin, see RejectionReason
+	RegistrationUnverified = "unverified" // Waiting for the code sent by SMS to confirm the phone number (OTP_REGISTRATION_ENABLED)
+)
+
 type User struct {
-	ID              uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
-	Phone           string         `gorm:"uniqueIndex:idx_phone_deleted_at;not null" json:"phone"`
-	Password        string         `gorm:"not null" json:"-"` // Never expose password in JSON
-	TokenVersion    int            `gorm:"default:0;not null" json:"-"` // Token version for invalidation
-	CurrentDeviceID string         `gorm:"type:varchar(255);default:''" json:"-"` // Track current device for device-based token invalidation
-	CreatedAt       time.Time      `json:"created_at"`
-	UpdatedAt       time.Time      `json:"updated_at"`
-	DeletedAt       gorm.DeletedAt `gorm:"uniqueIndex:idx_phone_deleted_at;index" json:"-"` // Soft delete support with composite unique index
+	ID                 uuid.UUID      `gorm:"type:char(36);primaryKey" json:"id"`
+	Phone              string         `gorm:"serializer:encrypted;not null" json:"phone"` // Encrypted at rest; look up by PhoneIndex
+	PhoneIndex         string         `gorm:"type:varchar(64);uniqueIndex:idx_phone_index_deleted_at" json:"-"` // Blind index of Phone for lookups and uniqueness
+	PhoneSuffixIndex   string         `gorm:"type:varchar(64);index" json:"-"` // Blind index of the last digits of Phone for admin search
+	Email              string         `gorm:"serializer:encrypted;type:text;default:''" json:"email,omitempty"` // Optional alternative login identifier (encrypted at rest; look up by EmailIndex)
+	EmailIndex         *string        `gorm:"type:varchar(64);uniqueIndex:idx_email_index_deleted_at" json:"-"` // Blind index of the normalized Email; NULL when the user has no email
+	EmailVerifiedAt    *time.Time     `json:"email_verified_at,omitempty"` // Only verified emails can be used to log in
+	Password           string         `gorm:"not null" json:"-"` // Never expose password in JSON
+	PasswordChangedAt  *time.Time     `json:"password_changed_at,omitempty"` // Last password change; NULL for users created before it was tracked (CreatedAt applies)
+	TokenVersion       int            `gorm:"default:0;not null" json:"-"` // Token version for invalidation
+	CurrentDeviceID    string         `gorm:"serializer:encrypted;type:text;default:''" json:"-"` // Track current device for device-based token invalidation (encrypted at rest)
+	TrashedAt          *time.Time     `gorm:"index" json:"trashed_at,omitempty"` // Set when the user is deleted; login is blocked and the user is purged after USER_TRASH_RETENTION
+	TrashedBy          string         `json:"trashed_by,omitempty"` // Admin who moved the user to the trash
+	InviteCodeID       *uuid.UUID     `gorm:"type:char(36);index" json:"invite_code_id,omitempty"` // Invite code the user registered with
+	RegistrationStatus string         `gorm:"type:varchar(16);not null;default:'approved';index" json:"registration_status"` // approved, pending, rejected or unverified; only approved users can log in
+	ReviewedBy         string         `json:"reviewed_by,omitempty"` // Admin who approved or rejected a pending registration
+	ReviewedAt         *time.Time     `json:"reviewed_at,omitempty"`
+	RejectionReason    string         `json:"rejection_reason,omitempty"`
+	ExternalID         string         `gorm:"type:varchar(255);default:'';index" json:"external_id,omitempty"` // ID of the user in the identity provider provisioning it over SCIM
+	MaxSessions        *int           `json:"max_sessions,omitempty"` // Concurrent session limit overriding JWT_MAX_SESSIONS (0 = unlimited); NULL uses the deployment limit
+	DigestOptOut       bool           `gorm:"not null;default:false" json:"digest_opt_out"` // Turned the inactivity digest off in their notification preferences
+	DigestSentAt       *time.Time     `json:"-"` // Last inactivity digest sent, so a user gets at most one a week
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          gorm.DeletedAt `gorm:"uniqueIndex:idx_phone_index_deleted_at;uniqueIndex:idx_email_index_deleted_at;index" json:"-"` // Soft delete support with composite unique index
+}
+
+// BeforeSave is a GORM hook that keeps the phone and email blind indexes in sync
+func (u *User) BeforeSave(tx *gorm.DB) error {
+	if u.Phone != "" {
+		u.PhoneIndex = pii.BlindIndex(u.Phone)
+		u.PhoneSuffixIndex = pii.BlindIndex(PhoneSuffix(u.Phone))
+	}
+	u.Email = NormalizeEmail(u.Email)
+	if u.Email != "" {
+		index := pii.BlindIndex(u.Email)
+		u.EmailIndex = &index
+	} else {
+		u.EmailIndex = nil
+	}
+	return nil
+}
+
+// NormalizeEmail returns the form emails are stored and indexed in, so lookups are case-insensitive
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// AfterSave is a GORM hook that keeps the primary user_phones row in sync with Phone
+func (u *User) AfterSave(tx *gorm.DB) error {
+	return SyncPrimaryPhone(tx, u)
+}
+
+// AfterDelete is a GORM hook that releases the user's phone numbers, so they can be registered again
+func (u *User) AfterDelete(tx *gorm.DB) error {
+	if u.ID == uuid.Nil {
+		return nil
+	}
+	return tx.Session(&gorm.Session{NewDB: true}).Where("user_id = ?", u.ID).Delete(&UserPhone{}).Error
+}
+
+// PhoneSuffix returns the trailing digits of phone covered by PhoneSuffixIndex
+func PhoneSuffix(phone string) string {
+	if len(phone) <= phoneSuffixDigits {
+		return phone
+	}
+	return phone[len(phone)-phoneSuffixDigits:]
 }
 
 // BeforeCreate is a GORM hook that hashes the password and generates UUID before saving to database
@@ -25,6 +97,9 @@ func (u *User) BeforeCreate(tx *gorm.DB) error {
 	if u.ID == uuid.Nil {
 		u.ID = uuid.New()
 	}
+	if u.RegistrationStatus == "" {
+		u.RegistrationStatus = RegistrationApproved
+	}
 
 	// Hash the password with bcrypt (cost 10)
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
@@ -32,9 +107,27 @@ func (u *User) BeforeCreate(tx *gorm.DB) error {
 		return err
 	}
 	u.Password = string(hashedPassword)
+	if u.PasswordChangedAt == nil {
+		now := time.Now()
+		u.PasswordChangedAt = &now
+	}
 	return nil
 }
 
+// PasswordLastChanged returns when the password was last set, falling back to CreatedAt for
+// users created before password changes were tracked
+func (u *User) PasswordLastChanged() time.Time {
+	if u.PasswordChangedAt != nil {
+		return *u.PasswordChangedAt
+	}
+	return u.CreatedAt
+}
+
+// PasswordExpired reports whether the password is older than maxAge. A maxAge <= 0 never expires.
+func (u *User) PasswordExpired(maxAge time.Duration) bool {
+	return maxAge > 0 && time.Since(u.PasswordLastChanged()) > maxAge
+}
+
 // CheckPassword verifies if the provided password matches the stored hash
 func (u *User) CheckPassword(password string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
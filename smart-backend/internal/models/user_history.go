@@ -0,0 +1,71 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// User history actions
+const (
+	UserHistoryCreated         = "created"          // Created by an admin
+	UserHistoryRegistered      = "registered"       // Self-registered
+	UserHistoryUpdated         = "updated"          // Phone, email or password changed by an admin
+	UserHistoryPasswordChanged = "password_changed" // Password changed by the user
+	UserHistoryPasswordReset   = "password_reset"   // Password reset with a code sent by SMS
+	UserHistoryAssigned        = "assigned"         // Locations and gates changed
+	UserHistoryPhoneAdded      = "phone_added"      // Secondary number added
+	UserHistoryPhoneRemoved    = "phone_removed"
+	UserHistoryTrashed         = "trashed"
+	UserHistoryRestored        = "restored"
+	UserHistoryPurged          = "purged"          // Deleted after the trash retention, gate access revoked
+	UserHistoryApproved        = "approved"        // Registration approved
+	UserHistoryRejected        = "rejected"        // Registration rejected
+	UserHistoryMerged          = "merged"          // Other users were merged into this user
+	UserHistoryMergedInto      = "merged_into"     // This user was merged into another user and deleted
+	UserHistorySessionEvicted  = "session_evicted" // Oldest session logged out by the session limit at login
+	UserHistoryDeviceRemoved   = "device_removed"  // Session unused for JWT_STALE_DEVICE_AFTER deleted by the stale device purge
+	UserHistoryPhoneVerified   = "phone_verified"  // Registration confirmed with the code sent by SMS
+)
+
+// FieldChange is the value of a field before and after a change. A nil Before
+// means the field was not set or its previous value is unknown.
+type FieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// UserHistory is one change to a user, with the changed fields before and after it
+type UserHistory struct {
+	ID        uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID    uuid.UUID `gorm:"type:char(36);index:idx_user_history_user_created" json:"user_id"` // No foreign key: history outlives deleted users
+	Action    string    `gorm:"type:varchar(32);not null" json:"action"`
+	Changes   string    `gorm:"serializer:encrypted;type:text" json:"-"` // JSON of field -> FieldChange; encrypted at rest as it holds phone numbers
+	Actor     string    `gorm:"not null" json:"actor"`                   // Admin username, "self" or "system"
+	CreatedAt time.Time `gorm:"index:idx_user_history_user_created" json:"created_at"`
+}
+
+// TableName specifies the table name for the UserHistory model
+func (UserHistory) TableName() string {
+	return "user_history"
+}
+
+// BeforeCreate is a GORM hook that generates the UUID
+func (h *UserHistory) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
+
+// FieldChanges decodes Changes
+func (h UserHistory) FieldChanges() (map[string]FieldChange, error) {
+	changes := map[string]FieldChange{}
+	if h.Changes == "" {
+		return changes, nil
+	}
+	err := json.Unmarshal([]byte(h.Changes), &changes)
+	return changes, err
+}
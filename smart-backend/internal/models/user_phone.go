@@ -0,0 +1,69 @@
+package models
+
+import (
+	"errors"
+	"ololo-gate/internal/pii"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UserPhone is one phone number of a user. Every user has a primary number, mirrored from
+// User.Phone, and may have secondary numbers (e.g. family members sharing the account).
+// Users can log in with any verified number.
+type UserPhone struct {
+	ID         uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:char(36);index;not null" json:"user_id"`
+	Phone      string     `gorm:"serializer:encrypted;not null" json:"phone"`     // Encrypted at rest; look up by PhoneIndex
+	PhoneIndex string     `gorm:"type:varchar(64);uniqueIndex;not null" json:"-"` // Blind index of Phone; a number belongs to one user
+	IsPrimary  bool       `gorm:"not null;default:false" json:"is_primary"`
+	VerifiedAt *time.Time `json:"verified_at"` // Only verified numbers can log in
+	AddedBy    string     `json:"added_by"`    // Username of the admin who added it ("" for the primary number)
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (p *UserPhone) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// BeforeSave is a GORM hook that keeps the blind index in sync with Phone
+func (p *UserPhone) BeforeSave(tx *gorm.DB) error {
+	p.PhoneIndex = pii.BlindIndex(p.Phone)
+	return nil
+}
+
+// TableName specifies the table name for the UserPhone model
+func (UserPhone) TableName() string {
+	return "user_phones"
+}
+
+// SyncPrimaryPhone creates or updates the primary user_phones row of user to match User.Phone
+func SyncPrimaryPhone(tx *gorm.DB, user *User) error {
+	if user.ID == uuid.Nil || user.Phone == "" {
+		return nil
+	}
+	tx = tx.Session(&gorm.Session{NewDB: true})
+
+	var primary UserPhone
+	err := tx.Where("user_id = ? AND is_primary = ?", user.ID, true).First(&primary).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		verifiedAt := user.CreatedAt
+		if verifiedAt.IsZero() {
+			verifiedAt = time.Now()
+		}
+		return tx.Create(&UserPhone{UserID: user.ID, Phone: user.Phone, IsPrimary: true, VerifiedAt: &verifiedAt}).Error
+	}
+	if err != nil {
+		return err
+	}
+	if primary.Phone == user.Phone {
+		return nil
+	}
+	primary.Phone = user.Phone
+	return tx.Save(&primary).Error
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UserSession is a per-device login session. Its ID is carried in the tokens ("sid" claim),
+// so a single device can be logged out without affecting the user's other devices.
+type UserSession struct {
+	ID         uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:char(36);index;not null" json:"user_id"`
+	DeviceID   string     `gorm:"type:varchar(255);index" json:"device_id"` // Device identifier sent at login ("" if not provided)
+	IPAddress  string     `json:"ip_address"`                               // IP address at login
+	UserAgent  string     `gorm:"type:text" json:"user_agent"`              // User agent at login
+	LastUsedAt time.Time  `json:"last_used_at"`                             // Last login or token refresh
+	ExpiresAt  time.Time  `gorm:"index" json:"expires_at"`                  // Matches the refresh token expiry
+	Trusted    bool       `gorm:"not null;default:false" json:"trusted"`    // Logged in as a trusted device ("remember me"), with the long refresh expiry
+	RevokedAt  *time.Time `gorm:"index" json:"revoked_at"`                  // Set when the session is logged out or revoked
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (s *UserSession) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive reports whether the session can still be used
+func (s *UserSession) IsActive() bool {
+	return s.RevokedAt == nil && time.Now().Before(s.ExpiresAt)
+}
+
+// TableName specifies the table name for the UserSession model
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
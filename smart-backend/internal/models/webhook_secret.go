@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Webhook secret scopes
+const (
+	WebhookSecretOutbound         = "outbound"          // Signs the webhooks the API sends (alerts, gate reports)
+	WebhookSecretProviderCallback = "provider_callback" // Authenticates gate provider callbacks (X-Provider-Token)
+)
+
+// WebhookSecret is a signing secret of a webhook scope. A scope has at most two active secrets:
+// the current one, without expiry, and the one it replaced, valid until ExpiresAt so receivers
+// can switch over without missing deliveries. The secret is encrypted at rest.
+type WebhookSecret struct {
+	ID        uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	Scope     string     `gorm:"type:varchar(32);index;not null" json:"scope"` // "outbound" or "provider_callback"
+	Secret    string     `gorm:"serializer:encrypted;not null" json:"-"`
+	Hint      string     `gorm:"type:varchar(8)" json:"hint"` // Last characters of the secret, to recognize it
+	CreatedBy string     `json:"created_by"`                  // Username of the admin who rotated it in ("config" for the configured secret)
+	ExpiresAt *time.Time `gorm:"index" json:"expires_at"`     // NULL = current secret
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// BeforeCreate is a GORM hook that generates the UUID before saving to database
+func (w *WebhookSecret) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName specifies the table name for the WebhookSecret model
+func (WebhookSecret) TableName() string {
+	return "webhook_secrets"
+}
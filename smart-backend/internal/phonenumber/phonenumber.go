@@ -0,0 +1,179 @@
+// Package phonenumber parses phone numbers typed in international or national format,
+// validates them against per-region numbering rules and returns them in canonical E.164 form.
+package phonenumber
+
+import (
+	"errors"
+	"fmt"
+	"ololo-gate/internal/config"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrInvalid is returned for numbers that cannot be parsed or are not valid for their region
+var ErrInvalid = errors.New("invalid phone number")
+
+// ErrCountryNotAllowed is returned for numbers from countries outside PHONE_ALLOWED_COUNTRIES
+var ErrCountryNotAllowed = errors.New("phone number country not allowed")
+
+// region holds the numbering rules of one country
+type region struct {
+	callingCode string         // Country calling code without "+"
+	trunkPrefix string         // National dialling prefix dropped from national-format input (e.g. "8" in KZ)
+	national    *regexp.Regexp // Valid national significant numbers (mobile and fixed line)
+}
+
+// regions are the countries validated in detail, keyed by ISO 3166-1 alpha-2 code. Numbers with
+// other calling codes only have to satisfy the E.164 length rules.
+var regions = map[string]region{
+	"KZ": {callingCode: "7", trunkPrefix: "8", national: regexp.MustCompile(`^[67]\d{9}$`)},
+	"RU": {callingCode: "7", trunkPrefix: "8", national: regexp.MustCompile(`^[3489]\d{9}$`)},
+	"KG": {callingCode: "996", trunkPrefix: "0", national: regexp.MustCompile(`^[2-9]\d{8}$`)},
+	"UZ": {callingCode: "998", national: regexp.MustCompile(`^[1-9]\d{8}$`)},
+	"TJ": {callingCode: "992", trunkPrefix: "8", national: regexp.MustCompile(`^[3-9]\d{8}$`)},
+	"TR": {callingCode: "90", trunkPrefix: "0", national: regexp.MustCompile(`^[2-5]\d{9}$`)},
+	"GB": {callingCode: "44", trunkPrefix: "0", national: regexp.MustCompile(`^[1-9]\d{9}$`)},
+	"US": {callingCode: "1", trunkPrefix: "1", national: regexp.MustCompile(`^[2-9]\d{2}[2-9]\d{6}$`)},
+}
+
+// separators are the formatting characters people type between digits
+var separators = strings.NewReplacer(" ", "", "\u00a0", "", "-", "", ".", "", "(", "", ")", "")
+
+var digitsOnly = regexp.MustCompile(`^\d+$`)
+
+// Normalize parses raw and returns it in E.164 form (e.g. "+7 (777) 123-45-67" and
+// "8 777 123 4567" both become "+77771234567"). Numbers without "+" or "00" are read in the
+// national format of PHONE_DEFAULT_REGION.
+func Normalize(raw string) (string, error) {
+	number := separators.Replace(strings.TrimSpace(raw))
+
+	switch {
+	case strings.HasPrefix(number, "+"):
+		return normalizeInternational(number[1:])
+	case strings.HasPrefix(number, "00"):
+		return normalizeInternational(number[2:])
+	}
+	return normalizeNational(number, DefaultRegion())
+}
+
+// Region returns the region (ISO 3166-1 alpha-2) a canonical number belongs to, or "" when its
+// calling code is not one of the regions validated in detail. Regions sharing a calling code
+// (KZ and RU) are told apart by their national numbering rules.
+func Region(number string) string {
+	digits := strings.TrimPrefix(number, "+")
+	for _, code := range regionCodes() {
+		r := regions[code]
+		if strings.HasPrefix(digits, r.callingCode) && r.national.MatchString(digits[len(r.callingCode):]) {
+			return code
+		}
+	}
+	return ""
+}
+
+// CallingCode returns the calling code of a region with "+", e.g. "+996" for KG
+func CallingCode(regionCode string) string {
+	if r, ok := regions[strings.ToUpper(regionCode)]; ok {
+		return "+" + r.callingCode
+	}
+	return ""
+}
+
+// AllowedCountries returns the regions new numbers must belong to (PHONE_ALLOWED_COUNTRIES).
+// Empty means numbers from any country are accepted.
+func AllowedCountries() []string {
+	if config.AppConfig() == nil {
+		return nil
+	}
+	return config.AppConfig().Phone.AllowedCountries
+}
+
+// CheckAllowed returns ErrCountryNotAllowed when PHONE_ALLOWED_COUNTRIES is set and the canonical
+// number belongs to none of its regions. Numbers outside the regions validated in detail are
+// never allowed by a restriction.
+func CheckAllowed(number string) error {
+	allowed := AllowedCountries()
+	if len(allowed) == 0 {
+		return nil
+	}
+	region := Region(number)
+	for _, code := range allowed {
+		if region != "" && strings.EqualFold(code, region) {
+			return nil
+		}
+	}
+	return ErrCountryNotAllowed
+}
+
+// ValidateAllowedCountries checks that PHONE_ALLOWED_COUNTRIES only names regions validated in
+// detail, as a typo would otherwise reject every new number
+func ValidateAllowedCountries() error {
+	for _, code := range AllowedCountries() {
+		if _, ok := regions[strings.ToUpper(code)]; !ok {
+			return fmt.Errorf("unsupported country %q in PHONE_ALLOWED_COUNTRIES, use %s", code, strings.Join(regionCodes(), ", "))
+		}
+	}
+	return nil
+}
+
+// regionCodes returns the codes of the regions validated in detail, sorted
+func regionCodes() []string {
+	codes := make([]string, 0, len(regions))
+	for code := range regions {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// DefaultRegion returns the region used for numbers entered without a country code
+func DefaultRegion() string {
+	if config.AppConfig() == nil || config.AppConfig().Phone.DefaultRegion == "" {
+		return "KZ"
+	}
+	return strings.ToUpper(config.AppConfig().Phone.DefaultRegion)
+}
+
+func normalizeInternational(digits string) (string, error) {
+	if !digitsOnly.MatchString(digits) || digits[0] == '0' || len(digits) > 15 {
+		return "", ErrInvalid
+	}
+
+	// Calling codes are prefix-free, so at most one length matches a known code
+	for length := 1; length <= 3 && length < len(digits); length++ {
+		callingCode, national := digits[:length], digits[length:]
+		known := false
+		for _, r := range regions {
+			if r.callingCode != callingCode {
+				continue
+			}
+			known = true
+			if r.national.MatchString(national) {
+				return "+" + digits, nil
+			}
+		}
+		if known {
+			return "", ErrInvalid
+		}
+	}
+
+	// Unknown calling code: only the E.164 length rules apply
+	if len(digits) < 8 {
+		return "", ErrInvalid
+	}
+	return "+" + digits, nil
+}
+
+func normalizeNational(digits, regionCode string) (string, error) {
+	r, ok := regions[regionCode]
+	if !ok || !digitsOnly.MatchString(digits) {
+		return "", ErrInvalid
+	}
+	// Numbers are valid if they belong to any region sharing the calling code (e.g. RU numbers in KZ)
+	if r.trunkPrefix != "" && strings.HasPrefix(digits, r.trunkPrefix) {
+		if number, err := normalizeInternational(r.callingCode + digits[len(r.trunkPrefix):]); err == nil {
+			return number, nil
+		}
+	}
+	return normalizeInternational(r.callingCode + digits)
+}
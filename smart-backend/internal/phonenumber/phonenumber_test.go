@@ -0,0 +1,82 @@
+package phonenumber
+
+import (
+	"ololo-gate/internal/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize_CanonicalForm(t *testing.T) {
+	config.SetAppConfig(&config.Config{Phone: config.PhoneConfig{DefaultRegion: "KZ"}})
+
+	for _, raw := range []string{
+		"+77771234567",
+		"+7 777 123 45 67",
+		"+7 (777) 123-45-67",
+		"0077771234567",
+		"87771234567",
+		"777 123 4567",
+	} {
+		normalized, err := Normalize(raw)
+		assert.NoError(t, err, raw)
+		assert.Equal(t, "+77771234567", normalized, raw)
+	}
+
+	// Russian numbers share +7 and are accepted in KZ national format too
+	normalized, err := Normalize("8 916 123 45 67")
+	assert.NoError(t, err)
+	assert.Equal(t, "+79161234567", normalized)
+}
+
+func TestNormalize_RejectsInvalidNumbers(t *testing.T) {
+	config.SetAppConfig(&config.Config{Phone: config.PhoneConfig{DefaultRegion: "KZ"}})
+
+	for _, raw := range []string{
+		"",
+		"77771234567",   // Country code without "+"
+		"+7777123456",   // Too short for +7
+		"+777712345678", // Too long for +7
+		"+7 057 123 45 67",
+		"+996 155 123 456",
+		"+123",
+		"+7777abc4567",
+		"+1234567890123456",
+	} {
+		_, err := Normalize(raw)
+		assert.ErrorIs(t, err, ErrInvalid, raw)
+	}
+}
+
+func TestNormalize_DefaultRegion(t *testing.T) {
+	config.SetAppConfig(&config.Config{Phone: config.PhoneConfig{DefaultRegion: "kg"}})
+
+	normalized, err := Normalize("0555 123 456")
+	assert.NoError(t, err)
+	assert.Equal(t, "+996555123456", normalized)
+
+	// Numbers with unknown calling codes only need a valid E.164 length
+	normalized, err = Normalize("+49 30 1234567")
+	assert.NoError(t, err)
+	assert.Equal(t, "+49301234567", normalized)
+}
+
+func TestCheckAllowed_RestrictsCountries(t *testing.T) {
+	config.SetAppConfig(&config.Config{Phone: config.PhoneConfig{DefaultRegion: "KZ"}})
+
+	// Without a restriction every supported country is accepted
+	assert.NoError(t, CheckAllowed("+79161234567"))
+
+	config.AppConfig().Phone.AllowedCountries = []string{"KG", "KZ"}
+	assert.Equal(t, "KG", Region("+996555123456"))
+	assert.Equal(t, "KZ", Region("+77771234567"))
+	assert.Equal(t, "RU", Region("+79161234567"))
+	assert.NoError(t, CheckAllowed("+996555123456"))
+	assert.NoError(t, CheckAllowed("+77771234567"))
+	assert.ErrorIs(t, CheckAllowed("+79161234567"), ErrCountryNotAllowed)
+	assert.ErrorIs(t, CheckAllowed("+998901234567"), ErrCountryNotAllowed)
+	assert.NoError(t, ValidateAllowedCountries())
+
+	config.AppConfig().Phone.AllowedCountries = []string{"KGZ"}
+	assert.Error(t, ValidateAllowedCountries())
+}
@@ -0,0 +1,172 @@
+// Package pii encrypts personal data (phone numbers, device IDs) before it is stored and
+// computes blind indexes so encrypted columns can still be looked up by exact value.
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"strings"
+	"sync"
+)
+
+// ciphertextPrefix marks encrypted values. Values without it are legacy plaintext rows
+// written before encryption was enabled and are returned as-is.
+const ciphertextPrefix = "enc:v1:"
+
+// developmentKeySeed derives the key used when ENCRYPTION_KEY is not set outside production
+const developmentKeySeed = "ololo-gate-development-encryption-key"
+
+// ErrMalformedCiphertext is returned for encrypted values that cannot be decoded or authenticated
+var ErrMalformedCiphertext = errors.New("malformed encrypted value")
+
+// KeyProvider supplies the AES-256 encryption key and the blind-index HMAC key. The default
+// provider reads ENCRYPTION_KEY and BLIND_INDEX_KEY; a KMS-backed provider can unwrap data keys
+// at startup and be installed with SetKeyProvider.
+type KeyProvider interface {
+	Keys() (encryptionKey, indexKey []byte, err error)
+}
+
+// ConfigKeyProvider reads the keys from config.AppConfig().Encryption
+type ConfigKeyProvider struct{}
+
+var (
+	providerMu  sync.RWMutex
+	provider    KeyProvider = ConfigKeyProvider{}
+	warnDevOnce sync.Once
+)
+
+// SetKeyProvider replaces the key provider (e.g. with one backed by a KMS)
+func SetKeyProvider(p KeyProvider) {
+	providerMu.Lock()
+	defer providerMu.Unlock()
+	provider = p
+}
+
+// Keys decodes the configured keys. Without ENCRYPTION_KEY a fixed development key is used,
+// which config rejects in production. Without BLIND_INDEX_KEY the index key is derived from
+// the encryption key.
+func (ConfigKeyProvider) Keys() ([]byte, []byte, error) {
+	var cfg config.EncryptionConfig
+	if config.AppConfig() != nil {
+		cfg = config.AppConfig().Encryption
+	}
+
+	var encryptionKey []byte
+	if cfg.Key == "" {
+		warnDevOnce.Do(func() {
+			slog.Warn("[PII] ENCRYPTION_KEY is not set, using the development key. Do not use this in production.")
+		})
+		sum := sha256.Sum256([]byte(developmentKeySeed))
+		encryptionKey = sum[:]
+	} else {
+		key, err := config.DecodeEncryptionKey(cfg.Key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ENCRYPTION_KEY: %w", err)
+		}
+		encryptionKey = key
+	}
+
+	if cfg.IndexKey != "" {
+		indexKey, err := config.DecodeEncryptionKey(cfg.IndexKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("BLIND_INDEX_KEY: %w", err)
+		}
+		return encryptionKey, indexKey, nil
+	}
+	mac := hmac.New(sha256.New, encryptionKey)
+	mac.Write([]byte("blind-index"))
+	return encryptionKey, mac.Sum(nil), nil
+}
+
+func keys() ([]byte, []byte, error) {
+	providerMu.RLock()
+	p := provider
+	providerMu.RUnlock()
+	return p.Keys()
+}
+
+// Encrypt encrypts value with AES-256-GCM. Empty values stay empty.
+func Encrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	gcm, err := newGCM()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(value), nil)
+	return ciphertextPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt reverses Encrypt. Values that were never encrypted are returned unchanged.
+func Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(value, ciphertextPrefix))
+	if err != nil {
+		return "", ErrMalformedCiphertext
+	}
+	gcm, err := newGCM()
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", ErrMalformedCiphertext
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrMalformedCiphertext
+	}
+	return string(plaintext), nil
+}
+
+// IsEncrypted reports whether value was produced by Encrypt
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, ciphertextPrefix)
+}
+
+// BlindIndex returns a keyed hash of value for exact-match lookups and unique indexes on
+// encrypted columns. Empty values have an empty index. If the keys cannot be loaded an empty
+// index is returned, which matches no stored row.
+func BlindIndex(value string) string {
+	if value == "" {
+		return ""
+	}
+	_, indexKey, err := keys()
+	if err != nil {
+		slog.Error("[PII] Failed to load blind index key", "error", err)
+		return ""
+	}
+	mac := hmac.New(sha256.New, indexKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newGCM() (cipher.AEAD, error) {
+	encryptionKey, _, err := keys()
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
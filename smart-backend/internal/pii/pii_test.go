@@ -0,0 +1,55 @@
+package pii
+
+import (
+	"encoding/base64"
+	"ololo-gate/internal/config"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupKeys(key string) {
+	config.SetAppConfig(&config.Config{Encryption: config.EncryptionConfig{Key: key}})
+}
+
+func TestEncrypt_RoundTrip(t *testing.T) {
+	setupKeys(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+
+	first, err := Encrypt("+77771234567")
+	assert.NoError(t, err)
+	second, err := Encrypt("+77771234567")
+	assert.NoError(t, err)
+
+	assert.True(t, IsEncrypted(first))
+	assert.NotContains(t, first, "77771234567")
+	assert.NotEqual(t, first, second, "each encryption uses a fresh nonce")
+
+	plaintext, err := Decrypt(first)
+	assert.NoError(t, err)
+	assert.Equal(t, "+77771234567", plaintext)
+}
+
+func TestDecrypt_LegacyPlaintextAndTampering(t *testing.T) {
+	setupKeys("")
+
+	plaintext, err := Decrypt("+77771234567")
+	assert.NoError(t, err)
+	assert.Equal(t, "+77771234567", plaintext)
+
+	encrypted, _ := Encrypt("device-1")
+	setupKeys(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 32))))
+	_, err = Decrypt(encrypted)
+	assert.ErrorIs(t, err, ErrMalformedCiphertext)
+}
+
+func TestBlindIndex_DeterministicPerKey(t *testing.T) {
+	setupKeys("")
+	index := BlindIndex("+77771234567")
+	assert.Equal(t, index, BlindIndex("+77771234567"))
+	assert.NotEqual(t, index, BlindIndex("+77771234568"))
+	assert.Empty(t, BlindIndex(""))
+
+	setupKeys(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+	assert.NotEqual(t, index, BlindIndex("+77771234567"))
+}
@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// acquireLock takes the job lease for owner if it is free, expired or already held by owner.
+// A scheduled run also claims its slot, the cron time it was scheduled for: once a replica took
+// a slot, a replica whose timer fires later for the same slot does not run it again, even after
+// the lease was released. Manual runs pass a zero slot. The conditional UPDATE makes this safe
+// across replicas sharing the database.
+func acquireLock(jobName, owner string, ttl time.Duration, slot time.Time) (bool, error) {
+	now := time.Now()
+
+	// Make sure the row exists; a concurrent insert by another replica is fine
+	if err := db.DB.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.JobLock{JobName: jobName, LockedUntil: time.Time{}}).Error; err != nil {
+		return false, err
+	}
+
+	query := db.DB.Model(&models.JobLock{}).
+		Where("job_name = ? AND (locked_until < ? OR owner = ?)", jobName, now, owner)
+	updates := map[string]interface{}{"owner": owner, "locked_until": now.Add(ttl)}
+	if !slot.IsZero() {
+		slot = slot.UTC()
+		query = query.Where("(last_slot IS NULL OR last_slot < ?)", slot)
+		updates["last_slot"] = slot
+	}
+	result := query.Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// releaseLock ends the lease early so the next run is not delayed by the TTL
+func releaseLock(jobName, owner string) {
+	db.DB.Model(&models.JobLock{}).
+		Where("job_name = ? AND owner = ?", jobName, owner).
+		Update("locked_until", time.Now())
+}
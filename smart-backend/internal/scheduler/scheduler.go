@@ -0,0 +1,287 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/models"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultJobTimeout bounds a job run (and its lock lease) when no timeout is given
+const DefaultJobTimeout = 10 * time.Minute
+
+// JobFunc is the work performed by a scheduled job
+type JobFunc func(ctx context.Context) error
+
+// JobStatus describes a registered job for the admin job listing
+type JobStatus struct {
+	Name     string
+	Schedule string
+	NextRun  time.Time
+	Running  bool
+	LastRun  *models.JobRun
+}
+
+type job struct {
+	name     string
+	schedule *Schedule
+	timeout  time.Duration
+	fn       JobFunc
+	nextRun  time.Time
+	running  bool
+}
+
+// Scheduler runs registered jobs on cron schedules. Each run takes a database lease first,
+// so when several replicas run the same scheduler only one of them executes a given run.
+type Scheduler struct {
+	mu       sync.Mutex
+	jobs     map[string]*job
+	instance string
+	stop     chan struct{}
+	wg       sync.WaitGroup
+	started  bool
+}
+
+var defaultScheduler = New()
+
+// New creates a scheduler identified by the host name and a random suffix
+func New() *Scheduler {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return &Scheduler{
+		jobs:     make(map[string]*job),
+		instance: fmt.Sprintf("%s-%s", host, uuid.New().String()[:8]),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Default returns the process-wide scheduler
+func Default() *Scheduler {
+	return defaultScheduler
+}
+
+// Register adds a job. The timeout bounds a single run and the lock lease (0 = DefaultJobTimeout).
+func (s *Scheduler) Register(name, cronExpr string, timeout time.Duration, fn JobFunc) error {
+	schedule, err := ParseCron(cronExpr)
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		timeout = DefaultJobTimeout
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[name]; exists {
+		return fmt.Errorf("job %q is already registered", name)
+	}
+	j := &job{name: name, schedule: schedule, timeout: timeout, fn: fn}
+	s.jobs[name] = j
+
+	if s.started {
+		s.startJob(j)
+	}
+	return nil
+}
+
+// Start begins running all registered jobs on their schedules
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+	s.started = true
+	for _, j := range s.jobs {
+		s.startJob(j)
+	}
+	slog.Info("[SCHEDULER] Started", "jobs", len(s.jobs), "instance", s.instance)
+}
+
+// Stop stops scheduling new runs and waits for running jobs to finish
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if !s.started {
+		s.mu.Unlock()
+		return
+	}
+	s.started = false
+	close(s.stop)
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
+
+// startJob launches the scheduling loop for a job. Caller must hold s.mu.
+func (s *Scheduler) startJob(j *job) {
+	j.nextRun = j.schedule.Next(time.Now())
+	s.wg.Add(1)
+	go s.loop(j)
+}
+
+func (s *Scheduler) loop(j *job) {
+	defer s.wg.Done()
+
+	for {
+		s.mu.Lock()
+		next := j.nextRun
+		s.mu.Unlock()
+
+		if next.IsZero() {
+			slog.Warn("[SCHEDULER] Job has no upcoming runs", "job", j.name)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-s.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.run(j.name, next)
+
+		s.mu.Lock()
+		j.nextRun = j.schedule.Next(time.Now())
+		s.mu.Unlock()
+	}
+}
+
+// RunOnce runs a job immediately if this instance can take its lock.
+// It reports whether the job ran here.
+func (s *Scheduler) RunOnce(name string) (bool, error) {
+	return s.run(name, time.Time{})
+}
+
+// run runs a job if this instance can take its lock. slot is the scheduled time of the run,
+// zero for runs started by hand.
+func (s *Scheduler) run(name string, slot time.Time) (bool, error) {
+	s.mu.Lock()
+	j, ok := s.jobs[name]
+	if !ok {
+		s.mu.Unlock()
+		return false, fmt.Errorf("job %q is not registered", name)
+	}
+	if j.running {
+		s.mu.Unlock()
+		return false, nil
+	}
+	j.running = true
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		j.running = false
+		s.mu.Unlock()
+	}()
+
+	acquired, err := acquireLock(j.name, s.instance, j.timeout, slot)
+	if err != nil {
+		slog.Error("[SCHEDULER] Failed to acquire job lock", "job", j.name, "error", err)
+		return false, err
+	}
+	if !acquired {
+		slog.Debug("[SCHEDULER] Job is running on another instance, skipping", "job", j.name)
+		return false, nil
+	}
+	defer releaseLock(j.name, s.instance)
+
+	run := models.JobRun{
+		JobName:   j.name,
+		Instance:  s.instance,
+		Status:    models.JobRunRunning,
+		StartedAt: time.Now(),
+	}
+	db.DB.Create(&run)
+
+	ctx, cancel := context.WithTimeout(context.Background(), j.timeout)
+	defer cancel()
+
+	runErr := s.execute(ctx, j)
+
+	finishedAt := time.Now()
+	updates := map[string]interface{}{"status": models.JobRunSucceeded, "finished_at": finishedAt}
+	if runErr != nil {
+		updates["status"] = models.JobRunFailed
+		updates["error"] = runErr.Error()
+		slog.Error("[SCHEDULER] Job failed", "job", j.name, "duration", finishedAt.Sub(run.StartedAt), "error", runErr)
+		events.Publish(events.JobFailed, map[string]interface{}{
+			"job":      j.name,
+			"run_id":   run.ID,
+			"instance": s.instance,
+			"error":    runErr.Error(),
+		})
+	} else {
+		slog.Info("[SCHEDULER] Job succeeded", "job", j.name, "duration", finishedAt.Sub(run.StartedAt))
+	}
+	db.DB.Model(&models.JobRun{}).Where("id = ?", run.ID).Updates(updates)
+
+	return true, runErr
+}
+
+// execute runs the job function, converting panics into errors
+func (s *Scheduler) execute(ctx context.Context, j *job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return j.fn(ctx)
+}
+
+// Backlog returns how many jobs are past their scheduled run time, because a previous run
+// is still going or the run has not started yet
+func (s *Scheduler) Backlog(now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	backlog := 0
+	for _, j := range s.jobs {
+		if !j.nextRun.IsZero() && j.nextRun.Before(now) {
+			backlog++
+		}
+	}
+	return backlog
+}
+
+// Statuses returns every registered job with its next and most recent run, sorted by name
+func (s *Scheduler) Statuses() []JobStatus {
+	s.mu.Lock()
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		next := j.nextRun
+		if next.IsZero() {
+			next = j.schedule.Next(time.Now())
+		}
+		statuses = append(statuses, JobStatus{
+			Name:     j.name,
+			Schedule: j.schedule.String(),
+			NextRun:  next,
+			Running:  j.running,
+		})
+	}
+	s.mu.Unlock()
+
+	sort.Slice(statuses, func(i, k int) bool { return statuses[i].Name < statuses[k].Name })
+
+	for i := range statuses {
+		var last models.JobRun
+		if err := db.DB.Where("job_name = ?", statuses[i].Name).Order("started_at DESC").First(&last).Error; err == nil {
+			statuses[i].LastRun = &last
+		}
+	}
+	return statuses
+}
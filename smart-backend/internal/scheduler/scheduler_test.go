@@ -0,0 +1,131 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupSchedulerDB(t *testing.T) {
+	var err error
+	db.DB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+	sqlDB, _ := db.DB.DB()
+	sqlDB.SetMaxOpenConns(1)
+
+	if err := db.DB.AutoMigrate(&models.JobRun{}, &models.JobLock{}); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+}
+
+func TestScheduler_RunOnceRecordsHistory(t *testing.T) {
+	setupSchedulerDB(t)
+	s := New()
+
+	assert.NoError(t, s.Register("ok_job", "@daily", time.Minute, func(ctx context.Context) error { return nil }))
+	assert.NoError(t, s.Register("failing_job", "@daily", time.Minute, func(ctx context.Context) error {
+		return errors.New("provider down")
+	}))
+
+	ran, err := s.RunOnce("ok_job")
+	assert.True(t, ran)
+	assert.NoError(t, err)
+
+	ran, err = s.RunOnce("failing_job")
+	assert.True(t, ran)
+	assert.Error(t, err)
+
+	statuses := s.Statuses()
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "failing_job", statuses[0].Name)
+	assert.Equal(t, models.JobRunFailed, statuses[0].LastRun.Status)
+	assert.Equal(t, "provider down", statuses[0].LastRun.Error)
+	assert.Equal(t, models.JobRunSucceeded, statuses[1].LastRun.Status)
+	assert.NotNil(t, statuses[1].LastRun.FinishedAt)
+}
+
+func TestScheduler_LockPreventsDoubleRunAcrossInstances(t *testing.T) {
+	setupSchedulerDB(t)
+	replicaA := New()
+	replicaB := New()
+
+	runs := 0
+	job := func(ctx context.Context) error { runs++; return nil }
+	assert.NoError(t, replicaA.Register("report", "@daily", time.Minute, job))
+	assert.NoError(t, replicaB.Register("report", "@daily", time.Minute, job))
+
+	// Replica A holds an unexpired lease
+	acquired, err := acquireLock("report", replicaA.instance, time.Minute, time.Time{})
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	ran, err := replicaB.RunOnce("report")
+	assert.NoError(t, err)
+	assert.False(t, ran)
+	assert.Equal(t, 0, runs)
+
+	// Once released, the other replica can take over
+	releaseLock("report", replicaA.instance)
+	ran, err = replicaB.RunOnce("report")
+	assert.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, 1, runs)
+}
+
+func TestScheduler_SlotRunsOnceAcrossInstances(t *testing.T) {
+	setupSchedulerDB(t)
+	replicaA := New()
+	replicaB := New()
+
+	runs := 0
+	job := func(ctx context.Context) error { runs++; return nil }
+	assert.NoError(t, replicaA.Register("digest", "* * * * *", time.Minute, job))
+	assert.NoError(t, replicaB.Register("digest", "* * * * *", time.Minute, job))
+	slot := time.Now().Truncate(time.Minute)
+
+	ran, err := replicaA.run("digest", slot)
+	assert.NoError(t, err)
+	assert.True(t, ran)
+
+	// Replica A released the lease, but B's timer firing late for the same slot does not run it again
+	ran, err = replicaB.run("digest", slot)
+	assert.NoError(t, err)
+	assert.False(t, ran)
+	assert.Equal(t, 1, runs)
+
+	// Runs started by hand and later slots are not held back
+	ran, _ = replicaB.RunOnce("digest")
+	assert.True(t, ran)
+	ran, _ = replicaB.run("digest", slot.Add(time.Minute))
+	assert.True(t, ran)
+	assert.Equal(t, 3, runs)
+}
+
+func TestScheduler_PanicIsRecordedAsFailure(t *testing.T) {
+	setupSchedulerDB(t)
+	s := New()
+	assert.NoError(t, s.Register("panicky", "@hourly", time.Minute, func(ctx context.Context) error {
+		panic("boom")
+	}))
+
+	ran, err := s.RunOnce("panicky")
+	assert.True(t, ran)
+	assert.EqualError(t, err, "panic: boom")
+}
+
+func TestScheduler_RejectsInvalidRegistration(t *testing.T) {
+	s := New()
+	assert.Error(t, s.Register("bad", "not a cron", 0, nil))
+
+	assert.NoError(t, s.Register("dup", "@daily", 0, func(ctx context.Context) error { return nil }))
+	assert.Error(t, s.Register("dup", "@daily", 0, func(ctx context.Context) error { return nil }))
+}
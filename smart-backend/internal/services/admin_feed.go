@@ -0,0 +1,95 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/events"
+	"sync"
+)
+
+// adminFeedBuffer is how many events a slow feed client may lag behind before events are dropped for it
+const adminFeedBuffer = 64
+
+// adminFeedEvents are the event types streamed to the live admin dashboard
+var adminFeedEvents = []string{
+	events.AuditEntry,
+	events.GateOpened,
+	events.GateClosed,
+	events.AdminNotification,
+}
+
+// AdminFeedHub fans domain events out to connected admin dashboard clients
+type AdminFeedHub struct {
+	mu      sync.RWMutex
+	clients map[chan events.Event]adminFeedClient
+}
+
+// adminFeedClient records what a connected client may see. Audit entries are only sent to
+// clients that may read the audit log (super admins).
+type adminFeedClient struct {
+	auditLog bool
+}
+
+var (
+	adminFeedHub     *AdminFeedHub
+	adminFeedHubOnce sync.Once
+)
+
+// NewAdminFeedHub creates a hub that is not yet subscribed to the event bus
+func NewAdminFeedHub() *AdminFeedHub {
+	return &AdminFeedHub{clients: make(map[chan events.Event]adminFeedClient)}
+}
+
+// AdminFeed returns the process-wide admin feed hub, subscribing it to the event bus on first use
+func AdminFeed() *AdminFeedHub {
+	adminFeedHubOnce.Do(func() {
+		adminFeedHub = NewAdminFeedHub()
+		for _, eventType := range adminFeedEvents {
+			events.Subscribe(eventType, adminFeedHub.Broadcast)
+		}
+	})
+	return adminFeedHub
+}
+
+// Subscribe registers a client and returns its event channel and an unsubscribe function.
+// auditLog says whether the client receives audit entries.
+func (h *AdminFeedHub) Subscribe(auditLog bool) (<-chan events.Event, func()) {
+	ch := make(chan events.Event, adminFeedBuffer)
+
+	h.mu.Lock()
+	h.clients[ch] = adminFeedClient{auditLog: auditLog}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.clients, ch)
+			h.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Broadcast sends an event to every connected client without blocking on slow ones
+func (h *AdminFeedHub) Broadcast(event events.Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch, client := range h.clients {
+		if event.Type == events.AuditEntry && !client.auditLog {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			slog.Warn("[ADMIN_FEED] Dropping event for slow client", "event", event.Type)
+		}
+	}
+}
+
+// ClientCount returns the number of connected clients
+func (h *AdminFeedHub) ClientCount() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients)
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"ololo-gate/internal/events"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminFeedHub_BroadcastsToAllClients(t *testing.T) {
+	hub := NewAdminFeedHub()
+	first, unsubscribeFirst := hub.Subscribe(true)
+	second, unsubscribeSecond := hub.Subscribe(true)
+	defer unsubscribeFirst()
+	defer unsubscribeSecond()
+
+	hub.Broadcast(events.Event{Type: events.GateOpened})
+
+	assert.Equal(t, events.GateOpened, (<-first).Type)
+	assert.Equal(t, events.GateOpened, (<-second).Type)
+}
+
+func TestAdminFeedHub_SlowClientDoesNotBlock(t *testing.T) {
+	hub := NewAdminFeedHub()
+	ch, unsubscribe := hub.Subscribe(true)
+	defer unsubscribe()
+
+	// Nobody reads from ch; broadcasting past the buffer must not block
+	for i := 0; i < adminFeedBuffer+10; i++ {
+		hub.Broadcast(events.Event{Type: events.AuditEntry})
+	}
+
+	assert.Len(t, ch, adminFeedBuffer)
+}
+
+func TestAdminFeedHub_Unsubscribe(t *testing.T) {
+	hub := NewAdminFeedHub()
+	ch, unsubscribe := hub.Subscribe(true)
+	assert.Equal(t, 1, hub.ClientCount())
+
+	unsubscribe()
+	unsubscribe() // Safe to call twice
+
+	assert.Equal(t, 0, hub.ClientCount())
+	_, open := <-ch
+	assert.False(t, open)
+}
+
+func TestAdminFeedHub_AuditEntriesOnlyForAuditLogReaders(t *testing.T) {
+	hub := NewAdminFeedHub()
+	super, unsubscribeSuper := hub.Subscribe(true)
+	regular, unsubscribeRegular := hub.Subscribe(false)
+	defer unsubscribeSuper()
+	defer unsubscribeRegular()
+
+	hub.Broadcast(events.Event{Type: events.AuditEntry})
+	hub.Broadcast(events.Event{Type: events.GateOpened})
+
+	assert.Equal(t, events.AuditEntry, (<-super).Type)
+	assert.Equal(t, events.GateOpened, (<-super).Type)
+	assert.Equal(t, events.GateOpened, (<-regular).Type)
+	assert.Len(t, regular, 0)
+}
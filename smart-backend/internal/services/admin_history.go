@@ -0,0 +1,46 @@
+package services
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// DiffAdmin records the admin account fields that differ between before and after.
+// Passwords are never stored, only that one was changed.
+func (c FieldChanges) DiffAdmin(before, after models.Admin) FieldChanges {
+	if before.Username != after.Username {
+		c.Set("username", emptyAsNil(before.Username), after.Username)
+	}
+	if before.Role != after.Role {
+		c.Set("role", emptyAsNil(before.Role), after.Role)
+	}
+	if before.Timezone != after.Timezone {
+		c.Set("timezone", emptyAsNil(before.Timezone), emptyAsNil(after.Timezone))
+	}
+	if before.Password != after.Password {
+		c.Set("password_changed", false, true)
+	}
+	return c
+}
+
+// RecordAdminHistory stores a history entry for the admin account. actorID is nil for changes
+// made by the system. A promotion to super admin also notifies the admins, so role escalations
+// are seen as they happen. Failures are logged rather than returned, as for RecordUserHistory.
+func RecordAdminHistory(adminID uuid.UUID, action string, actorID *uuid.UUID, actor string, changes FieldChanges) {
+	if changes == nil {
+		changes = FieldChanges{}
+	}
+	entry := models.AdminHistory{AdminID: adminID, Action: action, Changes: changes, ActorID: actorID, Actor: actor}
+	if err := db.DB.Create(&entry).Error; err != nil {
+		slog.Error("[ADMIN_HISTORY] Failed to record admin change", "action", action, "admin_id", adminID, "error", err)
+	}
+
+	if role, ok := changes["role"]; ok && role.After == models.RoleSuper {
+		NotifyAdmins(models.SeverityWarning, models.NotificationSecurity, "Admin promoted to super admin",
+			fmt.Sprintf("Admin %s was given the super admin role by %s", adminID, actor))
+	}
+}
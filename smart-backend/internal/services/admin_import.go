@@ -0,0 +1,202 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AdminImportMaxRows limits the accounts one import creates, as hashing each password takes a while
+const AdminImportMaxRows = 200
+
+// ImportedAdmin is an admin account created by an import, with its generated initial password
+type ImportedAdmin struct {
+	Admin    models.Admin
+	Password string
+}
+
+// AdminImportLineError is a problem with one line of an import CSV (the header is line 1)
+type AdminImportLineError struct {
+	Line    int
+	Message string
+}
+
+// AdminImportError is returned when an import CSV is invalid. It lists every problem found;
+// no account is created.
+type AdminImportError struct {
+	Lines []AdminImportLineError
+}
+
+func (e *AdminImportError) Error() string {
+	return fmt.Sprintf("invalid admin import: %d problem(s)", len(e.Lines))
+}
+
+// adminImportRow is one account to create
+type adminImportRow struct {
+	line     int
+	username string
+	role     string
+}
+
+// ImportAdmins creates admin accounts from a CSV with a header row naming its columns:
+// username, role and optionally locations. Every account gets a generated password,
+// returned once. Either all accounts are created or, if any line is invalid, none are and
+// an *AdminImportError lists the problems.
+func ImportAdmins(r io.Reader, actorID *uuid.UUID, actor string) ([]ImportedAdmin, error) {
+	rows, err := parseAdminImport(r)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := make([]ImportedAdmin, 0, len(rows))
+	err = db.DB.Transaction(func(tx *gorm.DB) error {
+		for _, row := range rows {
+			password, err := generateAdminPassword()
+			if err != nil {
+				return err
+			}
+			// The password is hashed by the BeforeCreate hook
+			admin := models.Admin{Username: row.username, Password: password, Role: row.role}
+			if err := tx.Create(&admin).Error; err != nil {
+				return fmt.Errorf("failed to create admin %s: %w", row.username, err)
+			}
+			imported = append(imported, ImportedAdmin{Admin: admin, Password: password})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, created := range imported {
+		RecordAdminHistory(created.Admin.ID, models.AdminHistoryCreated, actorID, actor,
+			FieldChanges{}.DiffAdmin(models.Admin{}, models.Admin{Username: created.Admin.Username, Role: created.Admin.Role}))
+	}
+	return imported, nil
+}
+
+// parseAdminImport reads and validates the CSV, collecting every invalid line
+func parseAdminImport(r io.Reader) ([]adminImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, &AdminImportError{Lines: []AdminImportLineError{{Line: 1, Message: "CSV is empty, expected a header row: username,role,locations"}}}
+	}
+	if err != nil {
+		return nil, adminImportReadError(err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		// Spreadsheets often save CSV with a byte order mark
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		switch name {
+		case "username", "role", "locations":
+			columns[name] = i
+		default:
+			return nil, &AdminImportError{Lines: []AdminImportLineError{{Line: 1, Message: fmt.Sprintf("unknown column %q, expected username, role and optionally locations", name)}}}
+		}
+	}
+	for _, required := range []string{"username", "role"} {
+		if _, ok := columns[required]; !ok {
+			return nil, &AdminImportError{Lines: []AdminImportLineError{{Line: 1, Message: fmt.Sprintf("missing column %q", required)}}}
+		}
+	}
+
+	var rows []adminImportRow
+	var problems []AdminImportLineError
+	seen := map[string]int{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, adminImportReadError(err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		row := adminImportRow{
+			line:     line,
+			username: strings.TrimSpace(record[columns["username"]]),
+			role:     strings.ToLower(strings.TrimSpace(record[columns["role"]])),
+		}
+		problem := func(format string, args ...interface{}) {
+			problems = append(problems, AdminImportLineError{Line: line, Message: fmt.Sprintf(format, args...)})
+		}
+		switch {
+		case row.username == "":
+			problem("username is required")
+		case seen[row.username] != 0:
+			problem("username %q is already on line %d", row.username, seen[row.username])
+		default:
+			seen[row.username] = line
+		}
+		if !models.ValidAdminRole(row.role) {
+			problem("invalid role %q, must be super, regular or auditor", row.role)
+		}
+		// Admin accounts are not limited to locations: creating one for a guard desk would
+		// silently grant it every location
+		if i, ok := columns["locations"]; ok && strings.TrimSpace(record[i]) != "" {
+			problem("admin accounts cannot be limited to locations, leave locations empty")
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 && len(problems) == 0 {
+		problems = append(problems, AdminImportLineError{Line: 2, Message: "no admins to import"})
+	}
+	if len(rows) > AdminImportMaxRows {
+		problems = append(problems, AdminImportLineError{Line: rows[AdminImportMaxRows].line, Message: fmt.Sprintf("at most %d admins can be imported at once", AdminImportMaxRows)})
+	}
+
+	if len(seen) > 0 {
+		usernames := make([]string, 0, len(seen))
+		for username := range seen {
+			usernames = append(usernames, username)
+		}
+		var existing []models.Admin
+		if err := db.DB.Select("username").Where("username IN ?", usernames).Find(&existing).Error; err != nil {
+			return nil, err
+		}
+		for _, admin := range existing {
+			problems = append(problems, AdminImportLineError{Line: seen[admin.Username], Message: fmt.Sprintf("admin %q already exists", admin.Username)})
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+		return nil, &AdminImportError{Lines: problems}
+	}
+	return rows, nil
+}
+
+// adminImportReadError reports a malformed CSV at the line it was found
+func adminImportReadError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &AdminImportError{Lines: []AdminImportLineError{{Line: parseErr.Line, Message: parseErr.Err.Error()}}}
+	}
+	return err
+}
+
+// generateAdminPassword returns an initial password for an imported admin
+func generateAdminPassword() (string, error) {
+	random := make([]byte, 12)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
@@ -0,0 +1,390 @@
+package services
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/webauthn"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrPasskeysDisabled is returned when WEBAUTHN_RP_ID is not configured
+	ErrPasskeysDisabled = errors.New("passkey login is not enabled")
+	// ErrPasskeyInvalid is returned, possibly wrapped with the reason, for passkey responses that
+	// fail: unknown or expired challenge, unknown credential, or a response that does not verify
+	ErrPasskeyInvalid = errors.New("passkey could not be verified")
+	// ErrPasskeyExists is returned when registering a credential that is already registered
+	ErrPasskeyExists = errors.New("passkey is already registered")
+
+	errPasskeyChallenge = fmt.Errorf("%w: unknown or expired challenge", ErrPasskeyInvalid)
+)
+
+// PasskeyCredentialDescriptor identifies a registered credential in ceremony options
+type PasskeyCredentialDescriptor struct {
+	Type       string   `json:"type" example:"public-key"`
+	ID         string   `json:"id" example:"pX3f0w8sQ1u9kq2Hn7cZbA"` // base64url
+	Transports []string `json:"transports,omitempty" example:"internal,hybrid"`
+}
+
+// PasskeyCredentialParameter is a credential type and COSE algorithm the server accepts
+type PasskeyCredentialParameter struct {
+	Type string `json:"type" example:"public-key"`
+	Alg  int64  `json:"alg" example:"-7"`
+}
+
+// PasskeyUser is the account a credential is created for
+type PasskeyUser struct {
+	ID          string `json:"id" example:"AAAAAAAAAAAAAAAAAAAAAQ"` // base64url of the admin UUID bytes, returned as the user handle
+	Name        string `json:"name" example:"admin"`
+	DisplayName string `json:"displayName" example:"admin"`
+}
+
+// PasskeyRelyingParty is the site a credential is created for
+type PasskeyRelyingParty struct {
+	ID   string `json:"id" example:"admin.example.com"`
+	Name string `json:"name" example:"Ololo Gate"`
+}
+
+// PasskeyAuthenticatorSelection states the authenticator features the server asks for
+type PasskeyAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey" example:"required"`
+	UserVerification string `json:"userVerification" example:"preferred"`
+}
+
+// PasskeyCreationOptions are the options for navigator.credentials.create(), in the JSON form
+// accepted by PublicKeyCredential.parseCreationOptionsFromJSON()
+type PasskeyCreationOptions struct {
+	Challenge              string                        `json:"challenge" example:"mX2v0Vw1b7N6Q3xYk8zJ4tH5aR9cL0pE2uS6dF1gW3o"`
+	RP                     PasskeyRelyingParty           `json:"rp"`
+	User                   PasskeyUser                   `json:"user"`
+	PubKeyCredParams       []PasskeyCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                         `json:"timeout" example:"300000"` // Milliseconds
+	ExcludeCredentials     []PasskeyCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection PasskeyAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                        `json:"attestation" example:"none"`
+}
+
+// PasskeyRequestOptions are the options for navigator.credentials.get(), in the JSON form
+// accepted by PublicKeyCredential.parseRequestOptionsFromJSON()
+type PasskeyRequestOptions struct {
+	Challenge        string                        `json:"challenge" example:"mX2v0Vw1b7N6Q3xYk8zJ4tH5aR9cL0pE2uS6dF1gW3o"`
+	RPID             string                        `json:"rpId" example:"admin.example.com"`
+	Timeout          int64                         `json:"timeout" example:"300000"` // Milliseconds
+	AllowCredentials []PasskeyCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                        `json:"userVerification" example:"preferred"`
+}
+
+// PasskeyAssertion is a passkey login response from the browser, with binary fields decoded
+type PasskeyAssertion struct {
+	CredentialID      string
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	UserHandle        []byte
+}
+
+// PasskeysEnabled reports whether passkey login is configured
+func PasskeysEnabled() bool {
+	return config.AppConfig().WebAuthn.RPID != ""
+}
+
+// PasswordLoginAllowed reports whether the admin may log in with a password. With
+// WEBAUTHN_PASSWORD_FALLBACK=unenrolled, admins who registered a passkey must use it.
+func PasswordLoginAllowed(adminID uuid.UUID) (bool, error) {
+	if !PasskeysEnabled() || config.AppConfig().WebAuthn.PasswordFallback != "unenrolled" {
+		return true, nil
+	}
+	var count int64
+	if err := db.DB.Model(&models.AdminCredential{}).Where("admin_id = ?", adminID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count == 0, nil
+}
+
+// BeginPasskeyRegistration creates a registration challenge for the admin and returns the
+// options to pass to the browser. Credentials the admin already has are excluded, so the same
+// authenticator is not registered twice.
+func BeginPasskeyRegistration(admin models.Admin) (PasskeyCreationOptions, error) {
+	var options PasskeyCreationOptions
+	if !PasskeysEnabled() {
+		return options, ErrPasskeysDisabled
+	}
+	cfg := config.AppConfig().WebAuthn
+
+	challenge, err := createWebAuthnChallenge(models.WebAuthnRegistration, &admin.ID)
+	if err != nil {
+		return options, err
+	}
+	existing, err := passkeyDescriptors(admin.ID)
+	if err != nil {
+		return options, err
+	}
+
+	options = PasskeyCreationOptions{
+		Challenge: challenge,
+		RP:        PasskeyRelyingParty{ID: cfg.RPID, Name: cfg.RPName},
+		User: PasskeyUser{
+			ID:          base64.RawURLEncoding.EncodeToString(admin.ID[:]),
+			Name:        admin.Username,
+			DisplayName: admin.Username,
+		},
+		Timeout:                cfg.ChallengeTTL.Milliseconds(),
+		ExcludeCredentials:     existing,
+		AuthenticatorSelection: PasskeyAuthenticatorSelection{ResidentKey: "required", UserVerification: cfg.UserVerification}, // Login never lists credentials
+		Attestation:            "none",
+	}
+	for _, alg := range webauthn.SupportedAlgorithms {
+		options.PubKeyCredParams = append(options.PubKeyCredParams, PasskeyCredentialParameter{Type: "public-key", Alg: alg})
+	}
+	return options, nil
+}
+
+// FinishPasskeyRegistration verifies the browser's response to a registration challenge of the
+// admin and stores the new credential. Returns ErrPasskeyInvalid, wrapped with the reason, if
+// the challenge is unknown or expired or the response does not verify, and ErrPasskeyExists if
+// the credential is already registered.
+func FinishPasskeyRegistration(admin models.Admin, clientDataJSON, attestationObject []byte, name string, transports []string) (models.AdminCredential, error) {
+	if !PasskeysEnabled() {
+		return models.AdminCredential{}, ErrPasskeysDisabled
+	}
+
+	challenge, err := consumeWebAuthnChallenge(clientDataJSON, models.WebAuthnRegistration)
+	if err != nil {
+		return models.AdminCredential{}, err
+	}
+	if challenge.AdminID == nil || *challenge.AdminID != admin.ID {
+		return models.AdminCredential{}, errPasskeyChallenge
+	}
+
+	verified, err := relyingParty().VerifyRegistration(clientDataJSON, attestationObject, challenge.Challenge)
+	if err != nil {
+		return models.AdminCredential{}, fmt.Errorf("%w: %v", ErrPasskeyInvalid, err)
+	}
+
+	credential := models.AdminCredential{
+		AdminID:        admin.ID,
+		CredentialID:   base64.RawURLEncoding.EncodeToString(verified.ID),
+		PublicKey:      verified.PublicKey,
+		Algorithm:      int(verified.Algorithm),
+		SignCount:      int64(verified.SignCount),
+		Transports:     strings.Join(transports, ","),
+		Name:           name,
+		BackupEligible: verified.BackupEligible,
+	}
+	if aaguid, err := uuid.FromBytes(verified.AAGUID); err == nil {
+		credential.AAGUID = aaguid.String()
+	}
+	if credential.Name == "" {
+		credential.Name = "Passkey"
+	}
+
+	var count int64
+	if err := db.DB.Model(&models.AdminCredential{}).Where("credential_id = ?", credential.CredentialID).Count(&count).Error; err != nil {
+		return credential, err
+	}
+	if count > 0 {
+		return credential, ErrPasskeyExists
+	}
+	if err := db.DB.Create(&credential).Error; err != nil {
+		return credential, err
+	}
+	slog.Info("[PASSKEY] Passkey registered", "admin", admin.Username, "passkey_id", credential.ID, "name", credential.Name)
+	return credential, nil
+}
+
+// BeginPasskeyLogin creates a login challenge and returns the options to pass to the browser.
+// allowCredentials is always empty, so the browser offers any passkey it holds for the relying
+// party and the options do not reveal which accounts exist or have passkeys. When username
+// names an admin, the challenge only accepts that admin's passkeys.
+func BeginPasskeyLogin(username string) (PasskeyRequestOptions, error) {
+	var options PasskeyRequestOptions
+	if !PasskeysEnabled() {
+		return options, ErrPasskeysDisabled
+	}
+	cfg := config.AppConfig().WebAuthn
+
+	var adminID *uuid.UUID
+	options.AllowCredentials = []PasskeyCredentialDescriptor{}
+	if username != "" {
+		var admin models.Admin
+		err := db.DB.Select("id").Where("username = ?", username).First(&admin).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return options, err
+		}
+		if err == nil {
+			adminID = &admin.ID
+		}
+	}
+
+	challenge, err := createWebAuthnChallenge(models.WebAuthnLogin, adminID)
+	if err != nil {
+		return options, err
+	}
+	options.Challenge = challenge
+	options.RPID = cfg.RPID
+	options.Timeout = cfg.ChallengeTTL.Milliseconds()
+	options.UserVerification = cfg.UserVerification
+	return options, nil
+}
+
+// FinishPasskeyLogin verifies the browser's response to a login challenge and returns the admin
+// the passkey belongs to. Every failure returns ErrPasskeyInvalid, except database errors, and
+// the reason is only logged.
+func FinishPasskeyLogin(assertion PasskeyAssertion) (models.Admin, error) {
+	var admin models.Admin
+	if !PasskeysEnabled() {
+		return admin, ErrPasskeysDisabled
+	}
+
+	challenge, err := consumeWebAuthnChallenge(assertion.ClientDataJSON, models.WebAuthnLogin)
+	if errors.Is(err, ErrPasskeyInvalid) {
+		return admin, ErrPasskeyInvalid
+	}
+	if err != nil {
+		return admin, err
+	}
+
+	var credential models.AdminCredential
+	err = db.DB.Where("credential_id = ?", assertion.CredentialID).First(&credential).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return admin, ErrPasskeyInvalid
+	}
+	if err != nil {
+		return admin, err
+	}
+	if challenge.AdminID != nil && *challenge.AdminID != credential.AdminID {
+		return admin, ErrPasskeyInvalid
+	}
+	if len(assertion.UserHandle) > 0 && !bytes.Equal(assertion.UserHandle, credential.AdminID[:]) {
+		return admin, ErrPasskeyInvalid
+	}
+
+	signCount, err := relyingParty().VerifyAssertion(assertion.ClientDataJSON, assertion.AuthenticatorData,
+		assertion.Signature, challenge.Challenge, credential.PublicKey, uint32(credential.SignCount))
+	if err != nil {
+		slog.Warn("[PASSKEY] Login with passkey failed", "passkey_id", credential.ID, "error", err)
+		return admin, ErrPasskeyInvalid
+	}
+
+	// Conditional update: two assertions with the same counter cannot both log in
+	updated := db.DB.Model(&models.AdminCredential{}).
+		Where("id = ? AND sign_count = ?", credential.ID, credential.SignCount).
+		Updates(map[string]interface{}{"sign_count": int64(signCount), "last_used_at": time.Now()})
+	if updated.Error != nil {
+		return admin, updated.Error
+	}
+	if updated.RowsAffected == 0 {
+		return admin, ErrPasskeyInvalid
+	}
+
+	err = db.DB.First(&admin, "id = ?", credential.AdminID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return admin, ErrPasskeyInvalid
+	}
+	return admin, err
+}
+
+// ListAdminCredentials returns the admin's passkeys, oldest first
+func ListAdminCredentials(adminID uuid.UUID) ([]models.AdminCredential, error) {
+	var credentials []models.AdminCredential
+	err := db.DB.Where("admin_id = ?", adminID).Order("created_at").Find(&credentials).Error
+	return credentials, err
+}
+
+// FindAdminCredential returns one of the admin's passkeys by its ID
+func FindAdminCredential(adminID, id uuid.UUID) (models.AdminCredential, error) {
+	var credential models.AdminCredential
+	err := db.DB.Where("id = ? AND admin_id = ?", id, adminID).First(&credential).Error
+	return credential, err
+}
+
+// PurgeWebAuthnChallenges deletes challenges that expired before the cutoff
+func PurgeWebAuthnChallenges(cutoff time.Time) (int64, error) {
+	result := db.DB.Where("expires_at < ?", cutoff).Delete(&models.WebAuthnChallenge{})
+	return result.RowsAffected, result.Error
+}
+
+// relyingParty returns the relying party configured by WEBAUTHN_*
+func relyingParty() webauthn.RelyingParty {
+	cfg := config.AppConfig().WebAuthn
+	return webauthn.RelyingParty{
+		ID:                      cfg.RPID,
+		Origins:                 cfg.Origins,
+		RequireUserVerification: cfg.UserVerification == "required",
+	}
+}
+
+// createWebAuthnChallenge stores a new challenge for the purpose and returns it
+func createWebAuthnChallenge(purpose string, adminID *uuid.UUID) (string, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return "", err
+	}
+	record := models.WebAuthnChallenge{
+		Challenge: challenge,
+		Purpose:   purpose,
+		AdminID:   adminID,
+		ExpiresAt: time.Now().Add(config.AppConfig().WebAuthn.ChallengeTTL),
+	}
+	if err := db.DB.Create(&record).Error; err != nil {
+		return "", fmt.Errorf("failed to store challenge: %w", err)
+	}
+	return challenge, nil
+}
+
+// consumeWebAuthnChallenge finds the challenge clientDataJSON answers and deletes it, so each
+// challenge can be answered once. Returns errPasskeyChallenge for unknown, expired and used
+// challenges.
+func consumeWebAuthnChallenge(clientDataJSON []byte, purpose string) (models.WebAuthnChallenge, error) {
+	var challenge models.WebAuthnChallenge
+	clientData, err := webauthn.ParseClientData(clientDataJSON)
+	if err != nil || clientData.Challenge == "" {
+		return challenge, errPasskeyChallenge
+	}
+
+	err = db.DB.Where("challenge = ? AND purpose = ? AND expires_at > ?", clientData.Challenge, purpose, time.Now()).
+		First(&challenge).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return challenge, errPasskeyChallenge
+	}
+	if err != nil {
+		return challenge, err
+	}
+
+	// Conditional delete: a challenge answered concurrently is only accepted once
+	deleted := db.DB.Where("id = ?", challenge.ID).Delete(&models.WebAuthnChallenge{})
+	if deleted.Error != nil {
+		return challenge, deleted.Error
+	}
+	if deleted.RowsAffected == 0 {
+		return challenge, errPasskeyChallenge
+	}
+	return challenge, nil
+}
+
+// passkeyDescriptors lists the admin's credentials for excludeCredentials
+func passkeyDescriptors(adminID uuid.UUID) ([]PasskeyCredentialDescriptor, error) {
+	credentials, err := ListAdminCredentials(adminID)
+	if err != nil {
+		return nil, err
+	}
+	descriptors := make([]PasskeyCredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		descriptor := PasskeyCredentialDescriptor{Type: "public-key", ID: credential.CredentialID}
+		if credential.Transports != "" {
+			descriptor.Transports = strings.Split(credential.Transports, ",")
+		}
+		descriptors = append(descriptors, descriptor)
+	}
+	return descriptors, nil
+}
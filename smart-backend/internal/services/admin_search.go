@@ -0,0 +1,251 @@
+package services
+
+import (
+	"fmt"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/phonenumber"
+	"ololo-gate/internal/pii"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Types of admin search results, in the order they are listed when scores are equal
+const (
+	SearchUser     = "user"
+	SearchAdmin    = "admin"
+	SearchLocation = "location"
+	SearchGate     = "gate"
+	SearchAuditLog = "audit_log"
+)
+
+var searchTypeOrder = map[string]int{SearchUser: 0, SearchAdmin: 1, SearchLocation: 2, SearchGate: 3, SearchAuditLog: 4}
+
+// Scores of admin search matches, highest first
+const (
+	scoreExact    = 100 // Same ID, full phone number, email or name
+	scorePrefix   = 80  // Name starts with the query
+	scoreSuffix   = 60  // Last digits of a phone number
+	scoreContains = 50  // Name contains the query
+	scoreAudit    = 30  // Audit log entries rank below the records they are about
+)
+
+// auditSearchLimit bounds how many audit log entries a search returns
+const auditSearchLimit = 10
+
+// SearchResult is one match of an admin search
+type SearchResult struct {
+	Type     string
+	ID       string
+	Title    string
+	Subtitle string
+	Score    int
+}
+
+// AdminSearch is the admin panel's omnibox search
+type AdminSearch struct {
+	Query       string
+	IncludeAll  bool              // Also search admin accounts and audit logs (super admins and auditors only)
+	Client      *ThirdPartyClient // Used to load the location catalog when it is stale
+	results     []SearchResult
+	locationErr error
+}
+
+// Run searches users, locations and gates, and for super admins admin accounts and audit logs.
+// Results are ranked by score, then type. Locations come from the cached catalog; when the
+// provider cannot be reached and nothing is cached, they are skipped and LocationError
+// reports why.
+func (s *AdminSearch) Run(limit int) ([]SearchResult, error) {
+	s.results = nil
+	q := strings.TrimSpace(s.Query)
+
+	if err := s.searchUsers(q); err != nil {
+		return nil, err
+	}
+	s.searchLocations(q)
+	if s.IncludeAll {
+		if err := s.searchAdmins(q); err != nil {
+			return nil, err
+		}
+		if err := s.searchAuditLogs(q); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(s.results, func(i, j int) bool {
+		a, b := s.results[i], s.results[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if searchTypeOrder[a.Type] != searchTypeOrder[b.Type] {
+			return searchTypeOrder[a.Type] < searchTypeOrder[b.Type]
+		}
+		return a.Title < b.Title
+	})
+	if len(s.results) > limit {
+		s.results = s.results[:limit]
+	}
+	return s.results, nil
+}
+
+// LocationError is the error that kept locations and gates out of the last Run, if any
+func (s *AdminSearch) LocationError() error {
+	return s.locationErr
+}
+
+func (s *AdminSearch) add(result SearchResult) {
+	s.results = append(s.results, result)
+}
+
+// searchUsers matches users by ID, full phone number, the last digits of a phone number or
+// email. Phones and emails are encrypted, so they are matched by blind index, not by substring.
+func (s *AdminSearch) searchUsers(q string) error {
+	seen := make(map[uuid.UUID]bool)
+	find := func(score int, query string, args ...interface{}) error {
+		var users []models.User
+		if err := db.DB.Where(query, args...).Limit(20).Find(&users).Error; err != nil {
+			return err
+		}
+		for _, user := range users {
+			if seen[user.ID] {
+				continue
+			}
+			seen[user.ID] = true
+			subtitle := user.Email
+			if user.TrashedAt != nil {
+				subtitle = strings.TrimSpace(subtitle + " (in the trash)")
+			}
+			s.add(SearchResult{Type: SearchUser, ID: user.ID.String(), Title: user.Phone, Subtitle: subtitle, Score: score})
+		}
+		return nil
+	}
+
+	if id, err := uuid.Parse(q); err == nil {
+		if err := find(scoreExact, "id = ?", id); err != nil {
+			return err
+		}
+	}
+	if phone, err := phonenumber.Normalize(q); err == nil {
+		if err := find(scoreExact, "phone_index = ?", pii.BlindIndex(phone)); err != nil {
+			return err
+		}
+	}
+	if strings.Contains(q, "@") {
+		if err := find(scoreExact, "email_index = ?", pii.BlindIndex(models.NormalizeEmail(q))); err != nil {
+			return err
+		}
+	}
+	if digits := strings.TrimPrefix(q, "+"); isDigits(digits) {
+		if err := find(scoreSuffix, "phone_suffix_index = ?", pii.BlindIndex(models.PhoneSuffix(digits))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchAdmins matches admin accounts by ID or username
+func (s *AdminSearch) searchAdmins(q string) error {
+	var admins []models.Admin
+	query := db.DB.Where(`LOWER(username) LIKE ? ESCAPE '\'`, "%"+escapeLike(strings.ToLower(q))+"%")
+	if id, err := uuid.Parse(q); err == nil {
+		query = query.Or("id = ?", id)
+	}
+	if err := query.Limit(20).Find(&admins).Error; err != nil {
+		return err
+	}
+	for _, admin := range admins {
+		score := scoreExact
+		if admin.ID.String() != q {
+			score = textScore(admin.Username, q)
+		}
+		s.add(SearchResult{Type: SearchAdmin, ID: admin.ID.String(), Title: admin.Username, Subtitle: admin.Role, Score: score})
+	}
+	return nil
+}
+
+// searchLocations matches locations and gates in the cached catalog by ID, title or address
+func (s *AdminSearch) searchLocations(q string) {
+	s.locationErr = nil
+	locations, err := Locations().All(s.Client)
+	if err != nil && locations == nil {
+		s.locationErr = err
+		return
+	}
+
+	id, idErr := strconv.Atoi(q)
+	for _, location := range locations {
+		score := max(textScore(location.Title, q), textScore(location.Address, q))
+		if idErr == nil && location.ID == id {
+			score = scoreExact
+		}
+		if score > 0 {
+			s.add(SearchResult{Type: SearchLocation, ID: strconv.Itoa(location.ID), Title: location.Title, Subtitle: location.Address, Score: score})
+		}
+		for _, gate := range location.Gates {
+			score := max(textScore(gate.Title, q), textScore(gate.Description, q))
+			if idErr == nil && gate.ID == id {
+				score = scoreExact
+			}
+			if score > 0 {
+				s.add(SearchResult{Type: SearchGate, ID: strconv.Itoa(gate.ID), Title: gate.Title, Subtitle: location.Title, Score: score})
+			}
+		}
+	}
+}
+
+// searchAuditLogs returns the latest audit log entries about the resource with this ID, or
+// by an admin or with an action matching the query
+func (s *AdminSearch) searchAuditLogs(q string) error {
+	pattern := "%" + escapeLike(strings.ToLower(q)) + "%"
+	var logs []models.AdminAuditLog
+	if err := db.DB.Where(`resource_id = ? OR LOWER(admin_name) LIKE ? ESCAPE '\' OR LOWER(action) LIKE ? ESCAPE '\'`, q, pattern, pattern).
+		Order("created_at DESC").Limit(auditSearchLimit).Find(&logs).Error; err != nil {
+		return err
+	}
+	for _, entry := range logs {
+		s.add(SearchResult{
+			Type:     SearchAuditLog,
+			ID:       entry.ID.String(),
+			Title:    entry.Action,
+			Subtitle: fmt.Sprintf("%s on %s %s, %s", entry.AdminName, entry.ResourceType, entry.ResourceID, entry.CreatedAt.Format("2006-01-02 15:04")),
+			Score:    scoreAudit,
+		})
+	}
+	return nil
+}
+
+// textScore ranks a case-insensitive match of q in text; 0 means no match
+func textScore(text, q string) int {
+	text, q = strings.ToLower(text), strings.ToLower(q)
+	switch {
+	case text == "" || q == "":
+		return 0
+	case text == q:
+		return scoreExact
+	case strings.HasPrefix(text, q):
+		return scorePrefix
+	case strings.Contains(text, q):
+		return scoreContains
+	}
+	return 0
+}
+
+// escapeLike escapes the LIKE wildcards in s
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
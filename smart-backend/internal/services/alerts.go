@@ -0,0 +1,271 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/smtp"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/scheduler"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics alert rules can watch
+const (
+	AlertProviderErrorRate = "provider_error_rate" // % of third-party API calls that failed since the previous check
+	AlertDBPoolSaturation  = "db_pool_saturation"  // % of the maximum open database connections in use
+	AlertJobBacklog        = "job_backlog"         // Scheduled jobs past their run time
+)
+
+// Alert is a rule that started firing or resolved
+type Alert struct {
+	Rule   config.AlertRule
+	Value  float64   // Metric value at the check that changed the state
+	Firing bool      // false when the alert resolved
+	Since  time.Time // When the metric crossed the threshold
+	At     time.Time
+}
+
+// Title is a one-line summary of the alert
+func (a Alert) Title() string {
+	if a.Firing {
+		return fmt.Sprintf("Alert: %s above %g", a.Rule.Metric, a.Rule.Threshold)
+	}
+	return fmt.Sprintf("Resolved: %s back to normal", a.Rule.Metric)
+}
+
+// Message describes the alert for people reading it in a notification or email
+func (a Alert) Message() string {
+	if a.Firing {
+		return fmt.Sprintf("%s has been above %g since %s (for at least %s); current value %.2f",
+			a.Rule.Metric, a.Rule.Threshold, a.Since.UTC().Format(time.RFC3339), a.Rule.For, a.Value)
+	}
+	return fmt.Sprintf("%s is at %.2f, within the threshold of %g again", a.Rule.Metric, a.Value, a.Rule.Threshold)
+}
+
+// AlertNotifier delivers alerts to a channel (admin notifications, webhook, email)
+type AlertNotifier interface {
+	Notify(alert Alert) error
+}
+
+type alertState struct {
+	breachSince time.Time // Zero while the metric is within the threshold
+	firing      bool
+}
+
+// AlertMonitor evaluates alert rules against this instance's metrics and notifies on state changes
+type AlertMonitor struct {
+	mu        sync.Mutex
+	rules     []config.AlertRule
+	states    []alertState
+	samplers  map[string]func() float64
+	notifiers []AlertNotifier
+	now       func() time.Time
+}
+
+// NewAlertMonitor creates a monitor for rules. Rules on unknown metrics are logged and ignored.
+func NewAlertMonitor(rules []config.AlertRule, notifiers []AlertNotifier) *AlertMonitor {
+	m := &AlertMonitor{
+		samplers: map[string]func() float64{
+			AlertProviderErrorRate: providerErrorRateSampler(),
+			AlertDBPoolSaturation:  dbPoolSaturation,
+			AlertJobBacklog:        func() float64 { return float64(scheduler.Default().Backlog(time.Now())) },
+		},
+		notifiers: notifiers,
+		now:       time.Now,
+	}
+	for _, rule := range rules {
+		if _, ok := m.samplers[rule.Metric]; !ok {
+			slog.Warn("[ALERTS] Ignoring rule on unknown metric", "metric", rule.Metric)
+			continue
+		}
+		m.rules = append(m.rules, rule)
+	}
+	m.states = make([]alertState, len(m.rules))
+	return m
+}
+
+// Check samples every metric once, delivers alerts that started firing or resolved and returns them
+func (m *AlertMonitor) Check() []Alert {
+	m.mu.Lock()
+	now := m.now()
+	values := make(map[string]float64)
+	var alerts []Alert
+	for i, rule := range m.rules {
+		value, ok := values[rule.Metric]
+		if !ok {
+			value = m.samplers[rule.Metric]()
+			values[rule.Metric] = value
+		}
+
+		state := &m.states[i]
+		if value <= rule.Threshold {
+			if state.firing {
+				alerts = append(alerts, Alert{Rule: rule, Value: value, Since: state.breachSince, At: now})
+			}
+			*state = alertState{}
+			continue
+		}
+		if state.breachSince.IsZero() {
+			state.breachSince = now
+		}
+		if !state.firing && now.Sub(state.breachSince) >= rule.For {
+			state.firing = true
+			alerts = append(alerts, Alert{Rule: rule, Value: value, Firing: true, Since: state.breachSince, At: now})
+		}
+	}
+	m.mu.Unlock()
+
+	for _, alert := range alerts {
+		slog.Warn("[ALERTS] Alert", "title", alert.Title(), "message", alert.Message())
+		for _, notifier := range m.notifiers {
+			if err := notifier.Notify(alert); err != nil {
+				slog.Error("[ALERTS] Failed to deliver alert", "title", alert.Title(), "notifier", fmt.Sprintf("%T", notifier), "error", err)
+			}
+		}
+	}
+	return alerts
+}
+
+// Start checks the rules every interval until the process exits
+func (m *AlertMonitor) Start(interval time.Duration) {
+	if interval <= 0 || len(m.rules) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			m.Check()
+		}
+	}()
+}
+
+// StartAlertMonitor starts the monitor with the configured rules and delivery channels
+func StartAlertMonitor() *AlertMonitor {
+	cfg := config.AppConfig().Alerts
+	notifiers := []AlertNotifier{AdminNotificationAlertNotifier{}}
+	if cfg.WebhookURL != "" {
+		notifiers = append(notifiers, &WebhookAlertNotifier{URL: cfg.WebhookURL, Client: &http.Client{Timeout: 10 * time.Second}, Residency: config.AppConfig().Residency})
+	}
+	if len(cfg.EmailTo) > 0 && cfg.SMTPAddr != "" {
+		notifiers = append(notifiers, &EmailAlertNotifier{
+			Addr: cfg.SMTPAddr, Username: cfg.SMTPUsername, Password: cfg.SMTPPassword, From: cfg.SMTPFrom, To: cfg.EmailTo,
+		})
+	}
+
+	monitor := NewAlertMonitor(cfg.Rules, notifiers)
+	monitor.Start(cfg.CheckInterval)
+	return monitor
+}
+
+// providerErrorRateSampler returns the share of third-party calls that failed since the previous sample
+func providerErrorRateSampler() func() float64 {
+	lastRequests := metrics.CounterTotal("third_party_requests_total")
+	lastErrors := metrics.CounterTotal("third_party_errors_total")
+	return func() float64 {
+		requests := metrics.CounterTotal("third_party_requests_total")
+		errors := metrics.CounterTotal("third_party_errors_total")
+		deltaRequests, deltaErrors := requests-lastRequests, errors-lastErrors
+		lastRequests, lastErrors = requests, errors
+		if deltaRequests <= 0 {
+			return 0
+		}
+		return deltaErrors / deltaRequests * 100
+	}
+}
+
+// dbPoolSaturation returns the share of the maximum open database connections in use
+func dbPoolSaturation() float64 {
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		return 0
+	}
+	stats := sqlDB.Stats()
+	if stats.MaxOpenConnections <= 0 {
+		return 0 // Unlimited pool
+	}
+	return float64(stats.InUse) / float64(stats.MaxOpenConnections) * 100
+}
+
+// AdminNotificationAlertNotifier adds alerts to the admin notification center
+type AdminNotificationAlertNotifier struct{}
+
+// Notify stores the alert as a critical notification, or an info one when it resolves
+func (AdminNotificationAlertNotifier) Notify(alert Alert) error {
+	severity := models.SeverityInfo
+	if alert.Firing {
+		severity = models.SeverityCritical
+	}
+	_, err := NotifyAdmins(severity, models.NotificationAlerts, alert.Title(), alert.Message())
+	return err
+}
+
+// WebhookAlertNotifier posts alerts as JSON to a webhook (e.g. a chat or paging integration)
+type WebhookAlertNotifier struct {
+	URL       string
+	Client    *http.Client
+	Residency config.ResidencyConfig // Data residency policy checked before each post
+}
+
+// Notify posts the alert, signed like every outbound webhook; any non-2xx response is an error
+func (n *WebhookAlertNotifier) Notify(alert Alert) error {
+	if err := n.Residency.CheckWebhook(n.URL); err != nil {
+		return err
+	}
+	status := "resolved"
+	if alert.Firing {
+		status = "firing"
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"status":    status,
+		"metric":    alert.Rule.Metric,
+		"threshold": alert.Rule.Threshold,
+		"for":       alert.Rule.For.String(),
+		"value":     alert.Value,
+		"since":     alert.Since.UTC(),
+		"at":        alert.At.UTC(),
+		"title":     alert.Title(),
+		"message":   alert.Message(),
+	})
+	if err != nil {
+		return err
+	}
+	return postWebhook(n.Client, n.URL, body)
+}
+
+// EmailAlertNotifier sends alerts by email over SMTP
+type EmailAlertNotifier struct {
+	Addr     string // host:port
+	Username string // PLAIN auth is used when set
+	Password string
+	From     string
+	To       []string
+}
+
+// Notify sends the alert as a plain-text email to every recipient
+func (n *EmailAlertNotifier) Notify(alert Alert) error {
+	return n.Send(alert.Title(), alert.Message())
+}
+
+// Send emails a plain-text message to every recipient
+func (n *EmailAlertNotifier) Send(subject, body string) error {
+	var auth smtp.Auth
+	if n.Username != "" {
+		host := n.Addr
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", n.Username, n.Password, host)
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: [Ololo Gate] %s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s\r\n",
+		n.From, strings.Join(n.To, ", "), subject, body)
+	return smtp.SendMail(n.Addr, auth, n.From, n.To, []byte(msg))
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingAlertNotifier struct {
+	alerts []Alert
+}
+
+func (n *recordingAlertNotifier) Notify(alert Alert) error {
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func TestAlertMonitor_FiresAfterDurationAndResolves(t *testing.T) {
+	notifier := &recordingAlertNotifier{}
+	monitor := NewAlertMonitor([]config.AlertRule{
+		{Metric: AlertProviderErrorRate, Threshold: 20, For: 5 * time.Minute},
+		{Metric: "disk_usage", Threshold: 90, For: time.Minute}, // Unknown metrics are ignored
+	}, []AlertNotifier{notifier})
+
+	now := time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC)
+	monitor.now = func() time.Time { return now }
+	errorRate := 50.0
+	monitor.samplers[AlertProviderErrorRate] = func() float64 { return errorRate }
+
+	// Above the threshold, but not for long enough yet
+	assert.Empty(t, monitor.Check())
+	now = now.Add(4 * time.Minute)
+	assert.Empty(t, monitor.Check())
+
+	now = now.Add(time.Minute)
+	alerts := monitor.Check()
+	assert.Len(t, alerts, 1)
+	assert.True(t, alerts[0].Firing)
+	assert.Equal(t, now.Add(-5*time.Minute), alerts[0].Since)
+
+	// A firing alert is delivered once
+	now = now.Add(time.Minute)
+	assert.Empty(t, monitor.Check())
+
+	errorRate = 5
+	alerts = monitor.Check()
+	assert.Len(t, alerts, 1)
+	assert.False(t, alerts[0].Firing)
+	assert.Len(t, notifier.alerts, 2)
+
+	// A short spike after resolving does not fire again
+	errorRate = 50
+	assert.Empty(t, monitor.Check())
+}
+
+func TestWebhookAlertNotifier_PostsAlert(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+	}))
+	defer server.Close()
+	setupWebhookSecretTestDB(t, &config.Config{})
+
+	notifier := &WebhookAlertNotifier{URL: server.URL, Client: server.Client()}
+	err := notifier.Notify(Alert{
+		Rule:   config.AlertRule{Metric: AlertJobBacklog, Threshold: 0, For: 15 * time.Minute},
+		Value:  2,
+		Firing: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "firing", payload["status"])
+	assert.Equal(t, AlertJobBacklog, payload["metric"])
+	assert.Equal(t, "15m0s", payload["for"])
+	assert.Equal(t, float64(2), payload["value"])
+}
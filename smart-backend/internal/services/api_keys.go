@@ -0,0 +1,94 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// apiKeyPrefix starts every API key, so leaked keys are easy to recognize and scan for
+const apiKeyPrefix = "ogk_"
+
+// apiKeyLastUsedInterval limits how often LastUsedAt is written for a busy key
+const apiKeyLastUsedInterval = time.Minute
+
+// ErrAPIKeyInvalid is returned for unknown and revoked API keys
+var ErrAPIKeyInvalid = errors.New("invalid or revoked API key")
+
+// APIKeyScopes are the scopes an API key can be given
+var APIKeyScopes = []string{models.APIKeyScopeSCIM, models.APIKeyScopeSync}
+
+// CreateAPIKey generates and stores a new API key. The returned key is not stored and cannot be
+// shown again.
+func CreateAPIKey(name string, scopes []string, createdBy string) (models.APIKey, string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return models.APIKey{}, "", err
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(random)
+
+	apiKey := models.APIKey{
+		Name:      name,
+		Prefix:    key[:len(apiKeyPrefix)+6],
+		KeyHash:   hashAPIKey(key),
+		Scopes:    strings.Join(scopes, ","),
+		CreatedBy: createdBy,
+	}
+	if err := db.DB.Create(&apiKey).Error; err != nil {
+		return models.APIKey{}, "", err
+	}
+	return apiKey, key, nil
+}
+
+// AuthenticateAPIKey returns the active API key matching key and records its use.
+// Returns ErrAPIKeyInvalid for unknown and revoked keys.
+func AuthenticateAPIKey(key string) (models.APIKey, error) {
+	var apiKey models.APIKey
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return apiKey, ErrAPIKeyInvalid
+	}
+	err := db.DB.Where("key_hash = ? AND revoked_at IS NULL", hashAPIKey(key)).First(&apiKey).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return apiKey, ErrAPIKeyInvalid
+	}
+	if err != nil {
+		return apiKey, err
+	}
+
+	now := time.Now()
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) > apiKeyLastUsedInterval {
+		if err := db.DB.Model(&apiKey).Update("last_used_at", now).Error; err != nil {
+			slog.Error("[API_KEY] Failed to record use of key", "key_id", apiKey.ID, "error", err)
+		}
+	}
+	return apiKey, nil
+}
+
+// RevokeAPIKey stops the key from authenticating. Revoking a revoked key does nothing.
+func RevokeAPIKey(apiKey *models.APIKey) error {
+	if apiKey.RevokedAt != nil {
+		return nil
+	}
+	now := time.Now()
+	if err := db.DB.Model(apiKey).Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+	apiKey.RevokedAt = &now
+	return nil
+}
+
+// hashAPIKey returns the hex SHA-256 of a key. Keys are long and random, so they need no salt
+// or slow hash.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// AttemptTracker counts failed attempts per key (e.g. a client IP) within a sliding window, to
+// throttle guessing of low-entropy secrets. Like the in-memory quota store it only covers a
+// single instance.
+type AttemptTracker struct {
+	mu       sync.Mutex
+	window   time.Duration
+	failures map[string][]time.Time
+	now      func() time.Time
+}
+
+var (
+	gateLinkAttempts     *AttemptTracker
+	gateLinkAttemptsOnce sync.Once
+)
+
+// NewAttemptTracker creates a tracker counting failures within window
+func NewAttemptTracker(window time.Duration) *AttemptTracker {
+	return &AttemptTracker{window: window, failures: make(map[string][]time.Time), now: time.Now}
+}
+
+// GateLinkAttempts returns the process-wide tracker of failed gate link resolutions, keyed by
+// client IP ("ip:...") and by gate ("gate:...")
+func GateLinkAttempts() *AttemptTracker {
+	gateLinkAttemptsOnce.Do(func() {
+		gateLinkAttempts = NewAttemptTracker(time.Hour)
+	})
+	return gateLinkAttempts
+}
+
+// Fail records a failed attempt for key and returns the number of failures in the window
+func (t *AttemptTracker) Fail(key string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	t.prune(now)
+	t.failures[key] = append(t.failures[key], now)
+	return len(t.failures[key])
+}
+
+// Blocked reports whether key has limit or more failures in the window, and how long until
+// the oldest of them expires. A limit <= 0 never blocks.
+func (t *AttemptTracker) Blocked(key string, limit int) (time.Duration, bool) {
+	if limit <= 0 {
+		return 0, false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	t.prune(now)
+	failures := t.failures[key]
+	if len(failures) < limit {
+		return 0, false
+	}
+	return failures[len(failures)-limit].Add(t.window).Sub(now), true
+}
+
+// prune drops failures older than the window, and keys without failures
+func (t *AttemptTracker) prune(now time.Time) {
+	cutoff := now.Add(-t.window)
+	for key, failures := range t.failures {
+		i := 0
+		for i < len(failures) && !failures[i].After(cutoff) {
+			i++
+		}
+		if i == len(failures) {
+			delete(t.failures, key)
+		} else if i > 0 {
+			t.failures[key] = failures[i:]
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttemptTracker_BlocksUntilFailuresExpire(t *testing.T) {
+	tracker := NewAttemptTracker(time.Hour)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+
+	assert.Equal(t, 1, tracker.Fail("ip:10.0.0.1"))
+	now = now.Add(10 * time.Minute)
+	assert.Equal(t, 2, tracker.Fail("ip:10.0.0.1"))
+	tracker.Fail("ip:10.0.0.2")
+
+	wait, blocked := tracker.Blocked("ip:10.0.0.1", 2)
+	assert.True(t, blocked)
+	assert.Equal(t, 50*time.Minute, wait)
+	_, blocked = tracker.Blocked("ip:10.0.0.2", 2)
+	assert.False(t, blocked)
+	_, blocked = tracker.Blocked("ip:10.0.0.1", 0)
+	assert.False(t, blocked)
+
+	// The first failure leaves the window
+	now = now.Add(51 * time.Minute)
+	_, blocked = tracker.Blocked("ip:10.0.0.1", 2)
+	assert.False(t, blocked)
+	assert.Equal(t, 2, tracker.Fail("ip:10.0.0.1"))
+}
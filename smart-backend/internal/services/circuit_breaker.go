@@ -0,0 +1,119 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/metrics"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is wrapped by the UpstreamError returned while the provider circuit breaker is open
+var ErrCircuitOpen = errors.New("third-party API circuit breaker is open")
+
+// CircuitBreaker stops calls to a failing provider. After threshold consecutive failures it opens
+// and rejects calls for the cooldown; then one trial call is let through, which closes it again
+// on success or reopens it on failure.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openedAt  time.Time // Zero while closed
+	trial     bool      // A trial call is in flight
+	now       func() time.Time
+}
+
+var (
+	providerBreaker     *CircuitBreaker
+	providerBreakerOnce sync.Once
+)
+
+// NewCircuitBreaker creates a closed breaker. A threshold <= 0 never opens.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// ProviderBreaker returns the process-wide circuit breaker for third-party API calls
+func ProviderBreaker() *CircuitBreaker {
+	providerBreakerOnce.Do(func() {
+		cfg := config.AppConfig().ThirdParty
+		providerBreaker = NewCircuitBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown)
+	})
+	return providerBreaker
+}
+
+// Allow reports whether a call may go to the provider now
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openedAt.IsZero() {
+		return true
+	}
+	if b.trial || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+// IsOpen reports whether calls are currently being rejected (including while a trial call is in flight)
+func (b *CircuitBreaker) IsOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !b.openedAt.IsZero() && (b.trial || b.now().Sub(b.openedAt) < b.cooldown)
+}
+
+// RetryAfter returns how long until the breaker lets a call through again, 0 while it is closed
+func (b *CircuitBreaker) RetryAfter() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openedAt.IsZero() {
+		return 0
+	}
+	if wait := b.cooldown - b.now().Sub(b.openedAt); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// Success records a call that reached a working provider and closes the breaker
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.openedAt.IsZero() {
+		slog.Info("[CIRCUIT_BREAKER] Provider recovered, closing circuit")
+		metrics.SetGauge("third_party_circuit_open", nil, 0)
+	}
+	b.failures = 0
+	b.openedAt = time.Time{}
+	b.trial = false
+}
+
+// Cancel records a call that was let through but cancelled before the provider answered. A
+// cancelled trial call tells nothing about the provider, so the next call is tried instead.
+func (b *CircuitBreaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
+
+// Failure records a call that found the provider unavailable
+func (b *CircuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.trial || (b.openedAt.IsZero() && b.threshold > 0 && b.failures >= b.threshold) {
+		if b.openedAt.IsZero() {
+			slog.Warn("[CIRCUIT_BREAKER] Consecutive provider failures, opening circuit", "failures", b.failures, "cooldown", b.cooldown)
+		}
+		b.openedAt = b.now()
+		b.trial = false
+		metrics.SetGauge("third_party_circuit_open", nil, 1)
+	}
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker_OpensAndRecovers(t *testing.T) {
+	breaker := NewCircuitBreaker(3, 30*time.Second)
+	now := time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+
+	breaker.Failure()
+	breaker.Failure()
+	breaker.Success() // A success in between resets the count
+	breaker.Failure()
+	breaker.Failure()
+	assert.True(t, breaker.Allow())
+	assert.False(t, breaker.IsOpen())
+
+	breaker.Failure()
+	assert.True(t, breaker.IsOpen())
+	assert.False(t, breaker.Allow())
+	assert.Equal(t, 30*time.Second, breaker.RetryAfter())
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, 20*time.Second, breaker.RetryAfter())
+	now = now.Add(-10 * time.Second)
+
+	// After the cooldown one trial call goes through; a failed trial reopens the breaker
+	now = now.Add(30 * time.Second)
+	assert.True(t, breaker.Allow())
+	assert.False(t, breaker.Allow())
+	breaker.Failure()
+	assert.True(t, breaker.IsOpen())
+
+	now = now.Add(30 * time.Second)
+	assert.True(t, breaker.Allow())
+	breaker.Success()
+	assert.False(t, breaker.IsOpen())
+	assert.True(t, breaker.Allow())
+}
+
+func TestCircuitBreaker_ZeroThresholdNeverOpens(t *testing.T) {
+	breaker := NewCircuitBreaker(0, time.Minute)
+	for i := 0; i < 100; i++ {
+		breaker.Failure()
+	}
+	assert.False(t, breaker.IsOpen())
+	assert.True(t, breaker.Allow())
+}
+
+func TestCircuitBreaker_CancelledTrialLetsNextCallThrough(t *testing.T) {
+	breaker := NewCircuitBreaker(1, 30*time.Second)
+	now := time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+
+	breaker.Failure()
+	now = now.Add(30 * time.Second)
+	assert.True(t, breaker.Allow())
+	assert.False(t, breaker.Allow())
+
+	breaker.Cancel()
+	assert.True(t, breaker.Allow())
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"net/url"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"strings"
+	"sync"
+	"time"
+)
+
+// corsOriginsTTL bounds how long an instance keeps serving its cached origin list
+// after another instance edits it
+const corsOriginsTTL = 30 * time.Second
+
+// ErrInvalidOrigin is returned for origins that are not scheme://host[:port]
+var ErrInvalidOrigin = errors.New("origin must be http(s)://host[:port] without a path")
+
+// CORSOriginCache caches the database-managed CORS origins so the CORS middleware
+// does not query the database on every cross-origin request
+type CORSOriginCache struct {
+	mu       sync.RWMutex
+	origins  map[string]map[string]bool // scope -> origin -> allowed
+	loadedAt time.Time
+	ttl      time.Duration
+}
+
+var (
+	corsOriginCache     *CORSOriginCache
+	corsOriginCacheOnce sync.Once
+)
+
+// CORSOrigins returns the process-wide CORS origin cache
+func CORSOrigins() *CORSOriginCache {
+	corsOriginCacheOnce.Do(func() {
+		corsOriginCache = &CORSOriginCache{ttl: corsOriginsTTL}
+	})
+	return corsOriginCache
+}
+
+// Allowed reports whether origin was added for scope by a super admin
+func (c *CORSOriginCache) Allowed(scope, origin string) bool {
+	normalized, err := NormalizeOrigin(origin)
+	if err != nil {
+		return false
+	}
+
+	c.mu.RLock()
+	fresh := c.origins != nil && time.Since(c.loadedAt) < c.ttl
+	allowed := c.origins[scope][normalized]
+	c.mu.RUnlock()
+	if fresh {
+		return allowed
+	}
+
+	c.refresh()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.origins[scope][normalized]
+}
+
+// Invalidate makes the next lookup reload the origins from the database
+func (c *CORSOriginCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loadedAt = time.Time{}
+}
+
+// refresh reloads the origins from the database. On failure the previous list is kept.
+func (c *CORSOriginCache) refresh() {
+	if db.DB == nil {
+		return
+	}
+
+	var rows []models.CORSOrigin
+	if err := db.DB.Find(&rows).Error; err != nil {
+		slog.Error("[CORS] Failed to load CORS origins, keeping cached list", "error", err)
+		c.mu.Lock()
+		c.loadedAt = time.Now() // Don't hit the failing database on every request
+		c.mu.Unlock()
+		return
+	}
+
+	origins := make(map[string]map[string]bool)
+	for _, row := range rows {
+		if origins[row.Scope] == nil {
+			origins[row.Scope] = make(map[string]bool)
+		}
+		origins[row.Scope][row.Origin] = true
+	}
+
+	c.mu.Lock()
+	c.origins = origins
+	c.loadedAt = time.Now()
+	c.mu.Unlock()
+}
+
+// NormalizeOrigin validates a browser origin and returns it in the form browsers send
+// (lower-case scheme and host, no trailing slash)
+func NormalizeOrigin(origin string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(origin))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", ErrInvalidOrigin
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil || strings.Contains(u.Host, "*") {
+		return "", ErrInvalidOrigin
+	}
+	return strings.ToLower(u.Scheme + "://" + u.Host), nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DigestJobName is the weekly scheduled job that sends the inactivity digest
+const DigestJobName = "inactive_user_digest"
+
+// digestResendInterval keeps a user from getting more than one digest a week, even when the
+// job is run again by hand
+const digestResendInterval = 6 * 24 * time.Hour
+
+// RunInactiveUserDigest is the scheduled digest job; it does nothing unless DIGEST_ENABLED is set
+func RunInactiveUserDigest(ctx context.Context) error {
+	if !config.AppConfig().Digest.Enabled {
+		return nil
+	}
+	run, err := SendInactiveUserDigest(ctx, time.Now())
+	slog.InfoContext(ctx, "[DIGEST] Digests sent", "sent", run.Sent, "eligible", run.Eligible, "opted_out", run.OptedOut, "failed", run.Failed)
+	return err
+}
+
+// SendInactiveUserDigest texts the digest to approved users who have not opened a gate within
+// DIGEST_INACTIVE_AFTER of now and did not get one in the last week, skipping those who opted
+// out. The run and its stats are recorded for admins.
+func SendInactiveUserDigest(ctx context.Context, now time.Time) (models.DigestRun, error) {
+	cfg := config.AppConfig().Digest
+	run := models.DigestRun{InactiveSince: now.Add(-cfg.InactiveAfter), StartedAt: now}
+	if err := db.DB.Create(&run).Error; err != nil {
+		return run, err
+	}
+
+	var users []models.User
+	err := db.DB.
+		Where("registration_status = ? AND trashed_at IS NULL AND created_at < ?", models.RegistrationApproved, run.InactiveSince).
+		Where("digest_sent_at IS NULL OR digest_sent_at < ?", now.Add(-digestResendInterval)).
+		Where("NOT EXISTS (SELECT 1 FROM gate_commands WHERE gate_commands.user_id = users.id AND gate_commands.action = ? AND gate_commands.created_at >= ?)",
+			GateActionOpen, run.InactiveSince).
+		FindInBatches(&users, 500, func(tx *gorm.DB, batch int) error {
+			for _, user := range users {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				run.Eligible++
+				if user.DigestOptOut {
+					run.OptedOut++
+					continue
+				}
+				if err := SendSMS(ctx, user.Phone, cfg.Message); err != nil {
+					run.Failed++
+					continue
+				}
+				run.Sent++
+				if err := db.DB.Model(&models.User{}).Where("id = ?", user.ID).Update("digest_sent_at", now).Error; err != nil {
+					slog.ErrorContext(ctx, "[DIGEST] Failed to record the digest sent to user", "user_id", user.ID, "error", err)
+				}
+			}
+			return nil
+		}).Error
+
+	finished := time.Now()
+	run.FinishedAt = &finished
+	if err != nil {
+		run.Error = err.Error()
+	}
+	if saveErr := db.DB.Save(&run).Error; saveErr != nil {
+		slog.ErrorContext(ctx, "[DIGEST] Failed to record digest run", "run_id", run.ID, "error", saveErr)
+	}
+	return run, err
+}
+
+// DigestRuns returns the most recent digest runs, newest first
+func DigestRuns(limit int) ([]models.DigestRun, error) {
+	var runs []models.DigestRun
+	err := db.DB.Order("started_at DESC").Limit(limit).Find(&runs).Error
+	return runs, err
+}
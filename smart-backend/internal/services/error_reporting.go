@@ -0,0 +1,35 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/config"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// InitErrorReporting connects to Sentry when SENTRY_DSN is set. Without a DSN reporting is a no-op.
+func InitErrorReporting() error {
+	cfg := config.AppConfig().Sentry
+	if cfg.DSN == "" {
+		slog.Info("Error reporting disabled (SENTRY_DSN not set)")
+		return nil
+	}
+
+	if err := sentry.Init(sentry.ClientOptions{
+		Dsn:              cfg.DSN,
+		Environment:      cfg.Environment,
+		Release:          cfg.Release,
+		AttachStacktrace: true,
+	}); err != nil {
+		return err
+	}
+
+	slog.Info("Error reporting enabled", "environment", cfg.Environment, "release", cfg.Release)
+	return nil
+}
+
+// FlushErrorReports waits up to timeout for queued reports to be sent
+func FlushErrorReports(timeout time.Duration) {
+	sentry.Flush(timeout)
+}
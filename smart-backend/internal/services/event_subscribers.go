@@ -0,0 +1,32 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+)
+
+// RegisterEventSubscribers wires the side-effect subsystems to the domain event bus
+func RegisterEventSubscribers() {
+	// Analytics: count every domain event by type
+	events.Subscribe(events.AllEvents, func(e events.Event) {
+		metrics.IncCounter("domain_events_total", metrics.Labels{"type": e.Type})
+	})
+
+	events.Subscribe(events.AdminLogin, func(e events.Event) {
+		slog.Info("[EVENTS] Admin logged in", "username", e.Data["username"], "ip", e.Data["ip"], "method", e.Data["method"])
+	})
+
+	// Usage metering: gate operations are billed per organization, simulated ones are not
+	meterGateOperation := func(e events.Event) {
+		if sandbox, _ := e.Data["sandbox"].(bool); sandbox {
+			return
+		}
+		Meter().Add(models.UsageGateOperations, 1)
+	}
+	events.Subscribe(events.GateOpened, meterGateOperation)
+	events.Subscribe(events.GateClosed, meterGateOperation)
+
+	registerNotificationSubscribers()
+}
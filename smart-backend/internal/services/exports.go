@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/scheduler"
+	"ololo-gate/internal/storage"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ExportJobName is the scheduled job that generates pending exports
+const ExportJobName = "exports"
+
+// ExportJobTimeout bounds one run of the exports job; exports still running after it are
+// considered interrupted
+const ExportJobTimeout = time.Hour
+
+// ExportRequest describes the file to generate. Gate event exports use the filters, report
+// exports use Report and Format.
+type ExportRequest struct {
+	Type       string      `json:"type"`
+	Range      ReportRange `json:"range"`
+	LocationID int         `json:"location_id,omitempty"`
+	GateID     int         `json:"gate_id,omitempty"`
+	UserID     uuid.UUID   `json:"user_id,omitempty"`
+	Report     string      `json:"report,omitempty"`
+	Format     string      `json:"format,omitempty"` // "csv" or "json"
+}
+
+// CreateExport queues an export and starts the exports job on this instance. If the job is
+// busy here or on another instance, the export is picked up by the running job or by the next
+// scheduled run, at most a minute later.
+func CreateExport(req ExportRequest, adminUsername string) (models.Export, error) {
+	params, err := json.Marshal(req)
+	if err != nil {
+		return models.Export{}, err
+	}
+	export := models.Export{
+		Type:        req.Type,
+		Params:      string(params),
+		Status:      models.ExportPending,
+		RequestedBy: adminUsername,
+	}
+	if err := db.DB.Create(&export).Error; err != nil {
+		return models.Export{}, err
+	}
+
+	go func() {
+		if _, err := scheduler.Default().RunOnce(ExportJobName); err != nil {
+			slog.Warn("[EXPORTS] Could not start the exports job right away", "error", err)
+		}
+	}()
+	return export, nil
+}
+
+// RunPendingExports generates queued exports, oldest first, until none are left. Exports
+// left running by an instance that stopped are marked failed first.
+func RunPendingExports(ctx context.Context) error {
+	stale := time.Now().Add(-ExportJobTimeout)
+	if err := db.DB.Model(&models.Export{}).Where("status = ? AND started_at < ?", models.ExportRunning, stale).
+		Updates(map[string]interface{}{"status": models.ExportFailed, "error": "export was interrupted", "finished_at": time.Now()}).Error; err != nil {
+		return err
+	}
+
+	for ctx.Err() == nil {
+		var export models.Export
+		err := db.DB.Where("status = ?", models.ExportPending).Order("created_at").First(&export).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Claim the export, in case another run took it meanwhile
+		now := time.Now()
+		claim := db.DB.Model(&models.Export{}).Where("id = ? AND status = ?", export.ID, models.ExportPending).
+			Updates(map[string]interface{}{"status": models.ExportRunning, "started_at": now})
+		if claim.Error != nil {
+			return claim.Error
+		}
+		if claim.RowsAffected == 0 {
+			continue
+		}
+		export.StartedAt = &now
+		runExport(&export)
+	}
+	return ctx.Err()
+}
+
+// runExport generates one export and records the outcome. A failed export does not fail
+// the job; its error is shown on the export.
+func runExport(export *models.Export) {
+	updates := map[string]interface{}{"status": models.ExportReady, "error": ""}
+	if err := generateExport(export); err != nil {
+		slog.Error("[EXPORTS] Export failed", "export_id", export.ID, "type", export.Type, "error", err)
+		updates = map[string]interface{}{"status": models.ExportFailed, "error": err.Error()}
+	} else {
+		updates["file_key"] = export.FileKey
+		updates["filename"] = export.Filename
+		updates["content_type"] = export.ContentType
+		updates["size_bytes"] = export.SizeBytes
+		slog.Info("[EXPORTS] Export ready", "export_id", export.ID, "type", export.Type, "filename", export.Filename, "size_bytes", export.SizeBytes)
+	}
+	updates["finished_at"] = time.Now()
+	if err := db.DB.Model(&models.Export{}).Where("id = ?", export.ID).Updates(updates).Error; err != nil {
+		slog.Error("[EXPORTS] Failed to record the outcome of export", "export_id", export.ID, "error", err)
+	}
+}
+
+// generateExport writes the export to a temporary file and moves it to file storage
+func generateExport(export *models.Export) error {
+	var req ExportRequest
+	if err := json.Unmarshal([]byte(export.Params), &req); err != nil {
+		return fmt.Errorf("invalid export parameters: %w", err)
+	}
+	if err := config.AppConfig().Residency.CheckStorage(config.AppConfig().Storage); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "export-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	switch req.Type {
+	case models.ExportGateEvents:
+		filter := GateEventFilter{Range: req.Range, LocationID: req.LocationID, GateID: req.GateID, UserID: req.UserID}
+		gateEvents, err := NewGateEventExport(filter, NewThirdPartyClient())
+		if err != nil {
+			return fmt.Errorf("load locations: %w", err)
+		}
+		if err := gateEvents.WriteCSV(tmp); err != nil {
+			return err
+		}
+		export.Filename, export.ContentType = gateEvents.Filename(), "text/csv"
+	case models.ExportReport:
+		// Include this instance's buffered usage in the numbers
+		Meter().Flush()
+		report, err := RunReport(req.Report, req.Range, NewThirdPartyClient())
+		if err != nil {
+			return err
+		}
+		export.Filename = fmt.Sprintf("%s_%s_%s.%s", report.Name, report.From, report.To, req.Format)
+		if req.Format == "json" {
+			export.ContentType = "application/json"
+			err = report.WriteJSON(tmp)
+		} else {
+			export.ContentType = "text/csv"
+			err = report.WriteCSV(tmp)
+		}
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown export type %q", req.Type)
+	}
+
+	info, err := tmp.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, 0); err != nil {
+		return err
+	}
+	files, err := storage.Default()
+	if err != nil {
+		return err
+	}
+	key := "exports/" + export.ID.String() + storage.ExtensionFor(export.ContentType)
+	if err := files.Put(key, export.ContentType, tmp); err != nil {
+		return fmt.Errorf("store export: %w", err)
+	}
+	export.FileKey, export.SizeBytes = key, info.Size()
+	return nil
+}
+
+// ExportExpiresAt is when a finished export is purged (nil while it is not finished)
+func ExportExpiresAt(export models.Export) *time.Time {
+	if export.FinishedAt == nil {
+		return nil
+	}
+	expiresAt := export.FinishedAt.Add(config.AppConfig().Exports.Retention)
+	return &expiresAt
+}
+
+// PurgeExports deletes exports that finished before cutoff, with their files
+func PurgeExports(cutoff time.Time) (int, error) {
+	var expired []models.Export
+	if err := db.DB.Where("finished_at < ?", cutoff).Find(&expired).Error; err != nil {
+		return 0, err
+	}
+	files, err := storage.Default()
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, export := range expired {
+		if export.FileKey != "" {
+			if err := files.Delete(export.FileKey); err != nil {
+				slog.Error("[EXPORTS] Failed to delete the file of export", "export_id", export.ID, "error", err)
+				continue
+			}
+		}
+		if err := db.DB.Delete(&models.Export{}, "id = ?", export.ID).Error; err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
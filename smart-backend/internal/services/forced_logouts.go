@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/scheduler"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ForcedLogoutJobName is the scheduled job that signs users out for pending forced logouts
+const ForcedLogoutJobName = "forced_logout"
+
+// ForcedLogoutJobTimeout bounds one run of the forced logout job; an unfinished forced logout
+// is resumed by the next run
+const ForcedLogoutJobTimeout = 30 * time.Minute
+
+// ForcedLogoutBatchSize is how many users are signed out per transaction
+const ForcedLogoutBatchSize = 500
+
+// ErrForcedLogoutInProgress is returned when a forced logout is requested while another one
+// has not finished
+var ErrForcedLogoutInProgress = errors.New("a forced logout is already in progress")
+
+// CreateForcedLogout queues a forced logout of every user and starts the job on this instance.
+// If the job is busy here or on another instance, the next scheduled run picks it up, at most
+// a minute later.
+func CreateForcedLogout(reason, adminUsername string) (models.ForcedLogout, error) {
+	var active int64
+	if err := db.DB.Model(&models.ForcedLogout{}).
+		Where("status IN ?", []string{models.ForcedLogoutPending, models.ForcedLogoutRunning}).
+		Count(&active).Error; err != nil {
+		return models.ForcedLogout{}, err
+	}
+	if active > 0 {
+		return models.ForcedLogout{}, ErrForcedLogoutInProgress
+	}
+
+	forcedLogout := models.ForcedLogout{
+		Reason:      reason,
+		Status:      models.ForcedLogoutPending,
+		RequestedBy: adminUsername,
+	}
+	if err := db.DB.Create(&forcedLogout).Error; err != nil {
+		return models.ForcedLogout{}, err
+	}
+
+	go func() {
+		if _, err := scheduler.Default().RunOnce(ForcedLogoutJobName); err != nil {
+			slog.Warn("[FORCED_LOGOUT] Could not start the forced logout job right away", "error", err)
+		}
+	}()
+	return forcedLogout, nil
+}
+
+// RunPendingForcedLogouts works through unfinished forced logouts, oldest first. A forced logout
+// left running by an interrupted run continues after the last user it signed out.
+func RunPendingForcedLogouts(ctx context.Context) error {
+	for ctx.Err() == nil {
+		var forcedLogout models.ForcedLogout
+		err := db.DB.Where("status IN ?", []string{models.ForcedLogoutPending, models.ForcedLogoutRunning}).
+			Order("created_at").First(&forcedLogout).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if forcedLogout.Status == models.ForcedLogoutPending {
+			if err := startForcedLogout(&forcedLogout); err != nil {
+				return err
+			}
+		}
+		if err := runForcedLogout(ctx, &forcedLogout); err != nil {
+			slog.ErrorContext(ctx, "[FORCED_LOGOUT] Forced logout failed", "forced_logout_id", forcedLogout.ID, "error", err)
+			now := time.Now()
+			db.DB.Model(&models.ForcedLogout{}).Where("id = ?", forcedLogout.ID).
+				Updates(map[string]interface{}{"status": models.ForcedLogoutFailed, "error": err.Error(), "finished_at": now})
+			NotifyAdmins(models.SeverityCritical, models.NotificationSecurity, "Forced logout failed",
+				fmt.Sprintf("Forced logout requested by %s stopped after %d of %d user(s): %v", forcedLogout.RequestedBy, forcedLogout.Processed, forcedLogout.Total, err))
+		}
+	}
+	return ctx.Err()
+}
+
+// startForcedLogout marks the forced logout running and counts the users it will sign out
+func startForcedLogout(forcedLogout *models.ForcedLogout) error {
+	now := time.Now()
+	var total int64
+	if err := db.DB.Model(&models.User{}).Where("created_at <= ?", now).Count(&total).Error; err != nil {
+		return err
+	}
+	if err := db.DB.Model(&models.ForcedLogout{}).Where("id = ?", forcedLogout.ID).
+		Updates(map[string]interface{}{"status": models.ForcedLogoutRunning, "started_at": now, "total": total}).Error; err != nil {
+		return err
+	}
+	forcedLogout.Status, forcedLogout.StartedAt, forcedLogout.Total = models.ForcedLogoutRunning, &now, total
+	slog.Info("[FORCED_LOGOUT] Signing out users", "count", total, "requested_by", forcedLogout.RequestedBy, "reason", forcedLogout.Reason)
+	return nil
+}
+
+// runForcedLogout signs users out batch by batch until none are left or ctx is done. Users who
+// signed up after the forced logout started are left alone.
+func runForcedLogout(ctx context.Context, forcedLogout *models.ForcedLogout) error {
+	for {
+		if ctx.Err() != nil {
+			return nil // Resumed by the next run
+		}
+
+		var ids []uuid.UUID
+		if err := db.DB.Model(&models.User{}).
+			Where("id > ? AND created_at <= ?", forcedLogout.LastUserID, forcedLogout.StartedAt).
+			Order("id").Limit(ForcedLogoutBatchSize).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		// Bumping the versions and moving the cursor together means no user is skipped or
+		// signed out twice when a run is interrupted
+		lastID := ids[len(ids)-1].String()
+		err := db.DB.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Model(&models.User{}).Where("id IN ?", ids).
+				UpdateColumn("token_version", gorm.Expr("token_version + 1")).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&models.UserSession{}).Where("user_id IN ? AND revoked_at IS NULL", ids).
+				Update("revoked_at", time.Now()).Error; err != nil {
+				return err
+			}
+			return tx.Model(&models.ForcedLogout{}).Where("id = ?", forcedLogout.ID).
+				Updates(map[string]interface{}{"processed": gorm.Expr("processed + ?", len(ids)), "last_user_id": lastID}).Error
+		})
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			TokenVersions().InvalidateUser(id)
+		}
+		forcedLogout.Processed += int64(len(ids))
+		forcedLogout.LastUserID = lastID
+	}
+
+	now := time.Now()
+	if err := db.DB.Model(&models.ForcedLogout{}).Where("id = ?", forcedLogout.ID).
+		Updates(map[string]interface{}{"status": models.ForcedLogoutCompleted, "finished_at": now}).Error; err != nil {
+		return err
+	}
+	forcedLogout.Status, forcedLogout.FinishedAt = models.ForcedLogoutCompleted, &now
+	slog.InfoContext(ctx, "[FORCED_LOGOUT] Forced logout completed", "forced_logout_id", forcedLogout.ID, "signed_out", forcedLogout.Processed)
+	NotifyAdmins(models.SeverityWarning, models.NotificationSecurity, "Forced logout completed",
+		fmt.Sprintf("%d user(s) were signed out at the request of %s: %s", forcedLogout.Processed, forcedLogout.RequestedBy, forcedLogout.Reason))
+	return nil
+}
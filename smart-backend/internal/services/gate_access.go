@@ -0,0 +1,54 @@
+package services
+
+import "time"
+
+// gateAccessTTL bounds how long a user's cached gate list is trusted to allow a gate command
+// without asking the provider again, so revoked assignments stop working soon
+const gateAccessTTL = time.Minute
+
+// UserCanAccessGate reports whether gateID is among the gates assigned to phone. A list loaded by
+// UserLocations within gateAccessTTL that has the gate is enough; otherwise the list is reloaded
+// from the provider, so gates assigned since are found. While the provider is unavailable the last
+// known list decides, whatever its age; without one the provider's error is returned.
+func UserCanAccessGate(client *ThirdPartyClient, phone string, gateID int) (bool, error) {
+	userLocationsMu.Lock()
+	cached, ok := userLocations[phone]
+	userLocationsMu.Unlock()
+	if ok && time.Since(cached.loadedAt) < gateAccessTTL && hasGate(cached.locations, gateID) {
+		return true, nil
+	}
+
+	locations, _, _, err := UserLocations(client, phone)
+	if err != nil {
+		return false, err
+	}
+	return hasGate(locations, gateID), nil
+}
+
+// hasGate reports whether any of locations has gateID
+func hasGate(locations []LocationResponse, gateID int) bool {
+	for _, location := range locations {
+		for _, gate := range location.Gates {
+			if gate.ID == gateID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CachedGateLocation returns the location of gateID in phone's last loaded gate list, without
+// calling the provider, or 0 if the gate is not in it
+func CachedGateLocation(phone string, gateID int) int {
+	userLocationsMu.Lock()
+	cached := userLocations[phone]
+	userLocationsMu.Unlock()
+	for _, location := range cached.locations {
+		for _, gate := range location.Gates {
+			if gate.ID == gateID {
+				return location.ID
+			}
+		}
+	}
+	return 0
+}
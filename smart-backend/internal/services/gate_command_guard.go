@@ -0,0 +1,111 @@
+package services
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"sync"
+	"time"
+)
+
+const (
+	GateActionOpen  = "open"
+	GateActionClose = "close"
+)
+
+// GateCommandConflictError is returned when a command conflicts with another command
+// that is in flight (or was just issued) for the same gate
+type GateCommandConflictError struct {
+	GateID        int
+	Requested     string
+	PendingAction string
+}
+
+func (e *GateCommandConflictError) Error() string {
+	return fmt.Sprintf("gate %d is busy with a %s command, %s rejected", e.GateID, e.PendingAction, e.Requested)
+}
+
+// gateCommand tracks a single command issued for a gate
+type gateCommand struct {
+	action     string
+	done       chan struct{}
+	result     bool
+	err        error
+	finishedAt time.Time
+}
+
+// GateCommandGuard serializes commands per gate ID.
+// Identical commands issued while one is in flight (or inside the hold window) share its result,
+// conflicting commands (open vs close) are rejected.
+type GateCommandGuard struct {
+	mu         sync.Mutex
+	commands   map[int]*gateCommand
+	holdWindow time.Duration
+}
+
+var (
+	gateCommandGuard     *GateCommandGuard
+	gateCommandGuardOnce sync.Once
+)
+
+// NewGateCommandGuard creates a guard with the given hold window
+func NewGateCommandGuard(holdWindow time.Duration) *GateCommandGuard {
+	return &GateCommandGuard{
+		commands:   make(map[int]*gateCommand),
+		holdWindow: holdWindow,
+	}
+}
+
+// GateCommands returns the process-wide gate command guard
+func GateCommands() *GateCommandGuard {
+	gateCommandGuardOnce.Do(func() {
+		gateCommandGuard = NewGateCommandGuard(config.AppConfig().Gates.CommandHoldWindow)
+	})
+	return gateCommandGuard
+}
+
+// Execute runs fn for the gate unless a conflicting command is pending.
+// If the same action is already pending, the caller waits for and receives its result.
+func (g *GateCommandGuard) Execute(gateID int, action string, fn func() (bool, error)) (bool, error) {
+	g.mu.Lock()
+	if current, ok := g.commands[gateID]; ok && g.isActive(current) {
+		if current.action != action {
+			g.mu.Unlock()
+			slog.Warn("[GATE_GUARD] Rejected gate command, another command is pending",
+				"action", action, "gate_id", gateID, "pending", current.action)
+			return false, &GateCommandConflictError{GateID: gateID, Requested: action, PendingAction: current.action}
+		}
+		g.mu.Unlock()
+		slog.Info("[GATE_GUARD] Coalescing gate command with pending command", "action", action, "gate_id", gateID)
+		<-current.done
+		return current.result, current.err
+	}
+
+	cmd := &gateCommand{action: action, done: make(chan struct{})}
+	g.commands[gateID] = cmd
+	g.mu.Unlock()
+
+	result, err := fn()
+
+	g.mu.Lock()
+	cmd.result = result
+	cmd.err = err
+	cmd.finishedAt = time.Now()
+	if err != nil {
+		// Failed commands must not block retries
+		delete(g.commands, gateID)
+	}
+	g.mu.Unlock()
+	close(cmd.done)
+
+	return result, err
+}
+
+// isActive reports whether a command is still in flight or inside the hold window.
+// Must be called with g.mu held.
+func (g *GateCommandGuard) isActive(cmd *gateCommand) bool {
+	if cmd.finishedAt.IsZero() {
+		return true
+	}
+	return time.Since(cmd.finishedAt) < g.holdWindow
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGateCommandGuard_RejectsConflictingCommand(t *testing.T) {
+	guard := NewGateCommandGuard(time.Second)
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go guard.Execute(1, GateActionOpen, func() (bool, error) {
+		close(started)
+		<-release
+		return true, nil
+	})
+	<-started
+
+	_, err := guard.Execute(1, GateActionClose, func() (bool, error) {
+		t.Fatal("conflicting command must not reach the provider")
+		return false, nil
+	})
+	close(release)
+
+	var conflictErr *GateCommandConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+	assert.Equal(t, GateActionOpen, conflictErr.PendingAction)
+}
+
+func TestGateCommandGuard_CoalescesIdenticalCommands(t *testing.T) {
+	guard := NewGateCommandGuard(time.Second)
+	var calls int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	results := make([]bool, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = guard.Execute(7, GateActionOpen, func() (bool, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return true, nil
+			})
+		}(i)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, r := range results {
+		assert.True(t, r)
+	}
+}
+
+func TestGateCommandGuard_HoldWindowExpires(t *testing.T) {
+	guard := NewGateCommandGuard(20 * time.Millisecond)
+
+	_, err := guard.Execute(3, GateActionOpen, func() (bool, error) { return true, nil })
+	assert.NoError(t, err)
+
+	_, err = guard.Execute(3, GateActionClose, func() (bool, error) { return true, nil })
+	assert.Error(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+	_, err = guard.Execute(3, GateActionClose, func() (bool, error) { return true, nil })
+	assert.NoError(t, err)
+}
+
+func TestGateCommandGuard_FailedCommandDoesNotBlock(t *testing.T) {
+	guard := NewGateCommandGuard(time.Minute)
+
+	_, err := guard.Execute(4, GateActionOpen, func() (bool, error) { return false, errors.New("provider down") })
+	assert.Error(t, err)
+
+	ok, err := guard.Execute(4, GateActionClose, func() (bool, error) { return true, nil })
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
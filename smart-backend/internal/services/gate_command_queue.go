@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QueueGateCommand parks a command while the provider is unavailable so it is sent once the
+// circuit breaker closes. Returns false when queueing is disabled and the command was failed instead.
+func QueueGateCommand(ctx context.Context, cmd *models.GateCommand) bool {
+	if config.AppConfig().Gates.QueueTTL <= 0 {
+		UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandFailed, "Gate provider unavailable")
+		return false
+	}
+	UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandQueued, "Gate provider unavailable")
+	return true
+}
+
+// DrainQueuedGateCommands fails queued commands older than the queue TTL and, while the provider
+// circuit breaker is closed, sends the rest in the order they were issued, skipping users' commands
+// for gates under maintenance. Returns the number sent.
+func DrainQueuedGateCommands(ctx context.Context, client *ThirdPartyClient) (int, error) {
+	var expired []models.GateCommand
+	cutoff := time.Now().Add(-config.AppConfig().Gates.QueueTTL)
+	if err := db.DB.Select("id").Where("status = ? AND created_at < ?", models.GateCommandQueued, cutoff).
+		Find(&expired).Error; err != nil {
+		return 0, err
+	}
+	for _, cmd := range expired {
+		UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandFailed, "Gate provider did not recover before the queued command expired")
+	}
+
+	if ProviderBreaker().IsOpen() {
+		return 0, nil
+	}
+
+	var queued []models.GateCommand
+	if err := db.DB.Where("status = ?", models.GateCommandQueued).Order("created_at").Find(&queued).Error; err != nil {
+		return 0, err
+	}
+
+	gateIDs := make([]int, len(queued))
+	for i, cmd := range queued {
+		gateIDs[i] = cmd.GateID
+	}
+	maintenance := GatesUnderMaintenance(gateIDs)
+
+	sent := 0
+	for i := range queued {
+		cmd := &queued[i]
+
+		// Gates put under maintenance while the provider was down drop their users' commands;
+		// admin overrides (no user) still go through
+		if m, ok := maintenance[cmd.GateID]; ok && cmd.UserID != uuid.Nil {
+			UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandFailed, "Gate is under maintenance: "+m.Note)
+			continue
+		}
+
+		// Claim the command so another instance draining the queue does not send it twice
+		claimed := db.DB.Model(&models.GateCommand{}).
+			Where("id = ? AND status = ?", cmd.ID, models.GateCommandQueued).
+			Updates(map[string]interface{}{"status": models.GateCommandExecuting, "error_message": ""})
+		if claimed.Error != nil {
+			return sent, claimed.Error
+		}
+		if claimed.RowsAffected == 0 {
+			continue
+		}
+		recordGateEventByID(cmd.ID, models.GateCommandExecuting)
+
+		success, err := GateCommands().Execute(cmd.GateID, cmd.Action, func() (bool, error) {
+			if cmd.Action == GateActionOpen {
+				return client.OpenGate(cmd.Phone, cmd.GateID, cmd.ID.String())
+			}
+			return client.CloseGate(cmd.Phone, cmd.GateID)
+		})
+		var conflictErr *GateCommandConflictError
+		if errors.As(err, &conflictErr) {
+			UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandFailed, conflictErr.Error())
+			continue
+		}
+		var upstreamErr *UpstreamError
+		if errors.As(err, &upstreamErr) && upstreamErr.Kind == UpstreamUnavailable {
+			// The provider went down again - keep this and the remaining commands queued
+			UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandQueued, "Gate provider unavailable")
+			break
+		}
+		if err != nil {
+			UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandFailed, err.Error())
+			continue
+		}
+
+		slog.InfoContext(ctx, "[GATE_QUEUE] Sent queued command", "action", cmd.Action, "command_id", cmd.ID, "gate_id", cmd.GateID)
+		TrackGateCommand(ctx, cmd, success)
+		sent++
+
+		eventType := events.GateOpened
+		if cmd.Action == GateActionClose {
+			eventType = events.GateClosed
+		}
+		events.Publish(eventType, map[string]interface{}{
+			"user_id":    cmd.UserID,
+			"phone":      cmd.Phone,
+			"gate_id":    cmd.GateID,
+			"command_id": cmd.ID,
+			"accepted":   success,
+			"queued":     true,
+			"sandbox":    cmd.Sandbox,
+		})
+	}
+	return sent, nil
+}
+
+// StartGateCommandQueue drains queued gate commands every interval until the process exits
+func StartGateCommandQueue(interval time.Duration) {
+	if interval <= 0 || config.AppConfig().Gates.QueueTTL <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		client := NewThirdPartyClient()
+		for range ticker.C {
+			if _, err := DrainQueuedGateCommands(context.Background(), client); err != nil {
+				slog.Error("[GATE_QUEUE] Failed to drain queued commands", "error", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainQueuedGateCommands_WaitsForProviderAndExpires(t *testing.T) {
+	setupGateEventTestDB(t)
+	config.SetAppConfig(&config.Config{
+		ThirdPartyAPIURL: "http://127.0.0.1:1",
+		Gates:            config.GatesConfig{QueueTTL: 2 * time.Minute},
+	})
+
+	// Hold the provider breaker open for the test
+	previous := ProviderBreaker()
+	providerBreaker = NewCircuitBreaker(1, time.Hour)
+	providerBreaker.Failure()
+	defer func() { providerBreaker = previous }()
+
+	fresh, err := CreateGateCommand(context.Background(), uuid.New(), "+77771234567", 7, GateActionOpen)
+	assert.NoError(t, err)
+	assert.True(t, QueueGateCommand(context.Background(), fresh))
+
+	stale, err := CreateGateCommand(context.Background(), uuid.New(), "+77771234567", 8, GateActionOpen)
+	assert.NoError(t, err)
+	assert.True(t, QueueGateCommand(context.Background(), stale))
+	db.DB.Model(&models.GateCommand{}).Where("id = ?", stale.ID).Update("created_at", time.Now().Add(-5*time.Minute))
+
+	sent, err := DrainQueuedGateCommands(context.Background(), NewThirdPartyClient())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+
+	var freshNow, staleNow models.GateCommand
+	db.DB.First(&freshNow, "id = ?", fresh.ID)
+	db.DB.First(&staleNow, "id = ?", stale.ID)
+	assert.Equal(t, models.GateCommandQueued, freshNow.Status)
+	assert.Equal(t, models.GateCommandFailed, staleNow.Status)
+}
+
+func TestDrainQueuedGateCommands_SkipsGatesUnderMaintenance(t *testing.T) {
+	setupGateEventTestDB(t)
+	config.SetAppConfig(&config.Config{
+		ThirdPartyAPIURL: "http://127.0.0.1:1",
+		Gates:            config.GatesConfig{QueueTTL: 2 * time.Minute},
+	})
+
+	cmd, err := CreateGateCommand(context.Background(), uuid.New(), "+77771234567", 7, GateActionOpen)
+	assert.NoError(t, err)
+	assert.True(t, QueueGateCommand(context.Background(), cmd))
+	_, err = SetGateMaintenance(models.GateMaintenance{GateID: 7, Note: "Motor replacement", StartedBy: "admin"})
+	assert.NoError(t, err)
+
+	sent, err := DrainQueuedGateCommands(context.Background(), NewThirdPartyClient())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+
+	var current models.GateCommand
+	db.DB.First(&current, "id = ?", cmd.ID)
+	assert.Equal(t, models.GateCommandFailed, current.Status)
+	assert.Equal(t, "Gate is under maintenance: Motor replacement", current.ErrorMessage)
+}
+
+func TestQueueGateCommand_DisabledFailsCommand(t *testing.T) {
+	setupGateEventTestDB(t)
+	config.SetAppConfig(&config.Config{})
+
+	cmd, err := CreateGateCommand(context.Background(), uuid.New(), "+77771234567", 7, GateActionClose)
+	assert.NoError(t, err)
+	assert.False(t, QueueGateCommand(context.Background(), cmd))
+
+	var current models.GateCommand
+	db.DB.First(&current, "id = ?", cmd.ID)
+	assert.Equal(t, models.GateCommandFailed, current.Status)
+}
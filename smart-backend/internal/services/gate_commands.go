@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateGateCommand records a newly accepted gate command, marked as simulated in sandbox mode
+func CreateGateCommand(ctx context.Context, userID uuid.UUID, phone string, gateID int, action string) (*models.GateCommand, error) {
+	cmd := &models.GateCommand{
+		UserID:  userID,
+		Phone:   phone,
+		GateID:  gateID,
+		Action:  action,
+		Status:  models.GateCommandAccepted,
+		Sandbox: SandboxEnabled(),
+	}
+	if err := db.DB.Create(cmd).Error; err != nil {
+		slog.ErrorContext(ctx, "[GATE_COMMAND] Failed to record command", "action", action, "gate_id", gateID, "error", err)
+		return nil, err
+	}
+	recordGateEvent(cmd, models.GateCommandAccepted)
+	return cmd, nil
+}
+
+// UpdateGateCommandStatus moves a command to a new status.
+// Commands that already reached a terminal status are left untouched, so a late poll
+// cannot overwrite a provider callback and vice versa. Returns false if nothing was updated.
+func UpdateGateCommandStatus(ctx context.Context, id uuid.UUID, status, errorMessage string) (bool, error) {
+	updates := map[string]interface{}{
+		"status":        status,
+		"error_message": errorMessage,
+	}
+	if status == models.GateCommandConfirmed || status == models.GateCommandFailed {
+		updates["completed_at"] = time.Now()
+	}
+
+	result := db.DB.Model(&models.GateCommand{}).
+		Where("id = ? AND status NOT IN ?", id, []string{models.GateCommandConfirmed, models.GateCommandFailed}).
+		Updates(updates)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "[GATE_COMMAND] Failed to update command", "command_id", id, "status", status, "error", result.Error)
+		return false, result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		slog.InfoContext(ctx, "[GATE_COMMAND] Command status changed", "command_id", id, "status", status, "error", errorMessage)
+		recordGateEventByID(id, status)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// TrackGateCommand handles the provider's response for an executing command.
+// A rejected command fails immediately; an accepted one is confirmed by polling the gate state
+// in the background unless polling is disabled, in which case the provider response is trusted.
+func TrackGateCommand(ctx context.Context, cmd *models.GateCommand, providerAccepted bool) {
+	if !providerAccepted {
+		UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandFailed, "Provider rejected the command")
+		return
+	}
+
+	if config.AppConfig().Gates.ConfirmAttempts <= 0 {
+		UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandConfirmed, "")
+		return
+	}
+
+	// Polling outlives the request, so it keeps the request's log attributes but not its deadline
+	go pollGateCommand(context.WithoutCancel(ctx), *cmd)
+}
+
+// pollGateCommand polls the provider until the gate reaches the state expected by the command
+func pollGateCommand(ctx context.Context, cmd models.GateCommand) {
+	cfg := config.AppConfig().Gates
+	client := NewThirdPartyClient()
+	expectedOpen := cmd.Action == GateActionOpen
+
+	for attempt := 1; attempt <= cfg.ConfirmAttempts; attempt++ {
+		time.Sleep(cfg.ConfirmInterval)
+
+		// Stop polling if a provider callback already finished the command
+		var current models.GateCommand
+		if err := db.DB.Select("id", "status").First(&current, "id = ?", cmd.ID).Error; err != nil {
+			slog.WarnContext(ctx, "[GATE_COMMAND] Command disappeared while polling", "command_id", cmd.ID, "error", err)
+			return
+		}
+		if current.IsTerminal() {
+			return
+		}
+
+		gate, err := client.GetGateState(cmd.Phone, cmd.GateID)
+		if err != nil {
+			slog.WarnContext(ctx, "[GATE_COMMAND] Poll failed", "attempt", attempt, "attempts", cfg.ConfirmAttempts, "command_id", cmd.ID, "error", err)
+			continue
+		}
+
+		if gate.IsOpen == expectedOpen {
+			UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandConfirmed, "")
+			return
+		}
+	}
+
+	UpdateGateCommandStatus(ctx, cmd.ID, models.GateCommandFailed,
+		fmt.Sprintf("Gate did not reach the expected state after %d status checks", cfg.ConfirmAttempts))
+}
+
+// PurgeGateCommands deletes finished commands that completed before the cutoff
+func PurgeGateCommands(cutoff time.Time) (int64, error) {
+	result := db.DB.Where("status IN ? AND completed_at < ?",
+		[]string{models.GateCommandConfirmed, models.GateCommandFailed}, cutoff).
+		Delete(&models.GateCommand{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GateEventLogFilter selects gate event log entries. Zero values do not filter.
+type GateEventLogFilter struct {
+	Range      ReportRange
+	UserID     uuid.UUID
+	GateID     int
+	LocationID int
+	Result     string
+}
+
+// RecordGateEventLog stores a user's gate command attempt and counts it in
+// gate_attempts_total{action,result}. Failures are logged; the attempt is not affected.
+func RecordGateEventLog(entry *models.GateEventLog) {
+	metrics.IncCounter("gate_attempts_total", metrics.Labels{"action": entry.Action, "result": entry.Result})
+	if err := db.DB.Create(entry).Error; err != nil {
+		slog.Error("[GATE_EVENTS] Failed to record gate attempt",
+			"action", entry.Action, "gate_id", entry.GateID, "user_id", entry.UserID, "error", err)
+	}
+}
+
+// GateEventLogs returns a page of the entries matching filter, newest first, and the number of
+// matching entries
+func GateEventLogs(filter GateEventLogFilter, page, limit int) ([]models.GateEventLog, int64, error) {
+	query := db.DB.Model(&models.GateEventLog{}).
+		Where("created_at >= ? AND created_at < ?", filter.Range.start(), filter.Range.end())
+	if filter.UserID != uuid.Nil {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.GateID != 0 {
+		query = query.Where("gate_id = ?", filter.GateID)
+	}
+	if filter.LocationID != 0 {
+		query = query.Where("location_id = ?", filter.LocationID)
+	}
+	if filter.Result != "" {
+		query = query.Where("result = ?", filter.Result)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var entries []models.GateEventLog
+	err := query.Order("created_at DESC, id").Offset((page - 1) * limit).Limit(limit).Find(&entries).Error
+	return entries, total, err
+}
+
+// PurgeGateEventLogs deletes gate event log entries recorded before the cutoff
+func PurgeGateEventLogs(cutoff time.Time) (int64, error) {
+	result := db.DB.Where("created_at < ?", cutoff).Delete(&models.GateEventLog{})
+	return result.RowsAffected, result.Error
+}
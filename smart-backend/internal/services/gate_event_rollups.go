@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// recordGateEvent appends the command reaching status to the gate event log
+func recordGateEvent(cmd *models.GateCommand, status string) {
+	now := time.Now().UTC()
+	event := models.GateEvent{
+		Hour:       now.Truncate(time.Hour),
+		GateID:     cmd.GateID,
+		CommandID:  cmd.ID,
+		UserID:     cmd.UserID,
+		Action:     cmd.Action,
+		Status:     status,
+		OccurredAt: now,
+	}
+	if err := db.DB.Create(&event).Error; err != nil {
+		slog.Error("[GATE_EVENTS] Failed to record command outcome", "status", status, "command_id", cmd.ID, "error", err)
+	}
+}
+
+// recordGateEventByID appends a status change of the command with the given ID to the gate event log
+func recordGateEventByID(id uuid.UUID, status string) {
+	var cmd models.GateCommand
+	if err := db.DB.Select("id", "user_id", "gate_id", "action").First(&cmd, "id = ?", id).Error; err != nil {
+		slog.Error("[GATE_EVENTS] Failed to load command", "command_id", id, "error", err)
+		return
+	}
+	recordGateEvent(&cmd, status)
+}
+
+// RollupGateEvents refreshes the hourly rollups from the gate event log. Only hours from the
+// latest rolled-up hour on are recomputed, so each run reads the events of the last hour or two.
+// Returns the number of rollup rows written.
+func RollupGateEvents() (int, error) {
+	var written int
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		var start time.Time
+		var latest models.GateEventRollup
+		err := tx.Order("hour DESC").Take(&latest).Error
+		switch {
+		case err == nil:
+			start = latest.Hour // Recomputed: it may have been rolled up while still in progress
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			var earliest models.GateEvent
+			if err := tx.Select("hour").Order("hour").Take(&earliest).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil // Nothing recorded yet
+				}
+				return err
+			}
+			start = earliest.Hour
+		default:
+			return err
+		}
+
+		if err := tx.Where("hour >= ?", start).Delete(&models.GateEventRollup{}).Error; err != nil {
+			return err
+		}
+		var rollups []models.GateEventRollup
+		if err := tx.Model(&models.GateEvent{}).
+			Select("hour, gate_id, action, status, COUNT(*) AS count").
+			Where("hour >= ?", start).
+			Group("hour, gate_id, action, status").
+			Scan(&rollups).Error; err != nil {
+			return err
+		}
+		if len(rollups) == 0 {
+			return nil
+		}
+		written = len(rollups)
+		return tx.CreateInBatches(rollups, 500).Error
+	})
+	return written, err
+}
+
+// PurgeGateEvents deletes raw gate events older than the cutoff. Hours that are not rolled up yet
+// are kept, so the rollups stay complete after the raw rows are gone.
+func PurgeGateEvents(cutoff time.Time) (int64, error) {
+	var latest models.GateEventRollup
+	if err := db.DB.Order("hour DESC").Take(&latest).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if latest.Hour.Before(cutoff) {
+		cutoff = latest.Hour
+	}
+	result := db.DB.Where("hour < ?", cutoff.UTC()).Delete(&models.GateEvent{})
+	return result.RowsAffected, result.Error
+}
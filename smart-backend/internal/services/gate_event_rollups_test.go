@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupGateEventTestDB(t *testing.T) {
+	setupServiceTestDB(t, &models.GateCommand{}, &models.GateEvent{}, &models.GateEventRollup{}, &models.GateMaintenance{})
+}
+
+func addGateEvent(t *testing.T, at time.Time, gateID int, status string) {
+	event := models.GateEvent{Hour: at.Truncate(time.Hour), GateID: gateID, CommandID: uuid.New(), Action: GateActionOpen, Status: status, OccurredAt: at}
+	assert.NoError(t, db.DB.Create(&event).Error)
+}
+
+func rollupCounts(t *testing.T) map[string]int64 {
+	var rollups []models.GateEventRollup
+	assert.NoError(t, db.DB.Order("hour, gate_id, status").Find(&rollups).Error)
+	counts := make(map[string]int64)
+	for _, r := range rollups {
+		counts[r.Hour.UTC().Format("15")+"/"+r.Status] += r.Count
+	}
+	return counts
+}
+
+func TestRollupGateEvents_IncrementalHourlyCounts(t *testing.T) {
+	setupGateEventTestDB(t)
+
+	base := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	addGateEvent(t, base.Add(5*time.Minute), 1, models.GateCommandConfirmed)
+	addGateEvent(t, base.Add(10*time.Minute), 2, models.GateCommandConfirmed)
+	addGateEvent(t, base.Add(70*time.Minute), 1, models.GateCommandFailed)
+
+	_, err := RollupGateEvents()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"09/confirmed": 2, "10/failed": 1}, rollupCounts(t))
+
+	// The latest hour is recomputed as more events arrive; earlier hours are not rescanned
+	addGateEvent(t, base.Add(80*time.Minute), 1, models.GateCommandConfirmed)
+	addGateEvent(t, base.Add(130*time.Minute), 1, models.GateCommandConfirmed)
+	_, err = RollupGateEvents()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"09/confirmed": 2, "10/failed": 1, "10/confirmed": 1, "11/confirmed": 1}, rollupCounts(t))
+
+	// Raw events are purged up to the last rolled-up hour; the rollups remain
+	purged, err := PurgeGateEvents(base.Add(24 * time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), purged)
+	assert.Equal(t, map[string]int64{"09/confirmed": 2, "10/failed": 1, "10/confirmed": 1, "11/confirmed": 1}, rollupCounts(t))
+}
+
+func TestGateCommandLifecycle_RecordsEvents(t *testing.T) {
+	setupGateEventTestDB(t)
+
+	cmd, err := CreateGateCommand(context.Background(), uuid.New(), "+77771234567", 7, GateActionOpen)
+	assert.NoError(t, err)
+	UpdateGateCommandStatus(context.Background(), cmd.ID, models.GateCommandExecuting, "")
+	UpdateGateCommandStatus(context.Background(), cmd.ID, models.GateCommandConfirmed, "")
+	UpdateGateCommandStatus(context.Background(), cmd.ID, models.GateCommandFailed, "late poll") // Ignored: already terminal
+
+	var statuses []string
+	assert.NoError(t, db.DB.Model(&models.GateEvent{}).Where("command_id = ?", cmd.ID).Order("id").Pluck("status", &statuses).Error)
+	assert.Equal(t, []string{models.GateCommandAccepted, models.GateCommandExecuting, models.GateCommandConfirmed}, statuses)
+}
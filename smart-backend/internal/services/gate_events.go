@@ -0,0 +1,153 @@
+package services
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// gateEventColumns is the header row of the gate event CSV export
+var gateEventColumns = []string{
+	"command_id", "created_at", "completed_at", "user_id", "phone",
+	"location_id", "location_title", "gate_id", "gate_title", "action", "status", "error_message",
+}
+
+// GateEventFilter selects gate commands for export. Zero values do not filter.
+type GateEventFilter struct {
+	Range      ReportRange
+	LocationID int
+	GateID     int
+	UserID     uuid.UUID
+}
+
+// GateEventExport writes gate command history as CSV
+type GateEventExport struct {
+	filter    GateEventFilter
+	gates     map[int]gateInfo // Gates known to the provider, for location and gate titles
+	gateIDs   []int            // Gates of the filtered location
+	catalogOK bool
+}
+
+type gateInfo struct {
+	title         string
+	locationID    int
+	locationTitle string
+}
+
+// NewGateEventExport prepares an export. Filtering by location needs the provider's location list,
+// so it fails if the list cannot be loaded; otherwise locations are left blank.
+func NewGateEventExport(filter GateEventFilter, client *ThirdPartyClient) (*GateEventExport, error) {
+	export := &GateEventExport{filter: filter, gates: make(map[int]gateInfo)}
+
+	locations, err := Locations().All(client)
+	if err != nil && locations == nil {
+		if filter.LocationID != 0 {
+			return nil, err
+		}
+	} else {
+		export.catalogOK = true
+	}
+	for _, location := range locations {
+		for _, gate := range location.Gates {
+			export.gates[gate.ID] = gateInfo{title: gate.Title, locationID: location.ID, locationTitle: location.Title}
+			if location.ID == filter.LocationID {
+				export.gateIDs = append(export.gateIDs, gate.ID)
+			}
+		}
+	}
+	return export, nil
+}
+
+// WriteCSV streams the matching commands, oldest first, to w
+func (e *GateEventExport) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(gateEventColumns); err != nil {
+		return err
+	}
+
+	query := db.DB.Model(&models.GateCommand{}).
+		Where("created_at >= ? AND created_at < ?", e.filter.Range.start(), e.filter.Range.end())
+	if e.filter.LocationID != 0 {
+		if len(e.gateIDs) == 0 {
+			out.Flush()
+			return out.Error()
+		}
+		query = query.Where("gate_id IN ?", e.gateIDs)
+	}
+	if e.filter.GateID != 0 {
+		query = query.Where("gate_id = ?", e.filter.GateID)
+	}
+	if e.filter.UserID != uuid.Nil {
+		query = query.Where("user_id = ?", e.filter.UserID)
+	}
+
+	rows, err := query.Order("created_at, id").Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	written := 0
+	for rows.Next() {
+		var cmd models.GateCommand
+		if err := db.DB.ScanRows(rows, &cmd); err != nil {
+			return err
+		}
+		if err := out.Write(e.record(&cmd)); err != nil {
+			return err
+		}
+		// Flush periodically so large exports reach the client while they are being read
+		if written++; written%500 == 0 {
+			out.Flush()
+			if err := out.Error(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// record formats one command as a CSV row, with timestamps in the range's timezone
+func (e *GateEventExport) record(cmd *models.GateCommand) []string {
+	loc := e.filter.Range.location()
+	completedAt := ""
+	if cmd.CompletedAt != nil {
+		completedAt = cmd.CompletedAt.In(loc).Format(time.RFC3339)
+	}
+	locationID, locationTitle, gateTitle := "", "", ""
+	if gate, ok := e.gates[cmd.GateID]; ok {
+		locationID, locationTitle, gateTitle = strconv.Itoa(gate.locationID), gate.locationTitle, gate.title
+	} else if e.catalogOK {
+		locationTitle = "unknown" // Gate no longer listed by the provider
+	}
+	return []string{
+		cmd.ID.String(),
+		cmd.CreatedAt.In(loc).Format(time.RFC3339),
+		completedAt,
+		cmd.UserID.String(),
+		cmd.Phone,
+		locationID,
+		locationTitle,
+		strconv.Itoa(cmd.GateID),
+		gateTitle,
+		cmd.Action,
+		cmd.Status,
+		cmd.ErrorMessage,
+	}
+}
+
+// Filename is the suggested download name of the export
+func (e *GateEventExport) Filename() string {
+	return fmt.Sprintf("gate_events_%s_%s.csv",
+		e.filter.Range.dayOf(e.filter.Range.From), e.filter.Range.dayOf(e.filter.Range.To))
+}
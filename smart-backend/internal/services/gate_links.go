@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrGateLinkSignature is returned for links whose signature does not match
+	ErrGateLinkSignature = errors.New("invalid gate link signature")
+	// ErrGateLinkExpired is returned for links that expired or were revoked
+	ErrGateLinkExpired = errors.New("gate link expired")
+)
+
+// GateLinkTTL returns how long a new link stays valid: the requested duration, or the default
+// when none is requested, capped at the configured maximum
+func GateLinkTTL(requested time.Duration) time.Duration {
+	cfg := config.AppConfig().Links
+	ttl := requested
+	if ttl <= 0 {
+		ttl = cfg.DefaultTTL
+	}
+	if cfg.MaxTTL > 0 && ttl > cfg.MaxTTL {
+		ttl = cfg.MaxTTL
+	}
+	return ttl
+}
+
+// CreateGateLink stores a link to the gate for the user and returns it with its signature
+func CreateGateLink(userID uuid.UUID, gate GateResponse, label string, ttl time.Duration) (models.GateLink, string, error) {
+	link := models.GateLink{
+		UserID:     userID,
+		LocationID: gate.LocationID,
+		GateID:     gate.ID,
+		GateTitle:  gate.Title,
+		Label:      label,
+		ExpiresAt:  time.Now().Add(ttl).Truncate(time.Second),
+	}
+	if err := db.DB.Create(&link).Error; err != nil {
+		return models.GateLink{}, "", err
+	}
+	return link, SignGateLink(link), nil
+}
+
+// SignGateLink returns the URL-safe HMAC-SHA256 signature of the link's ID, gate and expiry
+func SignGateLink(link models.GateLink) string {
+	mac := hmac.New(sha256.New, []byte(config.AppConfig().JWT.Secret))
+	fmt.Fprintf(mac, "gate-link|%s|%d|%d|%d", link.ID, link.LocationID, link.GateID, link.ExpiresAt.Unix())
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ResolveGateLink loads a link and checks its signature, expiry and revocation. It returns
+// gorm.ErrRecordNotFound for unknown links, ErrGateLinkSignature or ErrGateLinkExpired.
+// Unknown links and wrong signatures count as failed attempts from ip (see GateLinkThrottled),
+// and a link is revoked once GATE_LINK_MAX_FAILED_ATTEMPTS client IPs sent a wrong signature.
+func ResolveGateLink(ctx context.Context, id uuid.UUID, signature, ip string) (models.GateLink, error) {
+	var link models.GateLink
+	err := db.DB.First(&link, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		GateLinkAttempts().Fail("ip:" + ip)
+	}
+	if err != nil {
+		return models.GateLink{}, err
+	}
+	if !hmac.Equal([]byte(signature), []byte(SignGateLink(link))) {
+		recordGateLinkFailure(ctx, link, ip)
+		return models.GateLink{}, ErrGateLinkSignature
+	}
+	if !link.IsActive() {
+		return link, ErrGateLinkExpired
+	}
+	return link, nil
+}
+
+// GateLinkURLs returns the universal link (https, opens the app when installed and the web
+// page otherwise) and the app deep link for a signed link. The universal link is empty when
+// GATE_LINK_BASE_URL is not set.
+func GateLinkURLs(link models.GateLink, signature string) (string, string) {
+	cfg := config.AppConfig().Links
+	path := "links/" + link.ID.String() + "?sig=" + url.QueryEscape(signature)
+
+	universal := ""
+	if cfg.BaseURL != "" {
+		universal = strings.TrimRight(cfg.BaseURL, "/") + "/" + path
+	}
+	scheme := cfg.AppScheme
+	if scheme == "" {
+		scheme = "ololo-gate"
+	}
+	return universal, scheme + "://" + path
+}
+
+// GateLinkThrottled reports whether ip failed to resolve links GATE_LINK_IP_HOURLY_FAILURES
+// times in the last hour, and how long until it may try again
+func GateLinkThrottled(ip string) (time.Duration, bool) {
+	return GateLinkAttempts().Blocked("ip:"+ip, config.AppConfig().Links.IPHourlyFailures)
+}
+
+// recordGateLinkFailure counts a wrong signature against the client IP and the gate, and against
+// the link for the first one from the IP in the hour. A single client is throttled by IP instead
+// (GATE_LINK_IP_HOURLY_FAILURES), so it cannot revoke a link by sending wrong signatures for it.
+// The link is revoked once it reaches the maximum failed attempts, and admins are alerted when the
+// link is revoked or links to the gate are failing often enough to look like brute force.
+func recordGateLinkFailure(ctx context.Context, link models.GateLink, ip string) {
+	cfg := config.AppConfig().Links
+	metrics.IncCounter("gate_link_failed_attempts_total", nil)
+	GateLinkAttempts().Fail("ip:" + ip)
+
+	if GateLinkAttempts().Fail(fmt.Sprintf("link:%s|ip:%s", link.ID, ip)) == 1 {
+		recordGateLinkIPFailure(ctx, link, ip)
+	}
+
+	// Alert once when failures for the gate reach the threshold within the hour
+	gateFailures := GateLinkAttempts().Fail(fmt.Sprintf("gate:%d", link.GateID))
+	if cfg.AlertFailures > 0 && gateFailures == cfg.AlertFailures {
+		slog.WarnContext(ctx, "[GATE_LINK] Failed link resolutions in the last hour, possible brute force", "failures", gateFailures, "gate_id", link.GateID)
+		events.Publish(events.SecurityAlert, map[string]interface{}{
+			"reason":  "gate_link_brute_force",
+			"ip":      ip,
+			"gate_id": link.GateID,
+			"title":   fmt.Sprintf("Possible brute force on links to gate %d", link.GateID),
+			"message": fmt.Sprintf("%d resolutions of links to gate %d (location %d) failed with a wrong signature in the last hour, the last from %s",
+				gateFailures, link.GateID, link.LocationID, ip),
+		})
+	}
+}
+
+// recordGateLinkIPFailure counts a client IP that sent a wrong signature against the link, and
+// revokes the link once GATE_LINK_MAX_FAILED_ATTEMPTS IPs did
+func recordGateLinkIPFailure(ctx context.Context, link models.GateLink, ip string) {
+	cfg := config.AppConfig().Links
+	if err := db.DB.Model(&models.GateLink{}).Where("id = ?", link.ID).
+		Update("failed_attempts", gorm.Expr("failed_attempts + 1")).Error; err != nil {
+		slog.ErrorContext(ctx, "[GATE_LINK] Failed to count failed attempt on link", "link_id", link.ID, "error", err)
+	}
+	if cfg.MaxFailedAttempts <= 0 || link.FailedAttempts+1 < cfg.MaxFailedAttempts {
+		return
+	}
+
+	// Conditional update: only the request that revokes the link alerts
+	revoked := db.DB.Model(&models.GateLink{}).
+		Where("id = ? AND revoked_at IS NULL", link.ID).
+		Update("revoked_at", time.Now())
+	if revoked.Error != nil {
+		slog.ErrorContext(ctx, "[GATE_LINK] Failed to revoke link", "link_id", link.ID, "error", revoked.Error)
+	} else if revoked.RowsAffected > 0 {
+		slog.WarnContext(ctx, "[GATE_LINK] Revoked link after wrong signatures",
+			"link_id", link.ID, "gate_id", link.GateID, "failed_attempts", link.FailedAttempts+1)
+		metrics.IncCounter("gate_link_revoked_total", nil)
+		events.Publish(events.SecurityAlert, map[string]interface{}{
+			"reason":  "gate_link_revoked",
+			"ip":      ip,
+			"gate_id": link.GateID,
+			"title":   "Shared gate link invalidated",
+			"message": fmt.Sprintf("Link %s to gate %d (location %d) was revoked after wrong signatures from %d client IPs, the last from %s",
+				link.ID, link.GateID, link.LocationID, link.FailedAttempts+1, ip),
+		})
+	}
+}
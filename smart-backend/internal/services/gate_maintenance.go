@@ -0,0 +1,64 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+
+	"gorm.io/gorm/clause"
+)
+
+// SetGateMaintenance puts a gate under maintenance, or replaces the note of one already under it
+func SetGateMaintenance(maintenance models.GateMaintenance) (models.GateMaintenance, error) {
+	err := db.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "gate_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"note", "started_by", "updated_at"}),
+	}).Create(&maintenance).Error
+	if err != nil {
+		return models.GateMaintenance{}, err
+	}
+	// Reload for the start time of a gate that was already under maintenance
+	var saved models.GateMaintenance
+	err = db.DB.First(&saved, "gate_id = ?", maintenance.GateID).Error
+	return saved, err
+}
+
+// EndGateMaintenance takes a gate out of maintenance and reports whether it was under it
+func EndGateMaintenance(gateID int) (bool, error) {
+	result := db.DB.Delete(&models.GateMaintenance{}, "gate_id = ?", gateID)
+	return result.RowsAffected > 0, result.Error
+}
+
+// GateMaintenances returns the gates under maintenance, longest first
+func GateMaintenances() ([]models.GateMaintenance, error) {
+	var maintenances []models.GateMaintenance
+	err := db.DB.Order("created_at, gate_id").Find(&maintenances).Error
+	return maintenances, err
+}
+
+// GatesUnderMaintenance returns the maintenance of each of the gates that is under it. If
+// maintenance cannot be loaded no gate is reported, so gate lists still load.
+func GatesUnderMaintenance(gateIDs []int) map[int]models.GateMaintenance {
+	if len(gateIDs) == 0 {
+		return nil
+	}
+	var maintenances []models.GateMaintenance
+	if err := db.DB.Where("gate_id IN ?", gateIDs).Find(&maintenances).Error; err != nil {
+		slog.Error("[GATE_MAINTENANCE] Failed to load gate maintenance", "error", err)
+		return nil
+	}
+	byID := make(map[int]models.GateMaintenance, len(maintenances))
+	for _, maintenance := range maintenances {
+		byID[maintenance.GateID] = maintenance
+	}
+	return byID
+}
+
+// GateMaintenanceFor returns the maintenance of the gate, or nil when it is not under maintenance
+func GateMaintenanceFor(gateID int) (*models.GateMaintenance, error) {
+	var maintenances []models.GateMaintenance
+	if err := db.DB.Where("gate_id = ?", gateID).Limit(1).Find(&maintenances).Error; err != nil || len(maintenances) == 0 {
+		return nil, err
+	}
+	return &maintenances[0], nil
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrGateReportPhotoTooLarge is returned for photos over GATE_REPORT_MAX_PHOTO_SIZE
+	ErrGateReportPhotoTooLarge = errors.New("gate report photo too large")
+	// ErrGateReportPhotoType is returned for photos that are not JPEG, PNG or WebP images
+	ErrGateReportPhotoType = errors.New("gate report photo must be a JPEG, PNG or WebP image")
+	// ErrGateReportOpen is returned when the user already has an open report for the gate
+	ErrGateReportOpen = errors.New("gate already has an open report from this user")
+	// ErrGateReportResolved is returned when resolving a report that is already resolved
+	ErrGateReportResolved = errors.New("gate report already resolved")
+)
+
+// gateReportPhotoTypes are the accepted photo formats, as detected from their content
+var gateReportPhotoTypes = map[string]bool{"image/jpeg": true, "image/png": true, "image/webp": true}
+
+// CreateGateReport stores a user's report of a problem with a gate, notifies admins and
+// publishes it for the facility team. The photo goes to file storage under
+// gate-reports/<report id>. A user can have one open report per gate; a second one returns
+// the open report with ErrGateReportOpen.
+func CreateGateReport(userID uuid.UUID, gate GateResponse, category, description string, photo []byte) (models.GateReport, error) {
+	report := models.GateReport{
+		ID:          uuid.New(),
+		UserID:      userID,
+		LocationID:  gate.LocationID,
+		GateID:      gate.ID,
+		GateTitle:   gate.Title,
+		Category:    category,
+		Description: description,
+		Status:      models.GateReportOpen,
+	}
+	if len(photo) > 0 {
+		if max := config.AppConfig().GateReports.MaxPhotoSize; max > 0 && int64(len(photo)) > max {
+			return models.GateReport{}, ErrGateReportPhotoTooLarge
+		}
+		contentType := http.DetectContentType(photo)
+		if !gateReportPhotoTypes[contentType] {
+			return models.GateReport{}, ErrGateReportPhotoType
+		}
+		report.PhotoKey = "gate-reports/" + report.ID.String() + storage.ExtensionFor(contentType)
+		report.PhotoContentType = contentType
+	}
+
+	var open models.GateReport
+	err := db.DB.Where("user_id = ? AND gate_id = ? AND status = ?", userID, gate.ID, models.GateReportOpen).First(&open).Error
+	if err == nil {
+		return open, ErrGateReportOpen
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.GateReport{}, err
+	}
+	if report.PhotoKey != "" {
+		files, err := storage.Default()
+		if err != nil {
+			return models.GateReport{}, err
+		}
+		if err := files.Put(report.PhotoKey, report.PhotoContentType, bytes.NewReader(photo)); err != nil {
+			return models.GateReport{}, fmt.Errorf("store photo: %w", err)
+		}
+	}
+	if err := db.DB.Create(&report).Error; err != nil {
+		return models.GateReport{}, err
+	}
+
+	title, message := gateReportTitle(report), gateReportMessage(report)
+	NotifyAdmins(models.SeverityWarning, models.NotificationGateReports, title, message)
+	events.Publish(events.GateReported, map[string]interface{}{
+		"report_id":   report.ID.String(),
+		"user_id":     userID.String(),
+		"location_id": report.LocationID,
+		"gate_id":     report.GateID,
+		"gate_title":  report.GateTitle,
+		"category":    report.Category,
+		"description": report.Description,
+		"has_photo":   report.PhotoContentType != "",
+		"created_at":  report.CreatedAt.UTC(),
+		"title":       title,
+		"message":     message,
+	})
+	return report, nil
+}
+
+// ResolveGateReport closes an open report with the admin's note on what was done
+func ResolveGateReport(id uuid.UUID, adminUsername, note string) (models.GateReport, error) {
+	var report models.GateReport
+	if err := db.DB.First(&report, "id = ?", id).Error; err != nil {
+		return models.GateReport{}, err
+	}
+	if report.Status == models.GateReportResolved {
+		return report, ErrGateReportResolved
+	}
+
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":          models.GateReportResolved,
+		"resolved_at":     now,
+		"resolved_by":     adminUsername,
+		"resolution_note": note,
+	}
+	if err := db.DB.Model(&report).Updates(updates).Error; err != nil {
+		return models.GateReport{}, err
+	}
+	report.Status = models.GateReportResolved
+	report.ResolvedAt = &now
+	report.ResolvedBy = adminUsername
+	report.ResolutionNote = note
+	return report, nil
+}
+
+// NotifyFacilityTeam delivers a new gate report (a GateReported event) to the facility team's
+// webhook and email when they are configured. Photos are not sent; they are in the admin queue.
+func NotifyFacilityTeam(e events.Event) {
+	cfg := config.AppConfig().GateReports
+	if cfg.WebhookURL != "" {
+		if err := postGateReportWebhook(cfg.WebhookURL, e.Data); err != nil {
+			slog.Error("[GATE_REPORTS] Failed to post report to the facility webhook", "report_id", e.Data["report_id"], "error", err)
+		}
+	}
+	alerts := config.AppConfig().Alerts
+	if len(cfg.EmailTo) > 0 && alerts.SMTPAddr != "" {
+		email := &EmailAlertNotifier{
+			Addr: alerts.SMTPAddr, Username: alerts.SMTPUsername, Password: alerts.SMTPPassword, From: alerts.SMTPFrom, To: cfg.EmailTo,
+		}
+		if err := email.Send(fmt.Sprint(e.Data["title"]), fmt.Sprint(e.Data["message"])); err != nil {
+			slog.Error("[GATE_REPORTS] Failed to email report to the facility team", "report_id", e.Data["report_id"], "error", err)
+		}
+	}
+}
+
+// postGateReportWebhook posts the report as signed JSON; any non-2xx response is an error
+func postGateReportWebhook(url string, data map[string]interface{}) error {
+	if err := config.AppConfig().Residency.CheckWebhook(url); err != nil {
+		return err
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return postWebhook(&http.Client{Timeout: 10 * time.Second}, url, body)
+}
+
+// gateReportTitle is a one-line summary of the report
+func gateReportTitle(report models.GateReport) string {
+	return fmt.Sprintf("Gate problem reported: %s", report.GateTitle)
+}
+
+// gateReportMessage describes the report for the notification center and email
+func gateReportMessage(report models.GateReport) string {
+	message := fmt.Sprintf("%s at gate %d (location %d)", report.Category, report.GateID, report.LocationID)
+	if report.Description != "" {
+		message += ": " + report.Description
+	}
+	return message
+}
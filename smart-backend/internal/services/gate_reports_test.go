@@ -0,0 +1,31 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/events"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyFacilityTeam_PostsWebhook(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer server.Close()
+	setupWebhookSecretTestDB(t, &config.Config{GateReports: config.GateReportsConfig{WebhookURL: server.URL}})
+
+	NotifyFacilityTeam(events.Event{Type: events.GateReported, Data: map[string]interface{}{
+		"report_id": "550e8400-e29b-41d4-a716-446655440000",
+		"gate_id":   12,
+		"category":  "stuck_closed",
+		"title":     "Gate problem reported: Gate 12",
+	}})
+	assert.Equal(t, "stuck_closed", received["category"])
+	assert.Equal(t, float64(12), received["gate_id"])
+	assert.Equal(t, "Gate problem reported: Gate 12", received["title"])
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"ololo-gate/internal/db"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupServiceTestDB points db.DB at a fresh in-memory sqlite database with the given models
+// migrated. One connection keeps every query on the same in-memory database.
+func setupServiceTestDB(t *testing.T, models ...interface{}) {
+	var err error
+	db.DB, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	sqlDB, err := db.DB.DB()
+	assert.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	assert.NoError(t, db.DB.AutoMigrate(models...))
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// inviteCodeAlphabet leaves out characters that are easy to confuse when a code is read out (0/O, 1/I/L)
+const inviteCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// inviteCodeLength is the number of random characters in a generated code
+const inviteCodeLength = 10
+
+// ErrInviteCodeInvalid is returned for unknown, revoked, expired and used up invite codes
+var ErrInviteCodeInvalid = errors.New("invalid or expired invite code")
+
+// GenerateInviteCode returns a new random invite code
+func GenerateInviteCode() (string, error) {
+	max := big.NewInt(int64(len(inviteCodeAlphabet)))
+	var code strings.Builder
+	for i := 0; i < inviteCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code.WriteByte(inviteCodeAlphabet[n.Int64()])
+	}
+	return code.String(), nil
+}
+
+// NormalizeInviteCode returns the stored form of a code as typed by a user: upper-case,
+// without spaces or dashes
+func NormalizeInviteCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// RegisterWithInviteCode creates the user and counts the registration against the code in one
+// transaction, so a code cannot be used more often than allowed. It returns ErrInviteCodeInvalid
+// if the code cannot be used.
+func RegisterWithInviteCode(user *models.User, code string) (models.InviteCode, error) {
+	var invite models.InviteCode
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&invite, "code = ?", NormalizeInviteCode(code)).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInviteCodeInvalid
+			}
+			return err
+		}
+		if !invite.IsActive() {
+			return ErrInviteCodeInvalid
+		}
+
+		// Conditional increment: concurrent registrations cannot both take the last use
+		used := tx.Model(&models.InviteCode{}).
+			Where("id = ? AND revoked_at IS NULL AND (max_uses = 0 OR uses < max_uses)", invite.ID).
+			Update("uses", gorm.Expr("uses + 1"))
+		if used.Error != nil {
+			return used.Error
+		}
+		if used.RowsAffected == 0 {
+			return ErrInviteCodeInvalid
+		}
+		invite.Uses++
+
+		user.InviteCodeID = &invite.ID
+		return tx.Create(user).Error
+	})
+	return invite, err
+}
+
+// InviteAssignment returns the code's locations in the form AssignUserToLocationsAndGates takes
+func InviteAssignment(invite models.InviteCode) []LocationAssignmentDTO {
+	locations := make([]LocationAssignmentDTO, len(invite.Locations))
+	for i, location := range invite.Locations {
+		locations[i] = LocationAssignmentDTO{LocationID: location.LocationID, GateIds: location.GateIds}
+	}
+	return locations
+}
+
+// RevokeInviteCode stops the code from being used for new registrations
+func RevokeInviteCode(invite *models.InviteCode) error {
+	now := time.Now()
+	invite.RevokedAt = &now
+	return db.DB.Model(invite).Update("revoked_at", now).Error
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"errors"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// legalDocumentsTTL bounds how long an instance keeps serving its cached current versions
+// after another instance publishes a new one
+const legalDocumentsTTL = 30 * time.Second
+
+var (
+	// ErrLegalKind is returned for document kinds other than terms and privacy
+	ErrLegalKind = errors.New("unknown legal document kind")
+	// ErrLegalVersionNotCurrent is returned when accepting a version that is not the current one
+	ErrLegalVersionNotCurrent = errors.New("only the current version can be accepted")
+)
+
+// LegalKinds lists the legal document kinds users accept
+var LegalKinds = []string{models.LegalTermsOfService, models.LegalPrivacyPolicy}
+
+// ValidLegalKind reports whether kind is a legal document kind
+func ValidLegalKind(kind string) bool {
+	return kind == models.LegalTermsOfService || kind == models.LegalPrivacyPolicy
+}
+
+// legalDocumentCache caches the current version of each legal document, which every
+// authenticated user request compares the user's acceptances against
+type legalDocumentCache struct {
+	mu       sync.RWMutex
+	current  map[string]models.LegalDocument // kind -> current version
+	loadedAt time.Time
+}
+
+var legalDocuments = &legalDocumentCache{}
+
+// CurrentLegalDocuments returns the current version of each published legal document, by kind
+func CurrentLegalDocuments() (map[string]models.LegalDocument, error) {
+	legalDocuments.mu.RLock()
+	current := legalDocuments.current
+	fresh := current != nil && time.Since(legalDocuments.loadedAt) < legalDocumentsTTL
+	legalDocuments.mu.RUnlock()
+	if fresh {
+		return current, nil
+	}
+
+	current = make(map[string]models.LegalDocument)
+	for _, kind := range LegalKinds {
+		var doc models.LegalDocument
+		err := db.DB.Where("kind = ?", kind).Order("created_at DESC").First(&doc).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		current[kind] = doc
+	}
+
+	legalDocuments.mu.Lock()
+	legalDocuments.current = current
+	legalDocuments.loadedAt = time.Now()
+	legalDocuments.mu.Unlock()
+	return current, nil
+}
+
+// InvalidateLegalDocuments drops the cached current versions, e.g. after publishing
+func InvalidateLegalDocuments() {
+	legalDocuments.mu.Lock()
+	legalDocuments.current = nil
+	legalDocuments.mu.Unlock()
+}
+
+// PublishLegalDocument stores a new version, which becomes the current version of its kind
+func PublishLegalDocument(doc *models.LegalDocument) error {
+	if !ValidLegalKind(doc.Kind) {
+		return ErrLegalKind
+	}
+	if err := db.DB.Create(doc).Error; err != nil {
+		return err
+	}
+	InvalidateLegalDocuments()
+	TokenVersions().InvalidateUsers()
+	return nil
+}
+
+// LegalAcceptances returns the user's acceptance of each current legal document, by kind
+func LegalAcceptances(userID uuid.UUID) (map[string]models.LegalAcceptance, error) {
+	current, err := CurrentLegalDocuments()
+	if err != nil || len(current) == 0 {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(current))
+	for _, doc := range current {
+		ids = append(ids, doc.ID)
+	}
+
+	var acceptances []models.LegalAcceptance
+	if err := db.DB.Where("user_id = ? AND document_id IN ?", userID, ids).Find(&acceptances).Error; err != nil {
+		return nil, err
+	}
+	accepted := make(map[string]models.LegalAcceptance, len(acceptances))
+	for _, acceptance := range acceptances {
+		accepted[acceptance.Kind] = acceptance
+	}
+	return accepted, nil
+}
+
+// PendingLegalKinds returns the kinds of legal documents whose current version the user has
+// not accepted, in LegalKinds order
+func PendingLegalKinds(userID uuid.UUID) ([]string, error) {
+	current, err := CurrentLegalDocuments()
+	if err != nil || len(current) == 0 {
+		return nil, err
+	}
+	accepted, err := LegalAcceptances(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for _, kind := range LegalKinds {
+		if _, published := current[kind]; !published {
+			continue
+		}
+		if _, ok := accepted[kind]; !ok {
+			pending = append(pending, kind)
+		}
+	}
+	return pending, nil
+}
+
+// AcceptLegalDocument records that the user accepted the current version of a legal document.
+// Accepting the same version again keeps the original acceptance.
+func AcceptLegalDocument(userID uuid.UUID, kind, version, ipAddress string) (models.LegalAcceptance, error) {
+	if !ValidLegalKind(kind) {
+		return models.LegalAcceptance{}, ErrLegalKind
+	}
+	current, err := CurrentLegalDocuments()
+	if err != nil {
+		return models.LegalAcceptance{}, err
+	}
+	doc, ok := current[kind]
+	if !ok || doc.Version != version {
+		return models.LegalAcceptance{}, ErrLegalVersionNotCurrent
+	}
+
+	acceptance := models.LegalAcceptance{
+		UserID:     userID,
+		DocumentID: doc.ID,
+		Kind:       kind,
+		Version:    version,
+		IPAddress:  ipAddress,
+		AcceptedAt: time.Now(),
+	}
+	err = db.DB.Where("user_id = ? AND document_id = ?", userID, doc.ID).FirstOrCreate(&acceptance).Error
+	if err == nil {
+		TokenVersions().InvalidateUser(userID)
+	}
+	return acceptance, err
+}
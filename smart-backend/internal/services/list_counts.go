@@ -0,0 +1,76 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+// Count modes for the totals of paginated lists, selected with the count query parameter
+const (
+	CountExact    = "exact"    // COUNT(*) over the matching rows (default)
+	CountEstimate = "estimate" // The query planner's row estimate, read from table statistics
+)
+
+// countEstimateExactBelow is the estimate under which an exact count is taken anyway: it is
+// cheap at that size and the planner is least accurate on small tables
+const countEstimateExactBelow = 10000
+
+// ErrCountMode is returned for count modes other than exact and estimate
+var ErrCountMode = errors.New("count must be exact or estimate")
+
+// ParseCountMode validates the count query parameter; empty means exact
+func ParseCountMode(mode string) (string, error) {
+	switch mode {
+	case "", CountExact:
+		return CountExact, nil
+	case CountEstimate:
+		return CountEstimate, nil
+	}
+	return "", ErrCountMode
+}
+
+// CountRows returns the number of rows query matches, and whether it is an estimate. query must
+// have its model set. Estimates are only available on PostgreSQL; elsewhere, and for small
+// results, the rows are counted.
+func CountRows(query *gorm.DB, mode string) (int64, bool, error) {
+	if mode == CountEstimate {
+		if estimate, ok := estimateRows(query); ok && estimate >= countEstimateExactBelow {
+			return estimate, true, nil
+		}
+	}
+
+	var total int64
+	err := query.Count(&total).Error
+	return total, false, err
+}
+
+// estimateRows asks the PostgreSQL planner how many rows query returns. The planner works from
+// pg_class.reltuples and the column statistics kept by autovacuum, so no rows are scanned.
+func estimateRows(query *gorm.DB) (int64, bool) {
+	if query.Dialector.Name() != "postgres" {
+		return 0, false
+	}
+
+	var rows []map[string]interface{}
+	stmt := query.Session(&gorm.Session{DryRun: true}).Find(&rows).Statement
+	var plan string
+	if err := query.Session(&gorm.Session{NewDB: true}).
+		Raw("EXPLAIN (FORMAT JSON) "+stmt.SQL.String(), stmt.Vars...).Row().Scan(&plan); err != nil {
+		slog.Warn("[COUNT] Failed to estimate rows, counting instead", "error", err)
+		return 0, false
+	}
+
+	var explained []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explained); err != nil || len(explained) == 0 {
+		slog.Warn("[COUNT] Unexpected query plan, counting instead", "error", err)
+		return 0, false
+	}
+	return int64(explained[0].Plan.Rows), true
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCountMode(t *testing.T) {
+	mode, err := ParseCountMode("")
+	assert.NoError(t, err)
+	assert.Equal(t, CountExact, mode)
+
+	mode, err = ParseCountMode("estimate")
+	assert.NoError(t, err)
+	assert.Equal(t, CountEstimate, mode)
+
+	_, err = ParseCountMode("approximate")
+	assert.ErrorIs(t, err, ErrCountMode)
+}
+
+func TestCountRows_CountsWithoutPlannerEstimates(t *testing.T) {
+	setupServiceTestDB(t, &models.AdminNotification{})
+	for _, severity := range []string{models.SeverityInfo, models.SeverityInfo, models.SeverityCritical} {
+		assert.NoError(t, db.DB.Create(&models.AdminNotification{Severity: severity, Category: "jobs", Title: "t"}).Error)
+	}
+
+	query := db.DB.Model(&models.AdminNotification{}).Where("severity = ?", models.SeverityInfo)
+	total, estimated, err := CountRows(query, CountEstimate)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.False(t, estimated)
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// locationCatalogTTL bounds how long the provider's location list is served from memory.
+// Locations and gates change rarely, so searches don't need to hit the provider every time.
+const locationCatalogTTL = 5 * time.Minute
+
+// LocationCatalog caches every location and gate the provider knows about
+type LocationCatalog struct {
+	mu        sync.RWMutex
+	locations []LocationResponse
+	loadedAt  time.Time
+	ttl       time.Duration
+}
+
+var (
+	locationCatalog     *LocationCatalog
+	locationCatalogOnce sync.Once
+)
+
+// Locations returns the process-wide location catalog
+func Locations() *LocationCatalog {
+	locationCatalogOnce.Do(func() {
+		locationCatalog = &LocationCatalog{ttl: locationCatalogTTL}
+	})
+	return locationCatalog
+}
+
+// All returns every location with its gates. When the cached list is stale it is reloaded
+// from the provider; if that fails, the stale list is returned with the error, so callers
+// can keep serving it.
+func (c *LocationCatalog) All(client *ThirdPartyClient) ([]LocationResponse, error) {
+	c.mu.RLock()
+	locations := c.locations
+	fresh := locations != nil && time.Since(c.loadedAt) < c.ttl
+	c.mu.RUnlock()
+	if fresh {
+		return locations, nil
+	}
+
+	loaded, err := client.GetAllLocations()
+	if err != nil {
+		slog.Warn("[LOCATIONS] Failed to load locations, serving cached", "cached", len(locations), "error", err)
+		return locations, err
+	}
+
+	c.mu.Lock()
+	c.locations = loaded
+	c.loadedAt = time.Now()
+	c.mu.Unlock()
+	return loaded, nil
+}
+
+// Invalidate makes the next lookup reload the locations from the provider
+func (c *LocationCatalog) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.locations = nil
+	c.loadedAt = time.Time{}
+}
+
+// cachedUserLocations is a user's last successfully loaded list of locations and gates
+type cachedUserLocations struct {
+	locations []LocationResponse
+	loadedAt  time.Time
+}
+
+var (
+	userLocationsMu sync.Mutex
+	userLocations   = make(map[string]cachedUserLocations) // By phone
+)
+
+// UserLocations returns the locations and gates accessible to phone. Every successful load is kept,
+// so while the provider is unavailable the last known list is returned instead of an error,
+// with degraded set and the time it was loaded. Gate open/closed states in it may be stale.
+func UserLocations(client *ThirdPartyClient, phone string) (locations []LocationResponse, degraded bool, loadedAt time.Time, err error) {
+	locations, err = client.GetAllLocationsWithGates(phone)
+	if err == nil {
+		userLocationsMu.Lock()
+		userLocations[phone] = cachedUserLocations{locations: locations, loadedAt: time.Now()}
+		userLocationsMu.Unlock()
+		return locations, false, time.Time{}, nil
+	}
+
+	var upstreamErr *UpstreamError
+	if !errors.As(err, &upstreamErr) || upstreamErr.Kind != UpstreamUnavailable {
+		return nil, false, time.Time{}, err
+	}
+	userLocationsMu.Lock()
+	cached, ok := userLocations[phone]
+	userLocationsMu.Unlock()
+	if !ok {
+		return nil, false, time.Time{}, err
+	}
+	slog.Warn("[LOCATIONS] Provider unavailable, serving cached locations",
+		"loaded_at", cached.loadedAt.Format(time.RFC3339), "phone", phone, "error", err)
+	return cached.locations, true, cached.loadedAt, nil
+}
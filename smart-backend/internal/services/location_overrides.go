@@ -0,0 +1,85 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"sort"
+
+	"gorm.io/gorm/clause"
+)
+
+// SetLocationOverride creates or replaces the override of a location
+func SetLocationOverride(override models.LocationOverride) (models.LocationOverride, error) {
+	err := db.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "location_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"display_name", "logo", "sort_order", "updated_by", "updated_at"}),
+	}).Create(&override).Error
+	if err != nil {
+		return models.LocationOverride{}, err
+	}
+	// Reload for the creation time of a replaced override
+	var saved models.LocationOverride
+	err = db.DB.First(&saved, "location_id = ?", override.LocationID).Error
+	return saved, err
+}
+
+// DeleteLocationOverride removes the override of a location and reports whether there was one
+func DeleteLocationOverride(locationID int) (bool, error) {
+	result := db.DB.Delete(&models.LocationOverride{}, "location_id = ?", locationID)
+	return result.RowsAffected > 0, result.Error
+}
+
+// LocationOverrides returns all overrides, in list order
+func LocationOverrides() ([]models.LocationOverride, error) {
+	var overrides []models.LocationOverride
+	err := db.DB.Order("sort_order IS NULL, sort_order, location_id").Find(&overrides).Error
+	return overrides, err
+}
+
+// ApplyLocationOverrides returns a copy of the provider's locations with display names and
+// logos overridden, and locations with a sort order moved first, lowest first. The rest keep
+// the provider's order. If overrides cannot be loaded the locations are returned unchanged.
+func ApplyLocationOverrides(locations []LocationResponse) []LocationResponse {
+	if len(locations) == 0 {
+		return locations
+	}
+	ids := make([]int, len(locations))
+	for i, location := range locations {
+		ids[i] = location.ID
+	}
+	var overrides []models.LocationOverride
+	if err := db.DB.Where("location_id IN ?", ids).Find(&overrides).Error; err != nil {
+		slog.Error("[LOCATIONS] Failed to load location overrides", "error", err)
+		return locations
+	}
+	if len(overrides) == 0 {
+		return locations
+	}
+
+	byID := make(map[int]models.LocationOverride, len(overrides))
+	for _, override := range overrides {
+		byID[override.LocationID] = override
+	}
+	merged := make([]LocationResponse, len(locations))
+	for i, location := range locations {
+		if override, ok := byID[location.ID]; ok {
+			if override.DisplayName != "" {
+				location.Title = override.DisplayName
+			}
+			if override.Logo != "" {
+				location.Logo = override.Logo
+			}
+		}
+		merged[i] = location
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		a, b := byID[merged[i].ID].SortOrder, byID[merged[j].ID].SortOrder
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return *a < *b
+	})
+	return merged
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/metrics"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LoginGuard protects password logins against brute force. It counts failed logins per account
+// and per client IP within LOGIN_FAILURE_WINDOW and locks a key out once it reaches its limit.
+// Each further lockout of the same key lasts twice as long, up to LOGIN_MAX_LOCKOUT; the backoff
+// resets after a successful login, or a full window without failures after the lockout ends. Like
+// AttemptTracker it only covers a single instance.
+type LoginGuard struct {
+	mu      sync.Mutex
+	entries map[string]*loginEntry
+	now     func() time.Time
+}
+
+// loginEntry holds the recent failures and the backoff of one key
+type loginEntry struct {
+	failures    []time.Time
+	lockouts    int // Lockouts since the backoff last reset
+	lockedUntil time.Time
+}
+
+var (
+	loginGuard     *LoginGuard
+	loginGuardOnce sync.Once
+)
+
+// NewLoginGuard creates an empty login guard
+func NewLoginGuard() *LoginGuard {
+	return &LoginGuard{entries: make(map[string]*loginEntry), now: time.Now}
+}
+
+// LoginAttempts returns the process-wide login guard
+func LoginAttempts() *LoginGuard {
+	loginGuardOnce.Do(func() {
+		loginGuard = NewLoginGuard()
+	})
+	return loginGuard
+}
+
+// UserLoginKey is the account key of a user login by canonical phone or email
+func UserLoginKey(identifier string) string {
+	return "user:" + strings.ToLower(identifier)
+}
+
+// AdminLoginKey is the account key of an admin login
+func AdminLoginKey(username string) string {
+	return "admin:" + strings.ToLower(username)
+}
+
+// LoginIPKey is the key of a client IP, shared by user and admin logins
+func LoginIPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Locked reports whether any of keys is locked out, and how long until the last of their
+// lockouts ends
+func (g *LoginGuard) Locked(keys ...string) (time.Duration, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	var wait time.Duration
+	for _, key := range keys {
+		if entry, ok := g.entries[key]; ok && entry.lockedUntil.After(now) {
+			if remaining := entry.lockedUntil.Sub(now); remaining > wait {
+				wait = remaining
+			}
+		}
+	}
+	return wait, wait > 0
+}
+
+// Fail records a failed login of account from ipKey. If either reaches its limit
+// (LOGIN_MAX_FAILED_ATTEMPTS, LOGIN_IP_MAX_FAILED_ATTEMPTS) it is locked out, and the longest
+// lockout started is returned.
+func (g *LoginGuard) Fail(account, ipKey string) (time.Duration, bool) {
+	cfg := config.AppConfig().Login
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	g.prune(now, cfg.FailureWindow)
+	lockout := g.fail(account, "account", cfg.MaxFailedAttempts, cfg, now)
+	if ipLockout := g.fail(ipKey, "ip", cfg.IPMaxFailedAttempts, cfg, now); ipLockout > lockout {
+		lockout = ipLockout
+	}
+	return lockout, lockout > 0
+}
+
+// Succeed clears the failures and the backoff of account. Failures of the client IP are kept, so
+// logging in to one account does not reset guessing at others.
+func (g *LoginGuard) Succeed(account string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.entries, account)
+}
+
+// fail records a failure of key and locks it out once it has limit failures in the window. A
+// limit <= 0 never locks out.
+func (g *LoginGuard) fail(key, kind string, limit int, cfg config.LoginConfig, now time.Time) time.Duration {
+	entry, ok := g.entries[key]
+	if !ok {
+		entry = &loginEntry{}
+		g.entries[key] = entry
+	}
+	entry.failures = append(entry.failures, now)
+	if limit <= 0 || len(entry.failures) < limit {
+		return 0
+	}
+
+	lockout := cfg.Lockout
+	for i := 0; i < entry.lockouts && lockout < cfg.MaxLockout; i++ {
+		lockout *= 2
+	}
+	if lockout > cfg.MaxLockout {
+		lockout = cfg.MaxLockout
+	}
+	entry.lockouts++
+	entry.failures = nil
+	entry.lockedUntil = now.Add(lockout)
+
+	metrics.IncCounter("login_lockouts_total", metrics.Labels{"kind": kind})
+	slog.Warn("[LOGIN_GUARD] Locked out after failed logins", "key", key, "lockout", lockout, "failed_logins", limit, "lockouts", entry.lockouts)
+	return lockout
+}
+
+// prune drops failures older than the window, and keys without failures whose last lockout ended
+// a window ago, resetting their backoff
+func (g *LoginGuard) prune(now time.Time, window time.Duration) {
+	cutoff := now.Add(-window)
+	for key, entry := range g.entries {
+		i := 0
+		for i < len(entry.failures) && !entry.failures[i].After(cutoff) {
+			i++
+		}
+		entry.failures = entry.failures[i:]
+		if len(entry.failures) == 0 && !entry.lockedUntil.After(cutoff) {
+			delete(g.entries, key)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"ololo-gate/internal/config"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginGuard_ExponentialLockouts(t *testing.T) {
+	config.SetAppConfig(&config.Config{Login: config.LoginConfig{
+		MaxFailedAttempts:   2,
+		IPMaxFailedAttempts: 10,
+		FailureWindow:       15 * time.Minute,
+		Lockout:             time.Minute,
+		MaxLockout:          3 * time.Minute,
+	}})
+	guard := NewLoginGuard()
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	guard.now = func() time.Time { return now }
+	account, ip := UserLoginKey("+77771234567"), LoginIPKey("10.0.0.1")
+
+	_, locked := guard.Fail(account, ip)
+	assert.False(t, locked)
+	lockout, locked := guard.Fail(account, ip)
+	assert.True(t, locked)
+	assert.Equal(t, time.Minute, lockout)
+
+	now = now.Add(30 * time.Second)
+	wait, locked := guard.Locked(account, ip)
+	assert.True(t, locked)
+	assert.Equal(t, 30*time.Second, wait)
+	_, locked = guard.Locked(ip)
+	assert.False(t, locked)
+
+	// Each further lockout doubles, up to the maximum
+	for _, expected := range []time.Duration{2 * time.Minute, 3 * time.Minute} {
+		now = now.Add(5 * time.Minute)
+		_, locked = guard.Locked(account)
+		assert.False(t, locked)
+		guard.Fail(account, ip)
+		lockout, _ = guard.Fail(account, ip)
+		assert.Equal(t, expected, lockout)
+	}
+
+	// A window without failures after the lockout resets the backoff
+	now = now.Add(3*time.Minute + 15*time.Minute)
+	guard.Fail(account, ip)
+	lockout, _ = guard.Fail(account, ip)
+	assert.Equal(t, time.Minute, lockout)
+
+	// So does a successful login
+	now = now.Add(2 * time.Minute)
+	guard.Fail(account, ip)
+	guard.Succeed(account)
+	_, locked = guard.Fail(account, ip)
+	assert.False(t, locked)
+}
+
+func TestLoginGuard_IPLockout(t *testing.T) {
+	config.SetAppConfig(&config.Config{Login: config.LoginConfig{
+		IPMaxFailedAttempts: 3,
+		FailureWindow:       15 * time.Minute,
+		Lockout:             time.Minute,
+		MaxLockout:          time.Hour,
+	}})
+	guard := NewLoginGuard()
+	ip := LoginIPKey("10.0.0.1")
+
+	for _, username := range []string{"a", "b"} {
+		_, locked := guard.Fail(AdminLoginKey(username), ip)
+		assert.False(t, locked)
+	}
+	_, locked := guard.Fail(AdminLoginKey("c"), ip)
+	assert.True(t, locked)
+
+	// Accounts without a limit are never locked out; logging in does not clear the IP
+	_, locked = guard.Locked(AdminLoginKey("a"))
+	assert.False(t, locked)
+	guard.Succeed(AdminLoginKey("a"))
+	_, locked = guard.Locked(AdminLoginKey("a"), ip)
+	assert.True(t, locked)
+}
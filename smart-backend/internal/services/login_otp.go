@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// loginOTPSMS is the text message carrying a login code
+const loginOTPSMS = "Your Ololo Gate login code is %s. It expires in %d minutes. Do not share it with anyone."
+
+// RequestLoginOTP creates a login code for phone (canonical E.164) and texts it to the number if
+// it belongs to a user. Codes are also created, but not sent, for unknown numbers, so neither the
+// response nor the rate limits reveal which numbers have an account. Requesting a code invalidates
+// the previous one. Returns an *OTPRateLimitError if phone or ip requested too many codes.
+func RequestLoginOTP(ctx context.Context, phone, ip string) error {
+	user, err := FindUserByPhone(phone)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	found := err == nil
+
+	var userID *uuid.UUID
+	if found {
+		userID = &user.ID
+	}
+	code, err := issueOTPCode(ctx, models.OTPPurposeLogin, phone, ip, userID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		slog.InfoContext(ctx, "[LOGIN_OTP] Code requested for unknown phone, not sent", "phone", phone)
+		return nil
+	}
+	return SendSMS(ctx, phone, fmt.Sprintf(loginOTPSMS, code, otpTTLMinutes()))
+}
+
+// ConfirmLoginOTP checks a login code for phone and returns the user it logs in. A code can be
+// used once; after too many wrong guesses it is invalidated. Returns ErrOTPInvalid if the code
+// is wrong, expired or used, or was not sent to the user now holding the number.
+func ConfirmLoginOTP(ctx context.Context, phone, code string) (models.User, error) {
+	otp, err := checkOTPCode(ctx, models.OTPPurposeLogin, phone, code)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	user, err := FindUserByPhone(phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && (otp.UserID == nil || *otp.UserID != user.ID)) {
+		return models.User{}, ErrOTPInvalid
+	}
+	if err != nil {
+		return models.User{}, err
+	}
+
+	// Conditional update: the same code cannot log in twice when confirmed concurrently
+	if err := consumeOTPCode(db.DB, otp.ID); err != nil {
+		return models.User{}, err
+	}
+	return user, nil
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type usageKey struct {
+	metric string
+	day    string
+}
+
+// UsageMeter buffers per-organization usage in memory and periodically adds it to the
+// daily counters in the database, so metering does not cost a write per request.
+type UsageMeter struct {
+	mu          sync.Mutex
+	orgID       string
+	counts      map[usageKey]int64
+	activeUsers map[uuid.UUID]time.Time // Users first seen today and not yet written
+	seenDay     string
+	seen        map[uuid.UUID]struct{} // Users already recorded for seenDay by this process
+	now         func() time.Time
+}
+
+// UsageRollup is the usage of one organization for one month
+type UsageRollup struct {
+	OrgID   string
+	Month   string
+	Metrics map[string]int64
+}
+
+var (
+	usageMeter     *UsageMeter
+	usageMeterOnce sync.Once
+)
+
+// NewUsageMeter creates a meter recording usage for orgID
+func NewUsageMeter(orgID string) *UsageMeter {
+	return &UsageMeter{
+		orgID:       orgID,
+		counts:      make(map[usageKey]int64),
+		activeUsers: make(map[uuid.UUID]time.Time),
+		seen:        make(map[uuid.UUID]struct{}),
+		now:         time.Now,
+	}
+}
+
+// Meter returns the process-wide usage meter
+func Meter() *UsageMeter {
+	usageMeterOnce.Do(func() {
+		usageMeter = NewUsageMeter(config.AppConfig().Metering.OrgID)
+	})
+	return usageMeter
+}
+
+// Add records n units of a usage metric for today
+func (m *UsageMeter) Add(metric string, n int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counts[usageKey{metric: metric, day: m.now().UTC().Format("2006-01-02")}] += n
+}
+
+// RecordActiveUser marks the user as active today and this month
+func (m *UsageMeter) RecordActiveUser(userID uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now().UTC()
+	if day := now.Format("2006-01-02"); day != m.seenDay {
+		m.seenDay = day
+		m.seen = make(map[uuid.UUID]struct{})
+	}
+	if _, ok := m.seen[userID]; ok {
+		return
+	}
+	m.seen[userID] = struct{}{}
+	m.activeUsers[userID] = now
+}
+
+// Flush writes buffered usage to the database. Counters that fail to write are kept for the next flush.
+func (m *UsageMeter) Flush() error {
+	m.mu.Lock()
+	counts := m.counts
+	activeUsers := m.activeUsers
+	m.counts = make(map[usageKey]int64)
+	m.activeUsers = make(map[uuid.UUID]time.Time)
+	m.mu.Unlock()
+
+	if len(counts) == 0 && len(activeUsers) == 0 {
+		return nil
+	}
+
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for key, value := range counts {
+			counter := models.UsageCounter{OrgID: m.orgID, Metric: key.metric, Day: key.day, Value: value, UpdatedAt: now}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "org_id"}, {Name: "metric"}, {Name: "day"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"value":      gorm.Expr("usage_counters.value + ?", value),
+					"updated_at": now,
+				}),
+			}).Create(&counter).Error; err != nil {
+				return err
+			}
+		}
+		for userID, seenAt := range activeUsers {
+			active := models.UsageActiveUser{OrgID: m.orgID, Month: seenAt.Format("2006-01"), UserID: userID, FirstSeenAt: seenAt}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&active).Error; err != nil {
+				return err
+			}
+			daily := models.UsageDailyActiveUser{OrgID: m.orgID, Day: seenAt.Format("2006-01-02"), UserID: userID}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&daily).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		slog.Error("[METERING] Failed to flush usage", "org_id", m.orgID, "error", err)
+		m.mu.Lock()
+		for key, value := range counts {
+			m.counts[key] += value
+		}
+		for userID, seenAt := range activeUsers {
+			m.activeUsers[userID] = seenAt
+		}
+		m.mu.Unlock()
+	}
+	return err
+}
+
+// Start flushes buffered usage every interval until the process exits
+func (m *UsageMeter) Start(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			m.Flush()
+		}
+	}()
+}
+
+// UsageRollups returns the monthly usage of every organization recorded in the database.
+// month is a UTC month in YYYY-MM format.
+func UsageRollups(month string) ([]UsageRollup, error) {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return nil, err
+	}
+	end := start.AddDate(0, 1, 0)
+
+	var counters []struct {
+		OrgID  string
+		Metric string
+		Total  int64
+	}
+	if err := db.DB.Model(&models.UsageCounter{}).
+		Select("org_id, metric, SUM(value) AS total").
+		Where("day >= ? AND day < ?", start.Format("2006-01-02"), end.Format("2006-01-02")).
+		Group("org_id, metric").
+		Scan(&counters).Error; err != nil {
+		return nil, err
+	}
+
+	var activeUsers []struct {
+		OrgID string
+		Total int64
+	}
+	if err := db.DB.Model(&models.UsageActiveUser{}).
+		Select("org_id, COUNT(*) AS total").
+		Where("month = ?", month).
+		Group("org_id").
+		Scan(&activeUsers).Error; err != nil {
+		return nil, err
+	}
+
+	byOrg := make(map[string]*UsageRollup)
+	rollupFor := func(orgID string) *UsageRollup {
+		if r, ok := byOrg[orgID]; ok {
+			return r
+		}
+		r := &UsageRollup{OrgID: orgID, Month: month, Metrics: map[string]int64{
+			models.UsageAPICalls:       0,
+			models.UsageGateOperations: 0,
+			models.UsageSMSSent:        0,
+			models.UsageActiveUsers:    0,
+		}}
+		byOrg[orgID] = r
+		return r
+	}
+	for _, c := range counters {
+		rollupFor(c.OrgID).Metrics[c.Metric] = c.Total
+	}
+	for _, a := range activeUsers {
+		rollupFor(a.OrgID).Metrics[models.UsageActiveUsers] = a.Total
+	}
+
+	rollups := make([]UsageRollup, 0, len(byOrg))
+	for _, r := range byOrg {
+		rollups = append(rollups, *r)
+	}
+	sort.Slice(rollups, func(i, j int) bool { return rollups[i].OrgID < rollups[j].OrgID })
+	return rollups, nil
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMeteringTestDB(t *testing.T) {
+	setupServiceTestDB(t, &models.UsageCounter{}, &models.UsageActiveUser{}, &models.UsageDailyActiveUser{})
+}
+
+func TestUsageMeter_FlushAccumulatesAndRollsUp(t *testing.T) {
+	setupMeteringTestDB(t)
+
+	meter := NewUsageMeter("acme")
+	meter.now = func() time.Time { return time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC) }
+	userID := uuid.New()
+
+	meter.Add(models.UsageAPICalls, 2)
+	meter.Add(models.UsageSMSSent, 1)
+	meter.RecordActiveUser(userID)
+	assert.NoError(t, meter.Flush())
+
+	// Flushes add to the stored counters and active users are counted once per month
+	meter.Add(models.UsageAPICalls, 3)
+	meter.RecordActiveUser(userID)
+	assert.NoError(t, meter.Flush())
+
+	other := NewUsageMeter("globex")
+	other.now = meter.now
+	other.Add(models.UsageGateOperations, 7)
+	assert.NoError(t, other.Flush())
+
+	rollups, err := UsageRollups("2026-10")
+	assert.NoError(t, err)
+	assert.Len(t, rollups, 2)
+
+	assert.Equal(t, "acme", rollups[0].OrgID)
+	assert.Equal(t, int64(5), rollups[0].Metrics[models.UsageAPICalls])
+	assert.Equal(t, int64(1), rollups[0].Metrics[models.UsageSMSSent])
+	assert.Equal(t, int64(1), rollups[0].Metrics[models.UsageActiveUsers])
+	assert.Equal(t, int64(0), rollups[0].Metrics[models.UsageGateOperations])
+
+	assert.Equal(t, "globex", rollups[1].OrgID)
+	assert.Equal(t, int64(7), rollups[1].Metrics[models.UsageGateOperations])
+
+	rollups, err = UsageRollups("2026-11")
+	assert.NoError(t, err)
+	assert.Empty(t, rollups)
+}
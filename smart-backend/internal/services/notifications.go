@@ -0,0 +1,83 @@
+package services
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/models"
+	"sync"
+	"time"
+)
+
+// notificationDedupWindow suppresses repeats of the same alert (e.g. every failed call during an outage)
+const notificationDedupWindow = 10 * time.Minute
+
+var (
+	notificationMu   sync.Mutex
+	lastNotification = make(map[string]time.Time)
+)
+
+// NotifyAdmins adds a notification to the admin notification center and publishes it on the event bus
+func NotifyAdmins(severity, category, title, message string) (*models.AdminNotification, error) {
+	notification := &models.AdminNotification{
+		Severity: severity,
+		Category: category,
+		Title:    title,
+		Message:  message,
+	}
+	if err := db.DB.Create(notification).Error; err != nil {
+		slog.Error("[NOTIFICATIONS] Failed to store notification", "severity", severity, "title", title, "error", err)
+		return nil, err
+	}
+
+	events.Publish(events.AdminNotification, map[string]interface{}{
+		"id":       notification.ID,
+		"severity": severity,
+		"category": category,
+		"title":    title,
+		"message":  message,
+	})
+	return notification, nil
+}
+
+// NotifyAdminsOnce is NotifyAdmins but skips the notification if one with the same key
+// was sent within the dedup window
+func NotifyAdminsOnce(key, severity, category, title, message string) {
+	notificationMu.Lock()
+	if last, ok := lastNotification[key]; ok && time.Since(last) < notificationDedupWindow {
+		notificationMu.Unlock()
+		return
+	}
+	lastNotification[key] = time.Now()
+	notificationMu.Unlock()
+
+	NotifyAdmins(severity, category, title, message)
+}
+
+// registerNotificationSubscribers turns alert-worthy domain events into admin notifications
+// and forwards gate reports to the facility team
+func registerNotificationSubscribers() {
+	events.Subscribe(events.ProviderUnavailable, func(e events.Event) {
+		operation := fmt.Sprint(e.Data["operation"])
+		NotifyAdminsOnce("provider:"+operation, models.SeverityCritical, models.NotificationProvider,
+			"Gate provider unavailable",
+			fmt.Sprintf("Third-party %s failed: %v", operation, e.Data["detail"]))
+	})
+
+	events.Subscribe(events.JobFailed, func(e events.Event) {
+		NotifyAdmins(models.SeverityWarning, models.NotificationJobs,
+			fmt.Sprintf("Scheduled job %v failed", e.Data["job"]),
+			fmt.Sprint(e.Data["error"]))
+	})
+
+	events.Subscribe(events.SecurityAlert, func(e events.Event) {
+		NotifyAdminsOnce(fmt.Sprintf("security:%v:%v", e.Data["reason"], e.Data["ip"]),
+			models.SeverityWarning, models.NotificationSecurity,
+			fmt.Sprint(e.Data["title"]),
+			fmt.Sprint(e.Data["message"]))
+	})
+
+	// Gate reports are already in the notification center; the facility team gets them too
+	events.Subscribe(events.GateReported, NotifyFacilityTeam)
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/pii"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrOTPInvalid is returned for unknown, expired, used up and wrong one-time codes
+var ErrOTPInvalid = errors.New("invalid or expired code")
+
+// OTPRateLimitError is returned when a phone or client IP has requested too many one-time codes
+type OTPRateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *OTPRateLimitError) Error() string {
+	return fmt.Sprintf("too many codes requested, retry after %s", e.RetryAfter)
+}
+
+// issueOTPCode creates a one-time code for purpose and phone (canonical E.164), for userID when the
+// number belongs to a user, and returns the code to send. The previous unused code of the purpose
+// is invalidated. Returns an *OTPRateLimitError if phone or ip requested too many codes of the purpose.
+func issueOTPCode(ctx context.Context, purpose, phone, ip string, userID *uuid.UUID) (string, error) {
+	cfg := config.AppConfig().OTP
+	now := time.Now()
+	index := pii.BlindIndex(phone)
+
+	if err := checkOTPRateLimits(purpose, index, ip, now); err != nil {
+		return "", err
+	}
+
+	code, err := generateOTPCode(cfg.Length)
+	if err != nil {
+		return "", err
+	}
+	otp := models.OTPCode{ID: uuid.New(), Purpose: purpose, UserID: userID, PhoneIndex: index, IP: ip, ExpiresAt: now.Add(cfg.TTL)}
+	otp.CodeHash = hashOTPCode(otp.ID, code)
+
+	err = db.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.OTPCode{}).
+			Where("purpose = ? AND phone_index = ? AND consumed_at IS NULL", purpose, index).
+			Update("consumed_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Create(&otp).Error
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[OTP] Failed to store code", "purpose", purpose, "error", err)
+		return "", err
+	}
+	return code, nil
+}
+
+// checkOTPCode returns the latest usable code of purpose for phone if code matches it, without
+// consuming it. Wrong guesses are counted and invalidate the code after OTP_MAX_ATTEMPTS.
+// Returns ErrOTPInvalid if the code is wrong, expired or used.
+func checkOTPCode(ctx context.Context, purpose, phone, code string) (models.OTPCode, error) {
+	cfg := config.AppConfig().OTP
+	now := time.Now()
+
+	var otp models.OTPCode
+	err := db.DB.Where("purpose = ? AND phone_index = ? AND consumed_at IS NULL AND expires_at > ? AND attempts < ?",
+		purpose, pii.BlindIndex(phone), now, cfg.MaxAttempts).
+		Order("created_at DESC").First(&otp).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return otp, ErrOTPInvalid
+	}
+	if err != nil {
+		return otp, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashOTPCode(otp.ID, strings.TrimSpace(code))), []byte(otp.CodeHash)) != 1 {
+		updates := map[string]interface{}{"attempts": gorm.Expr("attempts + 1")}
+		if otp.Attempts+1 >= cfg.MaxAttempts {
+			slog.WarnContext(ctx, "[OTP] Code invalidated after wrong attempts", "purpose", purpose, "otp_id", otp.ID, "attempts", otp.Attempts+1)
+			updates["consumed_at"] = now
+		}
+		if err := db.DB.Model(&models.OTPCode{}).Where("id = ?", otp.ID).Updates(updates).Error; err != nil {
+			return otp, err
+		}
+		return otp, ErrOTPInvalid
+	}
+	return otp, nil
+}
+
+// consumeOTPCode marks a code as used. The update is conditional, so a code used concurrently
+// only succeeds once; the other use gets ErrOTPInvalid.
+func consumeOTPCode(tx *gorm.DB, id uuid.UUID) error {
+	consumed := tx.Model(&models.OTPCode{}).
+		Where("id = ? AND consumed_at IS NULL", id).
+		Update("consumed_at", time.Now())
+	if consumed.Error != nil {
+		return consumed.Error
+	}
+	if consumed.RowsAffected == 0 {
+		return ErrOTPInvalid
+	}
+	return nil
+}
+
+// PurgeOTPCodes deletes one-time codes requested before the cutoff
+func PurgeOTPCodes(cutoff time.Time) (int64, error) {
+	result := db.DB.Where("created_at < ?", cutoff).Delete(&models.OTPCode{})
+	return result.RowsAffected, result.Error
+}
+
+// otpRequest is the part of a stored code the rate limits look at
+type otpRequest struct {
+	CreatedAt time.Time
+}
+
+// checkOTPRateLimits enforces the resend interval and the hourly limits per phone and per client IP
+// on the codes of purpose
+func checkOTPRateLimits(purpose, phoneIndex, ip string, now time.Time) error {
+	cfg := config.AppConfig().OTP
+	hourAgo := now.Add(-time.Hour)
+
+	var recent []otpRequest
+	if err := db.DB.Model(&models.OTPCode{}).Select("created_at").
+		Where("purpose = ? AND phone_index = ? AND created_at > ?", purpose, phoneIndex, hourAgo).
+		Order("created_at").Find(&recent).Error; err != nil {
+		return err
+	}
+	if len(recent) > 0 {
+		if wait := recent[len(recent)-1].CreatedAt.Add(cfg.ResendInterval).Sub(now); wait > 0 {
+			return &OTPRateLimitError{RetryAfter: wait}
+		}
+		if cfg.PhoneHourly > 0 && len(recent) >= cfg.PhoneHourly {
+			return &OTPRateLimitError{RetryAfter: recent[len(recent)-cfg.PhoneHourly].CreatedAt.Sub(hourAgo)}
+		}
+	}
+
+	if cfg.IPHourly > 0 && ip != "" {
+		var fromIP []otpRequest
+		if err := db.DB.Model(&models.OTPCode{}).Select("created_at").
+			Where("purpose = ? AND ip = ? AND created_at > ?", purpose, ip, hourAgo).
+			Order("created_at DESC").Limit(cfg.IPHourly).Find(&fromIP).Error; err != nil {
+			return err
+		}
+		if len(fromIP) >= cfg.IPHourly {
+			return &OTPRateLimitError{RetryAfter: fromIP[len(fromIP)-1].CreatedAt.Sub(hourAgo)}
+		}
+	}
+	return nil
+}
+
+// generateOTPCode returns a random numeric code of the given length
+func generateOTPCode(length int) (string, error) {
+	if length <= 0 {
+		length = 6
+	}
+	ten := big.NewInt(10)
+	var code strings.Builder
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, ten)
+		if err != nil {
+			return "", err
+		}
+		code.WriteByte(byte('0' + n.Int64()))
+	}
+	return code.String(), nil
+}
+
+// hashOTPCode hashes a code with its OTP ID, so equal codes have different hashes
+func hashOTPCode(id uuid.UUID, code string) string {
+	sum := sha256.Sum256([]byte(id.String() + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+// otpTTLMinutes is OTP_TTL in whole minutes, for the text messages carrying codes
+func otpTTLMinutes() int {
+	minutes := int(config.AppConfig().OTP.TTL.Round(time.Minute) / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+	return minutes
+}
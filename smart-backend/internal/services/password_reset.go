@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// passwordResetSMS is the text message carrying a password reset code
+const passwordResetSMS = "Your Ololo Gate password reset code is %s. It expires in %d minutes. If you did not ask to reset your password, ignore this message."
+
+// RequestPasswordReset creates a password reset code for phone (canonical E.164) and texts it to
+// the number if it belongs to a user. As for login codes, codes are also created, but not sent,
+// for unknown numbers, so the response does not reveal which numbers are registered. Requesting a
+// code invalidates the previous one. Returns an *OTPRateLimitError if phone or ip requested too
+// many codes.
+func RequestPasswordReset(ctx context.Context, phone, ip string) error {
+	user, err := findResettableUser(phone)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	found := err == nil
+
+	var userID *uuid.UUID
+	if found {
+		userID = &user.ID
+	}
+	code, err := issueOTPCode(ctx, models.OTPPurposePasswordReset, phone, ip, userID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		slog.InfoContext(ctx, "[PASSWORD_RESET] Code requested for unknown phone, not sent", "phone", phone)
+		return nil
+	}
+	return SendSMS(ctx, phone, fmt.Sprintf(passwordResetSMS, code, otpTTLMinutes()))
+}
+
+// ResetPassword checks a password reset code for phone and sets newPassword. The token version is
+// bumped and every session revoked, so all devices are logged out. A code can be used once; after
+// too many wrong guesses it is invalidated. Returns ErrOTPInvalid if the code is wrong, expired or
+// used, or was not sent to the user now holding the number.
+func ResetPassword(ctx context.Context, phone, code, newPassword string) (models.User, error) {
+	otp, err := checkOTPCode(ctx, models.OTPPurposePasswordReset, phone, code)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	user, err := findResettableUser(phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && (otp.UserID == nil || *otp.UserID != user.ID)) {
+		return models.User{}, ErrOTPInvalid
+	}
+	if err != nil {
+		return models.User{}, err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	now := time.Now()
+	err = db.DB.Transaction(func(tx *gorm.DB) error {
+		// Conditional update: the same code cannot reset the password twice when used concurrently
+		if err := consumeOTPCode(tx, otp.ID); err != nil {
+			return err
+		}
+		return tx.Model(&models.User{}).Where("id = ?", user.ID).Updates(map[string]interface{}{
+			"password":            string(hashedPassword),
+			"password_changed_at": now,
+			"token_version":       gorm.Expr("token_version + 1"),
+		}).Error
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+	RevokeAllSessions(ctx, user.ID)
+
+	// Passwords are never stored in the history, only that one was set
+	RecordUserHistory(user.ID, models.UserHistoryPasswordReset, HistoryActorSelf,
+		FieldChanges{}.Set("password_changed", false, true))
+	slog.InfoContext(ctx, "[PASSWORD_RESET] Password reset", "user_id", user.ID)
+
+	user.PasswordChangedAt = &now
+	user.TokenVersion++
+	return user, nil
+}
+
+// findResettableUser returns the user holding phone, unless their registration still waits for
+// its phone to be confirmed
+func findResettableUser(phone string) (models.User, error) {
+	user, err := FindUserByPhone(phone)
+	if err == nil && user.RegistrationStatus == models.RegistrationUnverified {
+		return models.User{}, gorm.ErrRecordNotFound
+	}
+	return user, err
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AssignmentRetryJobName is the scheduled job that retries failed location/gate assignments
+const AssignmentRetryJobName = "assignment_retry"
+
+// assignmentRetryBatch bounds the pending assignments retried by one run of the job
+const assignmentRetryBatch = 100
+
+// ErrNoPendingAssignment is returned when a user has no failed assignment to retry
+var ErrNoPendingAssignment = errors.New("user has no pending assignment")
+
+// QueueAssignment records a location/gate assignment the provider refused, so the
+// assignment_retry job sends it again after ASSIGNMENT_RETRY_BACKOFF. A pending assignment
+// already queued for the user is replaced, since the newer assignment supersedes it.
+func QueueAssignment(ctx context.Context, userID uuid.UUID, locations []LocationAssignmentDTO, requestedBy string, cause error) (models.PendingAssignment, error) {
+	encoded, err := json.Marshal(locations)
+	if err != nil {
+		return models.PendingAssignment{}, err
+	}
+
+	var pending models.PendingAssignment
+	err = db.DB.Where("user_id = ?", userID).First(&pending).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.PendingAssignment{}, err
+	}
+	pending.UserID = userID
+	pending.Locations = string(encoded)
+	pending.Status = models.AssignmentPending
+	pending.Attempts = 1
+	pending.LastError = cause.Error()
+	pending.NextAttemptAt = time.Now().Add(assignmentRetryDelay(pending.Attempts))
+	pending.RequestedBy = requestedBy
+	if err := db.DB.Save(&pending).Error; err != nil {
+		return models.PendingAssignment{}, err
+	}
+
+	slog.InfoContext(ctx, "[ASSIGNMENT_RETRY] Queued failed assignment", "user_id", userID, "pending_assignment_id", pending.ID, "next_attempt_at", pending.NextAttemptAt)
+	return pending, nil
+}
+
+// ClearPendingAssignment drops the pending assignment of a user, once a newer assignment
+// reached the provider
+func ClearPendingAssignment(userID uuid.UUID) error {
+	return db.DB.Where("user_id = ?", userID).Delete(&models.PendingAssignment{}).Error
+}
+
+// FindPendingAssignment returns the pending assignment of a user, or ErrNoPendingAssignment
+func FindPendingAssignment(userID uuid.UUID) (models.PendingAssignment, error) {
+	var pending models.PendingAssignment
+	err := db.DB.Where("user_id = ?", userID).First(&pending).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return pending, ErrNoPendingAssignment
+	}
+	return pending, err
+}
+
+// RetryPendingAssignment sends a pending assignment to the provider again. On success it is
+// deleted and recorded in the user's history with actor; on failure the next attempt is
+// scheduled, and after ASSIGNMENT_RETRY_MAX_ATTEMPTS it is marked failed and admins are
+// notified. Assignments of users that were deleted or trashed meanwhile are dropped.
+func RetryPendingAssignment(ctx context.Context, client *ThirdPartyClient, pending *models.PendingAssignment, actor string) error {
+	var user models.User
+	err := db.DB.First(&user, "id = ?", pending.UserID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && user.TrashedAt != nil) {
+		slog.InfoContext(ctx, "[ASSIGNMENT_RETRY] Dropped assignment of deleted user", "user_id", pending.UserID, "pending_assignment_id", pending.ID)
+		if err := db.DB.Delete(&models.PendingAssignment{}, "id = ?", pending.ID).Error; err != nil {
+			return err
+		}
+		return ErrNoPendingAssignment
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "[ASSIGNMENT_RETRY] Failed to load user of pending assignment", "user_id", pending.UserID, "error", err)
+		return err
+	}
+
+	var locations []LocationAssignmentDTO
+	if err := json.Unmarshal([]byte(pending.Locations), &locations); err != nil {
+		err = fmt.Errorf("decode pending assignment: %w", err)
+		recordAssignmentFailure(ctx, pending, user, err)
+		return err
+	}
+
+	if err := AssignAllPhones(client, user, locations); err != nil {
+		recordAssignmentFailure(ctx, pending, user, err)
+		return err
+	}
+
+	// Only the assignment that was sent is deleted, not one queued by a newer failure meanwhile
+	if err := db.DB.Where("id = ? AND locations = ?", pending.ID, pending.Locations).Delete(&models.PendingAssignment{}).Error; err != nil {
+		slog.ErrorContext(ctx, "[ASSIGNMENT_RETRY] Failed to delete retried assignment", "pending_assignment_id", pending.ID, "error", err)
+	}
+	slog.InfoContext(ctx, "[ASSIGNMENT_RETRY] Assignment retried successfully", "user_id", user.ID, "pending_assignment_id", pending.ID, "attempts", pending.Attempts+1, "actor", actor)
+	RecordUserHistory(user.ID, models.UserHistoryAssigned, actor, FieldChanges{}.Set("assignments", nil, locations))
+	return nil
+}
+
+// recordAssignmentFailure counts a failed retry and schedules the next one, or gives the
+// assignment up once it reached ASSIGNMENT_RETRY_MAX_ATTEMPTS
+func recordAssignmentFailure(ctx context.Context, pending *models.PendingAssignment, user models.User, cause error) {
+	pending.Attempts++
+	pending.LastError = cause.Error()
+	pending.NextAttemptAt = time.Now().Add(assignmentRetryDelay(pending.Attempts))
+
+	maxAttempts := config.AppConfig().Assignment.RetryMaxAttempts
+	givenUp := pending.Status == models.AssignmentPending && maxAttempts > 0 && pending.Attempts >= maxAttempts
+	if givenUp {
+		pending.Status = models.AssignmentFailed
+	}
+
+	if err := db.DB.Model(&models.PendingAssignment{}).Where("id = ?", pending.ID).Updates(map[string]interface{}{
+		"status":          pending.Status,
+		"attempts":        pending.Attempts,
+		"last_error":      pending.LastError,
+		"next_attempt_at": pending.NextAttemptAt,
+	}).Error; err != nil {
+		slog.ErrorContext(ctx, "[ASSIGNMENT_RETRY] Failed to record failed retry", "pending_assignment_id", pending.ID, "error", err)
+	}
+
+	if !givenUp {
+		slog.WarnContext(ctx, "[ASSIGNMENT_RETRY] Assignment retry failed", "user_id", user.ID, "pending_assignment_id", pending.ID, "attempts", pending.Attempts, "next_attempt_at", pending.NextAttemptAt, "error", cause)
+		return
+	}
+	slog.ErrorContext(ctx, "[ASSIGNMENT_RETRY] Gave up assignment", "user_id", user.ID, "pending_assignment_id", pending.ID, "attempts", pending.Attempts, "error", cause)
+	NotifyAdmins(models.SeverityWarning, models.NotificationProvider, "Location assignment failed",
+		fmt.Sprintf("The locations and gates of user %s could not be assigned after %d attempts: %v. Retry with POST /api/v1/users/%s/retry-assignment.",
+			user.ID, pending.Attempts, cause, user.ID))
+}
+
+// assignmentRetryDelay is the wait after the given number of failed attempts: ASSIGNMENT_RETRY_BACKOFF
+// doubled after every further failure, up to ASSIGNMENT_RETRY_MAX_BACKOFF
+func assignmentRetryDelay(attempts int) time.Duration {
+	cfg := config.AppConfig().Assignment
+	return retryBackoff(attempts-1, cfg.RetryBackoff, cfg.RetryMaxBackoff)
+}
+
+// RunPendingAssignments retries the pending assignments that are due, oldest first. Nothing
+// is sent while the provider circuit breaker is open; the assignments wait for the next run.
+func RunPendingAssignments(ctx context.Context) error {
+	var due []models.PendingAssignment
+	if err := db.DB.Where("status = ? AND next_attempt_at <= ?", models.AssignmentPending, time.Now()).
+		Order("next_attempt_at").Limit(assignmentRetryBatch).Find(&due).Error; err != nil {
+		return err
+	}
+
+	client := NewThirdPartyClient().WithContext(ctx)
+	for i := range due {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if ProviderBreaker().IsOpen() {
+			slog.InfoContext(ctx, "[ASSIGNMENT_RETRY] Provider circuit is open, postponing retries", "remaining", len(due)-i)
+			return nil
+		}
+		// A failed retry is recorded on the assignment and does not fail the job
+		RetryPendingAssignment(ctx, client, &due[i], HistoryActorSystem)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+	"sync"
+	"time"
+)
+
+// mirrorTimeout bounds mirrored calls when THIRD_PARTY_TIMEOUT is not set
+const mirrorTimeout = 30 * time.Second
+
+// mirrorWG tracks mirrored calls in flight, so tests can wait for their results
+var mirrorWG sync.WaitGroup
+
+// MirrorCall is a write sent to the current provider that is mirrored to the migration provider
+type MirrorCall struct {
+	Operation      string
+	Method         string
+	Path           string      // Request path relative to the provider base URL, e.g. "/locations/phone"
+	Payload        interface{} // JSON request body (nil = none)
+	IdempotencyKey string
+	Phone          string // User of an assignment
+	GateID         int    // Gate of a gate command
+}
+
+// MirrorOperationReport summarizes the mirrored calls of one operation
+type MirrorOperationReport struct {
+	Operation    string
+	Total        int64
+	Matched      int64
+	Mismatched   int64
+	MirrorErrors int64 // Mirrored calls that failed, matching or not
+}
+
+// MirrorReport compares the current and the migration provider since a point in time
+type MirrorReport struct {
+	Enabled    bool
+	Since      time.Time
+	Operations []MirrorOperationReport
+	Mismatches []models.ProviderMirrorResult // Most recent first
+}
+
+// MirrorEnabled reports whether writes are mirrored to a migration provider
+func MirrorEnabled() bool {
+	return config.AppConfig().ThirdParty.MirrorURL != ""
+}
+
+// mirrorWrite sends call to the migration provider in the background, when migration mode is on,
+// and records whether its outcome matches the current provider's. primaryResult is the decoded
+// result of gate commands (nil for calls without one). The mirror has its own HTTP
+// client and bypasses the rate limiter, circuit breaker and usage metering of the current
+// provider, so its failures never affect users.
+func mirrorWrite(call MirrorCall, primaryResult *bool, primaryErr error) {
+	cfg := config.AppConfig().ThirdParty
+	if cfg.MirrorURL == "" {
+		return
+	}
+	if call.GateID != 0 && !cfg.MirrorGateCommands {
+		return
+	}
+
+	primary := mirrorOutcome(primaryResult, primaryErr)
+	mirrorWG.Add(1)
+	go func() {
+		defer mirrorWG.Done()
+
+		var result *bool
+		if primaryResult != nil {
+			result = new(bool)
+		}
+		mirrorErr := sendMirror(cfg.MirrorURL, cfg.Timeout, call, result)
+
+		record := models.ProviderMirrorResult{
+			Operation:      call.Operation,
+			Phone:          call.Phone,
+			GateID:         call.GateID,
+			PrimaryOutcome: primary,
+			MirrorOutcome:  mirrorOutcome(result, mirrorErr),
+		}
+		record.Matched = record.PrimaryOutcome == record.MirrorOutcome
+		if mirrorErr != nil {
+			record.MirrorDetail = mirrorErr.Error()
+		}
+		if !record.Matched {
+			slog.Warn("[PROVIDER_MIRROR] Outcome mismatch",
+				"operation", call.Operation, "current_provider", record.PrimaryOutcome, "migration_provider", record.MirrorOutcome)
+		}
+		metrics.IncCounter("provider_mirror_calls_total", metrics.Labels{
+			"operation": call.Operation,
+			"matched":   fmt.Sprint(record.Matched),
+		})
+		if err := db.DB.Create(&record).Error; err != nil {
+			slog.Error("[PROVIDER_MIRROR] Failed to store result", "operation", call.Operation, "error", err)
+		}
+	}()
+}
+
+// sendMirror performs call against the migration provider and decodes a 200 response into out (if not nil)
+func sendMirror(baseURL string, timeout time.Duration, call MirrorCall, out *bool) error {
+	if timeout <= 0 {
+		timeout = mirrorTimeout
+	}
+	var reqBody io.Reader
+	if call.Payload != nil {
+		body, err := json.Marshal(call.Payload)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(call.Method, baseURL+call.Path, reqBody)
+	if err != nil {
+		return err
+	}
+	if call.Payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if call.IdempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", call.IdempotencyKey)
+	}
+
+	Meter().Add(models.UsageMirrorProviderCalls, 1)
+	resp, err := (&http.Client{Timeout: timeout}).Do(req)
+	if err != nil {
+		return &UpstreamError{Kind: UpstreamUnavailable, Operation: call.Operation, Detail: err.Error(), Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &UpstreamError{Kind: UpstreamUnavailable, Operation: call.Operation, StatusCode: resp.StatusCode, Detail: "failed to read response body", Err: err}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &UpstreamError{Kind: classifyStatus(resp.StatusCode), Operation: call.Operation, StatusCode: resp.StatusCode,
+			Detail: fmt.Sprintf("migration provider returned status code %d", resp.StatusCode)}
+	}
+	if out != nil {
+		if err := json.Unmarshal(body, out); err != nil {
+			return &UpstreamError{Kind: UpstreamMalformed, Operation: call.Operation, StatusCode: resp.StatusCode, Detail: "unexpected response body: " + err.Error(), Err: err}
+		}
+	}
+	return nil
+}
+
+// mirrorOutcome describes the outcome of a provider call in a form that can be compared across
+// providers: "ok", "ok:<result>" or "error:<kind>"
+func mirrorOutcome(result *bool, err error) string {
+	if err != nil {
+		var upstreamErr *UpstreamError
+		if errors.As(err, &upstreamErr) {
+			return "error:" + string(upstreamErr.Kind)
+		}
+		return "error"
+	}
+	if result == nil {
+		return "ok"
+	}
+	return fmt.Sprintf("ok:%v", *result)
+}
+
+// ProviderMirrorReport summarizes the mirrored calls since the given time, per operation, with
+// up to limit of the most recent mismatches
+func ProviderMirrorReport(since time.Time, limit int) (MirrorReport, error) {
+	report := MirrorReport{Enabled: MirrorEnabled(), Since: since, Operations: []MirrorOperationReport{}}
+
+	if err := db.DB.Model(&models.ProviderMirrorResult{}).
+		Select(`operation,
+			COUNT(*) AS total,
+			SUM(CASE WHEN matched THEN 1 ELSE 0 END) AS matched,
+			SUM(CASE WHEN matched THEN 0 ELSE 1 END) AS mismatched,
+			SUM(CASE WHEN mirror_outcome LIKE 'error%' THEN 1 ELSE 0 END) AS mirror_errors`).
+		Where("created_at >= ?", since).
+		Group("operation").Order("operation").
+		Scan(&report.Operations).Error; err != nil {
+		return report, err
+	}
+
+	if err := db.DB.Where("created_at >= ? AND matched = ?", since, false).
+		Order("created_at DESC").Limit(limit).
+		Find(&report.Mismatches).Error; err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+// PurgeProviderMirrorResults deletes mirror results recorded before the cutoff
+func PurgeProviderMirrorResults(cutoff time.Time) (int64, error) {
+	result := db.DB.Where("created_at < ?", cutoff).Delete(&models.ProviderMirrorResult{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderMirror_ComparesWritesWithMigrationProvider(t *testing.T) {
+	setupGateEventTestDB(t)
+	assert.NoError(t, db.DB.AutoMigrate(&models.ProviderMirrorResult{}))
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`true`))
+	})
+	var mirrored []string
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mirrored = append(mirrored, r.Method+" "+r.URL.Path+" "+r.Header.Get("Idempotency-Key"))
+		if r.URL.Path == "/locations/9/open" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`true`))
+	}))
+	defer mirror.Close()
+	config.AppConfig().ThirdParty = config.ThirdPartyConfig{MirrorURL: mirror.URL, MirrorGateCommands: true}
+
+	assert.NoError(t, client.AssignUserToLocationsAndGates(UserLocationGateAssignmentDTO{Phone: "+77771234567"}))
+	opened, err := client.OpenGate("+77771234567", 7, "cmd-1")
+	assert.NoError(t, err)
+	assert.True(t, opened)
+	_, err = client.OpenGate("+77771234567", 9, "cmd-2")
+	assert.NoError(t, err)
+	mirrorWG.Wait()
+
+	assert.ElementsMatch(t, []string{"PUT /locations/phone ", "PUT /locations/7/open cmd-1", "PUT /locations/9/open cmd-2"}, mirrored)
+
+	report, err := ProviderMirrorReport(time.Now().Add(-time.Hour), 10)
+	assert.NoError(t, err)
+	assert.True(t, report.Enabled)
+	assert.Equal(t, []MirrorOperationReport{
+		{Operation: "assign_user", Total: 1, Matched: 1},
+		{Operation: "open_gate", Total: 2, Matched: 1, Mismatched: 1, MirrorErrors: 1},
+	}, report.Operations)
+	if assert.Len(t, report.Mismatches, 1) {
+		assert.Equal(t, 9, report.Mismatches[0].GateID)
+		assert.Equal(t, "ok:true", report.Mismatches[0].PrimaryOutcome)
+		assert.Equal(t, "error:not_found", report.Mismatches[0].MirrorOutcome)
+	}
+
+	// Gate commands can be left out of the migration
+	config.AppConfig().ThirdParty.MirrorGateCommands = false
+	mirrored = nil
+	client.CloseGate("+77771234567", 7)
+	mirrorWG.Wait()
+	assert.Empty(t, mirrored)
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// Providers whose calls are metered against a monthly quota
+const (
+	ProviderPrimary = "primary" // THIRD_PARTY_API_URL
+	ProviderMirror  = "mirror"  // THIRD_PARTY_MIRROR_API_URL
+)
+
+// ProviderQuotaJobName is the scheduled job that warns admins as providers near their quota
+const ProviderQuotaJobName = "provider_quota_check"
+
+// ProviderQuotaThresholds are the percentages of a monthly quota admins are warned at
+var ProviderQuotaThresholds = []int{80, 95}
+
+// ProviderUsage is one provider's calls in a month against its monthly quota
+type ProviderUsage struct {
+	Provider string
+	Month    string
+	Calls    int64
+	Quota    int64   // 0 = no quota
+	Percent  float64 // Share of the quota used, 0 without a quota
+	Warned   []int   // Thresholds crossed this month, ascending
+}
+
+// providerUsageMetric is the usage counter each provider's calls are metered in
+var providerUsageMetric = map[string]string{
+	ProviderPrimary: models.UsageProviderCalls,
+	ProviderMirror:  models.UsageMirrorProviderCalls,
+}
+
+// providerQuota returns the configured monthly quota of the provider
+func providerQuota(provider string) int64 {
+	if provider == ProviderMirror {
+		return config.AppConfig().ThirdParty.MirrorMonthlyQuota
+	}
+	return config.AppConfig().ThirdParty.MonthlyQuota
+}
+
+// ProviderUsages returns the calls of this organization to each provider in month (YYYY-MM),
+// against the current quotas. The mirror is left out when it is not configured and was not
+// called that month. Usage buffered by other instances is not included until they flush.
+func ProviderUsages(month string) ([]ProviderUsage, error) {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return nil, err
+	}
+	end := start.AddDate(0, 1, 0)
+
+	var counters []struct {
+		Metric string
+		Total  int64
+	}
+	if err := db.DB.Model(&models.UsageCounter{}).
+		Select("metric, SUM(value) AS total").
+		Where("org_id = ? AND metric IN ? AND day >= ? AND day < ?", config.AppConfig().Metering.OrgID,
+			[]string{models.UsageProviderCalls, models.UsageMirrorProviderCalls}, start.Format("2006-01-02"), end.Format("2006-01-02")).
+		Group("metric").
+		Scan(&counters).Error; err != nil {
+		return nil, err
+	}
+	totals := make(map[string]int64, len(counters))
+	for _, c := range counters {
+		totals[c.Metric] = c.Total
+	}
+
+	var warnings []models.ProviderQuotaWarning
+	if err := db.DB.Where("month = ?", month).Order("threshold").Find(&warnings).Error; err != nil {
+		return nil, err
+	}
+
+	var usages []ProviderUsage
+	for _, provider := range []string{ProviderPrimary, ProviderMirror} {
+		usage := ProviderUsage{Provider: provider, Month: month, Calls: totals[providerUsageMetric[provider]], Quota: providerQuota(provider), Warned: []int{}}
+		if provider == ProviderMirror && !MirrorEnabled() && usage.Calls == 0 {
+			continue
+		}
+		if usage.Quota > 0 {
+			usage.Percent = float64(usage.Calls) * 100 / float64(usage.Quota)
+		}
+		for _, w := range warnings {
+			if w.Provider == provider {
+				usage.Warned = append(usage.Warned, w.Threshold)
+			}
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+// CheckProviderQuotas warns admins the first time each provider's calls this month cross one
+// of ProviderQuotaThresholds, and returns the new warnings
+func CheckProviderQuotas(now time.Time) ([]models.ProviderQuotaWarning, error) {
+	// Include this instance's buffered usage in the numbers
+	Meter().Flush()
+
+	usages, err := ProviderUsages(now.UTC().Format("2006-01"))
+	if err != nil {
+		return nil, err
+	}
+
+	var created []models.ProviderQuotaWarning
+	for _, usage := range usages {
+		metrics.SetGauge("provider_quota_usage_percent", metrics.Labels{"provider": usage.Provider}, usage.Percent)
+		if usage.Quota <= 0 {
+			continue
+		}
+
+		// Only the highest threshold reached is announced; lower ones are recorded silently
+		var reached []int
+		for _, threshold := range ProviderQuotaThresholds {
+			if usage.Percent >= float64(threshold) {
+				reached = append(reached, threshold)
+			}
+		}
+		announce := false
+		for _, threshold := range reached {
+			warning := models.ProviderQuotaWarning{Provider: usage.Provider, Month: usage.Month, Threshold: threshold, Calls: usage.Calls, Quota: usage.Quota}
+			result := db.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&warning)
+			if result.Error != nil {
+				return created, result.Error
+			}
+			if result.RowsAffected > 0 {
+				created = append(created, warning)
+				announce = true
+			}
+		}
+		if !announce {
+			continue
+		}
+
+		threshold := reached[len(reached)-1]
+		severity := models.SeverityWarning
+		if threshold == ProviderQuotaThresholds[len(ProviderQuotaThresholds)-1] {
+			severity = models.SeverityCritical
+		}
+		slog.Warn("[PROVIDER_QUOTA] Provider nearing its monthly quota",
+			"provider", usage.Provider, "percent", usage.Percent, "calls", usage.Calls, "quota", usage.Quota)
+		NotifyAdmins(severity, models.NotificationProvider,
+			fmt.Sprintf("Provider API usage at %d%% of the monthly quota", threshold),
+			fmt.Sprintf("The %s provider has received %d of %d calls allowed in %s (%.1f%%)", usage.Provider, usage.Calls, usage.Quota, usage.Month, usage.Percent))
+	}
+	return created, nil
+}
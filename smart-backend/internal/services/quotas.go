@@ -0,0 +1,169 @@
+package services
+
+import (
+	"ololo-gate/internal/config"
+	"sync"
+	"time"
+)
+
+// QuotaStore counts requests per key within fixed windows. The in-memory store only covers a
+// single instance; a shared store (e.g. Redis INCR + EXPIREAT) can be plugged in with SetStore
+// so quotas hold across replicas.
+type QuotaStore interface {
+	// Incr increments the counter for key in the window ending at resetAt and returns the new count
+	Incr(key string, resetAt time.Time) (int64, error)
+}
+
+// QuotaLimits are the request limits applied to one principal (0 = unlimited)
+type QuotaLimits struct {
+	Hourly int
+	Daily  int
+}
+
+// QuotaResult describes the most constrained quota window after a request was counted
+type QuotaResult struct {
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+	Exceeded  bool
+}
+
+// Quotas enforces hourly and daily request quotas per principal
+type Quotas struct {
+	mu    sync.RWMutex
+	store QuotaStore
+	now   func() time.Time
+}
+
+var (
+	defaultQuotas     *Quotas
+	defaultQuotasOnce sync.Once
+)
+
+// NewQuotas creates a quota tracker backed by the given store (nil = in-memory)
+func NewQuotas(store QuotaStore) *Quotas {
+	q := &Quotas{store: store, now: time.Now}
+	if store == nil {
+		q.store = newMemoryQuotaStore(func() time.Time { return q.now() })
+	}
+	return q
+}
+
+// RequestQuotas returns the process-wide quota tracker
+func RequestQuotas() *Quotas {
+	defaultQuotasOnce.Do(func() {
+		defaultQuotas = NewQuotas(nil)
+	})
+	return defaultQuotas
+}
+
+// AdminQuotaLimits returns the configured quotas for admin accounts
+func AdminQuotaLimits() QuotaLimits {
+	return QuotaLimits{
+		Hourly: config.AppConfig().Quotas.AdminHourly,
+		Daily:  config.AppConfig().Quotas.AdminDaily,
+	}
+}
+
+// APIKeyQuotaLimits returns the configured quotas for machine API keys
+func APIKeyQuotaLimits() QuotaLimits {
+	return QuotaLimits{
+		Hourly: config.AppConfig().Quotas.APIKeyHourly,
+		Daily:  config.AppConfig().Quotas.APIKeyDaily,
+	}
+}
+
+// ExportQuotaLimits returns the configured daily export quota for admin accounts
+func ExportQuotaLimits() QuotaLimits {
+	return QuotaLimits{Daily: config.AppConfig().Quotas.ExportDaily}
+}
+
+// SetStore replaces the backing store (e.g. with a Redis-backed store shared by all instances)
+func (q *Quotas) SetStore(store QuotaStore) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.store = store
+}
+
+// Consume counts one request for principal in scope and reports the tightest window.
+// ok is false when no limit applies. Counting errors fail open so a store outage
+// does not lock admins out.
+func (q *Quotas) Consume(scope, principal string, limits QuotaLimits) (QuotaResult, bool) {
+	q.mu.RLock()
+	store := q.store
+	q.mu.RUnlock()
+
+	now := q.now().UTC()
+	windows := []struct {
+		name    string
+		limit   int
+		resetAt time.Time
+	}{
+		{"hour", limits.Hourly, now.Truncate(time.Hour).Add(time.Hour)},
+		{"day", limits.Daily, time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	var result QuotaResult
+	applied := false
+	for _, w := range windows {
+		if w.limit <= 0 {
+			continue
+		}
+
+		key := scope + ":" + principal + ":" + w.name + ":" + w.resetAt.Format(time.RFC3339)
+		count, err := store.Incr(key, w.resetAt)
+		if err != nil {
+			continue
+		}
+
+		remaining := w.limit - int(count)
+		if remaining < 0 {
+			remaining = 0
+		}
+		exceeded := int(count) > w.limit
+
+		// Report the window that is exceeded, or otherwise the one closest to running out
+		if !applied || (exceeded && !result.Exceeded) || (exceeded == result.Exceeded && remaining < result.Remaining) {
+			result = QuotaResult{Limit: w.limit, Remaining: remaining, ResetAt: w.resetAt, Exceeded: exceeded}
+		}
+		applied = true
+	}
+	return result, applied
+}
+
+// memoryQuotaStore keeps counters in process memory
+type memoryQuotaStore struct {
+	mu       sync.Mutex
+	counters map[string]*quotaCounter
+	now      func() time.Time
+}
+
+type quotaCounter struct {
+	count   int64
+	resetAt time.Time
+}
+
+func newMemoryQuotaStore(now func() time.Time) *memoryQuotaStore {
+	return &memoryQuotaStore{counters: make(map[string]*quotaCounter), now: now}
+}
+
+// Incr increments the counter and drops counters whose window has passed
+func (s *memoryQuotaStore) Incr(key string, resetAt time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for k, c := range s.counters {
+		if !c.resetAt.After(now) {
+			delete(s.counters, k)
+		}
+	}
+
+	c, ok := s.counters[key]
+	if !ok {
+		c = &quotaCounter{resetAt: resetAt}
+		s.counters[key] = c
+	}
+	c.count++
+	return c.count, nil
+}
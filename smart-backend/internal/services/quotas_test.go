@@ -0,0 +1,72 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingQuotaStore struct{}
+
+func (failingQuotaStore) Incr(string, time.Time) (int64, error) {
+	return 0, errors.New("store unavailable")
+}
+
+func TestQuotas_UnlimitedWhenNoLimits(t *testing.T) {
+	quotas := NewQuotas(nil)
+
+	_, applied := quotas.Consume("admin", "admin:1", QuotaLimits{})
+	assert.False(t, applied)
+}
+
+func TestQuotas_HourlyLimit(t *testing.T) {
+	quotas := NewQuotas(nil)
+	quotas.now = func() time.Time { return time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC) }
+	limits := QuotaLimits{Hourly: 2, Daily: 100}
+
+	result, applied := quotas.Consume("admin", "admin:1", limits)
+	assert.True(t, applied)
+	assert.Equal(t, 2, result.Limit)
+	assert.Equal(t, 1, result.Remaining)
+	assert.Equal(t, time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC), result.ResetAt)
+
+	result, _ = quotas.Consume("admin", "admin:1", limits)
+	assert.False(t, result.Exceeded)
+	result, _ = quotas.Consume("admin", "admin:1", limits)
+	assert.True(t, result.Exceeded)
+	assert.Equal(t, 0, result.Remaining)
+
+	// Other principals and scopes are counted separately
+	result, _ = quotas.Consume("admin", "admin:2", limits)
+	assert.False(t, result.Exceeded)
+	result, _ = quotas.Consume("export", "admin:1", limits)
+	assert.False(t, result.Exceeded)
+
+	// The next hour starts a fresh window
+	quotas.now = func() time.Time { return time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC) }
+	result, _ = quotas.Consume("admin", "admin:1", limits)
+	assert.False(t, result.Exceeded)
+	assert.Equal(t, 1, result.Remaining)
+}
+
+func TestQuotas_DailyLimitReportedWhenTighter(t *testing.T) {
+	quotas := NewQuotas(nil)
+	quotas.now = func() time.Time { return time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC) }
+
+	result, _ := quotas.Consume("export", "admin:1", QuotaLimits{Hourly: 10, Daily: 1})
+	assert.Equal(t, 1, result.Limit)
+	assert.Equal(t, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), result.ResetAt)
+
+	result, _ = quotas.Consume("export", "admin:1", QuotaLimits{Hourly: 10, Daily: 1})
+	assert.True(t, result.Exceeded)
+	assert.Equal(t, 1, result.Limit)
+}
+
+func TestQuotas_StoreErrorsFailOpen(t *testing.T) {
+	quotas := NewQuotas(failingQuotaStore{})
+
+	_, applied := quotas.Consume("admin", "admin:1", QuotaLimits{Hourly: 1})
+	assert.False(t, applied)
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitQueueTimeout is returned when a request waited too long for a provider slot
+var ErrRateLimitQueueTimeout = errors.New("timed out waiting for third-party rate limit slot")
+
+// limiterWaiter is a request queued for a token
+type limiterWaiter struct {
+	ready     chan struct{}
+	cancelled bool
+}
+
+// FairLimiter is a token-bucket rate limiter that queues requests once the bucket is empty
+// and hands out tokens round-robin across keys (users), so one busy user cannot starve others.
+type FairLimiter struct {
+	mu           sync.Mutex
+	rate         float64 // Tokens added per second
+	burst        float64
+	tokens       float64
+	lastRefill   time.Time
+	queueTimeout time.Duration
+
+	queues      map[string][]*limiterWaiter
+	order       []string // Keys with queued requests, in round-robin order
+	dispatching bool
+}
+
+var (
+	thirdPartyLimiter     *FairLimiter
+	thirdPartyLimiterOnce sync.Once
+)
+
+// NewFairLimiter creates a limiter allowing rate requests per second with the given burst.
+// A rate <= 0 disables limiting.
+func NewFairLimiter(rate, burst int, queueTimeout time.Duration) *FairLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &FairLimiter{
+		rate:         float64(rate),
+		burst:        float64(burst),
+		tokens:       float64(burst),
+		lastRefill:   time.Now(),
+		queueTimeout: queueTimeout,
+		queues:       make(map[string][]*limiterWaiter),
+	}
+}
+
+// ThirdPartyLimiter returns the process-wide limiter for third-party API requests
+func ThirdPartyLimiter() *FairLimiter {
+	thirdPartyLimiterOnce.Do(func() {
+		cfg := config.AppConfig().ThirdParty
+		thirdPartyLimiter = NewFairLimiter(cfg.RateLimit, cfg.Burst, cfg.QueueTimeout)
+		config.OnReload(func(cfg *config.Config) {
+			thirdPartyLimiter.SetRate(cfg.ThirdParty.RateLimit, cfg.ThirdParty.Burst, cfg.ThirdParty.QueueTimeout)
+		})
+	})
+	return thirdPartyLimiter
+}
+
+// SetRate changes the limits of a running limiter. Queued requests keep their place;
+// disabling the limit (rate <= 0) releases them immediately.
+func (l *FairLimiter) SetRate(rate, burst int, queueTimeout time.Duration) {
+	if burst < 1 {
+		burst = 1
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	l.rate = float64(rate)
+	l.burst = float64(burst)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.queueTimeout = queueTimeout
+}
+
+// Wait blocks until a token is available for key, the queue timeout expires or ctx ends
+func (l *FairLimiter) Wait(ctx context.Context, key string) error {
+	l.mu.Lock()
+	if l.rate <= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	l.refill()
+	if len(l.order) == 0 && l.tokens >= 1 {
+		l.tokens--
+		l.mu.Unlock()
+		return nil
+	}
+
+	w := &limiterWaiter{ready: make(chan struct{})}
+	if len(l.queues[key]) == 0 {
+		l.order = append(l.order, key)
+	}
+	l.queues[key] = append(l.queues[key], w)
+	if !l.dispatching {
+		l.dispatching = true
+		go l.dispatch()
+	}
+	queueTimeout := l.queueTimeout
+	l.mu.Unlock()
+
+	var timeout <-chan time.Time
+	if queueTimeout > 0 {
+		timer := time.NewTimer(queueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case <-w.ready:
+		return nil
+	case <-timeout:
+		err = ErrRateLimitQueueTimeout
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	select {
+	case <-w.ready:
+		// Token was granted while we were giving up
+		return nil
+	default:
+	}
+	w.cancelled = true
+	if err == ErrRateLimitQueueTimeout {
+		slog.WarnContext(ctx, "[RATE_LIMIT] Request timed out in queue", "key", key, "queue_timeout", queueTimeout)
+	}
+	return err
+}
+
+// dispatch grants tokens to queued requests, one key at a time, until the queues are empty
+func (l *FairLimiter) dispatch() {
+	for {
+		l.mu.Lock()
+		l.refill()
+		if l.rate <= 0 {
+			// Limiting was disabled by a config reload
+			for len(l.order) > 0 {
+				l.grantNext()
+			}
+		}
+		for l.tokens >= 1 && len(l.order) > 0 {
+			if l.grantNext() {
+				l.tokens--
+			}
+		}
+		if len(l.order) == 0 {
+			l.dispatching = false
+			l.mu.Unlock()
+			return
+		}
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		time.Sleep(wait)
+	}
+}
+
+// grantNext releases the oldest waiter of the next key in rotation.
+// It reports whether a token was consumed (cancelled waiters are skipped).
+func (l *FairLimiter) grantNext() bool {
+	key := l.order[0]
+	l.order = l.order[1:]
+
+	queue := l.queues[key]
+	w := queue[0]
+	queue = queue[1:]
+	if len(queue) > 0 {
+		l.queues[key] = queue
+		l.order = append(l.order, key)
+	} else {
+		delete(l.queues, key)
+	}
+
+	if w.cancelled {
+		return false
+	}
+	close(w.ready)
+	return true
+}
+
+// refill adds the tokens accumulated since the last refill
+func (l *FairLimiter) refill() {
+	now := time.Now()
+	l.tokens += now.Sub(l.lastRefill).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.lastRefill = now
+}
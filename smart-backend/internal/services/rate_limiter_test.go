@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFairLimiter_UnlimitedWhenRateIsZero(t *testing.T) {
+	limiter := NewFairLimiter(0, 1, time.Millisecond)
+
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, limiter.Wait(context.Background(), "+77771234567"))
+	}
+}
+
+func TestFairLimiter_QueuesInsteadOfFailing(t *testing.T) {
+	limiter := NewFairLimiter(50, 2, time.Second)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, limiter.Wait(context.Background(), "+77771234567"))
+	}
+
+	// 2 burst tokens, then 3 more at 50/s
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestFairLimiter_QueueTimeout(t *testing.T) {
+	limiter := NewFairLimiter(1, 1, 20*time.Millisecond)
+
+	assert.NoError(t, limiter.Wait(context.Background(), "+77771234567"))
+	assert.ErrorIs(t, limiter.Wait(context.Background(), "+77771234567"), ErrRateLimitQueueTimeout)
+}
+
+func TestFairLimiter_CancelledWhileQueued(t *testing.T) {
+	limiter := NewFairLimiter(1, 1, 5*time.Second)
+	assert.NoError(t, limiter.Wait(context.Background(), "+77771234567"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.ErrorIs(t, limiter.Wait(ctx, "+77771234567"), context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestFairLimiter_RoundRobinAcrossUsers(t *testing.T) {
+	limiter := NewFairLimiter(20, 1, 5*time.Second)
+	assert.NoError(t, limiter.Wait(context.Background(), "busy"))
+
+	var mu sync.Mutex
+	var order []string
+	var wg sync.WaitGroup
+	enqueue := func(key string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, limiter.Wait(context.Background(), key))
+			mu.Lock()
+			order = append(order, key)
+			mu.Unlock()
+		}()
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// The busy user queues several requests before the quiet user arrives
+	for i := 0; i < 4; i++ {
+		enqueue("busy")
+	}
+	enqueue("quiet")
+	wg.Wait()
+
+	assert.Len(t, order, 5)
+	assert.Contains(t, order[:2], "quiet")
+}
+
+func TestFairLimiter_SetRateReleasesQueueWhenDisabled(t *testing.T) {
+	limiter := NewFairLimiter(1, 1, 5*time.Second)
+	assert.NoError(t, limiter.Wait(context.Background(), "+77771234567"))
+
+	done := make(chan error, 1)
+	go func() { done <- limiter.Wait(context.Background(), "+77771234567") }()
+	time.Sleep(20 * time.Millisecond)
+
+	limiter.SetRate(0, 1, time.Second)
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("queued request was not released after disabling the limit")
+	}
+	assert.NoError(t, limiter.Wait(context.Background(), "+77771234567"))
+}
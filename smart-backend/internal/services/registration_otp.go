@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/pii"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// registrationOTPSMS is the text message carrying a registration code
+const registrationOTPSMS = "Your Ololo Gate registration code is %s. It expires in %d minutes. Do not share it with anyone."
+
+// CheckRegistrationOTPRateLimits returns an *OTPRateLimitError if phone or ip requested too many
+// registration codes, so Register can refuse before creating the user
+func CheckRegistrationOTPRateLimits(phone, ip string) error {
+	return checkOTPRateLimits(models.OTPPurposeRegistration, pii.BlindIndex(phone), ip, time.Now())
+}
+
+// SendRegistrationOTP creates a registration code for phone (canonical E.164) and texts it to the
+// number if it belongs to a user waiting for confirmation. As for login codes, codes are also
+// created, but not sent, for other numbers, so resending does not reveal which numbers registered.
+// Sending a code invalidates the previous one. Returns an *OTPRateLimitError if phone or ip
+// requested too many codes.
+func SendRegistrationOTP(ctx context.Context, phone, ip string) error {
+	user, err := findUnverifiedUser(phone)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	found := err == nil
+
+	var userID *uuid.UUID
+	if found {
+		userID = &user.ID
+	}
+	code, err := issueOTPCode(ctx, models.OTPPurposeRegistration, phone, ip, userID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		slog.InfoContext(ctx, "[REGISTRATION_OTP] Code requested without a registration to confirm, not sent", "phone", phone)
+		return nil
+	}
+	return SendSMS(ctx, phone, fmt.Sprintf(registrationOTPSMS, code, otpTTLMinutes()))
+}
+
+// VerifyRegistrationOTP checks a registration code for phone and activates the user waiting for it:
+// the registration becomes approved, or pending when it needs an admin's approval
+// (USER_REGISTRATION_APPROVAL without an invite code). A code can be used once; after too many
+// wrong guesses it is invalidated. Returns ErrOTPInvalid if the code is wrong, expired or used, or
+// the number has no registration to confirm.
+func VerifyRegistrationOTP(ctx context.Context, phone, code string) (models.User, error) {
+	otp, err := checkOTPCode(ctx, models.OTPPurposeRegistration, phone, code)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	user, err := findUnverifiedUser(phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && (otp.UserID == nil || *otp.UserID != user.ID)) {
+		return models.User{}, ErrOTPInvalid
+	}
+	if err != nil {
+		return models.User{}, err
+	}
+
+	status := models.RegistrationApproved
+	if user.InviteCodeID == nil && config.AppConfig().Users.RegistrationApproval {
+		status = models.RegistrationPending
+	}
+
+	err = db.DB.Transaction(func(tx *gorm.DB) error {
+		// Conditional updates: the same code cannot confirm a registration twice when used concurrently
+		if err := consumeOTPCode(tx, otp.ID); err != nil {
+			return err
+		}
+		activated := tx.Model(&models.User{}).
+			Where("id = ? AND registration_status = ?", user.ID, models.RegistrationUnverified).
+			Update("registration_status", status)
+		if activated.Error != nil {
+			return activated.Error
+		}
+		if activated.RowsAffected == 0 {
+			return ErrOTPInvalid
+		}
+		return nil
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+
+	user.RegistrationStatus = status
+	TokenVersions().InvalidateUser(user.ID)
+	RecordUserHistory(user.ID, models.UserHistoryPhoneVerified, HistoryActorSelf,
+		FieldChanges{}.Set("registration_status", models.RegistrationUnverified, status))
+	slog.InfoContext(ctx, "[REGISTRATION_OTP] Phone confirmed", "user_id", user.ID, "registration", status)
+	return user, nil
+}
+
+// ReleaseUnverifiedPhone deletes the registration holding phone if it was never confirmed and its
+// last code has expired, so the number can register again. The invite code use it took is given
+// back. Registrations with a code still valid are kept.
+func ReleaseUnverifiedPhone(ctx context.Context, phone string) error {
+	user, err := findUnverifiedUser(phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var live int64
+	if err := db.DB.Model(&models.OTPCode{}).
+		Where("purpose = ? AND phone_index = ? AND consumed_at IS NULL AND expires_at > ?",
+			models.OTPPurposeRegistration, pii.BlindIndex(phone), time.Now()).
+		Count(&live).Error; err != nil {
+		return err
+	}
+	if live > 0 {
+		return nil
+	}
+
+	err = db.DB.Transaction(func(tx *gorm.DB) error {
+		if user.InviteCodeID != nil {
+			if err := tx.Model(&models.InviteCode{}).
+				Where("id = ? AND uses > 0", *user.InviteCodeID).
+				Update("uses", gorm.Expr("uses - 1")).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.OTPCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&user).Error
+	})
+	if err == nil {
+		slog.InfoContext(ctx, "[REGISTRATION_OTP] Released phone from unconfirmed registration", "phone", phone, "user_id", user.ID)
+	}
+	return err
+}
+
+// findUnverifiedUser returns the user whose registration with phone waits for confirmation
+func findUnverifiedUser(phone string) (models.User, error) {
+	user, err := FindUserByPhone(phone)
+	if err == nil && user.RegistrationStatus != models.RegistrationUnverified {
+		return models.User{}, gorm.ErrRecordNotFound
+	}
+	return user, err
+}
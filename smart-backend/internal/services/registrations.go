@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+)
+
+// welcomeSMS is sent to users when their registration is approved
+const welcomeSMS = "Welcome to Ololo Gate! Your registration has been approved, you can now log in with your phone number."
+
+// ErrRegistrationReviewed is returned when a registration is no longer in a state the review applies to
+var ErrRegistrationReviewed = errors.New("registration was already reviewed")
+
+// ApproveRegistration approves a pending or rejected registration, so the user can log in
+func ApproveRegistration(user *models.User, reviewedBy string) error {
+	return reviewRegistration(user, []string{models.RegistrationPending, models.RegistrationRejected},
+		models.RegistrationApproved, reviewedBy, "")
+}
+
+// RejectRegistration rejects a pending registration and records the reason
+func RejectRegistration(user *models.User, reviewedBy, reason string) error {
+	return reviewRegistration(user, []string{models.RegistrationPending},
+		models.RegistrationRejected, reviewedBy, reason)
+}
+
+// reviewRegistration moves the registration from one of the from statuses to status. The
+// update is conditional, so two admins reviewing the same registration cannot both succeed.
+func reviewRegistration(user *models.User, from []string, status, reviewedBy, reason string) error {
+	now := time.Now()
+	result := db.DB.Model(&models.User{}).
+		Where("id = ? AND registration_status IN ?", user.ID, from).
+		Updates(map[string]interface{}{
+			"registration_status": status,
+			"reviewed_by":         reviewedBy,
+			"reviewed_at":         now,
+			"rejection_reason":    reason,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRegistrationReviewed
+	}
+	TokenVersions().InvalidateUser(user.ID)
+
+	before := SnapshotUser(*user)
+	user.RegistrationStatus = status
+	user.ReviewedBy = reviewedBy
+	user.ReviewedAt = &now
+	user.RejectionReason = reason
+
+	action := models.UserHistoryApproved
+	if status == models.RegistrationRejected {
+		action = models.UserHistoryRejected
+	}
+	changes := FieldChanges{}.DiffUser(before, SnapshotUser(*user))
+	if reason != "" {
+		changes.Set("rejection_reason", nil, reason)
+	}
+	RecordUserHistory(user.ID, action, reviewedBy, changes)
+	return nil
+}
+
+// SendWelcomeSMS tells the user their registration was approved
+func SendWelcomeSMS(ctx context.Context, user models.User) error {
+	return SendSMS(ctx, user.Phone, welcomeSMS)
+}
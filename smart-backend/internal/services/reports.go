@@ -0,0 +1,339 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Canned operational reports
+const (
+	ReportDailyActiveUsers  = "daily_active_users"
+	ReportGateOpens         = "gate_opens"
+	ReportUserChurn         = "user_churn"
+	ReportProviderErrorRate = "provider_error_rate"
+)
+
+// ReportMaxDays is the longest date range a report covers
+const ReportMaxDays = 366
+
+const reportDayLayout = "2006-01-02"
+
+// ReportNames lists the available reports in display order
+var ReportNames = []string{ReportDailyActiveUsers, ReportGateOpens, ReportUserChurn, ReportProviderErrorRate}
+
+// Report is a computed report: one row per day (and location, for gate opens)
+type Report struct {
+	Name     string
+	From     string // First day covered, YYYY-MM-DD
+	To       string // Last day covered, inclusive
+	Timezone string // IANA zone the days are in
+	Columns  []string
+	Rows     [][]interface{}
+	Warning  string // Set when part of the data could not be loaded
+}
+
+// ReportRange is an inclusive range of days in a timezone. From and To are midnight of the first
+// and last day.
+type ReportRange struct {
+	From     time.Time
+	To       time.Time
+	Timezone string // IANA zone of the days; empty for UTC (exports queued before timezones)
+}
+
+// ParseReportRange parses from and to (YYYY-MM-DD, inclusive) as days in loc. Empty values default
+// to the 30 days ending today in loc.
+func ParseReportRange(from, to string, now time.Time, loc *time.Location) (ReportRange, error) {
+	local := now.In(loc)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	r := ReportRange{From: today.AddDate(0, 0, -29), To: today, Timezone: loc.String()}
+
+	if to != "" {
+		t, err := time.ParseInLocation(reportDayLayout, to, loc)
+		if err != nil {
+			return r, fmt.Errorf("invalid to date, use YYYY-MM-DD")
+		}
+		r.To = t
+		if from == "" {
+			r.From = t.AddDate(0, 0, -29)
+		}
+	}
+	if from != "" {
+		f, err := time.ParseInLocation(reportDayLayout, from, loc)
+		if err != nil {
+			return r, fmt.Errorf("invalid from date, use YYYY-MM-DD")
+		}
+		r.From = f
+	}
+
+	if r.From.After(r.To) {
+		return r, fmt.Errorf("from must not be after to")
+	}
+	if len(r.days()) > ReportMaxDays {
+		return r, fmt.Errorf("date range must not exceed %d days", ReportMaxDays)
+	}
+	return r, nil
+}
+
+// location is the zone of the range's days. Ranges decoded from JSON (queued exports) only keep
+// the UTC offset of From and To, so the zone is loaded again by name.
+func (r ReportRange) location() *time.Location {
+	if loc, err := LoadTimezone(r.Timezone); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// days lists every day in the range
+func (r ReportRange) days() []string {
+	loc := r.location()
+	var days []string
+	for d := r.From.In(loc); !d.After(r.To); d = d.AddDate(0, 0, 1) {
+		days = append(days, d.Format(reportDayLayout))
+	}
+	return days
+}
+
+// start is the first instant of the range
+func (r ReportRange) start() time.Time {
+	return r.From.In(r.location())
+}
+
+// end is the first instant after the range
+func (r ReportRange) end() time.Time {
+	return r.To.In(r.location()).AddDate(0, 0, 1)
+}
+
+// dayOf returns the day of the range's zone t falls on
+func (r ReportRange) dayOf(t time.Time) string {
+	return t.In(r.location()).Format(reportDayLayout)
+}
+
+// RunReport computes the named report for the range. client is only used to map gates to locations.
+func RunReport(name string, r ReportRange, client *ThirdPartyClient) (*Report, error) {
+	report := &Report{Name: name, From: r.dayOf(r.From), To: r.dayOf(r.To), Timezone: r.location().String()}
+
+	var err error
+	switch name {
+	case ReportDailyActiveUsers:
+		err = dailyActiveUsersReport(report, r)
+	case ReportGateOpens:
+		err = gateOpensReport(report, r, client)
+	case ReportUserChurn:
+		err = userChurnReport(report, r)
+	case ReportProviderErrorRate:
+		err = providerErrorRateReport(report, r)
+	default:
+		return nil, fmt.Errorf("unknown report %q", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// dailyActiveUsersReport counts the distinct users active in this organization each day. Active
+// users are metered per UTC day, so this report keeps UTC days whatever the requested timezone.
+func dailyActiveUsersReport(report *Report, r ReportRange) error {
+	report.Timezone = time.UTC.String()
+	var rows []struct {
+		Day   string
+		Total int64
+	}
+	if err := db.DB.Model(&models.UsageDailyActiveUser{}).
+		Select("day, COUNT(*) AS total").
+		Where("org_id = ? AND day >= ? AND day <= ?", config.AppConfig().Metering.OrgID, report.From, report.To).
+		Group("day").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	byDay := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		byDay[row.Day] = row.Total
+	}
+
+	report.Columns = []string{"day", "active_users"}
+	for _, day := range r.days() {
+		report.Rows = append(report.Rows, []interface{}{day, byDay[day]})
+	}
+	return nil
+}
+
+// gateOpensReport counts confirmed open commands per location per day from the hourly gate event rollups.
+// Events only store the gate, so gates are mapped to locations with the cached provider catalog.
+func gateOpensReport(report *Report, r ReportRange, client *ThirdPartyClient) error {
+	// Include events since the last scheduled rollup
+	if _, err := RollupGateEvents(); err != nil {
+		return err
+	}
+
+	var rollups []models.GateEventRollup
+	if err := db.DB.
+		Where("action = ? AND status = ? AND hour >= ? AND hour < ?", GateActionOpen, models.GateCommandConfirmed, r.start(), r.end()).
+		Find(&rollups).Error; err != nil {
+		return err
+	}
+
+	type locationInfo struct {
+		id    int
+		title string
+	}
+	gateLocations := make(map[int]locationInfo)
+	locations, err := Locations().All(client)
+	if err != nil && locations == nil {
+		report.Warning = "Locations could not be loaded, gate opens are not attributed to locations: " + err.Error()
+	}
+	for _, location := range locations {
+		for _, gate := range location.Gates {
+			gateLocations[gate.ID] = locationInfo{id: location.ID, title: location.Title}
+		}
+	}
+
+	type key struct {
+		day      string
+		location locationInfo
+	}
+	counts := make(map[key]int64)
+	for _, rollup := range rollups {
+		location, ok := gateLocations[rollup.GateID]
+		if !ok {
+			location = locationInfo{title: "unknown"} // Gate no longer listed by the provider
+		}
+		counts[key{day: r.dayOf(rollup.Hour), location: location}] += rollup.Count
+	}
+
+	keys := make([]key, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].day != keys[j].day {
+			return keys[i].day < keys[j].day
+		}
+		return keys[i].location.id < keys[j].location.id
+	})
+
+	report.Columns = []string{"day", "location_id", "location_title", "gate_opens"}
+	for _, k := range keys {
+		report.Rows = append(report.Rows, []interface{}{k.day, k.location.id, k.location.title, counts[k]})
+	}
+	return nil
+}
+
+// userChurnReport counts users registered and users moved to the trash each day
+func userChurnReport(report *Report, r ReportRange) error {
+	var created []time.Time
+	if err := db.DB.Unscoped().Model(&models.User{}).
+		Where("created_at >= ? AND created_at < ?", r.start(), r.end()).
+		Pluck("created_at", &created).Error; err != nil {
+		return err
+	}
+	var trashed []time.Time
+	if err := db.DB.Unscoped().Model(&models.User{}).
+		Where("trashed_at >= ? AND trashed_at < ?", r.start(), r.end()).
+		Pluck("trashed_at", &trashed).Error; err != nil {
+		return err
+	}
+
+	newByDay := make(map[string]int64)
+	for _, t := range created {
+		newByDay[r.dayOf(t)]++
+	}
+	churnedByDay := make(map[string]int64)
+	for _, t := range trashed {
+		churnedByDay[r.dayOf(t)]++
+	}
+
+	report.Columns = []string{"day", "new_users", "churned_users", "net_change"}
+	for _, day := range r.days() {
+		report.Rows = append(report.Rows, []interface{}{day, newByDay[day], churnedByDay[day], newByDay[day] - churnedByDay[day]})
+	}
+	return nil
+}
+
+// providerErrorRateReport reports third-party API calls, failures and the failed share each day.
+// Usage is metered per UTC day, so this report keeps UTC days whatever the requested timezone.
+func providerErrorRateReport(report *Report, r ReportRange) error {
+	report.Timezone = time.UTC.String()
+	var rows []struct {
+		Day    string
+		Metric string
+		Value  int64
+	}
+	if err := db.DB.Model(&models.UsageCounter{}).
+		Select("day, metric, value").
+		Where("org_id = ? AND metric IN ? AND day >= ? AND day <= ?", config.AppConfig().Metering.OrgID,
+			[]string{models.UsageProviderCalls, models.UsageProviderErrors}, report.From, report.To).
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	calls := make(map[string]int64)
+	failures := make(map[string]int64)
+	for _, row := range rows {
+		if row.Metric == models.UsageProviderCalls {
+			calls[row.Day] = row.Value
+		} else {
+			failures[row.Day] = row.Value
+		}
+	}
+
+	report.Columns = []string{"day", "provider_calls", "provider_errors", "error_rate"}
+	for _, day := range r.days() {
+		rate := 0.0
+		if calls[day] > 0 {
+			rate = float64(failures[day]) / float64(calls[day])
+		}
+		report.Rows = append(report.Rows, []interface{}{day, calls[day], failures[day], rate})
+	}
+	return nil
+}
+
+// WriteCSV writes the report as CSV with a header row
+func (report *Report) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(report.Columns); err != nil {
+		return err
+	}
+	for _, row := range report.Rows {
+		record := make([]string, len(row))
+		for i, value := range row {
+			switch v := value.(type) {
+			case float64:
+				record[i] = strconv.FormatFloat(v, 'f', 4, 64)
+			default:
+				record[i] = fmt.Sprint(v)
+			}
+		}
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// WriteJSON writes the report as a JSON table, shaped like the data of GET /admin/reports
+func (report *Report) WriteJSON(w io.Writer) error {
+	rows := report.Rows
+	if rows == nil {
+		rows = [][]interface{}{}
+	}
+	return json.NewEncoder(w).Encode(map[string]interface{}{
+		"report":   report.Name,
+		"from":     report.From,
+		"to":       report.To,
+		"timezone": report.Timezone,
+		"columns":  report.Columns,
+		"rows":     rows,
+		"warning":  report.Warning,
+	})
+}
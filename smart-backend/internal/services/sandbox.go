@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"sync"
+	"time"
+)
+
+// sandboxOutboxSize is the number of simulated text messages kept for GET /admin/sandbox/sms
+const sandboxOutboxSize = 100
+
+// SandboxEnabled reports whether the instance runs in sandbox mode (SANDBOX_MODE): gate commands
+// and text messages are simulated and responses are marked
+func SandboxEnabled() bool {
+	return config.AppConfig() != nil && config.AppConfig().Sandbox.Enabled
+}
+
+// GateSimulator stands in for the provider's gate commands in sandbox mode. It remembers the
+// position each commanded gate was moved to, so command confirmation sees the barrier move.
+type GateSimulator struct {
+	mu    sync.RWMutex
+	gates map[int]bool // Gate ID -> open
+}
+
+var gateSimulator = &GateSimulator{gates: map[int]bool{}}
+
+// SimulatedGates returns the process-wide gate simulator
+func SimulatedGates() *GateSimulator {
+	return gateSimulator
+}
+
+// Execute moves a simulated gate and accepts the command like the provider would
+func (s *GateSimulator) Execute(gateID int, open bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gates[gateID] = open
+	slog.Info("[SANDBOX] Simulated gate changed", "gate_id", gateID, "open", open)
+	return true
+}
+
+// Apply overrides the provider's position of a gate with the simulated one, for gates commanded
+// in sandbox mode
+func (s *GateSimulator) Apply(gate *GateResponse) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if open, ok := s.gates[gate.ID]; ok {
+		gate.IsOpen = open
+	}
+}
+
+// SandboxSMS is a text message captured by the sandbox SMS sender
+type SandboxSMS struct {
+	Phone   string
+	Message string
+	SentAt  time.Time
+}
+
+// SandboxSMSSender keeps text messages in memory instead of sending them, so integrators can read
+// the codes their test flows receive. Only the latest messages are kept.
+type SandboxSMSSender struct {
+	mu       sync.Mutex
+	messages []SandboxSMS
+}
+
+var sandboxSMSSender = &SandboxSMSSender{}
+
+// SandboxOutbox returns the process-wide sandbox SMS sender
+func SandboxOutbox() *SandboxSMSSender {
+	return sandboxSMSSender
+}
+
+// Send captures the message
+func (s *SandboxSMSSender) Send(ctx context.Context, phone, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, SandboxSMS{Phone: phone, Message: message, SentAt: time.Now()})
+	if len(s.messages) > sandboxOutboxSize {
+		s.messages = s.messages[len(s.messages)-sandboxOutboxSize:]
+	}
+	slog.InfoContext(ctx, "[SANDBOX] SMS captured, not sent", "phone", phone)
+	return nil
+}
+
+// Messages returns the captured messages, newest first, optionally only those sent to phone
+func (s *SandboxSMSSender) Messages(phone string) []SandboxSMS {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	messages := make([]SandboxSMS, 0, len(s.messages))
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if phone == "" || s.messages[i].Phone == phone {
+			messages = append(messages, s.messages[i])
+		}
+	}
+	return messages
+}
+
+// applySimulatedGates shows the simulated position of the gates commanded in sandbox mode
+func applySimulatedGates(locations []LocationResponse) {
+	if !SandboxEnabled() {
+		return
+	}
+	for i := range locations {
+		for j := range locations[i].Gates {
+			SimulatedGates().Apply(&locations[i].Gates[j])
+		}
+	}
+}
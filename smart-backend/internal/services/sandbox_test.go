@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"ololo-gate/internal/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGateSimulator_AppliesCommandedPosition(t *testing.T) {
+	simulator := &GateSimulator{gates: map[int]bool{}}
+	gate := GateResponse{ID: 3, IsOpen: false}
+
+	simulator.Apply(&gate)
+	assert.False(t, gate.IsOpen)
+
+	assert.True(t, simulator.Execute(3, true))
+	simulator.Apply(&gate)
+	assert.True(t, gate.IsOpen)
+
+	// Gates never commanded keep the provider's position
+	other := GateResponse{ID: 4, IsOpen: true}
+	simulator.Apply(&other)
+	assert.True(t, other.IsOpen)
+}
+
+func TestSandboxSMSSender_KeepsLatestMessages(t *testing.T) {
+	sender := &SandboxSMSSender{}
+	for i := 0; i < sandboxOutboxSize+5; i++ {
+		assert.NoError(t, sender.Send(context.Background(), "+77771234567", fmt.Sprintf("message %d", i)))
+	}
+	assert.NoError(t, sender.Send(context.Background(), "+77777654321", "other"))
+
+	all := sender.Messages("")
+	assert.Len(t, all, sandboxOutboxSize)
+	assert.Equal(t, "other", all[0].Message)
+	assert.Equal(t, fmt.Sprintf("message %d", sandboxOutboxSize+4), sender.Messages("+77771234567")[0].Message)
+}
+
+func TestConfiguredSMSSender_SandboxOutbox(t *testing.T) {
+	config.SetAppConfig(&config.Config{SMS: config.SMSConfig{GatewayURL: "http://sms.example"}})
+	_, gateway := configuredSMSSender().(*HTTPSMSSender)
+	assert.True(t, gateway)
+
+	config.AppConfig().Sandbox.Enabled = true
+	assert.Equal(t, SandboxOutbox(), configuredSMSSender())
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/scheduler"
+	"time"
+)
+
+// RegisterScheduledJobs registers the periodic background jobs on the process-wide scheduler
+func RegisterScheduledJobs() error {
+	s := scheduler.Default()
+
+	// Nightly purge of finished gate commands, raw gate events and gate attempts
+	if err := s.Register("gate_commands_retention", "0 3 * * *", 0, func(ctx context.Context) error {
+		cutoff := time.Now().Add(-config.AppConfig().Gates.CommandRetention)
+		purged, err := PurgeGateCommands(cutoff)
+		if err != nil {
+			return err
+		}
+		slog.Info("[RETENTION] Purged gate commands", "count", purged, "completed_before", cutoff.Format(time.RFC3339))
+
+		purged, err = PurgeGateEvents(cutoff)
+		if err != nil {
+			return err
+		}
+		slog.Info("[RETENTION] Purged raw gate events", "count", purged, "before", cutoff.Format(time.RFC3339))
+
+		purged, err = PurgeGateEventLogs(cutoff)
+		if err != nil {
+			return err
+		}
+		slog.Info("[RETENTION] Purged gate attempts", "count", purged, "before", cutoff.Format(time.RFC3339))
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// Hourly gate event rollups for analytics, refreshed every few minutes
+	if err := s.Register("gate_event_rollup", "*/5 * * * *", 0, func(ctx context.Context) error {
+		_, err := RollupGateEvents()
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Hourly purge of login, registration and password reset codes, kept for a day for rate limiting and auditing
+	if err := s.Register("login_otp_purge", "30 * * * *", 0, func(ctx context.Context) error {
+		purged, err := PurgeOTPCodes(time.Now().Add(-24 * time.Hour))
+		if purged > 0 {
+			slog.Info("[OTP] Purged one-time codes", "count", purged)
+		}
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Daily purge of sessions unused for JWT_STALE_DEVICE_AFTER (a dry run unless JWT_STALE_DEVICE_PURGE is on)
+	if err := s.Register(StaleDeviceJobName, "20 4 * * *", 0, RunStaleDevicePurge); err != nil {
+		return err
+	}
+
+	// Failed location/gate assignments, retried with backoff once they are due
+	if err := s.Register(AssignmentRetryJobName, "* * * * *", 0, RunPendingAssignments); err != nil {
+		return err
+	}
+
+	// Hourly purge of passkey challenges that were never answered
+	if err := s.Register("webauthn_challenge_purge", "40 * * * *", 0, func(ctx context.Context) error {
+		purged, err := PurgeWebAuthnChallenges(time.Now())
+		if purged > 0 {
+			slog.Info("[PASSKEY] Purged expired challenges", "count", purged)
+		}
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Provider calls against the monthly quotas, checked every 15 minutes
+	if err := s.Register(ProviderQuotaJobName, "*/15 * * * *", 0, func(ctx context.Context) error {
+		_, err := CheckProviderQuotas(time.Now())
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Daily purge of provider migration comparisons older than a month
+	if err := s.Register("provider_mirror_purge", "45 3 * * *", 0, func(ctx context.Context) error {
+		purged, err := PurgeProviderMirrorResults(time.Now().Add(-30 * 24 * time.Hour))
+		if purged > 0 {
+			slog.Info("[PROVIDER_MIRROR] Purged mirror results", "count", purged)
+		}
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Exports requested through POST /admin/exports, started right away on request and
+	// every minute in case a request found the job busy
+	if err := s.Register(ExportJobName, "* * * * *", ExportJobTimeout, RunPendingExports); err != nil {
+		return err
+	}
+
+	// Forced logouts requested through POST /admin/forced-logouts, started right away on
+	// request and every minute to resume one that was interrupted
+	if err := s.Register(ForcedLogoutJobName, "* * * * *", ForcedLogoutJobTimeout, RunPendingForcedLogouts); err != nil {
+		return err
+	}
+
+	// Weekly SMS digest to users who stopped opening gates (Mondays at 10:00)
+	if err := s.Register(DigestJobName, "0 10 * * 1", 0, RunInactiveUserDigest); err != nil {
+		return err
+	}
+
+	// Hourly purge of finished exports past EXPORT_RETENTION
+	if err := s.Register("exports_purge", "15 * * * *", 0, func(ctx context.Context) error {
+		purged, err := PurgeExports(time.Now().Add(-config.AppConfig().Exports.Retention))
+		if purged > 0 {
+			slog.Info("[EXPORTS] Purged expired exports", "count", purged)
+		}
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Daily purge of security denials past SECURITY_DENIAL_RETENTION
+	if err := s.Register("security_denials_purge", "50 3 * * *", 0, func(ctx context.Context) error {
+		purged, err := PurgeSecurityDenials(time.Now().Add(-config.AppConfig().Security.DenialRetention))
+		if purged > 0 {
+			slog.Info("[SECURITY] Purged security denials", "count", purged)
+		}
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Hourly removal of users whose trash window has expired
+	return s.Register("user_trash_purge", "0 * * * *", 0, func(ctx context.Context) error {
+		cutoff := time.Now().Add(-config.AppConfig().Users.TrashRetention)
+		purged, err := PurgeTrashedUsers(NewThirdPartyClient(), cutoff)
+		if purged > 0 {
+			slog.Info("[USER_TRASH] Purged trashed users", "count", purged, "trashed_before", cutoff.Format(time.RFC3339))
+		}
+		return err
+	})
+}
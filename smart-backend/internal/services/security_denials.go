@@ -0,0 +1,24 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+	"time"
+)
+
+// RecordSecurityDenial stores a request refused with 403 and counts it in
+// security_denials_total. Failures are logged; the refusal itself is not affected.
+func RecordSecurityDenial(denial *models.SecurityDenial) {
+	metrics.IncCounter("security_denials_total", metrics.Labels{"reason": denial.Reason, "actor_type": denial.ActorType})
+	if err := db.DB.Create(denial).Error; err != nil {
+		slog.Error("[SECURITY] Failed to record denial", "method", denial.Method, "path", denial.Path, "reason", denial.Reason, "error", err)
+	}
+}
+
+// PurgeSecurityDenials deletes security denials recorded before the cutoff
+func PurgeSecurityDenials(cutoff time.Time) (int64, error) {
+	result := db.DB.Where("created_at < ?", cutoff).Delete(&models.SecurityDenial{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrSessionRevoked is returned when a token belongs to a session that was logged out, revoked or expired
+var ErrSessionRevoked = errors.New("session has been revoked")
+
+// ErrDeviceMismatch is returned when a device-bound token is presented from a different device
+var ErrDeviceMismatch = errors.New("token was issued to a different device")
+
+// CreateSession starts a new device session for the user. An earlier session on the same
+// device is revoked, so re-logging in on a device replaces its session instead of piling up.
+// trusted records that the user chose "remember me", so the session is long-lived.
+func CreateSession(ctx context.Context, userID uuid.UUID, deviceID, ipAddress, userAgent string, expiry time.Duration, trusted bool) (*models.UserSession, error) {
+	now := time.Now()
+
+	if deviceID != "" {
+		result := db.DB.Model(&models.UserSession{}).
+			Where("user_id = ? AND device_id = ? AND revoked_at IS NULL", userID, deviceID).
+			Update("revoked_at", now)
+		if result.RowsAffected > 0 {
+			TokenVersions().InvalidateUser(userID)
+			slog.InfoContext(ctx, "[SESSION] Replaced previous sessions", "count", result.RowsAffected, "user_id", userID, "device_id", deviceID)
+		}
+	}
+
+	session := &models.UserSession{
+		UserID:     userID,
+		DeviceID:   deviceID,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(expiry),
+		Trusted:    trusted,
+	}
+	if err := db.DB.Create(session).Error; err != nil {
+		slog.ErrorContext(ctx, "[SESSION] Failed to create session", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return session, nil
+}
+
+// ValidateSession checks that the session exists, belongs to the user and is still active
+func ValidateSession(sessionID, userID uuid.UUID) (*models.UserSession, error) {
+	var session models.UserSession
+	if err := db.DB.Where("id = ? AND user_id = ?", sessionID, userID).First(&session).Error; err != nil {
+		return nil, ErrSessionRevoked
+	}
+	if !session.IsActive() {
+		return nil, ErrSessionRevoked
+	}
+	return &session, nil
+}
+
+// VerifySessionDevice checks that a device-bound token is used from the device it was issued to.
+// The claimed device must match the session's device, and the device reported by the request
+// (X-Device-ID) must match the claim. A missing request device is rejected too, unless
+// JWT_REQUIRE_DEVICE_HEADER was turned off while older clients are rolled out.
+func VerifySessionDevice(session *models.UserSession, claimedDeviceID, requestDeviceID string) error {
+	if claimedDeviceID == "" {
+		return nil
+	}
+	if session != nil && session.DeviceID != claimedDeviceID {
+		return ErrDeviceMismatch
+	}
+	if requestDeviceID == "" {
+		if config.AppConfig().JWT.RequireDevice {
+			return ErrDeviceMismatch
+		}
+		return nil
+	}
+	if requestDeviceID != claimedDeviceID {
+		return ErrDeviceMismatch
+	}
+	return nil
+}
+
+// TouchSession records that the session was just used (e.g. a token refresh)
+func TouchSession(sessionID uuid.UUID) {
+	db.DB.Model(&models.UserSession{}).Where("id = ?", sessionID).Update("last_used_at", time.Now())
+}
+
+// RevokeSession logs out a single session of the user. Returns false if no active session matched.
+func RevokeSession(sessionID, userID uuid.UUID) (bool, error) {
+	result := db.DB.Model(&models.UserSession{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		Update("revoked_at", time.Now())
+	if result.RowsAffected > 0 {
+		TokenVersions().InvalidateUser(userID)
+	}
+	return result.RowsAffected > 0, result.Error
+}
+
+// RevokeAllSessions logs out every session of the user (password change, account deletion, ...)
+func RevokeAllSessions(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result := db.DB.Model(&models.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now())
+	// Invalidated even when no session was active: every caller has just bumped the token
+	// version or deleted the user
+	TokenVersions().InvalidateUser(userID)
+	if result.RowsAffected > 0 {
+		slog.InfoContext(ctx, "[SESSION] Revoked sessions", "count", result.RowsAffected, "user_id", userID)
+	}
+	return result.RowsAffected, result.Error
+}
+
+// ActiveSessions lists the user's sessions that can still be used, most recently used first
+func ActiveSessions(userID uuid.UUID) ([]models.UserSession, error) {
+	var sessions []models.UserSession
+	err := db.DB.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// SessionLimit returns how many concurrent sessions the user may have: their own limit when an
+// admin set one, JWT_MAX_SESSIONS otherwise. 0 means unlimited.
+func SessionLimit(user models.User) int {
+	if user.MaxSessions != nil {
+		return *user.MaxSessions
+	}
+	return config.AppConfig().JWT.MaxSessions
+}
+
+// EnforceSessionLimit logs out the user's oldest active sessions past their session limit,
+// never the session kept (the one just created), and records each eviction in the user's
+// history. Failures are logged, so a login is never refused for them.
+func EnforceSessionLimit(ctx context.Context, user models.User, keep uuid.UUID) []models.UserSession {
+	limit := SessionLimit(user)
+	if limit <= 0 {
+		return nil
+	}
+
+	var sessions []models.UserSession
+	if err := db.DB.Where("user_id = ? AND id <> ? AND revoked_at IS NULL AND expires_at > ?", user.ID, keep, time.Now()).
+		Order("created_at DESC").
+		Find(&sessions).Error; err != nil {
+		slog.ErrorContext(ctx, "[SESSION] Failed to load sessions to enforce the session limit", "user_id", user.ID, "error", err)
+		return nil
+	}
+	// The kept session counts towards the limit
+	if len(sessions) < limit {
+		return nil
+	}
+
+	var evicted []models.UserSession
+	for _, session := range sessions[limit-1:] {
+		revoked, err := RevokeSession(session.ID, user.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "[SESSION] Failed to evict session", "session_id", session.ID, "user_id", user.ID, "error", err)
+			continue
+		}
+		if !revoked {
+			continue
+		}
+		evicted = append(evicted, session)
+		RecordUserHistory(user.ID, models.UserHistorySessionEvicted, "system", FieldChanges{}.
+			Set("session_id", session.ID, nil).
+			Set("device_id", emptyAsNil(session.DeviceID), nil).
+			Set("limit", nil, limit))
+	}
+	if len(evicted) > 0 {
+		slog.InfoContext(ctx, "[SESSION] Evicted sessions over the limit", "count", len(evicted), "user_id", user.ID, "limit", limit)
+	}
+	return evicted
+}
+
+// StaleDeviceJobName is the scheduled job that reports or deletes stale sessions
+const StaleDeviceJobName = "stale_device_purge"
+
+// StaleDeviceReport describes the sessions not used since a cutoff, as the stale device
+// purge would delete them
+type StaleDeviceReport struct {
+	Cutoff  time.Time
+	Total   int64 // Stale sessions
+	Active  int64 // Of which could still be used; deleting them logs the device out
+	Users   int64 // Users with at least one stale session
+	Devices []models.UserSession
+}
+
+// StaleDevices reports the sessions last used before cutoff, with the limit least recently
+// used ones
+func StaleDevices(cutoff time.Time, limit int) (StaleDeviceReport, error) {
+	report := StaleDeviceReport{Cutoff: cutoff, Devices: []models.UserSession{}}
+	stale := func() *gorm.DB {
+		return db.DB.Model(&models.UserSession{}).Where("last_used_at < ?", cutoff)
+	}
+	if err := stale().Count(&report.Total).Error; err != nil {
+		return report, err
+	}
+	if err := stale().Where("revoked_at IS NULL AND expires_at > ?", time.Now()).Count(&report.Active).Error; err != nil {
+		return report, err
+	}
+	if err := stale().Distinct("user_id").Count(&report.Users).Error; err != nil {
+		return report, err
+	}
+	if limit <= 0 {
+		return report, nil
+	}
+	err := stale().Order("last_used_at").Limit(limit).Find(&report.Devices).Error
+	return report, err
+}
+
+// PurgeStaleDevices deletes the sessions last used before cutoff. Deleting a session
+// invalidates its refresh and access tokens; the users' other devices stay logged in.
+// Sessions that could still be used are recorded in their user's history.
+func PurgeStaleDevices(cutoff time.Time) (int64, error) {
+	var active []models.UserSession
+	if err := db.DB.Where("last_used_at < ? AND revoked_at IS NULL AND expires_at > ?", cutoff, time.Now()).
+		Find(&active).Error; err != nil {
+		return 0, err
+	}
+
+	result := db.DB.Where("last_used_at < ?", cutoff).Delete(&models.UserSession{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	for _, session := range active {
+		RecordUserHistory(session.UserID, models.UserHistoryDeviceRemoved, "system", FieldChanges{}.
+			Set("session_id", session.ID, nil).
+			Set("device_id", emptyAsNil(session.DeviceID), nil).
+			Set("last_used_at", session.LastUsedAt.UTC().Format(time.RFC3339), nil))
+	}
+	return result.RowsAffected, nil
+}
+
+// RunStaleDevicePurge deletes sessions unused for JWT_STALE_DEVICE_AFTER when
+// JWT_STALE_DEVICE_PURGE is on, and otherwise only logs how many it would delete
+func RunStaleDevicePurge(ctx context.Context) error {
+	cfg := config.AppConfig().JWT
+	cutoff := time.Now().Add(-cfg.StaleDeviceAfter)
+	if !cfg.StaleDevicePurge {
+		report, err := StaleDevices(cutoff, 0)
+		if err == nil && report.Total > 0 {
+			slog.InfoContext(ctx, "[SESSION] Dry run: stale sessions would be deleted",
+				"stale", report.Total, "users", report.Users, "unused_since", cutoff.Format(time.RFC3339), "active", report.Active)
+		}
+		return err
+	}
+
+	purged, err := PurgeStaleDevices(cutoff)
+	if purged > 0 {
+		slog.InfoContext(ctx, "[SESSION] Deleted stale sessions", "count", purged, "unused_since", cutoff.Format(time.RFC3339))
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/metrics"
+	"sync"
+	"time"
+)
+
+// sloBuckets is the number of slices the SLO window is divided into. Requests older than the
+// window drop out one slice at a time.
+const sloBuckets = 60
+
+type sloBucket struct {
+	start time.Time
+	total int64
+	good  int64
+}
+
+// SLOTracker counts requests to endpoints with a service level objective over a rolling
+// window, split into good ones (answered within the latency target without a 5xx) and bad ones
+type SLOTracker struct {
+	mu      sync.Mutex
+	buckets map[string]*[sloBuckets]sloBucket
+	now     func() time.Time
+}
+
+// SLOStatus is the compliance of one objective over the window
+type SLOStatus struct {
+	Objective            config.SLOObjective
+	Requests             int64
+	Good                 int64
+	Compliance           float64 // Percent of good requests (100 without requests)
+	ErrorBudgetRemaining float64 // Percent of the allowed bad requests not used yet; negative once overspent
+	Met                  bool
+}
+
+var (
+	sloTracker     *SLOTracker
+	sloTrackerOnce sync.Once
+)
+
+// NewSLOTracker creates an empty tracker
+func NewSLOTracker() *SLOTracker {
+	return &SLOTracker{buckets: make(map[string]*[sloBuckets]sloBucket), now: time.Now}
+}
+
+// SLOs returns the process-wide SLO tracker
+func SLOs() *SLOTracker {
+	sloTrackerOnce.Do(func() {
+		sloTracker = NewSLOTracker()
+	})
+	return sloTracker
+}
+
+// Record counts a request to the objective's endpoint and updates its Prometheus series:
+// slo_requests_total and slo_good_requests_total, and the compliance_ratio and
+// error_budget_remaining_ratio gauges over the window
+func (t *SLOTracker) Record(objective config.SLOObjective, window, latency time.Duration, failed bool) {
+	if window <= 0 {
+		return
+	}
+	good := !failed && latency <= objective.Latency
+
+	t.mu.Lock()
+	buckets, ok := t.buckets[objective.Name]
+	if !ok {
+		buckets = &[sloBuckets]sloBucket{}
+		t.buckets[objective.Name] = buckets
+	}
+	width := sloBucketWidth(window)
+	start := t.now().Truncate(width)
+	bucket := &buckets[(start.UnixNano()/int64(width))%sloBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = sloBucket{start: start}
+	}
+	bucket.total++
+	if good {
+		bucket.good++
+	}
+	status := t.status(objective, window)
+	t.mu.Unlock()
+
+	labels := metrics.Labels{"slo": objective.Name}
+	metrics.IncCounter("slo_requests_total", labels)
+	if good {
+		metrics.IncCounter("slo_good_requests_total", labels)
+	}
+	metrics.SetGauge("slo_objective_ratio", labels, objective.Target/100)
+	metrics.SetGauge("slo_compliance_ratio", labels, status.Compliance/100)
+	metrics.SetGauge("slo_error_budget_remaining_ratio", labels, status.ErrorBudgetRemaining/100)
+}
+
+// Status returns the compliance of the objective over the window
+func (t *SLOTracker) Status(objective config.SLOObjective, window time.Duration) SLOStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status(objective, window)
+}
+
+func (t *SLOTracker) status(objective config.SLOObjective, window time.Duration) SLOStatus {
+	status := SLOStatus{Objective: objective, Compliance: 100, ErrorBudgetRemaining: 100, Met: true}
+	buckets, ok := t.buckets[objective.Name]
+	if !ok || window <= 0 {
+		return status
+	}
+
+	cutoff := t.now().Add(-window)
+	for _, bucket := range buckets {
+		if bucket.start.After(cutoff) {
+			status.Requests += bucket.total
+			status.Good += bucket.good
+		}
+	}
+	if status.Requests == 0 {
+		return status
+	}
+
+	bad := float64(status.Requests - status.Good)
+	allowed := float64(status.Requests) * (100 - objective.Target) / 100
+	status.Compliance = float64(status.Good) * 100 / float64(status.Requests)
+	status.ErrorBudgetRemaining = (1 - bad/allowed) * 100
+	status.Met = status.Compliance >= objective.Target
+	return status
+}
+
+// sloBucketWidth is the duration of one slice of the window
+func sloBucketWidth(window time.Duration) time.Duration {
+	if width := window / sloBuckets; width > 0 {
+		return width
+	}
+	return 1
+}
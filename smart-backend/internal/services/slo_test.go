@@ -0,0 +1,54 @@
+package services
+
+import (
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/metrics"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSLOTracker_ComplianceAndErrorBudget(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tracker := NewSLOTracker()
+	tracker.now = func() time.Time { return now }
+	objective := config.SLOObjective{Name: "test_gate_open", Method: "PUT", Path: "/api/v1/locations/:gateId/open", Latency: 2 * time.Second, Target: 90}
+	window := time.Hour
+
+	status := tracker.Status(objective, window)
+	assert.Equal(t, float64(100), status.Compliance)
+	assert.True(t, status.Met)
+
+	// 18 fast requests, one slow and one failed: 90% good, the whole budget of 2 is spent
+	for i := 0; i < 18; i++ {
+		tracker.Record(objective, window, 300*time.Millisecond, false)
+	}
+	tracker.Record(objective, window, 3*time.Second, false)
+	tracker.Record(objective, window, 100*time.Millisecond, true)
+
+	status = tracker.Status(objective, window)
+	assert.Equal(t, int64(20), status.Requests)
+	assert.Equal(t, int64(18), status.Good)
+	assert.InDelta(t, 90, status.Compliance, 0.001)
+	assert.InDelta(t, 0, status.ErrorBudgetRemaining, 0.001)
+	assert.True(t, status.Met)
+
+	labels := metrics.Labels{"slo": "test_gate_open"}
+	assert.Equal(t, float64(20), metrics.CounterValue("slo_requests_total", labels))
+	assert.Equal(t, float64(18), metrics.CounterValue("slo_good_requests_total", labels))
+	assert.Contains(t, metrics.Render(), `slo_error_budget_remaining_ratio{slo="test_gate_open"} 0`)
+
+	// One more failure overspends the budget
+	tracker.Record(objective, window, time.Second, true)
+	status = tracker.Status(objective, window)
+	assert.False(t, status.Met)
+	assert.Less(t, status.ErrorBudgetRemaining, float64(0))
+
+	// Requests older than the window drop out
+	now = now.Add(window + time.Minute)
+	tracker.Record(objective, window, time.Second, false)
+	status = tracker.Status(objective, window)
+	assert.Equal(t, int64(1), status.Requests)
+	assert.True(t, status.Met)
+}
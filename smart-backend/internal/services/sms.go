@@ -0,0 +1,110 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"sync"
+	"time"
+)
+
+// SMSSender delivers text messages to phone numbers
+type SMSSender interface {
+	Send(ctx context.Context, phone, message string) error
+}
+
+var (
+	smsMu     sync.RWMutex
+	smsSender SMSSender
+)
+
+// SetSMSSender replaces the SMS sender (e.g. with a fake in tests); nil restores the configured one
+func SetSMSSender(sender SMSSender) {
+	smsMu.Lock()
+	defer smsMu.Unlock()
+	smsSender = sender
+}
+
+// SendSMS sends a text message through the configured sender and meters it for billing.
+// Messages captured in sandbox mode are not billed.
+func SendSMS(ctx context.Context, phone, message string) error {
+	smsMu.RLock()
+	sender := smsSender
+	smsMu.RUnlock()
+	if sender == nil {
+		sender = configuredSMSSender()
+	}
+
+	if err := sender.Send(ctx, phone, message); err != nil {
+		slog.ErrorContext(ctx, "[SMS] Failed to send SMS", "phone", phone, "error", err)
+		return err
+	}
+	if _, captured := sender.(*SandboxSMSSender); !captured {
+		Meter().Add(models.UsageSMSSent, 1)
+	}
+	return nil
+}
+
+// configuredSMSSender returns the sandbox outbox in sandbox mode, otherwise the gateway sender, or
+// the log sender when SMS_GATEWAY_URL is not set
+func configuredSMSSender() SMSSender {
+	if SandboxEnabled() {
+		return SandboxOutbox()
+	}
+	cfg := config.AppConfig().SMS
+	if cfg.GatewayURL == "" {
+		return LogSMSSender{}
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &HTTPSMSSender{URL: cfg.GatewayURL, Token: cfg.GatewayToken, Client: &http.Client{Timeout: timeout}}
+}
+
+// LogSMSSender writes messages to the log instead of sending them, for development
+type LogSMSSender struct{}
+
+// Send logs the message
+func (LogSMSSender) Send(ctx context.Context, phone, message string) error {
+	slog.InfoContext(ctx, "[SMS] Not sent, SMS_GATEWAY_URL is not set", "phone", phone, "message", message)
+	return nil
+}
+
+// HTTPSMSSender posts {"phone", "message"} as JSON to an SMS gateway
+type HTTPSMSSender struct {
+	URL    string
+	Token  string // Sent as a Bearer token when set
+	Client *http.Client
+}
+
+// Send posts the message to the gateway; any non-2xx response is an error
+func (s *HTTPSMSSender) Send(ctx context.Context, phone, message string) error {
+	body, err := json.Marshal(map[string]string{"phone": phone, "message": message})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.Token)
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("SMS gateway returned status %d", resp.StatusCode)
+	}
+	return nil
+}
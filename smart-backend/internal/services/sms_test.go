@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPSMSSender_PostsMessage(t *testing.T) {
+	var received map[string]string
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&received)
+		if received["phone"] == "+70000000000" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sender := &HTTPSMSSender{URL: server.URL, Token: "secret", Client: server.Client()}
+	assert.NoError(t, sender.Send(context.Background(), "+77771234567", "Hello"))
+	assert.Equal(t, map[string]string{"phone": "+77771234567", "message": "Hello"}, received)
+	assert.Equal(t, "Bearer secret", auth)
+
+	assert.Error(t, sender.Send(context.Background(), "+70000000000", "Hello"))
+}
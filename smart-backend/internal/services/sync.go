@@ -0,0 +1,81 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrSyncTokenInvalid is returned for change tokens that were not issued by SyncChanges
+var ErrSyncTokenInvalid = errors.New("invalid change token")
+
+// SyncPage is a page of the change feed
+type SyncPage struct {
+	Changes   []models.UserHistory // Oldest first
+	NextToken string               // Position after the last change; the given token when there are none
+	HasMore   bool                 // More changes follow NextToken
+}
+
+// SyncChanges returns up to limit changes to users and their assignments recorded after the
+// position encoded in token, oldest first. An empty token starts at the beginning of the log.
+// The feed is the user history, which is append-only, so a token stays valid and the feed can
+// be resumed from it at any time. Returns ErrSyncTokenInvalid for malformed tokens.
+func SyncChanges(token string, limit int) (SyncPage, error) {
+	page := SyncPage{NextToken: token}
+
+	query := db.DB.Model(&models.UserHistory{})
+	if token != "" {
+		after, id, err := decodeSyncToken(token)
+		if err != nil {
+			return page, err
+		}
+		query = query.Where("created_at > ? OR (created_at = ? AND id > ?)", after, after, id)
+	}
+
+	// Fetch one extra change to know whether more follow
+	if err := query.Order("created_at, id").Limit(limit + 1).Find(&page.Changes).Error; err != nil {
+		return page, err
+	}
+	if len(page.Changes) > limit {
+		page.Changes = page.Changes[:limit]
+		page.HasMore = true
+	}
+	if len(page.Changes) > 0 {
+		last := page.Changes[len(page.Changes)-1]
+		page.NextToken = encodeSyncToken(last.CreatedAt, last.ID)
+	}
+	return page, nil
+}
+
+// encodeSyncToken encodes the position of a change as an opaque token
+func encodeSyncToken(createdAt time.Time, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", createdAt.UnixNano(), id)))
+}
+
+// decodeSyncToken returns the creation time and ID of the change a token points at
+func decodeSyncToken(token string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrSyncTokenInvalid
+	}
+	nanos, id, found := strings.Cut(string(raw), ":")
+	if !found {
+		return time.Time{}, uuid.Nil, ErrSyncTokenInvalid
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrSyncTokenInvalid
+	}
+	changeID, err := uuid.Parse(id)
+	if err != nil {
+		return time.Time{}, uuid.Nil, ErrSyncTokenInvalid
+	}
+	return time.Unix(0, n), changeID, nil
+}
@@ -2,28 +2,37 @@ package services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
+	"math/rand/v2"
 	"net/http"
 	"net/url"
 	"ololo-gate/internal/config"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+	"time"
+
+	"golang.org/x/sync/singleflight"
 )
 
 // ThirdPartyClient handles all communication with the third-party backend API
 type ThirdPartyClient struct {
 	baseURL string
 	client  *http.Client
+	ctx     context.Context // Calls stop when it ends, see WithContext
 }
 
 // LocationResponse represents a location from the third-party API with gates
 type LocationResponse struct {
-	ID      int                `json:"id"`
-	Title   string             `json:"title"`
-	Address string             `json:"address"`
-	Logo    string             `json:"logo"`
-	Gates   []GateResponse     `json:"gates"` // Gates should always be included in response
+	ID      int            `json:"id"`
+	Title   string         `json:"title"`
+	Address string         `json:"address"`
+	Logo    string         `json:"logo"`
+	Gates   []GateResponse `json:"gates"` // Gates should always be included in response
 }
 
 // LocationLiteDTO represents a lightweight location response without gates
@@ -53,51 +62,44 @@ type LocationAssignmentDTO struct {
 // UserLocationGateAssignmentDTO represents the request to assign user to locations/gates
 // New nested structure: each location has its own array of gate IDs
 type UserLocationGateAssignmentDTO struct {
-	Phone     string                   `json:"phone"`
-	Locations []LocationAssignmentDTO  `json:"locations"`
+	Phone     string                  `json:"phone"`
+	Locations []LocationAssignmentDTO `json:"locations"`
 }
 
 // NewThirdPartyClient creates a new instance of ThirdPartyClient
 func NewThirdPartyClient() *ThirdPartyClient {
 	return &ThirdPartyClient{
-		baseURL: config.AppConfig.ThirdPartyAPIURL,
-		client:  &http.Client{},
+		baseURL: config.AppConfig().ThirdPartyAPIURL,
+		client:  &http.Client{Timeout: config.AppConfig().ThirdParty.Timeout},
+		ctx:     context.Background(),
 	}
 }
 
+// WithContext returns a copy of the client whose calls stop when ctx ends, usually the request's
+// c.UserContext(). A call stopped while queued, in flight or between retries fails with an
+// UpstreamUnavailable error wrapping ctx's error, and does not count against the provider.
+func (c *ThirdPartyClient) WithContext(ctx context.Context) *ThirdPartyClient {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
 // GetAllLocations fetches all locations with gates from the third-party API
 func (c *ThirdPartyClient) GetAllLocations() ([]LocationResponse, error) {
 	url := fmt.Sprintf("%s/locations", c.baseURL)
-	resp, err := c.client.Get(url)
-	if err != nil {
-		log.Printf("Error calling third-party API GET %s: %v", url, err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("Third-party API returned status %d: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("third-party API returned status code %d", resp.StatusCode)
-	}
 
-	// Read the entire body first for debugging
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		log.Printf("Error reading response body: %v", err)
+	var locations []LocationResponse
+	if err := c.doJSON("get_all_locations", "", http.MethodGet, url, nil, &locations); err != nil {
 		return nil, err
 	}
-
-
-	var locations []LocationResponse
-	if err := json.Unmarshal(bodyBytes, &locations); err != nil {
+	if err := validateLocations("get_all_locations", locations); err != nil {
 		return nil, err
 	}
+	applySimulatedGates(locations)
 
 	return locations, nil
 }
 
-
 // GetLocationsByPhone fetches all locations or locations filtered by phone from the third-party API
 func (c *ThirdPartyClient) GetAllLocationsWithGates(phone string) ([]LocationResponse, error) {
 	apiURL := fmt.Sprintf("%s/locations", c.baseURL)
@@ -106,24 +108,14 @@ func (c *ThirdPartyClient) GetAllLocationsWithGates(phone string) ([]LocationRes
 		apiURL = fmt.Sprintf("%s?phone=%s", apiURL, url.QueryEscape(phone))
 	}
 
-	resp, err := c.client.Get(apiURL)
-	if err != nil {
-		log.Printf("Error calling third-party API GET %s: %v", apiURL, err)
+	var locations []LocationResponse
+	if err := c.doJSON("get_locations_with_gates", phone, http.MethodGet, apiURL, nil, &locations); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("Third-party API returned status %d: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("third-party API returned status code %d", resp.StatusCode)
-	}
-
-	var locations []LocationResponse
-	if err := json.NewDecoder(resp.Body).Decode(&locations); err != nil {
-		log.Printf("Error decoding locations response: %v", err)
+	if err := validateLocations("get_locations_with_gates", locations); err != nil {
 		return nil, err
 	}
+	applySimulatedGates(locations)
 
 	return locations, nil
 }
@@ -131,22 +123,12 @@ func (c *ThirdPartyClient) GetAllLocationsWithGates(phone string) ([]LocationRes
 // GetLocationsByPhone fetches locations accessible to a specific phone number
 func (c *ThirdPartyClient) GetLocationsByPhone(phone string) ([]LocationLiteDTO, error) {
 	url := fmt.Sprintf("%s/locations/by-phone/%s", c.baseURL, phone)
-	resp, err := c.client.Get(url)
-	if err != nil {
-		log.Printf("Error calling third-party API GET %s: %v", url, err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("Third-party API returned status %d: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("third-party API returned status code %d", resp.StatusCode)
-	}
 
 	var locations []LocationLiteDTO
-	if err := json.NewDecoder(resp.Body).Decode(&locations); err != nil {
-		log.Printf("Error decoding locations response: %v", err)
+	if err := c.doJSON("get_locations_by_phone", phone, http.MethodGet, url, nil, &locations); err != nil {
+		return nil, err
+	}
+	if err := validateLiteLocations("get_locations_by_phone", locations); err != nil {
 		return nil, err
 	}
 
@@ -156,123 +138,378 @@ func (c *ThirdPartyClient) GetLocationsByPhone(phone string) ([]LocationLiteDTO,
 // GetGatesByPhoneAndLocation fetches gates accessible to a phone for a specific location
 func (c *ThirdPartyClient) GetGatesByPhoneAndLocation(phone string, locationID int) ([]GateResponse, error) {
 	url := fmt.Sprintf("%s/locations/by-phone/%s/%d", c.baseURL, phone, locationID)
-	resp, err := c.client.Get(url)
-	if err != nil {
-		log.Printf("Error calling third-party API GET %s: %v", url, err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("Third-party API returned status %d: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("third-party API returned status code %d", resp.StatusCode)
-	}
 
 	var gates []GateResponse
-	if err := json.NewDecoder(resp.Body).Decode(&gates); err != nil {
-		log.Printf("Error decoding gates response: %v", err)
+	if err := c.doJSON("get_gates_by_phone_and_location", phone, http.MethodGet, url, nil, &gates); err != nil {
+		return nil, err
+	}
+	if err := validateGates("get_gates_by_phone_and_location", gates); err != nil {
 		return nil, err
 	}
+	if SandboxEnabled() {
+		for i := range gates {
+			SimulatedGates().Apply(&gates[i])
+		}
+	}
 
 	return gates, nil
 }
 
-// OpenGate sends a request to open a gate
-func (c *ThirdPartyClient) OpenGate(gateID int) (bool, error) {
-	log.Printf("[GATE_OPEN] Attempting to open gate ID: %d", gateID)
-	url := fmt.Sprintf("%s/locations/%d/open", c.baseURL, gateID)
-	req, err := http.NewRequest("PUT", url, nil)
+// GetGateState fetches the current state of a single gate accessible to the phone
+func (c *ThirdPartyClient) GetGateState(phone string, gateID int) (*GateResponse, error) {
+	locations, err := c.GetAllLocationsWithGates(phone)
 	if err != nil {
-		log.Printf("[GATE_OPEN] Error creating request for gate %d: %v", gateID, err)
-		return false, err
+		return nil, err
 	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		log.Printf("[GATE_OPEN] Error calling third-party API for gate %d: %v", gateID, err)
-		return false, err
+	for _, loc := range locations {
+		for _, gate := range loc.Gates {
+			if gate.ID == gateID {
+				return &gate, nil
+			}
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("[GATE_OPEN] Third-party API returned status %d for gate %d: %s", resp.StatusCode, gateID, string(body))
-		return false, fmt.Errorf("third-party API returned status code %d", resp.StatusCode)
+	return nil, newUpstreamError("get_gate_state", UpstreamNotFound, http.StatusOK,
+		fmt.Sprintf("gate %d not found for phone %s", gateID, phone), nil)
+}
+
+// OpenGate sends a request to open a gate on behalf of phone (empty for admin overrides), which
+// the request is scheduled under the rate limit by. The idempotency key (the gate command ID) is
+// sent as Idempotency-Key so the provider executes repeated attempts only once; when it is set
+// and THIRD_PARTY_HEDGE_DELAY is configured, the request is hedged. In sandbox mode the gate
+// simulator executes the command instead.
+func (c *ThirdPartyClient) OpenGate(phone string, gateID int, idempotencyKey string) (bool, error) {
+	slog.InfoContext(c.ctx, "[GATE_OPEN] Attempting to open gate", "gate_id", gateID)
+	if SandboxEnabled() {
+		return SimulatedGates().Execute(gateID, true), nil
 	}
+	url := fmt.Sprintf("%s/locations/%d/open", c.baseURL, gateID)
+	limitKey := gateCommandLimitKey(phone, gateID)
 
 	var result bool
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		log.Printf("[GATE_OPEN] Error decoding response for gate %d: %v", gateID, err)
+	var err error
+	if delay := config.AppConfig().ThirdParty.HedgeDelay; delay > 0 && idempotencyKey != "" {
+		err = c.hedgedJSON("open_gate", limitKey, http.MethodPut, url, idempotencyKey, delay, &result)
+	} else {
+		err = c.doKeyedJSON("open_gate", limitKey, http.MethodPut, url, nil, idempotencyKey, &result)
+	}
+	mirrorWrite(MirrorCall{Operation: "open_gate", Method: http.MethodPut, Path: fmt.Sprintf("/locations/%d/open", gateID),
+		IdempotencyKey: idempotencyKey, GateID: gateID}, &result, err)
+	if err != nil {
+		slog.ErrorContext(c.ctx, "[GATE_OPEN] Failed to open gate", "gate_id", gateID, "error", err)
 		return false, err
 	}
 
-	log.Printf("[GATE_OPEN] Successfully opened gate ID: %d (result: %v)", gateID, result)
+	slog.InfoContext(c.ctx, "[GATE_OPEN] Gate opened", "gate_id", gateID, "result", result)
 	return result, nil
 }
 
-// CloseGate sends a request to close a gate
-func (c *ThirdPartyClient) CloseGate(gateID int) (bool, error) {
-	log.Printf("[GATE_CLOSE] Attempting to close gate ID: %d", gateID)
-	url := fmt.Sprintf("%s/locations/%d/close", c.baseURL, gateID)
-	req, err := http.NewRequest("PUT", url, nil)
-	if err != nil {
-		log.Printf("[GATE_CLOSE] Error creating request for gate %d: %v", gateID, err)
-		return false, err
+// CloseGate sends a request to close a gate on behalf of phone (empty for admin overrides), or
+// to the gate simulator in sandbox mode
+func (c *ThirdPartyClient) CloseGate(phone string, gateID int) (bool, error) {
+	slog.InfoContext(c.ctx, "[GATE_CLOSE] Attempting to close gate", "gate_id", gateID)
+	if SandboxEnabled() {
+		return SimulatedGates().Execute(gateID, false), nil
 	}
+	url := fmt.Sprintf("%s/locations/%d/close", c.baseURL, gateID)
 
-	resp, err := c.client.Do(req)
+	var result bool
+	err := c.doJSON("close_gate", gateCommandLimitKey(phone, gateID), http.MethodPut, url, nil, &result)
+	mirrorWrite(MirrorCall{Operation: "close_gate", Method: http.MethodPut, Path: fmt.Sprintf("/locations/%d/close", gateID), GateID: gateID}, &result, err)
 	if err != nil {
-		log.Printf("[GATE_CLOSE] Error calling third-party API for gate %d: %v", gateID, err)
+		slog.ErrorContext(c.ctx, "[GATE_CLOSE] Failed to close gate", "gate_id", gateID, "error", err)
 		return false, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("[GATE_CLOSE] Third-party API returned status %d for gate %d: %s", resp.StatusCode, gateID, string(body))
-		return false, fmt.Errorf("third-party API returned status code %d", resp.StatusCode)
-	}
+	slog.InfoContext(c.ctx, "[GATE_CLOSE] Gate closed", "gate_id", gateID, "result", result)
+	return result, nil
+}
 
-	var result bool
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		log.Printf("[GATE_CLOSE] Error decoding response for gate %d: %v", gateID, err)
-		return false, err
+// gateCommandLimitKey schedules gate commands per user, like every other provider call, so one
+// user's commands cannot hold back other users of the same gate. Admin overrides have no phone
+// and are scheduled per gate.
+func gateCommandLimitKey(phone string, gateID int) string {
+	if phone != "" {
+		return phone
 	}
-
-	log.Printf("[GATE_CLOSE] Successfully closed gate ID: %d (result: %v)", gateID, result)
-	return result, nil
+	return fmt.Sprintf("gate:%d", gateID)
 }
 
-// AssignUserToLocationsAndGates assigns a user (phone) to specific locations and gates
+// AssignUserToLocationsAndGates assigns a user (phone) to specific locations and gates.
+// Like gate commands, it is mirrored to the migration provider when THIRD_PARTY_MIRROR_API_URL is set.
 func (c *ThirdPartyClient) AssignUserToLocationsAndGates(assignment UserLocationGateAssignmentDTO) error {
 	url := fmt.Sprintf("%s/locations/phone", c.baseURL)
-	body, err := json.Marshal(assignment)
+	err := c.doJSON("assign_user", assignment.Phone, http.MethodPut, url, assignment, nil)
+	mirrorWrite(MirrorCall{Operation: "assign_user", Method: http.MethodPut, Path: "/locations/phone", Payload: assignment, Phone: assignment.Phone}, nil, err)
+	return err
+}
+
+// upstreamGroup coalesces concurrent identical GET requests across all client instances,
+// so a burst of users loading the same route (keyed by method and URL, which includes the phone)
+// triggers a single upstream call whose result is shared by every waiting caller.
+var upstreamGroup singleflight.Group
+
+// doJSON performs a request against the third-party API and decodes a 200 response into out.
+// limitKey identifies the user (usually the phone) for fair scheduling under the rate limit.
+// Every failure is returned as an *UpstreamError classified by cause.
+func (c *ThirdPartyClient) doJSON(operation, limitKey, method, url string, payload interface{}, out interface{}) error {
+	return c.doKeyedJSON(operation, limitKey, method, url, payload, "", out)
+}
+
+// doKeyedJSON is doJSON with an Idempotency-Key header (if not empty) on the request and its retries
+func (c *ThirdPartyClient) doKeyedJSON(operation, limitKey, method, url string, payload interface{}, idempotencyKey string, out interface{}) error {
+	var body []byte
+	var err error
+	if method == http.MethodGet && payload == nil {
+		// Only idempotent reads are coalesced; gate commands and assignments always go upstream.
+		// The shared call is not stopped by any one caller, who just stops waiting for it.
+		results := upstreamGroup.DoChan(method+" "+url, func() (interface{}, error) {
+			return c.WithContext(context.WithoutCancel(c.ctx)).fetch(operation, limitKey, method, url, nil, idempotencyKey)
+		})
+		select {
+		case result := <-results:
+			if result.Shared {
+				metrics.IncCounter("third_party_coalesced_total", metrics.Labels{"operation": operation})
+			}
+			err = result.Err
+			if err == nil {
+				body = result.Val.([]byte)
+			}
+		case <-c.ctx.Done():
+			return cancelledCall(operation, c.ctx.Err())
+		}
+	} else {
+		body, err = c.fetch(operation, limitKey, method, url, payload, idempotencyKey)
+	}
 	if err != nil {
-		log.Printf("Error marshaling assignment request: %v", err)
 		return err
 	}
+	return decodeUpstream(operation, body, out)
+}
+
+// hedgedJSON sends a request and, if no response has arrived after delay, an identical second attempt.
+// The first successful response is decoded into out and the other attempt is cancelled. Both carry the
+// same Idempotency-Key, so the provider executes the request once even if both reach it.
+func (c *ThirdPartyClient) hedgedJSON(operation, limitKey, method, url, idempotencyKey string, delay time.Duration, out interface{}) error {
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+
+	type attempt struct {
+		body   []byte
+		err    error
+		hedged bool
+	}
+	results := make(chan attempt, 2)
+	send := func(hedged bool) {
+		body, err := c.send(ctx, operation, limitKey, method, url, nil, idempotencyKey)
+		results <- attempt{body: body, err: err, hedged: hedged}
+	}
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(body))
+	go send(false)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	hedge := timer.C
+	inFlight := 1
+
+	var firstErr error
+	for {
+		select {
+		case <-hedge:
+			slog.InfoContext(c.ctx, "[HEDGE] No response yet, sending a second attempt", "operation", operation, "delay", delay)
+			metrics.IncCounter("third_party_hedged_total", metrics.Labels{"operation": operation})
+			hedge = nil
+			inFlight++
+			go send(true)
+		case result := <-results:
+			inFlight--
+			if result.err == nil {
+				if result.hedged {
+					metrics.IncCounter("third_party_hedge_wins_total", metrics.Labels{"operation": operation})
+				}
+				return decodeUpstream(operation, result.body, out)
+			}
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			// A failure is only final once no attempt is left to succeed; a failed first attempt is not retried
+			if inFlight == 0 {
+				if c.ctx.Err() != nil {
+					return cancelledCall(operation, c.ctx.Err())
+				}
+				return firstErr
+			}
+		}
+	}
+}
+
+// decodeUpstream decodes a 200 response body into out (if not nil)
+func decodeUpstream(operation string, body []byte, out interface{}) error {
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		slog.Error("Error decoding third-party response", "operation", operation, "error", err)
+		Meter().Add(models.UsageProviderErrors, 1)
+		return newUpstreamError(operation, UpstreamMalformed, http.StatusOK, "unexpected response body: "+err.Error(), err)
+	}
+
+	return nil
+}
+
+// fetch performs a request against the third-party API and returns the raw body of a 200 response.
+// Transient failures are retried up to THIRD_PARTY_RETRIES times with exponential backoff; each
+// attempt has its own THIRD_PARTY_TIMEOUT.
+func (c *ThirdPartyClient) fetch(operation, limitKey, method, url string, payload interface{}, idempotencyKey string) ([]byte, error) {
+	cfg := config.AppConfig().ThirdParty
+	for retry := 0; ; retry++ {
+		body, err := c.send(c.ctx, operation, limitKey, method, url, payload, idempotencyKey)
+		if err != nil && c.ctx.Err() != nil {
+			return nil, cancelledCall(operation, c.ctx.Err())
+		}
+		if err == nil || retry >= cfg.Retries || !retryable(operation, method, idempotencyKey, err) {
+			return body, err
+		}
+
+		wait := retryBackoff(retry, cfg.RetryBackoff, cfg.RetryMaxBackoff)
+		metrics.IncCounter("third_party_retries_total", metrics.Labels{"operation": operation})
+		slog.WarnContext(c.ctx, "[RETRY] Third-party call failed, retrying",
+			"operation", operation, "wait", wait, "retry", retry+1, "retries", cfg.Retries, "error", err)
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-c.ctx.Done():
+			timer.Stop()
+			return nil, cancelledCall(operation, c.ctx.Err())
+		}
+	}
+}
+
+// cancelledCall is the error of a call stopped because its context ended. The provider did not
+// fail, so it is neither counted as a provider error nor against the circuit breaker.
+func cancelledCall(operation string, err error) error {
+	metrics.IncCounter("third_party_cancelled_total", metrics.Labels{"operation": operation})
+	return &UpstreamError{Kind: UpstreamUnavailable, Operation: operation, Detail: "call cancelled: " + err.Error(), Err: err}
+}
+
+// unsafeRetryOperations must not reach the provider twice unless sent with an Idempotency-Key:
+// a retried open whose first attempt timed out after all would open the barrier again.
+// Other writes set state (closed gate, a phone's assignments), so repeating them is harmless.
+var unsafeRetryOperations = map[string]bool{"open_gate": true}
+
+// retryable reports whether a failed call may be sent again: the provider was unavailable rather
+// than refusing the request, and the call is safe to repeat. Calls stopped by the circuit breaker
+// or by the rate limit queue are not retried, since waiting a little longer would not help.
+func retryable(operation, method, idempotencyKey string, err error) bool {
+	var upstreamErr *UpstreamError
+	if !errors.As(err, &upstreamErr) || upstreamErr.Kind != UpstreamUnavailable {
+		return false
+	}
+	if errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrRateLimitQueueTimeout) {
+		return false
+	}
+	return method == http.MethodGet || idempotencyKey != "" || !unsafeRetryOperations[operation]
+}
+
+// retryBackoff is the delay before retry n (from 0): base doubled n times, up to max, with jitter
+// between half and all of it so retries of concurrent calls spread out
+func retryBackoff(n int, base, max time.Duration) time.Duration {
+	wait := base
+	for i := 0; i < n && wait < max; i++ {
+		wait *= 2
+	}
+	if wait > max {
+		wait = max
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait/2 + rand.N(wait/2+1)
+}
+
+// send performs a single request, optionally with an Idempotency-Key header. A request cancelled through
+// ctx (a hedged attempt that lost, or a caller that gave up) returns ctx's error and is not counted as a
+// provider failure.
+func (c *ThirdPartyClient) send(ctx context.Context, operation, limitKey, method, url string, payload interface{}, idempotencyKey string) ([]byte, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error marshaling third-party request", "operation", operation, "error", err)
+			return nil, err
+		}
+		reqBody = bytes.NewBuffer(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		log.Printf("Error creating request to third-party API: %v", err)
-		return err
+		slog.ErrorContext(ctx, "Error creating request to third-party API", "error", err)
+		return nil, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	// Smooth bursts instead of letting the provider answer with 429s
+	if err := ThirdPartyLimiter().Wait(ctx, limitKey); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		metrics.IncCounter("third_party_throttled_total", metrics.Labels{"operation": operation})
+		return nil, newUpstreamError(operation, UpstreamUnavailable, http.StatusTooManyRequests, err.Error(), err)
+	}
+
+	// Fail fast while the provider is known to be down, without counting it as another provider failure
+	breaker := ProviderBreaker()
+	if !breaker.Allow() {
+		return nil, &UpstreamError{Kind: UpstreamUnavailable, Operation: operation, Detail: "provider unavailable, circuit breaker open", Err: ErrCircuitOpen}
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	metrics.IncCounter("third_party_requests_total", metrics.Labels{"operation": operation})
+	Meter().Add(models.UsageProviderCalls, 1)
 
 	resp, err := c.client.Do(req)
+	if err != nil && ctx.Err() != nil {
+		breaker.Cancel()
+		return nil, ctx.Err()
+	}
 	if err != nil {
-		log.Printf("Error calling third-party API PUT %s: %v", url, err)
-		return err
+		slog.ErrorContext(ctx, "Error calling third-party API", "method", method, "url", url, "error", err)
+		Meter().Add(models.UsageProviderErrors, 1)
+		breaker.Failure()
+		return nil, newUpstreamError(operation, UpstreamUnavailable, 0, err.Error(), err)
 	}
 	defer resp.Body.Close()
 
+	body, err := io.ReadAll(resp.Body)
+	if err != nil && ctx.Err() != nil {
+		breaker.Cancel()
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error reading third-party response body", "error", err)
+		Meter().Add(models.UsageProviderErrors, 1)
+		breaker.Failure()
+		return nil, newUpstreamError(operation, UpstreamUnavailable, resp.StatusCode, "failed to read response body", err)
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		log.Printf("Third-party API returned status %d: %s", resp.StatusCode, string(body))
-		return fmt.Errorf("third-party API returned status code %d", resp.StatusCode)
+		slog.ErrorContext(ctx, "Third-party API returned an error status", "status", resp.StatusCode, "body", string(body))
+		Meter().Add(models.UsageProviderErrors, 1)
+		kind := classifyStatus(resp.StatusCode)
+		if kind == UpstreamUnavailable {
+			breaker.Failure()
+		} else {
+			breaker.Success() // The provider is up, it just refused this request
+		}
+		return nil, newUpstreamError(operation, kind, resp.StatusCode,
+			fmt.Sprintf("third-party API returned status code %d", resp.StatusCode), nil)
 	}
 
-	return nil
+	breaker.Success()
+	return body, nil
 }
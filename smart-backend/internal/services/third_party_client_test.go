@@ -0,0 +1,281 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *ThirdPartyClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config.SetAppConfig(&config.Config{ThirdPartyAPIURL: server.URL})
+	return NewThirdPartyClient()
+}
+
+func assertUpstreamKind(t *testing.T, err error, kind UpstreamErrorKind) {
+	var upstreamErr *UpstreamError
+	if assert.True(t, errors.As(err, &upstreamErr), "expected *UpstreamError, got %v", err) {
+		assert.Equal(t, kind, upstreamErr.Kind)
+	}
+}
+
+func TestThirdPartyClient_ValidResponse(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":1,"title":"Ala-Too","gates":[{"id":2,"title":"Main","location_id":1}]}]`))
+	})
+
+	locations, err := client.GetAllLocations()
+	assert.NoError(t, err)
+	assert.Len(t, locations, 1)
+	assert.Len(t, locations[0].Gates, 1)
+}
+
+func TestThirdPartyClient_MalformedJSON(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"unexpected": "object"}`))
+	})
+
+	_, err := client.GetAllLocations()
+	assertUpstreamKind(t, err, UpstreamMalformed)
+}
+
+func TestThirdPartyClient_MissingRequiredFields(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id":1,"title":"Ala-Too","gates":[{"description":"no id"}]}]`))
+	})
+
+	_, err := client.GetAllLocationsWithGates("+77771234567")
+	assertUpstreamKind(t, err, UpstreamMalformed)
+}
+
+func TestThirdPartyClient_NotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.OpenGate("+77771234567", 99, "")
+	assertUpstreamKind(t, err, UpstreamNotFound)
+}
+
+func TestThirdPartyClient_ProviderDown(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.CloseGate("+77771234567", 1)
+	assertUpstreamKind(t, err, UpstreamUnavailable)
+}
+
+func TestThirdPartyClient_Unreachable(t *testing.T) {
+	config.SetAppConfig(&config.Config{ThirdPartyAPIURL: "http://127.0.0.1:1"})
+	client := NewThirdPartyClient()
+
+	err := client.AssignUserToLocationsAndGates(UserLocationGateAssignmentDTO{Phone: "+77771234567"})
+	assertUpstreamKind(t, err, UpstreamUnavailable)
+}
+
+func TestThirdPartyClient_CoalescesConcurrentReads(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		w.Write([]byte(`[{"id":1,"title":"Ala-Too","gates":[]}]`))
+	})
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([][]LocationResponse, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			locations, err := client.GetAllLocationsWithGates("+77771234567")
+			assert.NoError(t, err)
+			results[i] = locations
+		}(i)
+	}
+
+	// Give every caller time to join the in-flight request before the provider answers
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	for _, locations := range results {
+		assert.Len(t, locations, 1)
+	}
+}
+
+func TestThirdPartyClient_DoesNotCoalesceGateCommands(t *testing.T) {
+	var hits int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte(`true`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.OpenGate("+77771234567", 1, "")
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+}
+
+func TestGateCommandLimitKey_SchedulesPerUser(t *testing.T) {
+	// Users of the same gate queue separately, and one user's commands share a queue across gates
+	assert.NotEqual(t, gateCommandLimitKey("+77771234567", 1), gateCommandLimitKey("+77777654321", 1))
+	assert.Equal(t, gateCommandLimitKey("+77771234567", 1), gateCommandLimitKey("+77771234567", 2))
+	// Like the other provider calls of the user
+	assert.Equal(t, "+77771234567", gateCommandLimitKey("+77771234567", 1))
+	// Admin overrides have no phone
+	assert.Equal(t, "gate:1", gateCommandLimitKey("", 1))
+}
+
+func TestThirdPartyClient_HedgesSlowOpenGate(t *testing.T) {
+	var hits int32
+	keys := make(chan string, 2)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		keys <- r.Header.Get("Idempotency-Key")
+		if atomic.AddInt32(&hits, 1) == 1 {
+			// The first attempt stalls until the client gives up on it
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`true`))
+	})
+	config.AppConfig().ThirdParty.HedgeDelay = 50 * time.Millisecond
+
+	start := time.Now()
+	opened, err := client.OpenGate("+77771234567", 1, "cmd-123")
+	assert.NoError(t, err)
+	assert.True(t, opened)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+	assert.Equal(t, "cmd-123", <-keys)
+	assert.Equal(t, "cmd-123", <-keys)
+}
+
+func TestThirdPartyClient_FastOpenGateIsNotHedged(t *testing.T) {
+	var hits int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte(`true`))
+	})
+	config.AppConfig().ThirdParty.HedgeDelay = 200 * time.Millisecond
+
+	_, err := client.OpenGate("+77771234567", 1, "cmd-456")
+	assert.NoError(t, err)
+	time.Sleep(300 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestThirdPartyClient_RetriesTransientFailures(t *testing.T) {
+	var hits int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+	config.AppConfig().ThirdParty.Retries = 2
+	config.AppConfig().ThirdParty.RetryBackoff = time.Millisecond
+	config.AppConfig().ThirdParty.RetryMaxBackoff = 5 * time.Millisecond
+
+	_, err := client.GetLocationsByPhone("+77771234567")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+
+	// Out of retries, the last failure is returned
+	atomic.StoreInt32(&hits, -10)
+	_, err = client.GetLocationsByPhone("+77771234567")
+	assertUpstreamKind(t, err, UpstreamUnavailable)
+	assert.Equal(t, int32(-7), atomic.LoadInt32(&hits))
+}
+
+func TestThirdPartyClient_DoesNotRetryRejectionsOrUnkeyedOpens(t *testing.T) {
+	var hits int32
+	status := http.StatusBadRequest
+	keys := make(chan string, 3)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		keys <- r.Header.Get("Idempotency-Key")
+		w.WriteHeader(status)
+	})
+	config.AppConfig().ThirdParty.Retries = 2
+	config.AppConfig().ThirdParty.RetryBackoff = time.Millisecond
+	config.AppConfig().ThirdParty.RetryMaxBackoff = time.Millisecond
+
+	err := client.AssignUserToLocationsAndGates(UserLocationGateAssignmentDTO{Phone: "+77771234567"})
+	assertUpstreamKind(t, err, UpstreamRejected)
+	assert.Equal(t, int32(1), atomic.SwapInt32(&hits, 0))
+	<-keys
+
+	// An open that may have reached the provider is only resent with its Idempotency-Key
+	status = http.StatusServiceUnavailable
+	_, err = client.OpenGate("+77771234567", 1, "")
+	assertUpstreamKind(t, err, UpstreamUnavailable)
+	assert.Equal(t, int32(1), atomic.SwapInt32(&hits, 0))
+	<-keys
+
+	_, err = client.OpenGate("+77771234567", 1, "cmd-789")
+	assertUpstreamKind(t, err, UpstreamUnavailable)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, "cmd-789", <-keys)
+	}
+}
+
+func TestThirdPartyClient_StopsWhenContextEnds(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(300 * time.Millisecond):
+		}
+		w.Write([]byte(`[]`))
+	})
+	previous := ProviderBreaker()
+	providerBreaker = NewCircuitBreaker(1, time.Hour)
+	defer func() { providerBreaker = previous }()
+
+	for _, call := range []func(c *ThirdPartyClient) error{
+		func(c *ThirdPartyClient) error { _, err := c.GetLocationsByPhone("+77771234567"); return err },
+		func(c *ThirdPartyClient) error { _, err := c.CloseGate("+77771234567", 1); return err },
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		start := time.Now()
+		err := call(client.WithContext(ctx))
+		cancel()
+
+		assertUpstreamKind(t, err, UpstreamUnavailable)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 250*time.Millisecond)
+	}
+	// Giving up is not a provider failure
+	assert.False(t, ProviderBreaker().IsOpen())
+}
+
+func TestRetryBackoff_DoublesUpToMax(t *testing.T) {
+	for n, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 500 * time.Millisecond, 500 * time.Millisecond} {
+		wait := retryBackoff(n, 100*time.Millisecond, 500*time.Millisecond)
+		assert.GreaterOrEqual(t, wait, want/2)
+		assert.LessOrEqual(t, wait, want)
+	}
+	assert.Zero(t, retryBackoff(3, 0, 0))
+}
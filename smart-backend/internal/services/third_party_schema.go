@@ -0,0 +1,43 @@
+package services
+
+import "fmt"
+
+// Schema checks for third-party responses. Decoding alone accepts almost anything
+// (missing fields become zero values), so required fields are verified explicitly.
+
+// validateLocations checks that every location (and its gates) carries the required fields
+func validateLocations(operation string, locations []LocationResponse) error {
+	for i, loc := range locations {
+		if loc.ID <= 0 || loc.Title == "" {
+			return malformed(operation, fmt.Sprintf("location[%d] is missing id or title", i))
+		}
+		if err := validateGates(operation, loc.Gates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateLiteLocations checks that every lightweight location carries the required fields
+func validateLiteLocations(operation string, locations []LocationLiteDTO) error {
+	for i, loc := range locations {
+		if loc.ID <= 0 || loc.Title == "" {
+			return malformed(operation, fmt.Sprintf("location[%d] is missing id or title", i))
+		}
+	}
+	return nil
+}
+
+// validateGates checks that every gate carries the required fields
+func validateGates(operation string, gates []GateResponse) error {
+	for i, gate := range gates {
+		if gate.ID <= 0 || gate.Title == "" {
+			return malformed(operation, fmt.Sprintf("gate[%d] is missing id or title", i))
+		}
+	}
+	return nil
+}
+
+func malformed(operation, detail string) error {
+	return newUpstreamError(operation, UpstreamMalformed, 200, detail, nil)
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"fmt"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoadTimezone returns the zone with an IANA name such as Asia/Bishkek. "Local" is refused, as it
+// depends on the server.
+func LoadTimezone(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "" || name == "Local" {
+		return nil, fmt.Errorf("unknown timezone %q, use an IANA zone name such as Asia/Bishkek", name)
+	}
+	return loc, nil
+}
+
+// DefaultTimezone returns DEFAULT_TIMEZONE, the zone of requests without X-Timezone or an admin
+// preference
+func DefaultTimezone() *time.Location {
+	if config.AppConfig() != nil {
+		if loc, err := LoadTimezone(config.AppConfig().Server.DefaultTimezone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// AdminTimezone returns the timezone an admin chose for the admin panel, or "" if they have not
+func AdminTimezone(adminID uuid.UUID) string {
+	var timezones []string
+	db.DB.Model(&models.Admin{}).Where("id = ?", adminID).Limit(1).Pluck("timezone", &timezones)
+	if len(timezones) == 0 {
+		return ""
+	}
+	return timezones[0]
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/metrics"
+	"ololo-gate/internal/models"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// tokenVersionCacheSize bounds the cached accounts; expired entries are dropped when it is reached
+const tokenVersionCacheSize = 10000
+
+const (
+	tokenVersionUser  = "user"
+	tokenVersionAdmin = "admin"
+)
+
+// TokenVersionCache caches what the JWT middleware checks every access token against: the
+// token version of admins, and the token version, account status, pending legal documents and
+// active sessions of users, so authenticated requests skip the database round trips. Changes
+// made on this instance invalidate the entry right away; those made on another instance take
+// effect within JWT_VERSION_CACHE_TTL.
+type TokenVersionCache struct {
+	mu         sync.Mutex
+	entries    map[tokenVersionKey]tokenVersionEntry
+	generation uint64 // Incremented on every invalidation, so loads racing one are not cached
+}
+
+type tokenVersionKey struct {
+	kind string // tokenVersionUser or tokenVersionAdmin
+	id   uuid.UUID
+}
+
+type tokenVersionEntry struct {
+	version  int
+	user     UserAuthState                    // Set for users
+	sessions map[uuid.UUID]models.UserSession // Sessions of the user found active since the entry was loaded
+	loadedAt time.Time
+	partial  bool // Not cached, e.g. the legal acceptances could not be checked
+}
+
+// UserAuthState is what the JWT middleware checks of a user on every request
+type UserAuthState struct {
+	TokenVersion       int
+	Trashed            bool
+	RegistrationStatus string
+	PendingLegalKinds  []string // Legal document kinds whose current version is not accepted yet
+}
+
+// Active reports whether the user may still use their tokens: not trashed and approved
+func (s UserAuthState) Active() bool {
+	return !s.Trashed && s.RegistrationStatus == models.RegistrationApproved
+}
+
+var (
+	tokenVersionCache     *TokenVersionCache
+	tokenVersionCacheOnce sync.Once
+)
+
+// TokenVersions returns the process-wide token version cache
+func TokenVersions() *TokenVersionCache {
+	tokenVersionCacheOnce.Do(func() {
+		tokenVersionCache = &TokenVersionCache{entries: make(map[tokenVersionKey]tokenVersionEntry)}
+	})
+	return tokenVersionCache
+}
+
+// User returns the auth state of a user that is not deleted
+func (c *TokenVersionCache) User(id uuid.UUID) (UserAuthState, error) {
+	entry, err := c.lookup(tokenVersionKey{tokenVersionUser, id}, func() (tokenVersionEntry, error) {
+		var user models.User
+		if err := db.DB.Select("id", "token_version", "trashed_at", "registration_status").First(&user, "id = ?", id).Error; err != nil {
+			return tokenVersionEntry{}, err
+		}
+		entry := tokenVersionEntry{
+			version: user.TokenVersion,
+			user: UserAuthState{
+				TokenVersion:       user.TokenVersion,
+				Trashed:            user.TrashedAt != nil,
+				RegistrationStatus: user.RegistrationStatus,
+			},
+			sessions: make(map[uuid.UUID]models.UserSession),
+		}
+		pending, err := PendingLegalKinds(id)
+		if err != nil {
+			slog.Error("[LEGAL] Failed to check legal acceptances", "user_id", id, "error", err)
+			entry.partial = true
+		}
+		entry.user.PendingLegalKinds = pending
+		return entry, nil
+	})
+	return entry.user, err
+}
+
+// Session returns the session of a user while it is active, or ErrSessionRevoked
+func (c *TokenVersionCache) Session(sessionID, userID uuid.UUID) (*models.UserSession, error) {
+	ttl := config.AppConfig().JWT.VersionCacheTTL
+	if ttl <= 0 {
+		return ValidateSession(sessionID, userID)
+	}
+
+	key := tokenVersionKey{tokenVersionUser, userID}
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	session, found := entry.sessions[sessionID]
+	generation := c.generation
+	c.mu.Unlock()
+	// A cached session still expires on time
+	if ok && found && time.Since(entry.loadedAt) < ttl && session.IsActive() {
+		metrics.IncCounter("token_version_cache_lookups_total", metrics.Labels{"kind": "session", "result": "hit"})
+		return &session, nil
+	}
+	metrics.IncCounter("token_version_cache_lookups_total", metrics.Labels{"kind": "session", "result": "miss"})
+
+	loaded, err := ValidateSession(sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[key]; ok && c.generation == generation && entry.sessions != nil {
+		entry.sessions[sessionID] = *loaded
+	}
+	return loaded, nil
+}
+
+// Admin returns the token version of an admin that is not deleted
+func (c *TokenVersionCache) Admin(id uuid.UUID) (int, error) {
+	entry, err := c.lookup(tokenVersionKey{tokenVersionAdmin, id}, func() (tokenVersionEntry, error) {
+		var admin models.Admin
+		err := db.DB.Select("id", "token_version").First(&admin, "id = ?", id).Error
+		return tokenVersionEntry{version: admin.TokenVersion}, err
+	})
+	return entry.version, err
+}
+
+// InvalidateUser makes the next lookup of the user read the database, after its token
+// version was bumped, a session was revoked, its status changed, it accepted a legal
+// document or it was deleted
+func (c *TokenVersionCache) InvalidateUser(id uuid.UUID) {
+	c.invalidate(tokenVersionKey{tokenVersionUser, id})
+}
+
+// InvalidateUsers drops every cached user, e.g. after a legal document was published
+func (c *TokenVersionCache) InvalidateUsers() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if key.kind == tokenVersionUser {
+			delete(c.entries, key)
+		}
+	}
+	c.generation++
+}
+
+// InvalidateAdmin makes the next lookup of the admin read the database, after its token
+// version was bumped or it was deleted
+func (c *TokenVersionCache) InvalidateAdmin(id uuid.UUID) {
+	c.invalidate(tokenVersionKey{tokenVersionAdmin, id})
+}
+
+// lookup returns the cached entry while it is fresh, and otherwise loads it. Accounts that
+// are not found are not cached.
+func (c *TokenVersionCache) lookup(key tokenVersionKey, load func() (tokenVersionEntry, error)) (tokenVersionEntry, error) {
+	ttl := config.AppConfig().JWT.VersionCacheTTL
+	if ttl <= 0 {
+		return load()
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && time.Since(entry.loadedAt) < ttl {
+		metrics.IncCounter("token_version_cache_lookups_total", metrics.Labels{"kind": key.kind, "result": "hit"})
+		return entry, nil
+	}
+	metrics.IncCounter("token_version_cache_lookups_total", metrics.Labels{"kind": key.kind, "result": "miss"})
+
+	entry, err := load()
+	if err != nil {
+		return tokenVersionEntry{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation || entry.partial {
+		return entry, nil
+	}
+	if len(c.entries) >= tokenVersionCacheSize {
+		c.evictExpired(ttl)
+	}
+	entry.loadedAt = time.Now()
+	c.entries[key] = entry
+	return entry, nil
+}
+
+func (c *TokenVersionCache) invalidate(key tokenVersionKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+	c.generation++
+}
+
+// evictExpired drops expired entries, or every entry if none has expired. Callers hold c.mu.
+func (c *TokenVersionCache) evictExpired(ttl time.Duration) {
+	for key, entry := range c.entries {
+		if time.Since(entry.loadedAt) >= ttl {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) >= tokenVersionCacheSize {
+		c.entries = make(map[tokenVersionKey]tokenVersionEntry)
+	}
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupTokenVersionTest(t *testing.T, ttl time.Duration) models.Admin {
+	config.SetAppConfig(&config.Config{JWT: config.JWTConfig{VersionCacheTTL: ttl}})
+	setupServiceTestDB(t, &models.Admin{})
+
+	admin := models.Admin{Username: "cached", Password: "hash", Role: models.RoleRegular}
+	assert.NoError(t, db.DB.Create(&admin).Error)
+	return admin
+}
+
+func bumpAdminTokenVersion(t *testing.T, id uuid.UUID) {
+	assert.NoError(t, db.DB.Model(&models.Admin{}).Where("id = ?", id).
+		UpdateColumn("token_version", gorm.Expr("token_version + 1")).Error)
+}
+
+func TestTokenVersionCache_ServesCachedVersionUntilInvalidated(t *testing.T) {
+	admin := setupTokenVersionTest(t, time.Minute)
+	cache := &TokenVersionCache{entries: make(map[tokenVersionKey]tokenVersionEntry)}
+
+	version, err := cache.Admin(admin.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	// A bump on another instance is not seen until the entry expires
+	bumpAdminTokenVersion(t, admin.ID)
+	version, _ = cache.Admin(admin.ID)
+	assert.Equal(t, 0, version)
+
+	// A bump on this instance is seen right away
+	cache.InvalidateAdmin(admin.ID)
+	version, _ = cache.Admin(admin.ID)
+	assert.Equal(t, 1, version)
+
+	// Deleted accounts are not served from the cache either
+	assert.NoError(t, db.DB.Delete(&admin).Error)
+	cache.InvalidateAdmin(admin.ID)
+	_, err = cache.Admin(admin.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestTokenVersionCache_ExpiresEntries(t *testing.T) {
+	admin := setupTokenVersionTest(t, time.Minute)
+	cache := &TokenVersionCache{entries: make(map[tokenVersionKey]tokenVersionEntry)}
+
+	_, err := cache.Admin(admin.ID)
+	assert.NoError(t, err)
+	bumpAdminTokenVersion(t, admin.ID)
+
+	key := tokenVersionKey{tokenVersionAdmin, admin.ID}
+	cache.entries[key] = tokenVersionEntry{version: 0, loadedAt: time.Now().Add(-2 * time.Minute)}
+	version, _ := cache.Admin(admin.ID)
+	assert.Equal(t, 1, version)
+}
+
+func TestTokenVersionCache_DisabledWithZeroTTL(t *testing.T) {
+	admin := setupTokenVersionTest(t, 0)
+	cache := &TokenVersionCache{entries: make(map[tokenVersionKey]tokenVersionEntry)}
+
+	_, err := cache.Admin(admin.ID)
+	assert.NoError(t, err)
+	bumpAdminTokenVersion(t, admin.ID)
+
+	version, _ := cache.Admin(admin.ID)
+	assert.Equal(t, 1, version)
+	assert.Empty(t, cache.entries)
+}
+
+func TestTokenVersionCache_UserStateInvalidatedOnSessionAndLegalChanges(t *testing.T) {
+	setupTokenVersionTest(t, time.Minute)
+	assert.NoError(t, db.DB.AutoMigrate(&models.User{}, &models.UserPhone{}, &models.UserSession{}, &models.LegalDocument{}, &models.LegalAcceptance{}))
+	InvalidateLegalDocuments()
+	t.Cleanup(InvalidateLegalDocuments)
+	cache := TokenVersions()
+
+	user := models.User{Phone: "+77771239001", Password: "password123"}
+	assert.NoError(t, db.DB.Create(&user).Error)
+	session, err := CreateSession(context.Background(), user.ID, "phone", "", "", time.Hour, false)
+	assert.NoError(t, err)
+
+	state, err := cache.User(user.ID)
+	assert.NoError(t, err)
+	assert.True(t, state.Active())
+	assert.Empty(t, state.PendingLegalKinds)
+	_, err = cache.Session(session.ID, user.ID)
+	assert.NoError(t, err)
+
+	// Changes made on another instance are not seen until the entry expires
+	assert.NoError(t, db.DB.Model(&models.UserSession{}).Where("id = ?", session.ID).Update("device_id", "tablet").Error)
+	cached, err := cache.Session(session.ID, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "phone", cached.DeviceID)
+
+	// Publishing and accepting legal documents on this instance is seen right away
+	assert.NoError(t, PublishLegalDocument(&models.LegalDocument{Kind: models.LegalTermsOfService, Version: "2025-01"}))
+	state, _ = cache.User(user.ID)
+	assert.Equal(t, []string{models.LegalTermsOfService}, state.PendingLegalKinds)
+	_, err = AcceptLegalDocument(user.ID, models.LegalTermsOfService, "2025-01", "")
+	assert.NoError(t, err)
+	state, _ = cache.User(user.ID)
+	assert.Empty(t, state.PendingLegalKinds)
+
+	// So is revoking the session
+	revoked, err := RevokeSession(session.ID, user.ID)
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+	_, err = cache.Session(session.ID, user.ID)
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"ololo-gate/internal/events"
+	"ololo-gate/internal/metrics"
+)
+
+// UpstreamErrorKind classifies why a third-party API call failed
+type UpstreamErrorKind string
+
+const (
+	UpstreamUnavailable UpstreamErrorKind = "provider_unavailable" // Network failure, timeout, 5xx or 429
+	UpstreamMalformed   UpstreamErrorKind = "malformed_response"   // Response did not match the expected schema
+	UpstreamNotFound    UpstreamErrorKind = "not_found"            // Provider returned 404 or the resource is missing
+	UpstreamRejected    UpstreamErrorKind = "rejected"             // Provider returned another 4xx
+)
+
+// UpstreamError describes a failed third-party API call
+type UpstreamError struct {
+	Kind       UpstreamErrorKind
+	Operation  string // Client operation, e.g. "open_gate"
+	StatusCode int    // HTTP status returned by the provider (0 if no response)
+	Detail     string
+	Err        error
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("third-party %s failed (%s): %s", e.Operation, e.Kind, e.Detail)
+}
+
+func (e *UpstreamError) Unwrap() error {
+	return e.Err
+}
+
+// newUpstreamError creates an UpstreamError and records it in the error metrics
+func newUpstreamError(operation string, kind UpstreamErrorKind, statusCode int, detail string, err error) *UpstreamError {
+	metrics.IncCounter("third_party_errors_total", metrics.Labels{
+		"operation": operation,
+		"kind":      string(kind),
+	})
+	if kind == UpstreamUnavailable {
+		events.Publish(events.ProviderUnavailable, map[string]interface{}{
+			"operation":   operation,
+			"status_code": statusCode,
+			"detail":      detail,
+		})
+	}
+	return &UpstreamError{
+		Kind:       kind,
+		Operation:  operation,
+		StatusCode: statusCode,
+		Detail:     detail,
+		Err:        err,
+	}
+}
+
+// classifyStatus maps a non-200 provider status code to an error kind
+func classifyStatus(statusCode int) UpstreamErrorKind {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return UpstreamNotFound
+	case statusCode == http.StatusTooManyRequests, statusCode == http.StatusRequestTimeout, statusCode >= 500:
+		return UpstreamUnavailable
+	default:
+		return UpstreamRejected
+	}
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"errors"
+	"net/mail"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/pii"
+)
+
+// ErrInvalidEmail is returned by ParseEmail for values that are not a bare email address
+var ErrInvalidEmail = errors.New("invalid email address")
+
+// ParseEmail validates a bare email address (no display name) and returns it normalized
+func ParseEmail(raw string) (string, error) {
+	email := models.NormalizeEmail(raw)
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidEmail
+	}
+	return email, nil
+}
+
+// FindUserByEmail returns the user whose verified email is email. Users in the trash are not
+// returned. email must be normalized.
+func FindUserByEmail(email string) (models.User, error) {
+	var user models.User
+	err := db.DB.Scopes(notTrashed).Where("email_index = ? AND email_verified_at IS NOT NULL", pii.BlindIndex(email)).First(&user).Error
+	return user, err
+}
+
+// EmailInUse reports whether email belongs to any user, verified or not
+func EmailInUse(email string) bool {
+	var count int64
+	db.DB.Model(&models.User{}).Where("email_index = ?", pii.BlindIndex(email)).Count(&count)
+	return count > 0
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"encoding/json"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// Actors of user history entries that are not admins
+const (
+	HistoryActorSelf   = "self"   // The user, e.g. on self-registration
+	HistoryActorSystem = "system" // Background jobs
+)
+
+// UserSnapshot holds the fields of a user tracked in its history
+type UserSnapshot struct {
+	Phone  string
+	Email  string
+	Status string
+}
+
+// SnapshotUser returns the tracked fields of the user. Status is "trashed" for users in
+// the trash and the registration status otherwise.
+func SnapshotUser(user models.User) UserSnapshot {
+	status := user.RegistrationStatus
+	if user.TrashedAt != nil {
+		status = "trashed"
+	}
+	return UserSnapshot{Phone: user.Phone, Email: user.Email, Status: status}
+}
+
+// FieldChanges collects the changed fields of a user or admin history entry
+type FieldChanges map[string]models.FieldChange
+
+// Set records a field's value before and after the change
+func (c FieldChanges) Set(field string, before, after interface{}) FieldChanges {
+	c[field] = models.FieldChange{Before: before, After: after}
+	return c
+}
+
+// DiffUser records the user snapshot fields that differ between before and after
+func (c FieldChanges) DiffUser(before, after UserSnapshot) FieldChanges {
+	diff := func(field, from, to string) {
+		if from != to {
+			c.Set(field, emptyAsNil(from), to)
+		}
+	}
+	diff("phone", before.Phone, after.Phone)
+	diff("email", before.Email, after.Email)
+	diff("status", before.Status, after.Status)
+	return c
+}
+
+// emptyAsNil records unset values as null
+func emptyAsNil(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// RecordUserHistory stores a history entry for the user. Failures are logged rather than
+// returned, so the change itself is never rolled back for lack of history.
+func RecordUserHistory(userID uuid.UUID, action, actor string, changes FieldChanges) {
+	if changes == nil {
+		changes = FieldChanges{}
+	}
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		slog.Error("[USER_HISTORY] Failed to encode user changes", "action", action, "user_id", userID, "error", err)
+		return
+	}
+	entry := models.UserHistory{UserID: userID, Action: action, Changes: string(encoded), Actor: actor}
+	if err := db.DB.Create(&entry).Error; err != nil {
+		slog.Error("[USER_HISTORY] Failed to record user change", "action", action, "user_id", userID, "error", err)
+	}
+}
+
+// PreviousAssignment returns the locations and gates phone can access at the provider before
+// it is reassigned, for the user's history. When they cannot be read, nil is returned, so the
+// previous assignment is recorded as unknown.
+func PreviousAssignment(client *ThirdPartyClient, phone string) interface{} {
+	current, err := CurrentAssignment(client, phone)
+	if err != nil {
+		slog.Error("[USER_HISTORY] Failed to read the current assignment", "phone", phone, "error", err)
+		return nil
+	}
+	return current
+}
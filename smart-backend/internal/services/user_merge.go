@@ -0,0 +1,274 @@
+package services
+
+import (
+	"fmt"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/phonenumber"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DuplicateGroup is a set of users that likely belong to the same person
+type DuplicateGroup struct {
+	Phone string        // Canonical E.164 number the users share (digits only if it cannot be normalized)
+	Users []models.User // Oldest first; the oldest account is the suggested merge target
+}
+
+// FindDuplicateUsers groups users whose phone numbers, primary or verified secondary, are the
+// same number once normalized. Imports stored numbers in many formats ("8 777 ...", "+7777..."),
+// which the blind index treats as different values, so the numbers are compared in plaintext.
+func FindDuplicateUsers() ([]DuplicateGroup, error) {
+	owners := make(map[string]map[uuid.UUID]bool)
+	addOwner := func(phone string, userID uuid.UUID) {
+		key := duplicateKey(phone)
+		if key == "" {
+			return
+		}
+		if owners[key] == nil {
+			owners[key] = make(map[uuid.UUID]bool)
+		}
+		owners[key][userID] = true
+	}
+
+	usersByID := make(map[uuid.UUID]models.User)
+	var users []models.User
+	if err := db.DB.FindInBatches(&users, 500, func(tx *gorm.DB, batch int) error {
+		for _, user := range users {
+			usersByID[user.ID] = user
+			addOwner(user.Phone, user.ID)
+		}
+		return nil
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	var phones []models.UserPhone
+	if err := db.DB.Where("is_primary = ? AND verified_at IS NOT NULL", false).
+		FindInBatches(&phones, 500, func(tx *gorm.DB, batch int) error {
+			for _, phone := range phones {
+				if _, ok := usersByID[phone.UserID]; ok {
+					addOwner(phone.Phone, phone.UserID)
+				}
+			}
+			return nil
+		}).Error; err != nil {
+		return nil, err
+	}
+
+	groups := []DuplicateGroup{}
+	for key, ids := range owners {
+		if len(ids) < 2 {
+			continue
+		}
+		group := DuplicateGroup{Phone: key}
+		for id := range ids {
+			group.Users = append(group.Users, usersByID[id])
+		}
+		sort.Slice(group.Users, func(i, j int) bool {
+			return group.Users[i].CreatedAt.Before(group.Users[j].CreatedAt)
+		})
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Phone < groups[j].Phone })
+	return groups, nil
+}
+
+// duplicateKey returns the value phone is compared by when looking for duplicates
+func duplicateKey(phone string) string {
+	if normalized, err := phonenumber.Normalize(phone); err == nil {
+		return normalized
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+// MergeResult summarizes what MergeUsers moved into the target account
+type MergeResult struct {
+	PhonesAdded       []string // Source numbers that became verified secondary numbers of the target
+	SourcePhones      []string // Every number of the merged users, as stored before the merge
+	SessionsMoved     int64    // Device sessions moved to the target (revoked; the devices log in again)
+	GateCommandsMoved int64    // Gate command history moved to the target
+	EmailMoved        bool     // The target took over a source user's email
+}
+
+// MergeUsers consolidates the source users into target in one transaction: their numbers become
+// verified secondary numbers of target (numbers target already has are dropped), their device
+// sessions and gate history move to target, and target takes over a verified email if it has none.
+// The source users are then deleted. Provider assignments are consolidated separately with
+// ConsolidateAssignments, as they cannot be part of the transaction.
+func MergeUsers(target models.User, sources []models.User, mergedBy string) (MergeResult, error) {
+	var result MergeResult
+
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		known := make(map[string]bool)
+		var targetPhones []models.UserPhone
+		if err := tx.Where("user_id = ?", target.ID).Find(&targetPhones).Error; err != nil {
+			return err
+		}
+		known[duplicateKey(target.Phone)] = true
+		for _, phone := range targetPhones {
+			known[duplicateKey(phone.Phone)] = true
+		}
+
+		now := time.Now()
+		for _, source := range sources {
+			var sourcePhones []models.UserPhone
+			if err := tx.Where("user_id = ?", source.ID).Order("is_primary DESC, created_at").Find(&sourcePhones).Error; err != nil {
+				return err
+			}
+			numbers := []string{source.Phone}
+			for _, phone := range sourcePhones {
+				if phone.Phone != source.Phone && phone.VerifiedAt != nil {
+					numbers = append(numbers, phone.Phone)
+				}
+			}
+			result.SourcePhones = append(result.SourcePhones, numbers...)
+
+			// Release the source numbers before they are added to the target
+			if err := tx.Where("user_id = ?", source.ID).Delete(&models.UserPhone{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&source).Error; err != nil {
+				return err
+			}
+
+			for _, number := range numbers {
+				key := duplicateKey(number)
+				if key == "" || known[key] {
+					continue
+				}
+				known[key] = true
+
+				phone := number
+				if normalized, err := phonenumber.Normalize(number); err == nil {
+					phone = normalized
+				}
+				verifiedAt := now
+				if err := tx.Create(&models.UserPhone{UserID: target.ID, Phone: phone, VerifiedAt: &verifiedAt, AddedBy: mergedBy}).Error; err != nil {
+					return fmt.Errorf("failed to add %s to the target user: %w", phone, err)
+				}
+				result.PhonesAdded = append(result.PhonesAdded, phone)
+			}
+
+			// Tokens issued to the source user stop working once it is deleted, so moved sessions are revoked
+			sessions := tx.Model(&models.UserSession{}).Where("user_id = ?", source.ID).
+				Updates(map[string]interface{}{"user_id": target.ID, "revoked_at": gorm.Expr("COALESCE(revoked_at, ?)", now)})
+			if sessions.Error != nil {
+				return sessions.Error
+			}
+			result.SessionsMoved += sessions.RowsAffected
+
+			commands := tx.Model(&models.GateCommand{}).Where("user_id = ?", source.ID).Update("user_id", target.ID)
+			if commands.Error != nil {
+				return commands.Error
+			}
+			result.GateCommandsMoved += commands.RowsAffected
+
+			if target.Email == "" && source.Email != "" && source.EmailVerifiedAt != nil {
+				target.Email = source.Email
+				target.EmailVerifiedAt = source.EmailVerifiedAt
+				result.EmailMoved = true
+			}
+		}
+
+		if result.EmailMoved {
+			return tx.Save(&target).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	sourceIDs := make([]uuid.UUID, len(sources))
+	for i, source := range sources {
+		sourceIDs[i] = source.ID
+		TokenVersions().InvalidateUser(source.ID)
+		RecordUserHistory(source.ID, models.UserHistoryMergedInto, mergedBy, FieldChanges{}.
+			Set("status", SnapshotUser(source).Status, "deleted").
+			Set("merged_into", nil, target.ID))
+	}
+	changes := FieldChanges{}.Set("merged_users", nil, sourceIDs)
+	if len(result.PhonesAdded) > 0 {
+		changes.Set("secondary_phones", nil, result.PhonesAdded)
+	}
+	if result.EmailMoved {
+		changes.Set("email", nil, target.Email)
+	}
+	RecordUserHistory(target.ID, models.UserHistoryMerged, mergedBy, changes)
+	return result, nil
+}
+
+// ConsolidateAssignments gives every number of target the union of the locations and gates that
+// target and the merged numbers had at the provider, and revokes merged numbers that are no longer
+// used as stored (e.g. a differently formatted copy of a target number). All numbers are attempted;
+// the first error is returned.
+func ConsolidateAssignments(client *ThirdPartyClient, target models.User, sourcePhones []string) error {
+	var firstErr error
+	record := func(err error) {
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	gates := make(map[int]map[int]bool)
+	var order []int
+	for _, phone := range append([]string{target.Phone}, sourcePhones...) {
+		assignment, err := CurrentAssignment(client, phone)
+		if err != nil {
+			record(fmt.Errorf("failed to read assignment of %s: %w", phone, err))
+			continue
+		}
+		for _, location := range assignment {
+			if gates[location.LocationID] == nil {
+				gates[location.LocationID] = make(map[int]bool)
+				order = append(order, location.LocationID)
+			}
+			for _, gateID := range location.GateIds {
+				gates[location.LocationID][gateID] = true
+			}
+		}
+	}
+	if firstErr != nil {
+		// Assigning a partial union would revoke access the unread numbers had
+		return firstErr
+	}
+
+	locations := make([]LocationAssignmentDTO, 0, len(order))
+	for _, locationID := range order {
+		gateIDs := make([]int, 0, len(gates[locationID]))
+		for gateID := range gates[locationID] {
+			gateIDs = append(gateIDs, gateID)
+		}
+		sort.Ints(gateIDs)
+		locations = append(locations, LocationAssignmentDTO{LocationID: locationID, GateIds: gateIDs})
+	}
+	if len(locations) > 0 {
+		record(AssignAllPhones(client, target, locations))
+	}
+
+	current := make(map[string]bool)
+	for _, phone := range UserPhoneNumbers(target) {
+		current[phone] = true
+	}
+	for _, phone := range sourcePhones {
+		if current[phone] {
+			continue
+		}
+		record(client.AssignUserToLocationsAndGates(UserLocationGateAssignmentDTO{
+			Phone:     phone,
+			Locations: []LocationAssignmentDTO{},
+		}))
+	}
+	return firstErr
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"ololo-gate/internal/pii"
+
+	"gorm.io/gorm"
+)
+
+// FindUserByPhone returns the user owning phone, which may be their primary number or any
+// verified secondary number. Users in the trash are not returned. phone must be in canonical
+// E.164 form.
+func FindUserByPhone(phone string) (models.User, error) {
+	var user models.User
+	index := pii.BlindIndex(phone)
+
+	var userPhone models.UserPhone
+	err := db.DB.Where("phone_index = ? AND verified_at IS NOT NULL", index).First(&userPhone).Error
+	if err == nil {
+		return user, db.DB.Scopes(notTrashed).First(&user, "id = ?", userPhone.UserID).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user, err
+	}
+
+	// Users without user_phones rows yet (e.g. created before the table existed)
+	return user, db.DB.Scopes(notTrashed).Where("phone_index = ?", index).First(&user).Error
+}
+
+// PhoneInUse reports whether phone is the primary or a secondary number of any user
+func PhoneInUse(phone string) bool {
+	index := pii.BlindIndex(phone)
+
+	var count int64
+	db.DB.Model(&models.UserPhone{}).Where("phone_index = ?", index).Count(&count)
+	if count > 0 {
+		return true
+	}
+	db.DB.Model(&models.User{}).Where("phone_index = ?", index).Count(&count)
+	return count > 0
+}
+
+// UserPhoneNumbers returns the verified numbers of the user, primary first
+func UserPhoneNumbers(user models.User) []string {
+	numbers := []string{user.Phone}
+
+	var phones []models.UserPhone
+	if err := db.DB.Where("user_id = ? AND is_primary = ? AND verified_at IS NOT NULL", user.ID, false).
+		Order("created_at").Find(&phones).Error; err != nil {
+		slog.Error("[USER_PHONES] Failed to load secondary numbers", "user_id", user.ID, "error", err)
+		return numbers
+	}
+	for _, phone := range phones {
+		numbers = append(numbers, phone.Phone)
+	}
+	return numbers
+}
+
+// AssignAllPhones assigns every verified number of the user to the locations and gates.
+// The provider grants access per phone, so secondary numbers need the same assignment as the
+// primary. All numbers are attempted; the first error is returned.
+func AssignAllPhones(client *ThirdPartyClient, user models.User, locations []LocationAssignmentDTO) error {
+	var firstErr error
+	for _, phone := range UserPhoneNumbers(user) {
+		err := client.AssignUserToLocationsAndGates(UserLocationGateAssignmentDTO{
+			Phone:     phone,
+			Locations: locations,
+		})
+		if err != nil {
+			slog.Warn("[USER_PHONES] Failed to assign number", "phone", phone, "user_id", user.ID, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// CurrentAssignment returns the locations and gates the phone can access at the provider,
+// in the form AssignUserToLocationsAndGates takes
+func CurrentAssignment(client *ThirdPartyClient, phone string) ([]LocationAssignmentDTO, error) {
+	locations, err := client.GetAllLocationsWithGates(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment := make([]LocationAssignmentDTO, 0, len(locations))
+	for _, location := range locations {
+		gateIDs := make([]int, 0, len(location.Gates))
+		for _, gate := range location.Gates {
+			gateIDs = append(gateIDs, gate.ID)
+		}
+		assignment = append(assignment, LocationAssignmentDTO{LocationID: location.ID, GateIds: gateIDs})
+	}
+	return assignment, nil
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrUserTrashed is returned when a user is already in the trash
+var ErrUserTrashed = errors.New("user is already in the trash")
+
+// ErrUserNotTrashed is returned when restoring a user that is not in the trash
+var ErrUserNotTrashed = errors.New("user is not in the trash")
+
+// TrashPurgeAt returns when a user trashed at trashedAt is purged
+func TrashPurgeAt(trashedAt time.Time) time.Time {
+	return trashedAt.Add(config.AppConfig().Users.TrashRetention)
+}
+
+// TrashUser moves the user to the trash. Login is blocked and every token and session is
+// revoked, but the user's numbers and provider assignments are kept so RestoreUser can undo it
+// until PurgeTrashedUsers removes the user.
+func TrashUser(ctx context.Context, user *models.User, trashedBy string) error {
+	if user.TrashedAt != nil {
+		return ErrUserTrashed
+	}
+	before := SnapshotUser(*user)
+	now := time.Now()
+	if err := db.DB.Model(user).Updates(map[string]interface{}{
+		"trashed_at":    now,
+		"trashed_by":    trashedBy,
+		"token_version": user.TokenVersion + 1,
+	}).Error; err != nil {
+		return err
+	}
+	user.TrashedAt = &now
+	user.TrashedBy = trashedBy
+	user.TokenVersion++
+	RevokeAllSessions(ctx, user.ID)
+	RecordUserHistory(user.ID, models.UserHistoryTrashed, trashedBy, FieldChanges{}.DiffUser(before, SnapshotUser(*user)))
+	return nil
+}
+
+// RestoreUser takes the user out of the trash. The user logs in again with their existing password.
+func RestoreUser(user *models.User, restoredBy string) error {
+	if user.TrashedAt == nil {
+		return ErrUserNotTrashed
+	}
+	before := SnapshotUser(*user)
+	if err := db.DB.Model(user).Updates(map[string]interface{}{
+		"trashed_at": nil,
+		"trashed_by": "",
+	}).Error; err != nil {
+		return err
+	}
+	user.TrashedAt = nil
+	user.TrashedBy = ""
+	TokenVersions().InvalidateUser(user.ID)
+	RecordUserHistory(user.ID, models.UserHistoryRestored, restoredBy, FieldChanges{}.DiffUser(before, SnapshotUser(*user)))
+	return nil
+}
+
+// PurgeTrashedUsers permanently removes users trashed before the cutoff: every number of the
+// user is sent an empty assignment, revoking its gate access at the provider, and the user is
+// deleted, releasing their numbers. Users whose access cannot be revoked stay in the trash and
+// are retried on the next run; the first such error is returned.
+func PurgeTrashedUsers(client *ThirdPartyClient, cutoff time.Time) (int, error) {
+	var users []models.User
+	if err := db.DB.Where("trashed_at IS NOT NULL AND trashed_at < ?", cutoff).Find(&users).Error; err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var firstErr error
+	for i := range users {
+		user := &users[i]
+		if err := AssignAllPhones(client, *user, []LocationAssignmentDTO{}); err != nil {
+			slog.Warn("[USER_TRASH] Failed to revoke gate access of trashed user, retrying on the next run", "user_id", user.ID, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if err := db.DB.Delete(user).Error; err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		TokenVersions().InvalidateUser(user.ID)
+		RecordUserHistory(user.ID, models.UserHistoryPurged, HistoryActorSystem, FieldChanges{}.
+			Set("status", "trashed", "deleted").
+			Set("assignments", nil, []LocationAssignmentDTO{}))
+		purged++
+	}
+	return purged, firstErr
+}
+
+// notTrashed limits a users query to users that are not in the trash
+func notTrashed(tx *gorm.DB) *gorm.DB {
+	return tx.Where("trashed_at IS NULL")
+}
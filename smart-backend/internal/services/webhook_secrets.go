@@ -0,0 +1,189 @@
+package services
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/db"
+	"ololo-gate/internal/models"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WebhookSignatureHeader carries the signatures of outbound webhooks
+const WebhookSignatureHeader = "X-Ololo-Signature"
+
+// webhookSecretPrefix starts every generated webhook secret
+const webhookSecretPrefix = "whsec_"
+
+// WebhookSecretScopes are the scopes whose secrets can be rotated
+var WebhookSecretScopes = []string{models.WebhookSecretOutbound, models.WebhookSecretProviderCallback}
+
+// ActiveWebhookSecrets returns the secrets of scope that are valid now, the current one first.
+// Until the scope is first rotated, this is the secret set in the configuration, if any.
+func ActiveWebhookSecrets(scope string) ([]string, error) {
+	var rows []models.WebhookSecret
+	if err := db.DB.Where("scope = ? AND (expires_at IS NULL OR expires_at > ?)", scope, time.Now()).
+		Order("expires_at IS NOT NULL, created_at DESC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		if configured := configuredWebhookSecret(scope); configured != "" {
+			return []string{configured}, nil
+		}
+		return nil, nil
+	}
+
+	secrets := make([]string, len(rows))
+	for i, row := range rows {
+		secrets[i] = row.Secret
+	}
+	return secrets, nil
+}
+
+// ListWebhookSecrets returns the secrets rotated in that are still valid, by scope, the current
+// one first. Secrets only set in the configuration are not listed.
+func ListWebhookSecrets() ([]models.WebhookSecret, error) {
+	var secrets []models.WebhookSecret
+	err := db.DB.Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("scope, expires_at IS NOT NULL, created_at DESC").Find(&secrets).Error
+	return secrets, err
+}
+
+// RotateWebhookSecret generates a new current secret for scope. The secret it replaces stays valid
+// for WEBHOOK_SECRET_OVERLAP, so deliveries keep working while the other side switches over; any
+// older secret expires right away. The returned secret is only shown once.
+func RotateWebhookSecret(scope, rotatedBy string) (models.WebhookSecret, string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return models.WebhookSecret{}, "", err
+	}
+	secret := webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(random)
+
+	now := time.Now()
+	expiresAt := now.Add(config.AppConfig().Webhooks.SecretOverlap)
+	current := models.WebhookSecret{Scope: scope, Secret: secret, Hint: webhookSecretHint(secret), CreatedBy: rotatedBy}
+
+	err := db.DB.Transaction(func(tx *gorm.DB) error {
+		// At most two secrets are active: the new one and the one it replaces
+		if err := tx.Model(&models.WebhookSecret{}).
+			Where("scope = ? AND expires_at > ?", scope, now).
+			Update("expires_at", now).Error; err != nil {
+			return err
+		}
+		replaced := tx.Model(&models.WebhookSecret{}).
+			Where("scope = ? AND expires_at IS NULL", scope).
+			Update("expires_at", expiresAt)
+		if replaced.Error != nil {
+			return replaced.Error
+		}
+
+		// The first rotation replaces the secret set in the configuration
+		if configured := configuredWebhookSecret(scope); replaced.RowsAffected == 0 && configured != "" {
+			previous := models.WebhookSecret{
+				Scope: scope, Secret: configured, Hint: webhookSecretHint(configured), CreatedBy: "config", ExpiresAt: &expiresAt,
+			}
+			if err := tx.Create(&previous).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(&current).Error
+	})
+	if err != nil {
+		return models.WebhookSecret{}, "", err
+	}
+	return current, secret, nil
+}
+
+// MatchWebhookSecret reports whether token is one of the active secrets returned by
+// ActiveWebhookSecrets. Tokens matching the previous secret are logged, so a rotation the other
+// side has not picked up yet can be followed up before the secret expires.
+func MatchWebhookSecret(secrets []string, token string) bool {
+	for i, secret := range secrets {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1 {
+			if i > 0 {
+				slog.Info("[WEBHOOK_SECRETS] Request authenticated with the previous secret")
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// SignWebhook returns the X-Ololo-Signature value for a webhook body sent at now:
+// "t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>">", with one v1 per active outbound
+// secret, so receivers holding either secret during a rotation can verify it. Returns "" when no
+// secret is set.
+func SignWebhook(body []byte, now time.Time) (string, error) {
+	secrets, err := ActiveWebhookSecrets(models.WebhookSecretOutbound)
+	if err != nil || len(secrets) == 0 {
+		return "", err
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	parts := []string{"t=" + timestamp}
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(body)
+		parts = append(parts, "v1="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// postWebhook posts a JSON body to a webhook, signed with the active outbound secrets; any non-2xx
+// response is an error
+func postWebhook(client *http.Client, url string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	signature, err := SignWebhook(body, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to sign webhook: %w", err)
+	}
+	if signature != "" {
+		req.Header.Set(WebhookSignatureHeader, signature)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// configuredWebhookSecret returns the secret of scope set in the configuration
+func configuredWebhookSecret(scope string) string {
+	switch scope {
+	case models.WebhookSecretOutbound:
+		return config.AppConfig().Webhooks.SigningSecret
+	case models.WebhookSecretProviderCallback:
+		return config.AppConfig().Gates.ProviderCallbackToken
+	}
+	return ""
+}
+
+// webhookSecretHint returns the last characters of a secret
+func webhookSecretHint(secret string) string {
+	if len(secret) <= 4 {
+		return ""
+	}
+	return secret[len(secret)-4:]
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"ololo-gate/internal/config"
+	"ololo-gate/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupWebhookSecretTestDB gives webhook senders an empty webhook_secrets table and the configuration
+func setupWebhookSecretTestDB(t *testing.T, cfg *config.Config) {
+	setupServiceTestDB(t, &models.WebhookSecret{})
+	config.SetAppConfig(cfg)
+}
+
+func TestPostWebhook_SignsWithConfiguredSecret(t *testing.T) {
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(WebhookSignatureHeader)
+	}))
+	defer server.Close()
+
+	// Unsigned without a secret
+	setupWebhookSecretTestDB(t, &config.Config{})
+	assert.NoError(t, postWebhook(server.Client(), server.URL, []byte(`{"a":1}`)))
+	assert.Empty(t, signature)
+
+	setupWebhookSecretTestDB(t, &config.Config{Webhooks: config.WebhooksConfig{SigningSecret: "s3cret"}})
+	assert.NoError(t, postWebhook(server.Client(), server.URL, []byte(`{"a":1}`)))
+
+	now := time.Unix(1760000000, 0)
+	expected, err := SignWebhook([]byte(`{"a":1}`), now)
+	assert.NoError(t, err)
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte(`1760000000.{"a":1}`))
+	assert.Equal(t, "t=1760000000,v1="+hex.EncodeToString(mac.Sum(nil)), expected)
+	assert.Regexp(t, `^t=\d+,v1=[0-9a-f]{64}$`, signature)
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage_PutGetAndSignedURL(t *testing.T) {
+	s := NewLocal(t.TempDir(), "https://api.example.com/", "secret")
+
+	assert.NoError(t, s.Put("reports/a.csv", "text/csv", strings.NewReader("id\n1\n")))
+	body, contentType, err := s.Get("reports/a.csv")
+	assert.NoError(t, err)
+	data, _ := io.ReadAll(body)
+	body.Close()
+	assert.Equal(t, "id\n1\n", string(data))
+	assert.Contains(t, contentType, "text/csv")
+
+	assert.NoError(t, s.Put("reports/b.csv", "text/csv", strings.NewReader("x")))
+	assert.NoError(t, s.Delete("reports/b.csv"))
+	assert.NoError(t, s.Delete("reports/b.csv"))
+	_, _, err = s.Get("reports/b.csv")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, _, err = s.Get("reports/missing.csv")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, s.Put("../escape.txt", "text/plain", strings.NewReader("x")), ErrInvalidKey)
+	assert.ErrorIs(t, s.Put("/etc/passwd", "text/plain", strings.NewReader("x")), ErrInvalidKey)
+
+	signed, err := s.SignURL("reports/a.csv", time.Minute)
+	assert.NoError(t, err)
+	u, err := url.Parse(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "api.example.com", u.Host)
+	assert.Equal(t, LocalFilesPath+"reports/a.csv", u.Path)
+
+	expires, sig := u.Query().Get("expires"), u.Query().Get("sig")
+	assert.NoError(t, s.Verify("reports/a.csv", expires, sig))
+	assert.ErrorIs(t, s.Verify("reports/b.csv", expires, sig), ErrSignatureInvalid)
+	assert.ErrorIs(t, s.Verify("reports/a.csv", expires+"0", sig), ErrSignatureInvalid)
+	assert.ErrorIs(t, NewLocal(t.TempDir(), "", "other").Verify("reports/a.csv", expires, sig), ErrSignatureInvalid)
+
+	expired, _ := s.SignURL("reports/a.csv", -time.Minute)
+	u, _ = url.Parse(expired)
+	assert.ErrorIs(t, s.Verify("reports/a.csv", u.Query().Get("expires"), u.Query().Get("sig")), ErrSignatureInvalid)
+}
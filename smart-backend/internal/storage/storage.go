@@ -0,0 +1,117 @@
+// Package storage keeps file artifacts (e.g. gate report photos) outside the database, on the
+// local disk or in an S3-compatible bucket, and hands out short-lived signed URLs to them.
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"ololo-gate/internal/config"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Storage drivers
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var (
+	// ErrNotFound is returned by Get for keys that are not stored
+	ErrNotFound = errors.New("file not found")
+	// ErrInvalidKey is returned for keys outside validKey, e.g. ones escaping the storage root
+	ErrInvalidKey = errors.New("invalid file key")
+)
+
+// validKey restricts keys to slash-separated segments of safe characters, so they need no
+// escaping in paths or URLs
+var validKey = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9._-]*(/[A-Za-z0-9_-][A-Za-z0-9._-]*)*$`)
+
+// Storage stores files by key, e.g. "gate-reports/<id>.jpg"
+type Storage interface {
+	// Put stores the file under key, replacing any file already there
+	Put(key, contentType string, body io.Reader) error
+	// Get opens the file stored under key and returns its content type, or ErrNotFound.
+	// The caller closes the reader.
+	Get(key string) (io.ReadCloser, string, error)
+	// SignURL returns a URL anyone can download the file from until ttl passes
+	SignURL(key string, ttl time.Duration) (string, error)
+	// Delete removes the file stored under key; deleting a missing file is not an error
+	Delete(key string) error
+}
+
+var (
+	defaultMu sync.Mutex
+	current   Storage
+)
+
+// Default returns the process-wide storage, created from config.AppConfig().Storage on first use
+func Default() (Storage, error) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if current == nil {
+		s, err := New(config.AppConfig().Storage)
+		if err != nil {
+			return nil, err
+		}
+		current = s
+	}
+	return current, nil
+}
+
+// SetDefault replaces the process-wide storage (nil = recreate it from config on next use)
+func SetDefault(s Storage) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	current = s
+}
+
+// New creates the storage selected by cfg.Driver
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case DriverLocal, "":
+		return NewLocal(cfg.LocalDir, cfg.PublicURL, config.AppConfig().JWT.Secret), nil
+	case DriverS3:
+		return NewS3(cfg.S3), nil
+	}
+	return nil, fmt.Errorf("unknown STORAGE_DRIVER %q, use local or s3", cfg.Driver)
+}
+
+// CheckKey returns ErrInvalidKey unless key is a safe relative path
+func CheckKey(key string) error {
+	if !validKey.MatchString(key) || strings.Contains(key, "..") {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+// ExtensionFor returns the file extension for a content type, e.g. ".jpg" for image/jpeg
+func ExtensionFor(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/webp":
+		return ".webp"
+	case "text/csv":
+		return ".csv"
+	}
+	if extensions, _ := mime.ExtensionsByType(contentType); len(extensions) > 0 {
+		return extensions[0]
+	}
+	return ""
+}
+
+// contentTypeFor guesses the content type of a stored file from its key's extension
+func contentTypeFor(key string) string {
+	if contentType := mime.TypeByExtension(path.Ext(key)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+)
+
+// Authenticator is a software WebAuthn authenticator holding one ES256 credential, for tests
+// of passkey registration and login
+type Authenticator struct {
+	RPID         string
+	Origin       string
+	CredentialID []byte
+	UserHandle   []byte
+	SignCount    uint32
+	UserVerified bool
+	key          *ecdsa.PrivateKey
+}
+
+// NewAuthenticator creates an authenticator for the relying party with a fresh credential
+func NewAuthenticator(rpID, origin string) *Authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	id := make([]byte, 16)
+	rand.Read(id)
+	return &Authenticator{RPID: rpID, Origin: origin, CredentialID: id, UserVerified: true, key: key}
+}
+
+// Create answers a credential creation challenge with "none" attestation and returns the
+// clientDataJSON and attestationObject, base64url-encoded as browsers send them
+func (a *Authenticator) Create(challenge string, userHandle []byte) (string, string) {
+	a.UserHandle = userHandle
+	clientData := a.clientData("webauthn.create", challenge)
+
+	coseKey := encodeCBOR(map[interface{}]interface{}{
+		int64(1):  int64(2),  // kty: EC2
+		int64(3):  int64(-7), // alg: ES256
+		int64(-1): int64(1),  // crv: P-256
+		int64(-2): a.key.PublicKey.X.FillBytes(make([]byte, 32)),
+		int64(-3): a.key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	attested := make([]byte, 16, 18+len(a.CredentialID)+len(coseKey)) // Zero AAGUID
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.CredentialID)))
+	attested = append(attested, a.CredentialID...)
+	attested = append(attested, coseKey...)
+
+	attestation := encodeCBOR(map[interface{}]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[interface{}]interface{}{},
+		"authData": a.authenticatorData(0x40, attested),
+	})
+	return base64.RawURLEncoding.EncodeToString(clientData), base64.RawURLEncoding.EncodeToString(attestation)
+}
+
+// Get answers an authentication challenge and returns the clientDataJSON, authenticatorData,
+// signature and user handle, base64url-encoded as browsers send them
+func (a *Authenticator) Get(challenge string) (string, string, string, string) {
+	clientData := a.clientData("webauthn.get", challenge)
+	a.SignCount++
+	authData := a.authenticatorData(0, nil)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		panic(err)
+	}
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode(clientData), encode(authData), encode(sig), encode(a.UserHandle)
+}
+
+// CredentialIDString returns the credential ID, base64url-encoded
+func (a *Authenticator) CredentialIDString() string {
+	return base64.RawURLEncoding.EncodeToString(a.CredentialID)
+}
+
+func (a *Authenticator) clientData(ceremony, challenge string) []byte {
+	clientData, _ := json.Marshal(map[string]interface{}{
+		"type":      ceremony,
+		"challenge": challenge,
+		"origin":    a.Origin,
+	})
+	return clientData
+}
+
+func (a *Authenticator) authenticatorData(flags byte, attested []byte) []byte {
+	flags |= 0x01 // User present
+	if a.UserVerified {
+		flags |= 0x04
+	}
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	data := append(rpIDHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, a.SignCount)
+	return append(data, attested...)
+}
+
+// encodeCBOR encodes the subset of CBOR used by authenticator responses, with map keys in
+// canonical order
+func encodeCBOR(value interface{}) []byte {
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return cborHeader(1, uint64(-1-v))
+		}
+		return cborHeader(0, uint64(v))
+	case []byte:
+		return append(cborHeader(2, uint64(len(v))), v...)
+	case string:
+		return append(cborHeader(3, uint64(len(v))), v...)
+	case map[interface{}]interface{}:
+		entries := make([][2][]byte, 0, len(v))
+		for key, item := range v {
+			entries = append(entries, [2][]byte{encodeCBOR(key), encodeCBOR(item)})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			if len(entries[i][0]) != len(entries[j][0]) {
+				return len(entries[i][0]) < len(entries[j][0])
+			}
+			return string(entries[i][0]) < string(entries[j][0])
+		})
+		out := cborHeader(5, uint64(len(v)))
+		for _, entry := range entries {
+			out = append(append(out, entry[0]...), entry[1]...)
+		}
+		return out
+	}
+	panic("encodeCBOR: unsupported type")
+}
+
+func cborHeader(major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		return []byte{major<<5 | 24, byte(arg)}
+	case arg <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+	case arg <= 0xffffffff:
+		return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(arg))
+	}
+	return binary.BigEndian.AppendUint64([]byte{major<<5 | 27}, arg)
+}
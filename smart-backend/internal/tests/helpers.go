@@ -24,7 +24,7 @@ func SetupTestDB(t *testing.T) {
 	}
 
 	// Auto-migrate test models
-	err = db.DB.AutoMigrate(&models.User{}, &models.Admin{})
+	err = db.DB.AutoMigrate(&models.User{}, &models.Admin{}, &models.UserSession{}, &models.UserPhone{}, &models.InviteCode{}, &models.LegalDocument{}, &models.LegalAcceptance{}, &models.UserHistory{}, &models.AdminHistory{}, &models.OTPCode{}, &models.PendingAssignment{})
 	if err != nil {
 		t.Fatalf("Failed to migrate test database: %v", err)
 	}
@@ -32,17 +32,18 @@ func SetupTestDB(t *testing.T) {
 
 // SetupTestConfig initializes test configuration
 func SetupTestConfig() {
-	config.AppConfig = &config.Config{
+	config.SetAppConfig(&config.Config{
 		JWT: config.JWTConfig{
 			Secret:        "test-secret-key",
 			AccessExpiry:  900000000000,   // 15 minutes in nanoseconds
 			RefreshExpiry: 2592000000000000, // 30 days in nanoseconds
+			RequireDevice: true,
 		},
 		Server: config.ServerConfig{
 			Port: "8080",
 			Env:  "test",
 		},
-	}
+	})
 }
 
 // CreateTestUser creates a test user in the database
@@ -108,4 +109,7 @@ func CleanupTestDB(t *testing.T) {
 	if err := db.DB.Exec("DELETE FROM admins").Error; err != nil {
 		t.Logf("Warning: Failed to cleanup admins: %v", err)
 	}
+	if err := db.DB.Exec("DELETE FROM user_phones").Error; err != nil {
+		t.Logf("Warning: Failed to cleanup user phones: %v", err)
+	}
 }
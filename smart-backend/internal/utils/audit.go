@@ -1,8 +1,9 @@
 package utils
 
 import (
-	"log"
+	"log/slog"
 	"ololo-gate/internal/db"
+	"ololo-gate/internal/events"
 	"ololo-gate/internal/models"
 
 	"github.com/google/uuid"
@@ -37,6 +38,18 @@ func LogAdminAction(
 	}
 
 	if err := db.DB.Create(&auditLog).Error; err != nil {
-		log.Printf("Error creating audit log: %v", err)
+		slog.Error("Error creating audit log", "error", err)
+		return
 	}
+
+	events.Publish(events.AuditEntry, map[string]interface{}{
+		"id":            auditLog.ID,
+		"admin_id":      adminID,
+		"admin_name":    adminName,
+		"action":        action,
+		"resource_type": resourceType,
+		"resource_id":   resourceID,
+		"status":        status,
+		"error_message": errorMessage,
+	})
 }
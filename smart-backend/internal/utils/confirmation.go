@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"ololo-gate/internal/config"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ConfirmationTokenType marks tokens that confirm a destructive admin operation
+const ConfirmationTokenType TokenType = "confirmation"
+
+// ErrConfirmationMismatch is returned for confirmation tokens issued to another admin or for another operation
+var ErrConfirmationMismatch = errors.New("confirmation token does not match this request")
+
+// ConfirmationClaims binds a confirmation token to the admin, the action and the exact targets
+// summarized to them, so the token cannot confirm a different operation
+type ConfirmationClaims struct {
+	AdminID   uuid.UUID `json:"admin_id"`
+	Action    string    `json:"action"`
+	Digest    string    `json:"digest"` // ConfirmationDigest of the targets
+	TokenType TokenType `json:"token_type"`
+	jwt.RegisteredClaims
+}
+
+// ConfirmationDigest returns an order-independent digest of the operation's targets
+func ConfirmationDigest(targets []string) string {
+	sorted := append([]string(nil), targets...)
+	sort.Strings(sorted)
+	sum := sha256.Sum256([]byte(strings.Join(sorted, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateConfirmationToken creates a short-lived token the admin echoes back to execute the action
+func GenerateConfirmationToken(adminID uuid.UUID, action, digest string, expiry time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(expiry)
+	claims := ConfirmationClaims{
+		AdminID:   adminID,
+		Action:    action,
+		Digest:    digest,
+		TokenType: ConfirmationTokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    config.AppConfig().JWT.Issuer,
+			Audience:  tokenAudience(),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.AppConfig().JWT.Secret))
+	return token, expiresAt, err
+}
+
+// ValidateConfirmationToken checks that the token is unexpired and was issued to the admin for
+// the same action and targets
+func ValidateConfirmationToken(tokenString string, adminID uuid.UUID, action, digest string) error {
+	token, err := jwt.ParseWithClaims(tokenString, &ConfirmationClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid signing method")
+		}
+		return []byte(config.AppConfig().JWT.Secret), nil
+	}, parserOptions()...)
+	if err != nil {
+		return err
+	}
+
+	claims, ok := token.Claims.(*ConfirmationClaims)
+	if !ok || !token.Valid || claims.TokenType != ConfirmationTokenType {
+		return errors.New("invalid confirmation token")
+	}
+	if claims.AdminID != adminID || claims.Action != action || claims.Digest != digest {
+		return ErrConfirmationMismatch
+	}
+	return nil
+}
@@ -2,8 +2,9 @@ package utils
 
 import (
 	"errors"
-	"log"
+	"log/slog"
 	"ololo-gate/internal/config"
+	"ololo-gate/internal/metrics"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -16,6 +17,14 @@ const (
 	AccessToken  TokenType = "access"
 	RefreshToken TokenType = "refresh"
 	AdminToken   TokenType = "admin"
+
+	AdminRefreshToken TokenType = "admin_refresh"
+)
+
+// Login identifiers recorded in the idt claim
+const (
+	IdentifierPhone = "phone"
+	IdentifierEmail = "email"
 )
 
 // Claims defines the JWT claims structure
@@ -24,9 +33,26 @@ type Claims struct {
 	Phone        string    `json:"phone"`
 	TokenType    TokenType `json:"token_type"`
 	TokenVersion int       `json:"token_version"` // Token version for invalidation
+	SessionID    uuid.UUID `json:"sid"`           // Device session the token belongs to (uuid.Nil for legacy tokens)
+	ClientType   string    `json:"client,omitempty"` // Client type the token lifetimes were chosen for
+	DeviceID     string    `json:"device_id,omitempty"` // Device the token was issued to at login
+	Identifier   string    `json:"idt,omitempty"` // Identifier the user logged in with (phone or email; empty for legacy tokens)
+	Impersonator string    `json:"imp,omitempty"` // ID of the admin the token was issued to for impersonation ("" for the user's own tokens)
+	BlockGates   bool      `json:"imp_block_gates,omitempty"` // Gate operations are refused with this impersonation token
 	jwt.RegisteredClaims
 }
 
+// TokenOptions carries optional per-login settings for GenerateTokensWithOptions
+type TokenOptions struct {
+	SessionID    uuid.UUID // Device session to bind the tokens to
+	ClientType   string    // Client type selecting token lifetimes from JWT_CLIENT_PROFILES
+	Trusted      bool      // Login from a trusted device ("remember me"), which gets JWT_TRUSTED_REFRESH_EXPIRY
+	DeviceID     string    // Device to bind the tokens to
+	Identifier   string    // Identifier the user logged in with (IdentifierPhone or IdentifierEmail)
+	Impersonator string    // Admin the token is issued to for impersonation
+	BlockGates   bool      // Refuse gate operations with the impersonation token
+}
+
 // TokenPair holds both access and refresh tokens
 type TokenPair struct {
 	AccessToken  string `json:"access_token"`
@@ -35,30 +61,33 @@ type TokenPair struct {
 
 // GenerateTokens creates both access and refresh tokens for a user
 func GenerateTokens(userID uuid.UUID, phone string, tokenVersion int) (*TokenPair, error) {
-	accessExpiryMinutes := int(config.AppConfig.JWT.AccessExpiry.Minutes())
-	refreshExpiryHours := int(config.AppConfig.JWT.RefreshExpiry.Hours())
+	return GenerateTokensWithOptions(userID, phone, tokenVersion, TokenOptions{})
+}
 
-	log.Printf("[TOKEN_GENERATION] Generating tokens for user ID=%s (phone=%s, token_version=%d)",
-		userID, phone, tokenVersion)
-	log.Printf("[TOKEN_GENERATION] Token expiry config: Access=%d minutes, Refresh=%d hours (%d days)",
-		accessExpiryMinutes, refreshExpiryHours, refreshExpiryHours/24)
+// GenerateTokensWithOptions creates both access and refresh tokens for a user with per-login options
+func GenerateTokensWithOptions(userID uuid.UUID, phone string, tokenVersion int, opts TokenOptions) (*TokenPair, error) {
+	accessExpiry, refreshExpiry := config.AppConfig().JWT.LoginExpiry(opts.ClientType, opts.Trusted)
+	accessExpiryMinutes := int(accessExpiry.Minutes())
+	refreshExpiryHours := int(refreshExpiry.Hours())
+
+	slog.Debug("[TOKEN_GENERATION] Generating tokens", "user_id", userID, "phone", phone, "token_version", tokenVersion, "client", opts.ClientType)
+	slog.Debug("[TOKEN_GENERATION] Token expiry config", "access_minutes", accessExpiryMinutes, "refresh_hours", refreshExpiryHours)
 
 	// Generate access token
-	accessToken, err := generateToken(userID, phone, tokenVersion, AccessToken, config.AppConfig.JWT.AccessExpiry)
+	accessToken, err := generateToken(userID, phone, tokenVersion, opts, AccessToken, accessExpiry)
 	if err != nil {
-		log.Printf("[TOKEN_GENERATION] Failed to generate access token: %v", err)
+		slog.Error("[TOKEN_GENERATION] Failed to generate access token", "error", err)
 		return nil, err
 	}
 
 	// Generate refresh token
-	refreshToken, err := generateToken(userID, phone, tokenVersion, RefreshToken, config.AppConfig.JWT.RefreshExpiry)
+	refreshToken, err := generateToken(userID, phone, tokenVersion, opts, RefreshToken, refreshExpiry)
 	if err != nil {
-		log.Printf("[TOKEN_GENERATION] Failed to generate refresh token: %v", err)
+		slog.Error("[TOKEN_GENERATION] Failed to generate refresh token", "error", err)
 		return nil, err
 	}
 
-	log.Printf("[TOKEN_GENERATION] ✅ Tokens generated successfully. Access token expires in %d minutes, Refresh token expires in %d hours (%d days)",
-		accessExpiryMinutes, refreshExpiryHours, refreshExpiryHours/24)
+	slog.Debug("[TOKEN_GENERATION] Tokens generated", "access_minutes", accessExpiryMinutes, "refresh_hours", refreshExpiryHours)
 
 	return &TokenPair{
 		AccessToken:  accessToken,
@@ -66,22 +95,73 @@ func GenerateTokens(userID uuid.UUID, phone string, tokenVersion int) (*TokenPai
 	}, nil
 }
 
+// tokenAudience returns the configured "aud" claim, or nil when no audience is configured
+func tokenAudience() jwt.ClaimStrings {
+	if config.AppConfig().JWT.Audience == "" {
+		return nil
+	}
+	return jwt.ClaimStrings{config.AppConfig().JWT.Audience}
+}
+
+// parserOptions returns the validation options shared by user and admin tokens.
+// Tokens minted by an instance with a different issuer or audience are rejected,
+// so a staging token does not validate in production even if the secrets match.
+func parserOptions() []jwt.ParserOption {
+	var opts []jwt.ParserOption
+	if config.AppConfig().JWT.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(config.AppConfig().JWT.Issuer))
+	}
+	if config.AppConfig().JWT.Audience != "" {
+		opts = append(opts, jwt.WithAudience(config.AppConfig().JWT.Audience))
+	}
+	if config.AppConfig().JWT.Leeway > 0 {
+		opts = append(opts, jwt.WithLeeway(config.AppConfig().JWT.Leeway))
+	}
+	return opts
+}
+
+// reportClockSkew logs and counts tokens that were only accepted thanks to the leeway,
+// which points at a drifting clock on the device or another instance
+func reportClockSkew(tokenType TokenType, claims jwt.RegisteredClaims) {
+	now := time.Now()
+	report := func(claim string, skew time.Duration) {
+		slog.Info("[TOKEN_CLOCK_SKEW] Token accepted within leeway",
+			"token_type", tokenType, "claim", claim, "skew", skew.Round(time.Millisecond), "leeway", config.AppConfig().JWT.Leeway)
+		labels := metrics.Labels{"token_type": string(tokenType), "claim": claim}
+		metrics.IncCounter("jwt_clock_skew_total", labels)
+		metrics.SetGauge("jwt_clock_skew_seconds", labels, skew.Seconds())
+	}
+
+	if claims.NotBefore != nil && claims.NotBefore.After(now) {
+		report("nbf", claims.NotBefore.Sub(now))
+	}
+	if claims.IssuedAt != nil && claims.IssuedAt.After(now) {
+		report("iat", claims.IssuedAt.Sub(now))
+	}
+	if claims.ExpiresAt != nil && claims.ExpiresAt.Before(now) {
+		report("exp", now.Sub(claims.ExpiresAt.Time))
+	}
+}
+
 // generateToken creates a JWT token with the specified parameters
-func generateToken(userID uuid.UUID, phone string, tokenVersion int, tokenType TokenType, expiry time.Duration) (string, error) {
+func generateToken(userID uuid.UUID, phone string, tokenVersion int, opts TokenOptions, tokenType TokenType, expiry time.Duration) (string, error) {
 	now := time.Now()
 	expiresAt := now.Add(expiry)
 
-	// Calculate expiry in minutes for logging
-	expiryMinutes := int(expiry.Minutes())
-	expiryHours := int(expiry.Hours())
-	expiryDays := expiryHours / 24
-
 	claims := Claims{
 		UserID:       userID,
 		Phone:        phone,
 		TokenType:    tokenType,
 		TokenVersion: tokenVersion,
+		SessionID:    opts.SessionID,
+		ClientType:   opts.ClientType,
+		DeviceID:     opts.DeviceID,
+		Identifier:   opts.Identifier,
+		Impersonator: opts.Impersonator,
+		BlockGates:   opts.BlockGates,
 		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    config.AppConfig().JWT.Issuer,
+			Audience:  tokenAudience(),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -89,27 +169,27 @@ func generateToken(userID uuid.UUID, phone string, tokenVersion int, tokenType T
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(config.AppConfig.JWT.Secret))
+	tokenString, err := token.SignedString([]byte(config.AppConfig().JWT.Secret))
 	if err != nil {
-		log.Printf("[TOKEN_GENERATION] Failed to sign %s token: %v", tokenType, err)
+		slog.Error("[TOKEN_GENERATION] Failed to sign token", "token_type", tokenType, "error", err)
 		return "", err
 	}
 
 	// Log token details
-	if expiryDays > 0 {
-		log.Printf("[TOKEN_INFO] %s token created: User=%s, Phone=%s, token_version=%d, IssuedAt=%s, ExpiresAt=%s (in %d days, %d hours)",
-			tokenType, userID, phone, tokenVersion, now.Format("2006-01-02 15:04:05"), expiresAt.Format("2006-01-02 15:04:05"), expiryDays, expiryHours%24)
-	} else if expiryHours > 0 {
-		log.Printf("[TOKEN_INFO] %s token created: User=%s, Phone=%s, token_version=%d, IssuedAt=%s, ExpiresAt=%s (in %d hours, %d minutes)",
-			tokenType, userID, phone, tokenVersion, now.Format("2006-01-02 15:04:05"), expiresAt.Format("2006-01-02 15:04:05"), expiryHours, expiryMinutes%60)
-	} else {
-		log.Printf("[TOKEN_INFO] %s token created: User=%s, Phone=%s, token_version=%d, IssuedAt=%s, ExpiresAt=%s (in %d minutes)",
-			tokenType, userID, phone, tokenVersion, now.Format("2006-01-02 15:04:05"), expiresAt.Format("2006-01-02 15:04:05"), expiryMinutes)
-	}
+	slog.Debug("[TOKEN_INFO] Token created",
+		"token_type", tokenType, "user_id", userID, "phone", phone, "token_version", tokenVersion, "issued_at", now.Format(time.RFC3339), "expires_at", expiresAt.Format(time.RFC3339))
 
 	return tokenString, nil
 }
 
+// GenerateImpersonationToken creates a short-lived access token for a user, issued to an admin
+// troubleshooting as that user. No refresh token is issued, so it cannot be extended.
+func GenerateImpersonationToken(userID uuid.UUID, phone string, tokenVersion int, adminID uuid.UUID, blockGates bool, expiry time.Duration) (string, error) {
+	slog.Debug("[TOKEN_GENERATION] Generating impersonation token", "user_id", userID, "admin_id", adminID, "block_gates", blockGates)
+	opts := TokenOptions{Impersonator: adminID.String(), BlockGates: blockGates}
+	return generateToken(userID, phone, tokenVersion, opts, AccessToken, expiry)
+}
+
 // ValidateToken validates a JWT token and returns the claims
 func ValidateToken(tokenString string, expectedType TokenType) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
@@ -117,72 +197,59 @@ func ValidateToken(tokenString string, expectedType TokenType) (*Claims, error)
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.New("invalid signing method")
 		}
-		return []byte(config.AppConfig.JWT.Secret), nil
-	})
+		return []byte(config.AppConfig().JWT.Secret), nil
+	}, parserOptions()...)
 
 	if err != nil {
-		log.Printf("[TOKEN_VALIDATION] Token validation failed: %v", err)
+		slog.Debug("[TOKEN_VALIDATION] Token validation failed", "error", err)
 		return nil, err
 	}
 
 	claims, ok := token.Claims.(*Claims)
 	if !ok || !token.Valid {
-		log.Printf("[TOKEN_VALIDATION] Token claims invalid or token not valid")
+		slog.Debug("[TOKEN_VALIDATION] Token claims invalid or token not valid")
 		return nil, errors.New("invalid token")
 	}
 
 	// Verify token type
 	if claims.TokenType != expectedType {
-		log.Printf("[TOKEN_VALIDATION] Token type mismatch. Expected=%s, Got=%s", expectedType, claims.TokenType)
+		slog.Debug("[TOKEN_VALIDATION] Token type mismatch", "expected", expectedType, "got", claims.TokenType)
 		return nil, errors.New("invalid token type")
 	}
 
+	reportClockSkew(claims.TokenType, claims.RegisteredClaims)
+
 	// Log token info
-	now := time.Now()
-	expiresAt := claims.ExpiresAt.Time
-	timeUntilExpiry := expiresAt.Sub(now)
-	minutesUntilExpiry := int(timeUntilExpiry.Minutes())
-	hoursUntilExpiry := int(timeUntilExpiry.Hours())
-	daysUntilExpiry := hoursUntilExpiry / 24
-
-	if daysUntilExpiry > 0 {
-		log.Printf("[TOKEN_INFO] %s token validated: User ID=%s, Phone=%s, token_version=%d, ExpiresAt=%s (in %d days, %d hours)",
-			claims.TokenType, claims.UserID, claims.Phone, claims.TokenVersion, expiresAt.Format("2006-01-02 15:04:05"), daysUntilExpiry, hoursUntilExpiry%24)
-	} else if hoursUntilExpiry > 0 {
-		log.Printf("[TOKEN_INFO] %s token validated: User ID=%s, Phone=%s, token_version=%d, ExpiresAt=%s (in %d hours, %d minutes)",
-			claims.TokenType, claims.UserID, claims.Phone, claims.TokenVersion, expiresAt.Format("2006-01-02 15:04:05"), hoursUntilExpiry, minutesUntilExpiry%60)
-	} else {
-		log.Printf("[TOKEN_INFO] %s token validated: User ID=%s, Phone=%s, token_version=%d, ExpiresAt=%s (in %d minutes)",
-			claims.TokenType, claims.UserID, claims.Phone, claims.TokenVersion, expiresAt.Format("2006-01-02 15:04:05"), minutesUntilExpiry)
-	}
+	slog.Debug("[TOKEN_INFO] Token validated",
+		"token_type", claims.TokenType, "user_id", claims.UserID, "phone", claims.Phone, "token_version", claims.TokenVersion, "expires_at", claims.ExpiresAt.Time.Format(time.RFC3339))
 
 	return claims, nil
 }
 
 // RefreshAccessToken generates a new access token from a valid refresh token
 func RefreshAccessToken(refreshTokenString string) (string, error) {
-	log.Printf("[TOKEN_REFRESH] Starting token refresh process...")
+	slog.Debug("[TOKEN_REFRESH] Starting token refresh")
 
 	// Validate refresh token
 	claims, err := ValidateToken(refreshTokenString, RefreshToken)
 	if err != nil {
-		log.Printf("[TOKEN_REFRESH] Refresh token validation failed: %v", err)
+		slog.Debug("[TOKEN_REFRESH] Refresh token validation failed", "error", err)
 		return "", err
 	}
 
-	log.Printf("[TOKEN_REFRESH] Refresh token validated. User ID=%s, Phone=%s, token_version=%d",
-		claims.UserID, claims.Phone, claims.TokenVersion)
+	slog.Debug("[TOKEN_REFRESH] Refresh token validated", "user_id", claims.UserID, "phone", claims.Phone, "token_version", claims.TokenVersion)
 
-	// Generate new access token with the same token version
-	accessToken, err := generateToken(claims.UserID, claims.Phone, claims.TokenVersion, AccessToken, config.AppConfig.JWT.AccessExpiry)
+	// Generate new access token with the same token version, session and client lifetimes
+	accessExpiry, _ := config.AppConfig().JWT.Expiry(claims.ClientType)
+	opts := TokenOptions{SessionID: claims.SessionID, ClientType: claims.ClientType, DeviceID: claims.DeviceID, Identifier: claims.Identifier}
+	accessToken, err := generateToken(claims.UserID, claims.Phone, claims.TokenVersion, opts, AccessToken, accessExpiry)
 	if err != nil {
-		log.Printf("[TOKEN_REFRESH] Failed to generate new access token: %v", err)
+		slog.Error("[TOKEN_REFRESH] Failed to generate new access token", "error", err)
 		return "", err
 	}
 
-	accessExpiryMinutes := int(config.AppConfig.JWT.AccessExpiry.Minutes())
-	log.Printf("[TOKEN_REFRESH] ✅ New access token generated successfully. Expires in %d minutes",
-		accessExpiryMinutes)
+	accessExpiryMinutes := int(accessExpiry.Minutes())
+	slog.Debug("[TOKEN_REFRESH] New access token generated", "access_minutes", accessExpiryMinutes)
 
 	return accessToken, nil
 }
@@ -192,74 +259,112 @@ type AdminClaims struct {
 	AdminID      uuid.UUID `json:"id"`
 	Username     string    `json:"username"`
 	Role         string    `json:"role"`        // "super" or "regular"
-	TokenType    TokenType `json:"token_type"`   // always "admin"
+	TokenType    TokenType `json:"token_type"`   // "admin" (access) or "admin_refresh"
 	TokenVersion int       `json:"token_version"` // Token version for invalidation
 	jwt.RegisteredClaims
 }
 
-// GenerateAdminToken creates a permanent JWT token for admins (no expiry)
+// GenerateAdminTokens creates both access and refresh tokens for an admin
+func GenerateAdminTokens(adminID uuid.UUID, username, role string, tokenVersion int) (*TokenPair, error) {
+	accessToken, err := GenerateAdminToken(adminID, username, role, tokenVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := generateAdminToken(adminID, username, role, tokenVersion, AdminRefreshToken, config.AppConfig().JWT.AdminRefreshExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// GenerateAdminToken creates an admin access token, valid for JWT_ADMIN_ACCESS_EXPIRY
 func GenerateAdminToken(adminID uuid.UUID, username, role string, tokenVersion int) (string, error) {
-	log.Printf("[TOKEN_GENERATION] Generating admin token for Admin ID=%s (username=%s, role=%s, token_version=%d)",
-		adminID, username, role, tokenVersion)
+	return generateAdminToken(adminID, username, role, tokenVersion, AdminToken, config.AppConfig().JWT.AdminAccessExpiry)
+}
+
+// generateAdminToken creates an admin JWT token of the given type
+func generateAdminToken(adminID uuid.UUID, username, role string, tokenVersion int, tokenType TokenType, expiry time.Duration) (string, error) {
+	slog.Debug("[TOKEN_GENERATION] Generating admin token",
+		"token_type", tokenType, "admin_id", adminID, "username", username, "role", role, "token_version", tokenVersion)
 
 	now := time.Now()
+	expiresAt := now.Add(expiry)
 	claims := AdminClaims{
 		AdminID:      adminID,
 		Username:     username,
 		Role:         role,
-		TokenType:    AdminToken,
+		TokenType:    tokenType,
 		TokenVersion: tokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    config.AppConfig().JWT.Issuer,
+			Audience:  tokenAudience(),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
-			// No ExpiresAt - token never expires
 		},
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(config.AppConfig.JWT.Secret))
+	tokenString, err := token.SignedString([]byte(config.AppConfig().JWT.Secret))
 	if err != nil {
-		log.Printf("[TOKEN_GENERATION] Failed to sign admin token: %v", err)
+		slog.Error("[TOKEN_GENERATION] Failed to sign token", "token_type", tokenType, "error", err)
 		return "", err
 	}
 
-	log.Printf("[TOKEN_INFO] Admin token created: Admin ID=%s, Username=%s, Role=%s, token_version=%d, IssuedAt=%s (NEVER EXPIRES)",
-		adminID, username, role, tokenVersion, now.Format("2006-01-02 15:04:05"))
+	slog.Debug("[TOKEN_INFO] Admin token created",
+		"token_type", tokenType, "admin_id", adminID, "username", username, "role", role, "token_version", tokenVersion, "issued_at", now.Format(time.RFC3339), "expires_at", expiresAt.Format(time.RFC3339))
 
 	return tokenString, nil
 }
 
-// ValidateAdminToken validates an admin JWT token and returns the claims
+// ValidateAdminToken validates an admin access token and returns the claims
 func ValidateAdminToken(tokenString string) (*AdminClaims, error) {
+	return validateAdminToken(tokenString, AdminToken)
+}
+
+// ValidateAdminRefreshToken validates an admin refresh token and returns the claims
+func ValidateAdminRefreshToken(tokenString string) (*AdminClaims, error) {
+	return validateAdminToken(tokenString, AdminRefreshToken)
+}
+
+// validateAdminToken validates an admin JWT token of the expected type. The expiry is
+// required, so the permanent tokens issued by earlier versions are rejected.
+func validateAdminToken(tokenString string, expectedType TokenType) (*AdminClaims, error) {
+	opts := append(parserOptions(), jwt.WithExpirationRequired())
 	token, err := jwt.ParseWithClaims(tokenString, &AdminClaims{}, func(token *jwt.Token) (interface{}, error) {
 		// Verify signing method
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.New("invalid signing method")
 		}
-		return []byte(config.AppConfig.JWT.Secret), nil
-	})
+		return []byte(config.AppConfig().JWT.Secret), nil
+	}, opts...)
 
 	if err != nil {
-		log.Printf("[TOKEN_VALIDATION] Admin token validation failed: %v", err)
+		slog.Debug("[TOKEN_VALIDATION] Admin token validation failed", "error", err)
 		return nil, err
 	}
 
 	claims, ok := token.Claims.(*AdminClaims)
 	if !ok || !token.Valid {
-		log.Printf("[TOKEN_VALIDATION] Admin token claims invalid or token not valid")
+		slog.Debug("[TOKEN_VALIDATION] Admin token claims invalid or token not valid")
 		return nil, errors.New("invalid token")
 	}
 
 	// Verify token type
-	if claims.TokenType != AdminToken {
-		log.Printf("[TOKEN_VALIDATION] Admin token type mismatch. Expected=%s, Got=%s", AdminToken, claims.TokenType)
+	if claims.TokenType != expectedType {
+		slog.Debug("[TOKEN_VALIDATION] Admin token type mismatch", "expected", expectedType, "got", claims.TokenType)
 		return nil, errors.New("invalid token type")
 	}
 
-	// Log admin token info
-	issuedAt := claims.IssuedAt.Time
-	log.Printf("[TOKEN_INFO] Admin token validated: Admin ID=%s, Username=%s, Role=%s, token_version=%d, IssuedAt=%s (NEVER EXPIRES)",
-		claims.AdminID, claims.Username, claims.Role, claims.TokenVersion, issuedAt.Format("2006-01-02 15:04:05"))
+	reportClockSkew(claims.TokenType, claims.RegisteredClaims)
+
+	slog.Debug("[TOKEN_INFO] Admin token validated",
+		"token_type", claims.TokenType, "admin_id", claims.AdminID, "username", claims.Username, "role", claims.Role, "token_version", claims.TokenVersion, "expires_at", claims.ExpiresAt.Time.Format(time.RFC3339))
 
 	return claims, nil
 }
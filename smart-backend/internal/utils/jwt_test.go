@@ -2,21 +2,26 @@ package utils
 
 import (
 	"ololo-gate/internal/config"
+	"ololo-gate/internal/metrics"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func setupJWTTest() {
-	config.AppConfig = &config.Config{
+	config.SetAppConfig(&config.Config{
 		JWT: config.JWTConfig{
 			Secret:        "test-secret-key-for-jwt-testing",
 			AccessExpiry:  15 * time.Minute,
 			RefreshExpiry: 30 * 24 * time.Hour,
+
+			AdminAccessExpiry:  30 * time.Minute,
+			AdminRefreshExpiry: 24 * time.Hour,
 		},
-	}
+	})
 }
 
 func TestGenerateTokens_Success(t *testing.T) {
@@ -194,13 +199,13 @@ func TestTokenVersion_Included(t *testing.T) {
 
 func TestTokenExpiry(t *testing.T) {
 	// Use very short expiry for testing
-	config.AppConfig = &config.Config{
+	config.SetAppConfig(&config.Config{
 		JWT: config.JWTConfig{
 			Secret:        "test-secret",
 			AccessExpiry:  1 * time.Nanosecond,  // Extremely short
 			RefreshExpiry: 1 * time.Nanosecond,
 		},
-	}
+	})
 
 	tokens, err := GenerateTokens(uuid.New(), "+77771234567", 0)
 	assert.NoError(t, err)
@@ -239,3 +244,115 @@ func TestGenerateToken_DifferentUsers(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, userID2, claims2.UserID)
 }
+
+func TestGenerateTokens_ClientProfileExpiry(t *testing.T) {
+	setupJWTTest()
+	config.AppConfig().JWT.ClientProfiles = map[string]config.ClientProfile{
+		"kiosk": {AccessExpiry: 12 * time.Hour, RefreshExpiry: 24 * time.Hour},
+	}
+
+	tokens, err := GenerateTokensWithOptions(uuid.New(), "+77771234567", 0, TokenOptions{ClientType: "kiosk"})
+	assert.NoError(t, err)
+
+	access, err := ValidateToken(tokens.AccessToken, AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "kiosk", access.ClientType)
+	assert.Equal(t, 12*time.Hour, access.ExpiresAt.Sub(access.IssuedAt.Time))
+
+	refresh, err := ValidateToken(tokens.RefreshToken, RefreshToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, refresh.ExpiresAt.Sub(refresh.IssuedAt.Time))
+
+	// Refreshed access tokens keep the client's lifetime
+	refreshed, err := RefreshAccessToken(tokens.RefreshToken)
+	assert.NoError(t, err)
+	claims, err := ValidateToken(refreshed, AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 12*time.Hour, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+}
+
+func TestGenerateTokens_UnknownClientUsesDefaults(t *testing.T) {
+	setupJWTTest()
+
+	tokens, err := GenerateTokensWithOptions(uuid.New(), "+77771234567", 0, TokenOptions{ClientType: "unknown"})
+	assert.NoError(t, err)
+
+	claims, err := ValidateToken(tokens.AccessToken, AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+}
+
+func TestValidateToken_IssuerAudience(t *testing.T) {
+	setupJWTTest()
+	config.AppConfig().JWT.Issuer = "ololo-gate-staging"
+	config.AppConfig().JWT.Audience = "ololo-gate-api"
+
+	tokens, err := GenerateTokens(uuid.New(), "+77771234567", 0)
+	assert.NoError(t, err)
+	adminToken, err := GenerateAdminToken(uuid.New(), "admin", "super", 0)
+	assert.NoError(t, err)
+
+	claims, err := ValidateToken(tokens.AccessToken, AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "ololo-gate-staging", claims.Issuer)
+	assert.Equal(t, []string{"ololo-gate-api"}, []string(claims.Audience))
+
+	// A different environment with the same secret must reject the tokens
+	config.AppConfig().JWT.Issuer = "ololo-gate"
+	_, err = ValidateToken(tokens.AccessToken, AccessToken)
+	assert.Error(t, err)
+	_, err = ValidateAdminToken(adminToken)
+	assert.Error(t, err)
+
+	config.AppConfig().JWT.Issuer = "ololo-gate-staging"
+	config.AppConfig().JWT.Audience = "other-api"
+	_, err = ValidateToken(tokens.AccessToken, AccessToken)
+	assert.Error(t, err)
+	_, err = ValidateAdminToken(adminToken)
+	assert.Error(t, err)
+}
+
+// signTestClaims signs a user token with the given validity window relative to now
+func signTestClaims(t *testing.T, notBefore, expiresAt time.Duration) string {
+	now := time.Now()
+	claims := Claims{
+		UserID:    uuid.New(),
+		Phone:     "+77771234567",
+		TokenType: AccessToken,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now.Add(notBefore)),
+			NotBefore: jwt.NewNumericDate(now.Add(notBefore)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiresAt)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.AppConfig().JWT.Secret))
+	assert.NoError(t, err)
+	return token
+}
+
+func TestValidateToken_Leeway(t *testing.T) {
+	setupJWTTest()
+	config.AppConfig().JWT.Leeway = 30 * time.Second
+	nbfLabels := metrics.Labels{"token_type": string(AccessToken), "claim": "nbf"}
+	before := metrics.CounterValue("jwt_clock_skew_total", nbfLabels)
+
+	// Minted by a clock running 10s ahead: accepted and reported as skew
+	_, err := ValidateToken(signTestClaims(t, 10*time.Second, time.Hour), AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, before+1, metrics.CounterValue("jwt_clock_skew_total", nbfLabels))
+
+	// Expired 10s ago: still inside the leeway
+	_, err = ValidateToken(signTestClaims(t, -time.Hour, -10*time.Second), AccessToken)
+	assert.NoError(t, err)
+
+	// Beyond the leeway in either direction
+	_, err = ValidateToken(signTestClaims(t, time.Minute, time.Hour), AccessToken)
+	assert.Error(t, err)
+	_, err = ValidateToken(signTestClaims(t, -time.Hour, -time.Minute), AccessToken)
+	assert.Error(t, err)
+
+	// Without leeway the skewed token is rejected
+	config.AppConfig().JWT.Leeway = 0
+	_, err = ValidateToken(signTestClaims(t, 10*time.Second, time.Hour), AccessToken)
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// RedactedValue replaces secret values in captured request and response bodies
+const RedactedValue = "[REDACTED]"
+
+// maxCapturedBody bounds the size of a body stored in the audit log
+const maxCapturedBody = 16 * 1024
+
+// secretKeyParts marks a JSON key as secret when the lower-cased key contains one of them
+var secretKeyParts = []string{"password", "token", "secret", "authorization", "api_key", "apikey"}
+
+// secretKeys marks a JSON key as secret when the lower-cased key equals one of them. sig is
+// the signature of gate links, which grants access like a token.
+var secretKeys = map[string]bool{"pin": true, "otp": true, "code": true, "sig": true}
+
+// RedactJSON parses a JSON body and replaces the values of secret keys (passwords, tokens,
+// secrets, PINs, ...) at any depth with RedactedValue. Empty bodies return nil. Bodies that are
+// not JSON or exceed the capture limit are not stored, only described.
+func RedactJSON(body []byte) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	if len(body) > maxCapturedBody {
+		return map[string]interface{}{"omitted": "body too large", "size": len(body)}
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return map[string]interface{}{"omitted": "not JSON", "size": len(body)}
+	}
+	return redactValue(value)
+}
+
+// RedactQuery replaces the values of secret keys in a raw query string (?token=, ?sig=, ...)
+// with RedactedValue, keeping the other parameters and their order
+func RedactQuery(query string) string {
+	if query == "" {
+		return ""
+	}
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		rawKey, _, found := strings.Cut(param, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			key = rawKey
+		}
+		if found && IsSecretKey(key) {
+			params[i] = rawKey + "=" + RedactedValue
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if IsSecretKey(key) {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = redactValue(inner)
+		}
+		return v
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = redactValue(inner)
+		}
+		return v
+	}
+	return value
+}
+
+// IsSecretKey reports whether values stored under key must not be written to the audit log
+func IsSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	if secretKeys[key] {
+		return true
+	}
+	for _, part := range secretKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactJSON_RedactsSecretsAtAnyDepth(t *testing.T) {
+	body := []byte(`{
+		"phone": "+77771234567",
+		"password": "hunter22",
+		"tokens": {"access_token": "a", "refresh_token": "r"},
+		"devices": [{"name": "phone", "pin": "1234"}],
+		"Authorization": "Bearer x"
+	}`)
+
+	redacted := RedactJSON(body).(map[string]interface{})
+
+	assert.Equal(t, "+77771234567", redacted["phone"])
+	assert.Equal(t, RedactedValue, redacted["password"])
+	assert.Equal(t, RedactedValue, redacted["tokens"])
+	assert.Equal(t, RedactedValue, redacted["Authorization"])
+	device := redacted["devices"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "phone", device["name"])
+	assert.Equal(t, RedactedValue, device["pin"])
+}
+
+func TestRedactJSON_NonJSONAndOversizedBodies(t *testing.T) {
+	assert.Nil(t, RedactJSON(nil))
+
+	notJSON := RedactJSON([]byte("password=hunter22")).(map[string]interface{})
+	assert.Equal(t, "not JSON", notJSON["omitted"])
+	assert.NotContains(t, notJSON, "password")
+
+	large := make([]byte, maxCapturedBody+1)
+	oversized := RedactJSON(large).(map[string]interface{})
+	assert.Equal(t, "body too large", oversized["omitted"])
+}
+
+func TestRedactQuery(t *testing.T) {
+	assert.Equal(t, "", RedactQuery(""))
+	assert.Equal(t, "page=2&token=[REDACTED]", RedactQuery("page=2&token=eyJhbGciOi"))
+	assert.Equal(t, "exp=1700000000&sig=[REDACTED]", RedactQuery("exp=1700000000&sig=c2lnbmF0dXJl"))
+	assert.Equal(t, "access%5Ftoken=[REDACTED]&flag", RedactQuery("access%5Ftoken=x&flag"))
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// LocalizeJSONTimestamps rewrites the RFC 3339 timestamps of a JSON body, at any depth, to the
+// same instants in loc with its UTC offset (e.g. 2026-10-16T04:30:00Z becomes
+// 2026-10-16T10:30:00+06:00 in Asia/Bishkek). Other values are kept; object keys are re-encoded
+// in sorted order. Bodies that are not JSON return an error.
+func LocalizeJSONTimestamps(body []byte, loc *time.Location) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber() // Keep numbers exactly as written
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(localizeValue(value, loc))
+}
+
+func localizeValue(value interface{}, loc *time.Location) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			v[key] = localizeValue(inner, loc)
+		}
+		return v
+	case []interface{}:
+		for i, inner := range v {
+			v[i] = localizeValue(inner, loc)
+		}
+		return v
+	case string:
+		// Cheap shape check before parsing: YYYY-MM-DDThh:mm:ss followed by a zone
+		if len(v) < 20 || v[4] != '-' || v[10] != 'T' {
+			return v
+		}
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t.In(loc).Format(time.RFC3339Nano)
+		}
+	}
+	return value
+}
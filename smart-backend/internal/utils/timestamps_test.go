@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalizeJSONTimestamps_RewritesTimestampsAtAnyDepth(t *testing.T) {
+	bishkek := time.FixedZone("UTC+6", 6*60*60)
+	body := []byte(`{
+		"created_at": "2026-10-15T20:30:00Z",
+		"day": "2026-10-16",
+		"id": 12345678901234567890,
+		"items": [{"completed_at": "2026-10-16T01:02:03.456789Z", "title": "North gate"}],
+		"expires_at": null
+	}`)
+
+	localized, err := LocalizeJSONTimestamps(body, bishkek)
+	assert.NoError(t, err)
+
+	var result map[string]interface{}
+	assert.NoError(t, json.Unmarshal(localized, &result))
+	assert.Equal(t, "2026-10-16T02:30:00+06:00", result["created_at"])
+	assert.Equal(t, "2026-10-16", result["day"])
+	assert.Nil(t, result["expires_at"])
+	item := result["items"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "2026-10-16T07:02:03.456789+06:00", item["completed_at"])
+	assert.Equal(t, "North gate", item["title"])
+	assert.Contains(t, string(localized), `"id":12345678901234567890`)
+
+	_, err = LocalizeJSONTimestamps([]byte("not json"), bishkek)
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// COSE algorithms accepted for credentials (RFC 9053), in order of preference
+const (
+	AlgES256 int64 = -7   // ECDSA P-256 with SHA-256
+	AlgEdDSA int64 = -8   // Ed25519
+	AlgRS256 int64 = -257 // RSASSA-PKCS1-v1_5 with SHA-256 (Windows Hello)
+)
+
+// SupportedAlgorithms lists the algorithms offered in credential creation options
+var SupportedAlgorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+// COSE key parameters and values used by the supported algorithms
+const (
+	coseKty    int64 = 1
+	coseAlg    int64 = 3
+	coseCrv    int64 = -1 // EC2 and OKP curve
+	coseX      int64 = -2 // EC2 and OKP x coordinate
+	coseY      int64 = -3 // EC2 y coordinate
+	coseRSAN   int64 = -1 // RSA modulus
+	coseRSAE   int64 = -2 // RSA public exponent
+	ktyOKP     int64 = 1
+	ktyEC2     int64 = 2
+	ktyRSA     int64 = 3
+	crvP256    int64 = 1
+	crvEd25519 int64 = 6
+)
+
+var errUnsupportedKey = errors.New("unsupported credential public key")
+
+// parsePublicKey decodes a COSE_Key and returns the public key and its algorithm
+func parsePublicKey(coseKey []byte) (crypto.PublicKey, int64, error) {
+	decoded, rest, err := decodeCBOR(coseKey)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rest) != 0 {
+		return nil, 0, errors.New("trailing data after credential public key")
+	}
+	key, ok := decoded.(map[interface{}]interface{})
+	if !ok {
+		return nil, 0, errUnsupportedKey
+	}
+	kty, _ := key[coseKty].(int64)
+	alg, _ := key[coseAlg].(int64)
+
+	switch {
+	case kty == ktyEC2 && alg == AlgES256:
+		crv, _ := key[coseCrv].(int64)
+		x, _ := key[coseX].([]byte)
+		y, _ := key[coseY].([]byte)
+		if crv != crvP256 || len(x) != 32 || len(y) != 32 {
+			return nil, 0, errUnsupportedKey
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, 0, errors.New("credential public key is not on the curve")
+		}
+		return pub, alg, nil
+
+	case kty == ktyOKP && alg == AlgEdDSA:
+		crv, _ := key[coseCrv].(int64)
+		x, _ := key[coseX].([]byte)
+		if crv != crvEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, 0, errUnsupportedKey
+		}
+		return ed25519.PublicKey(x), alg, nil
+
+	case kty == ktyRSA && alg == AlgRS256:
+		n, _ := key[coseRSAN].([]byte)
+		e, _ := key[coseRSAE].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, 0, errUnsupportedKey
+		}
+		exponent := int(new(big.Int).SetBytes(e).Int64())
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}, alg, nil
+	}
+	return nil, 0, fmt.Errorf("%w (kty %d, alg %d)", errUnsupportedKey, kty, alg)
+}
+
+// verifySignature checks sig over data with a COSE_Key
+func verifySignature(coseKey, data, sig []byte) error {
+	pub, alg, err := parsePublicKey(coseKey)
+	if err != nil {
+		return err
+	}
+
+	switch alg {
+	case AlgES256:
+		digest := sha256.Sum256(data)
+		if !ecdsa.VerifyASN1(pub.(*ecdsa.PublicKey), digest[:], sig) {
+			return ErrSignature
+		}
+	case AlgEdDSA:
+		if !ed25519.Verify(pub.(ed25519.PublicKey), data, sig) {
+			return ErrSignature
+		}
+	case AlgRS256:
+		digest := sha256.Sum256(data)
+		if rsa.VerifyPKCS1v15(pub.(*rsa.PublicKey), crypto.SHA256, digest[:], sig) != nil {
+			return ErrSignature
+		}
+	}
+	return nil
+}
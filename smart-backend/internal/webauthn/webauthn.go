@@ -0,0 +1,222 @@
+// Package webauthn verifies WebAuthn (passkey and security key) registrations and assertions
+// for a relying party, as specified in W3C Web Authentication Level 2. Attestation statements
+// are not verified: credentials are trusted on first use, which is what "none" attestation
+// conveyance asks of browsers anyway.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Authenticator data flags
+const (
+	flagUserPresent      = 0x01
+	flagUserVerified     = 0x04
+	flagBackupEligible   = 0x08
+	flagBackedUp         = 0x10
+	flagAttestedCredData = 0x40
+)
+
+// Client data types of the two ceremonies
+const (
+	typeCreate = "webauthn.create"
+	typeGet    = "webauthn.get"
+)
+
+var (
+	// ErrSignature is returned when an assertion signature does not verify
+	ErrSignature = errors.New("signature does not verify")
+	// ErrChallenge is returned when the client signed a different challenge
+	ErrChallenge = errors.New("challenge does not match")
+	// ErrOrigin is returned for ceremonies run on an origin that is not allowed
+	ErrOrigin = errors.New("origin is not allowed")
+	// ErrRelyingParty is returned when the authenticator scoped the credential to another RP ID
+	ErrRelyingParty = errors.New("relying party ID does not match")
+	// ErrUserVerification is returned when user verification is required but was not performed
+	ErrUserVerification = errors.New("user verification is required")
+	// ErrSignCount is returned when the signature counter went backwards, a sign of a cloned authenticator
+	ErrSignCount = errors.New("signature counter did not increase")
+)
+
+// RelyingParty is the site credentials are scoped to
+type RelyingParty struct {
+	ID                      string   // Registrable domain, e.g. admin.example.com or example.com
+	Origins                 []string // Origins ceremonies may run on, e.g. https://admin.example.com
+	RequireUserVerification bool     // Require PIN or biometric verification, not just presence
+}
+
+// ClientData is the collected client data the browser passes to the authenticator
+type ClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"` // base64url without padding
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// Credential is a public key credential created during registration
+type Credential struct {
+	ID             []byte
+	PublicKey      []byte // COSE_Key, as passed to VerifyAssertion
+	Algorithm      int64
+	SignCount      uint32
+	AAGUID         []byte // Authenticator model, all zeroes when not disclosed
+	BackupEligible bool   // Synced passkey rather than a device-bound key
+	AttestationFmt string
+}
+
+// NewChallenge returns a random challenge, base64url-encoded as it appears in client data
+func NewChallenge() (string, error) {
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(challenge), nil
+}
+
+// ParseClientData decodes clientDataJSON, e.g. to find the challenge it answers
+func ParseClientData(clientDataJSON []byte) (ClientData, error) {
+	var clientData ClientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return clientData, fmt.Errorf("invalid client data: %w", err)
+	}
+	return clientData, nil
+}
+
+// VerifyRegistration checks the response to a credential creation for challenge and returns
+// the new credential
+func (rp RelyingParty) VerifyRegistration(clientDataJSON, attestationObject []byte, challenge string) (Credential, error) {
+	if err := rp.verifyClientData(clientDataJSON, typeCreate, challenge); err != nil {
+		return Credential{}, err
+	}
+
+	decoded, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return Credential{}, fmt.Errorf("invalid attestation object: %w", err)
+	}
+	attestation, ok := decoded.(map[interface{}]interface{})
+	if !ok {
+		return Credential{}, errors.New("invalid attestation object")
+	}
+	authData, _ := attestation["authData"].([]byte)
+	format, _ := attestation["fmt"].(string)
+
+	data, err := rp.parseAuthenticatorData(authData)
+	if err != nil {
+		return Credential{}, err
+	}
+	if data.flags&flagAttestedCredData == 0 {
+		return Credential{}, errors.New("no attested credential data")
+	}
+
+	// The credential ID follows the AAGUID, then the COSE key runs up to any extensions
+	rest := data.rest
+	if len(rest) < 18 {
+		return Credential{}, errors.New("attested credential data is truncated")
+	}
+	aaguid := rest[:16]
+	idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if idLength == 0 || idLength > 1023 || len(rest) < idLength {
+		return Credential{}, errors.New("invalid credential ID length")
+	}
+	credentialID := rest[:idLength]
+	rest = rest[idLength:]
+	_, extensions, err := decodeCBOR(rest)
+	if err != nil {
+		return Credential{}, fmt.Errorf("invalid credential public key: %w", err)
+	}
+	publicKey := rest[:len(rest)-len(extensions)]
+	_, alg, err := parsePublicKey(publicKey)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	return Credential{
+		ID:             bytes.Clone(credentialID),
+		PublicKey:      bytes.Clone(publicKey),
+		Algorithm:      alg,
+		SignCount:      data.signCount,
+		AAGUID:         bytes.Clone(aaguid),
+		BackupEligible: data.flags&flagBackupEligible != 0,
+		AttestationFmt: format,
+	}, nil
+}
+
+// VerifyAssertion checks the response to an authentication for challenge, signed by the
+// credential with publicKey (COSE_Key). It returns the authenticator's new signature counter.
+// Authenticators that do not count (synced passkeys) always report 0.
+func (rp RelyingParty) VerifyAssertion(clientDataJSON, authenticatorData, signature []byte, challenge string, publicKey []byte, storedSignCount uint32) (uint32, error) {
+	if err := rp.verifyClientData(clientDataJSON, typeGet, challenge); err != nil {
+		return 0, err
+	}
+	data, err := rp.parseAuthenticatorData(authenticatorData)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(bytes.Clone(authenticatorData), clientDataHash[:]...)
+	if err := verifySignature(publicKey, signed, signature); err != nil {
+		return 0, err
+	}
+
+	if (data.signCount != 0 || storedSignCount != 0) && data.signCount <= storedSignCount {
+		return 0, ErrSignCount
+	}
+	return data.signCount, nil
+}
+
+// verifyClientData checks the ceremony type, challenge and origin
+func (rp RelyingParty) verifyClientData(clientDataJSON []byte, ceremony, challenge string) error {
+	clientData, err := ParseClientData(clientDataJSON)
+	if err != nil {
+		return err
+	}
+	if clientData.Type != ceremony {
+		return fmt.Errorf("client data type is %q, expected %q", clientData.Type, ceremony)
+	}
+	if subtle.ConstantTimeCompare([]byte(clientData.Challenge), []byte(challenge)) != 1 {
+		return ErrChallenge
+	}
+	if clientData.CrossOrigin || !slices.Contains(rp.Origins, clientData.Origin) {
+		return fmt.Errorf("%w: %s", ErrOrigin, clientData.Origin)
+	}
+	return nil
+}
+
+type authenticatorData struct {
+	flags     byte
+	signCount uint32
+	rest      []byte // Attested credential data and extensions
+}
+
+// parseAuthenticatorData checks the RP ID hash and the user presence and verification flags
+func (rp RelyingParty) parseAuthenticatorData(raw []byte) (authenticatorData, error) {
+	if len(raw) < 37 {
+		return authenticatorData{}, errors.New("authenticator data is truncated")
+	}
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(raw[:32], rpIDHash[:]) != 1 {
+		return authenticatorData{}, ErrRelyingParty
+	}
+	data := authenticatorData{flags: raw[32], signCount: binary.BigEndian.Uint32(raw[33:37]), rest: raw[37:]}
+	if data.flags&flagUserPresent == 0 {
+		return data, errors.New("user presence was not confirmed")
+	}
+	if rp.RequireUserVerification && data.flags&flagUserVerified == 0 {
+		return data, ErrUserVerification
+	}
+	if data.flags&flagBackedUp != 0 && data.flags&flagBackupEligible == 0 {
+		return data, errors.New("credential is backed up but not backup eligible")
+	}
+	return data, nil
+}
@@ -0,0 +1,93 @@
+package webauthn
+
+import (
+	"encoding/base64"
+	"ololo-gate/internal/tests"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decode(t *testing.T, value string) []byte {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	assert.NoError(t, err)
+	return decoded
+}
+
+func TestRelyingParty_RegisterAndAuthenticate(t *testing.T) {
+	rp := RelyingParty{ID: "admin.example.com", Origins: []string{"https://admin.example.com"}, RequireUserVerification: true}
+	authenticator := tests.NewAuthenticator(rp.ID, "https://admin.example.com")
+
+	challenge, err := NewChallenge()
+	assert.NoError(t, err)
+	clientData, attestation := authenticator.Create(challenge, []byte("admin-1"))
+	credential, err := rp.VerifyRegistration(decode(t, clientData), decode(t, attestation), challenge)
+	assert.NoError(t, err)
+	assert.Equal(t, authenticator.CredentialID, credential.ID)
+	assert.Equal(t, AlgES256, credential.Algorithm)
+	assert.Equal(t, "none", credential.AttestationFmt)
+
+	challenge, _ = NewChallenge()
+	clientData, authData, sig, _ := authenticator.Get(challenge)
+	signCount, err := rp.VerifyAssertion(decode(t, clientData), decode(t, authData), decode(t, sig), challenge, credential.PublicKey, credential.SignCount)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), signCount)
+
+	// A replayed assertion fails the counter check, a different challenge the challenge check
+	_, err = rp.VerifyAssertion(decode(t, clientData), decode(t, authData), decode(t, sig), challenge, credential.PublicKey, signCount)
+	assert.ErrorIs(t, err, ErrSignCount)
+	other, _ := NewChallenge()
+	_, err = rp.VerifyAssertion(decode(t, clientData), decode(t, authData), decode(t, sig), other, credential.PublicKey, 0)
+	assert.ErrorIs(t, err, ErrChallenge)
+
+	// A tampered signature does not verify
+	signature := decode(t, sig)
+	signature[len(signature)-1] ^= 0xff
+	_, err = rp.VerifyAssertion(decode(t, clientData), decode(t, authData), signature, challenge, credential.PublicKey, 0)
+	assert.Error(t, err)
+}
+
+func TestRelyingParty_RejectsOtherOriginsAndRPs(t *testing.T) {
+	rp := RelyingParty{ID: "admin.example.com", Origins: []string{"https://admin.example.com"}}
+	challenge, _ := NewChallenge()
+
+	phishing := tests.NewAuthenticator(rp.ID, "https://admin.example.com.evil.test")
+	clientData, attestation := phishing.Create(challenge, []byte("admin-1"))
+	_, err := rp.VerifyRegistration(decode(t, clientData), decode(t, attestation), challenge)
+	assert.ErrorIs(t, err, ErrOrigin)
+
+	otherRP := tests.NewAuthenticator("evil.test", "https://admin.example.com")
+	clientData, attestation = otherRP.Create(challenge, []byte("admin-1"))
+	_, err = rp.VerifyRegistration(decode(t, clientData), decode(t, attestation), challenge)
+	assert.ErrorIs(t, err, ErrRelyingParty)
+}
+
+func TestRelyingParty_RequiresUserVerification(t *testing.T) {
+	rp := RelyingParty{ID: "admin.example.com", Origins: []string{"https://admin.example.com"}, RequireUserVerification: true}
+	authenticator := tests.NewAuthenticator(rp.ID, "https://admin.example.com")
+	authenticator.UserVerified = false
+
+	challenge, _ := NewChallenge()
+	clientData, attestation := authenticator.Create(challenge, []byte("admin-1"))
+	_, err := rp.VerifyRegistration(decode(t, clientData), decode(t, attestation), challenge)
+	assert.ErrorIs(t, err, ErrUserVerification)
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	// {1: 2, "a": [-1, h'0102', true], 3: 1.5} from RFC 8949 style encodings
+	data := []byte{0xa3, 0x01, 0x02, 0x61, 'a', 0x83, 0x20, 0x42, 0x01, 0x02, 0xf5, 0x03, 0xf9, 0x3e, 0x00}
+	decoded, rest, err := decodeCBOR(data)
+	assert.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, map[interface{}]interface{}{
+		int64(1): int64(2),
+		"a":      []interface{}{int64(-1), []byte{1, 2}, true},
+		int64(3): 1.5,
+	}, decoded)
+
+	// Truncated and indefinite-length input is rejected
+	_, _, err = decodeCBOR([]byte{0x5a, 0xff, 0xff, 0xff, 0xff})
+	assert.Error(t, err)
+	_, _, err = decodeCBOR([]byte{0x9f, 0x01, 0xff})
+	assert.Error(t, err)
+}
@@ -0,0 +1,23935 @@
+// Package apiclient provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.12.4 DO NOT EDIT.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for GetApiV1AdminReportsParamsReport.
+const (
+	DailyActiveUsers  GetApiV1AdminReportsParamsReport = "daily_active_users"
+	GateOpens         GetApiV1AdminReportsParamsReport = "gate_opens"
+	ProviderErrorRate GetApiV1AdminReportsParamsReport = "provider_error_rate"
+	UserChurn         GetApiV1AdminReportsParamsReport = "user_churn"
+)
+
+// Defines values for GetApiV1AdminReportsParamsFormat.
+const (
+	Csv  GetApiV1AdminReportsParamsFormat = "csv"
+	Json GetApiV1AdminReportsParamsFormat = "json"
+)
+
+// HandlersAPIKeyDTO defines model for handlers.APIKeyDTO.
+type HandlersAPIKeyDTO struct {
+	CreatedAt  *string `json:"created_at,omitempty"`
+	CreatedBy  *string `json:"created_by,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+	Name       *string `json:"name,omitempty"`
+
+	// Prefix First characters of the key, to recognize it
+	Prefix    *string   `json:"prefix,omitempty"`
+	RevokedAt *string   `json:"revoked_at,omitempty"`
+	Scopes    *[]string `json:"scopes,omitempty"`
+}
+
+// HandlersAPIKeyResponse defines model for handlers.APIKeyResponse.
+type HandlersAPIKeyResponse struct {
+	Data    *HandlersAPIKeyDTO `json:"data,omitempty"`
+	Message string             `json:"message"`
+	Success bool               `json:"success"`
+}
+
+// HandlersAPIKeysResponse defines model for handlers.APIKeysResponse.
+type HandlersAPIKeysResponse struct {
+	Data    *[]HandlersAPIKeyDTO `json:"data,omitempty"`
+	Message string               `json:"message"`
+	Success bool                 `json:"success"`
+}
+
+// HandlersAPIResponse defines model for handlers.APIResponse.
+type HandlersAPIResponse struct {
+	Data    *interface{} `json:"data,omitempty"`
+	Message *string      `json:"message,omitempty"`
+
+	// RetryStrategy Set on throttled (429) and degraded (503) responses
+	RetryStrategy *MiddlewareRetryStrategy `json:"retry_strategy,omitempty"`
+	Success       *bool                    `json:"success,omitempty"`
+}
+
+// HandlersAcceptLegalDocumentRequest defines model for handlers.AcceptLegalDocumentRequest.
+type HandlersAcceptLegalDocumentRequest struct {
+	Kind string `json:"kind"`
+
+	// Version Must be the current version
+	Version string `json:"version"`
+}
+
+// HandlersAddUserPhoneRequest defines model for handlers.AddUserPhoneRequest.
+type HandlersAddUserPhoneRequest struct {
+	Phone string `json:"phone"`
+}
+
+// HandlersAdminDTO defines model for handlers.AdminDTO.
+type HandlersAdminDTO struct {
+	CreatedAt string `json:"created_at"`
+	Id        string `json:"id"`
+	Role      string `json:"role"`
+	UpdatedAt string `json:"updated_at"`
+	Username  string `json:"username"`
+}
+
+// HandlersAdminData defines model for handlers.AdminData.
+type HandlersAdminData struct {
+	Id       string `json:"id"`
+	Role     string `json:"role"`
+	Username string `json:"username"`
+}
+
+// HandlersAdminDetailData defines model for handlers.AdminDetailData.
+type HandlersAdminDetailData struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Role      *string `json:"role,omitempty"`
+
+	// Timezone Admin panel timezone; omitted for DEFAULT_TIMEZONE
+	Timezone  *string `json:"timezone,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	Username  *string `json:"username,omitempty"`
+}
+
+// HandlersAdminDetailResponse defines model for handlers.AdminDetailResponse.
+type HandlersAdminDetailResponse struct {
+	Data    *HandlersAdminDetailData `json:"data,omitempty"`
+	Message *string                  `json:"message,omitempty"`
+	Success *bool                    `json:"success,omitempty"`
+}
+
+// HandlersAdminHistoryDTO defines model for handlers.AdminHistoryDTO.
+type HandlersAdminHistoryDTO struct {
+	// Action created, updated or deleted
+	Action *string `json:"action,omitempty"`
+	Actor  *string `json:"actor,omitempty"`
+
+	// ActorId Admin who made the change; omitted for the system
+	ActorId *string `json:"actor_id,omitempty"`
+
+	// Changes Changed fields, e.g. "username", "role", "password_changed"
+	Changes   *map[string]HandlersFieldChangeDTO `json:"changes,omitempty"`
+	CreatedAt *string                            `json:"created_at,omitempty"`
+	Id        *string                            `json:"id,omitempty"`
+}
+
+// HandlersAdminHistoryResponse defines model for handlers.AdminHistoryResponse.
+type HandlersAdminHistoryResponse struct {
+	Data       *[]HandlersAdminHistoryDTO `json:"data,omitempty"`
+	Message    string                     `json:"message"`
+	Pagination *HandlersPaginationMeta    `json:"pagination,omitempty"`
+	Success    bool                       `json:"success"`
+}
+
+// HandlersAdminImportErrorResponse defines model for handlers.AdminImportErrorResponse.
+type HandlersAdminImportErrorResponse struct {
+	Data    *[]HandlersAdminImportLineErrorDTO `json:"data,omitempty"`
+	Message string                             `json:"message"`
+	Success bool                               `json:"success"`
+}
+
+// HandlersAdminImportLineErrorDTO defines model for handlers.AdminImportLineErrorDTO.
+type HandlersAdminImportLineErrorDTO struct {
+	// Line The header is line 1
+	Line    *int    `json:"line,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// HandlersAdminImportResponse defines model for handlers.AdminImportResponse.
+type HandlersAdminImportResponse struct {
+	Data    *[]HandlersImportedAdminDTO `json:"data,omitempty"`
+	Message string                      `json:"message"`
+	Success bool                        `json:"success"`
+}
+
+// HandlersAdminLoginData defines model for handlers.AdminLoginData.
+type HandlersAdminLoginData struct {
+	AccessToken  string `json:"access_token"`
+	Id           string `json:"id"`
+	RefreshToken string `json:"refresh_token"`
+	Role         string `json:"role"`
+	Username     string `json:"username"`
+}
+
+// HandlersAdminLoginRequest defines model for handlers.AdminLoginRequest.
+type HandlersAdminLoginRequest struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// HandlersAdminLoginResponse defines model for handlers.AdminLoginResponse.
+type HandlersAdminLoginResponse struct {
+	Data    *HandlersAdminLoginData `json:"data,omitempty"`
+	Message string                  `json:"message"`
+	Success bool                    `json:"success"`
+}
+
+// HandlersAdminNotificationDTO defines model for handlers.AdminNotificationDTO.
+type HandlersAdminNotificationDTO struct {
+	Category  *string `json:"category,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	Read      *bool   `json:"read,omitempty"`
+	ReadAt    *string `json:"read_at,omitempty"`
+	ReadBy    *string `json:"read_by,omitempty"`
+	Severity  *string `json:"severity,omitempty"`
+	Title     *string `json:"title,omitempty"`
+}
+
+// HandlersAdminNotificationDetailDTO defines model for handlers.AdminNotificationDetailDTO.
+type HandlersAdminNotificationDetailDTO struct {
+	Category *string `json:"category,omitempty"`
+
+	// Comments Oldest first
+	Comments  *[]HandlersAuditCommentDTO `json:"comments,omitempty"`
+	CreatedAt *string                    `json:"created_at,omitempty"`
+	Id        *string                    `json:"id,omitempty"`
+	Message   *string                    `json:"message,omitempty"`
+	Read      *bool                      `json:"read,omitempty"`
+	ReadAt    *string                    `json:"read_at,omitempty"`
+	ReadBy    *string                    `json:"read_by,omitempty"`
+
+	// Resolved A resolution note was added
+	Resolved *bool   `json:"resolved,omitempty"`
+	Severity *string `json:"severity,omitempty"`
+	Title    *string `json:"title,omitempty"`
+}
+
+// HandlersAdminNotificationDetailResponse defines model for handlers.AdminNotificationDetailResponse.
+type HandlersAdminNotificationDetailResponse struct {
+	Data    *HandlersAdminNotificationDetailDTO `json:"data,omitempty"`
+	Message string                              `json:"message"`
+	Success bool                                `json:"success"`
+}
+
+// HandlersAdminNotificationResponse defines model for handlers.AdminNotificationResponse.
+type HandlersAdminNotificationResponse struct {
+	Data    *HandlersAdminNotificationDTO `json:"data,omitempty"`
+	Message string                        `json:"message"`
+	Success bool                          `json:"success"`
+}
+
+// HandlersAdminNotificationsResponse defines model for handlers.AdminNotificationsResponse.
+type HandlersAdminNotificationsResponse struct {
+	Data        *[]HandlersAdminNotificationDTO `json:"data,omitempty"`
+	Message     string                          `json:"message"`
+	Pagination  *HandlersPaginationMeta         `json:"pagination,omitempty"`
+	Success     bool                            `json:"success"`
+	UnreadCount *int                            `json:"unread_count,omitempty"`
+}
+
+// HandlersAdminPasskeyDTO defines model for handlers.AdminPasskeyDTO.
+type HandlersAdminPasskeyDTO struct {
+	// Aaguid Authenticator model, zero when not disclosed
+	Aaguid *string `json:"aaguid,omitempty"`
+
+	// Algorithm COSE algorithm
+	Algorithm *int `json:"algorithm,omitempty"`
+
+	// BackupEligible Synced passkey rather than a device-bound key
+	BackupEligible *bool   `json:"backup_eligible,omitempty"`
+	CreatedAt      *string `json:"created_at,omitempty"`
+
+	// CredentialId base64url, as browsers report it
+	CredentialId *string   `json:"credential_id,omitempty"`
+	Id           *string   `json:"id,omitempty"`
+	LastUsedAt   *string   `json:"last_used_at,omitempty"`
+	Name         *string   `json:"name,omitempty"`
+	Transports   *[]string `json:"transports,omitempty"`
+}
+
+// HandlersAdminPasskeyResponse defines model for handlers.AdminPasskeyResponse.
+type HandlersAdminPasskeyResponse struct {
+	Data    *HandlersAdminPasskeyDTO `json:"data,omitempty"`
+	Message string                   `json:"message"`
+	Success bool                     `json:"success"`
+}
+
+// HandlersAdminPasskeysResponse defines model for handlers.AdminPasskeysResponse.
+type HandlersAdminPasskeysResponse struct {
+	Data    *[]HandlersAdminPasskeyDTO `json:"data,omitempty"`
+	Message string                     `json:"message"`
+	Success bool                       `json:"success"`
+}
+
+// HandlersAdminReportDTO defines model for handlers.AdminReportDTO.
+type HandlersAdminReportDTO struct {
+	Columns []string `json:"columns"`
+
+	// From First day covered
+	From   string `json:"from"`
+	Report string `json:"report"`
+
+	// Rows One value per column
+	Rows *[][]interface{} `json:"rows,omitempty"`
+
+	// Timezone IANA zone of the days
+	Timezone string `json:"timezone"`
+
+	// To Last day covered, inclusive
+	To string `json:"to"`
+}
+
+// HandlersAdminReportResponse defines model for handlers.AdminReportResponse.
+type HandlersAdminReportResponse struct {
+	Data    *HandlersAdminReportDTO `json:"data,omitempty"`
+	Message string                  `json:"message"`
+	Success bool                    `json:"success"`
+
+	// Warning Set when gate opens could not be attributed to locations
+	Warning *string `json:"warning,omitempty"`
+}
+
+// HandlersAdminResponse defines model for handlers.AdminResponse.
+type HandlersAdminResponse struct {
+	Data    *HandlersAdminData `json:"data,omitempty"`
+	Message string             `json:"message"`
+	Success bool               `json:"success"`
+}
+
+// HandlersAdminSearchResponse defines model for handlers.AdminSearchResponse.
+type HandlersAdminSearchResponse struct {
+	Data    *[]HandlersSearchResultDTO `json:"data,omitempty"`
+	Message string                     `json:"message"`
+	Success bool                       `json:"success"`
+
+	// Warning Set when locations and gates could not be searched
+	Warning *string `json:"warning,omitempty"`
+}
+
+// HandlersAdminsListResponse defines model for handlers.AdminsListResponse.
+type HandlersAdminsListResponse struct {
+	Data       *[]HandlersAdminDTO     `json:"data,omitempty"`
+	Message    string                  `json:"message"`
+	Pagination *HandlersPaginationMeta `json:"pagination,omitempty"`
+	Success    bool                    `json:"success"`
+}
+
+// HandlersApproveRegistrationRequest defines model for handlers.ApproveRegistrationRequest.
+type HandlersApproveRegistrationRequest struct {
+	// Locations Optional - locations and gates assigned to the user on approval
+	Locations *[]HandlersLocationAssignmentRequest `json:"locations,omitempty"`
+}
+
+// HandlersAuditCommentDTO defines model for handlers.AuditCommentDTO.
+type HandlersAuditCommentDTO struct {
+	AdminId   *string `json:"admin_id,omitempty"`
+	AdminName *string `json:"admin_name,omitempty"`
+	Body      *string `json:"body,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	Id        *string `json:"id,omitempty"`
+
+	// Resolution The comment closes the investigation
+	Resolution *bool `json:"resolution,omitempty"`
+}
+
+// HandlersAuditCommentResponse defines model for handlers.AuditCommentResponse.
+type HandlersAuditCommentResponse struct {
+	Data    *HandlersAuditCommentDTO `json:"data,omitempty"`
+	Message string                   `json:"message"`
+	Success bool                     `json:"success"`
+}
+
+// HandlersAuditLogDetailDTO defines model for handlers.AuditLogDetailDTO.
+type HandlersAuditLogDetailDTO struct {
+	// Action "create_user", "update_user", "delete_user", "create_admin", "delete_admin", "update_contact", etc.
+	Action *string `json:"action,omitempty"`
+
+	// AdminId Who performed the action
+	AdminId *string `json:"admin_id,omitempty"`
+
+	// AdminName Admin username for quick access (denormalized)
+	AdminName *string `json:"admin_name,omitempty"`
+
+	// Comments Oldest first
+	Comments  *[]HandlersAuditCommentDTO `json:"comments,omitempty"`
+	CreatedAt *string                    `json:"created_at,omitempty"`
+
+	// Details JSON with request details (what was changed)
+	Details *string `json:"details,omitempty"`
+
+	// ErrorMessage Error message if failed
+	ErrorMessage *string `json:"error_message,omitempty"`
+	Id           *string `json:"id,omitempty"`
+
+	// IpAddress Request IP address
+	IpAddress *string `json:"ip_address,omitempty"`
+
+	// Resolved A resolution note was added
+	Resolved *bool `json:"resolved,omitempty"`
+
+	// ResourceId UUID or ID of affected resource
+	ResourceId *string `json:"resource_id,omitempty"`
+
+	// ResourceType "user", "admin", "contact", etc.
+	ResourceType *string `json:"resource_type,omitempty"`
+
+	// Status "success" or "failed"
+	Status *string `json:"status,omitempty"`
+
+	// UserAgent Request user agent
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// HandlersAuditLogDetailResponse defines model for handlers.AuditLogDetailResponse.
+type HandlersAuditLogDetailResponse struct {
+	Data    *HandlersAuditLogDetailDTO `json:"data,omitempty"`
+	Message *string                    `json:"message,omitempty"`
+	Success *bool                      `json:"success,omitempty"`
+}
+
+// HandlersAvailableLocationsResponse defines model for handlers.AvailableLocationsResponse.
+type HandlersAvailableLocationsResponse struct {
+	Data    *[]HandlersLocationDTO `json:"data,omitempty"`
+	Message string                 `json:"message"`
+	Success bool                   `json:"success"`
+}
+
+// HandlersBulkDeleteResultDTO defines model for handlers.BulkDeleteResultDTO.
+type HandlersBulkDeleteResultDTO struct {
+	// Skipped Users that do not exist, were already in the trash, or failed
+	Skipped *[]string `json:"skipped,omitempty"`
+
+	// Trashed Users moved to the trash
+	Trashed *[]string `json:"trashed,omitempty"`
+}
+
+// HandlersBulkDeleteResultResponse defines model for handlers.BulkDeleteResultResponse.
+type HandlersBulkDeleteResultResponse struct {
+	Data    *HandlersBulkDeleteResultDTO `json:"data,omitempty"`
+	Message string                       `json:"message"`
+	Success bool                         `json:"success"`
+}
+
+// HandlersBulkDeleteSummaryDTO defines model for handlers.BulkDeleteSummaryDTO.
+type HandlersBulkDeleteSummaryDTO struct {
+	ConfirmationToken *string `json:"confirmation_token,omitempty"`
+
+	// Count Users that will be moved to the trash
+	Count     *int    `json:"count,omitempty"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
+	// Skipped Requested users that do not exist or are already in the trash
+	Skipped *[]string          `json:"skipped,omitempty"`
+	Users   *[]HandlersUserDTO `json:"users,omitempty"`
+}
+
+// HandlersBulkDeleteSummaryResponse defines model for handlers.BulkDeleteSummaryResponse.
+type HandlersBulkDeleteSummaryResponse struct {
+	Data    *HandlersBulkDeleteSummaryDTO `json:"data,omitempty"`
+	Message string                        `json:"message"`
+	Success bool                          `json:"success"`
+}
+
+// HandlersBulkDeleteUsersRequest defines model for handlers.BulkDeleteUsersRequest.
+type HandlersBulkDeleteUsersRequest struct {
+	// ConfirmationToken Token from the summary response; omit to get a summary
+	ConfirmationToken *string  `json:"confirmation_token,omitempty"`
+	UserIds           []string `json:"user_ids"`
+}
+
+// HandlersCORSOriginDTO defines model for handlers.CORSOriginDTO.
+type HandlersCORSOriginDTO struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Origin    *string `json:"origin,omitempty"`
+
+	// Scope "public" or "admin"
+	Scope *string `json:"scope,omitempty"`
+}
+
+// HandlersCORSOriginResponse defines model for handlers.CORSOriginResponse.
+type HandlersCORSOriginResponse struct {
+	Data    *HandlersCORSOriginDTO `json:"data,omitempty"`
+	Message string                 `json:"message"`
+	Success bool                   `json:"success"`
+}
+
+// HandlersCORSOriginsResponse defines model for handlers.CORSOriginsResponse.
+type HandlersCORSOriginsResponse struct {
+	Data    *[]HandlersCORSOriginDTO `json:"data,omitempty"`
+	Message string                   `json:"message"`
+	Success bool                     `json:"success"`
+}
+
+// HandlersChangePasswordRequest defines model for handlers.ChangePasswordRequest.
+type HandlersChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+
+	// Email Alternative to phone; only verified emails can be used
+	Email       *string `json:"email,omitempty"`
+	NewPassword string  `json:"new_password"`
+	Phone       *string `json:"phone,omitempty"`
+}
+
+// HandlersConfigReloadDTO defines model for handlers.ConfigReloadDTO.
+type HandlersConfigReloadDTO struct {
+	Changed    *[]string `json:"changed,omitempty"`
+	Reloadable *[]string `json:"reloadable,omitempty"`
+}
+
+// HandlersConfigReloadResponse defines model for handlers.ConfigReloadResponse.
+type HandlersConfigReloadResponse struct {
+	Data    *HandlersConfigReloadDTO `json:"data,omitempty"`
+	Message string                   `json:"message"`
+	Success bool                     `json:"success"`
+}
+
+// HandlersContactDTO defines model for handlers.ContactDTO.
+type HandlersContactDTO struct {
+	Address       *string `json:"address,omitempty"`
+	EmailSupport  *string `json:"email_support,omitempty"`
+	SupportNumber *int    `json:"support_number,omitempty"`
+}
+
+// HandlersContactResponse defines model for handlers.ContactResponse.
+type HandlersContactResponse struct {
+	Data    *HandlersContactDTO `json:"data,omitempty"`
+	Message string              `json:"message"`
+	Success bool                `json:"success"`
+}
+
+// HandlersCreateAPIKeyRequest defines model for handlers.CreateAPIKeyRequest.
+type HandlersCreateAPIKeyRequest struct {
+	Name string `json:"name"`
+
+	// Scopes What the key can access: scim, sync
+	Scopes []string `json:"scopes"`
+}
+
+// HandlersCreateAdminRequest defines model for handlers.CreateAdminRequest.
+type HandlersCreateAdminRequest struct {
+	Password string `json:"password"`
+
+	// Role "super", "regular" or "auditor" (read-only)
+	Role     string `json:"role"`
+	Username string `json:"username"`
+}
+
+// HandlersCreateAuditCommentRequest defines model for handlers.CreateAuditCommentRequest.
+type HandlersCreateAuditCommentRequest struct {
+	// Body Up to 4000 characters
+	Body string `json:"body"`
+
+	// Resolution Mark the comment as the resolution of the investigation
+	Resolution *bool `json:"resolution,omitempty"`
+}
+
+// HandlersCreateCORSOriginRequest defines model for handlers.CreateCORSOriginRequest.
+type HandlersCreateCORSOriginRequest struct {
+	Origin string `json:"origin"`
+
+	// Scope "public" or "admin"
+	Scope string `json:"scope"`
+}
+
+// HandlersCreateExportRequest defines model for handlers.CreateExportRequest.
+type HandlersCreateExportRequest struct {
+	// Format report only: csv (default) or json
+	Format *string `json:"format,omitempty"`
+
+	// From First day in the request's timezone, YYYY-MM-DD (defaults to 29 days before to)
+	From *string `json:"from,omitempty"`
+
+	// GateId gate_events only: only this gate
+	GateId *int `json:"gate_id,omitempty"`
+
+	// LocationId gate_events only: only gates of this location
+	LocationId *int `json:"location_id,omitempty"`
+
+	// Report report only: report name, as for GET /admin/reports
+	Report *string `json:"report,omitempty"`
+
+	// To Last day, inclusive, YYYY-MM-DD (defaults to today)
+	To *string `json:"to,omitempty"`
+
+	// Type gate_events or report
+	Type string `json:"type"`
+
+	// UserId gate_events only: only commands issued by this user
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// HandlersCreateForcedLogoutRequest defines model for handlers.CreateForcedLogoutRequest.
+type HandlersCreateForcedLogoutRequest struct {
+	// Reason Kept in the audit log and shown to admins
+	Reason string `json:"reason"`
+}
+
+// HandlersCreateGateLinkRequest defines model for handlers.CreateGateLinkRequest.
+type HandlersCreateGateLinkRequest struct {
+	// ExpiresInMinutes Link lifetime (default GATE_LINK_TTL, capped at GATE_LINK_MAX_TTL)
+	ExpiresInMinutes *int `json:"expires_in_minutes,omitempty"`
+
+	// Label Optional note shown with the link
+	Label *string `json:"label,omitempty"`
+}
+
+// HandlersCreateInviteCodeRequest defines model for handlers.CreateInviteCodeRequest.
+type HandlersCreateInviteCodeRequest struct {
+	// ExpiresInHours Optional - code lifetime (0 = never expires)
+	ExpiresInHours *int `json:"expires_in_hours,omitempty"`
+
+	// Locations Optional - assigned to users registering with the code
+	Locations *[]HandlersLocationAssignmentRequest `json:"locations,omitempty"`
+
+	// MaxUses Optional - registrations allowed (0 = unlimited)
+	MaxUses *int    `json:"max_uses,omitempty"`
+	Note    *string `json:"note,omitempty"`
+}
+
+// HandlersCreateUserRequest defines model for handlers.CreateUserRequest.
+type HandlersCreateUserRequest struct {
+	// Email Optional - alternative login identifier, verified when set by an admin
+	Email *string `json:"email,omitempty"`
+
+	// Locations Optional - if provided, will assign user to these locations and gates
+	Locations *[]HandlersLocationAssignmentRequest `json:"locations,omitempty"`
+	Password  string                               `json:"password"`
+	Phone     string                               `json:"phone"`
+}
+
+// HandlersCreatedAPIKeyDTO defines model for handlers.CreatedAPIKeyDTO.
+type HandlersCreatedAPIKeyDTO struct {
+	CreatedAt  *string `json:"created_at,omitempty"`
+	CreatedBy  *string `json:"created_by,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	Key        *string `json:"key,omitempty"`
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+	Name       *string `json:"name,omitempty"`
+
+	// Prefix First characters of the key, to recognize it
+	Prefix    *string   `json:"prefix,omitempty"`
+	RevokedAt *string   `json:"revoked_at,omitempty"`
+	Scopes    *[]string `json:"scopes,omitempty"`
+}
+
+// HandlersCreatedAPIKeyResponse defines model for handlers.CreatedAPIKeyResponse.
+type HandlersCreatedAPIKeyResponse struct {
+	Data    *HandlersCreatedAPIKeyDTO `json:"data,omitempty"`
+	Message string                    `json:"message"`
+	Success bool                      `json:"success"`
+}
+
+// HandlersDigestRunDTO defines model for handlers.DigestRunDTO.
+type HandlersDigestRunDTO struct {
+	Eligible   *int    `json:"eligible,omitempty"`
+	Error      *string `json:"error,omitempty"`
+	Failed     *int    `json:"failed,omitempty"`
+	FinishedAt *string `json:"finished_at,omitempty"`
+	Id         *string `json:"id,omitempty"`
+
+	// InactiveSince Users without a gate open since then were eligible
+	InactiveSince *string `json:"inactive_since,omitempty"`
+	OptedOut      *int    `json:"opted_out,omitempty"`
+	Sent          *int    `json:"sent,omitempty"`
+	StartedAt     *string `json:"started_at,omitempty"`
+}
+
+// HandlersDigestRunsResponse defines model for handlers.DigestRunsResponse.
+type HandlersDigestRunsResponse struct {
+	Data    *[]HandlersDigestRunDTO `json:"data,omitempty"`
+	Message string                  `json:"message"`
+	Success bool                    `json:"success"`
+}
+
+// HandlersDuplicateUserGroupDTO defines model for handlers.DuplicateUserGroupDTO.
+type HandlersDuplicateUserGroupDTO struct {
+	// Phone Normalized number the users share
+	Phone *string `json:"phone,omitempty"`
+
+	// Users Oldest first; the oldest account is the suggested merge target
+	Users *[]HandlersUserDTO `json:"users,omitempty"`
+}
+
+// HandlersDuplicateUsersResponse defines model for handlers.DuplicateUsersResponse.
+type HandlersDuplicateUsersResponse struct {
+	Data    *[]HandlersDuplicateUserGroupDTO `json:"data,omitempty"`
+	Message string                           `json:"message"`
+	Success bool                             `json:"success"`
+}
+
+// HandlersEmergencyOpenRequest defines model for handlers.EmergencyOpenRequest.
+type HandlersEmergencyOpenRequest struct {
+	Reason string `json:"reason"`
+}
+
+// HandlersExportDTO defines model for handlers.ExportDTO.
+type HandlersExportDTO struct {
+	ContentType *string `json:"content_type,omitempty"`
+	CreatedAt   *string `json:"created_at,omitempty"`
+
+	// DownloadUrl Signed link to the file, once ready
+	DownloadUrl          *string `json:"download_url,omitempty"`
+	DownloadUrlExpiresAt *string `json:"download_url_expires_at,omitempty"`
+
+	// Error Why the export failed
+	Error *string `json:"error,omitempty"`
+
+	// ExpiresAt When the finished export is deleted
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
+	// Filename Set once ready
+	Filename    *string `json:"filename,omitempty"`
+	FinishedAt  *string `json:"finished_at,omitempty"`
+	Id          string  `json:"id"`
+	RequestedBy *string `json:"requested_by,omitempty"`
+	SizeBytes   *int    `json:"size_bytes,omitempty"`
+	StartedAt   *string `json:"started_at,omitempty"`
+
+	// Status pending, running, ready or failed
+	Status string `json:"status"`
+
+	// Type gate_events or report
+	Type string `json:"type"`
+}
+
+// HandlersExportResponse defines model for handlers.ExportResponse.
+type HandlersExportResponse struct {
+	Data    *HandlersExportDTO `json:"data,omitempty"`
+	Message string             `json:"message"`
+	Success bool               `json:"success"`
+}
+
+// HandlersFieldChangeDTO defines model for handlers.FieldChangeDTO.
+type HandlersFieldChangeDTO struct {
+	After *interface{} `json:"after,omitempty"`
+
+	// Before null when the field was not set or its previous value is unknown
+	Before *interface{} `json:"before,omitempty"`
+}
+
+// HandlersForcedLogoutDTO defines model for handlers.ForcedLogoutDTO.
+type HandlersForcedLogoutDTO struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+
+	// Error Why the forced logout failed
+	Error      *string `json:"error,omitempty"`
+	FinishedAt *string `json:"finished_at,omitempty"`
+	Id         string  `json:"id"`
+	Percent    *int    `json:"percent,omitempty"`
+
+	// Processed Users signed out so far
+	Processed   *int    `json:"processed,omitempty"`
+	Reason      string  `json:"reason"`
+	RequestedBy *string `json:"requested_by,omitempty"`
+	StartedAt   *string `json:"started_at,omitempty"`
+
+	// Status pending, running, completed or failed
+	Status string `json:"status"`
+
+	// Total Users to sign out, counted when the job starts
+	Total *int `json:"total,omitempty"`
+}
+
+// HandlersForcedLogoutResponse defines model for handlers.ForcedLogoutResponse.
+type HandlersForcedLogoutResponse struct {
+	Data    *HandlersForcedLogoutDTO `json:"data,omitempty"`
+	Message string                   `json:"message"`
+	Success bool                     `json:"success"`
+}
+
+// HandlersForgotPasswordRequest defines model for handlers.ForgotPasswordRequest.
+type HandlersForgotPasswordRequest struct {
+	Phone string `json:"phone"`
+}
+
+// HandlersFreezeLocationRequest defines model for handlers.FreezeLocationRequest.
+type HandlersFreezeLocationRequest struct {
+	// EndsAt Optional - frozen until lifted when omitted
+	EndsAt *string `json:"ends_at,omitempty"`
+
+	// Reason Shown to users whose open is refused
+	Reason string `json:"reason"`
+
+	// StartsAt Optional - defaults to now
+	StartsAt *string `json:"starts_at,omitempty"`
+}
+
+// HandlersGateActionData defines model for handlers.GateActionData.
+type HandlersGateActionData struct {
+	CommandId *string `json:"command_id,omitempty"`
+
+	// CommandStatus accepted, queued, executing, confirmed or failed
+	CommandStatus *string `json:"command_status,omitempty"`
+	GateId        *int    `json:"gate_id,omitempty"`
+
+	// Sandbox Executed by the gate simulator (SANDBOX_MODE), no barrier moved
+	Sandbox *bool `json:"sandbox,omitempty"`
+	Status  *bool `json:"status,omitempty"`
+}
+
+// HandlersGateActionResponse defines model for handlers.GateActionResponse.
+type HandlersGateActionResponse struct {
+	Data    *HandlersGateActionData `json:"data,omitempty"`
+	Message string                  `json:"message"`
+
+	// RetryStrategy Set when the provider is unavailable: poll a queued command, retry a failed one
+	RetryStrategy *MiddlewareRetryStrategy `json:"retry_strategy,omitempty"`
+	Success       bool                     `json:"success"`
+}
+
+// HandlersGateCommandCallbackRequest defines model for handlers.GateCommandCallbackRequest.
+type HandlersGateCommandCallbackRequest struct {
+	// Error Optional failure reason
+	Error *string `json:"error,omitempty"`
+
+	// Status "confirmed" or "failed"
+	Status string `json:"status"`
+}
+
+// HandlersGateCommandDTO defines model for handlers.GateCommandDTO.
+type HandlersGateCommandDTO struct {
+	Action       *string `json:"action,omitempty"`
+	CompletedAt  *string `json:"completed_at,omitempty"`
+	CreatedAt    *string `json:"created_at,omitempty"`
+	ErrorMessage *string `json:"error_message,omitempty"`
+	GateId       *int    `json:"gate_id,omitempty"`
+	Id           *string `json:"id,omitempty"`
+
+	// Sandbox Executed by the gate simulator (SANDBOX_MODE), no barrier moved
+	Sandbox *bool `json:"sandbox,omitempty"`
+
+	// Status accepted, queued, executing, confirmed or failed
+	Status    *string `json:"status,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// HandlersGateCommandResponse defines model for handlers.GateCommandResponse.
+type HandlersGateCommandResponse struct {
+	Data    *HandlersGateCommandDTO `json:"data,omitempty"`
+	Message string                  `json:"message"`
+	Success bool                    `json:"success"`
+}
+
+// HandlersGateDTO defines model for handlers.GateDTO.
+type HandlersGateDTO struct {
+	Description      *string `json:"description,omitempty"`
+	GateIsHorizontal *bool   `json:"gate_is_horizontal,omitempty"`
+	Id               *int    `json:"id,omitempty"`
+	IsOpen           *bool   `json:"is_open,omitempty"`
+	LocationId       *int    `json:"location_id,omitempty"`
+
+	// MaintenanceNote Present while under maintenance
+	MaintenanceNote *string `json:"maintenance_note,omitempty"`
+	Title           *string `json:"title,omitempty"`
+
+	// UnderMaintenance Shown greyed out - opens and closes are refused
+	UnderMaintenance *bool `json:"under_maintenance,omitempty"`
+}
+
+// HandlersGateEventLogDTO defines model for handlers.GateEventLogDTO.
+type HandlersGateEventLogDTO struct {
+	Action    *string `json:"action,omitempty"`
+	CommandId *string `json:"command_id,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	GateId    *int    `json:"gate_id,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	IpAddress *string `json:"ip_address,omitempty"`
+	LatencyMs *int    `json:"latency_ms,omitempty"`
+
+	// LocationId Unknown for gates not assigned to the user
+	LocationId *int    `json:"location_id,omitempty"`
+	Message    *string `json:"message,omitempty"`
+
+	// Result sent, queued, refused or failed
+	Result     *string `json:"result,omitempty"`
+	StatusCode *int    `json:"status_code,omitempty"`
+	UserId     *string `json:"user_id,omitempty"`
+}
+
+// HandlersGateEventLogsResponse defines model for handlers.GateEventLogsResponse.
+type HandlersGateEventLogsResponse struct {
+	Data       *[]HandlersGateEventLogDTO `json:"data,omitempty"`
+	Message    string                     `json:"message"`
+	Pagination *HandlersPaginationMeta    `json:"pagination,omitempty"`
+	Success    bool                       `json:"success"`
+}
+
+// HandlersGateLinkDTO defines model for handlers.GateLinkDTO.
+type HandlersGateLinkDTO struct {
+	CreatedAt  *string `json:"created_at,omitempty"`
+	DeepLink   *string `json:"deep_link,omitempty"`
+	ExpiresAt  *string `json:"expires_at,omitempty"`
+	GateId     *int    `json:"gate_id,omitempty"`
+	GateTitle  *string `json:"gate_title,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	Label      *string `json:"label,omitempty"`
+	LocationId *int    `json:"location_id,omitempty"`
+	Signature  *string `json:"signature,omitempty"`
+
+	// UniversalLink Empty when GATE_LINK_BASE_URL is not set
+	UniversalLink *string `json:"universal_link,omitempty"`
+}
+
+// HandlersGateLinkMetadataDTO defines model for handlers.GateLinkMetadataDTO.
+type HandlersGateLinkMetadataDTO struct {
+	CreatedAt  *string `json:"created_at,omitempty"`
+	ExpiresAt  *string `json:"expires_at,omitempty"`
+	GateId     *int    `json:"gate_id,omitempty"`
+	GateTitle  *string `json:"gate_title,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	Label      *string `json:"label,omitempty"`
+	LocationId *int    `json:"location_id,omitempty"`
+}
+
+// HandlersGateLinkMetadataResponse defines model for handlers.GateLinkMetadataResponse.
+type HandlersGateLinkMetadataResponse struct {
+	Data    *HandlersGateLinkMetadataDTO `json:"data,omitempty"`
+	Message string                       `json:"message"`
+	Success bool                         `json:"success"`
+}
+
+// HandlersGateLinkResponse defines model for handlers.GateLinkResponse.
+type HandlersGateLinkResponse struct {
+	Data    *HandlersGateLinkDTO `json:"data,omitempty"`
+	Message string               `json:"message"`
+	Success bool                 `json:"success"`
+}
+
+// HandlersGateMaintenanceDTO defines model for handlers.GateMaintenanceDTO.
+type HandlersGateMaintenanceDTO struct {
+	// CreatedAt When the gate went under maintenance
+	CreatedAt *string `json:"created_at,omitempty"`
+	GateId    *int    `json:"gate_id,omitempty"`
+	Note      *string `json:"note,omitempty"`
+	StartedBy *string `json:"started_by,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// HandlersGateMaintenanceRefusedData defines model for handlers.GateMaintenanceRefusedData.
+type HandlersGateMaintenanceRefusedData struct {
+	Code   *string `json:"code,omitempty"`
+	GateId *int    `json:"gate_id,omitempty"`
+	Note   *string `json:"note,omitempty"`
+	Since  *string `json:"since,omitempty"`
+}
+
+// HandlersGateMaintenanceRefusedResponse defines model for handlers.GateMaintenanceRefusedResponse.
+type HandlersGateMaintenanceRefusedResponse struct {
+	Data    *HandlersGateMaintenanceRefusedData `json:"data,omitempty"`
+	Message string                              `json:"message"`
+	Success bool                                `json:"success"`
+}
+
+// HandlersGateMaintenanceResponse defines model for handlers.GateMaintenanceResponse.
+type HandlersGateMaintenanceResponse struct {
+	Data    *HandlersGateMaintenanceDTO `json:"data,omitempty"`
+	Message string                      `json:"message"`
+	Success bool                        `json:"success"`
+}
+
+// HandlersGateMaintenancesResponse defines model for handlers.GateMaintenancesResponse.
+type HandlersGateMaintenancesResponse struct {
+	Data    *[]HandlersGateMaintenanceDTO `json:"data,omitempty"`
+	Message string                        `json:"message"`
+	Success bool                          `json:"success"`
+}
+
+// HandlersGateReportDTO defines model for handlers.GateReportDTO.
+type HandlersGateReportDTO struct {
+	// Category stuck_open, stuck_closed, damaged or other
+	Category    *string `json:"category,omitempty"`
+	CreatedAt   *string `json:"created_at,omitempty"`
+	Description *string `json:"description,omitempty"`
+	GateId      *int    `json:"gate_id,omitempty"`
+	GateTitle   *string `json:"gate_title,omitempty"`
+
+	// HasPhoto Admins download it from /admin/gate-reports/:id/photo
+	HasPhoto       *bool   `json:"has_photo,omitempty"`
+	Id             *string `json:"id,omitempty"`
+	LocationId     *int    `json:"location_id,omitempty"`
+	ResolutionNote *string `json:"resolution_note,omitempty"`
+	ResolvedAt     *string `json:"resolved_at,omitempty"`
+	ResolvedBy     *string `json:"resolved_by,omitempty"`
+
+	// Status open or resolved
+	Status *string `json:"status,omitempty"`
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// HandlersGateReportResponse defines model for handlers.GateReportResponse.
+type HandlersGateReportResponse struct {
+	Data    *HandlersGateReportDTO `json:"data,omitempty"`
+	Message string                 `json:"message"`
+	Success bool                   `json:"success"`
+}
+
+// HandlersGateReportsResponse defines model for handlers.GateReportsResponse.
+type HandlersGateReportsResponse struct {
+	Data       *[]HandlersGateReportDTO `json:"data,omitempty"`
+	Message    string                   `json:"message"`
+	Pagination *HandlersPaginationMeta  `json:"pagination,omitempty"`
+	Success    bool                     `json:"success"`
+}
+
+// HandlersGatesListResponse defines model for handlers.GatesListResponse.
+type HandlersGatesListResponse struct {
+	Data    *[]HandlersGateDTO `json:"data,omitempty"`
+	Message string             `json:"message"`
+	Success bool               `json:"success"`
+}
+
+// HandlersHealthCheckResponse defines model for handlers.HealthCheckResponse.
+type HandlersHealthCheckResponse struct {
+	Environment *string `json:"environment,omitempty"`
+	Message     string  `json:"message"`
+	Status      string  `json:"status"`
+	Success     bool    `json:"success"`
+
+	// Timestamp Details are left out without the HEALTH_TOKEN, when it is set
+	Timestamp *string `json:"timestamp,omitempty"`
+	Uptime    *string `json:"uptime,omitempty"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// HandlersImpersonateUserRequest defines model for handlers.ImpersonateUserRequest.
+type HandlersImpersonateUserRequest struct {
+	// BlockGateOperations Refuse gate open/close with the token (defaults to true unless IMPERSONATION_ALLOW_GATE_OPERATIONS is set)
+	BlockGateOperations *bool `json:"block_gate_operations,omitempty"`
+
+	// Reason Why the admin needs to act as the user (kept in the audit log)
+	Reason string `json:"reason"`
+}
+
+// HandlersImpersonationResponse defines model for handlers.ImpersonationResponse.
+type HandlersImpersonationResponse struct {
+	Data    *HandlersImpersonationTokenDTO `json:"data,omitempty"`
+	Message string                         `json:"message"`
+	Success bool                           `json:"success"`
+}
+
+// HandlersImpersonationTokenDTO defines model for handlers.ImpersonationTokenDTO.
+type HandlersImpersonationTokenDTO struct {
+	AccessToken *string `json:"access_token,omitempty"`
+	ExpiresAt   *string `json:"expires_at,omitempty"`
+
+	// ExpiresIn Seconds; no refresh token is issued
+	ExpiresIn *int `json:"expires_in,omitempty"`
+
+	// GateOperationsBlocked Gate open/close is refused with this token
+	GateOperationsBlocked *bool   `json:"gate_operations_blocked,omitempty"`
+	Phone                 *string `json:"phone,omitempty"`
+	UserId                *string `json:"user_id,omitempty"`
+}
+
+// HandlersImportedAdminDTO defines model for handlers.ImportedAdminDTO.
+type HandlersImportedAdminDTO struct {
+	Id *string `json:"id,omitempty"`
+
+	// Password Shown only in this response
+	Password *string `json:"password,omitempty"`
+	Role     *string `json:"role,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// HandlersInviteCodeDTO defines model for handlers.InviteCodeDTO.
+type HandlersInviteCodeDTO struct {
+	// Active Not revoked, expired or used up
+	Active    *bool   `json:"active,omitempty"`
+	Code      *string `json:"code,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+	Id        *string `json:"id,omitempty"`
+
+	// Locations Assigned to users registering with the code
+	Locations *[]HandlersLocationAssignmentRequest `json:"locations,omitempty"`
+
+	// MaxUses 0 = unlimited
+	MaxUses   *int    `json:"max_uses,omitempty"`
+	Note      *string `json:"note,omitempty"`
+	RevokedAt *string `json:"revoked_at,omitempty"`
+	Uses      *int    `json:"uses,omitempty"`
+}
+
+// HandlersInviteCodeResponse defines model for handlers.InviteCodeResponse.
+type HandlersInviteCodeResponse struct {
+	Data    *HandlersInviteCodeDTO `json:"data,omitempty"`
+	Message string                 `json:"message"`
+	Success bool                   `json:"success"`
+}
+
+// HandlersInviteCodesResponse defines model for handlers.InviteCodesResponse.
+type HandlersInviteCodesResponse struct {
+	Data    *[]HandlersInviteCodeDTO `json:"data,omitempty"`
+	Message string                   `json:"message"`
+	Success bool                     `json:"success"`
+}
+
+// HandlersJobRunDTO defines model for handlers.JobRunDTO.
+type HandlersJobRunDTO struct {
+	Error      *string `json:"error,omitempty"`
+	FinishedAt *string `json:"finished_at,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	Instance   *string `json:"instance,omitempty"`
+	StartedAt  *string `json:"started_at,omitempty"`
+	Status     *string `json:"status,omitempty"`
+}
+
+// HandlersLegalDocumentDTO defines model for handlers.LegalDocumentDTO.
+type HandlersLegalDocumentDTO struct {
+	Content *string `json:"content,omitempty"`
+
+	// Kind "terms" or "privacy"
+	Kind        *string `json:"kind,omitempty"`
+	PublishedAt *string `json:"published_at,omitempty"`
+	Title       *string `json:"title,omitempty"`
+	Version     *string `json:"version,omitempty"`
+}
+
+// HandlersLegalDocumentResponse defines model for handlers.LegalDocumentResponse.
+type HandlersLegalDocumentResponse struct {
+	Data    *HandlersLegalDocumentDTO `json:"data,omitempty"`
+	Message string                    `json:"message"`
+	Success bool                      `json:"success"`
+}
+
+// HandlersLegalDocumentsResponse defines model for handlers.LegalDocumentsResponse.
+type HandlersLegalDocumentsResponse struct {
+	Data    *[]HandlersLegalDocumentDTO `json:"data,omitempty"`
+	Message string                      `json:"message"`
+	Success bool                        `json:"success"`
+}
+
+// HandlersLegalStatusDTO defines model for handlers.LegalStatusDTO.
+type HandlersLegalStatusDTO struct {
+	// AcceptanceRequired The current version has not been accepted
+	AcceptanceRequired *bool   `json:"acceptance_required,omitempty"`
+	AcceptedAt         *string `json:"accepted_at,omitempty"`
+
+	// AcceptedVersion Latest version the user accepted
+	AcceptedVersion *string `json:"accepted_version,omitempty"`
+	CurrentVersion  *string `json:"current_version,omitempty"`
+	Kind            *string `json:"kind,omitempty"`
+}
+
+// HandlersLegalStatusResponse defines model for handlers.LegalStatusResponse.
+type HandlersLegalStatusResponse struct {
+	Data    *[]HandlersLegalStatusDTO `json:"data,omitempty"`
+	Message string                    `json:"message"`
+	Success bool                      `json:"success"`
+}
+
+// HandlersLocationAssignmentRequest defines model for handlers.LocationAssignmentRequest.
+type HandlersLocationAssignmentRequest struct {
+	GateIds    []int `json:"gateIds"`
+	LocationId int   `json:"locationId"`
+}
+
+// HandlersLocationDTO defines model for handlers.LocationDTO.
+type HandlersLocationDTO struct {
+	Address *string `json:"address,omitempty"`
+
+	// Gates Always include gates, even if empty array
+	Gates *[]HandlersGateDTO `json:"gates,omitempty"`
+	Id    *int               `json:"id,omitempty"`
+	Logo  *string            `json:"logo,omitempty"`
+	Title *string            `json:"title,omitempty"`
+}
+
+// HandlersLocationFreezeDTO defines model for handlers.LocationFreezeDTO.
+type HandlersLocationFreezeDTO struct {
+	// Active Blocking opens right now
+	Active    *bool   `json:"active,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+
+	// EndsAt Absent when frozen until lifted
+	EndsAt     *string `json:"ends_at,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	LiftedAt   *string `json:"lifted_at,omitempty"`
+	LiftedBy   *string `json:"lifted_by,omitempty"`
+	LocationId *int    `json:"location_id,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
+	StartsAt   *string `json:"starts_at,omitempty"`
+}
+
+// HandlersLocationFreezeResponse defines model for handlers.LocationFreezeResponse.
+type HandlersLocationFreezeResponse struct {
+	Data    *HandlersLocationFreezeDTO `json:"data,omitempty"`
+	Message string                     `json:"message"`
+	Success bool                       `json:"success"`
+}
+
+// HandlersLocationFreezesResponse defines model for handlers.LocationFreezesResponse.
+type HandlersLocationFreezesResponse struct {
+	Data    *[]HandlersLocationFreezeDTO `json:"data,omitempty"`
+	Message string                       `json:"message"`
+	Success bool                         `json:"success"`
+}
+
+// HandlersLocationFrozenData defines model for handlers.LocationFrozenData.
+type HandlersLocationFrozenData struct {
+	// EndsAt Absent when frozen until lifted
+	EndsAt     *string `json:"ends_at,omitempty"`
+	LocationId *int    `json:"location_id,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
+}
+
+// HandlersLocationFrozenResponse defines model for handlers.LocationFrozenResponse.
+type HandlersLocationFrozenResponse struct {
+	Data    *HandlersLocationFrozenData `json:"data,omitempty"`
+	Message string                      `json:"message"`
+	Success bool                        `json:"success"`
+}
+
+// HandlersLocationOverrideDTO defines model for handlers.LocationOverrideDTO.
+type HandlersLocationOverrideDTO struct {
+	DisplayName *string `json:"display_name,omitempty"`
+	LocationId  int     `json:"location_id"`
+	Logo        *string `json:"logo,omitempty"`
+	SortOrder   *int    `json:"sort_order,omitempty"`
+	UpdatedAt   *string `json:"updated_at,omitempty"`
+	UpdatedBy   *string `json:"updated_by,omitempty"`
+}
+
+// HandlersLocationOverrideRequest defines model for handlers.LocationOverrideRequest.
+type HandlersLocationOverrideRequest struct {
+	// DisplayName Replaces the provider's title (empty = keep it)
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Logo http(s) URL replacing the provider's logo (empty = keep it)
+	Logo *string `json:"logo,omitempty"`
+
+	// SortOrder Lower is listed first (null = provider order, after sorted locations)
+	SortOrder *int `json:"sort_order,omitempty"`
+}
+
+// HandlersLocationOverrideResponse defines model for handlers.LocationOverrideResponse.
+type HandlersLocationOverrideResponse struct {
+	Data    *HandlersLocationOverrideDTO `json:"data,omitempty"`
+	Message string                       `json:"message"`
+	Success bool                         `json:"success"`
+}
+
+// HandlersLocationOverridesResponse defines model for handlers.LocationOverridesResponse.
+type HandlersLocationOverridesResponse struct {
+	Data    *[]HandlersLocationOverrideDTO `json:"data,omitempty"`
+	Message string                         `json:"message"`
+	Success bool                           `json:"success"`
+}
+
+// HandlersLocationsListResponse defines model for handlers.LocationsListResponse.
+type HandlersLocationsListResponse struct {
+	// CachedAt When the cached data was loaded (degraded only)
+	CachedAt *string                `json:"cached_at,omitempty"`
+	Data     *[]HandlersLocationDTO `json:"data,omitempty"`
+
+	// Degraded true when the provider is down and cached data is served
+	Degraded *bool  `json:"degraded,omitempty"`
+	Message  string `json:"message"`
+
+	// RetryStrategy When to reload for fresh data (degraded only)
+	RetryStrategy *MiddlewareRetryStrategy `json:"retry_strategy,omitempty"`
+	Success       bool                     `json:"success"`
+}
+
+// HandlersLoginData defines model for handlers.LoginData.
+type HandlersLoginData struct {
+	AccessExpiresIn  int    `json:"access_expires_in"`
+	AccessToken      string `json:"access_token"`
+	Id               string `json:"id"`
+	Phone            string `json:"phone"`
+	RefreshExpiresIn int    `json:"refresh_expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+}
+
+// HandlersLoginOTPConfirmRequest defines model for handlers.LoginOTPConfirmRequest.
+type HandlersLoginOTPConfirmRequest struct {
+	Code  string `json:"code"`
+	Phone string `json:"phone"`
+
+	// TrustedDevice "Remember me": use the long refresh expiry (JWT_TRUSTED_REFRESH_EXPIRY)
+	TrustedDevice *bool `json:"trusted_device,omitempty"`
+}
+
+// HandlersLoginOTPRequest defines model for handlers.LoginOTPRequest.
+type HandlersLoginOTPRequest struct {
+	Phone string `json:"phone"`
+}
+
+// HandlersLoginRequest defines model for handlers.LoginRequest.
+type HandlersLoginRequest struct {
+	// Email Alternative to phone; only verified emails can log in
+	Email    *string `json:"email,omitempty"`
+	Password string  `json:"password"`
+	Phone    *string `json:"phone,omitempty"`
+
+	// TrustedDevice "Remember me": use the long refresh expiry (JWT_TRUSTED_REFRESH_EXPIRY)
+	TrustedDevice *bool `json:"trusted_device,omitempty"`
+}
+
+// HandlersLoginResponse defines model for handlers.LoginResponse.
+type HandlersLoginResponse struct {
+	Data    *HandlersLoginData `json:"data,omitempty"`
+	Message string             `json:"message"`
+	Success bool               `json:"success"`
+}
+
+// HandlersMergeUsersRequest defines model for handlers.MergeUsersRequest.
+type HandlersMergeUsersRequest struct {
+	// SourceIds Users to merge into the target and delete
+	SourceIds []string `json:"source_ids"`
+}
+
+// HandlersMergeUsersResponse defines model for handlers.MergeUsersResponse.
+type HandlersMergeUsersResponse struct {
+	Data    *HandlersMergeUsersResultDTO `json:"data,omitempty"`
+	Message string                       `json:"message"`
+	Success bool                         `json:"success"`
+
+	// Warning Set when consolidating gate access failed
+	Warning *string `json:"warning,omitempty"`
+}
+
+// HandlersMergeUsersResultDTO defines model for handlers.MergeUsersResultDTO.
+type HandlersMergeUsersResultDTO struct {
+	// EmailMoved The target took over a merged user's email
+	EmailMoved *bool `json:"email_moved,omitempty"`
+
+	// GateCommandsMoved Gate command history moved
+	GateCommandsMoved *int      `json:"gate_commands_moved,omitempty"`
+	MergedUserIds     *[]string `json:"merged_user_ids,omitempty"`
+
+	// PhonesAdded Numbers added as verified secondary numbers
+	PhonesAdded *[]string `json:"phones_added,omitempty"`
+
+	// SessionsMoved Device sessions moved (revoked; the devices log in again)
+	SessionsMoved *int    `json:"sessions_moved,omitempty"`
+	UserId        *string `json:"user_id,omitempty"`
+}
+
+// HandlersNotificationPreferencesDTO defines model for handlers.NotificationPreferencesDTO.
+type HandlersNotificationPreferencesDTO struct {
+	// Digest SMS digest after weeks without opening a gate
+	Digest *bool `json:"digest,omitempty"`
+}
+
+// HandlersNotificationPreferencesResponse defines model for handlers.NotificationPreferencesResponse.
+type HandlersNotificationPreferencesResponse struct {
+	Data    *HandlersNotificationPreferencesDTO `json:"data,omitempty"`
+	Message string                              `json:"message"`
+	Success bool                                `json:"success"`
+}
+
+// HandlersPaginatedAuditLogResponse defines model for handlers.PaginatedAuditLogResponse.
+type HandlersPaginatedAuditLogResponse struct {
+	Data       *[]ModelsAdminAuditLog  `json:"data,omitempty"`
+	Message    *string                 `json:"message,omitempty"`
+	Pagination *HandlersPaginationMeta `json:"pagination,omitempty"`
+	Success    *bool                   `json:"success,omitempty"`
+}
+
+// HandlersPaginationMeta defines model for handlers.PaginationMeta.
+type HandlersPaginationMeta struct {
+	CurrentPage *int `json:"current_page,omitempty"`
+
+	// Estimated Total is the planner's estimate (count=estimate)
+	Estimated *bool `json:"estimated,omitempty"`
+	LastPage  *int  `json:"last_page,omitempty"`
+	PerPage   *int  `json:"per_page,omitempty"`
+	Total     *int  `json:"total,omitempty"`
+}
+
+// HandlersPasskeyCreationOptionsResponse defines model for handlers.PasskeyCreationOptionsResponse.
+type HandlersPasskeyCreationOptionsResponse struct {
+	Data    *ServicesPasskeyCreationOptions `json:"data,omitempty"`
+	Message string                          `json:"message"`
+	Success bool                            `json:"success"`
+}
+
+// HandlersPasskeyLoginOptionsRequest defines model for handlers.PasskeyLoginOptionsRequest.
+type HandlersPasskeyLoginOptionsRequest struct {
+	// Username Optional: restricts the login to this admin's passkeys
+	Username *string `json:"username,omitempty"`
+}
+
+// HandlersPasskeyLoginRequest defines model for handlers.PasskeyLoginRequest.
+type HandlersPasskeyLoginRequest struct {
+	// Id Credential ID, base64url
+	Id       string `json:"id"`
+	Response *struct {
+		AuthenticatorData string  `json:"authenticatorData"`
+		ClientDataJSON    string  `json:"clientDataJSON"`
+		Signature         string  `json:"signature"`
+		UserHandle        *string `json:"userHandle,omitempty"`
+	} `json:"response,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// HandlersPasskeyRegistrationRequest defines model for handlers.PasskeyRegistrationRequest.
+type HandlersPasskeyRegistrationRequest struct {
+	Credential *struct {
+		Id       string `json:"id"`
+		Response *struct {
+			AttestationObject string    `json:"attestationObject"`
+			ClientDataJSON    string    `json:"clientDataJSON"`
+			Transports        *[]string `json:"transports,omitempty"`
+		} `json:"response,omitempty"`
+		Type *string `json:"type,omitempty"`
+	} `json:"credential,omitempty"`
+
+	// Name Label shown in the passkey list
+	Name *string `json:"name,omitempty"`
+}
+
+// HandlersPasskeyRequestOptionsResponse defines model for handlers.PasskeyRequestOptionsResponse.
+type HandlersPasskeyRequestOptionsResponse struct {
+	Data    *ServicesPasskeyRequestOptions `json:"data,omitempty"`
+	Message string                         `json:"message"`
+	Success bool                           `json:"success"`
+}
+
+// HandlersPasswordExpiredData defines model for handlers.PasswordExpiredData.
+type HandlersPasswordExpiredData struct {
+	Code              string `json:"code"`
+	PasswordChangedAt string `json:"password_changed_at"`
+}
+
+// HandlersPasswordExpiredResponse defines model for handlers.PasswordExpiredResponse.
+type HandlersPasswordExpiredResponse struct {
+	Data    *HandlersPasswordExpiredData `json:"data,omitempty"`
+	Message string                       `json:"message"`
+	Success bool                         `json:"success"`
+}
+
+// HandlersPhoneAvailabilityResponse defines model for handlers.PhoneAvailabilityResponse.
+type HandlersPhoneAvailabilityResponse struct {
+	// Available true if phone is available, false if already in use
+	Available bool   `json:"available"`
+	Message   string `json:"message"`
+	Success   bool   `json:"success"`
+}
+
+// HandlersProviderMigrationReportDTO defines model for handlers.ProviderMigrationReportDTO.
+type HandlersProviderMigrationReportDTO struct {
+	// Enabled Whether writes are being mirrored now (THIRD_PARTY_MIRROR_API_URL is set)
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Mismatches Most recent first
+	Mismatches *[]HandlersProviderMirrorMismatchDTO  `json:"mismatches,omitempty"`
+	Operations *[]HandlersProviderMirrorOperationDTO `json:"operations,omitempty"`
+	Since      *string                               `json:"since,omitempty"`
+}
+
+// HandlersProviderMigrationReportResponse defines model for handlers.ProviderMigrationReportResponse.
+type HandlersProviderMigrationReportResponse struct {
+	Data    *HandlersProviderMigrationReportDTO `json:"data,omitempty"`
+	Message string                              `json:"message"`
+	Success bool                                `json:"success"`
+}
+
+// HandlersProviderMirrorMismatchDTO defines model for handlers.ProviderMirrorMismatchDTO.
+type HandlersProviderMirrorMismatchDTO struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+
+	// GateId Set for gate commands
+	GateId        *int    `json:"gate_id,omitempty"`
+	Id            *string `json:"id,omitempty"`
+	MirrorDetail  *string `json:"mirror_detail,omitempty"`
+	MirrorOutcome *string `json:"mirror_outcome,omitempty"`
+	Operation     *string `json:"operation,omitempty"`
+
+	// Phone Set for assignments
+	Phone *string `json:"phone,omitempty"`
+
+	// PrimaryOutcome ok, ok:<result> or error:<kind>
+	PrimaryOutcome *string `json:"primary_outcome,omitempty"`
+}
+
+// HandlersProviderMirrorOperationDTO defines model for handlers.ProviderMirrorOperationDTO.
+type HandlersProviderMirrorOperationDTO struct {
+	Matched *int `json:"matched,omitempty"`
+
+	// MirrorErrors Calls the migration provider failed, whether or not the current provider failed too
+	MirrorErrors *int `json:"mirror_errors,omitempty"`
+	Mismatched   *int `json:"mismatched,omitempty"`
+
+	// Operation assign_user, open_gate or close_gate
+	Operation *string `json:"operation,omitempty"`
+	Total     *int    `json:"total,omitempty"`
+}
+
+// HandlersProviderUsageDTO defines model for handlers.ProviderUsageDTO.
+type HandlersProviderUsageDTO struct {
+	Calls *int   `json:"calls,omitempty"`
+	Month string `json:"month"`
+
+	// Percent Share of the quota used, 0 without a quota
+	Percent *float32 `json:"percent,omitempty"`
+
+	// Provider primary or mirror (the migration provider)
+	Provider string `json:"provider"`
+
+	// Quota THIRD_PARTY_MONTHLY_QUOTA or THIRD_PARTY_MIRROR_MONTHLY_QUOTA, 0 = no quota
+	Quota *int `json:"quota,omitempty"`
+
+	// Warned Thresholds admins were warned about this month
+	Warned *[]int `json:"warned,omitempty"`
+}
+
+// HandlersProviderUsageResponse defines model for handlers.ProviderUsageResponse.
+type HandlersProviderUsageResponse struct {
+	Data    *[]HandlersProviderUsageDTO `json:"data,omitempty"`
+	Message string                      `json:"message"`
+	Success bool                        `json:"success"`
+}
+
+// HandlersPublishLegalDocumentRequest defines model for handlers.PublishLegalDocumentRequest.
+type HandlersPublishLegalDocumentRequest struct {
+	Content string `json:"content"`
+
+	// Kind "terms" or "privacy"
+	Kind    string  `json:"kind"`
+	Title   *string `json:"title,omitempty"`
+	Version string  `json:"version"`
+}
+
+// HandlersRefreshData defines model for handlers.RefreshData.
+type HandlersRefreshData struct {
+	AccessToken string `json:"access_token"`
+}
+
+// HandlersRefreshRequest defines model for handlers.RefreshRequest.
+type HandlersRefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// HandlersRefreshResponse defines model for handlers.RefreshResponse.
+type HandlersRefreshResponse struct {
+	Data    *HandlersRefreshData `json:"data,omitempty"`
+	Message string               `json:"message"`
+	Success bool                 `json:"success"`
+}
+
+// HandlersRegisterData defines model for handlers.RegisterData.
+type HandlersRegisterData struct {
+	Id    string `json:"id"`
+	Phone string `json:"phone"`
+}
+
+// HandlersRegisterRequest defines model for handlers.RegisterRequest.
+type HandlersRegisterRequest struct {
+	// InviteCode Required unless USER_OPEN_REGISTRATION is enabled
+	InviteCode *string `json:"invite_code,omitempty"`
+	Password   string  `json:"password"`
+	Phone      string  `json:"phone"`
+}
+
+// HandlersRegisterResponse defines model for handlers.RegisterResponse.
+type HandlersRegisterResponse struct {
+	Data    *HandlersRegisterData `json:"data,omitempty"`
+	Message string                `json:"message"`
+	Success bool                  `json:"success"`
+}
+
+// HandlersRegisteredRoutesResponse defines model for handlers.RegisteredRoutesResponse.
+type HandlersRegisteredRoutesResponse struct {
+	Data    *[]HandlersRouteDTO `json:"data,omitempty"`
+	Message string              `json:"message"`
+	Success bool                `json:"success"`
+}
+
+// HandlersRegistrationDTO defines model for handlers.RegistrationDTO.
+type HandlersRegistrationDTO struct {
+	CreatedAt       *string `json:"created_at,omitempty"`
+	Id              *string `json:"id,omitempty"`
+	Phone           *string `json:"phone,omitempty"`
+	RejectionReason *string `json:"rejection_reason,omitempty"`
+	ReviewedAt      *string `json:"reviewed_at,omitempty"`
+	ReviewedBy      *string `json:"reviewed_by,omitempty"`
+
+	// Status pending, rejected or approved
+	Status *string `json:"status,omitempty"`
+}
+
+// HandlersRegistrationResponse defines model for handlers.RegistrationResponse.
+type HandlersRegistrationResponse struct {
+	Data    *HandlersRegistrationDTO `json:"data,omitempty"`
+	Message string                   `json:"message"`
+	Success bool                     `json:"success"`
+
+	// Warning Set when assigning locations/gates or the welcome SMS failed
+	Warning *string `json:"warning,omitempty"`
+}
+
+// HandlersRegistrationsResponse defines model for handlers.RegistrationsResponse.
+type HandlersRegistrationsResponse struct {
+	Data    *[]HandlersRegistrationDTO `json:"data,omitempty"`
+	Message string                     `json:"message"`
+	Success bool                       `json:"success"`
+}
+
+// HandlersRejectRegistrationRequest defines model for handlers.RejectRegistrationRequest.
+type HandlersRejectRegistrationRequest struct {
+	Reason string `json:"reason"`
+}
+
+// HandlersRenamePasskeyRequest defines model for handlers.RenamePasskeyRequest.
+type HandlersRenamePasskeyRequest struct {
+	Name string `json:"name"`
+}
+
+// HandlersResendRegistrationOTPRequest defines model for handlers.ResendRegistrationOTPRequest.
+type HandlersResendRegistrationOTPRequest struct {
+	Phone string `json:"phone"`
+}
+
+// HandlersResetPasswordRequest defines model for handlers.ResetPasswordRequest.
+type HandlersResetPasswordRequest struct {
+	Code        string `json:"code"`
+	NewPassword string `json:"new_password"`
+	Phone       string `json:"phone"`
+}
+
+// HandlersResolveGateReportRequest defines model for handlers.ResolveGateReportRequest.
+type HandlersResolveGateReportRequest struct {
+	// Note What was done, shown with the report
+	Note *string `json:"note,omitempty"`
+}
+
+// HandlersRotatedWebhookSecretDTO defines model for handlers.RotatedWebhookSecretDTO.
+type HandlersRotatedWebhookSecretDTO struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+
+	// Current False for the previous secret, still valid until expires_at
+	Current   *bool   `json:"current,omitempty"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
+	// Hint Last characters of the secret, to recognize it
+	Hint *string `json:"hint,omitempty"`
+	Id   *string `json:"id,omitempty"`
+
+	// Scope outbound or provider_callback
+	Scope  *string `json:"scope,omitempty"`
+	Secret *string `json:"secret,omitempty"`
+}
+
+// HandlersRotatedWebhookSecretResponse defines model for handlers.RotatedWebhookSecretResponse.
+type HandlersRotatedWebhookSecretResponse struct {
+	Data    *HandlersRotatedWebhookSecretDTO `json:"data,omitempty"`
+	Message string                           `json:"message"`
+	Success bool                             `json:"success"`
+}
+
+// HandlersRouteDTO defines model for handlers.RouteDTO.
+type HandlersRouteDTO struct {
+	// Access "public", "provider_token", "user", "admin" or "super_admin"
+	Access     string    `json:"access"`
+	Handler    string    `json:"handler"`
+	Method     string    `json:"method"`
+	Middleware *[]string `json:"middleware,omitempty"`
+	Path       string    `json:"path"`
+}
+
+// HandlersSCIMEmail defines model for handlers.SCIMEmail.
+type HandlersSCIMEmail struct {
+	Primary *bool   `json:"primary,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Value   *string `json:"value,omitempty"`
+}
+
+// HandlersSCIMError defines model for handlers.SCIMError.
+type HandlersSCIMError struct {
+	Detail   *string   `json:"detail,omitempty"`
+	Schemas  *[]string `json:"schemas,omitempty"`
+	ScimType *string   `json:"scimType,omitempty"`
+	Status   *string   `json:"status,omitempty"`
+}
+
+// HandlersSCIMListResponse defines model for handlers.SCIMListResponse.
+type HandlersSCIMListResponse struct {
+	Resources    *[]HandlersSCIMUser `json:"Resources,omitempty"`
+	ItemsPerPage *int                `json:"itemsPerPage,omitempty"`
+	Schemas      *[]string           `json:"schemas,omitempty"`
+	StartIndex   *int                `json:"startIndex,omitempty"`
+	TotalResults *int                `json:"totalResults,omitempty"`
+}
+
+// HandlersSCIMMeta defines model for handlers.SCIMMeta.
+type HandlersSCIMMeta struct {
+	Created      *string `json:"created,omitempty"`
+	LastModified *string `json:"lastModified,omitempty"`
+	Location     *string `json:"location,omitempty"`
+	ResourceType *string `json:"resourceType,omitempty"`
+}
+
+// HandlersSCIMPatchOperation defines model for handlers.SCIMPatchOperation.
+type HandlersSCIMPatchOperation struct {
+	// Op add, replace or remove
+	Op *string `json:"op,omitempty"`
+
+	// Path Optional; without a path, value is an object of attributes
+	Path  *string                 `json:"path,omitempty"`
+	Value *map[string]interface{} `json:"value,omitempty"`
+}
+
+// HandlersSCIMPatchRequest defines model for handlers.SCIMPatchRequest.
+type HandlersSCIMPatchRequest struct {
+	Operations *[]HandlersSCIMPatchOperation `json:"Operations,omitempty"`
+	Schemas    *[]string                     `json:"schemas,omitempty"`
+}
+
+// HandlersSCIMUser defines model for handlers.SCIMUser.
+type HandlersSCIMUser struct {
+	// Active false moves the user to the trash
+	Active *bool `json:"active,omitempty"`
+
+	// Emails The primary email is the user's login email
+	Emails *[]HandlersSCIMEmail `json:"emails,omitempty"`
+
+	// ExternalId The identity provider's ID for the user
+	ExternalId *string           `json:"externalId,omitempty"`
+	Id         *string           `json:"id,omitempty"`
+	Meta       *HandlersSCIMMeta `json:"meta,omitempty"`
+
+	// Password Write-only; users created without one get a random password
+	Password                                     *string                    `json:"password,omitempty"`
+	Schemas                                      *[]string                  `json:"schemas,omitempty"`
+	UrnOloloGateParamsScimSchemasExtension20User *HandlersSCIMUserExtension `json:"urn:ololo-gate:params:scim:schemas:extension:2.0:User,omitempty"`
+
+	// UserName Phone number in international format
+	UserName *string `json:"userName,omitempty"`
+}
+
+// HandlersSCIMUserExtension defines model for handlers.SCIMUserExtension.
+type HandlersSCIMUserExtension struct {
+	Locations *[]HandlersLocationAssignmentRequest `json:"locations,omitempty"`
+}
+
+// HandlersSLOStatusDTO defines model for handlers.SLOStatusDTO.
+type HandlersSLOStatusDTO struct {
+	// Compliance Percent of good requests; 100 without requests
+	Compliance *float32 `json:"compliance,omitempty"`
+
+	// ErrorBudgetRemaining Percent of the allowed bad requests left; negative once overspent
+	ErrorBudgetRemaining *float32 `json:"error_budget_remaining,omitempty"`
+	GoodRequests         *int     `json:"good_requests,omitempty"`
+	LatencyTarget        *string  `json:"latency_target,omitempty"`
+	Met                  *bool    `json:"met,omitempty"`
+	Method               *string  `json:"method,omitempty"`
+	Name                 *string  `json:"name,omitempty"`
+	Path                 *string  `json:"path,omitempty"`
+	Requests             *int     `json:"requests,omitempty"`
+
+	// Target Percent of requests that must succeed within latency_target
+	Target *float32 `json:"target,omitempty"`
+	Window *string  `json:"window,omitempty"`
+}
+
+// HandlersSLOSummaryResponse defines model for handlers.SLOSummaryResponse.
+type HandlersSLOSummaryResponse struct {
+	Data    *[]HandlersSLOStatusDTO `json:"data,omitempty"`
+	Message string                  `json:"message"`
+	Success bool                    `json:"success"`
+}
+
+// HandlersSandboxSMSDTO defines model for handlers.SandboxSMSDTO.
+type HandlersSandboxSMSDTO struct {
+	Message *string `json:"message,omitempty"`
+	Phone   *string `json:"phone,omitempty"`
+	SentAt  *string `json:"sent_at,omitempty"`
+}
+
+// HandlersSandboxSMSResponse defines model for handlers.SandboxSMSResponse.
+type HandlersSandboxSMSResponse struct {
+	Data    *[]HandlersSandboxSMSDTO `json:"data,omitempty"`
+	Message string                   `json:"message"`
+	Success bool                     `json:"success"`
+}
+
+// HandlersScheduledJobDTO defines model for handlers.ScheduledJobDTO.
+type HandlersScheduledJobDTO struct {
+	LastRun  *HandlersJobRunDTO `json:"last_run,omitempty"`
+	Name     *string            `json:"name,omitempty"`
+	NextRun  *string            `json:"next_run,omitempty"`
+	Running  *bool              `json:"running,omitempty"`
+	Schedule *string            `json:"schedule,omitempty"`
+}
+
+// HandlersScheduledJobsResponse defines model for handlers.ScheduledJobsResponse.
+type HandlersScheduledJobsResponse struct {
+	Data    *[]HandlersScheduledJobDTO `json:"data,omitempty"`
+	Message string                     `json:"message"`
+	Success bool                       `json:"success"`
+}
+
+// HandlersSearchResultDTO defines model for handlers.SearchResultDTO.
+type HandlersSearchResultDTO struct {
+	// Id UUID, or the provider's numeric ID for locations and gates
+	Id *string `json:"id,omitempty"`
+
+	// Score Higher is a better match
+	Score *int `json:"score,omitempty"`
+
+	// Subtitle Email, role, address, the gate's location or the audit entry summary
+	Subtitle *string `json:"subtitle,omitempty"`
+	Title    *string `json:"title,omitempty"`
+
+	// Type user, admin, location, gate or audit_log
+	Type *string `json:"type,omitempty"`
+}
+
+// HandlersSecurityDenialDTO defines model for handlers.SecurityDenialDTO.
+type HandlersSecurityDenialDTO struct {
+	ActorId   *string `json:"actor_id,omitempty"`
+	ActorName *string `json:"actor_name,omitempty"`
+	ActorRole *string `json:"actor_role,omitempty"`
+
+	// ActorType admin, user, api_key or anonymous
+	ActorType *string `json:"actor_type,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	IpAddress *string `json:"ip_address,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	Method    *string `json:"method,omitempty"`
+	Path      *string `json:"path,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+	Route     *string `json:"route,omitempty"`
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// HandlersSecurityDenialsResponse defines model for handlers.SecurityDenialsResponse.
+type HandlersSecurityDenialsResponse struct {
+	Data       *[]HandlersSecurityDenialDTO `json:"data,omitempty"`
+	Message    string                       `json:"message"`
+	Pagination *HandlersPaginationMeta      `json:"pagination,omitempty"`
+	Success    bool                         `json:"success"`
+}
+
+// HandlersSetGateMaintenanceRequest defines model for handlers.SetGateMaintenanceRequest.
+type HandlersSetGateMaintenanceRequest struct {
+	// Note Shown to users whose open or close is refused
+	Note string `json:"note"`
+}
+
+// HandlersStaleDeviceDTO defines model for handlers.StaleDeviceDTO.
+type HandlersStaleDeviceDTO struct {
+	// Active Not logged out or expired yet; deleting it logs the device out
+	Active     *bool   `json:"active,omitempty"`
+	DeviceId   *string `json:"device_id,omitempty"`
+	ExpiresAt  *string `json:"expires_at,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+	UserAgent  *string `json:"user_agent,omitempty"`
+	UserId     *string `json:"user_id,omitempty"`
+}
+
+// HandlersStaleDeviceReportDTO defines model for handlers.StaleDeviceReportDTO.
+type HandlersStaleDeviceReportDTO struct {
+	// Active Of which could still be used
+	Active *int `json:"active,omitempty"`
+
+	// Cutoff Sessions last used before this are stale
+	Cutoff *string `json:"cutoff,omitempty"`
+
+	// Devices Least recently used first, up to limit
+	Devices *[]HandlersStaleDeviceDTO `json:"devices,omitempty"`
+
+	// PurgeEnabled JWT_STALE_DEVICE_PURGE: the job deletes them, rather than only reporting
+	PurgeEnabled *bool `json:"purge_enabled,omitempty"`
+
+	// StaleAfter Retention the report was made for
+	StaleAfter *string `json:"stale_after,omitempty"`
+
+	// Total Stale sessions
+	Total *int `json:"total,omitempty"`
+
+	// Users Users with at least one stale session
+	Users *int `json:"users,omitempty"`
+}
+
+// HandlersStaleDeviceReportResponse defines model for handlers.StaleDeviceReportResponse.
+type HandlersStaleDeviceReportResponse struct {
+	Data    *HandlersStaleDeviceReportDTO `json:"data,omitempty"`
+	Message string                        `json:"message"`
+	Success bool                          `json:"success"`
+}
+
+// HandlersSyncChangeDTO defines model for handlers.SyncChangeDTO.
+type HandlersSyncChangeDTO struct {
+	// Action Same actions as the user history
+	Action *string `json:"action,omitempty"`
+	Actor  *string `json:"actor,omitempty"`
+
+	// Changes Changed fields, e.g. "phone", "email", "status", "assignments"
+	Changes    *map[string]HandlersFieldChangeDTO `json:"changes,omitempty"`
+	Id         *string                            `json:"id,omitempty"`
+	OccurredAt *string                            `json:"occurred_at,omitempty"`
+	UserId     *string                            `json:"user_id,omitempty"`
+}
+
+// HandlersSyncChangesResponse defines model for handlers.SyncChangesResponse.
+type HandlersSyncChangesResponse struct {
+	Data *[]HandlersSyncChangeDTO `json:"data,omitempty"`
+
+	// HasMore More changes are available right away
+	HasMore *bool  `json:"has_more,omitempty"`
+	Message string `json:"message"`
+
+	// NextToken Pass as since to get the following changes
+	NextToken *string `json:"next_token,omitempty"`
+	Success   bool    `json:"success"`
+}
+
+// HandlersTrashedUserDTO defines model for handlers.TrashedUserDTO.
+type HandlersTrashedUserDTO struct {
+	Id    *string `json:"id,omitempty"`
+	Phone *string `json:"phone,omitempty"`
+
+	// PurgeAt When the user is purged and their gate access revoked
+	PurgeAt   *string `json:"purge_at,omitempty"`
+	TrashedAt *string `json:"trashed_at,omitempty"`
+	TrashedBy *string `json:"trashed_by,omitempty"`
+}
+
+// HandlersTrashedUserResponse defines model for handlers.TrashedUserResponse.
+type HandlersTrashedUserResponse struct {
+	Data    *HandlersTrashedUserDTO `json:"data,omitempty"`
+	Message string                  `json:"message"`
+	Success bool                    `json:"success"`
+}
+
+// HandlersTrashedUsersResponse defines model for handlers.TrashedUsersResponse.
+type HandlersTrashedUsersResponse struct {
+	Data    *[]HandlersTrashedUserDTO `json:"data,omitempty"`
+	Message string                    `json:"message"`
+	Success bool                      `json:"success"`
+}
+
+// HandlersUpdateAdminRequest defines model for handlers.UpdateAdminRequest.
+type HandlersUpdateAdminRequest struct {
+	Password *string `json:"password,omitempty"`
+	Role     *string `json:"role,omitempty"`
+
+	// Timezone IANA zone of timestamps and report days in the admin panel; "" for DEFAULT_TIMEZONE
+	Timezone *string `json:"timezone,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// HandlersUpdateContactRequest defines model for handlers.UpdateContactRequest.
+type HandlersUpdateContactRequest struct {
+	Address       string `json:"address"`
+	EmailSupport  string `json:"email_support"`
+	SupportNumber int    `json:"support_number"`
+}
+
+// HandlersUpdateNotificationPreferencesRequest defines model for handlers.UpdateNotificationPreferencesRequest.
+type HandlersUpdateNotificationPreferencesRequest struct {
+	// Digest Receive the inactivity digest SMS
+	Digest bool `json:"digest"`
+}
+
+// HandlersUpdateUserRequest defines model for handlers.UpdateUserRequest.
+type HandlersUpdateUserRequest struct {
+	// Email Optional - if provided, will set the login email after checking availability
+	Email *string `json:"email,omitempty"`
+
+	// Locations Optional - if provided, will reassign user to these locations and gates
+	Locations *[]HandlersLocationAssignmentRequest `json:"locations,omitempty"`
+
+	// MaxSessions Optional - concurrent session limit (0 = unlimited, -1 = back to JWT_MAX_SESSIONS)
+	MaxSessions *int `json:"max_sessions,omitempty"`
+
+	// Password Optional - only updates if provided
+	Password *string `json:"password,omitempty"`
+
+	// Phone Optional - if provided, will update phone number after checking availability
+	Phone *string `json:"phone,omitempty"`
+}
+
+// HandlersUsageRollupDTO defines model for handlers.UsageRollupDTO.
+type HandlersUsageRollupDTO struct {
+	ActiveUsers    int    `json:"active_users"`
+	ApiCalls       int    `json:"api_calls"`
+	GateOperations int    `json:"gate_operations"`
+	Month          string `json:"month"`
+	OrgId          string `json:"org_id"`
+	SmsSent        int    `json:"sms_sent"`
+}
+
+// HandlersUsageRollupResponse defines model for handlers.UsageRollupResponse.
+type HandlersUsageRollupResponse struct {
+	Data    *[]HandlersUsageRollupDTO `json:"data,omitempty"`
+	Message string                    `json:"message"`
+	Success bool                      `json:"success"`
+}
+
+// HandlersUserDTO defines model for handlers.UserDTO.
+type HandlersUserDTO struct {
+	CreatedAt string  `json:"created_at"`
+	Email     *string `json:"email,omitempty"`
+	Id        string  `json:"id"`
+	Phone     string  `json:"phone"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// HandlersUserData defines model for handlers.UserData.
+type HandlersUserData struct {
+	Id    string `json:"id"`
+	Phone string `json:"phone"`
+}
+
+// HandlersUserDetailDTO defines model for handlers.UserDetailDTO.
+type HandlersUserDetailDTO struct {
+	CreatedAt       string                `json:"created_at"`
+	Email           *string               `json:"email,omitempty"`
+	EmailVerifiedAt *string               `json:"email_verified_at,omitempty"`
+	Id              string                `json:"id"`
+	Locations       []HandlersLocationDTO `json:"locations"`
+
+	// MaxSessions User's own session limit; absent when JWT_MAX_SESSIONS applies
+	MaxSessions *int   `json:"max_sessions,omitempty"`
+	Phone       string `json:"phone"`
+
+	// Phones Primary and secondary numbers
+	Phones *[]HandlersUserPhoneDTO `json:"phones,omitempty"`
+
+	// TrashedAt Set while the user is in the trash
+	TrashedAt *string `json:"trashed_at,omitempty"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// HandlersUserDetailResponse defines model for handlers.UserDetailResponse.
+type HandlersUserDetailResponse struct {
+	Data    *HandlersUserDetailDTO `json:"data,omitempty"`
+	Message string                 `json:"message"`
+	Success bool                   `json:"success"`
+}
+
+// HandlersUserHistoryDTO defines model for handlers.UserHistoryDTO.
+type HandlersUserHistoryDTO struct {
+	Action *string `json:"action,omitempty"`
+
+	// Actor Admin username, "self" or "system"
+	Actor *string `json:"actor,omitempty"`
+
+	// Changes Changed fields, e.g. "phone", "email", "status", "password_changed", "assignments"
+	Changes   *map[string]HandlersFieldChangeDTO `json:"changes,omitempty"`
+	CreatedAt *string                            `json:"created_at,omitempty"`
+	Id        *string                            `json:"id,omitempty"`
+}
+
+// HandlersUserHistoryResponse defines model for handlers.UserHistoryResponse.
+type HandlersUserHistoryResponse struct {
+	Data       *[]HandlersUserHistoryDTO `json:"data,omitempty"`
+	Message    string                    `json:"message"`
+	Pagination *HandlersPaginationMeta   `json:"pagination,omitempty"`
+	Success    bool                      `json:"success"`
+}
+
+// HandlersUserPhoneDTO defines model for handlers.UserPhoneDTO.
+type HandlersUserPhoneDTO struct {
+	AddedBy   *string `json:"added_by,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	IsPrimary *bool   `json:"is_primary,omitempty"`
+	Phone     *string `json:"phone,omitempty"`
+
+	// VerifiedAt Only verified numbers can log in
+	VerifiedAt *string `json:"verified_at,omitempty"`
+}
+
+// HandlersUserPhoneResponse defines model for handlers.UserPhoneResponse.
+type HandlersUserPhoneResponse struct {
+	Data    *HandlersUserPhoneDTO `json:"data,omitempty"`
+	Message string                `json:"message"`
+	Success bool                  `json:"success"`
+
+	// Warning Set when copying gate access to the number failed
+	Warning *string `json:"warning,omitempty"`
+}
+
+// HandlersUserPhonesResponse defines model for handlers.UserPhonesResponse.
+type HandlersUserPhonesResponse struct {
+	Data    *[]HandlersUserPhoneDTO `json:"data,omitempty"`
+	Message string                  `json:"message"`
+	Success bool                    `json:"success"`
+}
+
+// HandlersUserResponse defines model for handlers.UserResponse.
+type HandlersUserResponse struct {
+	Data    *HandlersUserData `json:"data,omitempty"`
+	Message string            `json:"message"`
+	Success bool              `json:"success"`
+}
+
+// HandlersUserSessionDTO defines model for handlers.UserSessionDTO.
+type HandlersUserSessionDTO struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+
+	// Current True for the session making this request
+	Current    *bool   `json:"current,omitempty"`
+	DeviceId   *string `json:"device_id,omitempty"`
+	ExpiresAt  *string `json:"expires_at,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	IpAddress  *string `json:"ip_address,omitempty"`
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+
+	// Trusted Logged in as a trusted device ("remember me"), so the session is long-lived
+	Trusted   *bool   `json:"trusted,omitempty"`
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// HandlersUserSessionsResponse defines model for handlers.UserSessionsResponse.
+type HandlersUserSessionsResponse struct {
+	Data    *[]HandlersUserSessionDTO `json:"data,omitempty"`
+	Message string                    `json:"message"`
+	Success bool                      `json:"success"`
+}
+
+// HandlersUsersListResponse defines model for handlers.UsersListResponse.
+type HandlersUsersListResponse struct {
+	Data       *[]HandlersUserDTO      `json:"data,omitempty"`
+	Message    string                  `json:"message"`
+	Pagination *HandlersPaginationMeta `json:"pagination,omitempty"`
+	Success    bool                    `json:"success"`
+}
+
+// HandlersVerifyRegistrationOTPRequest defines model for handlers.VerifyRegistrationOTPRequest.
+type HandlersVerifyRegistrationOTPRequest struct {
+	Code  string `json:"code"`
+	Phone string `json:"phone"`
+}
+
+// HandlersWebhookSecretDTO defines model for handlers.WebhookSecretDTO.
+type HandlersWebhookSecretDTO struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+
+	// Current False for the previous secret, still valid until expires_at
+	Current   *bool   `json:"current,omitempty"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
+	// Hint Last characters of the secret, to recognize it
+	Hint *string `json:"hint,omitempty"`
+	Id   *string `json:"id,omitempty"`
+
+	// Scope outbound or provider_callback
+	Scope *string `json:"scope,omitempty"`
+}
+
+// HandlersWebhookSecretsResponse defines model for handlers.WebhookSecretsResponse.
+type HandlersWebhookSecretsResponse struct {
+	Data    *[]HandlersWebhookSecretDTO `json:"data,omitempty"`
+	Message string                      `json:"message"`
+	Success bool                        `json:"success"`
+}
+
+// MiddlewareRetryStrategy defines model for middleware.RetryStrategy.
+type MiddlewareRetryStrategy struct {
+	// MaxAttempts Exponential only: give up after this many retries
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+
+	// ResetAt Unix time of the earliest retry, same as X-RateLimit-Reset
+	ResetAt *int `json:"reset_at,omitempty"`
+
+	// RetryAfterSeconds Same as the Retry-After header
+	RetryAfterSeconds *int `json:"retry_after_seconds,omitempty"`
+
+	// Strategy fixed, exponential or poll
+	Strategy *string `json:"strategy,omitempty"`
+}
+
+// ModelsAdminAuditLog defines model for models.AdminAuditLog.
+type ModelsAdminAuditLog struct {
+	// Action "create_user", "update_user", "delete_user", "create_admin", "delete_admin", "update_contact", etc.
+	Action *string `json:"action,omitempty"`
+
+	// AdminId Who performed the action
+	AdminId *string `json:"admin_id,omitempty"`
+
+	// AdminName Admin username for quick access (denormalized)
+	AdminName *string `json:"admin_name,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+
+	// Details JSON with request details (what was changed)
+	Details *string `json:"details,omitempty"`
+
+	// ErrorMessage Error message if failed
+	ErrorMessage *string `json:"error_message,omitempty"`
+	Id           *string `json:"id,omitempty"`
+
+	// IpAddress Request IP address
+	IpAddress *string `json:"ip_address,omitempty"`
+
+	// ResourceId UUID or ID of affected resource
+	ResourceId *string `json:"resource_id,omitempty"`
+
+	// ResourceType "user", "admin", "contact", etc.
+	ResourceType *string `json:"resource_type,omitempty"`
+
+	// Status "success" or "failed"
+	Status *string `json:"status,omitempty"`
+
+	// UserAgent Request user agent
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// ServicesPasskeyAuthenticatorSelection defines model for services.PasskeyAuthenticatorSelection.
+type ServicesPasskeyAuthenticatorSelection struct {
+	ResidentKey      *string `json:"residentKey,omitempty"`
+	UserVerification *string `json:"userVerification,omitempty"`
+}
+
+// ServicesPasskeyCreationOptions defines model for services.PasskeyCreationOptions.
+type ServicesPasskeyCreationOptions struct {
+	Attestation            *string                                `json:"attestation,omitempty"`
+	AuthenticatorSelection *ServicesPasskeyAuthenticatorSelection `json:"authenticatorSelection,omitempty"`
+	Challenge              *string                                `json:"challenge,omitempty"`
+	ExcludeCredentials     *[]ServicesPasskeyCredentialDescriptor `json:"excludeCredentials,omitempty"`
+	PubKeyCredParams       *[]ServicesPasskeyCredentialParameter  `json:"pubKeyCredParams,omitempty"`
+	Rp                     *ServicesPasskeyRelyingParty           `json:"rp,omitempty"`
+
+	// Timeout Milliseconds
+	Timeout *int                 `json:"timeout,omitempty"`
+	User    *ServicesPasskeyUser `json:"user,omitempty"`
+}
+
+// ServicesPasskeyCredentialDescriptor defines model for services.PasskeyCredentialDescriptor.
+type ServicesPasskeyCredentialDescriptor struct {
+	// Id base64url
+	Id         *string   `json:"id,omitempty"`
+	Transports *[]string `json:"transports,omitempty"`
+	Type       *string   `json:"type,omitempty"`
+}
+
+// ServicesPasskeyCredentialParameter defines model for services.PasskeyCredentialParameter.
+type ServicesPasskeyCredentialParameter struct {
+	Alg  *int    `json:"alg,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// ServicesPasskeyRelyingParty defines model for services.PasskeyRelyingParty.
+type ServicesPasskeyRelyingParty struct {
+	Id   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// ServicesPasskeyRequestOptions defines model for services.PasskeyRequestOptions.
+type ServicesPasskeyRequestOptions struct {
+	AllowCredentials *[]ServicesPasskeyCredentialDescriptor `json:"allowCredentials,omitempty"`
+	Challenge        *string                                `json:"challenge,omitempty"`
+	RpId             *string                                `json:"rpId,omitempty"`
+
+	// Timeout Milliseconds
+	Timeout          *int    `json:"timeout,omitempty"`
+	UserVerification *string `json:"userVerification,omitempty"`
+}
+
+// ServicesPasskeyUser defines model for services.PasskeyUser.
+type ServicesPasskeyUser struct {
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Id base64url of the admin UUID bytes, returned as the user handle
+	Id   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// GetParams defines parameters for Get.
+type GetParams struct {
+	// Authorization Bearer HEALTH_TOKEN, when it is set
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetApiV1AdminAuditLogsParams defines parameters for GetApiV1AdminAuditLogs.
+type GetApiV1AdminAuditLogsParams struct {
+	// Page Page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// AdminId Filter by admin ID
+	AdminId *string `form:"admin_id,omitempty" json:"admin_id,omitempty"`
+
+	// Action Filter by action type
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// ResourceType Filter by resource type
+	ResourceType *string `form:"resource_type,omitempty" json:"resource_type,omitempty"`
+
+	// Count exact (default) or estimate: approximate total from table statistics, for large tables
+	Count *string `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetApiV1AdminCorsOriginsParams defines parameters for GetApiV1AdminCorsOrigins.
+type GetApiV1AdminCorsOriginsParams struct {
+	// Scope Filter by scope (public, admin)
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// GetApiV1AdminFeedParams defines parameters for GetApiV1AdminFeed.
+type GetApiV1AdminFeedParams struct {
+	// Token Admin access token (alternative to the Authorization header)
+	Token *string `form:"token,omitempty" json:"token,omitempty"`
+}
+
+// GetApiV1AdminGateEventsParams defines parameters for GetApiV1AdminGateEvents.
+type GetApiV1AdminGateEventsParams struct {
+	// Page Page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (max 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// From First day, YYYY-MM-DD (defaults to 29 days before to)
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day, inclusive, YYYY-MM-DD (defaults to today)
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// UserId Only attempts by this user (UUID)
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// GateId Only this gate
+	GateId *int `form:"gate_id,omitempty" json:"gate_id,omitempty"`
+
+	// LocationId Only gates of this location
+	LocationId *int `form:"location_id,omitempty" json:"location_id,omitempty"`
+
+	// Result Only this result (sent, queued, refused, failed)
+	Result *string `form:"result,omitempty" json:"result,omitempty"`
+}
+
+// GetApiV1AdminGateEventsExportParams defines parameters for GetApiV1AdminGateEventsExport.
+type GetApiV1AdminGateEventsExportParams struct {
+	// From First day, YYYY-MM-DD (defaults to 29 days before to)
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day, inclusive, YYYY-MM-DD (defaults to today)
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// LocationId Only gates of this location
+	LocationId *int `form:"location_id,omitempty" json:"location_id,omitempty"`
+
+	// GateId Only this gate
+	GateId *int `form:"gate_id,omitempty" json:"gate_id,omitempty"`
+
+	// UserId Only commands issued by this user (UUID)
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetApiV1AdminGateReportsParams defines parameters for GetApiV1AdminGateReports.
+type GetApiV1AdminGateReportsParams struct {
+	// Status open (default), resolved or all
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// GateId Only reports on this gate
+	GateId *int `form:"gate_id,omitempty" json:"gate_id,omitempty"`
+
+	// Page Page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (max 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1AdminInviteCodesParams defines parameters for GetApiV1AdminInviteCodes.
+type GetApiV1AdminInviteCodesParams struct {
+	// Active Only list codes that can still be used
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+}
+
+// GetApiV1AdminNotificationsParams defines parameters for GetApiV1AdminNotifications.
+type GetApiV1AdminNotificationsParams struct {
+	// Page Page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (max 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Severity Filter by severity (info, warning, critical)
+	Severity *string `form:"severity,omitempty" json:"severity,omitempty"`
+
+	// Category Filter by category (security, provider, jobs)
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+
+	// Unread Only return unread notifications
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+
+	// Count exact (default) or estimate: approximate total from table statistics, for large tables
+	Count *string `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetApiV1AdminProviderMigrationReportParams defines parameters for GetApiV1AdminProviderMigrationReport.
+type GetApiV1AdminProviderMigrationReportParams struct {
+	// SinceHours Only include calls from the last N hours
+	SinceHours *int `form:"since_hours,omitempty" json:"since_hours,omitempty"`
+
+	// Limit Maximum number of mismatches to return (max 500)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1AdminProviderUsageParams defines parameters for GetApiV1AdminProviderUsage.
+type GetApiV1AdminProviderUsageParams struct {
+	// Month UTC month in YYYY-MM format (defaults to the current month)
+	Month *string `form:"month,omitempty" json:"month,omitempty"`
+}
+
+// GetApiV1AdminRegistrationsParams defines parameters for GetApiV1AdminRegistrations.
+type GetApiV1AdminRegistrationsParams struct {
+	// Status pending (default), rejected, approved or unverified (phone not confirmed yet, OTP_REGISTRATION_ENABLED)
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetApiV1AdminReportsParams defines parameters for GetApiV1AdminReports.
+type GetApiV1AdminReportsParams struct {
+	// Report Report name
+	Report GetApiV1AdminReportsParamsReport `form:"report" json:"report"`
+
+	// From First day, YYYY-MM-DD (defaults to 29 days before to)
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day, inclusive, YYYY-MM-DD (defaults to today)
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Format Output format
+	Format *GetApiV1AdminReportsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetApiV1AdminReportsParamsReport defines parameters for GetApiV1AdminReports.
+type GetApiV1AdminReportsParamsReport string
+
+// GetApiV1AdminReportsParamsFormat defines parameters for GetApiV1AdminReports.
+type GetApiV1AdminReportsParamsFormat string
+
+// GetApiV1AdminSandboxSmsParams defines parameters for GetApiV1AdminSandboxSms.
+type GetApiV1AdminSandboxSmsParams struct {
+	// Phone Only messages sent to this phone number
+	Phone *string `form:"phone,omitempty" json:"phone,omitempty"`
+}
+
+// GetApiV1AdminSearchParams defines parameters for GetApiV1AdminSearch.
+type GetApiV1AdminSearchParams struct {
+	// Q Search query (at least 2 characters)
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of results (max 50)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1AdminSecurityDenialsParams defines parameters for GetApiV1AdminSecurityDenials.
+type GetApiV1AdminSecurityDenialsParams struct {
+	// Page Page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (max 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// ActorType Filter by caller type (admin, user, api_key, anonymous)
+	ActorType *string `form:"actor_type,omitempty" json:"actor_type,omitempty"`
+
+	// ActorId Filter by admin, user or API key ID (UUID)
+	ActorId *string `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+
+	// Reason Filter by reason (super_admin_required, not_owner, auditor_read_only, api_key_scope, impersonation, gate_not_assigned, invalid_signature, unknown_requirement, forbidden)
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
+
+	// Route Filter by access rule pattern, e.g. /api/v1/admins/:id
+	Route *string `form:"route,omitempty" json:"route,omitempty"`
+
+	// Ip Filter by client IP address
+	Ip *string `form:"ip,omitempty" json:"ip,omitempty"`
+
+	// Since Only denials at or after this time (RFC3339)
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetApiV1AdminStaleDevicesParams defines parameters for GetApiV1AdminStaleDevices.
+type GetApiV1AdminStaleDevicesParams struct {
+	// OlderThan Retention to preview as a Go duration, e.g. 2160h (defaults to JWT_STALE_DEVICE_AFTER)
+	OlderThan *string `form:"older_than,omitempty" json:"older_than,omitempty"`
+
+	// Limit Stale sessions to list (default 20, max 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1AdminUsageParams defines parameters for GetApiV1AdminUsage.
+type GetApiV1AdminUsageParams struct {
+	// Month UTC month in YYYY-MM format (defaults to the current month)
+	Month *string `form:"month,omitempty" json:"month,omitempty"`
+}
+
+// GetApiV1AdminUsersParams defines parameters for GetApiV1AdminUsers.
+type GetApiV1AdminUsersParams struct {
+	// Page Page number (default: 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Records per page (default: 500)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Search Search by username
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Role Filter by role (super, regular or auditor)
+	Role *string `form:"role,omitempty" json:"role,omitempty"`
+
+	// Order Order results by created_at (ASC or DESC, default: DESC)
+	Order *string `form:"order,omitempty" json:"order,omitempty"`
+
+	// Stream With limit=-1: json streams the response row by row, ndjson streams one object per line
+	Stream *string `form:"stream,omitempty" json:"stream,omitempty"`
+}
+
+// PostApiV1AdminUsersImportMultipartBody defines parameters for PostApiV1AdminUsersImport.
+type PostApiV1AdminUsersImportMultipartBody struct {
+	// File CSV file, when uploading a multipart form
+	File *openapi_types.File `json:"file,omitempty"`
+}
+
+// GetApiV1AdminUsersIdHistoryParams defines parameters for GetApiV1AdminUsersIdHistory.
+type GetApiV1AdminUsersIdHistoryParams struct {
+	// Page Page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (max 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1AuthCheckPhoneParams defines parameters for GetApiV1AuthCheckPhone.
+type GetApiV1AuthCheckPhoneParams struct {
+	// Phone Phone number, international (e.g., +77771234567) or national format
+	Phone string `form:"phone" json:"phone"`
+}
+
+// PostApiV1AuthLoginParams defines parameters for PostApiV1AuthLogin.
+type PostApiV1AuthLoginParams struct {
+	// DeviceId Unique device identifier (optional - a new login on the same device replaces that device's previous session)
+	DeviceId *string `form:"device_id,omitempty" json:"device_id,omitempty"`
+
+	// ClientType Client type selecting token lifetimes (e.g. kiosk, resident); unknown types use the default lifetimes
+	ClientType *string `form:"client_type,omitempty" json:"client_type,omitempty"`
+}
+
+// PostApiV1AuthLoginOtpConfirmParams defines parameters for PostApiV1AuthLoginOtpConfirm.
+type PostApiV1AuthLoginOtpConfirmParams struct {
+	// DeviceId Unique device identifier (optional - a new login on the same device replaces that device's previous session)
+	DeviceId *string `form:"device_id,omitempty" json:"device_id,omitempty"`
+
+	// ClientType Client type selecting token lifetimes (e.g. kiosk, resident); unknown types use the default lifetimes
+	ClientType *string `form:"client_type,omitempty" json:"client_type,omitempty"`
+}
+
+// PostApiV1AuthRefreshParams defines parameters for PostApiV1AuthRefresh.
+type PostApiV1AuthRefreshParams struct {
+	// XDeviceID Device the refresh token was issued to (required for device-bound tokens unless JWT_REQUIRE_DEVICE_HEADER is off)
+	XDeviceID *string `json:"X-Device-ID,omitempty"`
+}
+
+// GetApiV1FilesKeyParams defines parameters for GetApiV1FilesKey.
+type GetApiV1FilesKeyParams struct {
+	// Expires Expiry (unix seconds)
+	Expires int `form:"expires" json:"expires"`
+
+	// Sig Signature
+	Sig string `form:"sig" json:"sig"`
+}
+
+// PostApiV1GateCommandsIdCallbackParams defines parameters for PostApiV1GateCommandsIdCallback.
+type PostApiV1GateCommandsIdCallbackParams struct {
+	// XProviderToken Shared provider callback token
+	XProviderToken string `json:"X-Provider-Token"`
+}
+
+// PostApiV1GatesGateIdReportJSONBody defines parameters for PostApiV1GatesGateIdReport.
+type PostApiV1GatesGateIdReportJSONBody struct {
+	// Category Problem category: stuck_open, stuck_closed, damaged or other
+	Category string `json:"category"`
+
+	// Description What is wrong with the gate
+	Description *string `json:"description,omitempty"`
+
+	// Photo Photo of the problem
+	Photo *openapi_types.File `json:"photo,omitempty"`
+}
+
+// PostApiV1GatesGateIdReportMultipartBody defines parameters for PostApiV1GatesGateIdReport.
+type PostApiV1GatesGateIdReportMultipartBody struct {
+	// Category Problem category: stuck_open, stuck_closed, damaged or other
+	Category string `json:"category"`
+
+	// Description What is wrong with the gate
+	Description *string `json:"description,omitempty"`
+
+	// Photo Photo of the problem
+	Photo *openapi_types.File `json:"photo,omitempty"`
+}
+
+// GetApiV1LegalKindParams defines parameters for GetApiV1LegalKind.
+type GetApiV1LegalKindParams struct {
+	// Version Version (default: current)
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// GetApiV1LinksIdParams defines parameters for GetApiV1LinksId.
+type GetApiV1LinksIdParams struct {
+	// Sig Link signature
+	Sig string `form:"sig" json:"sig"`
+}
+
+// GetApiV1ScimV2UsersParams defines parameters for GetApiV1ScimV2Users.
+type GetApiV1ScimV2UsersParams struct {
+	// Filter Filter, e.g. userName eq \
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// StartIndex 1-based index of the first result
+	StartIndex *int `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+
+	// Count Results per page (max 200)
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetApiV1SyncChangesParams defines parameters for GetApiV1SyncChanges.
+type GetApiV1SyncChangesParams struct {
+	// Since Token returned as next_token by the previous call; omit to start at the first change
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Changes per page (max 1000)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1UsersParams defines parameters for GetApiV1Users.
+type GetApiV1UsersParams struct {
+	// Page Page number (default: 1)
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Records per page (default: 500)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Search Search by full phone number, its last 4 digits, or full email
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Order Order results by created_at (ASC or DESC, default: DESC)
+	Order *string `form:"order,omitempty" json:"order,omitempty"`
+
+	// Stream With limit=-1: json streams the response row by row, ndjson streams one object per line
+	Stream *string `form:"stream,omitempty" json:"stream,omitempty"`
+
+	// Count exact (default) or estimate: approximate total from table statistics, for large tables
+	Count *string `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// PostApiV1UsersParams defines parameters for PostApiV1Users.
+type PostApiV1UsersParams struct {
+	// Strict Roll back the user if location/gate assignment fails (defaults to ASSIGNMENT_STRICT_MODE)
+	Strict *bool `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetApiV1UsersIdHistoryParams defines parameters for GetApiV1UsersIdHistory.
+type GetApiV1UsersIdHistoryParams struct {
+	// Page Page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Items per page (max 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Action Filter by action (created, registered, updated, assigned, phone_added, phone_removed, trashed, restored, purged, approved, rejected, merged, merged_into)
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+}
+
+// PostApiV1AdminApiKeysJSONRequestBody defines body for PostApiV1AdminApiKeys for application/json ContentType.
+type PostApiV1AdminApiKeysJSONRequestBody = HandlersCreateAPIKeyRequest
+
+// PostApiV1AdminAuditLogsIdCommentsJSONRequestBody defines body for PostApiV1AdminAuditLogsIdComments for application/json ContentType.
+type PostApiV1AdminAuditLogsIdCommentsJSONRequestBody = HandlersCreateAuditCommentRequest
+
+// PostApiV1AdminCorsOriginsJSONRequestBody defines body for PostApiV1AdminCorsOrigins for application/json ContentType.
+type PostApiV1AdminCorsOriginsJSONRequestBody = HandlersCreateCORSOriginRequest
+
+// PostApiV1AdminExportsJSONRequestBody defines body for PostApiV1AdminExports for application/json ContentType.
+type PostApiV1AdminExportsJSONRequestBody = HandlersCreateExportRequest
+
+// PostApiV1AdminForcedLogoutsJSONRequestBody defines body for PostApiV1AdminForcedLogouts for application/json ContentType.
+type PostApiV1AdminForcedLogoutsJSONRequestBody = HandlersCreateForcedLogoutRequest
+
+// PostApiV1AdminGateReportsIdResolveJSONRequestBody defines body for PostApiV1AdminGateReportsIdResolve for application/json ContentType.
+type PostApiV1AdminGateReportsIdResolveJSONRequestBody = HandlersResolveGateReportRequest
+
+// PutApiV1AdminGatesGateIdMaintenanceJSONRequestBody defines body for PutApiV1AdminGatesGateIdMaintenance for application/json ContentType.
+type PutApiV1AdminGatesGateIdMaintenanceJSONRequestBody = HandlersSetGateMaintenanceRequest
+
+// PutApiV1AdminGatesGateIdOpenJSONRequestBody defines body for PutApiV1AdminGatesGateIdOpen for application/json ContentType.
+type PutApiV1AdminGatesGateIdOpenJSONRequestBody = HandlersEmergencyOpenRequest
+
+// PostApiV1AdminImpersonateUserIdJSONRequestBody defines body for PostApiV1AdminImpersonateUserId for application/json ContentType.
+type PostApiV1AdminImpersonateUserIdJSONRequestBody = HandlersImpersonateUserRequest
+
+// PostApiV1AdminInviteCodesJSONRequestBody defines body for PostApiV1AdminInviteCodes for application/json ContentType.
+type PostApiV1AdminInviteCodesJSONRequestBody = HandlersCreateInviteCodeRequest
+
+// PostApiV1AdminLegalJSONRequestBody defines body for PostApiV1AdminLegal for application/json ContentType.
+type PostApiV1AdminLegalJSONRequestBody = HandlersPublishLegalDocumentRequest
+
+// PostApiV1AdminLocationsIdFreezeJSONRequestBody defines body for PostApiV1AdminLocationsIdFreeze for application/json ContentType.
+type PostApiV1AdminLocationsIdFreezeJSONRequestBody = HandlersFreezeLocationRequest
+
+// PutApiV1AdminLocationsIdOverrideJSONRequestBody defines body for PutApiV1AdminLocationsIdOverride for application/json ContentType.
+type PutApiV1AdminLocationsIdOverrideJSONRequestBody = HandlersLocationOverrideRequest
+
+// PostApiV1AdminLoginJSONRequestBody defines body for PostApiV1AdminLogin for application/json ContentType.
+type PostApiV1AdminLoginJSONRequestBody = HandlersAdminLoginRequest
+
+// PostApiV1AdminLoginPasskeyJSONRequestBody defines body for PostApiV1AdminLoginPasskey for application/json ContentType.
+type PostApiV1AdminLoginPasskeyJSONRequestBody = HandlersPasskeyLoginRequest
+
+// PostApiV1AdminLoginPasskeyOptionsJSONRequestBody defines body for PostApiV1AdminLoginPasskeyOptions for application/json ContentType.
+type PostApiV1AdminLoginPasskeyOptionsJSONRequestBody = HandlersPasskeyLoginOptionsRequest
+
+// PostApiV1AdminNotificationsIdCommentsJSONRequestBody defines body for PostApiV1AdminNotificationsIdComments for application/json ContentType.
+type PostApiV1AdminNotificationsIdCommentsJSONRequestBody = HandlersCreateAuditCommentRequest
+
+// PostApiV1AdminRefreshJSONRequestBody defines body for PostApiV1AdminRefresh for application/json ContentType.
+type PostApiV1AdminRefreshJSONRequestBody = HandlersRefreshRequest
+
+// PostApiV1AdminRegistrationsIdApproveJSONRequestBody defines body for PostApiV1AdminRegistrationsIdApprove for application/json ContentType.
+type PostApiV1AdminRegistrationsIdApproveJSONRequestBody = HandlersApproveRegistrationRequest
+
+// PostApiV1AdminRegistrationsIdRejectJSONRequestBody defines body for PostApiV1AdminRegistrationsIdReject for application/json ContentType.
+type PostApiV1AdminRegistrationsIdRejectJSONRequestBody = HandlersRejectRegistrationRequest
+
+// PostApiV1AdminUsersJSONRequestBody defines body for PostApiV1AdminUsers for application/json ContentType.
+type PostApiV1AdminUsersJSONRequestBody = HandlersCreateAdminRequest
+
+// PostApiV1AdminUsersImportMultipartRequestBody defines body for PostApiV1AdminUsersImport for multipart/form-data ContentType.
+type PostApiV1AdminUsersImportMultipartRequestBody PostApiV1AdminUsersImportMultipartBody
+
+// PatchApiV1AdminUsersIdJSONRequestBody defines body for PatchApiV1AdminUsersId for application/json ContentType.
+type PatchApiV1AdminUsersIdJSONRequestBody = HandlersUpdateAdminRequest
+
+// PostApiV1AdminUsersIdPasskeysJSONRequestBody defines body for PostApiV1AdminUsersIdPasskeys for application/json ContentType.
+type PostApiV1AdminUsersIdPasskeysJSONRequestBody = HandlersPasskeyRegistrationRequest
+
+// PatchApiV1AdminUsersIdPasskeysCredentialIdJSONRequestBody defines body for PatchApiV1AdminUsersIdPasskeysCredentialId for application/json ContentType.
+type PatchApiV1AdminUsersIdPasskeysCredentialIdJSONRequestBody = HandlersRenamePasskeyRequest
+
+// PostApiV1AuthChangePasswordJSONRequestBody defines body for PostApiV1AuthChangePassword for application/json ContentType.
+type PostApiV1AuthChangePasswordJSONRequestBody = HandlersChangePasswordRequest
+
+// PostApiV1AuthForgotPasswordJSONRequestBody defines body for PostApiV1AuthForgotPassword for application/json ContentType.
+type PostApiV1AuthForgotPasswordJSONRequestBody = HandlersForgotPasswordRequest
+
+// PostApiV1AuthLegalAcceptJSONRequestBody defines body for PostApiV1AuthLegalAccept for application/json ContentType.
+type PostApiV1AuthLegalAcceptJSONRequestBody = HandlersAcceptLegalDocumentRequest
+
+// PostApiV1AuthLoginJSONRequestBody defines body for PostApiV1AuthLogin for application/json ContentType.
+type PostApiV1AuthLoginJSONRequestBody = HandlersLoginRequest
+
+// PostApiV1AuthLoginOtpConfirmJSONRequestBody defines body for PostApiV1AuthLoginOtpConfirm for application/json ContentType.
+type PostApiV1AuthLoginOtpConfirmJSONRequestBody = HandlersLoginOTPConfirmRequest
+
+// PostApiV1AuthLoginOtpRequestJSONRequestBody defines body for PostApiV1AuthLoginOtpRequest for application/json ContentType.
+type PostApiV1AuthLoginOtpRequestJSONRequestBody = HandlersLoginOTPRequest
+
+// PutApiV1AuthNotificationPreferencesJSONRequestBody defines body for PutApiV1AuthNotificationPreferences for application/json ContentType.
+type PutApiV1AuthNotificationPreferencesJSONRequestBody = HandlersUpdateNotificationPreferencesRequest
+
+// PostApiV1AuthRefreshJSONRequestBody defines body for PostApiV1AuthRefresh for application/json ContentType.
+type PostApiV1AuthRefreshJSONRequestBody = HandlersRefreshRequest
+
+// PostApiV1AuthRegisterJSONRequestBody defines body for PostApiV1AuthRegister for application/json ContentType.
+type PostApiV1AuthRegisterJSONRequestBody = HandlersRegisterRequest
+
+// PostApiV1AuthResetPasswordJSONRequestBody defines body for PostApiV1AuthResetPassword for application/json ContentType.
+type PostApiV1AuthResetPasswordJSONRequestBody = HandlersResetPasswordRequest
+
+// PostApiV1AuthVerifyOtpJSONRequestBody defines body for PostApiV1AuthVerifyOtp for application/json ContentType.
+type PostApiV1AuthVerifyOtpJSONRequestBody = HandlersVerifyRegistrationOTPRequest
+
+// PostApiV1AuthVerifyOtpResendJSONRequestBody defines body for PostApiV1AuthVerifyOtpResend for application/json ContentType.
+type PostApiV1AuthVerifyOtpResendJSONRequestBody = HandlersResendRegistrationOTPRequest
+
+// PatchApiV1ContactsJSONRequestBody defines body for PatchApiV1Contacts for application/json ContentType.
+type PatchApiV1ContactsJSONRequestBody = HandlersUpdateContactRequest
+
+// PostApiV1GateCommandsIdCallbackJSONRequestBody defines body for PostApiV1GateCommandsIdCallback for application/json ContentType.
+type PostApiV1GateCommandsIdCallbackJSONRequestBody = HandlersGateCommandCallbackRequest
+
+// PostApiV1GatesGateIdReportJSONRequestBody defines body for PostApiV1GatesGateIdReport for application/json ContentType.
+type PostApiV1GatesGateIdReportJSONRequestBody PostApiV1GatesGateIdReportJSONBody
+
+// PostApiV1GatesGateIdReportMultipartRequestBody defines body for PostApiV1GatesGateIdReport for multipart/form-data ContentType.
+type PostApiV1GatesGateIdReportMultipartRequestBody PostApiV1GatesGateIdReportMultipartBody
+
+// PostApiV1LocationsGateIdLinksJSONRequestBody defines body for PostApiV1LocationsGateIdLinks for application/json ContentType.
+type PostApiV1LocationsGateIdLinksJSONRequestBody = HandlersCreateGateLinkRequest
+
+// PostApiV1ScimV2UsersJSONRequestBody defines body for PostApiV1ScimV2Users for application/scim+json ContentType.
+type PostApiV1ScimV2UsersJSONRequestBody = HandlersSCIMUser
+
+// PatchApiV1ScimV2UsersIdJSONRequestBody defines body for PatchApiV1ScimV2UsersId for application/scim+json ContentType.
+type PatchApiV1ScimV2UsersIdJSONRequestBody = HandlersSCIMPatchRequest
+
+// PutApiV1ScimV2UsersIdJSONRequestBody defines body for PutApiV1ScimV2UsersId for application/scim+json ContentType.
+type PutApiV1ScimV2UsersIdJSONRequestBody = HandlersSCIMUser
+
+// PostApiV1UsersJSONRequestBody defines body for PostApiV1Users for application/json ContentType.
+type PostApiV1UsersJSONRequestBody = HandlersCreateUserRequest
+
+// PostApiV1UsersBulkDeleteJSONRequestBody defines body for PostApiV1UsersBulkDelete for application/json ContentType.
+type PostApiV1UsersBulkDeleteJSONRequestBody = HandlersBulkDeleteUsersRequest
+
+// PatchApiV1UsersIdJSONRequestBody defines body for PatchApiV1UsersId for application/json ContentType.
+type PatchApiV1UsersIdJSONRequestBody = HandlersUpdateUserRequest
+
+// PostApiV1UsersIdMergeJSONRequestBody defines body for PostApiV1UsersIdMerge for application/json ContentType.
+type PostApiV1UsersIdMergeJSONRequestBody = HandlersMergeUsersRequest
+
+// PostApiV1UsersIdPhonesJSONRequestBody defines body for PostApiV1UsersIdPhones for application/json ContentType.
+type PostApiV1UsersIdPhonesJSONRequestBody = HandlersAddUserPhoneRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// Get request
+	Get(ctx context.Context, params *GetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminApiKeys request
+	GetApiV1AdminApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminApiKeys request with any body
+	PostApiV1AdminApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminApiKeys(ctx context.Context, body PostApiV1AdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AdminApiKeysId request
+	DeleteApiV1AdminApiKeysId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminAuditLogs request
+	GetApiV1AdminAuditLogs(ctx context.Context, params *GetApiV1AdminAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminAuditLogsId request
+	GetApiV1AdminAuditLogsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminAuditLogsIdComments request with any body
+	PostApiV1AdminAuditLogsIdCommentsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminAuditLogsIdComments(ctx context.Context, id string, body PostApiV1AdminAuditLogsIdCommentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminConfigReload request
+	PostApiV1AdminConfigReload(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminCorsOrigins request
+	GetApiV1AdminCorsOrigins(ctx context.Context, params *GetApiV1AdminCorsOriginsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminCorsOrigins request with any body
+	PostApiV1AdminCorsOriginsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminCorsOrigins(ctx context.Context, body PostApiV1AdminCorsOriginsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AdminCorsOriginsId request
+	DeleteApiV1AdminCorsOriginsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminDigestRuns request
+	GetApiV1AdminDigestRuns(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminExports request with any body
+	PostApiV1AdminExportsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminExports(ctx context.Context, body PostApiV1AdminExportsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminExportsId request
+	GetApiV1AdminExportsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminFeed request
+	GetApiV1AdminFeed(ctx context.Context, params *GetApiV1AdminFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminForcedLogouts request with any body
+	PostApiV1AdminForcedLogoutsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminForcedLogouts(ctx context.Context, body PostApiV1AdminForcedLogoutsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminForcedLogoutsId request
+	GetApiV1AdminForcedLogoutsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminGateEvents request
+	GetApiV1AdminGateEvents(ctx context.Context, params *GetApiV1AdminGateEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminGateEventsExport request
+	GetApiV1AdminGateEventsExport(ctx context.Context, params *GetApiV1AdminGateEventsExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminGateReports request
+	GetApiV1AdminGateReports(ctx context.Context, params *GetApiV1AdminGateReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminGateReportsIdPhoto request
+	GetApiV1AdminGateReportsIdPhoto(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminGateReportsIdResolve request with any body
+	PostApiV1AdminGateReportsIdResolveWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminGateReportsIdResolve(ctx context.Context, id string, body PostApiV1AdminGateReportsIdResolveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminGatesMaintenance request
+	GetApiV1AdminGatesMaintenance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AdminGatesGateIdMaintenance request
+	DeleteApiV1AdminGatesGateIdMaintenance(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1AdminGatesGateIdMaintenance request with any body
+	PutApiV1AdminGatesGateIdMaintenanceWithBody(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1AdminGatesGateIdMaintenance(ctx context.Context, gateId int, body PutApiV1AdminGatesGateIdMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1AdminGatesGateIdOpen request with any body
+	PutApiV1AdminGatesGateIdOpenWithBody(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1AdminGatesGateIdOpen(ctx context.Context, gateId int, body PutApiV1AdminGatesGateIdOpenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminImpersonateUserId request with any body
+	PostApiV1AdminImpersonateUserIdWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminImpersonateUserId(ctx context.Context, userId string, body PostApiV1AdminImpersonateUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminInviteCodes request
+	GetApiV1AdminInviteCodes(ctx context.Context, params *GetApiV1AdminInviteCodesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminInviteCodes request with any body
+	PostApiV1AdminInviteCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminInviteCodes(ctx context.Context, body PostApiV1AdminInviteCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AdminInviteCodesId request
+	DeleteApiV1AdminInviteCodesId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminJobs request
+	GetApiV1AdminJobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminLegal request with any body
+	PostApiV1AdminLegalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminLegal(ctx context.Context, body PostApiV1AdminLegalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminLocationsFreezes request
+	GetApiV1AdminLocationsFreezes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminLocationsOverrides request
+	GetApiV1AdminLocationsOverrides(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AdminLocationsIdFreeze request
+	DeleteApiV1AdminLocationsIdFreeze(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminLocationsIdFreeze request with any body
+	PostApiV1AdminLocationsIdFreezeWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminLocationsIdFreeze(ctx context.Context, id int, body PostApiV1AdminLocationsIdFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AdminLocationsIdOverride request
+	DeleteApiV1AdminLocationsIdOverride(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1AdminLocationsIdOverride request with any body
+	PutApiV1AdminLocationsIdOverrideWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1AdminLocationsIdOverride(ctx context.Context, id int, body PutApiV1AdminLocationsIdOverrideJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminLogin request with any body
+	PostApiV1AdminLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminLogin(ctx context.Context, body PostApiV1AdminLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminLoginPasskey request with any body
+	PostApiV1AdminLoginPasskeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminLoginPasskey(ctx context.Context, body PostApiV1AdminLoginPasskeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminLoginPasskeyOptions request with any body
+	PostApiV1AdminLoginPasskeyOptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminLoginPasskeyOptions(ctx context.Context, body PostApiV1AdminLoginPasskeyOptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminNotifications request
+	GetApiV1AdminNotifications(ctx context.Context, params *GetApiV1AdminNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiV1AdminNotificationsReadAll request
+	PatchApiV1AdminNotificationsReadAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminNotificationsId request
+	GetApiV1AdminNotificationsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminNotificationsIdComments request with any body
+	PostApiV1AdminNotificationsIdCommentsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminNotificationsIdComments(ctx context.Context, id string, body PostApiV1AdminNotificationsIdCommentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiV1AdminNotificationsIdRead request
+	PatchApiV1AdminNotificationsIdRead(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminProviderMigrationReport request
+	GetApiV1AdminProviderMigrationReport(ctx context.Context, params *GetApiV1AdminProviderMigrationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminProviderUsage request
+	GetApiV1AdminProviderUsage(ctx context.Context, params *GetApiV1AdminProviderUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminRefresh request with any body
+	PostApiV1AdminRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminRefresh(ctx context.Context, body PostApiV1AdminRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminRegistrations request
+	GetApiV1AdminRegistrations(ctx context.Context, params *GetApiV1AdminRegistrationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminRegistrationsIdApprove request with any body
+	PostApiV1AdminRegistrationsIdApproveWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminRegistrationsIdApprove(ctx context.Context, id string, body PostApiV1AdminRegistrationsIdApproveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminRegistrationsIdReject request with any body
+	PostApiV1AdminRegistrationsIdRejectWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminRegistrationsIdReject(ctx context.Context, id string, body PostApiV1AdminRegistrationsIdRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminReports request
+	GetApiV1AdminReports(ctx context.Context, params *GetApiV1AdminReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminRoutes request
+	GetApiV1AdminRoutes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminSandboxSms request
+	GetApiV1AdminSandboxSms(ctx context.Context, params *GetApiV1AdminSandboxSmsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminSearch request
+	GetApiV1AdminSearch(ctx context.Context, params *GetApiV1AdminSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminSecurityDenials request
+	GetApiV1AdminSecurityDenials(ctx context.Context, params *GetApiV1AdminSecurityDenialsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminSlo request
+	GetApiV1AdminSlo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminStaleDevices request
+	GetApiV1AdminStaleDevices(ctx context.Context, params *GetApiV1AdminStaleDevicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminUsage request
+	GetApiV1AdminUsage(ctx context.Context, params *GetApiV1AdminUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminUsers request
+	GetApiV1AdminUsers(ctx context.Context, params *GetApiV1AdminUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminUsers request with any body
+	PostApiV1AdminUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminUsers(ctx context.Context, body PostApiV1AdminUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminUsersImport request with any body
+	PostApiV1AdminUsersImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AdminUsersId request
+	DeleteApiV1AdminUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminUsersId request
+	GetApiV1AdminUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiV1AdminUsersId request with any body
+	PatchApiV1AdminUsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiV1AdminUsersId(ctx context.Context, id string, body PatchApiV1AdminUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminUsersIdHistory request
+	GetApiV1AdminUsersIdHistory(ctx context.Context, id string, params *GetApiV1AdminUsersIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminUsersIdPasskeys request
+	GetApiV1AdminUsersIdPasskeys(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminUsersIdPasskeys request with any body
+	PostApiV1AdminUsersIdPasskeysWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AdminUsersIdPasskeys(ctx context.Context, id string, body PostApiV1AdminUsersIdPasskeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminUsersIdPasskeysOptions request
+	PostApiV1AdminUsersIdPasskeysOptions(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AdminUsersIdPasskeysCredentialId request
+	DeleteApiV1AdminUsersIdPasskeysCredentialId(ctx context.Context, id string, credentialId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiV1AdminUsersIdPasskeysCredentialId request with any body
+	PatchApiV1AdminUsersIdPasskeysCredentialIdWithBody(ctx context.Context, id string, credentialId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiV1AdminUsersIdPasskeysCredentialId(ctx context.Context, id string, credentialId string, body PatchApiV1AdminUsersIdPasskeysCredentialIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminWebhookSecrets request
+	GetApiV1AdminWebhookSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AdminWebhookSecretsScopeRotate request
+	PostApiV1AdminWebhookSecretsScopeRotate(ctx context.Context, scope string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthChangePassword request with any body
+	PostApiV1AuthChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthChangePassword(ctx context.Context, body PostApiV1AuthChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AuthCheckPhone request
+	GetApiV1AuthCheckPhone(ctx context.Context, params *GetApiV1AuthCheckPhoneParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthForgotPassword request with any body
+	PostApiV1AuthForgotPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthForgotPassword(ctx context.Context, body PostApiV1AuthForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AuthLegal request
+	GetApiV1AuthLegal(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthLegalAccept request with any body
+	PostApiV1AuthLegalAcceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthLegalAccept(ctx context.Context, body PostApiV1AuthLegalAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthLogin request with any body
+	PostApiV1AuthLoginWithBody(ctx context.Context, params *PostApiV1AuthLoginParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthLogin(ctx context.Context, params *PostApiV1AuthLoginParams, body PostApiV1AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthLoginOtpConfirm request with any body
+	PostApiV1AuthLoginOtpConfirmWithBody(ctx context.Context, params *PostApiV1AuthLoginOtpConfirmParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthLoginOtpConfirm(ctx context.Context, params *PostApiV1AuthLoginOtpConfirmParams, body PostApiV1AuthLoginOtpConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthLoginOtpRequest request with any body
+	PostApiV1AuthLoginOtpRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthLoginOtpRequest(ctx context.Context, body PostApiV1AuthLoginOtpRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthLogout request
+	PostApiV1AuthLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AuthNotificationPreferences request
+	GetApiV1AuthNotificationPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1AuthNotificationPreferences request with any body
+	PutApiV1AuthNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1AuthNotificationPreferences(ctx context.Context, body PutApiV1AuthNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthRefresh request with any body
+	PostApiV1AuthRefreshWithBody(ctx context.Context, params *PostApiV1AuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthRefresh(ctx context.Context, params *PostApiV1AuthRefreshParams, body PostApiV1AuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthRegister request with any body
+	PostApiV1AuthRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthRegister(ctx context.Context, body PostApiV1AuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthResetPassword request with any body
+	PostApiV1AuthResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthResetPassword(ctx context.Context, body PostApiV1AuthResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AuthSessions request
+	DeleteApiV1AuthSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AuthSessions request
+	GetApiV1AuthSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1AuthSessionsId request
+	DeleteApiV1AuthSessionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthVerifyOtp request with any body
+	PostApiV1AuthVerifyOtpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthVerifyOtp(ctx context.Context, body PostApiV1AuthVerifyOtpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1AuthVerifyOtpResend request with any body
+	PostApiV1AuthVerifyOtpResendWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1AuthVerifyOtpResend(ctx context.Context, body PostApiV1AuthVerifyOtpResendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AvailableLocations request
+	GetApiV1AvailableLocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Contacts request
+	GetApiV1Contacts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiV1Contacts request with any body
+	PatchApiV1ContactsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiV1Contacts(ctx context.Context, body PatchApiV1ContactsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1FilesKey request
+	GetApiV1FilesKey(ctx context.Context, key string, params *GetApiV1FilesKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1GateCommandsId request
+	GetApiV1GateCommandsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1GateCommandsIdCallback request with any body
+	PostApiV1GateCommandsIdCallbackWithBody(ctx context.Context, id string, params *PostApiV1GateCommandsIdCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1GateCommandsIdCallback(ctx context.Context, id string, params *PostApiV1GateCommandsIdCallbackParams, body PostApiV1GateCommandsIdCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1GatesGateIdReport request with any body
+	PostApiV1GatesGateIdReportWithBody(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1GatesGateIdReport(ctx context.Context, gateId int, body PostApiV1GatesGateIdReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Legal request
+	GetApiV1Legal(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1LegalKind request
+	GetApiV1LegalKind(ctx context.Context, kind string, params *GetApiV1LegalKindParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1LinksId request
+	GetApiV1LinksId(ctx context.Context, id string, params *GetApiV1LinksIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Locations request
+	GetApiV1Locations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1LocationsGateIdClose request
+	PutApiV1LocationsGateIdClose(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1LocationsGateIdLinks request with any body
+	PostApiV1LocationsGateIdLinksWithBody(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1LocationsGateIdLinks(ctx context.Context, gateId int, body PostApiV1LocationsGateIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1LocationsGateIdOpen request
+	PutApiV1LocationsGateIdOpen(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1LocationsLocationIdGates request
+	GetApiV1LocationsLocationIdGates(ctx context.Context, locationId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ScimV2Users request
+	GetApiV1ScimV2Users(ctx context.Context, params *GetApiV1ScimV2UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ScimV2Users request with any body
+	PostApiV1ScimV2UsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ScimV2Users(ctx context.Context, body PostApiV1ScimV2UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1ScimV2UsersId request
+	DeleteApiV1ScimV2UsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ScimV2UsersId request
+	GetApiV1ScimV2UsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiV1ScimV2UsersId request with any body
+	PatchApiV1ScimV2UsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiV1ScimV2UsersId(ctx context.Context, id string, body PatchApiV1ScimV2UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1ScimV2UsersId request with any body
+	PutApiV1ScimV2UsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1ScimV2UsersId(ctx context.Context, id string, body PutApiV1ScimV2UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1SyncChanges request
+	GetApiV1SyncChanges(ctx context.Context, params *GetApiV1SyncChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Users request
+	GetApiV1Users(ctx context.Context, params *GetApiV1UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1Users request with any body
+	PostApiV1UsersWithBody(ctx context.Context, params *PostApiV1UsersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1Users(ctx context.Context, params *PostApiV1UsersParams, body PostApiV1UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1UsersBulkDelete request with any body
+	PostApiV1UsersBulkDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1UsersBulkDelete(ctx context.Context, body PostApiV1UsersBulkDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1UsersDuplicates request
+	GetApiV1UsersDuplicates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1UsersTrash request
+	GetApiV1UsersTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1UsersId request
+	DeleteApiV1UsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1UsersId request
+	GetApiV1UsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchApiV1UsersId request with any body
+	PatchApiV1UsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchApiV1UsersId(ctx context.Context, id string, body PatchApiV1UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1UsersIdHistory request
+	GetApiV1UsersIdHistory(ctx context.Context, id string, params *GetApiV1UsersIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1UsersIdMerge request with any body
+	PostApiV1UsersIdMergeWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1UsersIdMerge(ctx context.Context, id string, body PostApiV1UsersIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1UsersIdPhones request
+	GetApiV1UsersIdPhones(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1UsersIdPhones request with any body
+	PostApiV1UsersIdPhonesWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1UsersIdPhones(ctx context.Context, id string, body PostApiV1UsersIdPhonesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1UsersIdPhonesPhoneId request
+	DeleteApiV1UsersIdPhonesPhoneId(ctx context.Context, id string, phoneId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1UsersIdRestore request
+	PostApiV1UsersIdRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1UsersIdRetryAssignment request
+	PostApiV1UsersIdRetryAssignment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1UsersIdSessions request
+	GetApiV1UsersIdSessions(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPing request
+	GetPing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Get(ctx context.Context, params *GetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminApiKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminApiKeysRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminApiKeys(ctx context.Context, body PostApiV1AdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminApiKeysRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AdminApiKeysId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AdminApiKeysIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminAuditLogs(ctx context.Context, params *GetApiV1AdminAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminAuditLogsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminAuditLogsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminAuditLogsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminAuditLogsIdCommentsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminAuditLogsIdCommentsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminAuditLogsIdComments(ctx context.Context, id string, body PostApiV1AdminAuditLogsIdCommentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminAuditLogsIdCommentsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminConfigReload(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminConfigReloadRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminCorsOrigins(ctx context.Context, params *GetApiV1AdminCorsOriginsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminCorsOriginsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminCorsOriginsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminCorsOriginsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminCorsOrigins(ctx context.Context, body PostApiV1AdminCorsOriginsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminCorsOriginsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AdminCorsOriginsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AdminCorsOriginsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminDigestRuns(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminDigestRunsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminExportsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminExportsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminExports(ctx context.Context, body PostApiV1AdminExportsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminExportsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminExportsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminExportsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminFeed(ctx context.Context, params *GetApiV1AdminFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminFeedRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminForcedLogoutsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminForcedLogoutsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminForcedLogouts(ctx context.Context, body PostApiV1AdminForcedLogoutsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminForcedLogoutsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminForcedLogoutsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminForcedLogoutsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminGateEvents(ctx context.Context, params *GetApiV1AdminGateEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminGateEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminGateEventsExport(ctx context.Context, params *GetApiV1AdminGateEventsExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminGateEventsExportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminGateReports(ctx context.Context, params *GetApiV1AdminGateReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminGateReportsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminGateReportsIdPhoto(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminGateReportsIdPhotoRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminGateReportsIdResolveWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminGateReportsIdResolveRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminGateReportsIdResolve(ctx context.Context, id string, body PostApiV1AdminGateReportsIdResolveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminGateReportsIdResolveRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminGatesMaintenance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminGatesMaintenanceRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AdminGatesGateIdMaintenance(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AdminGatesGateIdMaintenanceRequest(c.Server, gateId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AdminGatesGateIdMaintenanceWithBody(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AdminGatesGateIdMaintenanceRequestWithBody(c.Server, gateId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AdminGatesGateIdMaintenance(ctx context.Context, gateId int, body PutApiV1AdminGatesGateIdMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AdminGatesGateIdMaintenanceRequest(c.Server, gateId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AdminGatesGateIdOpenWithBody(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AdminGatesGateIdOpenRequestWithBody(c.Server, gateId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AdminGatesGateIdOpen(ctx context.Context, gateId int, body PutApiV1AdminGatesGateIdOpenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AdminGatesGateIdOpenRequest(c.Server, gateId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminImpersonateUserIdWithBody(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminImpersonateUserIdRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminImpersonateUserId(ctx context.Context, userId string, body PostApiV1AdminImpersonateUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminImpersonateUserIdRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminInviteCodes(ctx context.Context, params *GetApiV1AdminInviteCodesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminInviteCodesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminInviteCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminInviteCodesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminInviteCodes(ctx context.Context, body PostApiV1AdminInviteCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminInviteCodesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AdminInviteCodesId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AdminInviteCodesIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminJobs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminJobsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLegalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLegalRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLegal(ctx context.Context, body PostApiV1AdminLegalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLegalRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminLocationsFreezes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminLocationsFreezesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminLocationsOverrides(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminLocationsOverridesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AdminLocationsIdFreeze(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AdminLocationsIdFreezeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLocationsIdFreezeWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLocationsIdFreezeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLocationsIdFreeze(ctx context.Context, id int, body PostApiV1AdminLocationsIdFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLocationsIdFreezeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AdminLocationsIdOverride(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AdminLocationsIdOverrideRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AdminLocationsIdOverrideWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AdminLocationsIdOverrideRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AdminLocationsIdOverride(ctx context.Context, id int, body PutApiV1AdminLocationsIdOverrideJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AdminLocationsIdOverrideRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLogin(ctx context.Context, body PostApiV1AdminLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLoginPasskeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLoginPasskeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLoginPasskey(ctx context.Context, body PostApiV1AdminLoginPasskeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLoginPasskeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLoginPasskeyOptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLoginPasskeyOptionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminLoginPasskeyOptions(ctx context.Context, body PostApiV1AdminLoginPasskeyOptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminLoginPasskeyOptionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminNotifications(ctx context.Context, params *GetApiV1AdminNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1AdminNotificationsReadAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1AdminNotificationsReadAllRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminNotificationsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminNotificationsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminNotificationsIdCommentsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminNotificationsIdCommentsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminNotificationsIdComments(ctx context.Context, id string, body PostApiV1AdminNotificationsIdCommentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminNotificationsIdCommentsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1AdminNotificationsIdRead(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1AdminNotificationsIdReadRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminProviderMigrationReport(ctx context.Context, params *GetApiV1AdminProviderMigrationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminProviderMigrationReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminProviderUsage(ctx context.Context, params *GetApiV1AdminProviderUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminProviderUsageRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminRefreshRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminRefresh(ctx context.Context, body PostApiV1AdminRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminRefreshRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminRegistrations(ctx context.Context, params *GetApiV1AdminRegistrationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminRegistrationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminRegistrationsIdApproveWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminRegistrationsIdApproveRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminRegistrationsIdApprove(ctx context.Context, id string, body PostApiV1AdminRegistrationsIdApproveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminRegistrationsIdApproveRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminRegistrationsIdRejectWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminRegistrationsIdRejectRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminRegistrationsIdReject(ctx context.Context, id string, body PostApiV1AdminRegistrationsIdRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminRegistrationsIdRejectRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminReports(ctx context.Context, params *GetApiV1AdminReportsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminReportsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminRoutes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminRoutesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminSandboxSms(ctx context.Context, params *GetApiV1AdminSandboxSmsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminSandboxSmsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminSearch(ctx context.Context, params *GetApiV1AdminSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminSearchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminSecurityDenials(ctx context.Context, params *GetApiV1AdminSecurityDenialsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminSecurityDenialsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminSlo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminSloRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminStaleDevices(ctx context.Context, params *GetApiV1AdminStaleDevicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminStaleDevicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminUsage(ctx context.Context, params *GetApiV1AdminUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminUsageRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminUsers(ctx context.Context, params *GetApiV1AdminUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminUsersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminUsers(ctx context.Context, body PostApiV1AdminUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminUsersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminUsersImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminUsersImportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AdminUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AdminUsersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminUsersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1AdminUsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1AdminUsersIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1AdminUsersId(ctx context.Context, id string, body PatchApiV1AdminUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1AdminUsersIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminUsersIdHistory(ctx context.Context, id string, params *GetApiV1AdminUsersIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminUsersIdHistoryRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminUsersIdPasskeys(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminUsersIdPasskeysRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminUsersIdPasskeysWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminUsersIdPasskeysRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminUsersIdPasskeys(ctx context.Context, id string, body PostApiV1AdminUsersIdPasskeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminUsersIdPasskeysRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminUsersIdPasskeysOptions(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminUsersIdPasskeysOptionsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AdminUsersIdPasskeysCredentialId(ctx context.Context, id string, credentialId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AdminUsersIdPasskeysCredentialIdRequest(c.Server, id, credentialId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1AdminUsersIdPasskeysCredentialIdWithBody(ctx context.Context, id string, credentialId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1AdminUsersIdPasskeysCredentialIdRequestWithBody(c.Server, id, credentialId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1AdminUsersIdPasskeysCredentialId(ctx context.Context, id string, credentialId string, body PatchApiV1AdminUsersIdPasskeysCredentialIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1AdminUsersIdPasskeysCredentialIdRequest(c.Server, id, credentialId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminWebhookSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminWebhookSecretsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AdminWebhookSecretsScopeRotate(ctx context.Context, scope string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AdminWebhookSecretsScopeRotateRequest(c.Server, scope)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthChangePasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthChangePassword(ctx context.Context, body PostApiV1AuthChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthChangePasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AuthCheckPhone(ctx context.Context, params *GetApiV1AuthCheckPhoneParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AuthCheckPhoneRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthForgotPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthForgotPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthForgotPassword(ctx context.Context, body PostApiV1AuthForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthForgotPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AuthLegal(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AuthLegalRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLegalAcceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLegalAcceptRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLegalAccept(ctx context.Context, body PostApiV1AuthLegalAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLegalAcceptRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLoginWithBody(ctx context.Context, params *PostApiV1AuthLoginParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLoginRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLogin(ctx context.Context, params *PostApiV1AuthLoginParams, body PostApiV1AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLoginRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLoginOtpConfirmWithBody(ctx context.Context, params *PostApiV1AuthLoginOtpConfirmParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLoginOtpConfirmRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLoginOtpConfirm(ctx context.Context, params *PostApiV1AuthLoginOtpConfirmParams, body PostApiV1AuthLoginOtpConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLoginOtpConfirmRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLoginOtpRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLoginOtpRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLoginOtpRequest(ctx context.Context, body PostApiV1AuthLoginOtpRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLoginOtpRequestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthLogoutRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AuthNotificationPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AuthNotificationPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AuthNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AuthNotificationPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AuthNotificationPreferences(ctx context.Context, body PutApiV1AuthNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AuthNotificationPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthRefreshWithBody(ctx context.Context, params *PostApiV1AuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthRefreshRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthRefresh(ctx context.Context, params *PostApiV1AuthRefreshParams, body PostApiV1AuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthRefreshRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthRegister(ctx context.Context, body PostApiV1AuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthResetPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthResetPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthResetPassword(ctx context.Context, body PostApiV1AuthResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthResetPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AuthSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AuthSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AuthSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AuthSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1AuthSessionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1AuthSessionsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthVerifyOtpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthVerifyOtpRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthVerifyOtp(ctx context.Context, body PostApiV1AuthVerifyOtpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthVerifyOtpRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthVerifyOtpResendWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthVerifyOtpResendRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1AuthVerifyOtpResend(ctx context.Context, body PostApiV1AuthVerifyOtpResendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1AuthVerifyOtpResendRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AvailableLocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AvailableLocationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1Contacts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ContactsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1ContactsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1ContactsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1Contacts(ctx context.Context, body PatchApiV1ContactsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1ContactsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1FilesKey(ctx context.Context, key string, params *GetApiV1FilesKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1FilesKeyRequest(c.Server, key, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1GateCommandsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1GateCommandsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1GateCommandsIdCallbackWithBody(ctx context.Context, id string, params *PostApiV1GateCommandsIdCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1GateCommandsIdCallbackRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1GateCommandsIdCallback(ctx context.Context, id string, params *PostApiV1GateCommandsIdCallbackParams, body PostApiV1GateCommandsIdCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1GateCommandsIdCallbackRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1GatesGateIdReportWithBody(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1GatesGateIdReportRequestWithBody(c.Server, gateId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1GatesGateIdReport(ctx context.Context, gateId int, body PostApiV1GatesGateIdReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1GatesGateIdReportRequest(c.Server, gateId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1Legal(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1LegalRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1LegalKind(ctx context.Context, kind string, params *GetApiV1LegalKindParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1LegalKindRequest(c.Server, kind, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1LinksId(ctx context.Context, id string, params *GetApiV1LinksIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1LinksIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1Locations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1LocationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1LocationsGateIdClose(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1LocationsGateIdCloseRequest(c.Server, gateId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1LocationsGateIdLinksWithBody(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1LocationsGateIdLinksRequestWithBody(c.Server, gateId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1LocationsGateIdLinks(ctx context.Context, gateId int, body PostApiV1LocationsGateIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1LocationsGateIdLinksRequest(c.Server, gateId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1LocationsGateIdOpen(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1LocationsGateIdOpenRequest(c.Server, gateId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1LocationsLocationIdGates(ctx context.Context, locationId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1LocationsLocationIdGatesRequest(c.Server, locationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ScimV2Users(ctx context.Context, params *GetApiV1ScimV2UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ScimV2UsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1ScimV2UsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ScimV2UsersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1ScimV2Users(ctx context.Context, body PostApiV1ScimV2UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ScimV2UsersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1ScimV2UsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1ScimV2UsersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ScimV2UsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ScimV2UsersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1ScimV2UsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1ScimV2UsersIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1ScimV2UsersId(ctx context.Context, id string, body PatchApiV1ScimV2UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1ScimV2UsersIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1ScimV2UsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ScimV2UsersIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1ScimV2UsersId(ctx context.Context, id string, body PutApiV1ScimV2UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ScimV2UsersIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1SyncChanges(ctx context.Context, params *GetApiV1SyncChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1SyncChangesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1Users(ctx context.Context, params *GetApiV1UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1UsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersWithBody(ctx context.Context, params *PostApiV1UsersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1Users(ctx context.Context, params *PostApiV1UsersParams, body PostApiV1UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersBulkDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersBulkDeleteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersBulkDelete(ctx context.Context, body PostApiV1UsersBulkDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersBulkDeleteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1UsersDuplicates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1UsersDuplicatesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1UsersTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1UsersTrashRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1UsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1UsersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1UsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1UsersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1UsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1UsersIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchApiV1UsersId(ctx context.Context, id string, body PatchApiV1UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchApiV1UsersIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1UsersIdHistory(ctx context.Context, id string, params *GetApiV1UsersIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1UsersIdHistoryRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersIdMergeWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersIdMergeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersIdMerge(ctx context.Context, id string, body PostApiV1UsersIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersIdMergeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1UsersIdPhones(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1UsersIdPhonesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersIdPhonesWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersIdPhonesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersIdPhones(ctx context.Context, id string, body PostApiV1UsersIdPhonesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersIdPhonesRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiV1UsersIdPhonesPhoneId(ctx context.Context, id string, phoneId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1UsersIdPhonesPhoneIdRequest(c.Server, id, phoneId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersIdRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersIdRestoreRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1UsersIdRetryAssignment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1UsersIdRetryAssignmentRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1UsersIdSessions(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1UsersIdSessionsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPingRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetRequest generates requests for Get
+func NewGetRequest(server string, params *GetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Authorization != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Authorization", runtime.ParamLocationHeader, *params.Authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminApiKeysRequest generates requests for GetApiV1AdminApiKeys
+func NewGetApiV1AdminApiKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminApiKeysRequest calls the generic PostApiV1AdminApiKeys builder with application/json body
+func NewPostApiV1AdminApiKeysRequest(server string, body PostApiV1AdminApiKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminApiKeysRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminApiKeysRequestWithBody generates requests for PostApiV1AdminApiKeys with any type of body
+func NewPostApiV1AdminApiKeysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1AdminApiKeysIdRequest generates requests for DeleteApiV1AdminApiKeysId
+func NewDeleteApiV1AdminApiKeysIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminAuditLogsRequest generates requests for GetApiV1AdminAuditLogs
+func NewGetApiV1AdminAuditLogsRequest(server string, params *GetApiV1AdminAuditLogsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/audit-logs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.AdminId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "admin_id", runtime.ParamLocationQuery, *params.AdminId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Action != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ResourceType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource_type", runtime.ParamLocationQuery, *params.ResourceType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminAuditLogsIdRequest generates requests for GetApiV1AdminAuditLogsId
+func NewGetApiV1AdminAuditLogsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/audit-logs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminAuditLogsIdCommentsRequest calls the generic PostApiV1AdminAuditLogsIdComments builder with application/json body
+func NewPostApiV1AdminAuditLogsIdCommentsRequest(server string, id string, body PostApiV1AdminAuditLogsIdCommentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminAuditLogsIdCommentsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminAuditLogsIdCommentsRequestWithBody generates requests for PostApiV1AdminAuditLogsIdComments with any type of body
+func NewPostApiV1AdminAuditLogsIdCommentsRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/audit-logs/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AdminConfigReloadRequest generates requests for PostApiV1AdminConfigReload
+func NewPostApiV1AdminConfigReloadRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/config/reload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminCorsOriginsRequest generates requests for GetApiV1AdminCorsOrigins
+func NewGetApiV1AdminCorsOriginsRequest(server string, params *GetApiV1AdminCorsOriginsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/cors-origins")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Scope != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scope", runtime.ParamLocationQuery, *params.Scope); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminCorsOriginsRequest calls the generic PostApiV1AdminCorsOrigins builder with application/json body
+func NewPostApiV1AdminCorsOriginsRequest(server string, body PostApiV1AdminCorsOriginsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminCorsOriginsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminCorsOriginsRequestWithBody generates requests for PostApiV1AdminCorsOrigins with any type of body
+func NewPostApiV1AdminCorsOriginsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/cors-origins")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1AdminCorsOriginsIdRequest generates requests for DeleteApiV1AdminCorsOriginsId
+func NewDeleteApiV1AdminCorsOriginsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/cors-origins/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminDigestRunsRequest generates requests for GetApiV1AdminDigestRuns
+func NewGetApiV1AdminDigestRunsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/digest/runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminExportsRequest calls the generic PostApiV1AdminExports builder with application/json body
+func NewPostApiV1AdminExportsRequest(server string, body PostApiV1AdminExportsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminExportsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminExportsRequestWithBody generates requests for PostApiV1AdminExports with any type of body
+func NewPostApiV1AdminExportsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/exports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminExportsIdRequest generates requests for GetApiV1AdminExportsId
+func NewGetApiV1AdminExportsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/exports/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminFeedRequest generates requests for GetApiV1AdminFeed
+func NewGetApiV1AdminFeedRequest(server string, params *GetApiV1AdminFeedParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Token != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminForcedLogoutsRequest calls the generic PostApiV1AdminForcedLogouts builder with application/json body
+func NewPostApiV1AdminForcedLogoutsRequest(server string, body PostApiV1AdminForcedLogoutsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminForcedLogoutsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminForcedLogoutsRequestWithBody generates requests for PostApiV1AdminForcedLogouts with any type of body
+func NewPostApiV1AdminForcedLogoutsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/forced-logouts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminForcedLogoutsIdRequest generates requests for GetApiV1AdminForcedLogoutsId
+func NewGetApiV1AdminForcedLogoutsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/forced-logouts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminGateEventsRequest generates requests for GetApiV1AdminGateEvents
+func NewGetApiV1AdminGateEventsRequest(server string, params *GetApiV1AdminGateEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gate-events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.From != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.To != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.UserId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.GateId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "gate_id", runtime.ParamLocationQuery, *params.GateId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.LocationId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "location_id", runtime.ParamLocationQuery, *params.LocationId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Result != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "result", runtime.ParamLocationQuery, *params.Result); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminGateEventsExportRequest generates requests for GetApiV1AdminGateEventsExport
+func NewGetApiV1AdminGateEventsExportRequest(server string, params *GetApiV1AdminGateEventsExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gate-events/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.From != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.To != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.LocationId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "location_id", runtime.ParamLocationQuery, *params.LocationId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.GateId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "gate_id", runtime.ParamLocationQuery, *params.GateId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.UserId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminGateReportsRequest generates requests for GetApiV1AdminGateReports
+func NewGetApiV1AdminGateReportsRequest(server string, params *GetApiV1AdminGateReportsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gate-reports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.GateId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "gate_id", runtime.ParamLocationQuery, *params.GateId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminGateReportsIdPhotoRequest generates requests for GetApiV1AdminGateReportsIdPhoto
+func NewGetApiV1AdminGateReportsIdPhotoRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gate-reports/%s/photo", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminGateReportsIdResolveRequest calls the generic PostApiV1AdminGateReportsIdResolve builder with application/json body
+func NewPostApiV1AdminGateReportsIdResolveRequest(server string, id string, body PostApiV1AdminGateReportsIdResolveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminGateReportsIdResolveRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminGateReportsIdResolveRequestWithBody generates requests for PostApiV1AdminGateReportsIdResolve with any type of body
+func NewPostApiV1AdminGateReportsIdResolveRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gate-reports/%s/resolve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminGatesMaintenanceRequest generates requests for GetApiV1AdminGatesMaintenance
+func NewGetApiV1AdminGatesMaintenanceRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gates/maintenance")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiV1AdminGatesGateIdMaintenanceRequest generates requests for DeleteApiV1AdminGatesGateIdMaintenance
+func NewDeleteApiV1AdminGatesGateIdMaintenanceRequest(server string, gateId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "gateId", runtime.ParamLocationPath, gateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gates/%s/maintenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiV1AdminGatesGateIdMaintenanceRequest calls the generic PutApiV1AdminGatesGateIdMaintenance builder with application/json body
+func NewPutApiV1AdminGatesGateIdMaintenanceRequest(server string, gateId int, body PutApiV1AdminGatesGateIdMaintenanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1AdminGatesGateIdMaintenanceRequestWithBody(server, gateId, "application/json", bodyReader)
+}
+
+// NewPutApiV1AdminGatesGateIdMaintenanceRequestWithBody generates requests for PutApiV1AdminGatesGateIdMaintenance with any type of body
+func NewPutApiV1AdminGatesGateIdMaintenanceRequestWithBody(server string, gateId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "gateId", runtime.ParamLocationPath, gateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gates/%s/maintenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutApiV1AdminGatesGateIdOpenRequest calls the generic PutApiV1AdminGatesGateIdOpen builder with application/json body
+func NewPutApiV1AdminGatesGateIdOpenRequest(server string, gateId int, body PutApiV1AdminGatesGateIdOpenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1AdminGatesGateIdOpenRequestWithBody(server, gateId, "application/json", bodyReader)
+}
+
+// NewPutApiV1AdminGatesGateIdOpenRequestWithBody generates requests for PutApiV1AdminGatesGateIdOpen with any type of body
+func NewPutApiV1AdminGatesGateIdOpenRequestWithBody(server string, gateId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "gateId", runtime.ParamLocationPath, gateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/gates/%s/open", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AdminImpersonateUserIdRequest calls the generic PostApiV1AdminImpersonateUserId builder with application/json body
+func NewPostApiV1AdminImpersonateUserIdRequest(server string, userId string, body PostApiV1AdminImpersonateUserIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminImpersonateUserIdRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminImpersonateUserIdRequestWithBody generates requests for PostApiV1AdminImpersonateUserId with any type of body
+func NewPostApiV1AdminImpersonateUserIdRequestWithBody(server string, userId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/impersonate/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminInviteCodesRequest generates requests for GetApiV1AdminInviteCodes
+func NewGetApiV1AdminInviteCodesRequest(server string, params *GetApiV1AdminInviteCodesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/invite-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Active != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminInviteCodesRequest calls the generic PostApiV1AdminInviteCodes builder with application/json body
+func NewPostApiV1AdminInviteCodesRequest(server string, body PostApiV1AdminInviteCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminInviteCodesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminInviteCodesRequestWithBody generates requests for PostApiV1AdminInviteCodes with any type of body
+func NewPostApiV1AdminInviteCodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/invite-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1AdminInviteCodesIdRequest generates requests for DeleteApiV1AdminInviteCodesId
+func NewDeleteApiV1AdminInviteCodesIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/invite-codes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminJobsRequest generates requests for GetApiV1AdminJobs
+func NewGetApiV1AdminJobsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminLegalRequest calls the generic PostApiV1AdminLegal builder with application/json body
+func NewPostApiV1AdminLegalRequest(server string, body PostApiV1AdminLegalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminLegalRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminLegalRequestWithBody generates requests for PostApiV1AdminLegal with any type of body
+func NewPostApiV1AdminLegalRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/legal")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminLocationsFreezesRequest generates requests for GetApiV1AdminLocationsFreezes
+func NewGetApiV1AdminLocationsFreezesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/locations/freezes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminLocationsOverridesRequest generates requests for GetApiV1AdminLocationsOverrides
+func NewGetApiV1AdminLocationsOverridesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/locations/overrides")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiV1AdminLocationsIdFreezeRequest generates requests for DeleteApiV1AdminLocationsIdFreeze
+func NewDeleteApiV1AdminLocationsIdFreezeRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/locations/%s/freeze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminLocationsIdFreezeRequest calls the generic PostApiV1AdminLocationsIdFreeze builder with application/json body
+func NewPostApiV1AdminLocationsIdFreezeRequest(server string, id int, body PostApiV1AdminLocationsIdFreezeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminLocationsIdFreezeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminLocationsIdFreezeRequestWithBody generates requests for PostApiV1AdminLocationsIdFreeze with any type of body
+func NewPostApiV1AdminLocationsIdFreezeRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/locations/%s/freeze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1AdminLocationsIdOverrideRequest generates requests for DeleteApiV1AdminLocationsIdOverride
+func NewDeleteApiV1AdminLocationsIdOverrideRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/locations/%s/override", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiV1AdminLocationsIdOverrideRequest calls the generic PutApiV1AdminLocationsIdOverride builder with application/json body
+func NewPutApiV1AdminLocationsIdOverrideRequest(server string, id int, body PutApiV1AdminLocationsIdOverrideJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1AdminLocationsIdOverrideRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutApiV1AdminLocationsIdOverrideRequestWithBody generates requests for PutApiV1AdminLocationsIdOverride with any type of body
+func NewPutApiV1AdminLocationsIdOverrideRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/locations/%s/override", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AdminLoginRequest calls the generic PostApiV1AdminLogin builder with application/json body
+func NewPostApiV1AdminLoginRequest(server string, body PostApiV1AdminLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminLoginRequestWithBody generates requests for PostApiV1AdminLogin with any type of body
+func NewPostApiV1AdminLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AdminLoginPasskeyRequest calls the generic PostApiV1AdminLoginPasskey builder with application/json body
+func NewPostApiV1AdminLoginPasskeyRequest(server string, body PostApiV1AdminLoginPasskeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminLoginPasskeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminLoginPasskeyRequestWithBody generates requests for PostApiV1AdminLoginPasskey with any type of body
+func NewPostApiV1AdminLoginPasskeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/login/passkey")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AdminLoginPasskeyOptionsRequest calls the generic PostApiV1AdminLoginPasskeyOptions builder with application/json body
+func NewPostApiV1AdminLoginPasskeyOptionsRequest(server string, body PostApiV1AdminLoginPasskeyOptionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminLoginPasskeyOptionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminLoginPasskeyOptionsRequestWithBody generates requests for PostApiV1AdminLoginPasskeyOptions with any type of body
+func NewPostApiV1AdminLoginPasskeyOptionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/login/passkey/options")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminNotificationsRequest generates requests for GetApiV1AdminNotifications
+func NewGetApiV1AdminNotificationsRequest(server string, params *GetApiV1AdminNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Severity != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity", runtime.ParamLocationQuery, *params.Severity); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Category != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Unread != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread", runtime.ParamLocationQuery, *params.Unread); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchApiV1AdminNotificationsReadAllRequest generates requests for PatchApiV1AdminNotificationsReadAll
+func NewPatchApiV1AdminNotificationsReadAllRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/notifications/read-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminNotificationsIdRequest generates requests for GetApiV1AdminNotificationsId
+func NewGetApiV1AdminNotificationsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/notifications/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminNotificationsIdCommentsRequest calls the generic PostApiV1AdminNotificationsIdComments builder with application/json body
+func NewPostApiV1AdminNotificationsIdCommentsRequest(server string, id string, body PostApiV1AdminNotificationsIdCommentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminNotificationsIdCommentsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminNotificationsIdCommentsRequestWithBody generates requests for PostApiV1AdminNotificationsIdComments with any type of body
+func NewPostApiV1AdminNotificationsIdCommentsRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/notifications/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPatchApiV1AdminNotificationsIdReadRequest generates requests for PatchApiV1AdminNotificationsIdRead
+func NewPatchApiV1AdminNotificationsIdReadRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminProviderMigrationReportRequest generates requests for GetApiV1AdminProviderMigrationReport
+func NewGetApiV1AdminProviderMigrationReportRequest(server string, params *GetApiV1AdminProviderMigrationReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/provider-migration/report")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SinceHours != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since_hours", runtime.ParamLocationQuery, *params.SinceHours); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminProviderUsageRequest generates requests for GetApiV1AdminProviderUsage
+func NewGetApiV1AdminProviderUsageRequest(server string, params *GetApiV1AdminProviderUsageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/provider-usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Month != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "month", runtime.ParamLocationQuery, *params.Month); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminRefreshRequest calls the generic PostApiV1AdminRefresh builder with application/json body
+func NewPostApiV1AdminRefreshRequest(server string, body PostApiV1AdminRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminRefreshRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminRefreshRequestWithBody generates requests for PostApiV1AdminRefresh with any type of body
+func NewPostApiV1AdminRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminRegistrationsRequest generates requests for GetApiV1AdminRegistrations
+func NewGetApiV1AdminRegistrationsRequest(server string, params *GetApiV1AdminRegistrationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/registrations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminRegistrationsIdApproveRequest calls the generic PostApiV1AdminRegistrationsIdApprove builder with application/json body
+func NewPostApiV1AdminRegistrationsIdApproveRequest(server string, id string, body PostApiV1AdminRegistrationsIdApproveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminRegistrationsIdApproveRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminRegistrationsIdApproveRequestWithBody generates requests for PostApiV1AdminRegistrationsIdApprove with any type of body
+func NewPostApiV1AdminRegistrationsIdApproveRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/registrations/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AdminRegistrationsIdRejectRequest calls the generic PostApiV1AdminRegistrationsIdReject builder with application/json body
+func NewPostApiV1AdminRegistrationsIdRejectRequest(server string, id string, body PostApiV1AdminRegistrationsIdRejectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminRegistrationsIdRejectRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminRegistrationsIdRejectRequestWithBody generates requests for PostApiV1AdminRegistrationsIdReject with any type of body
+func NewPostApiV1AdminRegistrationsIdRejectRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/registrations/%s/reject", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminReportsRequest generates requests for GetApiV1AdminReports
+func NewGetApiV1AdminReportsRequest(server string, params *GetApiV1AdminReportsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/reports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "report", runtime.ParamLocationQuery, params.Report); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.From != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.To != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Format != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminRoutesRequest generates requests for GetApiV1AdminRoutes
+func NewGetApiV1AdminRoutesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/routes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminSandboxSmsRequest generates requests for GetApiV1AdminSandboxSms
+func NewGetApiV1AdminSandboxSmsRequest(server string, params *GetApiV1AdminSandboxSmsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/sandbox/sms")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Phone != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "phone", runtime.ParamLocationQuery, *params.Phone); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminSearchRequest generates requests for GetApiV1AdminSearch
+func NewGetApiV1AdminSearchRequest(server string, params *GetApiV1AdminSearchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminSecurityDenialsRequest generates requests for GetApiV1AdminSecurityDenials
+func NewGetApiV1AdminSecurityDenialsRequest(server string, params *GetApiV1AdminSecurityDenialsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/security/denials")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ActorType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_type", runtime.ParamLocationQuery, *params.ActorType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ActorId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_id", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Reason != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reason", runtime.ParamLocationQuery, *params.Reason); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Route != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "route", runtime.ParamLocationQuery, *params.Route); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Ip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ip", runtime.ParamLocationQuery, *params.Ip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Since != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminSloRequest generates requests for GetApiV1AdminSlo
+func NewGetApiV1AdminSloRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/slo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminStaleDevicesRequest generates requests for GetApiV1AdminStaleDevices
+func NewGetApiV1AdminStaleDevicesRequest(server string, params *GetApiV1AdminStaleDevicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/stale-devices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.OlderThan != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "older_than", runtime.ParamLocationQuery, *params.OlderThan); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminUsageRequest generates requests for GetApiV1AdminUsage
+func NewGetApiV1AdminUsageRequest(server string, params *GetApiV1AdminUsageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Month != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "month", runtime.ParamLocationQuery, *params.Month); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminUsersRequest generates requests for GetApiV1AdminUsers
+func NewGetApiV1AdminUsersRequest(server string, params *GetApiV1AdminUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Search != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Role != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Order != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Stream != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stream", runtime.ParamLocationQuery, *params.Stream); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminUsersRequest calls the generic PostApiV1AdminUsers builder with application/json body
+func NewPostApiV1AdminUsersRequest(server string, body PostApiV1AdminUsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminUsersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminUsersRequestWithBody generates requests for PostApiV1AdminUsers with any type of body
+func NewPostApiV1AdminUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AdminUsersImportRequestWithBody generates requests for PostApiV1AdminUsersImport with any type of body
+func NewPostApiV1AdminUsersImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1AdminUsersIdRequest generates requests for DeleteApiV1AdminUsersId
+func NewDeleteApiV1AdminUsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminUsersIdRequest generates requests for GetApiV1AdminUsersId
+func NewGetApiV1AdminUsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchApiV1AdminUsersIdRequest calls the generic PatchApiV1AdminUsersId builder with application/json body
+func NewPatchApiV1AdminUsersIdRequest(server string, id string, body PatchApiV1AdminUsersIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiV1AdminUsersIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPatchApiV1AdminUsersIdRequestWithBody generates requests for PatchApiV1AdminUsersId with any type of body
+func NewPatchApiV1AdminUsersIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminUsersIdHistoryRequest generates requests for GetApiV1AdminUsersIdHistory
+func NewGetApiV1AdminUsersIdHistoryRequest(server string, id string, params *GetApiV1AdminUsersIdHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AdminUsersIdPasskeysRequest generates requests for GetApiV1AdminUsersIdPasskeys
+func NewGetApiV1AdminUsersIdPasskeysRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/passkeys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminUsersIdPasskeysRequest calls the generic PostApiV1AdminUsersIdPasskeys builder with application/json body
+func NewPostApiV1AdminUsersIdPasskeysRequest(server string, id string, body PostApiV1AdminUsersIdPasskeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AdminUsersIdPasskeysRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1AdminUsersIdPasskeysRequestWithBody generates requests for PostApiV1AdminUsersIdPasskeys with any type of body
+func NewPostApiV1AdminUsersIdPasskeysRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/passkeys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AdminUsersIdPasskeysOptionsRequest generates requests for PostApiV1AdminUsersIdPasskeysOptions
+func NewPostApiV1AdminUsersIdPasskeysOptionsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/passkeys/options", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiV1AdminUsersIdPasskeysCredentialIdRequest generates requests for DeleteApiV1AdminUsersIdPasskeysCredentialId
+func NewDeleteApiV1AdminUsersIdPasskeysCredentialIdRequest(server string, id string, credentialId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "credentialId", runtime.ParamLocationPath, credentialId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/passkeys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchApiV1AdminUsersIdPasskeysCredentialIdRequest calls the generic PatchApiV1AdminUsersIdPasskeysCredentialId builder with application/json body
+func NewPatchApiV1AdminUsersIdPasskeysCredentialIdRequest(server string, id string, credentialId string, body PatchApiV1AdminUsersIdPasskeysCredentialIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiV1AdminUsersIdPasskeysCredentialIdRequestWithBody(server, id, credentialId, "application/json", bodyReader)
+}
+
+// NewPatchApiV1AdminUsersIdPasskeysCredentialIdRequestWithBody generates requests for PatchApiV1AdminUsersIdPasskeysCredentialId with any type of body
+func NewPatchApiV1AdminUsersIdPasskeysCredentialIdRequestWithBody(server string, id string, credentialId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "credentialId", runtime.ParamLocationPath, credentialId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/users/%s/passkeys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AdminWebhookSecretsRequest generates requests for GetApiV1AdminWebhookSecrets
+func NewGetApiV1AdminWebhookSecretsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/webhook-secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AdminWebhookSecretsScopeRotateRequest generates requests for PostApiV1AdminWebhookSecretsScopeRotate
+func NewPostApiV1AdminWebhookSecretsScopeRotateRequest(server string, scope string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scope", runtime.ParamLocationPath, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/webhook-secrets/%s/rotate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AuthChangePasswordRequest calls the generic PostApiV1AuthChangePassword builder with application/json body
+func NewPostApiV1AuthChangePasswordRequest(server string, body PostApiV1AuthChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthChangePasswordRequestWithBody generates requests for PostApiV1AuthChangePassword with any type of body
+func NewPostApiV1AuthChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/change-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AuthCheckPhoneRequest generates requests for GetApiV1AuthCheckPhone
+func NewGetApiV1AuthCheckPhoneRequest(server string, params *GetApiV1AuthCheckPhoneParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/check-phone")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "phone", runtime.ParamLocationQuery, params.Phone); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AuthForgotPasswordRequest calls the generic PostApiV1AuthForgotPassword builder with application/json body
+func NewPostApiV1AuthForgotPasswordRequest(server string, body PostApiV1AuthForgotPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthForgotPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthForgotPasswordRequestWithBody generates requests for PostApiV1AuthForgotPassword with any type of body
+func NewPostApiV1AuthForgotPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/forgot-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AuthLegalRequest generates requests for GetApiV1AuthLegal
+func NewGetApiV1AuthLegalRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/legal")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AuthLegalAcceptRequest calls the generic PostApiV1AuthLegalAccept builder with application/json body
+func NewPostApiV1AuthLegalAcceptRequest(server string, body PostApiV1AuthLegalAcceptJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthLegalAcceptRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthLegalAcceptRequestWithBody generates requests for PostApiV1AuthLegalAccept with any type of body
+func NewPostApiV1AuthLegalAcceptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/legal/accept")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AuthLoginRequest calls the generic PostApiV1AuthLogin builder with application/json body
+func NewPostApiV1AuthLoginRequest(server string, params *PostApiV1AuthLoginParams, body PostApiV1AuthLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthLoginRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthLoginRequestWithBody generates requests for PostApiV1AuthLogin with any type of body
+func NewPostApiV1AuthLoginRequestWithBody(server string, params *PostApiV1AuthLoginParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.DeviceId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "device_id", runtime.ParamLocationQuery, *params.DeviceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ClientType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_type", runtime.ParamLocationQuery, *params.ClientType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AuthLoginOtpConfirmRequest calls the generic PostApiV1AuthLoginOtpConfirm builder with application/json body
+func NewPostApiV1AuthLoginOtpConfirmRequest(server string, params *PostApiV1AuthLoginOtpConfirmParams, body PostApiV1AuthLoginOtpConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthLoginOtpConfirmRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthLoginOtpConfirmRequestWithBody generates requests for PostApiV1AuthLoginOtpConfirm with any type of body
+func NewPostApiV1AuthLoginOtpConfirmRequestWithBody(server string, params *PostApiV1AuthLoginOtpConfirmParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/login-otp/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.DeviceId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "device_id", runtime.ParamLocationQuery, *params.DeviceId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ClientType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_type", runtime.ParamLocationQuery, *params.ClientType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AuthLoginOtpRequestRequest calls the generic PostApiV1AuthLoginOtpRequest builder with application/json body
+func NewPostApiV1AuthLoginOtpRequestRequest(server string, body PostApiV1AuthLoginOtpRequestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthLoginOtpRequestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthLoginOtpRequestRequestWithBody generates requests for PostApiV1AuthLoginOtpRequest with any type of body
+func NewPostApiV1AuthLoginOtpRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/login-otp/request")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AuthLogoutRequest generates requests for PostApiV1AuthLogout
+func NewPostApiV1AuthLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AuthNotificationPreferencesRequest generates requests for GetApiV1AuthNotificationPreferences
+func NewGetApiV1AuthNotificationPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/notification-preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiV1AuthNotificationPreferencesRequest calls the generic PutApiV1AuthNotificationPreferences builder with application/json body
+func NewPutApiV1AuthNotificationPreferencesRequest(server string, body PutApiV1AuthNotificationPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1AuthNotificationPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutApiV1AuthNotificationPreferencesRequestWithBody generates requests for PutApiV1AuthNotificationPreferences with any type of body
+func NewPutApiV1AuthNotificationPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/notification-preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AuthRefreshRequest calls the generic PostApiV1AuthRefresh builder with application/json body
+func NewPostApiV1AuthRefreshRequest(server string, params *PostApiV1AuthRefreshParams, body PostApiV1AuthRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthRefreshRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthRefreshRequestWithBody generates requests for PostApiV1AuthRefresh with any type of body
+func NewPostApiV1AuthRefreshRequestWithBody(server string, params *PostApiV1AuthRefreshParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.XDeviceID != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Device-ID", runtime.ParamLocationHeader, *params.XDeviceID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Device-ID", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AuthRegisterRequest calls the generic PostApiV1AuthRegister builder with application/json body
+func NewPostApiV1AuthRegisterRequest(server string, body PostApiV1AuthRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthRegisterRequestWithBody generates requests for PostApiV1AuthRegister with any type of body
+func NewPostApiV1AuthRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AuthResetPasswordRequest calls the generic PostApiV1AuthResetPassword builder with application/json body
+func NewPostApiV1AuthResetPasswordRequest(server string, body PostApiV1AuthResetPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthResetPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthResetPasswordRequestWithBody generates requests for PostApiV1AuthResetPassword with any type of body
+func NewPostApiV1AuthResetPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/reset-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1AuthSessionsRequest generates requests for DeleteApiV1AuthSessions
+func NewDeleteApiV1AuthSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1AuthSessionsRequest generates requests for GetApiV1AuthSessions
+func NewGetApiV1AuthSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiV1AuthSessionsIdRequest generates requests for DeleteApiV1AuthSessionsId
+func NewDeleteApiV1AuthSessionsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1AuthVerifyOtpRequest calls the generic PostApiV1AuthVerifyOtp builder with application/json body
+func NewPostApiV1AuthVerifyOtpRequest(server string, body PostApiV1AuthVerifyOtpJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthVerifyOtpRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthVerifyOtpRequestWithBody generates requests for PostApiV1AuthVerifyOtp with any type of body
+func NewPostApiV1AuthVerifyOtpRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/verify-otp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1AuthVerifyOtpResendRequest calls the generic PostApiV1AuthVerifyOtpResend builder with application/json body
+func NewPostApiV1AuthVerifyOtpResendRequest(server string, body PostApiV1AuthVerifyOtpResendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthVerifyOtpResendRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1AuthVerifyOtpResendRequestWithBody generates requests for PostApiV1AuthVerifyOtpResend with any type of body
+func NewPostApiV1AuthVerifyOtpResendRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/verify-otp/resend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1AvailableLocationsRequest generates requests for GetApiV1AvailableLocations
+func NewGetApiV1AvailableLocationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/available-locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ContactsRequest generates requests for GetApiV1Contacts
+func NewGetApiV1ContactsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/contacts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchApiV1ContactsRequest calls the generic PatchApiV1Contacts builder with application/json body
+func NewPatchApiV1ContactsRequest(server string, body PatchApiV1ContactsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiV1ContactsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPatchApiV1ContactsRequestWithBody generates requests for PatchApiV1Contacts with any type of body
+func NewPatchApiV1ContactsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/contacts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1FilesKeyRequest generates requests for GetApiV1FilesKey
+func NewGetApiV1FilesKeyRequest(server string, key string, params *GetApiV1FilesKeyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/files/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires", runtime.ParamLocationQuery, params.Expires); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sig", runtime.ParamLocationQuery, params.Sig); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1GateCommandsIdRequest generates requests for GetApiV1GateCommandsId
+func NewGetApiV1GateCommandsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/gate-commands/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1GateCommandsIdCallbackRequest calls the generic PostApiV1GateCommandsIdCallback builder with application/json body
+func NewPostApiV1GateCommandsIdCallbackRequest(server string, id string, params *PostApiV1GateCommandsIdCallbackParams, body PostApiV1GateCommandsIdCallbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1GateCommandsIdCallbackRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPostApiV1GateCommandsIdCallbackRequestWithBody generates requests for PostApiV1GateCommandsIdCallback with any type of body
+func NewPostApiV1GateCommandsIdCallbackRequestWithBody(server string, id string, params *PostApiV1GateCommandsIdCallbackParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/gate-commands/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Provider-Token", runtime.ParamLocationHeader, params.XProviderToken)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Provider-Token", headerParam0)
+
+	return req, nil
+}
+
+// NewPostApiV1GatesGateIdReportRequest calls the generic PostApiV1GatesGateIdReport builder with application/json body
+func NewPostApiV1GatesGateIdReportRequest(server string, gateId int, body PostApiV1GatesGateIdReportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1GatesGateIdReportRequestWithBody(server, gateId, "application/json", bodyReader)
+}
+
+// NewPostApiV1GatesGateIdReportRequestWithBody generates requests for PostApiV1GatesGateIdReport with any type of body
+func NewPostApiV1GatesGateIdReportRequestWithBody(server string, gateId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "gateId", runtime.ParamLocationPath, gateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/gates/%s/report", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1LegalRequest generates requests for GetApiV1Legal
+func NewGetApiV1LegalRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/legal")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1LegalKindRequest generates requests for GetApiV1LegalKind
+func NewGetApiV1LegalKindRequest(server string, kind string, params *GetApiV1LegalKindParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kind", runtime.ParamLocationPath, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/legal/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Version != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1LinksIdRequest generates requests for GetApiV1LinksId
+func NewGetApiV1LinksIdRequest(server string, id string, params *GetApiV1LinksIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sig", runtime.ParamLocationQuery, params.Sig); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1LocationsRequest generates requests for GetApiV1Locations
+func NewGetApiV1LocationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiV1LocationsGateIdCloseRequest generates requests for PutApiV1LocationsGateIdClose
+func NewPutApiV1LocationsGateIdCloseRequest(server string, gateId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "gateId", runtime.ParamLocationPath, gateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/locations/%s/close", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1LocationsGateIdLinksRequest calls the generic PostApiV1LocationsGateIdLinks builder with application/json body
+func NewPostApiV1LocationsGateIdLinksRequest(server string, gateId int, body PostApiV1LocationsGateIdLinksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1LocationsGateIdLinksRequestWithBody(server, gateId, "application/json", bodyReader)
+}
+
+// NewPostApiV1LocationsGateIdLinksRequestWithBody generates requests for PostApiV1LocationsGateIdLinks with any type of body
+func NewPostApiV1LocationsGateIdLinksRequestWithBody(server string, gateId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "gateId", runtime.ParamLocationPath, gateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/locations/%s/links", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutApiV1LocationsGateIdOpenRequest generates requests for PutApiV1LocationsGateIdOpen
+func NewPutApiV1LocationsGateIdOpenRequest(server string, gateId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "gateId", runtime.ParamLocationPath, gateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/locations/%s/open", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1LocationsLocationIdGatesRequest generates requests for GetApiV1LocationsLocationIdGates
+func NewGetApiV1LocationsLocationIdGatesRequest(server string, locationId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "locationId", runtime.ParamLocationPath, locationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/locations/%s/gates", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ScimV2UsersRequest generates requests for GetApiV1ScimV2Users
+func NewGetApiV1ScimV2UsersRequest(server string, params *GetApiV1ScimV2UsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/scim/v2/Users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.StartIndex != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startIndex", runtime.ParamLocationQuery, *params.StartIndex); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ScimV2UsersRequest calls the generic PostApiV1ScimV2Users builder with application/scim+json body
+func NewPostApiV1ScimV2UsersRequest(server string, body PostApiV1ScimV2UsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ScimV2UsersRequestWithBody(server, "application/scim+json", bodyReader)
+}
+
+// NewPostApiV1ScimV2UsersRequestWithBody generates requests for PostApiV1ScimV2Users with any type of body
+func NewPostApiV1ScimV2UsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/scim/v2/Users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1ScimV2UsersIdRequest generates requests for DeleteApiV1ScimV2UsersId
+func NewDeleteApiV1ScimV2UsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/scim/v2/Users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ScimV2UsersIdRequest generates requests for GetApiV1ScimV2UsersId
+func NewGetApiV1ScimV2UsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/scim/v2/Users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchApiV1ScimV2UsersIdRequest calls the generic PatchApiV1ScimV2UsersId builder with application/scim+json body
+func NewPatchApiV1ScimV2UsersIdRequest(server string, id string, body PatchApiV1ScimV2UsersIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiV1ScimV2UsersIdRequestWithBody(server, id, "application/scim+json", bodyReader)
+}
+
+// NewPatchApiV1ScimV2UsersIdRequestWithBody generates requests for PatchApiV1ScimV2UsersId with any type of body
+func NewPatchApiV1ScimV2UsersIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/scim/v2/Users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutApiV1ScimV2UsersIdRequest calls the generic PutApiV1ScimV2UsersId builder with application/scim+json body
+func NewPutApiV1ScimV2UsersIdRequest(server string, id string, body PutApiV1ScimV2UsersIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1ScimV2UsersIdRequestWithBody(server, id, "application/scim+json", bodyReader)
+}
+
+// NewPutApiV1ScimV2UsersIdRequestWithBody generates requests for PutApiV1ScimV2UsersId with any type of body
+func NewPutApiV1ScimV2UsersIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/scim/v2/Users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1SyncChangesRequest generates requests for GetApiV1SyncChanges
+func NewGetApiV1SyncChangesRequest(server string, params *GetApiV1SyncChangesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sync/changes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Since != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1UsersRequest generates requests for GetApiV1Users
+func NewGetApiV1UsersRequest(server string, params *GetApiV1UsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Search != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Order != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Stream != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stream", runtime.ParamLocationQuery, *params.Stream); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1UsersRequest calls the generic PostApiV1Users builder with application/json body
+func NewPostApiV1UsersRequest(server string, params *PostApiV1UsersParams, body PostApiV1UsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1UsersRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostApiV1UsersRequestWithBody generates requests for PostApiV1Users with any type of body
+func NewPostApiV1UsersRequestWithBody(server string, params *PostApiV1UsersParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Strict != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "strict", runtime.ParamLocationQuery, *params.Strict); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1UsersBulkDeleteRequest calls the generic PostApiV1UsersBulkDelete builder with application/json body
+func NewPostApiV1UsersBulkDeleteRequest(server string, body PostApiV1UsersBulkDeleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1UsersBulkDeleteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1UsersBulkDeleteRequestWithBody generates requests for PostApiV1UsersBulkDelete with any type of body
+func NewPostApiV1UsersBulkDeleteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/bulk-delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1UsersDuplicatesRequest generates requests for GetApiV1UsersDuplicates
+func NewGetApiV1UsersDuplicatesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/duplicates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1UsersTrashRequest generates requests for GetApiV1UsersTrash
+func NewGetApiV1UsersTrashRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiV1UsersIdRequest generates requests for DeleteApiV1UsersId
+func NewDeleteApiV1UsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1UsersIdRequest generates requests for GetApiV1UsersId
+func NewGetApiV1UsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchApiV1UsersIdRequest calls the generic PatchApiV1UsersId builder with application/json body
+func NewPatchApiV1UsersIdRequest(server string, id string, body PatchApiV1UsersIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchApiV1UsersIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPatchApiV1UsersIdRequestWithBody generates requests for PatchApiV1UsersId with any type of body
+func NewPatchApiV1UsersIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1UsersIdHistoryRequest generates requests for GetApiV1UsersIdHistory
+func NewGetApiV1UsersIdHistoryRequest(server string, id string, params *GetApiV1UsersIdHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Action != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1UsersIdMergeRequest calls the generic PostApiV1UsersIdMerge builder with application/json body
+func NewPostApiV1UsersIdMergeRequest(server string, id string, body PostApiV1UsersIdMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1UsersIdMergeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1UsersIdMergeRequestWithBody generates requests for PostApiV1UsersIdMerge with any type of body
+func NewPostApiV1UsersIdMergeRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1UsersIdPhonesRequest generates requests for GetApiV1UsersIdPhones
+func NewGetApiV1UsersIdPhonesRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s/phones", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1UsersIdPhonesRequest calls the generic PostApiV1UsersIdPhones builder with application/json body
+func NewPostApiV1UsersIdPhonesRequest(server string, id string, body PostApiV1UsersIdPhonesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1UsersIdPhonesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostApiV1UsersIdPhonesRequestWithBody generates requests for PostApiV1UsersIdPhones with any type of body
+func NewPostApiV1UsersIdPhonesRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s/phones", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1UsersIdPhonesPhoneIdRequest generates requests for DeleteApiV1UsersIdPhonesPhoneId
+func NewDeleteApiV1UsersIdPhonesPhoneIdRequest(server string, id string, phoneId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "phoneId", runtime.ParamLocationPath, phoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s/phones/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1UsersIdRestoreRequest generates requests for PostApiV1UsersIdRestore
+func NewPostApiV1UsersIdRestoreRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1UsersIdRetryAssignmentRequest generates requests for PostApiV1UsersIdRetryAssignment
+func NewPostApiV1UsersIdRetryAssignmentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s/retry-assignment", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1UsersIdSessionsRequest generates requests for GetApiV1UsersIdSessions
+func NewGetApiV1UsersIdSessionsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s/sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPingRequest generates requests for GetPing
+func NewGetPingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ping")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// Get request
+	GetWithResponse(ctx context.Context, params *GetParams, reqEditors ...RequestEditorFn) (*GetResponse, error)
+
+	// GetApiV1AdminApiKeys request
+	GetApiV1AdminApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminApiKeysResponse, error)
+
+	// PostApiV1AdminApiKeys request with any body
+	PostApiV1AdminApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminApiKeysResponse, error)
+
+	PostApiV1AdminApiKeysWithResponse(ctx context.Context, body PostApiV1AdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminApiKeysResponse, error)
+
+	// DeleteApiV1AdminApiKeysId request
+	DeleteApiV1AdminApiKeysIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminApiKeysIdResponse, error)
+
+	// GetApiV1AdminAuditLogs request
+	GetApiV1AdminAuditLogsWithResponse(ctx context.Context, params *GetApiV1AdminAuditLogsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminAuditLogsResponse, error)
+
+	// GetApiV1AdminAuditLogsId request
+	GetApiV1AdminAuditLogsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminAuditLogsIdResponse, error)
+
+	// PostApiV1AdminAuditLogsIdComments request with any body
+	PostApiV1AdminAuditLogsIdCommentsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminAuditLogsIdCommentsResponse, error)
+
+	PostApiV1AdminAuditLogsIdCommentsWithResponse(ctx context.Context, id string, body PostApiV1AdminAuditLogsIdCommentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminAuditLogsIdCommentsResponse, error)
+
+	// PostApiV1AdminConfigReload request
+	PostApiV1AdminConfigReloadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiV1AdminConfigReloadResponse, error)
+
+	// GetApiV1AdminCorsOrigins request
+	GetApiV1AdminCorsOriginsWithResponse(ctx context.Context, params *GetApiV1AdminCorsOriginsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminCorsOriginsResponse, error)
+
+	// PostApiV1AdminCorsOrigins request with any body
+	PostApiV1AdminCorsOriginsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminCorsOriginsResponse, error)
+
+	PostApiV1AdminCorsOriginsWithResponse(ctx context.Context, body PostApiV1AdminCorsOriginsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminCorsOriginsResponse, error)
+
+	// DeleteApiV1AdminCorsOriginsId request
+	DeleteApiV1AdminCorsOriginsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminCorsOriginsIdResponse, error)
+
+	// GetApiV1AdminDigestRuns request
+	GetApiV1AdminDigestRunsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminDigestRunsResponse, error)
+
+	// PostApiV1AdminExports request with any body
+	PostApiV1AdminExportsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminExportsResponse, error)
+
+	PostApiV1AdminExportsWithResponse(ctx context.Context, body PostApiV1AdminExportsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminExportsResponse, error)
+
+	// GetApiV1AdminExportsId request
+	GetApiV1AdminExportsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminExportsIdResponse, error)
+
+	// GetApiV1AdminFeed request
+	GetApiV1AdminFeedWithResponse(ctx context.Context, params *GetApiV1AdminFeedParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminFeedResponse, error)
+
+	// PostApiV1AdminForcedLogouts request with any body
+	PostApiV1AdminForcedLogoutsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminForcedLogoutsResponse, error)
+
+	PostApiV1AdminForcedLogoutsWithResponse(ctx context.Context, body PostApiV1AdminForcedLogoutsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminForcedLogoutsResponse, error)
+
+	// GetApiV1AdminForcedLogoutsId request
+	GetApiV1AdminForcedLogoutsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminForcedLogoutsIdResponse, error)
+
+	// GetApiV1AdminGateEvents request
+	GetApiV1AdminGateEventsWithResponse(ctx context.Context, params *GetApiV1AdminGateEventsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminGateEventsResponse, error)
+
+	// GetApiV1AdminGateEventsExport request
+	GetApiV1AdminGateEventsExportWithResponse(ctx context.Context, params *GetApiV1AdminGateEventsExportParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminGateEventsExportResponse, error)
+
+	// GetApiV1AdminGateReports request
+	GetApiV1AdminGateReportsWithResponse(ctx context.Context, params *GetApiV1AdminGateReportsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminGateReportsResponse, error)
+
+	// GetApiV1AdminGateReportsIdPhoto request
+	GetApiV1AdminGateReportsIdPhotoWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminGateReportsIdPhotoResponse, error)
+
+	// PostApiV1AdminGateReportsIdResolve request with any body
+	PostApiV1AdminGateReportsIdResolveWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminGateReportsIdResolveResponse, error)
+
+	PostApiV1AdminGateReportsIdResolveWithResponse(ctx context.Context, id string, body PostApiV1AdminGateReportsIdResolveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminGateReportsIdResolveResponse, error)
+
+	// GetApiV1AdminGatesMaintenance request
+	GetApiV1AdminGatesMaintenanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminGatesMaintenanceResponse, error)
+
+	// DeleteApiV1AdminGatesGateIdMaintenance request
+	DeleteApiV1AdminGatesGateIdMaintenanceWithResponse(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminGatesGateIdMaintenanceResponse, error)
+
+	// PutApiV1AdminGatesGateIdMaintenance request with any body
+	PutApiV1AdminGatesGateIdMaintenanceWithBodyWithResponse(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AdminGatesGateIdMaintenanceResponse, error)
+
+	PutApiV1AdminGatesGateIdMaintenanceWithResponse(ctx context.Context, gateId int, body PutApiV1AdminGatesGateIdMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AdminGatesGateIdMaintenanceResponse, error)
+
+	// PutApiV1AdminGatesGateIdOpen request with any body
+	PutApiV1AdminGatesGateIdOpenWithBodyWithResponse(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AdminGatesGateIdOpenResponse, error)
+
+	PutApiV1AdminGatesGateIdOpenWithResponse(ctx context.Context, gateId int, body PutApiV1AdminGatesGateIdOpenJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AdminGatesGateIdOpenResponse, error)
+
+	// PostApiV1AdminImpersonateUserId request with any body
+	PostApiV1AdminImpersonateUserIdWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminImpersonateUserIdResponse, error)
+
+	PostApiV1AdminImpersonateUserIdWithResponse(ctx context.Context, userId string, body PostApiV1AdminImpersonateUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminImpersonateUserIdResponse, error)
+
+	// GetApiV1AdminInviteCodes request
+	GetApiV1AdminInviteCodesWithResponse(ctx context.Context, params *GetApiV1AdminInviteCodesParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminInviteCodesResponse, error)
+
+	// PostApiV1AdminInviteCodes request with any body
+	PostApiV1AdminInviteCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminInviteCodesResponse, error)
+
+	PostApiV1AdminInviteCodesWithResponse(ctx context.Context, body PostApiV1AdminInviteCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminInviteCodesResponse, error)
+
+	// DeleteApiV1AdminInviteCodesId request
+	DeleteApiV1AdminInviteCodesIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminInviteCodesIdResponse, error)
+
+	// GetApiV1AdminJobs request
+	GetApiV1AdminJobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminJobsResponse, error)
+
+	// PostApiV1AdminLegal request with any body
+	PostApiV1AdminLegalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLegalResponse, error)
+
+	PostApiV1AdminLegalWithResponse(ctx context.Context, body PostApiV1AdminLegalJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLegalResponse, error)
+
+	// GetApiV1AdminLocationsFreezes request
+	GetApiV1AdminLocationsFreezesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminLocationsFreezesResponse, error)
+
+	// GetApiV1AdminLocationsOverrides request
+	GetApiV1AdminLocationsOverridesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminLocationsOverridesResponse, error)
+
+	// DeleteApiV1AdminLocationsIdFreeze request
+	DeleteApiV1AdminLocationsIdFreezeWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminLocationsIdFreezeResponse, error)
+
+	// PostApiV1AdminLocationsIdFreeze request with any body
+	PostApiV1AdminLocationsIdFreezeWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLocationsIdFreezeResponse, error)
+
+	PostApiV1AdminLocationsIdFreezeWithResponse(ctx context.Context, id int, body PostApiV1AdminLocationsIdFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLocationsIdFreezeResponse, error)
+
+	// DeleteApiV1AdminLocationsIdOverride request
+	DeleteApiV1AdminLocationsIdOverrideWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminLocationsIdOverrideResponse, error)
+
+	// PutApiV1AdminLocationsIdOverride request with any body
+	PutApiV1AdminLocationsIdOverrideWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AdminLocationsIdOverrideResponse, error)
+
+	PutApiV1AdminLocationsIdOverrideWithResponse(ctx context.Context, id int, body PutApiV1AdminLocationsIdOverrideJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AdminLocationsIdOverrideResponse, error)
+
+	// PostApiV1AdminLogin request with any body
+	PostApiV1AdminLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginResponse, error)
+
+	PostApiV1AdminLoginWithResponse(ctx context.Context, body PostApiV1AdminLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginResponse, error)
+
+	// PostApiV1AdminLoginPasskey request with any body
+	PostApiV1AdminLoginPasskeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginPasskeyResponse, error)
+
+	PostApiV1AdminLoginPasskeyWithResponse(ctx context.Context, body PostApiV1AdminLoginPasskeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginPasskeyResponse, error)
+
+	// PostApiV1AdminLoginPasskeyOptions request with any body
+	PostApiV1AdminLoginPasskeyOptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginPasskeyOptionsResponse, error)
+
+	PostApiV1AdminLoginPasskeyOptionsWithResponse(ctx context.Context, body PostApiV1AdminLoginPasskeyOptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginPasskeyOptionsResponse, error)
+
+	// GetApiV1AdminNotifications request
+	GetApiV1AdminNotificationsWithResponse(ctx context.Context, params *GetApiV1AdminNotificationsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminNotificationsResponse, error)
+
+	// PatchApiV1AdminNotificationsReadAll request
+	PatchApiV1AdminNotificationsReadAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PatchApiV1AdminNotificationsReadAllResponse, error)
+
+	// GetApiV1AdminNotificationsId request
+	GetApiV1AdminNotificationsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminNotificationsIdResponse, error)
+
+	// PostApiV1AdminNotificationsIdComments request with any body
+	PostApiV1AdminNotificationsIdCommentsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminNotificationsIdCommentsResponse, error)
+
+	PostApiV1AdminNotificationsIdCommentsWithResponse(ctx context.Context, id string, body PostApiV1AdminNotificationsIdCommentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminNotificationsIdCommentsResponse, error)
+
+	// PatchApiV1AdminNotificationsIdRead request
+	PatchApiV1AdminNotificationsIdReadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PatchApiV1AdminNotificationsIdReadResponse, error)
+
+	// GetApiV1AdminProviderMigrationReport request
+	GetApiV1AdminProviderMigrationReportWithResponse(ctx context.Context, params *GetApiV1AdminProviderMigrationReportParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminProviderMigrationReportResponse, error)
+
+	// GetApiV1AdminProviderUsage request
+	GetApiV1AdminProviderUsageWithResponse(ctx context.Context, params *GetApiV1AdminProviderUsageParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminProviderUsageResponse, error)
+
+	// PostApiV1AdminRefresh request with any body
+	PostApiV1AdminRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminRefreshResponse, error)
+
+	PostApiV1AdminRefreshWithResponse(ctx context.Context, body PostApiV1AdminRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminRefreshResponse, error)
+
+	// GetApiV1AdminRegistrations request
+	GetApiV1AdminRegistrationsWithResponse(ctx context.Context, params *GetApiV1AdminRegistrationsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminRegistrationsResponse, error)
+
+	// PostApiV1AdminRegistrationsIdApprove request with any body
+	PostApiV1AdminRegistrationsIdApproveWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminRegistrationsIdApproveResponse, error)
+
+	PostApiV1AdminRegistrationsIdApproveWithResponse(ctx context.Context, id string, body PostApiV1AdminRegistrationsIdApproveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminRegistrationsIdApproveResponse, error)
+
+	// PostApiV1AdminRegistrationsIdReject request with any body
+	PostApiV1AdminRegistrationsIdRejectWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminRegistrationsIdRejectResponse, error)
+
+	PostApiV1AdminRegistrationsIdRejectWithResponse(ctx context.Context, id string, body PostApiV1AdminRegistrationsIdRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminRegistrationsIdRejectResponse, error)
+
+	// GetApiV1AdminReports request
+	GetApiV1AdminReportsWithResponse(ctx context.Context, params *GetApiV1AdminReportsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminReportsResponse, error)
+
+	// GetApiV1AdminRoutes request
+	GetApiV1AdminRoutesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminRoutesResponse, error)
+
+	// GetApiV1AdminSandboxSms request
+	GetApiV1AdminSandboxSmsWithResponse(ctx context.Context, params *GetApiV1AdminSandboxSmsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminSandboxSmsResponse, error)
+
+	// GetApiV1AdminSearch request
+	GetApiV1AdminSearchWithResponse(ctx context.Context, params *GetApiV1AdminSearchParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminSearchResponse, error)
+
+	// GetApiV1AdminSecurityDenials request
+	GetApiV1AdminSecurityDenialsWithResponse(ctx context.Context, params *GetApiV1AdminSecurityDenialsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminSecurityDenialsResponse, error)
+
+	// GetApiV1AdminSlo request
+	GetApiV1AdminSloWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminSloResponse, error)
+
+	// GetApiV1AdminStaleDevices request
+	GetApiV1AdminStaleDevicesWithResponse(ctx context.Context, params *GetApiV1AdminStaleDevicesParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminStaleDevicesResponse, error)
+
+	// GetApiV1AdminUsage request
+	GetApiV1AdminUsageWithResponse(ctx context.Context, params *GetApiV1AdminUsageParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsageResponse, error)
+
+	// GetApiV1AdminUsers request
+	GetApiV1AdminUsersWithResponse(ctx context.Context, params *GetApiV1AdminUsersParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsersResponse, error)
+
+	// PostApiV1AdminUsers request with any body
+	PostApiV1AdminUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersResponse, error)
+
+	PostApiV1AdminUsersWithResponse(ctx context.Context, body PostApiV1AdminUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersResponse, error)
+
+	// PostApiV1AdminUsersImport request with any body
+	PostApiV1AdminUsersImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersImportResponse, error)
+
+	// DeleteApiV1AdminUsersId request
+	DeleteApiV1AdminUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminUsersIdResponse, error)
+
+	// GetApiV1AdminUsersId request
+	GetApiV1AdminUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsersIdResponse, error)
+
+	// PatchApiV1AdminUsersId request with any body
+	PatchApiV1AdminUsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1AdminUsersIdResponse, error)
+
+	PatchApiV1AdminUsersIdWithResponse(ctx context.Context, id string, body PatchApiV1AdminUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1AdminUsersIdResponse, error)
+
+	// GetApiV1AdminUsersIdHistory request
+	GetApiV1AdminUsersIdHistoryWithResponse(ctx context.Context, id string, params *GetApiV1AdminUsersIdHistoryParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsersIdHistoryResponse, error)
+
+	// GetApiV1AdminUsersIdPasskeys request
+	GetApiV1AdminUsersIdPasskeysWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsersIdPasskeysResponse, error)
+
+	// PostApiV1AdminUsersIdPasskeys request with any body
+	PostApiV1AdminUsersIdPasskeysWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersIdPasskeysResponse, error)
+
+	PostApiV1AdminUsersIdPasskeysWithResponse(ctx context.Context, id string, body PostApiV1AdminUsersIdPasskeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersIdPasskeysResponse, error)
+
+	// PostApiV1AdminUsersIdPasskeysOptions request
+	PostApiV1AdminUsersIdPasskeysOptionsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersIdPasskeysOptionsResponse, error)
+
+	// DeleteApiV1AdminUsersIdPasskeysCredentialId request
+	DeleteApiV1AdminUsersIdPasskeysCredentialIdWithResponse(ctx context.Context, id string, credentialId string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminUsersIdPasskeysCredentialIdResponse, error)
+
+	// PatchApiV1AdminUsersIdPasskeysCredentialId request with any body
+	PatchApiV1AdminUsersIdPasskeysCredentialIdWithBodyWithResponse(ctx context.Context, id string, credentialId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1AdminUsersIdPasskeysCredentialIdResponse, error)
+
+	PatchApiV1AdminUsersIdPasskeysCredentialIdWithResponse(ctx context.Context, id string, credentialId string, body PatchApiV1AdminUsersIdPasskeysCredentialIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1AdminUsersIdPasskeysCredentialIdResponse, error)
+
+	// GetApiV1AdminWebhookSecrets request
+	GetApiV1AdminWebhookSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminWebhookSecretsResponse, error)
+
+	// PostApiV1AdminWebhookSecretsScopeRotate request
+	PostApiV1AdminWebhookSecretsScopeRotateWithResponse(ctx context.Context, scope string, reqEditors ...RequestEditorFn) (*PostApiV1AdminWebhookSecretsScopeRotateResponse, error)
+
+	// PostApiV1AuthChangePassword request with any body
+	PostApiV1AuthChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthChangePasswordResponse, error)
+
+	PostApiV1AuthChangePasswordWithResponse(ctx context.Context, body PostApiV1AuthChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthChangePasswordResponse, error)
+
+	// GetApiV1AuthCheckPhone request
+	GetApiV1AuthCheckPhoneWithResponse(ctx context.Context, params *GetApiV1AuthCheckPhoneParams, reqEditors ...RequestEditorFn) (*GetApiV1AuthCheckPhoneResponse, error)
+
+	// PostApiV1AuthForgotPassword request with any body
+	PostApiV1AuthForgotPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthForgotPasswordResponse, error)
+
+	PostApiV1AuthForgotPasswordWithResponse(ctx context.Context, body PostApiV1AuthForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthForgotPasswordResponse, error)
+
+	// GetApiV1AuthLegal request
+	GetApiV1AuthLegalWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthLegalResponse, error)
+
+	// PostApiV1AuthLegalAccept request with any body
+	PostApiV1AuthLegalAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthLegalAcceptResponse, error)
+
+	PostApiV1AuthLegalAcceptWithResponse(ctx context.Context, body PostApiV1AuthLegalAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthLegalAcceptResponse, error)
+
+	// PostApiV1AuthLogin request with any body
+	PostApiV1AuthLoginWithBodyWithResponse(ctx context.Context, params *PostApiV1AuthLoginParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginResponse, error)
+
+	PostApiV1AuthLoginWithResponse(ctx context.Context, params *PostApiV1AuthLoginParams, body PostApiV1AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginResponse, error)
+
+	// PostApiV1AuthLoginOtpConfirm request with any body
+	PostApiV1AuthLoginOtpConfirmWithBodyWithResponse(ctx context.Context, params *PostApiV1AuthLoginOtpConfirmParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginOtpConfirmResponse, error)
+
+	PostApiV1AuthLoginOtpConfirmWithResponse(ctx context.Context, params *PostApiV1AuthLoginOtpConfirmParams, body PostApiV1AuthLoginOtpConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginOtpConfirmResponse, error)
+
+	// PostApiV1AuthLoginOtpRequest request with any body
+	PostApiV1AuthLoginOtpRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginOtpRequestResponse, error)
+
+	PostApiV1AuthLoginOtpRequestWithResponse(ctx context.Context, body PostApiV1AuthLoginOtpRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginOtpRequestResponse, error)
+
+	// PostApiV1AuthLogout request
+	PostApiV1AuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiV1AuthLogoutResponse, error)
+
+	// GetApiV1AuthNotificationPreferences request
+	GetApiV1AuthNotificationPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthNotificationPreferencesResponse, error)
+
+	// PutApiV1AuthNotificationPreferences request with any body
+	PutApiV1AuthNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AuthNotificationPreferencesResponse, error)
+
+	PutApiV1AuthNotificationPreferencesWithResponse(ctx context.Context, body PutApiV1AuthNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AuthNotificationPreferencesResponse, error)
+
+	// PostApiV1AuthRefresh request with any body
+	PostApiV1AuthRefreshWithBodyWithResponse(ctx context.Context, params *PostApiV1AuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthRefreshResponse, error)
+
+	PostApiV1AuthRefreshWithResponse(ctx context.Context, params *PostApiV1AuthRefreshParams, body PostApiV1AuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthRefreshResponse, error)
+
+	// PostApiV1AuthRegister request with any body
+	PostApiV1AuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthRegisterResponse, error)
+
+	PostApiV1AuthRegisterWithResponse(ctx context.Context, body PostApiV1AuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthRegisterResponse, error)
+
+	// PostApiV1AuthResetPassword request with any body
+	PostApiV1AuthResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthResetPasswordResponse, error)
+
+	PostApiV1AuthResetPasswordWithResponse(ctx context.Context, body PostApiV1AuthResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthResetPasswordResponse, error)
+
+	// DeleteApiV1AuthSessions request
+	DeleteApiV1AuthSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiV1AuthSessionsResponse, error)
+
+	// GetApiV1AuthSessions request
+	GetApiV1AuthSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthSessionsResponse, error)
+
+	// DeleteApiV1AuthSessionsId request
+	DeleteApiV1AuthSessionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AuthSessionsIdResponse, error)
+
+	// PostApiV1AuthVerifyOtp request with any body
+	PostApiV1AuthVerifyOtpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthVerifyOtpResponse, error)
+
+	PostApiV1AuthVerifyOtpWithResponse(ctx context.Context, body PostApiV1AuthVerifyOtpJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthVerifyOtpResponse, error)
+
+	// PostApiV1AuthVerifyOtpResend request with any body
+	PostApiV1AuthVerifyOtpResendWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthVerifyOtpResendResponse, error)
+
+	PostApiV1AuthVerifyOtpResendWithResponse(ctx context.Context, body PostApiV1AuthVerifyOtpResendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthVerifyOtpResendResponse, error)
+
+	// GetApiV1AvailableLocations request
+	GetApiV1AvailableLocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AvailableLocationsResponse, error)
+
+	// GetApiV1Contacts request
+	GetApiV1ContactsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ContactsResponse, error)
+
+	// PatchApiV1Contacts request with any body
+	PatchApiV1ContactsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1ContactsResponse, error)
+
+	PatchApiV1ContactsWithResponse(ctx context.Context, body PatchApiV1ContactsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1ContactsResponse, error)
+
+	// GetApiV1FilesKey request
+	GetApiV1FilesKeyWithResponse(ctx context.Context, key string, params *GetApiV1FilesKeyParams, reqEditors ...RequestEditorFn) (*GetApiV1FilesKeyResponse, error)
+
+	// GetApiV1GateCommandsId request
+	GetApiV1GateCommandsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1GateCommandsIdResponse, error)
+
+	// PostApiV1GateCommandsIdCallback request with any body
+	PostApiV1GateCommandsIdCallbackWithBodyWithResponse(ctx context.Context, id string, params *PostApiV1GateCommandsIdCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1GateCommandsIdCallbackResponse, error)
+
+	PostApiV1GateCommandsIdCallbackWithResponse(ctx context.Context, id string, params *PostApiV1GateCommandsIdCallbackParams, body PostApiV1GateCommandsIdCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1GateCommandsIdCallbackResponse, error)
+
+	// PostApiV1GatesGateIdReport request with any body
+	PostApiV1GatesGateIdReportWithBodyWithResponse(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1GatesGateIdReportResponse, error)
+
+	PostApiV1GatesGateIdReportWithResponse(ctx context.Context, gateId int, body PostApiV1GatesGateIdReportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1GatesGateIdReportResponse, error)
+
+	// GetApiV1Legal request
+	GetApiV1LegalWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1LegalResponse, error)
+
+	// GetApiV1LegalKind request
+	GetApiV1LegalKindWithResponse(ctx context.Context, kind string, params *GetApiV1LegalKindParams, reqEditors ...RequestEditorFn) (*GetApiV1LegalKindResponse, error)
+
+	// GetApiV1LinksId request
+	GetApiV1LinksIdWithResponse(ctx context.Context, id string, params *GetApiV1LinksIdParams, reqEditors ...RequestEditorFn) (*GetApiV1LinksIdResponse, error)
+
+	// GetApiV1Locations request
+	GetApiV1LocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1LocationsResponse, error)
+
+	// PutApiV1LocationsGateIdClose request
+	PutApiV1LocationsGateIdCloseWithResponse(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*PutApiV1LocationsGateIdCloseResponse, error)
+
+	// PostApiV1LocationsGateIdLinks request with any body
+	PostApiV1LocationsGateIdLinksWithBodyWithResponse(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1LocationsGateIdLinksResponse, error)
+
+	PostApiV1LocationsGateIdLinksWithResponse(ctx context.Context, gateId int, body PostApiV1LocationsGateIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1LocationsGateIdLinksResponse, error)
+
+	// PutApiV1LocationsGateIdOpen request
+	PutApiV1LocationsGateIdOpenWithResponse(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*PutApiV1LocationsGateIdOpenResponse, error)
+
+	// GetApiV1LocationsLocationIdGates request
+	GetApiV1LocationsLocationIdGatesWithResponse(ctx context.Context, locationId int, reqEditors ...RequestEditorFn) (*GetApiV1LocationsLocationIdGatesResponse, error)
+
+	// GetApiV1ScimV2Users request
+	GetApiV1ScimV2UsersWithResponse(ctx context.Context, params *GetApiV1ScimV2UsersParams, reqEditors ...RequestEditorFn) (*GetApiV1ScimV2UsersResponse, error)
+
+	// PostApiV1ScimV2Users request with any body
+	PostApiV1ScimV2UsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ScimV2UsersResponse, error)
+
+	PostApiV1ScimV2UsersWithResponse(ctx context.Context, body PostApiV1ScimV2UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ScimV2UsersResponse, error)
+
+	// DeleteApiV1ScimV2UsersId request
+	DeleteApiV1ScimV2UsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1ScimV2UsersIdResponse, error)
+
+	// GetApiV1ScimV2UsersId request
+	GetApiV1ScimV2UsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1ScimV2UsersIdResponse, error)
+
+	// PatchApiV1ScimV2UsersId request with any body
+	PatchApiV1ScimV2UsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1ScimV2UsersIdResponse, error)
+
+	PatchApiV1ScimV2UsersIdWithResponse(ctx context.Context, id string, body PatchApiV1ScimV2UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1ScimV2UsersIdResponse, error)
+
+	// PutApiV1ScimV2UsersId request with any body
+	PutApiV1ScimV2UsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ScimV2UsersIdResponse, error)
+
+	PutApiV1ScimV2UsersIdWithResponse(ctx context.Context, id string, body PutApiV1ScimV2UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ScimV2UsersIdResponse, error)
+
+	// GetApiV1SyncChanges request
+	GetApiV1SyncChangesWithResponse(ctx context.Context, params *GetApiV1SyncChangesParams, reqEditors ...RequestEditorFn) (*GetApiV1SyncChangesResponse, error)
+
+	// GetApiV1Users request
+	GetApiV1UsersWithResponse(ctx context.Context, params *GetApiV1UsersParams, reqEditors ...RequestEditorFn) (*GetApiV1UsersResponse, error)
+
+	// PostApiV1Users request with any body
+	PostApiV1UsersWithBodyWithResponse(ctx context.Context, params *PostApiV1UsersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1UsersResponse, error)
+
+	PostApiV1UsersWithResponse(ctx context.Context, params *PostApiV1UsersParams, body PostApiV1UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1UsersResponse, error)
+
+	// PostApiV1UsersBulkDelete request with any body
+	PostApiV1UsersBulkDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1UsersBulkDeleteResponse, error)
+
+	PostApiV1UsersBulkDeleteWithResponse(ctx context.Context, body PostApiV1UsersBulkDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1UsersBulkDeleteResponse, error)
+
+	// GetApiV1UsersDuplicates request
+	GetApiV1UsersDuplicatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1UsersDuplicatesResponse, error)
+
+	// GetApiV1UsersTrash request
+	GetApiV1UsersTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1UsersTrashResponse, error)
+
+	// DeleteApiV1UsersId request
+	DeleteApiV1UsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1UsersIdResponse, error)
+
+	// GetApiV1UsersId request
+	GetApiV1UsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1UsersIdResponse, error)
+
+	// PatchApiV1UsersId request with any body
+	PatchApiV1UsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1UsersIdResponse, error)
+
+	PatchApiV1UsersIdWithResponse(ctx context.Context, id string, body PatchApiV1UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1UsersIdResponse, error)
+
+	// GetApiV1UsersIdHistory request
+	GetApiV1UsersIdHistoryWithResponse(ctx context.Context, id string, params *GetApiV1UsersIdHistoryParams, reqEditors ...RequestEditorFn) (*GetApiV1UsersIdHistoryResponse, error)
+
+	// PostApiV1UsersIdMerge request with any body
+	PostApiV1UsersIdMergeWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdMergeResponse, error)
+
+	PostApiV1UsersIdMergeWithResponse(ctx context.Context, id string, body PostApiV1UsersIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdMergeResponse, error)
+
+	// GetApiV1UsersIdPhones request
+	GetApiV1UsersIdPhonesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1UsersIdPhonesResponse, error)
+
+	// PostApiV1UsersIdPhones request with any body
+	PostApiV1UsersIdPhonesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdPhonesResponse, error)
+
+	PostApiV1UsersIdPhonesWithResponse(ctx context.Context, id string, body PostApiV1UsersIdPhonesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdPhonesResponse, error)
+
+	// DeleteApiV1UsersIdPhonesPhoneId request
+	DeleteApiV1UsersIdPhonesPhoneIdWithResponse(ctx context.Context, id string, phoneId string, reqEditors ...RequestEditorFn) (*DeleteApiV1UsersIdPhonesPhoneIdResponse, error)
+
+	// PostApiV1UsersIdRestore request
+	PostApiV1UsersIdRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdRestoreResponse, error)
+
+	// PostApiV1UsersIdRetryAssignment request
+	PostApiV1UsersIdRetryAssignmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdRetryAssignmentResponse, error)
+
+	// GetApiV1UsersIdSessions request
+	GetApiV1UsersIdSessionsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1UsersIdSessionsResponse, error)
+
+	// GetPing request
+	GetPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPingResponse, error)
+}
+
+type GetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersHealthCheckResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIKeysResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersCreatedAPIKeyResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AdminApiKeysIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIKeyResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AdminApiKeysIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AdminApiKeysIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminAuditLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersPaginatedAuditLogResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminAuditLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminAuditLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminAuditLogsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAuditLogDetailResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminAuditLogsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminAuditLogsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminAuditLogsIdCommentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersAuditCommentResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminAuditLogsIdCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminAuditLogsIdCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminConfigReloadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersConfigReloadResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON422      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminConfigReloadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminConfigReloadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminCorsOriginsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersCORSOriginsResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminCorsOriginsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminCorsOriginsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminCorsOriginsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersCORSOriginResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminCorsOriginsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminCorsOriginsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AdminCorsOriginsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AdminCorsOriginsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AdminCorsOriginsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminDigestRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersDigestRunsResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminDigestRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminDigestRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminExportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *HandlersExportResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON429      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminExportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminExportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminExportsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersExportResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminExportsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminExportsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *HandlersAPIResponse
+	JSON426      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminForcedLogoutsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *HandlersForcedLogoutResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminForcedLogoutsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminForcedLogoutsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminForcedLogoutsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersForcedLogoutResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminForcedLogoutsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminForcedLogoutsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminGateEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateEventLogsResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminGateEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminGateEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminGateEventsExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminGateEventsExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminGateEventsExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminGateReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateReportsResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminGateReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminGateReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminGateReportsIdPhotoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON302      *string
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminGateReportsIdPhotoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminGateReportsIdPhotoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminGateReportsIdResolveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateReportResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminGateReportsIdResolveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminGateReportsIdResolveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminGatesMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateMaintenancesResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminGatesMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminGatesMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AdminGatesGateIdMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AdminGatesGateIdMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AdminGatesGateIdMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1AdminGatesGateIdMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateMaintenanceResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1AdminGatesGateIdMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1AdminGatesGateIdMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1AdminGatesGateIdOpenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateActionResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersGateActionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1AdminGatesGateIdOpenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1AdminGatesGateIdOpenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminImpersonateUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersImpersonationResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminImpersonateUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminImpersonateUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminInviteCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersInviteCodesResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminInviteCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminInviteCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminInviteCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersInviteCodeResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminInviteCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminInviteCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AdminInviteCodesIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersInviteCodeResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AdminInviteCodesIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AdminInviteCodesIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersScheduledJobsResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminLegalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersLegalDocumentResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminLegalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminLegalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminLocationsFreezesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLocationFreezesResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminLocationsFreezesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminLocationsFreezesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminLocationsOverridesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLocationOverridesResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminLocationsOverridesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminLocationsOverridesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AdminLocationsIdFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLocationFreezesResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AdminLocationsIdFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AdminLocationsIdFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminLocationsIdFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersLocationFreezeResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminLocationsIdFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminLocationsIdFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AdminLocationsIdOverrideResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AdminLocationsIdOverrideResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AdminLocationsIdOverrideResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1AdminLocationsIdOverrideResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLocationOverrideResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1AdminLocationsIdOverrideResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1AdminLocationsIdOverrideResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminLoginResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON429      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminLoginPasskeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminLoginResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminLoginPasskeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminLoginPasskeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminLoginPasskeyOptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersPasskeyRequestOptionsResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminLoginPasskeyOptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminLoginPasskeyOptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminNotificationsResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiV1AdminNotificationsReadAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiV1AdminNotificationsReadAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiV1AdminNotificationsReadAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminNotificationsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminNotificationDetailResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminNotificationsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminNotificationsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminNotificationsIdCommentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersAuditCommentResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminNotificationsIdCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminNotificationsIdCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiV1AdminNotificationsIdReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminNotificationResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiV1AdminNotificationsIdReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiV1AdminNotificationsIdReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminProviderMigrationReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersProviderMigrationReportResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminProviderMigrationReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminProviderMigrationReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminProviderUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersProviderUsageResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminProviderUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminProviderUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersRefreshResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminRegistrationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersRegistrationsResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminRegistrationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminRegistrationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminRegistrationsIdApproveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersRegistrationResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminRegistrationsIdApproveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminRegistrationsIdApproveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminRegistrationsIdRejectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersRegistrationResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminRegistrationsIdRejectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminRegistrationsIdRejectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminReportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminReportResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminReportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminReportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminRoutesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersRegisteredRoutesResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminRoutesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminRoutesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminSandboxSmsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersSandboxSMSResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminSandboxSmsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminSandboxSmsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminSearchResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminSecurityDenialsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersSecurityDenialsResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminSecurityDenialsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminSecurityDenialsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminSloResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersSLOSummaryResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminSloResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminSloResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminStaleDevicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersStaleDeviceReportResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminStaleDevicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminStaleDevicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUsageRollupResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminsListResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersAdminResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminUsersImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersAdminImportResponse
+	JSON400      *HandlersAdminImportErrorResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminUsersImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminUsersImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AdminUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AdminUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AdminUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminDetailResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiV1AdminUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiV1AdminUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiV1AdminUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminUsersIdHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminHistoryResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminUsersIdHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminUsersIdHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminUsersIdPasskeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminPasskeysResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminUsersIdPasskeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminUsersIdPasskeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminUsersIdPasskeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersAdminPasskeyResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminUsersIdPasskeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminUsersIdPasskeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminUsersIdPasskeysOptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersPasskeyCreationOptionsResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminUsersIdPasskeysOptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminUsersIdPasskeysOptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AdminUsersIdPasskeysCredentialIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminPasskeyResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AdminUsersIdPasskeysCredentialIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AdminUsersIdPasskeysCredentialIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiV1AdminUsersIdPasskeysCredentialIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAdminPasskeyResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiV1AdminUsersIdPasskeysCredentialIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiV1AdminUsersIdPasskeysCredentialIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminWebhookSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersWebhookSecretsResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminWebhookSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminWebhookSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AdminWebhookSecretsScopeRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersRotatedWebhookSecretResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AdminWebhookSecretsScopeRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AdminWebhookSecretsScopeRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AuthCheckPhoneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersPhoneAvailabilityResponse
+	JSON400      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AuthCheckPhoneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AuthCheckPhoneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthForgotPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON429      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthForgotPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthForgotPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AuthLegalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLegalStatusResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AuthLegalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AuthLegalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthLegalAcceptResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLegalStatusResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthLegalAcceptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthLegalAcceptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLoginResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersPasswordExpiredResponse
+	JSON429      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthLoginOtpConfirmResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLoginResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthLoginOtpConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthLoginOtpConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthLoginOtpRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON429      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthLoginOtpRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthLoginOtpRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AuthNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersNotificationPreferencesResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AuthNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AuthNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1AuthNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersNotificationPreferencesResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1AuthNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1AuthNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersRefreshResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersRegisterResponse
+	JSON400      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON429      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthResetPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthResetPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthResetPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AuthSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AuthSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AuthSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AuthSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUserSessionsResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AuthSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AuthSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1AuthSessionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1AuthSessionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1AuthSessionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthVerifyOtpResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersRegisterResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthVerifyOtpResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthVerifyOtpResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthVerifyOtpResendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON429      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthVerifyOtpResendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthVerifyOtpResendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AvailableLocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAvailableLocationsResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AvailableLocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AvailableLocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ContactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersContactResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ContactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ContactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiV1ContactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersContactResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiV1ContactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiV1ContactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1FilesKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1FilesKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1FilesKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1GateCommandsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateCommandResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1GateCommandsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1GateCommandsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1GateCommandsIdCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateCommandResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1GateCommandsIdCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1GateCommandsIdCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1GatesGateIdReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersGateReportResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersGateReportResponse
+	JSON413      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1GatesGateIdReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1GatesGateIdReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1LegalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLegalDocumentsResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1LegalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1LegalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1LegalKindResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLegalDocumentResponse
+	JSON400      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1LegalKindResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1LegalKindResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1LinksIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateLinkMetadataResponse
+	JSON400      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON410      *HandlersAPIResponse
+	JSON429      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1LinksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1LinksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1LocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersLocationsListResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1LocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1LocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1LocationsGateIdCloseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateActionResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON423      *HandlersGateMaintenanceRefusedResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersGateActionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1LocationsGateIdCloseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1LocationsGateIdCloseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1LocationsGateIdLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersGateLinkResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1LocationsGateIdLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1LocationsGateIdLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1LocationsGateIdOpenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGateActionResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON423      *HandlersLocationFrozenResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersGateActionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1LocationsGateIdOpenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1LocationsGateIdOpenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1LocationsLocationIdGatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersGatesListResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+	JSON503      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1LocationsLocationIdGatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1LocationsLocationIdGatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ScimV2UsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ScimV2UsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ScimV2UsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ScimV2UsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ScimV2UsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ScimV2UsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1ScimV2UsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersSCIMError
+	JSON500      *HandlersSCIMError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1ScimV2UsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1ScimV2UsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ScimV2UsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ScimV2UsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ScimV2UsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiV1ScimV2UsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiV1ScimV2UsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiV1ScimV2UsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1ScimV2UsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1ScimV2UsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1ScimV2UsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1SyncChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersSyncChangesResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1SyncChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1SyncChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1UsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUsersListResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1UsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1UsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1UsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersUserResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1UsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1UsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1UsersBulkDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersBulkDeleteSummaryResponse
+	JSON202      *HandlersBulkDeleteResultResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1UsersBulkDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1UsersBulkDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1UsersDuplicatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersDuplicateUsersResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1UsersDuplicatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1UsersDuplicatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1UsersTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersTrashedUsersResponse
+	JSON401      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1UsersTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1UsersTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1UsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersTrashedUserResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1UsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1UsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1UsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUserDetailResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1UsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1UsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchApiV1UsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUserResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchApiV1UsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchApiV1UsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1UsersIdHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUserHistoryResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1UsersIdHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1UsersIdHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1UsersIdMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersMergeUsersResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON403      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1UsersIdMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1UsersIdMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1UsersIdPhonesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUserPhonesResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1UsersIdPhonesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1UsersIdPhonesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1UsersIdPhonesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HandlersUserPhoneResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1UsersIdPhonesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1UsersIdPhonesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1UsersIdPhonesPhoneIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1UsersIdPhonesPhoneIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1UsersIdPhonesPhoneIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1UsersIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUserResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON409      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1UsersIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1UsersIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1UsersIdRetryAssignmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersAPIResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+	JSON502      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1UsersIdRetryAssignmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1UsersIdRetryAssignmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1UsersIdSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HandlersUserSessionsResponse
+	JSON400      *HandlersAPIResponse
+	JSON401      *HandlersAPIResponse
+	JSON404      *HandlersAPIResponse
+	JSON500      *HandlersAPIResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1UsersIdSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1UsersIdSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWithResponse request returning *GetResponse
+func (c *ClientWithResponses) GetWithResponse(ctx context.Context, params *GetParams, reqEditors ...RequestEditorFn) (*GetResponse, error) {
+	rsp, err := c.Get(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResponse(rsp)
+}
+
+// GetApiV1AdminApiKeysWithResponse request returning *GetApiV1AdminApiKeysResponse
+func (c *ClientWithResponses) GetApiV1AdminApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminApiKeysResponse, error) {
+	rsp, err := c.GetApiV1AdminApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminApiKeysResponse(rsp)
+}
+
+// PostApiV1AdminApiKeysWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminApiKeysResponse
+func (c *ClientWithResponses) PostApiV1AdminApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminApiKeysResponse, error) {
+	rsp, err := c.PostApiV1AdminApiKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminApiKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminApiKeysWithResponse(ctx context.Context, body PostApiV1AdminApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminApiKeysResponse, error) {
+	rsp, err := c.PostApiV1AdminApiKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminApiKeysResponse(rsp)
+}
+
+// DeleteApiV1AdminApiKeysIdWithResponse request returning *DeleteApiV1AdminApiKeysIdResponse
+func (c *ClientWithResponses) DeleteApiV1AdminApiKeysIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminApiKeysIdResponse, error) {
+	rsp, err := c.DeleteApiV1AdminApiKeysId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AdminApiKeysIdResponse(rsp)
+}
+
+// GetApiV1AdminAuditLogsWithResponse request returning *GetApiV1AdminAuditLogsResponse
+func (c *ClientWithResponses) GetApiV1AdminAuditLogsWithResponse(ctx context.Context, params *GetApiV1AdminAuditLogsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminAuditLogsResponse, error) {
+	rsp, err := c.GetApiV1AdminAuditLogs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminAuditLogsResponse(rsp)
+}
+
+// GetApiV1AdminAuditLogsIdWithResponse request returning *GetApiV1AdminAuditLogsIdResponse
+func (c *ClientWithResponses) GetApiV1AdminAuditLogsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminAuditLogsIdResponse, error) {
+	rsp, err := c.GetApiV1AdminAuditLogsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminAuditLogsIdResponse(rsp)
+}
+
+// PostApiV1AdminAuditLogsIdCommentsWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminAuditLogsIdCommentsResponse
+func (c *ClientWithResponses) PostApiV1AdminAuditLogsIdCommentsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminAuditLogsIdCommentsResponse, error) {
+	rsp, err := c.PostApiV1AdminAuditLogsIdCommentsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminAuditLogsIdCommentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminAuditLogsIdCommentsWithResponse(ctx context.Context, id string, body PostApiV1AdminAuditLogsIdCommentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminAuditLogsIdCommentsResponse, error) {
+	rsp, err := c.PostApiV1AdminAuditLogsIdComments(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminAuditLogsIdCommentsResponse(rsp)
+}
+
+// PostApiV1AdminConfigReloadWithResponse request returning *PostApiV1AdminConfigReloadResponse
+func (c *ClientWithResponses) PostApiV1AdminConfigReloadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiV1AdminConfigReloadResponse, error) {
+	rsp, err := c.PostApiV1AdminConfigReload(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminConfigReloadResponse(rsp)
+}
+
+// GetApiV1AdminCorsOriginsWithResponse request returning *GetApiV1AdminCorsOriginsResponse
+func (c *ClientWithResponses) GetApiV1AdminCorsOriginsWithResponse(ctx context.Context, params *GetApiV1AdminCorsOriginsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminCorsOriginsResponse, error) {
+	rsp, err := c.GetApiV1AdminCorsOrigins(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminCorsOriginsResponse(rsp)
+}
+
+// PostApiV1AdminCorsOriginsWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminCorsOriginsResponse
+func (c *ClientWithResponses) PostApiV1AdminCorsOriginsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminCorsOriginsResponse, error) {
+	rsp, err := c.PostApiV1AdminCorsOriginsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminCorsOriginsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminCorsOriginsWithResponse(ctx context.Context, body PostApiV1AdminCorsOriginsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminCorsOriginsResponse, error) {
+	rsp, err := c.PostApiV1AdminCorsOrigins(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminCorsOriginsResponse(rsp)
+}
+
+// DeleteApiV1AdminCorsOriginsIdWithResponse request returning *DeleteApiV1AdminCorsOriginsIdResponse
+func (c *ClientWithResponses) DeleteApiV1AdminCorsOriginsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminCorsOriginsIdResponse, error) {
+	rsp, err := c.DeleteApiV1AdminCorsOriginsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AdminCorsOriginsIdResponse(rsp)
+}
+
+// GetApiV1AdminDigestRunsWithResponse request returning *GetApiV1AdminDigestRunsResponse
+func (c *ClientWithResponses) GetApiV1AdminDigestRunsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminDigestRunsResponse, error) {
+	rsp, err := c.GetApiV1AdminDigestRuns(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminDigestRunsResponse(rsp)
+}
+
+// PostApiV1AdminExportsWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminExportsResponse
+func (c *ClientWithResponses) PostApiV1AdminExportsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminExportsResponse, error) {
+	rsp, err := c.PostApiV1AdminExportsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminExportsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminExportsWithResponse(ctx context.Context, body PostApiV1AdminExportsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminExportsResponse, error) {
+	rsp, err := c.PostApiV1AdminExports(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminExportsResponse(rsp)
+}
+
+// GetApiV1AdminExportsIdWithResponse request returning *GetApiV1AdminExportsIdResponse
+func (c *ClientWithResponses) GetApiV1AdminExportsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminExportsIdResponse, error) {
+	rsp, err := c.GetApiV1AdminExportsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminExportsIdResponse(rsp)
+}
+
+// GetApiV1AdminFeedWithResponse request returning *GetApiV1AdminFeedResponse
+func (c *ClientWithResponses) GetApiV1AdminFeedWithResponse(ctx context.Context, params *GetApiV1AdminFeedParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminFeedResponse, error) {
+	rsp, err := c.GetApiV1AdminFeed(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminFeedResponse(rsp)
+}
+
+// PostApiV1AdminForcedLogoutsWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminForcedLogoutsResponse
+func (c *ClientWithResponses) PostApiV1AdminForcedLogoutsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminForcedLogoutsResponse, error) {
+	rsp, err := c.PostApiV1AdminForcedLogoutsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminForcedLogoutsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminForcedLogoutsWithResponse(ctx context.Context, body PostApiV1AdminForcedLogoutsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminForcedLogoutsResponse, error) {
+	rsp, err := c.PostApiV1AdminForcedLogouts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminForcedLogoutsResponse(rsp)
+}
+
+// GetApiV1AdminForcedLogoutsIdWithResponse request returning *GetApiV1AdminForcedLogoutsIdResponse
+func (c *ClientWithResponses) GetApiV1AdminForcedLogoutsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminForcedLogoutsIdResponse, error) {
+	rsp, err := c.GetApiV1AdminForcedLogoutsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminForcedLogoutsIdResponse(rsp)
+}
+
+// GetApiV1AdminGateEventsWithResponse request returning *GetApiV1AdminGateEventsResponse
+func (c *ClientWithResponses) GetApiV1AdminGateEventsWithResponse(ctx context.Context, params *GetApiV1AdminGateEventsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminGateEventsResponse, error) {
+	rsp, err := c.GetApiV1AdminGateEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminGateEventsResponse(rsp)
+}
+
+// GetApiV1AdminGateEventsExportWithResponse request returning *GetApiV1AdminGateEventsExportResponse
+func (c *ClientWithResponses) GetApiV1AdminGateEventsExportWithResponse(ctx context.Context, params *GetApiV1AdminGateEventsExportParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminGateEventsExportResponse, error) {
+	rsp, err := c.GetApiV1AdminGateEventsExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminGateEventsExportResponse(rsp)
+}
+
+// GetApiV1AdminGateReportsWithResponse request returning *GetApiV1AdminGateReportsResponse
+func (c *ClientWithResponses) GetApiV1AdminGateReportsWithResponse(ctx context.Context, params *GetApiV1AdminGateReportsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminGateReportsResponse, error) {
+	rsp, err := c.GetApiV1AdminGateReports(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminGateReportsResponse(rsp)
+}
+
+// GetApiV1AdminGateReportsIdPhotoWithResponse request returning *GetApiV1AdminGateReportsIdPhotoResponse
+func (c *ClientWithResponses) GetApiV1AdminGateReportsIdPhotoWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminGateReportsIdPhotoResponse, error) {
+	rsp, err := c.GetApiV1AdminGateReportsIdPhoto(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminGateReportsIdPhotoResponse(rsp)
+}
+
+// PostApiV1AdminGateReportsIdResolveWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminGateReportsIdResolveResponse
+func (c *ClientWithResponses) PostApiV1AdminGateReportsIdResolveWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminGateReportsIdResolveResponse, error) {
+	rsp, err := c.PostApiV1AdminGateReportsIdResolveWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminGateReportsIdResolveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminGateReportsIdResolveWithResponse(ctx context.Context, id string, body PostApiV1AdminGateReportsIdResolveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminGateReportsIdResolveResponse, error) {
+	rsp, err := c.PostApiV1AdminGateReportsIdResolve(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminGateReportsIdResolveResponse(rsp)
+}
+
+// GetApiV1AdminGatesMaintenanceWithResponse request returning *GetApiV1AdminGatesMaintenanceResponse
+func (c *ClientWithResponses) GetApiV1AdminGatesMaintenanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminGatesMaintenanceResponse, error) {
+	rsp, err := c.GetApiV1AdminGatesMaintenance(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminGatesMaintenanceResponse(rsp)
+}
+
+// DeleteApiV1AdminGatesGateIdMaintenanceWithResponse request returning *DeleteApiV1AdminGatesGateIdMaintenanceResponse
+func (c *ClientWithResponses) DeleteApiV1AdminGatesGateIdMaintenanceWithResponse(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminGatesGateIdMaintenanceResponse, error) {
+	rsp, err := c.DeleteApiV1AdminGatesGateIdMaintenance(ctx, gateId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AdminGatesGateIdMaintenanceResponse(rsp)
+}
+
+// PutApiV1AdminGatesGateIdMaintenanceWithBodyWithResponse request with arbitrary body returning *PutApiV1AdminGatesGateIdMaintenanceResponse
+func (c *ClientWithResponses) PutApiV1AdminGatesGateIdMaintenanceWithBodyWithResponse(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AdminGatesGateIdMaintenanceResponse, error) {
+	rsp, err := c.PutApiV1AdminGatesGateIdMaintenanceWithBody(ctx, gateId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AdminGatesGateIdMaintenanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1AdminGatesGateIdMaintenanceWithResponse(ctx context.Context, gateId int, body PutApiV1AdminGatesGateIdMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AdminGatesGateIdMaintenanceResponse, error) {
+	rsp, err := c.PutApiV1AdminGatesGateIdMaintenance(ctx, gateId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AdminGatesGateIdMaintenanceResponse(rsp)
+}
+
+// PutApiV1AdminGatesGateIdOpenWithBodyWithResponse request with arbitrary body returning *PutApiV1AdminGatesGateIdOpenResponse
+func (c *ClientWithResponses) PutApiV1AdminGatesGateIdOpenWithBodyWithResponse(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AdminGatesGateIdOpenResponse, error) {
+	rsp, err := c.PutApiV1AdminGatesGateIdOpenWithBody(ctx, gateId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AdminGatesGateIdOpenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1AdminGatesGateIdOpenWithResponse(ctx context.Context, gateId int, body PutApiV1AdminGatesGateIdOpenJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AdminGatesGateIdOpenResponse, error) {
+	rsp, err := c.PutApiV1AdminGatesGateIdOpen(ctx, gateId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AdminGatesGateIdOpenResponse(rsp)
+}
+
+// PostApiV1AdminImpersonateUserIdWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminImpersonateUserIdResponse
+func (c *ClientWithResponses) PostApiV1AdminImpersonateUserIdWithBodyWithResponse(ctx context.Context, userId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminImpersonateUserIdResponse, error) {
+	rsp, err := c.PostApiV1AdminImpersonateUserIdWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminImpersonateUserIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminImpersonateUserIdWithResponse(ctx context.Context, userId string, body PostApiV1AdminImpersonateUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminImpersonateUserIdResponse, error) {
+	rsp, err := c.PostApiV1AdminImpersonateUserId(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminImpersonateUserIdResponse(rsp)
+}
+
+// GetApiV1AdminInviteCodesWithResponse request returning *GetApiV1AdminInviteCodesResponse
+func (c *ClientWithResponses) GetApiV1AdminInviteCodesWithResponse(ctx context.Context, params *GetApiV1AdminInviteCodesParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminInviteCodesResponse, error) {
+	rsp, err := c.GetApiV1AdminInviteCodes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminInviteCodesResponse(rsp)
+}
+
+// PostApiV1AdminInviteCodesWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminInviteCodesResponse
+func (c *ClientWithResponses) PostApiV1AdminInviteCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminInviteCodesResponse, error) {
+	rsp, err := c.PostApiV1AdminInviteCodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminInviteCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminInviteCodesWithResponse(ctx context.Context, body PostApiV1AdminInviteCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminInviteCodesResponse, error) {
+	rsp, err := c.PostApiV1AdminInviteCodes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminInviteCodesResponse(rsp)
+}
+
+// DeleteApiV1AdminInviteCodesIdWithResponse request returning *DeleteApiV1AdminInviteCodesIdResponse
+func (c *ClientWithResponses) DeleteApiV1AdminInviteCodesIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminInviteCodesIdResponse, error) {
+	rsp, err := c.DeleteApiV1AdminInviteCodesId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AdminInviteCodesIdResponse(rsp)
+}
+
+// GetApiV1AdminJobsWithResponse request returning *GetApiV1AdminJobsResponse
+func (c *ClientWithResponses) GetApiV1AdminJobsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminJobsResponse, error) {
+	rsp, err := c.GetApiV1AdminJobs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminJobsResponse(rsp)
+}
+
+// PostApiV1AdminLegalWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminLegalResponse
+func (c *ClientWithResponses) PostApiV1AdminLegalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLegalResponse, error) {
+	rsp, err := c.PostApiV1AdminLegalWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLegalResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminLegalWithResponse(ctx context.Context, body PostApiV1AdminLegalJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLegalResponse, error) {
+	rsp, err := c.PostApiV1AdminLegal(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLegalResponse(rsp)
+}
+
+// GetApiV1AdminLocationsFreezesWithResponse request returning *GetApiV1AdminLocationsFreezesResponse
+func (c *ClientWithResponses) GetApiV1AdminLocationsFreezesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminLocationsFreezesResponse, error) {
+	rsp, err := c.GetApiV1AdminLocationsFreezes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminLocationsFreezesResponse(rsp)
+}
+
+// GetApiV1AdminLocationsOverridesWithResponse request returning *GetApiV1AdminLocationsOverridesResponse
+func (c *ClientWithResponses) GetApiV1AdminLocationsOverridesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminLocationsOverridesResponse, error) {
+	rsp, err := c.GetApiV1AdminLocationsOverrides(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminLocationsOverridesResponse(rsp)
+}
+
+// DeleteApiV1AdminLocationsIdFreezeWithResponse request returning *DeleteApiV1AdminLocationsIdFreezeResponse
+func (c *ClientWithResponses) DeleteApiV1AdminLocationsIdFreezeWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminLocationsIdFreezeResponse, error) {
+	rsp, err := c.DeleteApiV1AdminLocationsIdFreeze(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AdminLocationsIdFreezeResponse(rsp)
+}
+
+// PostApiV1AdminLocationsIdFreezeWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminLocationsIdFreezeResponse
+func (c *ClientWithResponses) PostApiV1AdminLocationsIdFreezeWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLocationsIdFreezeResponse, error) {
+	rsp, err := c.PostApiV1AdminLocationsIdFreezeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLocationsIdFreezeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminLocationsIdFreezeWithResponse(ctx context.Context, id int, body PostApiV1AdminLocationsIdFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLocationsIdFreezeResponse, error) {
+	rsp, err := c.PostApiV1AdminLocationsIdFreeze(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLocationsIdFreezeResponse(rsp)
+}
+
+// DeleteApiV1AdminLocationsIdOverrideWithResponse request returning *DeleteApiV1AdminLocationsIdOverrideResponse
+func (c *ClientWithResponses) DeleteApiV1AdminLocationsIdOverrideWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminLocationsIdOverrideResponse, error) {
+	rsp, err := c.DeleteApiV1AdminLocationsIdOverride(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AdminLocationsIdOverrideResponse(rsp)
+}
+
+// PutApiV1AdminLocationsIdOverrideWithBodyWithResponse request with arbitrary body returning *PutApiV1AdminLocationsIdOverrideResponse
+func (c *ClientWithResponses) PutApiV1AdminLocationsIdOverrideWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AdminLocationsIdOverrideResponse, error) {
+	rsp, err := c.PutApiV1AdminLocationsIdOverrideWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AdminLocationsIdOverrideResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1AdminLocationsIdOverrideWithResponse(ctx context.Context, id int, body PutApiV1AdminLocationsIdOverrideJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AdminLocationsIdOverrideResponse, error) {
+	rsp, err := c.PutApiV1AdminLocationsIdOverride(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AdminLocationsIdOverrideResponse(rsp)
+}
+
+// PostApiV1AdminLoginWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminLoginResponse
+func (c *ClientWithResponses) PostApiV1AdminLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginResponse, error) {
+	rsp, err := c.PostApiV1AdminLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminLoginWithResponse(ctx context.Context, body PostApiV1AdminLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginResponse, error) {
+	rsp, err := c.PostApiV1AdminLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLoginResponse(rsp)
+}
+
+// PostApiV1AdminLoginPasskeyWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminLoginPasskeyResponse
+func (c *ClientWithResponses) PostApiV1AdminLoginPasskeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginPasskeyResponse, error) {
+	rsp, err := c.PostApiV1AdminLoginPasskeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLoginPasskeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminLoginPasskeyWithResponse(ctx context.Context, body PostApiV1AdminLoginPasskeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginPasskeyResponse, error) {
+	rsp, err := c.PostApiV1AdminLoginPasskey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLoginPasskeyResponse(rsp)
+}
+
+// PostApiV1AdminLoginPasskeyOptionsWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminLoginPasskeyOptionsResponse
+func (c *ClientWithResponses) PostApiV1AdminLoginPasskeyOptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginPasskeyOptionsResponse, error) {
+	rsp, err := c.PostApiV1AdminLoginPasskeyOptionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLoginPasskeyOptionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminLoginPasskeyOptionsWithResponse(ctx context.Context, body PostApiV1AdminLoginPasskeyOptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminLoginPasskeyOptionsResponse, error) {
+	rsp, err := c.PostApiV1AdminLoginPasskeyOptions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminLoginPasskeyOptionsResponse(rsp)
+}
+
+// GetApiV1AdminNotificationsWithResponse request returning *GetApiV1AdminNotificationsResponse
+func (c *ClientWithResponses) GetApiV1AdminNotificationsWithResponse(ctx context.Context, params *GetApiV1AdminNotificationsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminNotificationsResponse, error) {
+	rsp, err := c.GetApiV1AdminNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminNotificationsResponse(rsp)
+}
+
+// PatchApiV1AdminNotificationsReadAllWithResponse request returning *PatchApiV1AdminNotificationsReadAllResponse
+func (c *ClientWithResponses) PatchApiV1AdminNotificationsReadAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PatchApiV1AdminNotificationsReadAllResponse, error) {
+	rsp, err := c.PatchApiV1AdminNotificationsReadAll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1AdminNotificationsReadAllResponse(rsp)
+}
+
+// GetApiV1AdminNotificationsIdWithResponse request returning *GetApiV1AdminNotificationsIdResponse
+func (c *ClientWithResponses) GetApiV1AdminNotificationsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminNotificationsIdResponse, error) {
+	rsp, err := c.GetApiV1AdminNotificationsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminNotificationsIdResponse(rsp)
+}
+
+// PostApiV1AdminNotificationsIdCommentsWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminNotificationsIdCommentsResponse
+func (c *ClientWithResponses) PostApiV1AdminNotificationsIdCommentsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminNotificationsIdCommentsResponse, error) {
+	rsp, err := c.PostApiV1AdminNotificationsIdCommentsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminNotificationsIdCommentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminNotificationsIdCommentsWithResponse(ctx context.Context, id string, body PostApiV1AdminNotificationsIdCommentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminNotificationsIdCommentsResponse, error) {
+	rsp, err := c.PostApiV1AdminNotificationsIdComments(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminNotificationsIdCommentsResponse(rsp)
+}
+
+// PatchApiV1AdminNotificationsIdReadWithResponse request returning *PatchApiV1AdminNotificationsIdReadResponse
+func (c *ClientWithResponses) PatchApiV1AdminNotificationsIdReadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PatchApiV1AdminNotificationsIdReadResponse, error) {
+	rsp, err := c.PatchApiV1AdminNotificationsIdRead(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1AdminNotificationsIdReadResponse(rsp)
+}
+
+// GetApiV1AdminProviderMigrationReportWithResponse request returning *GetApiV1AdminProviderMigrationReportResponse
+func (c *ClientWithResponses) GetApiV1AdminProviderMigrationReportWithResponse(ctx context.Context, params *GetApiV1AdminProviderMigrationReportParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminProviderMigrationReportResponse, error) {
+	rsp, err := c.GetApiV1AdminProviderMigrationReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminProviderMigrationReportResponse(rsp)
+}
+
+// GetApiV1AdminProviderUsageWithResponse request returning *GetApiV1AdminProviderUsageResponse
+func (c *ClientWithResponses) GetApiV1AdminProviderUsageWithResponse(ctx context.Context, params *GetApiV1AdminProviderUsageParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminProviderUsageResponse, error) {
+	rsp, err := c.GetApiV1AdminProviderUsage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminProviderUsageResponse(rsp)
+}
+
+// PostApiV1AdminRefreshWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminRefreshResponse
+func (c *ClientWithResponses) PostApiV1AdminRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminRefreshResponse, error) {
+	rsp, err := c.PostApiV1AdminRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminRefreshWithResponse(ctx context.Context, body PostApiV1AdminRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminRefreshResponse, error) {
+	rsp, err := c.PostApiV1AdminRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminRefreshResponse(rsp)
+}
+
+// GetApiV1AdminRegistrationsWithResponse request returning *GetApiV1AdminRegistrationsResponse
+func (c *ClientWithResponses) GetApiV1AdminRegistrationsWithResponse(ctx context.Context, params *GetApiV1AdminRegistrationsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminRegistrationsResponse, error) {
+	rsp, err := c.GetApiV1AdminRegistrations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminRegistrationsResponse(rsp)
+}
+
+// PostApiV1AdminRegistrationsIdApproveWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminRegistrationsIdApproveResponse
+func (c *ClientWithResponses) PostApiV1AdminRegistrationsIdApproveWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminRegistrationsIdApproveResponse, error) {
+	rsp, err := c.PostApiV1AdminRegistrationsIdApproveWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminRegistrationsIdApproveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminRegistrationsIdApproveWithResponse(ctx context.Context, id string, body PostApiV1AdminRegistrationsIdApproveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminRegistrationsIdApproveResponse, error) {
+	rsp, err := c.PostApiV1AdminRegistrationsIdApprove(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminRegistrationsIdApproveResponse(rsp)
+}
+
+// PostApiV1AdminRegistrationsIdRejectWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminRegistrationsIdRejectResponse
+func (c *ClientWithResponses) PostApiV1AdminRegistrationsIdRejectWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminRegistrationsIdRejectResponse, error) {
+	rsp, err := c.PostApiV1AdminRegistrationsIdRejectWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminRegistrationsIdRejectResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminRegistrationsIdRejectWithResponse(ctx context.Context, id string, body PostApiV1AdminRegistrationsIdRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminRegistrationsIdRejectResponse, error) {
+	rsp, err := c.PostApiV1AdminRegistrationsIdReject(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminRegistrationsIdRejectResponse(rsp)
+}
+
+// GetApiV1AdminReportsWithResponse request returning *GetApiV1AdminReportsResponse
+func (c *ClientWithResponses) GetApiV1AdminReportsWithResponse(ctx context.Context, params *GetApiV1AdminReportsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminReportsResponse, error) {
+	rsp, err := c.GetApiV1AdminReports(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminReportsResponse(rsp)
+}
+
+// GetApiV1AdminRoutesWithResponse request returning *GetApiV1AdminRoutesResponse
+func (c *ClientWithResponses) GetApiV1AdminRoutesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminRoutesResponse, error) {
+	rsp, err := c.GetApiV1AdminRoutes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminRoutesResponse(rsp)
+}
+
+// GetApiV1AdminSandboxSmsWithResponse request returning *GetApiV1AdminSandboxSmsResponse
+func (c *ClientWithResponses) GetApiV1AdminSandboxSmsWithResponse(ctx context.Context, params *GetApiV1AdminSandboxSmsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminSandboxSmsResponse, error) {
+	rsp, err := c.GetApiV1AdminSandboxSms(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminSandboxSmsResponse(rsp)
+}
+
+// GetApiV1AdminSearchWithResponse request returning *GetApiV1AdminSearchResponse
+func (c *ClientWithResponses) GetApiV1AdminSearchWithResponse(ctx context.Context, params *GetApiV1AdminSearchParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminSearchResponse, error) {
+	rsp, err := c.GetApiV1AdminSearch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminSearchResponse(rsp)
+}
+
+// GetApiV1AdminSecurityDenialsWithResponse request returning *GetApiV1AdminSecurityDenialsResponse
+func (c *ClientWithResponses) GetApiV1AdminSecurityDenialsWithResponse(ctx context.Context, params *GetApiV1AdminSecurityDenialsParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminSecurityDenialsResponse, error) {
+	rsp, err := c.GetApiV1AdminSecurityDenials(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminSecurityDenialsResponse(rsp)
+}
+
+// GetApiV1AdminSloWithResponse request returning *GetApiV1AdminSloResponse
+func (c *ClientWithResponses) GetApiV1AdminSloWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminSloResponse, error) {
+	rsp, err := c.GetApiV1AdminSlo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminSloResponse(rsp)
+}
+
+// GetApiV1AdminStaleDevicesWithResponse request returning *GetApiV1AdminStaleDevicesResponse
+func (c *ClientWithResponses) GetApiV1AdminStaleDevicesWithResponse(ctx context.Context, params *GetApiV1AdminStaleDevicesParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminStaleDevicesResponse, error) {
+	rsp, err := c.GetApiV1AdminStaleDevices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminStaleDevicesResponse(rsp)
+}
+
+// GetApiV1AdminUsageWithResponse request returning *GetApiV1AdminUsageResponse
+func (c *ClientWithResponses) GetApiV1AdminUsageWithResponse(ctx context.Context, params *GetApiV1AdminUsageParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsageResponse, error) {
+	rsp, err := c.GetApiV1AdminUsage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminUsageResponse(rsp)
+}
+
+// GetApiV1AdminUsersWithResponse request returning *GetApiV1AdminUsersResponse
+func (c *ClientWithResponses) GetApiV1AdminUsersWithResponse(ctx context.Context, params *GetApiV1AdminUsersParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsersResponse, error) {
+	rsp, err := c.GetApiV1AdminUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminUsersResponse(rsp)
+}
+
+// PostApiV1AdminUsersWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminUsersResponse
+func (c *ClientWithResponses) PostApiV1AdminUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersResponse, error) {
+	rsp, err := c.PostApiV1AdminUsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminUsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminUsersWithResponse(ctx context.Context, body PostApiV1AdminUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersResponse, error) {
+	rsp, err := c.PostApiV1AdminUsers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminUsersResponse(rsp)
+}
+
+// PostApiV1AdminUsersImportWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminUsersImportResponse
+func (c *ClientWithResponses) PostApiV1AdminUsersImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersImportResponse, error) {
+	rsp, err := c.PostApiV1AdminUsersImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminUsersImportResponse(rsp)
+}
+
+// DeleteApiV1AdminUsersIdWithResponse request returning *DeleteApiV1AdminUsersIdResponse
+func (c *ClientWithResponses) DeleteApiV1AdminUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminUsersIdResponse, error) {
+	rsp, err := c.DeleteApiV1AdminUsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AdminUsersIdResponse(rsp)
+}
+
+// GetApiV1AdminUsersIdWithResponse request returning *GetApiV1AdminUsersIdResponse
+func (c *ClientWithResponses) GetApiV1AdminUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsersIdResponse, error) {
+	rsp, err := c.GetApiV1AdminUsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminUsersIdResponse(rsp)
+}
+
+// PatchApiV1AdminUsersIdWithBodyWithResponse request with arbitrary body returning *PatchApiV1AdminUsersIdResponse
+func (c *ClientWithResponses) PatchApiV1AdminUsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1AdminUsersIdResponse, error) {
+	rsp, err := c.PatchApiV1AdminUsersIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1AdminUsersIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiV1AdminUsersIdWithResponse(ctx context.Context, id string, body PatchApiV1AdminUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1AdminUsersIdResponse, error) {
+	rsp, err := c.PatchApiV1AdminUsersId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1AdminUsersIdResponse(rsp)
+}
+
+// GetApiV1AdminUsersIdHistoryWithResponse request returning *GetApiV1AdminUsersIdHistoryResponse
+func (c *ClientWithResponses) GetApiV1AdminUsersIdHistoryWithResponse(ctx context.Context, id string, params *GetApiV1AdminUsersIdHistoryParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsersIdHistoryResponse, error) {
+	rsp, err := c.GetApiV1AdminUsersIdHistory(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminUsersIdHistoryResponse(rsp)
+}
+
+// GetApiV1AdminUsersIdPasskeysWithResponse request returning *GetApiV1AdminUsersIdPasskeysResponse
+func (c *ClientWithResponses) GetApiV1AdminUsersIdPasskeysWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1AdminUsersIdPasskeysResponse, error) {
+	rsp, err := c.GetApiV1AdminUsersIdPasskeys(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminUsersIdPasskeysResponse(rsp)
+}
+
+// PostApiV1AdminUsersIdPasskeysWithBodyWithResponse request with arbitrary body returning *PostApiV1AdminUsersIdPasskeysResponse
+func (c *ClientWithResponses) PostApiV1AdminUsersIdPasskeysWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersIdPasskeysResponse, error) {
+	rsp, err := c.PostApiV1AdminUsersIdPasskeysWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminUsersIdPasskeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AdminUsersIdPasskeysWithResponse(ctx context.Context, id string, body PostApiV1AdminUsersIdPasskeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersIdPasskeysResponse, error) {
+	rsp, err := c.PostApiV1AdminUsersIdPasskeys(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminUsersIdPasskeysResponse(rsp)
+}
+
+// PostApiV1AdminUsersIdPasskeysOptionsWithResponse request returning *PostApiV1AdminUsersIdPasskeysOptionsResponse
+func (c *ClientWithResponses) PostApiV1AdminUsersIdPasskeysOptionsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostApiV1AdminUsersIdPasskeysOptionsResponse, error) {
+	rsp, err := c.PostApiV1AdminUsersIdPasskeysOptions(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminUsersIdPasskeysOptionsResponse(rsp)
+}
+
+// DeleteApiV1AdminUsersIdPasskeysCredentialIdWithResponse request returning *DeleteApiV1AdminUsersIdPasskeysCredentialIdResponse
+func (c *ClientWithResponses) DeleteApiV1AdminUsersIdPasskeysCredentialIdWithResponse(ctx context.Context, id string, credentialId string, reqEditors ...RequestEditorFn) (*DeleteApiV1AdminUsersIdPasskeysCredentialIdResponse, error) {
+	rsp, err := c.DeleteApiV1AdminUsersIdPasskeysCredentialId(ctx, id, credentialId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AdminUsersIdPasskeysCredentialIdResponse(rsp)
+}
+
+// PatchApiV1AdminUsersIdPasskeysCredentialIdWithBodyWithResponse request with arbitrary body returning *PatchApiV1AdminUsersIdPasskeysCredentialIdResponse
+func (c *ClientWithResponses) PatchApiV1AdminUsersIdPasskeysCredentialIdWithBodyWithResponse(ctx context.Context, id string, credentialId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1AdminUsersIdPasskeysCredentialIdResponse, error) {
+	rsp, err := c.PatchApiV1AdminUsersIdPasskeysCredentialIdWithBody(ctx, id, credentialId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1AdminUsersIdPasskeysCredentialIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiV1AdminUsersIdPasskeysCredentialIdWithResponse(ctx context.Context, id string, credentialId string, body PatchApiV1AdminUsersIdPasskeysCredentialIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1AdminUsersIdPasskeysCredentialIdResponse, error) {
+	rsp, err := c.PatchApiV1AdminUsersIdPasskeysCredentialId(ctx, id, credentialId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1AdminUsersIdPasskeysCredentialIdResponse(rsp)
+}
+
+// GetApiV1AdminWebhookSecretsWithResponse request returning *GetApiV1AdminWebhookSecretsResponse
+func (c *ClientWithResponses) GetApiV1AdminWebhookSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminWebhookSecretsResponse, error) {
+	rsp, err := c.GetApiV1AdminWebhookSecrets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminWebhookSecretsResponse(rsp)
+}
+
+// PostApiV1AdminWebhookSecretsScopeRotateWithResponse request returning *PostApiV1AdminWebhookSecretsScopeRotateResponse
+func (c *ClientWithResponses) PostApiV1AdminWebhookSecretsScopeRotateWithResponse(ctx context.Context, scope string, reqEditors ...RequestEditorFn) (*PostApiV1AdminWebhookSecretsScopeRotateResponse, error) {
+	rsp, err := c.PostApiV1AdminWebhookSecretsScopeRotate(ctx, scope, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AdminWebhookSecretsScopeRotateResponse(rsp)
+}
+
+// PostApiV1AuthChangePasswordWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthChangePasswordResponse
+func (c *ClientWithResponses) PostApiV1AuthChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthChangePasswordResponse, error) {
+	rsp, err := c.PostApiV1AuthChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthChangePasswordWithResponse(ctx context.Context, body PostApiV1AuthChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthChangePasswordResponse, error) {
+	rsp, err := c.PostApiV1AuthChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthChangePasswordResponse(rsp)
+}
+
+// GetApiV1AuthCheckPhoneWithResponse request returning *GetApiV1AuthCheckPhoneResponse
+func (c *ClientWithResponses) GetApiV1AuthCheckPhoneWithResponse(ctx context.Context, params *GetApiV1AuthCheckPhoneParams, reqEditors ...RequestEditorFn) (*GetApiV1AuthCheckPhoneResponse, error) {
+	rsp, err := c.GetApiV1AuthCheckPhone(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AuthCheckPhoneResponse(rsp)
+}
+
+// PostApiV1AuthForgotPasswordWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthForgotPasswordResponse
+func (c *ClientWithResponses) PostApiV1AuthForgotPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthForgotPasswordResponse, error) {
+	rsp, err := c.PostApiV1AuthForgotPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthForgotPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthForgotPasswordWithResponse(ctx context.Context, body PostApiV1AuthForgotPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthForgotPasswordResponse, error) {
+	rsp, err := c.PostApiV1AuthForgotPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthForgotPasswordResponse(rsp)
+}
+
+// GetApiV1AuthLegalWithResponse request returning *GetApiV1AuthLegalResponse
+func (c *ClientWithResponses) GetApiV1AuthLegalWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthLegalResponse, error) {
+	rsp, err := c.GetApiV1AuthLegal(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AuthLegalResponse(rsp)
+}
+
+// PostApiV1AuthLegalAcceptWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthLegalAcceptResponse
+func (c *ClientWithResponses) PostApiV1AuthLegalAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthLegalAcceptResponse, error) {
+	rsp, err := c.PostApiV1AuthLegalAcceptWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLegalAcceptResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthLegalAcceptWithResponse(ctx context.Context, body PostApiV1AuthLegalAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthLegalAcceptResponse, error) {
+	rsp, err := c.PostApiV1AuthLegalAccept(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLegalAcceptResponse(rsp)
+}
+
+// PostApiV1AuthLoginWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthLoginResponse
+func (c *ClientWithResponses) PostApiV1AuthLoginWithBodyWithResponse(ctx context.Context, params *PostApiV1AuthLoginParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginResponse, error) {
+	rsp, err := c.PostApiV1AuthLoginWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthLoginWithResponse(ctx context.Context, params *PostApiV1AuthLoginParams, body PostApiV1AuthLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginResponse, error) {
+	rsp, err := c.PostApiV1AuthLogin(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLoginResponse(rsp)
+}
+
+// PostApiV1AuthLoginOtpConfirmWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthLoginOtpConfirmResponse
+func (c *ClientWithResponses) PostApiV1AuthLoginOtpConfirmWithBodyWithResponse(ctx context.Context, params *PostApiV1AuthLoginOtpConfirmParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginOtpConfirmResponse, error) {
+	rsp, err := c.PostApiV1AuthLoginOtpConfirmWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLoginOtpConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthLoginOtpConfirmWithResponse(ctx context.Context, params *PostApiV1AuthLoginOtpConfirmParams, body PostApiV1AuthLoginOtpConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginOtpConfirmResponse, error) {
+	rsp, err := c.PostApiV1AuthLoginOtpConfirm(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLoginOtpConfirmResponse(rsp)
+}
+
+// PostApiV1AuthLoginOtpRequestWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthLoginOtpRequestResponse
+func (c *ClientWithResponses) PostApiV1AuthLoginOtpRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginOtpRequestResponse, error) {
+	rsp, err := c.PostApiV1AuthLoginOtpRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLoginOtpRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthLoginOtpRequestWithResponse(ctx context.Context, body PostApiV1AuthLoginOtpRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthLoginOtpRequestResponse, error) {
+	rsp, err := c.PostApiV1AuthLoginOtpRequest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLoginOtpRequestResponse(rsp)
+}
+
+// PostApiV1AuthLogoutWithResponse request returning *PostApiV1AuthLogoutResponse
+func (c *ClientWithResponses) PostApiV1AuthLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiV1AuthLogoutResponse, error) {
+	rsp, err := c.PostApiV1AuthLogout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthLogoutResponse(rsp)
+}
+
+// GetApiV1AuthNotificationPreferencesWithResponse request returning *GetApiV1AuthNotificationPreferencesResponse
+func (c *ClientWithResponses) GetApiV1AuthNotificationPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthNotificationPreferencesResponse, error) {
+	rsp, err := c.GetApiV1AuthNotificationPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AuthNotificationPreferencesResponse(rsp)
+}
+
+// PutApiV1AuthNotificationPreferencesWithBodyWithResponse request with arbitrary body returning *PutApiV1AuthNotificationPreferencesResponse
+func (c *ClientWithResponses) PutApiV1AuthNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AuthNotificationPreferencesResponse, error) {
+	rsp, err := c.PutApiV1AuthNotificationPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AuthNotificationPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1AuthNotificationPreferencesWithResponse(ctx context.Context, body PutApiV1AuthNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AuthNotificationPreferencesResponse, error) {
+	rsp, err := c.PutApiV1AuthNotificationPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AuthNotificationPreferencesResponse(rsp)
+}
+
+// PostApiV1AuthRefreshWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthRefreshResponse
+func (c *ClientWithResponses) PostApiV1AuthRefreshWithBodyWithResponse(ctx context.Context, params *PostApiV1AuthRefreshParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthRefreshResponse, error) {
+	rsp, err := c.PostApiV1AuthRefreshWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthRefreshWithResponse(ctx context.Context, params *PostApiV1AuthRefreshParams, body PostApiV1AuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthRefreshResponse, error) {
+	rsp, err := c.PostApiV1AuthRefresh(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthRefreshResponse(rsp)
+}
+
+// PostApiV1AuthRegisterWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthRegisterResponse
+func (c *ClientWithResponses) PostApiV1AuthRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthRegisterResponse, error) {
+	rsp, err := c.PostApiV1AuthRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthRegisterWithResponse(ctx context.Context, body PostApiV1AuthRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthRegisterResponse, error) {
+	rsp, err := c.PostApiV1AuthRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthRegisterResponse(rsp)
+}
+
+// PostApiV1AuthResetPasswordWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthResetPasswordResponse
+func (c *ClientWithResponses) PostApiV1AuthResetPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthResetPasswordResponse, error) {
+	rsp, err := c.PostApiV1AuthResetPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthResetPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthResetPasswordWithResponse(ctx context.Context, body PostApiV1AuthResetPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthResetPasswordResponse, error) {
+	rsp, err := c.PostApiV1AuthResetPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthResetPasswordResponse(rsp)
+}
+
+// DeleteApiV1AuthSessionsWithResponse request returning *DeleteApiV1AuthSessionsResponse
+func (c *ClientWithResponses) DeleteApiV1AuthSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiV1AuthSessionsResponse, error) {
+	rsp, err := c.DeleteApiV1AuthSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AuthSessionsResponse(rsp)
+}
+
+// GetApiV1AuthSessionsWithResponse request returning *GetApiV1AuthSessionsResponse
+func (c *ClientWithResponses) GetApiV1AuthSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthSessionsResponse, error) {
+	rsp, err := c.GetApiV1AuthSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AuthSessionsResponse(rsp)
+}
+
+// DeleteApiV1AuthSessionsIdWithResponse request returning *DeleteApiV1AuthSessionsIdResponse
+func (c *ClientWithResponses) DeleteApiV1AuthSessionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1AuthSessionsIdResponse, error) {
+	rsp, err := c.DeleteApiV1AuthSessionsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1AuthSessionsIdResponse(rsp)
+}
+
+// PostApiV1AuthVerifyOtpWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthVerifyOtpResponse
+func (c *ClientWithResponses) PostApiV1AuthVerifyOtpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthVerifyOtpResponse, error) {
+	rsp, err := c.PostApiV1AuthVerifyOtpWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthVerifyOtpResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthVerifyOtpWithResponse(ctx context.Context, body PostApiV1AuthVerifyOtpJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthVerifyOtpResponse, error) {
+	rsp, err := c.PostApiV1AuthVerifyOtp(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthVerifyOtpResponse(rsp)
+}
+
+// PostApiV1AuthVerifyOtpResendWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthVerifyOtpResendResponse
+func (c *ClientWithResponses) PostApiV1AuthVerifyOtpResendWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthVerifyOtpResendResponse, error) {
+	rsp, err := c.PostApiV1AuthVerifyOtpResendWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthVerifyOtpResendResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthVerifyOtpResendWithResponse(ctx context.Context, body PostApiV1AuthVerifyOtpResendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthVerifyOtpResendResponse, error) {
+	rsp, err := c.PostApiV1AuthVerifyOtpResend(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthVerifyOtpResendResponse(rsp)
+}
+
+// GetApiV1AvailableLocationsWithResponse request returning *GetApiV1AvailableLocationsResponse
+func (c *ClientWithResponses) GetApiV1AvailableLocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AvailableLocationsResponse, error) {
+	rsp, err := c.GetApiV1AvailableLocations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AvailableLocationsResponse(rsp)
+}
+
+// GetApiV1ContactsWithResponse request returning *GetApiV1ContactsResponse
+func (c *ClientWithResponses) GetApiV1ContactsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ContactsResponse, error) {
+	rsp, err := c.GetApiV1Contacts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ContactsResponse(rsp)
+}
+
+// PatchApiV1ContactsWithBodyWithResponse request with arbitrary body returning *PatchApiV1ContactsResponse
+func (c *ClientWithResponses) PatchApiV1ContactsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1ContactsResponse, error) {
+	rsp, err := c.PatchApiV1ContactsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1ContactsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiV1ContactsWithResponse(ctx context.Context, body PatchApiV1ContactsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1ContactsResponse, error) {
+	rsp, err := c.PatchApiV1Contacts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1ContactsResponse(rsp)
+}
+
+// GetApiV1FilesKeyWithResponse request returning *GetApiV1FilesKeyResponse
+func (c *ClientWithResponses) GetApiV1FilesKeyWithResponse(ctx context.Context, key string, params *GetApiV1FilesKeyParams, reqEditors ...RequestEditorFn) (*GetApiV1FilesKeyResponse, error) {
+	rsp, err := c.GetApiV1FilesKey(ctx, key, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1FilesKeyResponse(rsp)
+}
+
+// GetApiV1GateCommandsIdWithResponse request returning *GetApiV1GateCommandsIdResponse
+func (c *ClientWithResponses) GetApiV1GateCommandsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1GateCommandsIdResponse, error) {
+	rsp, err := c.GetApiV1GateCommandsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1GateCommandsIdResponse(rsp)
+}
+
+// PostApiV1GateCommandsIdCallbackWithBodyWithResponse request with arbitrary body returning *PostApiV1GateCommandsIdCallbackResponse
+func (c *ClientWithResponses) PostApiV1GateCommandsIdCallbackWithBodyWithResponse(ctx context.Context, id string, params *PostApiV1GateCommandsIdCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1GateCommandsIdCallbackResponse, error) {
+	rsp, err := c.PostApiV1GateCommandsIdCallbackWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1GateCommandsIdCallbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1GateCommandsIdCallbackWithResponse(ctx context.Context, id string, params *PostApiV1GateCommandsIdCallbackParams, body PostApiV1GateCommandsIdCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1GateCommandsIdCallbackResponse, error) {
+	rsp, err := c.PostApiV1GateCommandsIdCallback(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1GateCommandsIdCallbackResponse(rsp)
+}
+
+// PostApiV1GatesGateIdReportWithBodyWithResponse request with arbitrary body returning *PostApiV1GatesGateIdReportResponse
+func (c *ClientWithResponses) PostApiV1GatesGateIdReportWithBodyWithResponse(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1GatesGateIdReportResponse, error) {
+	rsp, err := c.PostApiV1GatesGateIdReportWithBody(ctx, gateId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1GatesGateIdReportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1GatesGateIdReportWithResponse(ctx context.Context, gateId int, body PostApiV1GatesGateIdReportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1GatesGateIdReportResponse, error) {
+	rsp, err := c.PostApiV1GatesGateIdReport(ctx, gateId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1GatesGateIdReportResponse(rsp)
+}
+
+// GetApiV1LegalWithResponse request returning *GetApiV1LegalResponse
+func (c *ClientWithResponses) GetApiV1LegalWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1LegalResponse, error) {
+	rsp, err := c.GetApiV1Legal(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1LegalResponse(rsp)
+}
+
+// GetApiV1LegalKindWithResponse request returning *GetApiV1LegalKindResponse
+func (c *ClientWithResponses) GetApiV1LegalKindWithResponse(ctx context.Context, kind string, params *GetApiV1LegalKindParams, reqEditors ...RequestEditorFn) (*GetApiV1LegalKindResponse, error) {
+	rsp, err := c.GetApiV1LegalKind(ctx, kind, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1LegalKindResponse(rsp)
+}
+
+// GetApiV1LinksIdWithResponse request returning *GetApiV1LinksIdResponse
+func (c *ClientWithResponses) GetApiV1LinksIdWithResponse(ctx context.Context, id string, params *GetApiV1LinksIdParams, reqEditors ...RequestEditorFn) (*GetApiV1LinksIdResponse, error) {
+	rsp, err := c.GetApiV1LinksId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1LinksIdResponse(rsp)
+}
+
+// GetApiV1LocationsWithResponse request returning *GetApiV1LocationsResponse
+func (c *ClientWithResponses) GetApiV1LocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1LocationsResponse, error) {
+	rsp, err := c.GetApiV1Locations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1LocationsResponse(rsp)
+}
+
+// PutApiV1LocationsGateIdCloseWithResponse request returning *PutApiV1LocationsGateIdCloseResponse
+func (c *ClientWithResponses) PutApiV1LocationsGateIdCloseWithResponse(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*PutApiV1LocationsGateIdCloseResponse, error) {
+	rsp, err := c.PutApiV1LocationsGateIdClose(ctx, gateId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1LocationsGateIdCloseResponse(rsp)
+}
+
+// PostApiV1LocationsGateIdLinksWithBodyWithResponse request with arbitrary body returning *PostApiV1LocationsGateIdLinksResponse
+func (c *ClientWithResponses) PostApiV1LocationsGateIdLinksWithBodyWithResponse(ctx context.Context, gateId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1LocationsGateIdLinksResponse, error) {
+	rsp, err := c.PostApiV1LocationsGateIdLinksWithBody(ctx, gateId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1LocationsGateIdLinksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1LocationsGateIdLinksWithResponse(ctx context.Context, gateId int, body PostApiV1LocationsGateIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1LocationsGateIdLinksResponse, error) {
+	rsp, err := c.PostApiV1LocationsGateIdLinks(ctx, gateId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1LocationsGateIdLinksResponse(rsp)
+}
+
+// PutApiV1LocationsGateIdOpenWithResponse request returning *PutApiV1LocationsGateIdOpenResponse
+func (c *ClientWithResponses) PutApiV1LocationsGateIdOpenWithResponse(ctx context.Context, gateId int, reqEditors ...RequestEditorFn) (*PutApiV1LocationsGateIdOpenResponse, error) {
+	rsp, err := c.PutApiV1LocationsGateIdOpen(ctx, gateId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1LocationsGateIdOpenResponse(rsp)
+}
+
+// GetApiV1LocationsLocationIdGatesWithResponse request returning *GetApiV1LocationsLocationIdGatesResponse
+func (c *ClientWithResponses) GetApiV1LocationsLocationIdGatesWithResponse(ctx context.Context, locationId int, reqEditors ...RequestEditorFn) (*GetApiV1LocationsLocationIdGatesResponse, error) {
+	rsp, err := c.GetApiV1LocationsLocationIdGates(ctx, locationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1LocationsLocationIdGatesResponse(rsp)
+}
+
+// GetApiV1ScimV2UsersWithResponse request returning *GetApiV1ScimV2UsersResponse
+func (c *ClientWithResponses) GetApiV1ScimV2UsersWithResponse(ctx context.Context, params *GetApiV1ScimV2UsersParams, reqEditors ...RequestEditorFn) (*GetApiV1ScimV2UsersResponse, error) {
+	rsp, err := c.GetApiV1ScimV2Users(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ScimV2UsersResponse(rsp)
+}
+
+// PostApiV1ScimV2UsersWithBodyWithResponse request with arbitrary body returning *PostApiV1ScimV2UsersResponse
+func (c *ClientWithResponses) PostApiV1ScimV2UsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ScimV2UsersResponse, error) {
+	rsp, err := c.PostApiV1ScimV2UsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ScimV2UsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ScimV2UsersWithResponse(ctx context.Context, body PostApiV1ScimV2UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ScimV2UsersResponse, error) {
+	rsp, err := c.PostApiV1ScimV2Users(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ScimV2UsersResponse(rsp)
+}
+
+// DeleteApiV1ScimV2UsersIdWithResponse request returning *DeleteApiV1ScimV2UsersIdResponse
+func (c *ClientWithResponses) DeleteApiV1ScimV2UsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1ScimV2UsersIdResponse, error) {
+	rsp, err := c.DeleteApiV1ScimV2UsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1ScimV2UsersIdResponse(rsp)
+}
+
+// GetApiV1ScimV2UsersIdWithResponse request returning *GetApiV1ScimV2UsersIdResponse
+func (c *ClientWithResponses) GetApiV1ScimV2UsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1ScimV2UsersIdResponse, error) {
+	rsp, err := c.GetApiV1ScimV2UsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ScimV2UsersIdResponse(rsp)
+}
+
+// PatchApiV1ScimV2UsersIdWithBodyWithResponse request with arbitrary body returning *PatchApiV1ScimV2UsersIdResponse
+func (c *ClientWithResponses) PatchApiV1ScimV2UsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1ScimV2UsersIdResponse, error) {
+	rsp, err := c.PatchApiV1ScimV2UsersIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1ScimV2UsersIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiV1ScimV2UsersIdWithResponse(ctx context.Context, id string, body PatchApiV1ScimV2UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1ScimV2UsersIdResponse, error) {
+	rsp, err := c.PatchApiV1ScimV2UsersId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1ScimV2UsersIdResponse(rsp)
+}
+
+// PutApiV1ScimV2UsersIdWithBodyWithResponse request with arbitrary body returning *PutApiV1ScimV2UsersIdResponse
+func (c *ClientWithResponses) PutApiV1ScimV2UsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ScimV2UsersIdResponse, error) {
+	rsp, err := c.PutApiV1ScimV2UsersIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ScimV2UsersIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1ScimV2UsersIdWithResponse(ctx context.Context, id string, body PutApiV1ScimV2UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ScimV2UsersIdResponse, error) {
+	rsp, err := c.PutApiV1ScimV2UsersId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ScimV2UsersIdResponse(rsp)
+}
+
+// GetApiV1SyncChangesWithResponse request returning *GetApiV1SyncChangesResponse
+func (c *ClientWithResponses) GetApiV1SyncChangesWithResponse(ctx context.Context, params *GetApiV1SyncChangesParams, reqEditors ...RequestEditorFn) (*GetApiV1SyncChangesResponse, error) {
+	rsp, err := c.GetApiV1SyncChanges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1SyncChangesResponse(rsp)
+}
+
+// GetApiV1UsersWithResponse request returning *GetApiV1UsersResponse
+func (c *ClientWithResponses) GetApiV1UsersWithResponse(ctx context.Context, params *GetApiV1UsersParams, reqEditors ...RequestEditorFn) (*GetApiV1UsersResponse, error) {
+	rsp, err := c.GetApiV1Users(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1UsersResponse(rsp)
+}
+
+// PostApiV1UsersWithBodyWithResponse request with arbitrary body returning *PostApiV1UsersResponse
+func (c *ClientWithResponses) PostApiV1UsersWithBodyWithResponse(ctx context.Context, params *PostApiV1UsersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1UsersResponse, error) {
+	rsp, err := c.PostApiV1UsersWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1UsersWithResponse(ctx context.Context, params *PostApiV1UsersParams, body PostApiV1UsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1UsersResponse, error) {
+	rsp, err := c.PostApiV1Users(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersResponse(rsp)
+}
+
+// PostApiV1UsersBulkDeleteWithBodyWithResponse request with arbitrary body returning *PostApiV1UsersBulkDeleteResponse
+func (c *ClientWithResponses) PostApiV1UsersBulkDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1UsersBulkDeleteResponse, error) {
+	rsp, err := c.PostApiV1UsersBulkDeleteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersBulkDeleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1UsersBulkDeleteWithResponse(ctx context.Context, body PostApiV1UsersBulkDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1UsersBulkDeleteResponse, error) {
+	rsp, err := c.PostApiV1UsersBulkDelete(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersBulkDeleteResponse(rsp)
+}
+
+// GetApiV1UsersDuplicatesWithResponse request returning *GetApiV1UsersDuplicatesResponse
+func (c *ClientWithResponses) GetApiV1UsersDuplicatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1UsersDuplicatesResponse, error) {
+	rsp, err := c.GetApiV1UsersDuplicates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1UsersDuplicatesResponse(rsp)
+}
+
+// GetApiV1UsersTrashWithResponse request returning *GetApiV1UsersTrashResponse
+func (c *ClientWithResponses) GetApiV1UsersTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1UsersTrashResponse, error) {
+	rsp, err := c.GetApiV1UsersTrash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1UsersTrashResponse(rsp)
+}
+
+// DeleteApiV1UsersIdWithResponse request returning *DeleteApiV1UsersIdResponse
+func (c *ClientWithResponses) DeleteApiV1UsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteApiV1UsersIdResponse, error) {
+	rsp, err := c.DeleteApiV1UsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1UsersIdResponse(rsp)
+}
+
+// GetApiV1UsersIdWithResponse request returning *GetApiV1UsersIdResponse
+func (c *ClientWithResponses) GetApiV1UsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1UsersIdResponse, error) {
+	rsp, err := c.GetApiV1UsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1UsersIdResponse(rsp)
+}
+
+// PatchApiV1UsersIdWithBodyWithResponse request with arbitrary body returning *PatchApiV1UsersIdResponse
+func (c *ClientWithResponses) PatchApiV1UsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchApiV1UsersIdResponse, error) {
+	rsp, err := c.PatchApiV1UsersIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1UsersIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchApiV1UsersIdWithResponse(ctx context.Context, id string, body PatchApiV1UsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchApiV1UsersIdResponse, error) {
+	rsp, err := c.PatchApiV1UsersId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchApiV1UsersIdResponse(rsp)
+}
+
+// GetApiV1UsersIdHistoryWithResponse request returning *GetApiV1UsersIdHistoryResponse
+func (c *ClientWithResponses) GetApiV1UsersIdHistoryWithResponse(ctx context.Context, id string, params *GetApiV1UsersIdHistoryParams, reqEditors ...RequestEditorFn) (*GetApiV1UsersIdHistoryResponse, error) {
+	rsp, err := c.GetApiV1UsersIdHistory(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1UsersIdHistoryResponse(rsp)
+}
+
+// PostApiV1UsersIdMergeWithBodyWithResponse request with arbitrary body returning *PostApiV1UsersIdMergeResponse
+func (c *ClientWithResponses) PostApiV1UsersIdMergeWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdMergeResponse, error) {
+	rsp, err := c.PostApiV1UsersIdMergeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersIdMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1UsersIdMergeWithResponse(ctx context.Context, id string, body PostApiV1UsersIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdMergeResponse, error) {
+	rsp, err := c.PostApiV1UsersIdMerge(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersIdMergeResponse(rsp)
+}
+
+// GetApiV1UsersIdPhonesWithResponse request returning *GetApiV1UsersIdPhonesResponse
+func (c *ClientWithResponses) GetApiV1UsersIdPhonesWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1UsersIdPhonesResponse, error) {
+	rsp, err := c.GetApiV1UsersIdPhones(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1UsersIdPhonesResponse(rsp)
+}
+
+// PostApiV1UsersIdPhonesWithBodyWithResponse request with arbitrary body returning *PostApiV1UsersIdPhonesResponse
+func (c *ClientWithResponses) PostApiV1UsersIdPhonesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdPhonesResponse, error) {
+	rsp, err := c.PostApiV1UsersIdPhonesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersIdPhonesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1UsersIdPhonesWithResponse(ctx context.Context, id string, body PostApiV1UsersIdPhonesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdPhonesResponse, error) {
+	rsp, err := c.PostApiV1UsersIdPhones(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersIdPhonesResponse(rsp)
+}
+
+// DeleteApiV1UsersIdPhonesPhoneIdWithResponse request returning *DeleteApiV1UsersIdPhonesPhoneIdResponse
+func (c *ClientWithResponses) DeleteApiV1UsersIdPhonesPhoneIdWithResponse(ctx context.Context, id string, phoneId string, reqEditors ...RequestEditorFn) (*DeleteApiV1UsersIdPhonesPhoneIdResponse, error) {
+	rsp, err := c.DeleteApiV1UsersIdPhonesPhoneId(ctx, id, phoneId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1UsersIdPhonesPhoneIdResponse(rsp)
+}
+
+// PostApiV1UsersIdRestoreWithResponse request returning *PostApiV1UsersIdRestoreResponse
+func (c *ClientWithResponses) PostApiV1UsersIdRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdRestoreResponse, error) {
+	rsp, err := c.PostApiV1UsersIdRestore(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersIdRestoreResponse(rsp)
+}
+
+// PostApiV1UsersIdRetryAssignmentWithResponse request returning *PostApiV1UsersIdRetryAssignmentResponse
+func (c *ClientWithResponses) PostApiV1UsersIdRetryAssignmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostApiV1UsersIdRetryAssignmentResponse, error) {
+	rsp, err := c.PostApiV1UsersIdRetryAssignment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1UsersIdRetryAssignmentResponse(rsp)
+}
+
+// GetApiV1UsersIdSessionsWithResponse request returning *GetApiV1UsersIdSessionsResponse
+func (c *ClientWithResponses) GetApiV1UsersIdSessionsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetApiV1UsersIdSessionsResponse, error) {
+	rsp, err := c.GetApiV1UsersIdSessions(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1UsersIdSessionsResponse(rsp)
+}
+
+// GetPingWithResponse request returning *GetPingResponse
+func (c *ClientWithResponses) GetPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPingResponse, error) {
+	rsp, err := c.GetPing(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPingResponse(rsp)
+}
+
+// ParseGetResponse parses an HTTP response from a GetWithResponse call
+func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersHealthCheckResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminApiKeysResponse parses an HTTP response from a GetApiV1AdminApiKeysWithResponse call
+func ParseGetApiV1AdminApiKeysResponse(rsp *http.Response) (*GetApiV1AdminApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIKeysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminApiKeysResponse parses an HTTP response from a PostApiV1AdminApiKeysWithResponse call
+func ParsePostApiV1AdminApiKeysResponse(rsp *http.Response) (*PostApiV1AdminApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersCreatedAPIKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AdminApiKeysIdResponse parses an HTTP response from a DeleteApiV1AdminApiKeysIdWithResponse call
+func ParseDeleteApiV1AdminApiKeysIdResponse(rsp *http.Response) (*DeleteApiV1AdminApiKeysIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AdminApiKeysIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIKeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminAuditLogsResponse parses an HTTP response from a GetApiV1AdminAuditLogsWithResponse call
+func ParseGetApiV1AdminAuditLogsResponse(rsp *http.Response) (*GetApiV1AdminAuditLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminAuditLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersPaginatedAuditLogResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminAuditLogsIdResponse parses an HTTP response from a GetApiV1AdminAuditLogsIdWithResponse call
+func ParseGetApiV1AdminAuditLogsIdResponse(rsp *http.Response) (*GetApiV1AdminAuditLogsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminAuditLogsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAuditLogDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminAuditLogsIdCommentsResponse parses an HTTP response from a PostApiV1AdminAuditLogsIdCommentsWithResponse call
+func ParsePostApiV1AdminAuditLogsIdCommentsResponse(rsp *http.Response) (*PostApiV1AdminAuditLogsIdCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminAuditLogsIdCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersAuditCommentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminConfigReloadResponse parses an HTTP response from a PostApiV1AdminConfigReloadWithResponse call
+func ParsePostApiV1AdminConfigReloadResponse(rsp *http.Response) (*PostApiV1AdminConfigReloadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminConfigReloadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersConfigReloadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminCorsOriginsResponse parses an HTTP response from a GetApiV1AdminCorsOriginsWithResponse call
+func ParseGetApiV1AdminCorsOriginsResponse(rsp *http.Response) (*GetApiV1AdminCorsOriginsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminCorsOriginsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersCORSOriginsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminCorsOriginsResponse parses an HTTP response from a PostApiV1AdminCorsOriginsWithResponse call
+func ParsePostApiV1AdminCorsOriginsResponse(rsp *http.Response) (*PostApiV1AdminCorsOriginsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminCorsOriginsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersCORSOriginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AdminCorsOriginsIdResponse parses an HTTP response from a DeleteApiV1AdminCorsOriginsIdWithResponse call
+func ParseDeleteApiV1AdminCorsOriginsIdResponse(rsp *http.Response) (*DeleteApiV1AdminCorsOriginsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AdminCorsOriginsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminDigestRunsResponse parses an HTTP response from a GetApiV1AdminDigestRunsWithResponse call
+func ParseGetApiV1AdminDigestRunsResponse(rsp *http.Response) (*GetApiV1AdminDigestRunsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminDigestRunsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersDigestRunsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminExportsResponse parses an HTTP response from a PostApiV1AdminExportsWithResponse call
+func ParsePostApiV1AdminExportsResponse(rsp *http.Response) (*PostApiV1AdminExportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminExportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest HandlersExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminExportsIdResponse parses an HTTP response from a GetApiV1AdminExportsIdWithResponse call
+func ParseGetApiV1AdminExportsIdResponse(rsp *http.Response) (*GetApiV1AdminExportsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminExportsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminFeedResponse parses an HTTP response from a GetApiV1AdminFeedWithResponse call
+func ParseGetApiV1AdminFeedResponse(rsp *http.Response) (*GetApiV1AdminFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 426:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON426 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminForcedLogoutsResponse parses an HTTP response from a PostApiV1AdminForcedLogoutsWithResponse call
+func ParsePostApiV1AdminForcedLogoutsResponse(rsp *http.Response) (*PostApiV1AdminForcedLogoutsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminForcedLogoutsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest HandlersForcedLogoutResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminForcedLogoutsIdResponse parses an HTTP response from a GetApiV1AdminForcedLogoutsIdWithResponse call
+func ParseGetApiV1AdminForcedLogoutsIdResponse(rsp *http.Response) (*GetApiV1AdminForcedLogoutsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminForcedLogoutsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersForcedLogoutResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminGateEventsResponse parses an HTTP response from a GetApiV1AdminGateEventsWithResponse call
+func ParseGetApiV1AdminGateEventsResponse(rsp *http.Response) (*GetApiV1AdminGateEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminGateEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateEventLogsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminGateEventsExportResponse parses an HTTP response from a GetApiV1AdminGateEventsExportWithResponse call
+func ParseGetApiV1AdminGateEventsExportResponse(rsp *http.Response) (*GetApiV1AdminGateEventsExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminGateEventsExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminGateReportsResponse parses an HTTP response from a GetApiV1AdminGateReportsWithResponse call
+func ParseGetApiV1AdminGateReportsResponse(rsp *http.Response) (*GetApiV1AdminGateReportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminGateReportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateReportsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminGateReportsIdPhotoResponse parses an HTTP response from a GetApiV1AdminGateReportsIdPhotoWithResponse call
+func ParseGetApiV1AdminGateReportsIdPhotoResponse(rsp *http.Response) (*GetApiV1AdminGateReportsIdPhotoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminGateReportsIdPhotoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 302:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON302 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminGateReportsIdResolveResponse parses an HTTP response from a PostApiV1AdminGateReportsIdResolveWithResponse call
+func ParsePostApiV1AdminGateReportsIdResolveResponse(rsp *http.Response) (*PostApiV1AdminGateReportsIdResolveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminGateReportsIdResolveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminGatesMaintenanceResponse parses an HTTP response from a GetApiV1AdminGatesMaintenanceWithResponse call
+func ParseGetApiV1AdminGatesMaintenanceResponse(rsp *http.Response) (*GetApiV1AdminGatesMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminGatesMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateMaintenancesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AdminGatesGateIdMaintenanceResponse parses an HTTP response from a DeleteApiV1AdminGatesGateIdMaintenanceWithResponse call
+func ParseDeleteApiV1AdminGatesGateIdMaintenanceResponse(rsp *http.Response) (*DeleteApiV1AdminGatesGateIdMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AdminGatesGateIdMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1AdminGatesGateIdMaintenanceResponse parses an HTTP response from a PutApiV1AdminGatesGateIdMaintenanceWithResponse call
+func ParsePutApiV1AdminGatesGateIdMaintenanceResponse(rsp *http.Response) (*PutApiV1AdminGatesGateIdMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1AdminGatesGateIdMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateMaintenanceResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1AdminGatesGateIdOpenResponse parses an HTTP response from a PutApiV1AdminGatesGateIdOpenWithResponse call
+func ParsePutApiV1AdminGatesGateIdOpenResponse(rsp *http.Response) (*PutApiV1AdminGatesGateIdOpenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1AdminGatesGateIdOpenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateActionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersGateActionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminImpersonateUserIdResponse parses an HTTP response from a PostApiV1AdminImpersonateUserIdWithResponse call
+func ParsePostApiV1AdminImpersonateUserIdResponse(rsp *http.Response) (*PostApiV1AdminImpersonateUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminImpersonateUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersImpersonationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminInviteCodesResponse parses an HTTP response from a GetApiV1AdminInviteCodesWithResponse call
+func ParseGetApiV1AdminInviteCodesResponse(rsp *http.Response) (*GetApiV1AdminInviteCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminInviteCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersInviteCodesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminInviteCodesResponse parses an HTTP response from a PostApiV1AdminInviteCodesWithResponse call
+func ParsePostApiV1AdminInviteCodesResponse(rsp *http.Response) (*PostApiV1AdminInviteCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminInviteCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersInviteCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AdminInviteCodesIdResponse parses an HTTP response from a DeleteApiV1AdminInviteCodesIdWithResponse call
+func ParseDeleteApiV1AdminInviteCodesIdResponse(rsp *http.Response) (*DeleteApiV1AdminInviteCodesIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AdminInviteCodesIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersInviteCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminJobsResponse parses an HTTP response from a GetApiV1AdminJobsWithResponse call
+func ParseGetApiV1AdminJobsResponse(rsp *http.Response) (*GetApiV1AdminJobsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersScheduledJobsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminLegalResponse parses an HTTP response from a PostApiV1AdminLegalWithResponse call
+func ParsePostApiV1AdminLegalResponse(rsp *http.Response) (*PostApiV1AdminLegalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminLegalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersLegalDocumentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminLocationsFreezesResponse parses an HTTP response from a GetApiV1AdminLocationsFreezesWithResponse call
+func ParseGetApiV1AdminLocationsFreezesResponse(rsp *http.Response) (*GetApiV1AdminLocationsFreezesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminLocationsFreezesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLocationFreezesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminLocationsOverridesResponse parses an HTTP response from a GetApiV1AdminLocationsOverridesWithResponse call
+func ParseGetApiV1AdminLocationsOverridesResponse(rsp *http.Response) (*GetApiV1AdminLocationsOverridesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminLocationsOverridesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLocationOverridesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AdminLocationsIdFreezeResponse parses an HTTP response from a DeleteApiV1AdminLocationsIdFreezeWithResponse call
+func ParseDeleteApiV1AdminLocationsIdFreezeResponse(rsp *http.Response) (*DeleteApiV1AdminLocationsIdFreezeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AdminLocationsIdFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLocationFreezesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminLocationsIdFreezeResponse parses an HTTP response from a PostApiV1AdminLocationsIdFreezeWithResponse call
+func ParsePostApiV1AdminLocationsIdFreezeResponse(rsp *http.Response) (*PostApiV1AdminLocationsIdFreezeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminLocationsIdFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersLocationFreezeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AdminLocationsIdOverrideResponse parses an HTTP response from a DeleteApiV1AdminLocationsIdOverrideWithResponse call
+func ParseDeleteApiV1AdminLocationsIdOverrideResponse(rsp *http.Response) (*DeleteApiV1AdminLocationsIdOverrideResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AdminLocationsIdOverrideResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1AdminLocationsIdOverrideResponse parses an HTTP response from a PutApiV1AdminLocationsIdOverrideWithResponse call
+func ParsePutApiV1AdminLocationsIdOverrideResponse(rsp *http.Response) (*PutApiV1AdminLocationsIdOverrideResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1AdminLocationsIdOverrideResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLocationOverrideResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminLoginResponse parses an HTTP response from a PostApiV1AdminLoginWithResponse call
+func ParsePostApiV1AdminLoginResponse(rsp *http.Response) (*PostApiV1AdminLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminLoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminLoginPasskeyResponse parses an HTTP response from a PostApiV1AdminLoginPasskeyWithResponse call
+func ParsePostApiV1AdminLoginPasskeyResponse(rsp *http.Response) (*PostApiV1AdminLoginPasskeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminLoginPasskeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminLoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminLoginPasskeyOptionsResponse parses an HTTP response from a PostApiV1AdminLoginPasskeyOptionsWithResponse call
+func ParsePostApiV1AdminLoginPasskeyOptionsResponse(rsp *http.Response) (*PostApiV1AdminLoginPasskeyOptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminLoginPasskeyOptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersPasskeyRequestOptionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminNotificationsResponse parses an HTTP response from a GetApiV1AdminNotificationsWithResponse call
+func ParseGetApiV1AdminNotificationsResponse(rsp *http.Response) (*GetApiV1AdminNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminNotificationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiV1AdminNotificationsReadAllResponse parses an HTTP response from a PatchApiV1AdminNotificationsReadAllWithResponse call
+func ParsePatchApiV1AdminNotificationsReadAllResponse(rsp *http.Response) (*PatchApiV1AdminNotificationsReadAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiV1AdminNotificationsReadAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminNotificationsIdResponse parses an HTTP response from a GetApiV1AdminNotificationsIdWithResponse call
+func ParseGetApiV1AdminNotificationsIdResponse(rsp *http.Response) (*GetApiV1AdminNotificationsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminNotificationsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminNotificationDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminNotificationsIdCommentsResponse parses an HTTP response from a PostApiV1AdminNotificationsIdCommentsWithResponse call
+func ParsePostApiV1AdminNotificationsIdCommentsResponse(rsp *http.Response) (*PostApiV1AdminNotificationsIdCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminNotificationsIdCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersAuditCommentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiV1AdminNotificationsIdReadResponse parses an HTTP response from a PatchApiV1AdminNotificationsIdReadWithResponse call
+func ParsePatchApiV1AdminNotificationsIdReadResponse(rsp *http.Response) (*PatchApiV1AdminNotificationsIdReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiV1AdminNotificationsIdReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminNotificationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminProviderMigrationReportResponse parses an HTTP response from a GetApiV1AdminProviderMigrationReportWithResponse call
+func ParseGetApiV1AdminProviderMigrationReportResponse(rsp *http.Response) (*GetApiV1AdminProviderMigrationReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminProviderMigrationReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersProviderMigrationReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminProviderUsageResponse parses an HTTP response from a GetApiV1AdminProviderUsageWithResponse call
+func ParseGetApiV1AdminProviderUsageResponse(rsp *http.Response) (*GetApiV1AdminProviderUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminProviderUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersProviderUsageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminRefreshResponse parses an HTTP response from a PostApiV1AdminRefreshWithResponse call
+func ParsePostApiV1AdminRefreshResponse(rsp *http.Response) (*PostApiV1AdminRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersRefreshResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminRegistrationsResponse parses an HTTP response from a GetApiV1AdminRegistrationsWithResponse call
+func ParseGetApiV1AdminRegistrationsResponse(rsp *http.Response) (*GetApiV1AdminRegistrationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminRegistrationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersRegistrationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminRegistrationsIdApproveResponse parses an HTTP response from a PostApiV1AdminRegistrationsIdApproveWithResponse call
+func ParsePostApiV1AdminRegistrationsIdApproveResponse(rsp *http.Response) (*PostApiV1AdminRegistrationsIdApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminRegistrationsIdApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersRegistrationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminRegistrationsIdRejectResponse parses an HTTP response from a PostApiV1AdminRegistrationsIdRejectWithResponse call
+func ParsePostApiV1AdminRegistrationsIdRejectResponse(rsp *http.Response) (*PostApiV1AdminRegistrationsIdRejectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminRegistrationsIdRejectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersRegistrationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminReportsResponse parses an HTTP response from a GetApiV1AdminReportsWithResponse call
+func ParseGetApiV1AdminReportsResponse(rsp *http.Response) (*GetApiV1AdminReportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminReportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 200:
+	// Content-type (text/csv) unsupported
+
+	case rsp.StatusCode == 400:
+	// Content-type (text/csv) unsupported
+
+	case rsp.StatusCode == 401:
+	// Content-type (text/csv) unsupported
+
+	case rsp.StatusCode == 403:
+	// Content-type (text/csv) unsupported
+
+	case rsp.StatusCode == 500:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminRoutesResponse parses an HTTP response from a GetApiV1AdminRoutesWithResponse call
+func ParseGetApiV1AdminRoutesResponse(rsp *http.Response) (*GetApiV1AdminRoutesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminRoutesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersRegisteredRoutesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminSandboxSmsResponse parses an HTTP response from a GetApiV1AdminSandboxSmsWithResponse call
+func ParseGetApiV1AdminSandboxSmsResponse(rsp *http.Response) (*GetApiV1AdminSandboxSmsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminSandboxSmsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersSandboxSMSResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminSearchResponse parses an HTTP response from a GetApiV1AdminSearchWithResponse call
+func ParseGetApiV1AdminSearchResponse(rsp *http.Response) (*GetApiV1AdminSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminSearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminSecurityDenialsResponse parses an HTTP response from a GetApiV1AdminSecurityDenialsWithResponse call
+func ParseGetApiV1AdminSecurityDenialsResponse(rsp *http.Response) (*GetApiV1AdminSecurityDenialsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminSecurityDenialsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersSecurityDenialsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminSloResponse parses an HTTP response from a GetApiV1AdminSloWithResponse call
+func ParseGetApiV1AdminSloResponse(rsp *http.Response) (*GetApiV1AdminSloResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminSloResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersSLOSummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminStaleDevicesResponse parses an HTTP response from a GetApiV1AdminStaleDevicesWithResponse call
+func ParseGetApiV1AdminStaleDevicesResponse(rsp *http.Response) (*GetApiV1AdminStaleDevicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminStaleDevicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersStaleDeviceReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminUsageResponse parses an HTTP response from a GetApiV1AdminUsageWithResponse call
+func ParseGetApiV1AdminUsageResponse(rsp *http.Response) (*GetApiV1AdminUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUsageRollupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminUsersResponse parses an HTTP response from a GetApiV1AdminUsersWithResponse call
+func ParseGetApiV1AdminUsersResponse(rsp *http.Response) (*GetApiV1AdminUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminsListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminUsersResponse parses an HTTP response from a PostApiV1AdminUsersWithResponse call
+func ParsePostApiV1AdminUsersResponse(rsp *http.Response) (*PostApiV1AdminUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersAdminResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminUsersImportResponse parses an HTTP response from a PostApiV1AdminUsersImportWithResponse call
+func ParsePostApiV1AdminUsersImportResponse(rsp *http.Response) (*PostApiV1AdminUsersImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminUsersImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersAdminImportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAdminImportErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AdminUsersIdResponse parses an HTTP response from a DeleteApiV1AdminUsersIdWithResponse call
+func ParseDeleteApiV1AdminUsersIdResponse(rsp *http.Response) (*DeleteApiV1AdminUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AdminUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminUsersIdResponse parses an HTTP response from a GetApiV1AdminUsersIdWithResponse call
+func ParseGetApiV1AdminUsersIdResponse(rsp *http.Response) (*GetApiV1AdminUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiV1AdminUsersIdResponse parses an HTTP response from a PatchApiV1AdminUsersIdWithResponse call
+func ParsePatchApiV1AdminUsersIdResponse(rsp *http.Response) (*PatchApiV1AdminUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiV1AdminUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminUsersIdHistoryResponse parses an HTTP response from a GetApiV1AdminUsersIdHistoryWithResponse call
+func ParseGetApiV1AdminUsersIdHistoryResponse(rsp *http.Response) (*GetApiV1AdminUsersIdHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminUsersIdHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminUsersIdPasskeysResponse parses an HTTP response from a GetApiV1AdminUsersIdPasskeysWithResponse call
+func ParseGetApiV1AdminUsersIdPasskeysResponse(rsp *http.Response) (*GetApiV1AdminUsersIdPasskeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminUsersIdPasskeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminPasskeysResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminUsersIdPasskeysResponse parses an HTTP response from a PostApiV1AdminUsersIdPasskeysWithResponse call
+func ParsePostApiV1AdminUsersIdPasskeysResponse(rsp *http.Response) (*PostApiV1AdminUsersIdPasskeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminUsersIdPasskeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersAdminPasskeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminUsersIdPasskeysOptionsResponse parses an HTTP response from a PostApiV1AdminUsersIdPasskeysOptionsWithResponse call
+func ParsePostApiV1AdminUsersIdPasskeysOptionsResponse(rsp *http.Response) (*PostApiV1AdminUsersIdPasskeysOptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminUsersIdPasskeysOptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersPasskeyCreationOptionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AdminUsersIdPasskeysCredentialIdResponse parses an HTTP response from a DeleteApiV1AdminUsersIdPasskeysCredentialIdWithResponse call
+func ParseDeleteApiV1AdminUsersIdPasskeysCredentialIdResponse(rsp *http.Response) (*DeleteApiV1AdminUsersIdPasskeysCredentialIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AdminUsersIdPasskeysCredentialIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminPasskeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiV1AdminUsersIdPasskeysCredentialIdResponse parses an HTTP response from a PatchApiV1AdminUsersIdPasskeysCredentialIdWithResponse call
+func ParsePatchApiV1AdminUsersIdPasskeysCredentialIdResponse(rsp *http.Response) (*PatchApiV1AdminUsersIdPasskeysCredentialIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiV1AdminUsersIdPasskeysCredentialIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAdminPasskeyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminWebhookSecretsResponse parses an HTTP response from a GetApiV1AdminWebhookSecretsWithResponse call
+func ParseGetApiV1AdminWebhookSecretsResponse(rsp *http.Response) (*GetApiV1AdminWebhookSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminWebhookSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersWebhookSecretsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AdminWebhookSecretsScopeRotateResponse parses an HTTP response from a PostApiV1AdminWebhookSecretsScopeRotateWithResponse call
+func ParsePostApiV1AdminWebhookSecretsScopeRotateResponse(rsp *http.Response) (*PostApiV1AdminWebhookSecretsScopeRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AdminWebhookSecretsScopeRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersRotatedWebhookSecretResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthChangePasswordResponse parses an HTTP response from a PostApiV1AuthChangePasswordWithResponse call
+func ParsePostApiV1AuthChangePasswordResponse(rsp *http.Response) (*PostApiV1AuthChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AuthCheckPhoneResponse parses an HTTP response from a GetApiV1AuthCheckPhoneWithResponse call
+func ParseGetApiV1AuthCheckPhoneResponse(rsp *http.Response) (*GetApiV1AuthCheckPhoneResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AuthCheckPhoneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersPhoneAvailabilityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthForgotPasswordResponse parses an HTTP response from a PostApiV1AuthForgotPasswordWithResponse call
+func ParsePostApiV1AuthForgotPasswordResponse(rsp *http.Response) (*PostApiV1AuthForgotPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthForgotPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AuthLegalResponse parses an HTTP response from a GetApiV1AuthLegalWithResponse call
+func ParseGetApiV1AuthLegalResponse(rsp *http.Response) (*GetApiV1AuthLegalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AuthLegalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLegalStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthLegalAcceptResponse parses an HTTP response from a PostApiV1AuthLegalAcceptWithResponse call
+func ParsePostApiV1AuthLegalAcceptResponse(rsp *http.Response) (*PostApiV1AuthLegalAcceptResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthLegalAcceptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLegalStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthLoginResponse parses an HTTP response from a PostApiV1AuthLoginWithResponse call
+func ParsePostApiV1AuthLoginResponse(rsp *http.Response) (*PostApiV1AuthLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersPasswordExpiredResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthLoginOtpConfirmResponse parses an HTTP response from a PostApiV1AuthLoginOtpConfirmWithResponse call
+func ParsePostApiV1AuthLoginOtpConfirmResponse(rsp *http.Response) (*PostApiV1AuthLoginOtpConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthLoginOtpConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthLoginOtpRequestResponse parses an HTTP response from a PostApiV1AuthLoginOtpRequestWithResponse call
+func ParsePostApiV1AuthLoginOtpRequestResponse(rsp *http.Response) (*PostApiV1AuthLoginOtpRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthLoginOtpRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthLogoutResponse parses an HTTP response from a PostApiV1AuthLogoutWithResponse call
+func ParsePostApiV1AuthLogoutResponse(rsp *http.Response) (*PostApiV1AuthLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AuthNotificationPreferencesResponse parses an HTTP response from a GetApiV1AuthNotificationPreferencesWithResponse call
+func ParseGetApiV1AuthNotificationPreferencesResponse(rsp *http.Response) (*GetApiV1AuthNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AuthNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersNotificationPreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1AuthNotificationPreferencesResponse parses an HTTP response from a PutApiV1AuthNotificationPreferencesWithResponse call
+func ParsePutApiV1AuthNotificationPreferencesResponse(rsp *http.Response) (*PutApiV1AuthNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1AuthNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersNotificationPreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthRefreshResponse parses an HTTP response from a PostApiV1AuthRefreshWithResponse call
+func ParsePostApiV1AuthRefreshResponse(rsp *http.Response) (*PostApiV1AuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersRefreshResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthRegisterResponse parses an HTTP response from a PostApiV1AuthRegisterWithResponse call
+func ParsePostApiV1AuthRegisterResponse(rsp *http.Response) (*PostApiV1AuthRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersRegisterResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthResetPasswordResponse parses an HTTP response from a PostApiV1AuthResetPasswordWithResponse call
+func ParsePostApiV1AuthResetPasswordResponse(rsp *http.Response) (*PostApiV1AuthResetPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthResetPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AuthSessionsResponse parses an HTTP response from a DeleteApiV1AuthSessionsWithResponse call
+func ParseDeleteApiV1AuthSessionsResponse(rsp *http.Response) (*DeleteApiV1AuthSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AuthSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AuthSessionsResponse parses an HTTP response from a GetApiV1AuthSessionsWithResponse call
+func ParseGetApiV1AuthSessionsResponse(rsp *http.Response) (*GetApiV1AuthSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AuthSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUserSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1AuthSessionsIdResponse parses an HTTP response from a DeleteApiV1AuthSessionsIdWithResponse call
+func ParseDeleteApiV1AuthSessionsIdResponse(rsp *http.Response) (*DeleteApiV1AuthSessionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1AuthSessionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthVerifyOtpResponse parses an HTTP response from a PostApiV1AuthVerifyOtpWithResponse call
+func ParsePostApiV1AuthVerifyOtpResponse(rsp *http.Response) (*PostApiV1AuthVerifyOtpResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthVerifyOtpResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersRegisterResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthVerifyOtpResendResponse parses an HTTP response from a PostApiV1AuthVerifyOtpResendWithResponse call
+func ParsePostApiV1AuthVerifyOtpResendResponse(rsp *http.Response) (*PostApiV1AuthVerifyOtpResendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthVerifyOtpResendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AvailableLocationsResponse parses an HTTP response from a GetApiV1AvailableLocationsWithResponse call
+func ParseGetApiV1AvailableLocationsResponse(rsp *http.Response) (*GetApiV1AvailableLocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AvailableLocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAvailableLocationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ContactsResponse parses an HTTP response from a GetApiV1ContactsWithResponse call
+func ParseGetApiV1ContactsResponse(rsp *http.Response) (*GetApiV1ContactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ContactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersContactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiV1ContactsResponse parses an HTTP response from a PatchApiV1ContactsWithResponse call
+func ParsePatchApiV1ContactsResponse(rsp *http.Response) (*PatchApiV1ContactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiV1ContactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersContactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1FilesKeyResponse parses an HTTP response from a GetApiV1FilesKeyWithResponse call
+func ParseGetApiV1FilesKeyResponse(rsp *http.Response) (*GetApiV1FilesKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1FilesKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1GateCommandsIdResponse parses an HTTP response from a GetApiV1GateCommandsIdWithResponse call
+func ParseGetApiV1GateCommandsIdResponse(rsp *http.Response) (*GetApiV1GateCommandsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1GateCommandsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateCommandResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1GateCommandsIdCallbackResponse parses an HTTP response from a PostApiV1GateCommandsIdCallbackWithResponse call
+func ParsePostApiV1GateCommandsIdCallbackResponse(rsp *http.Response) (*PostApiV1GateCommandsIdCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1GateCommandsIdCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateCommandResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1GatesGateIdReportResponse parses an HTTP response from a PostApiV1GatesGateIdReportWithResponse call
+func ParsePostApiV1GatesGateIdReportResponse(rsp *http.Response) (*PostApiV1GatesGateIdReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1GatesGateIdReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersGateReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersGateReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1LegalResponse parses an HTTP response from a GetApiV1LegalWithResponse call
+func ParseGetApiV1LegalResponse(rsp *http.Response) (*GetApiV1LegalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1LegalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLegalDocumentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1LegalKindResponse parses an HTTP response from a GetApiV1LegalKindWithResponse call
+func ParseGetApiV1LegalKindResponse(rsp *http.Response) (*GetApiV1LegalKindResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1LegalKindResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLegalDocumentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1LinksIdResponse parses an HTTP response from a GetApiV1LinksIdWithResponse call
+func ParseGetApiV1LinksIdResponse(rsp *http.Response) (*GetApiV1LinksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1LinksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateLinkMetadataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1LocationsResponse parses an HTTP response from a GetApiV1LocationsWithResponse call
+func ParseGetApiV1LocationsResponse(rsp *http.Response) (*GetApiV1LocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1LocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersLocationsListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1LocationsGateIdCloseResponse parses an HTTP response from a PutApiV1LocationsGateIdCloseWithResponse call
+func ParsePutApiV1LocationsGateIdCloseResponse(rsp *http.Response) (*PutApiV1LocationsGateIdCloseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1LocationsGateIdCloseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateActionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest HandlersGateMaintenanceRefusedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersGateActionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1LocationsGateIdLinksResponse parses an HTTP response from a PostApiV1LocationsGateIdLinksWithResponse call
+func ParsePostApiV1LocationsGateIdLinksResponse(rsp *http.Response) (*PostApiV1LocationsGateIdLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1LocationsGateIdLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersGateLinkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1LocationsGateIdOpenResponse parses an HTTP response from a PutApiV1LocationsGateIdOpenWithResponse call
+func ParsePutApiV1LocationsGateIdOpenResponse(rsp *http.Response) (*PutApiV1LocationsGateIdOpenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1LocationsGateIdOpenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGateActionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest HandlersLocationFrozenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersGateActionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1LocationsLocationIdGatesResponse parses an HTTP response from a GetApiV1LocationsLocationIdGatesWithResponse call
+func ParseGetApiV1LocationsLocationIdGatesResponse(rsp *http.Response) (*GetApiV1LocationsLocationIdGatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1LocationsLocationIdGatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersGatesListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ScimV2UsersResponse parses an HTTP response from a GetApiV1ScimV2UsersWithResponse call
+func ParseGetApiV1ScimV2UsersResponse(rsp *http.Response) (*GetApiV1ScimV2UsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ScimV2UsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ScimV2UsersResponse parses an HTTP response from a PostApiV1ScimV2UsersWithResponse call
+func ParsePostApiV1ScimV2UsersResponse(rsp *http.Response) (*PostApiV1ScimV2UsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ScimV2UsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1ScimV2UsersIdResponse parses an HTTP response from a DeleteApiV1ScimV2UsersIdWithResponse call
+func ParseDeleteApiV1ScimV2UsersIdResponse(rsp *http.Response) (*DeleteApiV1ScimV2UsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1ScimV2UsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersSCIMError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersSCIMError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ScimV2UsersIdResponse parses an HTTP response from a GetApiV1ScimV2UsersIdWithResponse call
+func ParseGetApiV1ScimV2UsersIdResponse(rsp *http.Response) (*GetApiV1ScimV2UsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ScimV2UsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiV1ScimV2UsersIdResponse parses an HTTP response from a PatchApiV1ScimV2UsersIdWithResponse call
+func ParsePatchApiV1ScimV2UsersIdResponse(rsp *http.Response) (*PatchApiV1ScimV2UsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiV1ScimV2UsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1ScimV2UsersIdResponse parses an HTTP response from a PutApiV1ScimV2UsersIdWithResponse call
+func ParsePutApiV1ScimV2UsersIdResponse(rsp *http.Response) (*PutApiV1ScimV2UsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1ScimV2UsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1SyncChangesResponse parses an HTTP response from a GetApiV1SyncChangesWithResponse call
+func ParseGetApiV1SyncChangesResponse(rsp *http.Response) (*GetApiV1SyncChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1SyncChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersSyncChangesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1UsersResponse parses an HTTP response from a GetApiV1UsersWithResponse call
+func ParseGetApiV1UsersResponse(rsp *http.Response) (*GetApiV1UsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1UsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUsersListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1UsersResponse parses an HTTP response from a PostApiV1UsersWithResponse call
+func ParsePostApiV1UsersResponse(rsp *http.Response) (*PostApiV1UsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1UsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1UsersBulkDeleteResponse parses an HTTP response from a PostApiV1UsersBulkDeleteWithResponse call
+func ParsePostApiV1UsersBulkDeleteResponse(rsp *http.Response) (*PostApiV1UsersBulkDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1UsersBulkDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersBulkDeleteSummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest HandlersBulkDeleteResultResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1UsersDuplicatesResponse parses an HTTP response from a GetApiV1UsersDuplicatesWithResponse call
+func ParseGetApiV1UsersDuplicatesResponse(rsp *http.Response) (*GetApiV1UsersDuplicatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1UsersDuplicatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersDuplicateUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1UsersTrashResponse parses an HTTP response from a GetApiV1UsersTrashWithResponse call
+func ParseGetApiV1UsersTrashResponse(rsp *http.Response) (*GetApiV1UsersTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1UsersTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersTrashedUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1UsersIdResponse parses an HTTP response from a DeleteApiV1UsersIdWithResponse call
+func ParseDeleteApiV1UsersIdResponse(rsp *http.Response) (*DeleteApiV1UsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1UsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersTrashedUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1UsersIdResponse parses an HTTP response from a GetApiV1UsersIdWithResponse call
+func ParseGetApiV1UsersIdResponse(rsp *http.Response) (*GetApiV1UsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1UsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUserDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchApiV1UsersIdResponse parses an HTTP response from a PatchApiV1UsersIdWithResponse call
+func ParsePatchApiV1UsersIdResponse(rsp *http.Response) (*PatchApiV1UsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchApiV1UsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1UsersIdHistoryResponse parses an HTTP response from a GetApiV1UsersIdHistoryWithResponse call
+func ParseGetApiV1UsersIdHistoryResponse(rsp *http.Response) (*GetApiV1UsersIdHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1UsersIdHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUserHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1UsersIdMergeResponse parses an HTTP response from a PostApiV1UsersIdMergeWithResponse call
+func ParsePostApiV1UsersIdMergeResponse(rsp *http.Response) (*PostApiV1UsersIdMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1UsersIdMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersMergeUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1UsersIdPhonesResponse parses an HTTP response from a GetApiV1UsersIdPhonesWithResponse call
+func ParseGetApiV1UsersIdPhonesResponse(rsp *http.Response) (*GetApiV1UsersIdPhonesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1UsersIdPhonesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUserPhonesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1UsersIdPhonesResponse parses an HTTP response from a PostApiV1UsersIdPhonesWithResponse call
+func ParsePostApiV1UsersIdPhonesResponse(rsp *http.Response) (*PostApiV1UsersIdPhonesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1UsersIdPhonesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HandlersUserPhoneResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1UsersIdPhonesPhoneIdResponse parses an HTTP response from a DeleteApiV1UsersIdPhonesPhoneIdWithResponse call
+func ParseDeleteApiV1UsersIdPhonesPhoneIdResponse(rsp *http.Response) (*DeleteApiV1UsersIdPhonesPhoneIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1UsersIdPhonesPhoneIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1UsersIdRestoreResponse parses an HTTP response from a PostApiV1UsersIdRestoreWithResponse call
+func ParsePostApiV1UsersIdRestoreResponse(rsp *http.Response) (*PostApiV1UsersIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1UsersIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1UsersIdRetryAssignmentResponse parses an HTTP response from a PostApiV1UsersIdRetryAssignmentWithResponse call
+func ParsePostApiV1UsersIdRetryAssignmentResponse(rsp *http.Response) (*PostApiV1UsersIdRetryAssignmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1UsersIdRetryAssignmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1UsersIdSessionsResponse parses an HTTP response from a GetApiV1UsersIdSessionsWithResponse call
+func ParseGetApiV1UsersIdSessionsResponse(rsp *http.Response) (*GetApiV1UsersIdSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1UsersIdSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandlersUserSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest HandlersAPIResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPingResponse parses an HTTP response from a GetPingWithResponse call
+func ParseGetPingResponse(rsp *http.Response) (*GetPingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
@@ -0,0 +1,13 @@
+// Package apiclient is the typed Go client of the Ololo Gate API, for services that call it
+// instead of writing HTTP requests by hand. client.gen.go is generated by `make client`
+// (oapi-codegen) from docs/swagger.json, converted to OpenAPI 3 in openapi.json; do not edit
+// them, change the handler annotations and regenerate. The same description is served at
+// GET /openapi.json.
+//
+//	api, err := apiclient.NewClientWithResponses("https://gate-api.internal:8080",
+//		apiclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+//			req.Header.Set("Authorization", "Bearer "+token)
+//			return nil
+//		}))
+//	resp, err := api.GetApiV1AdminNotificationsWithResponse(ctx, &apiclient.GetApiV1AdminNotificationsParams{})
+package apiclient
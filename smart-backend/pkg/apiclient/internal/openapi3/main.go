@@ -0,0 +1,70 @@
+// Command openapi3 converts the Swagger 2.0 description generated by swag into the OpenAPI 3
+// document the typed client is generated from (make client).
+//
+//	go run ./pkg/apiclient/internal/openapi3 docs/swagger.json pkg/apiclient/openapi.json
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: openapi3 <swagger.json> <openapi.json>")
+		os.Exit(2)
+	}
+	if err := convert(os.Args[1], os.Args[2]); err != nil {
+		fmt.Fprintln(os.Stderr, "openapi3:", err)
+		os.Exit(1)
+	}
+}
+
+// convert reads a Swagger 2.0 document from src and writes it to dst as OpenAPI 3
+func convert(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	var v2 openapi2.T
+	if err := json.Unmarshal(data, &v2); err != nil {
+		return fmt.Errorf("parse %s: %w", src, err)
+	}
+	v3, err := openapi2conv.ToV3(&v2)
+	if err != nil {
+		return fmt.Errorf("convert %s: %w", src, err)
+	}
+	binaryFileResponses(v3)
+
+	encoded, err := json.MarshalIndent(v3, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, append(encoded, '\n'), 0o644)
+}
+
+// binaryFileResponses rewrites the Swagger 2.0 "file" responses (downloads, exports, photos),
+// which the converter keeps as is, into the OpenAPI 3 binary string
+func binaryFileResponses(doc *openapi3.T) {
+	for _, item := range doc.Paths {
+		for _, op := range item.Operations() {
+			for _, response := range op.Responses {
+				if response.Value == nil {
+					continue
+				}
+				for _, media := range response.Value.Content {
+					if media.Schema != nil && media.Schema.Value != nil && media.Schema.Value.Type == "file" {
+						media.Schema.Value.Type = openapi3.TypeString
+						media.Schema.Value.Format = "binary"
+					}
+				}
+			}
+		}
+	}
+}